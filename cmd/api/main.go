@@ -3,26 +3,22 @@ package main
 
 import (
 	"context"
-	"database/sql"
-	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
-	"reflect"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/biyonik/conduit-go/internal/config"
-	"github.com/biyonik/conduit-go/internal/controllers"
-	"github.com/biyonik/conduit-go/internal/jobs"
-	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/internal/providers"
 	"github.com/biyonik/conduit-go/internal/router"
-	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/broadcast"
 	"github.com/biyonik/conduit-go/pkg/container"
-	"github.com/biyonik/conduit-go/pkg/database"
-	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/foundation"
+	"github.com/biyonik/conduit-go/pkg/version"
+	"github.com/biyonik/conduit-go/pkg/ws"
 )
 
 // -----------------------------------------------------------------------------
@@ -55,305 +51,62 @@ func main() {
 	// =========================================================================
 	c := container.New()
 
-	// =========================================================================
-	// 2. SERVİSLERİ KONTEYNERE KAYDET
-	// =========================================================================
-
-	// Config servisi
-	c.Register(func(c *container.Container) (*config.Config, error) {
-		return config.Load(), nil
-	})
-
-	// Logger servisi
-	c.Register(func(c *container.Container) (*log.Logger, error) {
-		return log.New(os.Stdout, "[Conduit-Go] ", log.Ldate|log.Ltime|log.Lshortfile), nil
-	})
-
-	// Veritabanı Bağlantısı
-	c.Register(func(c *container.Container) (*sql.DB, error) {
-		cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
-		db, err := database.Connect(cfg.DB.DSN)
-		if err != nil {
-			return nil, err
-		}
-		return db, nil
-	})
-
-	// SQL Grammar
-	c.Register(func(c *container.Container) (database.Grammar, error) {
-		return database.NewMySQLGrammar(), nil
-	})
-
-	// =========================================================================
-	// 3. PHASE 3: CACHE SYSTEM INITIALIZATION
-	// =========================================================================
-
-	// Cache servisi - driver'a göre oluştur
-	c.Register(func(c *container.Container) (cache.Cache, error) {
-		cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
-		logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
-
-		switch cfg.Cache.Driver {
-		case "redis":
-			// Redis Cache
-			logger.Println("🔄 Redis cache başlatılıyor...")
-
-			redisConfig := &database.RedisConfig{
-				Host:         cfg.Redis.Host,
-				Port:         cfg.Redis.Port,
-				Password:     cfg.Redis.Password,
-				DB:           cfg.Redis.DB,
-				PoolSize:     10,
-				MinIdleConns: 2,
-				MaxRetries:   3,
-				DialTimeout:  5 * time.Second,
-				ReadTimeout:  3 * time.Second,
-				WriteTimeout: 3 * time.Second,
-			}
-
-			redisClient, err := database.NewRedisClient(redisConfig, logger)
-			if err != nil {
-				logger.Printf("⚠️  Redis bağlantısı başarısız, file cache'e geçiliyor: %v", err)
-				// Fallback to file cache
-				return cache.NewFileCache(cfg.Cache.FileDir, logger)
-			}
-
-			// Redis client'ı container'a kaydet (shutdown için gerekli)
-			c.Register(func(c *container.Container) (*database.RedisClient, error) {
-				return redisClient, nil
-			})
-
-			logger.Printf("✅ Redis cache başlatıldı (prefix: %s)", cfg.Cache.Prefix)
-			return cache.NewRedisCache(redisClient.Client(), logger, cfg.Cache.Prefix), nil
-
-		case "file":
-			// File Cache
-			logger.Println("🔄 File cache başlatılıyor...")
-			fileCache, err := cache.NewFileCache(cfg.Cache.FileDir, logger)
-			if err != nil {
-				return nil, fmt.Errorf("file cache oluşturulamadı: %w", err)
-			}
-			logger.Printf("✅ File cache başlatıldı (dir: %s)", cfg.Cache.FileDir)
-			return fileCache, nil
-
-		case "memory":
-			// Memory Cache
-			logger.Println("🔄 Memory cache başlatılıyor...")
-			if cfg.IsProduction() {
-				logger.Println("⚠️  UYARI: Memory cache production ortamı için önerilmez!")
-			}
-			logger.Println("✅ Memory cache başlatıldı")
-			return cache.NewMemoryCache(logger), nil
-
-		default:
-			return nil, fmt.Errorf("geçersiz cache driver: %s", cfg.Cache.Driver)
-		}
-	})
-
-	c.Register(func(c *container.Container) (queue.Queue, error) {
-		cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
-		logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
-
-		switch cfg.Queue.Driver {
-		case "redis":
-			logger.Println("🔄 Redis queue başlatılıyor...")
-
-			// Redis client'ı al
-			redisClient, err := c.Get(reflect.TypeOf((*database.RedisClient)(nil)))
-			if err != nil {
-				logger.Printf("⚠️  Redis bağlantısı yok, sync queue'e geçiliyor")
-				// Fallback to sync queue
-				return queue.NewSyncQueue(logger), nil
-			}
-
-			rc := redisClient.(*database.RedisClient)
-			logger.Printf("✅ Redis queue başlatıldı (prefix: %s)", cfg.Cache.Prefix)
-			return queue.NewRedisQueue(rc.Client(), logger, cfg.Cache.Prefix), nil
-
-		case "sync":
-			logger.Println("✅ Sync queue başlatıldı (immediate execution)")
-			return queue.NewSyncQueue(logger), nil
-
-		default:
-			return nil, fmt.Errorf("geçersiz queue driver: %s", cfg.Queue.Driver)
-		}
-	})
-
-	// Controller'lar
-	c.Register(controllers.NewAppController)
-	c.Register(controllers.NewAuthController)
-	c.Register(controllers.NewPasswordController)
-
-	// =========================================================================
-	// 4. GEREKLI SERVİSLERİ RESOLVE ET
-	// =========================================================================
-	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
-	cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
-	cacheDriver := c.MustGet(reflect.TypeOf((*cache.Cache)(nil)).Elem()).(cache.Cache)
-
-	logger.Println("📋 Registering job types...")
-
-	// Job type'larını register et
-	queue.RegisterJob("*jobs.SendEmailJob", func() queue.Job {
-		return &jobs.SendEmailJob{}
-	})
-	queue.RegisterJob("*jobs.ProcessUploadJob", func() queue.Job {
-		return &jobs.ProcessUploadJob{}
+	// Config servisi. Eksik zorunlu değişken veya hatalı değer varsa
+	// varsayılanlarla çalışmak yerine tüm hatalar listelenip çıkılır.
+	appConfig, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	container.Register(c, func(c *container.Container) (*config.Config, error) {
+		return appConfig, nil
 	})
 
-	logger.Println("✅ Job types registered")
-
-	appController := c.MustGet(reflect.TypeOf((*controllers.AppController)(nil))).(*controllers.AppController)
-	authController := c.MustGet(reflect.TypeOf((*controllers.AuthController)(nil))).(*controllers.AuthController)
-	passwordController := c.MustGet(reflect.TypeOf((*controllers.PasswordController)(nil))).(*controllers.PasswordController)
-
 	// =========================================================================
-	// 5. CACHE DEMO (Opsiyonel - Development için)
-	// =========================================================================
-	if cfg.IsDevelopment() {
-		logger.Println("\n📝 Cache System Demo:")
-
-		// Set example
-		err := cacheDriver.Set("app:version", "1.0.0-phase3", 10*time.Minute)
-		if err != nil {
-			logger.Printf("⚠️  Cache set hatası: %v", err)
-		} else {
-			logger.Println("✅ Cache set: app:version = 1.0.0-phase3")
-		}
-
-		// Get example
-		version, err := cacheDriver.Get("app:version")
-		if err != nil {
-			logger.Printf("⚠️  Cache get hatası: %v", err)
-		} else if version != nil {
-			logger.Printf("✅ Cache get: app:version = %v", version)
-		}
-
-		// Remember pattern example
-		startTime := time.Now()
-		data, err := cacheDriver.Remember("demo:expensive", 5*time.Minute, func() (interface{}, error) {
-			logger.Println("   🔄 Expensive operation simulating...")
-			time.Sleep(100 * time.Millisecond)
-			return map[string]string{"result": "computed"}, nil
-		})
-		elapsed := time.Since(startTime)
-		if err != nil {
-			logger.Printf("⚠️  Remember hatası: %v", err)
-		} else {
-			logger.Printf("✅ Remember: %v (took: %v)", data, elapsed)
-		}
-
-		// Second call (should be cached)
-		startTime = time.Now()
-		data2, _ := cacheDriver.Remember("demo:expensive", 5*time.Minute, func() (interface{}, error) {
-			logger.Println("   ❌ Bu mesaj görünmemeli!")
-			return nil, nil
-		})
-		elapsed2 := time.Since(startTime)
-		logger.Printf("✅ Remember (cached): %v (took: %v)\n", data2, elapsed2)
+	// 2. SERVICE PROVIDER'LAR
+	// =========================================================================
+	// Önce tüm provider'lar servislerini kaydeder (Register), sonra sırayla
+	// boot edilir (route'lar, listener'lar, global ayarlar). Kapanışta ters
+	// sırayla kaynaklarını serbest bırakırlar. Bkz: internal/providers
+	app := foundation.New(c,
+		providers.AppServiceProvider{},
+		providers.CacheServiceProvider{},
+		providers.QueueServiceProvider{},
+		providers.MailServiceProvider{},
+		providers.AuthServiceProvider{},
+		providers.DispatcherServiceProvider{},
+		&providers.BroadcastServiceProvider{},
+		providers.RouteServiceProvider{},
+	)
+	if err := app.Boot(); err != nil {
+		log.Fatalf("❌ %v", err)
 	}
 
-	// =========================================================================
-	// 6. ROUTER'I OLUŞTUR VE MIDDLEWARE'LERI KAYDET
-	// =========================================================================
-	r := router.New()
-
-	// Global Middleware'ler (Sıralama önemli!)
-	r.Use(middleware.PanicRecovery(logger)) // 1. Panic yakalama
-	r.Use(middleware.Logging)               // 2. Request logging
-	r.Use(middleware.CORSMiddleware("*"))   // 3. CORS
-	r.Use(middleware.RateLimit(100, 60))    // 4. Rate limiting: 100 req/min
-
-	// =========================================================================
-	// 7. PUBLIC ROTALARI TANIMLA
-	// =========================================================================
-
-	// Genel endpoint'ler
-	r.GET("/", appController.HomeHandler)
-
-	// Health check endpoint - Cache status dahil
-	r.GET("/health", appController.HealthHandler)
-
-	// =========================================================================
-	// 8. AUTH ROTALARI (PUBLIC - Authentication gerektirmez)
-	// =========================================================================
-	authGroup := r.Group("/api/auth")
-
-	// CSRF koruması ekle (POST/PUT/DELETE için)
-	authGroup.Use(middleware.CSRFProtection())
-
-	// Daha sıkı rate limit (brute force koruması)
-	authGroup.Use(middleware.RateLimit(10, 60)) // 10 req/min
-
-	// Authentication endpoint'leri
-	authGroup.POST("/register", authController.Register)
-	authGroup.POST("/login", authController.Login)
-	authGroup.POST("/refresh", authController.RefreshToken)
-
-	// Password reset endpoint'leri
-	authGroup.POST("/forgot-password", passwordController.ForgotPassword)
-	authGroup.POST("/reset-password", passwordController.ResetPassword)
-
-	// =========================================================================
-	// 9. PROTECTED ROTALARI TANIMLA (Authentication gerekir)
-	// =========================================================================
-
-	// Authenticated user endpoint'leri
-	r.POST("/api/auth/logout", authController.Logout).
-		Middleware(middleware.Auth())
-
-	r.GET("/api/auth/profile", authController.Profile).
-		Middleware(middleware.Auth())
-
-	r.PUT("/api/auth/profile", authController.UpdateProfile).
-		Middleware(middleware.Auth()).
-		Middleware(middleware.CSRFProtection())
-
-	r.PUT("/api/auth/password", authController.ChangePassword).
-		Middleware(middleware.Auth()).
-		Middleware(middleware.CSRFProtection())
-
-	// =========================================================================
-	// 10. API V1 ROUTES (Authenticated + Stricter Limits)
-	// =========================================================================
-	apiV1 := r.Group("/api/v1")
-	apiV1.Use(middleware.Auth())            // Tüm API endpoint'leri protected
-	apiV1.Use(middleware.RateLimit(50, 60)) // API için daha sıkı limit: 50 req/min
-
-	// Test endpoint (authenticated)
-	apiV1.GET("/check", appController.CheckHandler)
-	apiV1.GET("/testquery", appController.TestQueryHandler)
-
-	// =========================================================================
-	// 11. ADMIN ROTALARI (Sadece admin'ler erişebilir)
-	// =========================================================================
-	adminGroup := r.Group("/api/admin")
-	adminGroup.Use(middleware.Auth())            // Authentication gerekli
-	adminGroup.Use(middleware.Admin())           // Admin role gerekli
-	adminGroup.Use(middleware.RateLimit(30, 60)) // Admin için limit: 30 req/min
-
-	// Admin endpoint'leri (Phase 3'te eklenecek)
-	// adminGroup.GET("/users", adminController.ListUsers)
-	// adminGroup.DELETE("/users/{id}", adminController.DeleteUser)
+	logger := container.GetLogger(c)
+	cfg := container.GetConfig(c)
+	devMail := cfg.IsDevelopment() && (cfg.Mail.Driver == "log" || cfg.Mail.Driver == "array")
 
 	// =========================================================================
-	// 12. HTTP SUNUCUSUNU YAPILANDIR
+	// 3. HTTP SUNUCUSUNU YAPILANDIR
 	// =========================================================================
 	srv := &http.Server{
 		Addr:           ":" + cfg.Server.Port,
-		Handler:        r,
+		Handler:        container.MustResolve[*router.Router](c),
 		ReadTimeout:    15 * time.Second,
 		WriteTimeout:   15 * time.Second,
 		IdleTimeout:    60 * time.Second,
 		MaxHeaderBytes: 1 << 20, // 1 MB
 	}
 
+	// Açık SSE ve WebSocket bağlantıları shutdown'ı bekletmesin
+	srv.RegisterOnShutdown(container.MustResolve[*broadcast.Hub](c).Close)
+	srv.RegisterOnShutdown(container.MustResolve[*ws.Server](c).Close)
+
 	// =========================================================================
-	// 13. SUNUCUYU GOROUTINE'DE BAŞLAT
+	// 4. SUNUCUYU GOROUTINE'DE BAŞLAT
 	// =========================================================================
 	go func() {
 		logger.Println("\n" + strings.Repeat("=", 70))
-		logger.Printf("🚀 Conduit-Go Framework v1.0.0 (Phase 3)")
+		logger.Printf("🚀 Conduit-Go Framework %s", version.String())
 		logger.Println(strings.Repeat("=", 70))
 		logger.Printf("📍 Server: http://localhost:%s", cfg.Server.Port)
 		logger.Printf("🌐 Environment: %s", cfg.App.Env)
@@ -365,20 +118,45 @@ func main() {
 		logger.Println("📡 Available Endpoints:")
 		logger.Println("   PUBLIC:")
 		logger.Printf("   - GET  /health")
+		if devMail {
+			logger.Printf("   - GET  /dev/mail (mail önizlemesi)")
+		}
+		logger.Printf("   - GET  /broadcasting/events (SSE, driver: %s)", cfg.Broadcast.Driver)
+		logger.Printf("   - GET  /broadcasting/ws (WebSocket)")
 		logger.Println("   AUTH:")
 		logger.Printf("   - POST /api/auth/register")
 		logger.Printf("   - POST /api/auth/login")
 		logger.Printf("   - POST /api/auth/refresh")
 		logger.Printf("   - POST /api/auth/forgot-password")
 		logger.Printf("   - POST /api/auth/reset-password")
+		logger.Printf("   - POST /api/auth/magic-link")
+		logger.Printf("   - POST /api/auth/magic-link/consume")
+		if cfg.OIDC.Enabled() {
+			logger.Printf("   - GET  /api/auth/oidc/redirect")
+			logger.Printf("   - GET  /api/auth/oidc/callback")
+		}
 		logger.Println("   PROTECTED:")
 		logger.Printf("   - POST /api/auth/logout")
 		logger.Printf("   - GET  /api/auth/profile")
 		logger.Printf("   - PUT  /api/auth/profile")
+		logger.Printf("   - PATCH /api/auth/profile")
 		logger.Printf("   - PUT  /api/auth/password")
+		logger.Printf("   - GET  /api/auth/tokens")
+		logger.Printf("   - POST /api/auth/tokens")
+		logger.Printf("   - DELETE /api/auth/tokens/{id}")
+		logger.Printf("   - DELETE /api/auth/impersonate")
 		logger.Println("   API:")
 		logger.Printf("   - GET  /api/v1/check")
 		logger.Printf("   - GET  /api/v1/testquery")
+		logger.Println("   ADMIN:")
+		logger.Printf("   - GET  /api/admin/users/export")
+		logger.Printf("   - POST /api/admin/users/import")
+		logger.Printf("   - GET  /api/admin/users/import/{id}")
+		logger.Printf("   - POST /api/admin/users/{id}/impersonate")
+		if cfg.EventStore.Enabled {
+			logger.Printf("   - GET  /api/admin/events")
+			logger.Printf("   - POST /api/admin/events/replay")
+		}
 		logger.Println(strings.Repeat("=", 70))
 
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -387,7 +165,7 @@ func main() {
 	}()
 
 	// =========================================================================
-	// 14. GRACEFUL SHUTDOWN
+	// 5. GRACEFUL SHUTDOWN
 	// =========================================================================
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -406,27 +184,10 @@ func main() {
 		logger.Println("✅ HTTP sunucusu gracefully kapatıldı")
 	}
 
-	// Redis client kapat (varsa)
-	if cfg.Cache.Driver == "redis" {
-		logger.Println("⏳ Redis bağlantısı kapatılıyor...")
-		if redisClient, _ := c.Get(reflect.TypeOf((*database.RedisClient)(nil))); redisClient != nil {
-			if rc, e := redisClient.(*database.RedisClient); e {
-				if err := rc.Close(); err != nil {
-					logger.Printf("⚠️  Redis kapatılamadı: %v", err)
-				} else {
-					logger.Println("✅ Redis bağlantısı kapatıldı")
-				}
-			}
-		}
-	}
-
-	// Database bağlantıları kapat
-	logger.Println("⏳ Database bağlantıları kapatılıyor...")
-	db := c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
-	if err := db.Close(); err != nil {
-		logger.Printf("⚠️  Database kapatılamadı: %v", err)
-	} else {
-		logger.Println("✅ Database bağlantıları kapatıldı")
+	// Broadcast listener, async event'ler, SMTP, Redis ve veritabanı
+	// bağlantıları provider'lar tarafından ters sırayla kapatılır
+	if err := app.Terminate(shutdownCtx); err != nil {
+		logger.Printf("⚠️  %v", err)
 	}
 
 	logger.Println("👋 Uygulama temiz bir şekilde kapatıldı. Hoşça kal!")
@@ -7,168 +7,1809 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
+
+	_ "github.com/biyonik/conduit-go/database/migrations" // Migration'lar init ile kaydedilir
+	_ "github.com/biyonik/conduit-go/database/seeders"    // Seeder'lar init ile kaydedilir
+	"github.com/biyonik/conduit-go/internal/config"
+	"github.com/biyonik/conduit-go/internal/http/response"
+	_ "github.com/biyonik/conduit-go/internal/jobs" // Job tipleri init ile kaydedilir
+	"github.com/biyonik/conduit-go/internal/providers"
+	"github.com/biyonik/conduit-go/internal/router"
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/console"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/crypt"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/database/migration"
+	"github.com/biyonik/conduit-go/pkg/database/schema"
+	"github.com/biyonik/conduit-go/pkg/database/seeder"
+	"github.com/biyonik/conduit-go/pkg/events"
+	"github.com/biyonik/conduit-go/pkg/foundation"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/scheduler"
+	"github.com/biyonik/conduit-go/pkg/version"
+	"github.com/go-sql-driver/mysql"
 )
 
 // -----------------------------------------------------------------------------
 // Migration Commands
 // -----------------------------------------------------------------------------
 
-func runMigrations(step int) {
-	fmt.Println("🔄 Running database migrations...")
+// bootMigrator, migration'ları çalıştıracak Migrator'ı CLI için başlatır.
+// Migration'lar database/migrations paketinin init fonksiyonlarıyla kayıtlıdır.
+func bootMigrator(pretend bool) (*migration.Migrator, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := database.Connect(cfg.DB.DSN)
+	if err != nil {
+		return nil, nil, fmt.Errorf("veritabanı bağlantısı kurulamadı: %w", err)
+	}
+
+	migrator := migration.NewMigrator(db, migration.NewMySQLGrammar()).SetPretend(pretend)
+	return migrator, func() { db.Close() }, nil
+}
+
+// mustBootMigrator, bootMigrator hata verirse çıkış yapar.
+func mustBootMigrator(pretend bool) (*migration.Migrator, func()) {
+	migrator, closeFn, err := bootMigrator(pretend)
+	if err != nil {
+		console.Fatal("Migrator could not be started: %v", err)
+	}
+	if pretend {
+		console.Info("Pretend mode: SQL is printed, nothing is executed")
+	}
+	return migrator, closeFn
+}
+
+// finishMigrations, migrate komutlarının sonucunu yazdırır; hata varsa
+// çıkış yapar.
+func finishMigrations(done []string, err error, verb string) {
+	if err != nil {
+		console.Error("%v", err)
+		if len(done) > 0 {
+			console.Line("   (%d migration(s) %s before the error)", len(done), verb)
+		}
+		os.Exit(1)
+	}
+	if len(done) == 0 {
+		console.Success("Nothing to do")
+		return
+	}
+	console.Success("%d migration(s) %s", len(done), verb)
+}
+
+func runMigrations(step int, pretend bool) {
+	migrator, closeFn := mustBootMigrator(pretend)
+	defer closeFn()
+
+	console.Info("Running database migrations...")
+	done, err := migrator.Run(step)
+	finishMigrations(done, err, "migrated")
+}
+
+func rollbackMigrations(step int, pretend bool) {
+	migrator, closeFn := mustBootMigrator(pretend)
+	defer closeFn()
+
+	if step > 0 {
+		console.Info("Rolling back %d migration(s)...", step)
+	} else {
+		console.Info("Rolling back the last batch...")
+	}
+	done, err := migrator.Rollback(step)
+	finishMigrations(done, err, "rolled back")
+}
+
+func resetMigrations(pretend bool) {
+	migrator, closeFn := mustBootMigrator(pretend)
+	defer closeFn()
+
+	console.Info("Rolling back all migrations...")
+	done, err := migrator.Reset()
+	finishMigrations(done, err, "rolled back")
+}
+
+func freshMigrations(pretend bool) {
+	migrator, closeFn := mustBootMigrator(pretend)
+	defer closeFn()
+
+	console.Info("Dropping all tables and running all migrations...")
+	done, err := migrator.Fresh()
+	finishMigrations(done, err, "migrated")
+}
+
+func showMigrationStatus() {
+	migrator, closeFn := mustBootMigrator(false)
+	defer closeFn()
+
+	statuses, err := migrator.Status()
+	if err != nil {
+		console.Fatal("Migration status could not be read: %v", err)
+	}
+
+	console.Title("Database Migration Status")
+
+	if len(statuses) == 0 {
+		console.Line("No migrations registered (conduit make:migration <name>)")
+		return
+	}
+
+	pending := 0
+	rows := make([][]string, len(statuses))
+	for i, status := range statuses {
+		if status.Ran {
+			rows[i] = []string{status.Name, console.Colorize(console.Green, "Ran"), strconv.Itoa(status.Batch)}
+		} else {
+			pending++
+			rows[i] = []string{status.Name, console.Colorize(console.Yellow, "Pending"), "-"}
+		}
+	}
+	console.Table([]string{"Migration", "Status", "Batch"}, rows)
+
+	console.NewLine()
+	console.Line("Total: %d, pending: %d", len(statuses), pending)
+}
+
+// -----------------------------------------------------------------------------
+// Seed Commands
+// -----------------------------------------------------------------------------
+
+// bootAppContainer, seeder'lar, zamanlanmış görevler ve uygulama komutları
+// için config, logger, veritabanı, grammar ve cache kayıtlı bir container
+// oluşturur.
+//
+// Veritabanı ve cache ilk kullanımda açılır; veritabanı kullanmayan komutlar
+// bağlantı olmadan da çalışır. Dönen close fonksiyonu açılan tüm
+// bağlantıları kapatır.
+func bootAppContainer() (*container.Container, *config.Config, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		closersMu sync.Mutex
+		closers   []func()
+	)
+
+	c := container.New()
+	c.Register(func(c *container.Container) (*config.Config, error) {
+		return cfg, nil
+	})
+	c.Register(func(c *container.Container) (*log.Logger, error) {
+		return log.New(os.Stdout, "", log.LstdFlags), nil
+	})
+	c.Register(func(c *container.Container) (*sql.DB, error) {
+		db, err := database.Connect(cfg.DB.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("veritabanı bağlantısı kurulamadı: %w", err)
+		}
+		closersMu.Lock()
+		closers = append(closers, func() { db.Close() })
+		closersMu.Unlock()
+		return db, nil
+	})
+	c.Register(func(c *container.Container) (database.Grammar, error) {
+		return database.NewMySQLGrammar(), nil
+	})
+	c.Register(func(c *container.Container) (cache.Cache, error) {
+		store, closeFn, err := openCache(cfg)
+		if err != nil {
+			return nil, err
+		}
+		closersMu.Lock()
+		closers = append(closers, closeFn)
+		closersMu.Unlock()
+		return store, nil
+	})
+
+	closeAll := func() {
+		closersMu.Lock()
+		defer closersMu.Unlock()
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}
+	return c, cfg, closeAll, nil
+}
+
+// seedDatabase, adı verilen seeder'ı çalıştırır. Production'da onay
+// istenir; --force onayı atlar.
+func seedDatabase(class string, force bool) {
+	s, ok := seeder.Get(class)
+	if !ok {
+		console.Error("Seeder not found: %s", class)
+		if names := seeder.Registered(); len(names) > 0 {
+			console.Line("   Registered seeders: %s", strings.Join(names, ", "))
+		}
+		os.Exit(1)
+	}
+
+	c, cfg, closeFn, err := bootAppContainer()
+	if err != nil {
+		console.Fatal("Seeders could not be started: %v", err)
+	}
+	defer closeFn()
+
+	// Bağlantı hatası seeder içinde panic yerine burada raporlansın
+	if _, err := c.Get(reflect.TypeOf((*sql.DB)(nil))); err != nil {
+		console.Fatal("Seeders could not be started: %v", err)
+	}
+
+	if cfg.IsProduction() && !force {
+		console.Warn("Application is in production")
+		if !console.Confirm("Do you really wish to seed the database?", false) {
+			console.Line("Operation cancelled (use --force to skip this prompt)")
+			return
+		}
+	}
+
+	start := time.Now()
+	if err := seeder.Call(c, s); err != nil {
+		console.Fatal("Seeding failed: %v", err)
+	}
+	console.Success("Database seeded (%s)", time.Since(start).Round(time.Millisecond))
+}
+
+// -----------------------------------------------------------------------------
+// Database Commands
+// -----------------------------------------------------------------------------
+
+// bootDatabase, config'deki veritabanına CLI için bağlanır.
+func bootDatabase() (*sql.DB, *config.Config, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := database.Connect(cfg.DB.DSN)
+	if err != nil {
+		return nil, nil, fmt.Errorf("veritabanı bağlantısı kurulamadı: %w", err)
+	}
+	return db, cfg, nil
+}
+
+// mustBootDatabase, bootDatabase hata verirse çıkış yapar.
+func mustBootDatabase() (*sql.DB, *config.Config) {
+	db, cfg, err := bootDatabase()
+	if err != nil {
+		console.Fatal("Database could not be opened: %v", err)
+	}
+	return db, cfg
+}
+
+// showDatabase, bağlantı bilgilerini ve tabloları (tahmini satır sayısı ve
+// boyutla) gösterir.
+func showDatabase() {
+	db, cfg := mustBootDatabase()
+	defer db.Close()
+
+	inspector := schema.NewInspector(db)
+	info, err := inspector.Database()
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+	tables, err := inspector.Tables()
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+
+	host, user := "-", "-"
+	if dsn, err := mysql.ParseDSN(cfg.DB.DSN); err == nil {
+		host, user = dsn.Addr, dsn.User
+	}
+	stats := db.Stats()
+
+	console.Title("Database")
+	console.Table([]string{"Setting", "Value"}, [][]string{
+		{"Database", info.Name},
+		{"Version", "MySQL " + info.Version},
+		{"Host", host},
+		{"Username", user},
+		{"Open connections", fmt.Sprintf("%d (max %d)", stats.OpenConnections, stats.MaxOpenConnections)},
+		{"Tables", strconv.Itoa(info.Tables)},
+		{"Total size", formatBytes(info.Size)},
+	})
+
+	if len(tables) == 0 {
+		return
+	}
+
+	rows := make([][]string, len(tables))
+	for i, t := range tables {
+		rows[i] = []string{t.Name, strconv.FormatInt(t.Rows, 10), formatBytes(t.Size), t.Engine}
+	}
+	console.NewLine()
+	console.Table([]string{"Table", "Rows (est.)", "Size", "Engine"}, rows)
+}
+
+// showTable, tablonun kolonlarını, index'lerini ve foreign key'lerini
+// gösterir.
+func showTable(name string) {
+	db, _ := mustBootDatabase()
+	defer db.Close()
+
+	inspector := schema.NewInspector(db)
+	table, err := inspector.Table(name)
+	if errors.Is(err, schema.ErrTableNotFound) {
+		console.Fatal("Table not found: %s", name)
+	}
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+
+	columns, err := inspector.Columns(name)
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+	indexes, err := inspector.Indexes(name)
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+	foreignKeys, err := inspector.ForeignKeys(name)
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+
+	console.Title(table.Name)
+	console.Line("Rows (est.): %d   Size: %s   Engine: %s   Collation: %s",
+		table.Rows, formatBytes(table.Size), table.Engine, table.Collation)
+	console.NewLine()
+
+	rows := make([][]string, len(columns))
+	for i, c := range columns {
+		nullable := "NO"
+		if c.Nullable {
+			nullable = "YES"
+		}
+		def := "-"
+		if c.Default.Valid {
+			def = c.Default.String
+		}
+		rows[i] = []string{c.Name, c.Type, nullable, def, c.Key, c.Extra}
+	}
+	console.Table([]string{"Column", "Type", "Nullable", "Default", "Key", "Extra"}, rows)
+
+	if len(indexes) > 0 {
+		rows = make([][]string, len(indexes))
+		for i, index := range indexes {
+			kind := "index"
+			switch {
+			case index.Primary:
+				kind = "primary"
+			case index.Unique:
+				kind = "unique"
+			}
+			rows[i] = []string{index.Name, strings.Join(index.Columns, ", "), kind, strings.ToLower(index.Type)}
+		}
+		console.NewLine()
+		console.Table([]string{"Index", "Columns", "Kind", "Type"}, rows)
+	}
+
+	if len(foreignKeys) > 0 {
+		rows = make([][]string, len(foreignKeys))
+		for i, fk := range foreignKeys {
+			rows[i] = []string{
+				fk.Name,
+				strings.Join(fk.Columns, ", "),
+				fmt.Sprintf("%s(%s)", fk.ReferencedTable, strings.Join(fk.ReferencedColumns, ", ")),
+				fk.OnUpdate,
+				fk.OnDelete,
+			}
+		}
+		console.NewLine()
+		console.Table([]string{"Foreign Key", "Columns", "References", "On Update", "On Delete"}, rows)
+	}
+}
+
+// wipeDatabase, migration'ları çalıştırmadan tüm tabloları siler.
+func wipeDatabase(pretend bool) {
+	migrator, closeFn := mustBootMigrator(pretend)
+	defer closeFn()
+
+	console.Info("Dropping all tables...")
+	if err := migrator.DropAllTables(); err != nil {
+		console.Fatal("%v", err)
+	}
+}
+
+// formatBytes, byte sayısını okunabilir biçime çevirir (örn: 1.5 MB).
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// -----------------------------------------------------------------------------
+// Schedule Commands
+// -----------------------------------------------------------------------------
+
+// bootScheduler, uygulama container'ını kurar ve görevleri tanımlar.
+//
+// WithoutOverlapping lock'ları uygulamanın cache'inde tutulur; böylece cron'un
+// ayrı process'lerde başlattığı schedule:run'lar birbirini görür.
+func bootScheduler() (*scheduler.Scheduler, func(), error) {
+	c, _, closeFn, err := bootAppContainer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := foundation.New(c, providers.ScheduleServiceProvider{}).Boot(); err != nil {
+		closeFn()
+		return nil, nil, err
+	}
+
+	s, err := container.Resolve[*scheduler.Scheduler](c)
+	if err != nil {
+		closeFn()
+		return nil, nil, err
+	}
+	return s, closeFn, nil
+}
+
+// mustBootScheduler, bootScheduler'ı çağırır; hata durumunda çıkar.
+func mustBootScheduler() (*scheduler.Scheduler, func()) {
+	s, closeFn, err := bootScheduler()
+	if err != nil {
+		fmt.Printf("❌ Scheduler could not be started: %v\n", err)
+		os.Exit(1)
+	}
+	return s, closeFn
+}
+
+// runSchedule, bu dakika due olan görevleri bir kez çalıştırır (cron'dan
+// her dakika çağrılır). Bir görev başarısız olursa çıkış kodu 1'dir.
+func runSchedule() {
+	s, closeFn := mustBootScheduler()
+	defer closeFn()
+
+	results := s.RunDue(context.Background(), time.Now())
+	if len(results) == 0 {
+		fmt.Println("ℹ️  No scheduled tasks are due")
+		return
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		closeFn()
+		os.Exit(1)
+	}
+}
+
+// workSchedule, SIGINT/SIGTERM gelene kadar her dakika due görevleri
+// çalıştırır; kapanırken çalışan görevlerin bitmesini bekler.
+func workSchedule() {
+	s, closeFn := mustBootScheduler()
+	defer closeFn()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Printf("⏰ Scheduler started (%d tasks). Press Ctrl+C to stop.\n", len(s.Tasks()))
+	s.Work(ctx)
+	fmt.Println("🛑 Scheduler stopped")
+}
+
+// listSchedule, görevleri cron ifadesi ve bir sonraki çalışma zamanıyla
+// listeler. Görevler çalıştırılmadığı için bağlantı açılmaz.
+func listSchedule() {
+	s := scheduler.New(log.New(io.Discard, "", 0))
+	providers.Schedule(s, container.New())
+
+	tasks := s.Tasks()
+	if len(tasks) == 0 {
+		fmt.Println("⚠️  No scheduled tasks")
+		return
+	}
+
+	now := time.Now().In(s.Location())
+	fmt.Printf("📋 Scheduled tasks (%d):\n\n", len(tasks))
+	for _, task := range tasks {
+		next := task.NextRun(now)
+		fmt.Printf("   %-15s  %-28s  next: %s (in %s)\n",
+			task.Expression(), task.Name(), next.Format("2006-01-02 15:04"), next.Sub(now).Round(time.Second))
+		if task.Description() != "" {
+			fmt.Printf("   %-15s  %s\n", "", task.Description())
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Key Commands
+// -----------------------------------------------------------------------------
+
+// generateAppKey, yeni bir APP_KEY üretir ve env dosyasına yazar.
+//
+// Mevcut bir anahtar --force olmadan değiştirilmez: eski anahtarla şifrelenmiş
+// cookie, cache ve queue verileri okunamaz hale gelir.
+func generateAppKey(envFile string, show bool, force bool) {
+	key, err := crypt.GenerateKey()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	if show {
+		fmt.Println(key)
+		return
+	}
+
+	env, err := config.ReadEnvFile(envFile)
+	if err != nil {
+		fmt.Printf("❌ Failed to read %s: %v\n", envFile, err)
+		fmt.Println("   Create it first: cp .env.example .env")
+		os.Exit(1)
+	}
+	if current, _ := env.Get("APP_KEY"); current != "" && !force {
+		fmt.Println("❌ APP_KEY is already set; use --force to replace it")
+		fmt.Println("   Data encrypted with the current key will become unreadable")
+		os.Exit(1)
+	}
+
+	env.Set("APP_KEY", key)
+	if err := env.Save(true); err != nil {
+		fmt.Printf("❌ Failed to write %s: %v\n", envFile, err)
+		os.Exit(1)
+	}
+	fmt.Printf("✅ Application key set in %s\n", envFile)
+}
+
+// -----------------------------------------------------------------------------
+// Env Commands
+// -----------------------------------------------------------------------------
+
+// mustReadEnvFile, env dosyasını okur; okunamazsa çıkış yapar.
+func mustReadEnvFile(path string) *config.EnvFile {
+	env, err := config.ReadEnvFile(path)
+	if err != nil {
+		console.Error("Failed to read %s: %v", path, err)
+		console.Line("   Create it first: cp .env.example .env")
+		os.Exit(1)
+	}
+	return env
+}
+
+// getEnvValue, env dosyasındaki key'in değerini yazdırır. Değer script'lerde
+// kullanılabilsin diye tek başına yazılır; key yoksa 1 koduyla çıkar.
+func getEnvValue(envFile, key string) {
+	env := mustReadEnvFile(envFile)
+
+	value, found := env.Get(key)
+	if !found {
+		console.Error("%s is not set in %s", key, envFile)
+		os.Exit(1)
+	}
+	fmt.Println(value)
+}
+
+// listEnvKeys, env dosyasındaki key'leri yazdırır; secret değerler reveal
+// false ise maskelenir.
+func listEnvKeys(envFile string, reveal bool) {
+	env := mustReadEnvFile(envFile)
+
+	keys := env.Keys()
+	rows := make([][]string, len(keys))
+	for i, key := range keys {
+		value, _ := env.Get(key)
+		if !reveal && value != "" && isSecretEnvKey(key) {
+			value = config.RedactedValue
+		}
+		rows[i] = []string{key, value}
+	}
+	console.Table([]string{"Key", "Value"}, rows)
+}
+
+// setEnvValues, env dosyasındaki key'leri günceller (yoksa ekler). Önceki
+// içerik <file>.backup olarak saklanır.
+//
+// Parametreler:
+//   - envFile: Güncellenecek dosya
+//   - pairs: KEY=VALUE çiftleri
+//   - backup: Yedek oluşturulsun mu
+func setEnvValues(envFile string, pairs []string, backup bool) {
+	env := mustReadEnvFile(envFile)
+
+	var keys []string
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			console.Fatal("Invalid assignment %q (expected KEY=VALUE)", pair)
+		}
+		if err := env.Set(key, value); err != nil {
+			console.Fatal("%v", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := env.Save(backup); err != nil {
+		console.Fatal("Failed to write %s: %v", envFile, err)
+	}
+
+	console.Success("%s updated in %s", strings.Join(keys, ", "), envFile)
+	if backup {
+		console.Line("   Previous version saved to %s", envFile+config.EnvBackupSuffix)
+	}
+	if _, err := os.Stat(config.CachePath()); err == nil {
+		console.Warn("Config is cached; run conduit config:cache to apply the change")
+	}
+}
+
+// envAppKey, env dosyasının APP_KEY'ini döndürür; dosyada yoksa ortamdaki
+// APP_KEY kullanılır.
+func envAppKey(env *config.EnvFile) string {
+	if key, _ := env.Get("APP_KEY"); key != "" {
+		return key
+	}
+	if key := os.Getenv("APP_KEY"); key != "" {
+		return key
+	}
+	console.Fatal("APP_KEY is not set in %s or the environment (run conduit key:generate)", env.Path())
+	return ""
+}
+
+// encryptEnvValues, env dosyasındaki key'lerin değerlerini APP_KEY ile
+// şifreleyip ENC(...) olarak yazar. Zaten şifreli değerler atlanır.
+//
+// Parametreler:
+//   - envFile: Güncellenecek dosya
+//   - keys: Şifrelenecek key'ler
+//   - backup: Yedek oluşturulsun mu
+func encryptEnvValues(envFile string, keys []string, backup bool) {
+	env := mustReadEnvFile(envFile)
+	appKey := envAppKey(env)
+
+	var encrypted []string
+	for _, key := range keys {
+		if key == "APP_KEY" {
+			console.Fatal("APP_KEY cannot be encrypted")
+		}
+		value, found := env.Get(key)
+		if !found {
+			console.Fatal("%s is not set in %s", key, envFile)
+		}
+		if config.IsEncrypted(value) {
+			console.Warn("%s is already encrypted, skipping", key)
+			continue
+		}
+
+		token, err := config.EncryptValue(appKey, value)
+		if err != nil {
+			console.Fatal("%v", err)
+		}
+		env.Set(key, token)
+		encrypted = append(encrypted, key)
+	}
+	if len(encrypted) == 0 {
+		return
+	}
+
+	if err := env.Save(backup); err != nil {
+		console.Fatal("Failed to write %s: %v", envFile, err)
+	}
+	console.Success("%s encrypted in %s", strings.Join(encrypted, ", "), envFile)
+	if backup {
+		console.Line("   Previous version saved to %s (contains plaintext values)", envFile+config.EnvBackupSuffix)
+	}
+}
+
+// decryptEnvValues, env dosyasındaki ENC(...) değerlerini çözer ve düz
+// metin olarak yazar; show true ise dosyayı değiştirmeden yazdırır.
+func decryptEnvValues(envFile string, keys []string, show bool, backup bool) {
+	env := mustReadEnvFile(envFile)
+	appKey := envAppKey(env)
+
+	var decrypted []string
+	for _, key := range keys {
+		value, found := env.Get(key)
+		if !found {
+			console.Fatal("%s is not set in %s", key, envFile)
+		}
+		if !config.IsEncrypted(value) {
+			console.Warn("%s is not encrypted, skipping", key)
+			continue
+		}
+
+		plaintext, err := config.DecryptValue(appKey, value)
+		if err != nil {
+			console.Fatal("%s: %v (wrong APP_KEY?)", key, err)
+		}
+		if show {
+			fmt.Printf("%s=%s\n", key, plaintext)
+			continue
+		}
+		env.Set(key, plaintext)
+		decrypted = append(decrypted, key)
+	}
+	if len(decrypted) == 0 {
+		return
+	}
+
+	if err := env.Save(backup); err != nil {
+		console.Fatal("Failed to write %s: %v", envFile, err)
+	}
+	console.Success("%s decrypted in %s", strings.Join(decrypted, ", "), envFile)
+}
+
+// printEncryptedValue, değeri env dosyasının APP_KEY'iyle şifreleyip
+// yazdırır (YAML config dosyaları için).
+func printEncryptedValue(envFile, value string) {
+	token, err := config.EncryptValue(envAppKey(mustReadEnvFile(envFile)), value)
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+	fmt.Println(token)
+}
+
+// printDecryptedValue, ENC(...) değerini env dosyasının APP_KEY'iyle çözüp
+// yazdırır.
+func printDecryptedValue(envFile, value string) {
+	if !config.IsEncrypted(value) {
+		console.Fatal("Value is not in ENC(...) format")
+	}
+	plaintext, err := config.DecryptValue(envAppKey(mustReadEnvFile(envFile)), value)
+	if err != nil {
+		console.Fatal("%v (wrong APP_KEY?)", err)
+	}
+	fmt.Println(plaintext)
+}
+
+// isSecretEnvKey, env key'inin secret içerip içermediğini belirler.
+func isSecretEnvKey(key string) bool {
+	upper := strings.ToUpper(key)
+	for _, word := range []string{"PASSWORD", "SECRET", "TOKEN", "KEY", "DSN"} {
+		if strings.Contains(upper, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// Config Commands
+// -----------------------------------------------------------------------------
+
+// showConfig, çözümlenmiş config'i secret'lar maskelenmiş olarak yazdırır.
+//
+// Parametreler:
+//   - key: Sadece bu key ve altındakiler (örn: "db", "mail.host"; boş: hepsi)
+//   - asJSON: key -> değer JSON nesnesi olarak yazdır
+//
+// Config geçersiz olsa bile gösterilir; doğrulama hatası sonda raporlanır.
+func showConfig(key string, asJSON bool) {
+	log.SetOutput(io.Discard)
+	cfg := config.Load()
+	log.SetOutput(os.Stderr)
+
+	key = strings.ToLower(key)
+	var entries []config.Entry
+	for _, entry := range cfg.Entries() {
+		if key == "" || entry.Key == key || strings.HasPrefix(entry.Key, key+".") {
+			entries = append(entries, entry)
+		}
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("❌ Config key not found: %s\n", key)
+		os.Exit(1)
+	}
+
+	if asJSON {
+		values := make(map[string]string, len(entries))
+		for _, entry := range entries {
+			values[entry.Key] = entry.Value
+		}
+		data, _ := json.MarshalIndent(values, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+
+	if _, err := os.Stat(config.CachePath()); err == nil {
+		fmt.Printf("📦 Loaded from config cache: %s\n\n", config.CachePath())
+	}
+
+	width := 0
+	for _, entry := range entries {
+		width = max(width, len(entry.Key))
+	}
+	for _, entry := range entries {
+		fmt.Printf("%-*s  %s\n", width, entry.Key, entry.Value)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		fmt.Printf("\n⚠️  Config is invalid: %v\n", err)
+	}
+}
+
+// cacheConfig, config'i ortam değişkenlerinden yükler, doğrular ve config
+// cache'ine yazar.
+func cacheConfig() {
+	log.SetOutput(io.Discard)
+	cfg := config.LoadFromEnv()
+	log.SetOutput(os.Stderr)
+
+	if err := cfg.Validate(); err != nil {
+		fmt.Printf("❌ Config is invalid, not cached: %v\n", err)
+		os.Exit(1)
+	}
+
+	path := config.CachePath()
+	if err := config.WriteCache(cfg, path); err != nil {
+		fmt.Printf("❌ Failed to cache config: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("✅ Configuration cached: %s\n", path)
+	fmt.Println("   Environment variables are ignored until you run: conduit config:clear")
+}
+
+// clearConfigCache, config cache dosyasını siler.
+func clearConfigCache() {
+	path := config.CachePath()
+	removed, err := config.ClearCache(path)
+	if err != nil {
+		fmt.Printf("❌ Failed to clear config cache: %v\n", err)
+		os.Exit(1)
+	}
+	if !removed {
+		fmt.Println("ℹ️  No config cache found")
+		return
+	}
+	fmt.Printf("✅ Configuration cache cleared: %s\n", path)
+}
+
+// -----------------------------------------------------------------------------
+// Optimize Commands
+// -----------------------------------------------------------------------------
+// Laravel'in `optimize` adımının karşılığı: config, route tablosu ve
+// event → listener eşlemesi config cache'i ile aynı dizine (bootstrap/cache)
+// yazılır. Route'lar ve listener'lar Go kodu olarak binary'ye derlendiği için
+// API bunları yine koddan kurar; route ve event cache'leri deploy edilen
+// binary'nin manifest'idir. route:list ve event:list bu dosyalar varken
+// uygulamayı (ve veritabanını) boot etmeden okur.
+// -----------------------------------------------------------------------------
+
+const (
+	routeCacheFile = "routes.json"
+	eventCacheFile = "events.json"
+)
+
+// bootstrapCachePath, bootstrap cache dosyasının yolunu döndürür (config
+// cache'i ile aynı dizin).
+func bootstrapCachePath(name string) string {
+	return filepath.Join(filepath.Dir(config.CachePath()), name)
+}
+
+// writeBootstrapCache, v'yi bootstrap cache dosyasına JSON olarak yazar.
+// Dosya önce geçici bir dosyaya yazılır ve rename edilir.
+func writeBootstrapCache(name string, v any) (string, error) {
+	path := bootstrapCachePath(name)
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return path, nil
+}
+
+// readBootstrapCache, bootstrap cache dosyasını v'ye okur. Dosya yoksa
+// false döner.
+func readBootstrapCache(name string, v any) (bool, error) {
+	path := bootstrapCachePath(name)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("%s bozuk: %w", path, err)
+	}
+	return true, nil
+}
+
+// clearBootstrapCache, bootstrap cache dosyasını siler.
+func clearBootstrapCache(name, label string) {
+	path := bootstrapCachePath(name)
+	removed, err := config.ClearCache(path)
+	if err != nil {
+		fmt.Printf("❌ Failed to clear %s cache: %v\n", label, err)
+		os.Exit(1)
+	}
+	if !removed {
+		fmt.Printf("ℹ️  No %s cache found\n", label)
+		return
+	}
+	fmt.Printf("✅ %s cache cleared: %s\n", strings.ToUpper(label[:1])+label[1:], path)
+}
 
-	// TODO: Implement actual migration logic
-	// Bu kısım migration system tamamlandığında implement edilecek
+// optimizeApplication, deploy için bootstrap cache'lerini oluşturur.
+func optimizeApplication() {
+	console.Info("Caching the framework bootstrap files")
+	cacheConfig()
+	cacheRoutes()
+	cacheEvents()
+}
 
-	fmt.Println(`
-Migration system will:
-1. Connect to database
-2. Check migrations table
-3. Run pending migrations
-4. Record migration status
+// clearOptimization, optimize'ın oluşturduğu cache'leri siler.
+func clearOptimization() {
+	console.Info("Clearing the cached bootstrap files")
+	clearConfigCache()
+	clearRouteCache()
+	clearEventCache()
+}
 
-To implement:
-- Create database/migrations directory
-- Implement migration runner
-- Track migration history
-`)
+// quietLoggerProvider, CLI'da boot edilen provider'ların loglarını gizler.
+type quietLoggerProvider struct{ foundation.BaseProvider }
 
-	fmt.Println("✅ Migration completed (placeholder)")
+// Register, logger'ı io.Discard'a yazan bir logger ile değiştirir.
+func (quietLoggerProvider) Register(c *container.Container) error {
+	container.Register(c, func(c *container.Container) (*log.Logger, error) {
+		return log.New(io.Discard, "", 0), nil
+	})
+	return nil
 }
 
-func rollbackMigrations(step int) {
-	fmt.Printf("🔄 Rolling back %d migration(s)...\n", step)
+// loadRouteTable, API ile aynı provider'ları boot ederek route tablosunu
+// oluşturur. Controller'lar çözüldüğü için veritabanı bağlantısı gerekir.
+func loadRouteTable() ([]router.RouteInfo, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
 
-	// TODO: Implement rollback logic
+	c := container.New()
+	container.Register(c, func(c *container.Container) (*config.Config, error) {
+		return cfg, nil
+	})
+	app := foundation.New(c,
+		providers.AppServiceProvider{},
+		quietLoggerProvider{},
+		providers.CacheServiceProvider{},
+		providers.QueueServiceProvider{},
+		providers.MailServiceProvider{},
+		providers.AuthServiceProvider{},
+		providers.DispatcherServiceProvider{},
+		&providers.BroadcastServiceProvider{},
+		providers.RouteServiceProvider{},
+	)
+	if err := app.Boot(); err != nil {
+		return nil, err
+	}
+	defer app.Terminate(context.Background())
 
-	fmt.Println("✅ Rollback completed (placeholder)")
+	return container.MustResolve[*router.Router](c).Routes(), nil
 }
 
-func freshMigrations() {
-	fmt.Println("🔄 Dropping all tables...")
-	fmt.Println("🔄 Running all migrations...")
+// cacheRoutes, route tablosunu route cache'ine yazar.
+func cacheRoutes() {
+	routes, err := loadRouteTable()
+	if err != nil {
+		fmt.Printf("❌ Routes could not be loaded, not cached: %v\n", err)
+		os.Exit(1)
+	}
 
-	// TODO: Implement fresh migration logic
+	path, err := writeBootstrapCache(routeCacheFile, routes)
+	if err != nil {
+		fmt.Printf("❌ Failed to cache routes: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("✅ Routes cached: %s (%d routes)\n", path, len(routes))
+}
 
-	fmt.Println("✅ Fresh migration completed (placeholder)")
+// clearRouteCache, route cache dosyasını siler.
+func clearRouteCache() {
+	clearBootstrapCache(routeCacheFile, "route")
 }
 
-func showMigrationStatus() {
-	fmt.Println("╔════════════════════════════════════════════════════════════════╗")
-	fmt.Println("║               DATABASE MIGRATION STATUS                       ║")
-	fmt.Println("╚════════════════════════════════════════════════════════════════╝")
-	fmt.Println()
-	fmt.Printf("%-50s %s\n", "Migration", "Status")
-	fmt.Println("----------------------------------------------------------------")
+// listRoutes, route tablosunu listeler; route cache varsa oradan okur.
+func listRoutes(method, path string) {
+	var routes []router.RouteInfo
+	cached, err := readBootstrapCache(routeCacheFile, &routes)
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+	if !cached {
+		if routes, err = loadRouteTable(); err != nil {
+			console.Fatal("Routes could not be loaded: %v", err)
+		}
+	}
 
-	// TODO: Implement actual status check
+	var rows [][]string
+	for _, route := range routes {
+		if (method != "" && !strings.EqualFold(route.Method, method)) || !strings.Contains(route.Path, path) {
+			continue
+		}
+		rows = append(rows, []string{route.Method, route.Path, route.Handler, strconv.Itoa(route.Middleware), route.CORSPolicy})
+	}
 
-	fmt.Printf("%-50s %s\n", "2024_01_15_100000_create_users_table", "✅ Ran")
-	fmt.Printf("%-50s %s\n", "2024_01_15_110000_create_posts_table", "✅ Ran")
-	fmt.Printf("%-50s %s\n", "2024_01_15_120000_add_avatar_to_users", "⏸  Pending")
+	if len(rows) == 0 {
+		console.Warn("No routes matched")
+		return
+	}
 
-	fmt.Println()
-	fmt.Println("✅ Migration status retrieved (placeholder)")
+	if cached {
+		fmt.Printf("📦 Loaded from route cache: %s\n\n", bootstrapCachePath(routeCacheFile))
+	}
+	console.Table([]string{"Method", "Path", "Handler", "Middleware", "CORS"}, rows)
+}
+
+// cacheEvents, event → listener eşlemesini event cache'ine yazar.
+func cacheEvents() {
+	mappings := providers.EventServiceProvider().Mappings()
+
+	path, err := writeBootstrapCache(eventCacheFile, mappings)
+	if err != nil {
+		fmt.Printf("❌ Failed to cache events: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("✅ Events cached: %s (%d events)\n", path, len(mappings))
+}
+
+// clearEventCache, event cache dosyasını siler.
+func clearEventCache() {
+	clearBootstrapCache(eventCacheFile, "event")
 }
 
 // -----------------------------------------------------------------------------
 // Cache Commands
 // -----------------------------------------------------------------------------
 
+// loadConfig, uygulama config'ini CLI için yükler ve doğrular.
+func loadConfig() (*config.Config, error) {
+	// Config eksik env uyarılarını standart log'a yazar; CLI çıktısını kirletmesin
+	log.SetOutput(io.Discard)
+	cfg := config.Load()
+	log.SetOutput(os.Stderr)
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// bootCache, config'deki cache driver'ını CLI için başlatır.
+//
+// Uygulamanın aksine Redis'e bağlanılamazsa file cache'e geçilmez; aksi halde
+// cache:clear yanlış store'u temizleyip başarılı görünebilirdi.
+//
+// Döndürür:
+//   - cache.Cache: Başlatılan driver
+//   - func(): Kaynakları serbest bırakan fonksiyon
+//   - error: Config veya bağlantı hatası
+func bootCache() (cache.Cache, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return openCache(cfg)
+}
+
+// openCache, cfg'deki cache driver'ını açar.
+func openCache(cfg *config.Config) (cache.Cache, func(), error) {
+	logger := log.New(io.Discard, "", 0)
+
+	serializer, err := cache.SerializerByName(cfg.Cache.Serializer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch cfg.Cache.Driver {
+	case "redis":
+		redisConfig := database.DefaultRedisConfig()
+		redisConfig.Host = cfg.Redis.Host
+		redisConfig.Port = cfg.Redis.Port
+		redisConfig.Password = cfg.Redis.Password
+		redisConfig.DB = cfg.Redis.DB
+
+		redisClient, err := database.NewRedisClient(redisConfig, logger)
+		if err != nil {
+			return nil, nil, fmt.Errorf("redis bağlantısı kurulamadı: %w", err)
+		}
+
+		redisCache := cache.NewRedisCache(redisClient.Client(), logger, cfg.Cache.Prefix)
+		redisCache.SetSerializer(serializer)
+		return redisCache, func() { redisClient.Close() }, nil
+
+	case "file":
+		fileCache, err := cache.NewFileCache(cfg.Cache.FileDir, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		fileCache.SetSerializer(serializer)
+		return fileCache, fileCache.Stop, nil
+
+	case "memory":
+		return nil, nil, fmt.Errorf("memory cache uygulama process'i içinde yaşar; CLI'dan erişilemez (CACHE_DRIVER=redis veya file kullanın)")
+
+	default:
+		return nil, nil, fmt.Errorf("geçersiz cache driver: %s", cfg.Cache.Driver)
+	}
+}
+
+// mustBootCache, bootCache hata verirse çıkış yapar.
+func mustBootCache() (cache.Cache, func()) {
+	c, closeFn, err := bootCache()
+	if err != nil {
+		fmt.Printf("❌ Cache could not be initialized: %v\n", err)
+		os.Exit(1)
+	}
+	return c, closeFn
+}
+
 func clearCache() {
 	fmt.Println("🔄 Clearing all cache...")
 
-	// TODO: Implement cache clearing
-	// Bu kısım cache system ile entegre edilecek
+	c, closeFn := mustBootCache()
+	defer closeFn()
 
-	fmt.Println(`
-Cache clearing will:
-1. Connect to Redis/cache driver
-2. Flush all cache keys
-3. Clear application cache
-4. Clear view cache (if any)
-`)
+	if err := c.Flush(); err != nil {
+		fmt.Printf("❌ Cache clear failed: %v\n", err)
+		os.Exit(1)
+	}
 
-	fmt.Println("✅ Cache cleared successfully (placeholder)")
+	fmt.Println("✅ Cache cleared successfully")
 }
 
 func forgetCacheKey(key string) {
 	fmt.Printf("🔄 Forgetting cache key: %s\n", key)
 
-	// TODO: Implement cache key removal
+	c, closeFn := mustBootCache()
+	defer closeFn()
+
+	exists, err := c.Has(key)
+	if err != nil {
+		fmt.Printf("❌ Cache lookup failed: %v\n", err)
+		os.Exit(1)
+	}
+	if !exists {
+		fmt.Printf("⚠️  Cache key '%s' not found\n", key)
+		return
+	}
+
+	if err := c.Delete(key); err != nil {
+		fmt.Printf("❌ Cache forget failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Cache key '%s' forgotten\n", key)
+}
+
+func showCacheStats() {
+	c, closeFn := mustBootCache()
+	defer closeFn()
+
+	s, ok := c.(cache.Stats)
+	if !ok {
+		fmt.Println("⚠️  Cache driver does not provide statistics")
+		return
+	}
+
+	stats := s.Stats()
+	if errMsg, ok := stats["error"]; ok {
+		fmt.Printf("❌ Cache stats failed: %v\n", errMsg)
+		os.Exit(1)
+	}
+
+	keys := make([]string, 0, len(stats))
+	for key := range stats {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Println("📊 Cache statistics:")
+	for _, key := range keys {
+		value := fmt.Sprint(stats[key])
+		// Redis INFO çıktısı çok satırlı; girintili yazdır
+		if strings.Contains(value, "\n") {
+			fmt.Printf("   %s:\n", key)
+			for _, line := range strings.Split(strings.TrimSpace(value), "\n") {
+				fmt.Printf("      %s\n", strings.TrimSpace(line))
+			}
+			continue
+		}
+		fmt.Printf("   %-14s %s\n", key+":", value)
+	}
+}
+
+func pruneCache() {
+	fmt.Println("🔄 Pruning expired cache entries...")
+
+	c, closeFn := mustBootCache()
+	defer closeFn()
+
+	p, ok := c.(cache.Pruner)
+	if !ok {
+		fmt.Println("ℹ️  Cache driver expires keys automatically; nothing to prune")
+		return
+	}
 
-	fmt.Printf("✅ Cache key '%s' forgotten (placeholder)\n", key)
+	removed, err := p.Prune()
+	if err != nil {
+		fmt.Printf("❌ Cache prune failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Pruned %d expired cache entries\n", removed)
 }
 
 // -----------------------------------------------------------------------------
 // Queue Commands
 // -----------------------------------------------------------------------------
 
-func startQueueWorker(queueName string, maxJobs int, timeout int) {
-	fmt.Printf("🔄 Starting queue worker for '%s' queue...\n", queueName)
+// startQueueWorker, config'deki queue driver'ına bağlanan bir worker pool
+// başlatır.
+//
+// Parametreler:
+//   - queues: Virgülle ayrılmış queue adları, öncelik sırasıyla; "ad:ağırlık"
+//     biçiminde ağırlık verilebilir (örn: "critical:5,default:3,low")
+//   - strategy: priority, weighted veya round-robin (boş = otomatik)
+//   - maxJobs: Toplam bu kadar job işlenince dur (0 = limitsiz)
+//   - timeout: Kendi Timeout'u olmayan job'lar için limit (saniye, 0 = limitsiz)
+//   - concurrency: Eşzamanlı goroutine sayısı
+func startQueueWorker(queues string, strategy string, maxJobs int, timeout int, concurrency int) {
+	_, weighted, err := queue.ParseQueues(queues)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	mode := queue.StrategyPriority
+	if weighted {
+		mode = queue.StrategyWeighted
+	}
+	if strategy != "" {
+		if mode, err = queue.ParseQueueStrategy(strategy); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Printf("🔄 Starting queue worker for %s...\n", queues)
+	fmt.Printf("   Strategy: %s\n", mode)
+	fmt.Printf("   Concurrency: %d\n", concurrency)
 	fmt.Printf("   Max jobs: %d\n", maxJobs)
 	fmt.Printf("   Timeout: %ds\n", timeout)
 	fmt.Println()
 
-	// Setup graceful shutdown
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	q, closeQueue, err := bootQueue()
+	if err != nil {
+		fmt.Printf("❌ Queue could not be initialized: %v\n", err)
+		os.Exit(1)
+	}
+	defer closeQueue()
+
+	logger := log.New(os.Stdout, "[Worker] ", log.LstdFlags)
+	worker := queue.NewWorker(q, logger).
+		SetConcurrency(concurrency).
+		SetStrategy(mode).
+		SetTimeout(time.Duration(timeout) * time.Second).
+		SetMaxJobs(maxJobs)
+
+	// Unique job lock'ları API ile aynı cache'te bırakılmalı; queue:restart
+	// sinyali de aynı cache'ten okunur
+	if c, closeCache, err := bootCache(); err != nil {
+		fmt.Printf("⚠️  Unique job locks will not be released: %v\n", err)
+		fmt.Println("⚠️  queue:restart will not reach this worker")
+	} else {
+		defer closeCache()
+		queue.SetUniqueLockStore(c)
+		worker.SetRestartSignal(c)
+	}
+
+	// Failed job kaydı opsiyonel: veritabanı yoksa worker yine çalışır
+	if provider, closeDB, err := bootFailedJobs(); err != nil {
+		fmt.Printf("⚠️  Failed jobs will not be persisted: %v\n", err)
+	} else {
+		defer closeDB()
+		worker.SetFailedJobProvider(provider)
+	}
 
-	// TODO: Implement actual queue worker
-	// Bu kısım queue system ile entegre edilecek
+	if sink, err := bootDeadLetter(q); err != nil {
+		fmt.Printf("⚠️  Dead letter disabled: %v\n", err)
+	} else if sink != nil {
+		worker.SetDeadLetterSink(sink)
+	}
 
-	fmt.Println("Queue worker will:")
-	fmt.Println("1. Connect to queue backend (Redis, Database, etc.)")
-	fmt.Println("2. Listen for incoming jobs")
-	fmt.Println("3. Execute jobs with timeout")
-	fmt.Println("4. Handle job failures and retries")
-	fmt.Println()
-	fmt.Println("Press Ctrl+C to stop...")
+	// mailer.Queue() ile kuyruğa alınan email'ler config'deki driver ile gönderilir
+	if mailer, closeMailer, err := bootMailer(logger); err != nil {
+		fmt.Printf("⚠️  Queued emails will fail: %v\n", err)
+	} else {
+		defer closeMailer()
+		queue.RegisterType(func() *mail.SendMessageJob { return &mail.SendMessageJob{Mailer: mailer} })
+	}
+
+	// Blocking; SIGINT/SIGTERM ile tüm goroutine'ler mevcut job'u bitirip durur
+	worker.Work(queues)
+}
+
+func startQueueListener(queueName string) {
+	fmt.Printf("🔄 Starting queue listener for '%s' queue...\n", queueName)
+
+	// TODO: Implement queue listener
+	// Listener genellikle worker'ı otomatik restart eder
+
+	fmt.Println("✅ Queue listener started (placeholder)")
+}
+
+// restartQueueWorkers, cache'e restart sinyali yazar; queue:work ile çalışan
+// worker'lar mevcut job'larını bitirip çıkar, supervisor onları yeni kodla
+// tekrar başlatır.
+func restartQueueWorkers() {
+	c, closeFn := mustBootCache()
+	defer closeFn()
+
+	if err := queue.SignalRestart(c); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Broadcasting queue restart signal")
+	fmt.Println("   Workers will exit after their current job; make sure a supervisor restarts them")
+}
+
+// listQueueJobs, internal/jobs içinde init ile kaydedilen job tiplerini listeler.
+//
+// Worker'da "job tipi register edilmemiş" hatası alınıyorsa yeni job'un
+// burada görünüp görünmediği kontrol edilmelidir.
+func listQueueJobs() {
+	types := queue.JobRegistry.Types()
+	if len(types) == 0 {
+		fmt.Println("⚠️  No job types registered")
+		return
+	}
 
-	// Simulate worker running
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+	fmt.Printf("📋 Registered job types (%d):\n", len(types))
+	for _, jobType := range types {
+		fmt.Printf("   • %s\n", jobType)
+	}
+}
+
+// monitorQueues, queue'ların derinliğini, en eski job yaşını, processed/failed
+// oranlarını ve worker sayılarını gösterir.
+//
+// Parametreler:
+//   - queues: Virgülle ayrılmış queue adları
+//   - interval: Yenileme aralığı (saniye, 0 = bir kez göster)
+//   - asJSON: Tablo yerine JSON yazdır (script/alerting için)
+func monitorQueues(queues string, interval int, asJSON bool) {
+	parsed, _, err := queue.ParseQueues(queues)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+	names := make([]string, len(parsed))
+	for i, q := range parsed {
+		names[i] = q.Name
+	}
+
+	q, closeQueue, err := bootQueue()
+	if err != nil {
+		fmt.Printf("❌ Queue could not be initialized: %v\n", err)
+		os.Exit(1)
+	}
+	defer closeQueue()
 
-	jobCount := 0
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	for {
-		select {
-		case <-ticker.C:
-			jobCount++
-			fmt.Printf("[%s] Processing job #%d...\n", time.Now().Format("15:04:05"), jobCount)
+		stats, err := queue.Stats(q, names...)
+		if err != nil {
+			fmt.Printf("❌ Queue stats failed: %v\n", err)
+			os.Exit(1)
+		}
 
-			if maxJobs > 0 && jobCount >= maxJobs {
-				fmt.Println("✅ Max jobs reached, shutting down...")
-				return
-			}
+		if asJSON {
+			data, _ := json.MarshalIndent(stats, "", "  ")
+			fmt.Println(string(data))
+		} else {
+			printQueueStats(stats)
+		}
+
+		if interval <= 0 {
+			return
+		}
+
+		select {
+		case <-time.After(time.Duration(interval) * time.Second):
+			fmt.Println()
 		case <-sigChan:
-			fmt.Println("\n🛑 Received shutdown signal, gracefully stopping...")
 			return
 		}
 	}
 }
 
-func startQueueListener(queueName string) {
-	fmt.Printf("🔄 Starting queue listener for '%s' queue...\n", queueName)
+// printQueueStats, queue durumlarını tablo olarak yazdırır.
+func printQueueStats(stats []queue.QueueStats) {
+	console.Title(fmt.Sprintf("📊 Queue monitor (%s)", time.Now().Format("15:04:05")))
 
-	// TODO: Implement queue listener
-	// Listener genellikle worker'ı otomatik restart eder
+	rows := make([][]string, len(stats))
+	for i, s := range stats {
+		oldest := "-"
+		if s.OldestJobAge > 0 {
+			oldest = s.OldestJobAge.Round(time.Second).String()
+		}
 
-	fmt.Println("✅ Queue listener started (placeholder)")
+		status := console.Colorize(console.Green, "OK")
+		switch {
+		case s.Workers == 0 && s.Size > 0:
+			status = console.Colorize(console.Yellow, "NO WORKERS")
+		case s.FailedPerMinute > 0:
+			status = console.Colorize(console.Red, "FAILING")
+		}
+
+		rows[i] = []string{
+			s.Queue,
+			status,
+			strconv.FormatInt(s.Size, 10),
+			oldest,
+			strconv.FormatInt(s.Processed, 10),
+			fmt.Sprintf("%.1f", s.ProcessedPerMinute),
+			fmt.Sprintf("%.1f", s.FailedPerMinute),
+			strconv.Itoa(s.Workers),
+		}
+	}
+	console.Table([]string{"Queue", "Status", "Size", "Oldest", "Processed", "OK/min", "Fail/min", "Workers"}, rows)
 }
 
-func restartQueueWorkers() {
-	fmt.Println("🔄 Restarting queue workers...")
+// bootFailedJobs, failed_jobs provider'ını CLI için başlatır.
+func bootFailedJobs() (*queue.DatabaseFailedJobProvider, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := database.Connect(cfg.DB.DSN)
+	if err != nil {
+		return nil, nil, fmt.Errorf("veritabanı bağlantısı kurulamadı: %w", err)
+	}
+
+	provider := queue.NewDatabaseFailedJobProvider(db, database.NewMySQLGrammar())
+	if err := provider.CreateTable(); err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	return provider, func() { db.Close() }, nil
+}
+
+// mustBootFailedJobs, bootFailedJobs hata verirse çıkış yapar.
+func mustBootFailedJobs() (*queue.DatabaseFailedJobProvider, func()) {
+	provider, closeFn, err := bootFailedJobs()
+	if err != nil {
+		console.Fatal("Failed jobs could not be loaded: %v", err)
+	}
+	return provider, closeFn
+}
+
+// bootQueue, config'deki queue driver'ını CLI için başlatır (queue:work,
+// queue:retry).
+//
+// Sync driver desteklenmez: job'lar dispatch eden process'te çalışır, CLI'dan
+// dinlenecek veya geri gönderilecek bir kuyruk yoktur.
+func bootQueue() (queue.Queue, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Şifreli job'lar (ShouldBeEncrypted) API ile aynı APP_KEY ile çözülür
+	if cfg.App.Key != "" {
+		encrypter, err := crypt.NewFromAppKey(cfg.App.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		queue.SetEncrypter(encrypter)
+	}
+
+	if cfg.Queue.Driver == "sqs" {
+		logger := log.New(io.Discard, "", 0)
+		return queue.NewSQSQueue(cfg.SQS.Queue(), logger), func() {}, nil
+	}
+
+	if cfg.Queue.Driver == "nats" {
+		logger := log.New(io.Discard, "", 0)
+		q := queue.NewNATSQueue(cfg.NATS.Queue(), logger)
+		return q, q.Close, nil
+	}
+
+	if cfg.Queue.Driver != "redis" {
+		return nil, nil, fmt.Errorf("QUEUE_DRIVER=redis, sqs veya nats gerekli (mevcut: %s)", cfg.Queue.Driver)
+	}
+
+	redisConfig := database.DefaultRedisConfig()
+	redisConfig.Host = cfg.Redis.Host
+	redisConfig.Port = cfg.Redis.Port
+	redisConfig.Password = cfg.Redis.Password
+	redisConfig.DB = cfg.Redis.DB
+
+	logger := log.New(io.Discard, "", 0)
+	redisClient, err := database.NewRedisClient(redisConfig, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("redis bağlantısı kurulamadı: %w", err)
+	}
+
+	queue.SetBatchStore(queue.NewRedisBatchStore(redisClient.Client(), cfg.Cache.Prefix))
+	queue.SetMetricsStore(queue.NewRedisMetricsStore(redisClient.Client(), cfg.Cache.Prefix))
+	return queue.NewRedisQueue(redisClient.Client(), logger, cfg.Cache.Prefix), func() { redisClient.Close() }, nil
+}
+
+// bootMailer, config'deki mail driver'ını worker için başlatır.
+func bootMailer(logger *log.Logger) (mail.Mailer, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch cfg.Mail.Driver {
+	case "log":
+		return mail.NewLogMailer(logger).SetFrom(cfg.Mail.FromAddress, cfg.Mail.FromName), func() {}, nil
+	case "array":
+		return mail.NewArrayMailer().SetFrom(cfg.Mail.FromAddress, cfg.Mail.FromName), func() {}, nil
+	case "smtp":
+		mailer := mail.NewSMTPMailer(cfg.Mail.SMTP(), logger)
+		return mailer, func() { mailer.Close() }, nil
+	case "ses":
+		return mail.NewSESMailer(cfg.Mail.SESConfig(), logger), func() {}, nil
+	case "mailgun":
+		return mail.NewMailgunMailer(cfg.Mail.MailgunConfig(), logger), func() {}, nil
+	case "postmark":
+		return mail.NewPostmarkMailer(cfg.Mail.PostmarkConfig(), logger), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("geçersiz mail driver: %s", cfg.Mail.Driver)
+	}
+}
+
+// bootDeadLetter, QUEUE_DEAD_LETTER_DRIVER'a göre dead letter hedefini
+// oluşturur (kapalıysa nil döner).
+func bootDeadLetter(q queue.Queue) (queue.DeadLetterSink, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: Implement worker restart logic
-	// Bu genellikle bir signal gönderilerek yapılır
+	switch cfg.Queue.DeadLetterDriver {
+	case "":
+		return nil, nil
+	case "queue":
+		return queue.NewQueueDeadLetterSink(q, cfg.Queue.DeadLetter), nil
+	case "stream":
+		redisQueue, ok := q.(*queue.RedisQueue)
+		if !ok {
+			return nil, fmt.Errorf("stream dead letter için QUEUE_DRIVER=redis gerekli")
+		}
+		return queue.NewRedisDeadLetterSink(redisQueue.Client(), cfg.Cache.Prefix, cfg.Queue.DeadLetter, int64(cfg.Queue.DeadLetterMaxLen)), nil
+	default:
+		return nil, fmt.Errorf("geçersiz dead letter driver: %s", cfg.Queue.DeadLetterDriver)
+	}
+}
+
+// listFailedJobs, failed_jobs kayıtlarını listeler.
+func listFailedJobs() {
+	provider, closeFn := mustBootFailedJobs()
+	defer closeFn()
+
+	failed, err := provider.All()
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+
+	if len(failed) == 0 {
+		console.Success("No failed jobs")
+		return
+	}
+
+	rows := make([][]string, len(failed))
+	for i, job := range failed {
+		jobType := "?"
+		if payload, err := job.JobPayload(); err == nil {
+			jobType = payload.Type
+		}
+
+		exception := job.Exception
+		if len(exception) > 80 {
+			exception = exception[:77] + "..."
+		}
+
+		rows[i] = []string{strconv.FormatInt(job.ID, 10), job.FailedAt.Format("2006-01-02 15:04:05"), job.Queue, jobType, exception}
+	}
+
+	console.Line("📋 Failed jobs (%d):", len(failed))
+	console.Table([]string{"ID", "Failed At", "Queue", "Job", "Exception"}, rows)
+}
+
+// retryFailedJobs, verilen failed job'ları (veya "all" ile hepsini) tekrar
+// kuyruğa alır ve kayıtlarını siler.
+func retryFailedJobs(ids []string) {
+	provider, closeFn := mustBootFailedJobs()
+	defer closeFn()
+
+	var failed []queue.FailedJob
+	if len(ids) == 1 && ids[0] == "all" {
+		all, err := provider.All()
+		if err != nil {
+			console.Fatal("%v", err)
+		}
+		failed = all
+	} else {
+		for _, arg := range ids {
+			id, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				console.Fatal("Invalid failed job id: %s", arg)
+			}
+			job, err := provider.Find(id)
+			if err != nil {
+				console.Fatal("%v", err)
+			}
+			failed = append(failed, *job)
+		}
+	}
+
+	if len(failed) == 0 {
+		console.Success("No failed jobs to retry")
+		return
+	}
+
+	q, closeQueue, err := bootQueue()
+	if err != nil {
+		console.Fatal("Queue could not be initialized: %v", err)
+	}
+	defer closeQueue()
+
+	retried := 0
+	bar := console.NewProgressBar(len(failed))
+	for i := range failed {
+		job := &failed[i]
+		if err := queue.RetryFailed(q, job); err != nil {
+			console.Error("Failed job #%d could not be retried: %v", job.ID, err)
+			bar.Advance()
+			continue
+		}
+		if _, err := provider.Forget(job.ID); err != nil {
+			console.Warn("Failed job #%d pushed but record could not be removed: %v", job.ID, err)
+		}
+		retried++
+		bar.Advance()
+	}
+	bar.Finish()
+
+	console.Success("%d/%d failed jobs retried", retried, len(failed))
+}
+
+// forgetFailedJob, failed job kaydını siler.
+func forgetFailedJob(arg string) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		console.Fatal("Invalid failed job id: %s", arg)
+	}
+
+	provider, closeFn := mustBootFailedJobs()
+	defer closeFn()
+
+	deleted, err := provider.Forget(id)
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+	if !deleted {
+		console.Warn("Failed job #%d not found", id)
+		return
+	}
+
+	console.Success("Failed job #%d deleted", id)
+}
+
+// flushFailedJobs, tüm failed job kayıtlarını siler. force false ise önce
+// onay istenir.
+func flushFailedJobs(force bool) {
+	if !force && !console.Confirm("Delete all failed jobs?", false) {
+		console.Line("Operation cancelled")
+		return
+	}
+
+	provider, closeFn := mustBootFailedJobs()
+	defer closeFn()
+
+	deleted, err := provider.Flush()
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+
+	console.Success("Deleted %d failed jobs", deleted)
+}
+
+// -----------------------------------------------------------------------------
+// Event Commands
+// -----------------------------------------------------------------------------
+
+// listEvents, providers.EventServiceProvider eşlemesini listeler.
+//
+// Parametre:
+//   - filter: Sadece adında bu değer geçen event'ler (boş: hepsi)
+//
+// Not: Subscriber'lar ve kod içinde Listen ile yapılan kayıtlar uygulama
+// boot edilmeden bilinemez; burada gösterilmez.
+func listEvents(filter string) {
+	all := providers.EventServiceProvider().Mappings()
+	cached, err := readBootstrapCache(eventCacheFile, &all)
+	if err != nil {
+		console.Fatal("%v", err)
+	}
+	if cached {
+		fmt.Printf("📦 Loaded from event cache: %s\n\n", bootstrapCachePath(eventCacheFile))
+	}
+
+	var mappings []events.EventMapping
+	for _, mapping := range all {
+		if strings.Contains(mapping.Event, filter) {
+			mappings = append(mappings, mapping)
+		}
+	}
+
+	if len(mappings) == 0 {
+		fmt.Println("⚠️  No events mapped")
+		return
+	}
 
-	fmt.Println("✅ Queue workers restarted (placeholder)")
+	fmt.Printf("📋 Event listeners (%d events):\n", len(mappings))
+	for _, mapping := range mappings {
+		fmt.Printf("\n   %s\n", mapping.Event)
+		for _, listener := range mapping.Listeners {
+			if listener.Queued {
+				fmt.Printf("     ⇂ %s (queued)\n", listener.Type)
+			} else {
+				fmt.Printf("     ⇂ %s\n", listener.Type)
+			}
+		}
+	}
 }
 
 // -----------------------------------------------------------------------------
@@ -191,7 +1832,7 @@ func startDevServer(host string, port int) {
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, `{"status":"ok","timestamp":"%s"}`, time.Now().Format(time.RFC3339))
+		fmt.Fprintf(w, `{"status":"ok","version":%q,"timestamp":"%s"}`, version.Version, time.Now().Format(time.RFC3339))
 	})
 
 	// Root endpoint
@@ -286,13 +1927,13 @@ func startDevServer(host string, port int) {
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, `{
 	"message": "Conduit API v1",
-	"version": "1.0.0",
+	"version": "%s",
 	"timestamp": "%s",
 	"endpoints": {
 		"health": "/health",
 		"api": "/api/v1/"
 	}
-}`, time.Now().Format(time.RFC3339))
+}`, version.Version, time.Now().Format(time.RFC3339))
 	})
 
 	// Create server with timeouts
@@ -312,7 +1953,7 @@ func startDevServer(host string, port int) {
 
 		fmt.Println("\n🛑 Shutting down server...")
 
-		ctx, cancel := signal.NotifyContext(os.Interrupt, 5*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
 		if err := server.Shutdown(ctx); err != nil {
@@ -336,28 +1977,19 @@ func loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
-		// Create response writer wrapper to capture status code
-		wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		// Status code is captured by the framework's response writer
+		wrapped := response.WrapWriter(w)
 
 		next.ServeHTTP(wrapped, r)
+		wrapped.Finish()
 
 		duration := time.Since(start)
 		fmt.Printf("[%s] %s %s %d %v\n",
 			time.Now().Format("15:04:05"),
 			r.Method,
 			r.URL.Path,
-			wrapped.statusCode,
+			wrapped.Status(),
 			duration,
 		)
 	})
 }
-
-type responseWriter struct {
-	http.ResponseWriter
-	statusCode int
-}
-
-func (rw *responseWriter) WriteHeader(code int) {
-	rw.statusCode = code
-	rw.ResponseWriter.WriteHeader(code)
-}
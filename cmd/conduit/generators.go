@@ -9,10 +9,18 @@ package main
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
 	"strings"
 	"time"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/console/command"
 )
 
 // -----------------------------------------------------------------------------
@@ -375,7 +383,7 @@ func (r *%sRepository) Delete(id int64) error {
 		name, name, name,
 		name, name, name,
 		toSnakeCase(pluralize(name)),
-		name, name, name, toSnakeCase(pluralize(name)))
+		name, name, toSnakeCase(pluralize(name)))
 
 	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
 		fmt.Printf("❌ Failed to create file: %v\n", err)
@@ -385,7 +393,8 @@ func (r *%sRepository) Delete(id int64) error {
 	fmt.Printf("✅ Model created: %s\n", filename)
 
 	if withMigration {
-		generateMigration("create_"+toSnakeCase(pluralize(name))+"_table", "")
+		table := toSnakeCase(pluralize(name))
+		generateMigration("create_"+table+"_table", table, true)
 	}
 }
 
@@ -517,6 +526,11 @@ type %s struct {
 	// Mailer mail.Mailer ` + "`json:\"-\"`" + `
 }
 
+// init registers the job type so workers can deserialize it.
+func init() {
+	queue.RegisterType(func() *%s { return &%s{} })
+}
+
 // New%s creates a new %s instance.
 func New%s() *%s {
 	return &%s{
@@ -559,7 +573,7 @@ func (j *%s) GetPayload() ([]byte, error) {
 func (j *%s) SetPayload(data []byte) error {
 	return json.Unmarshal(data, j)
 }
-`, name, name, name, name, name, name, name, name, name, name, name, name, name, name)
+`, name, name, name, name, name, name, name, name, name, name, name, name, name, name, name, name)
 
 	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
 		fmt.Printf("❌ Failed to create file: %v\n", err)
@@ -567,6 +581,7 @@ func (j *%s) SetPayload(data []byte) error {
 	}
 
 	fmt.Printf("✅ Job created: %s\n", filename)
+	fmt.Println("   Registered automatically via init(); verify with: conduit queue:jobs")
 }
 
 // -----------------------------------------------------------------------------
@@ -687,65 +702,688 @@ func (l *%s) Handle(event pkgevents.Event) error {
 	}
 
 	fmt.Printf("✅ Listener created: %s\n", filename)
+	fmt.Printf("💡 Map it to its event in internal/providers/event_service_provider.go and register a container factory for *listeners.%s\n", name)
+}
+
+// -----------------------------------------------------------------------------
+// Form Request Generator
+// -----------------------------------------------------------------------------
+
+func generateRequest(name string) {
+	// Ensure Request suffix
+	if !strings.HasSuffix(name, "Request") {
+		name = name + "Request"
+	}
+
+	dir := "internal/http/requests"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Printf("❌ Failed to create directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	filename := filepath.Join(dir, toSnakeCase(name)+".go")
+	if _, err := os.Stat(filename); err == nil {
+		fmt.Printf("❌ Request already exists: %s\n", filename)
+		os.Exit(1)
+	}
+
+	content := fmt.Sprintf(`package requests
+
+import (
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/pkg/validation"
+	"github.com/biyonik/conduit-go/pkg/validation/types"
+)
+
+// %s is the form request for...
+// TODO: Describe the endpoint this request belongs to
+//
+// Validated data is copied into the fields below by their json tags.
+//
+// Example usage:
+//   api.POST("/path", router.Form(controller.Store))
+//
+//   func (c *Controller) Store(w http.ResponseWriter, r *conduitReq.Request, form *requests.%s) {
+//       // form.Name is validated
+//   }
+type %s struct {
+	Name string `+"`json:\"name\"`"+`
+	// TODO: Add fields
+}
+
+// Authorize determines if the user is allowed to make this request.
+func (f *%s) Authorize(r *conduitReq.Request) bool {
+	// TODO: Implement authorization
+	// Example: return r.IsAuthenticated()
+	return true
+}
+
+// Rules returns the validation rules for the request.
+func (f *%s) Rules() map[string]validation.Type {
+	return map[string]validation.Type{
+		"name": types.String().Required().Max(255).Label("Name"),
+		// TODO: Add rules
+	}
+}
+`, name, name, name, name, name)
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		fmt.Printf("❌ Failed to create file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Request created: %s\n", filename)
+}
+
+// -----------------------------------------------------------------------------
+// Mail Generator
+// -----------------------------------------------------------------------------
+
+func generateMail(name string) {
+	// Ensure Mail suffix
+	if !strings.HasSuffix(name, "Mail") {
+		name = name + "Mail"
+	}
+
+	dir := "internal/mails"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Printf("❌ Failed to create directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	filename := filepath.Join(dir, toSnakeCase(name)+".go")
+	if _, err := os.Stat(filename); err == nil {
+		fmt.Printf("❌ Mail already exists: %s\n", filename)
+		os.Exit(1)
+	}
+
+	// WelcomeMail -> emails/welcome, OrderShippedMail -> emails/order-shipped
+	view := "emails/" + strings.ReplaceAll(toSnakeCase(strings.TrimSuffix(name, "Mail")), "_", "-")
+
+	content := fmt.Sprintf(`package mails
+
+import "github.com/biyonik/conduit-go/pkg/mail"
+
+// %s is the email sent when...
+// TODO: Describe when this email is sent
+//
+// Example usage:
+//
+//	err := mail.Send(mailer, &mails.%s{Email: user.Email, Name: user.Name})
+//	err := mail.Queue(mailer, &mails.%s{Email: user.Email, Name: user.Name}, "")
+type %s struct {
+	Email string
+	Name  string
+	// TODO: Add the data the email needs
+}
+
+// Build fills the message using the %s template.
+func (m *%s) Build(message *mail.Message) error {
+	message.
+		To(m.Email, m.Name).
+		Template("%s", map[string]any{
+			"Name": m.Name,
+		})
+	return nil
+}
+`, name, name, name, name, view, name, view)
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		fmt.Printf("❌ Failed to create file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Mail created: %s\n", filename)
+
+	// Şablon zaten varsa (başka bir mailable ile paylaşılıyorsa) dokunulmaz
+	viewFile := filepath.Join("resources/views", view+".html")
+	if _, err := os.Stat(viewFile); err == nil {
+		return
+	}
+
+	viewContent := `{{define "subject"}}TODO: Subject{{end}}
+<p>Merhaba {{.Name}},</p>
+<p>TODO: Email content</p>
+`
+	if err := os.WriteFile(viewFile, []byte(viewContent), 0644); err != nil {
+		fmt.Printf("❌ Failed to create template: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Template created: %s\n", viewFile)
 }
 
 // -----------------------------------------------------------------------------
 // Migration Generator
 // -----------------------------------------------------------------------------
 
-func generateMigration(name string, table string) string {
+// migrationNameRegex, migration adlarının geçerli biçimidir (snake_case).
+var migrationNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// guessMigrationTable, --create/--table verilmediğinde tabloyu migration
+// adından tahmin eder:
+//
+//	create_posts_table          → posts (create)
+//	add_avatar_to_users_table   → users
+//	remove_avatar_from_users    → users
+func guessMigrationTable(name string) (table string, create bool) {
+	if m := regexp.MustCompile(`^create_(\w+?)_table$`).FindStringSubmatch(name); m != nil {
+		return m[1], true
+	}
+	if m := regexp.MustCompile(`_(?:to|from|in)_(\w+?)(?:_table)?$`).FindStringSubmatch(name); m != nil {
+		return m[1], false
+	}
+	return "", false
+}
+
+// generateMigration, database/migrations altında timestamp'li bir migration
+// oluşturur. Migration init fonksiyonunda registry'ye kaydolur.
+//
+// Parametreler:
+//   - name: Migration adı (snake_case, örn: create_posts_table)
+//   - table: Tablo adı (boş: Up/Down örnek yorumlarla üretilir)
+//   - create: true ise CreateTable/DropTable, false ise AlterTable iskeleti
+func generateMigration(name string, table string, create bool) string {
+	if !migrationNameRegex.MatchString(name) {
+		fmt.Printf("❌ Invalid migration name: %s (use snake_case, e.g. create_posts_table)\n", name)
+		os.Exit(1)
+	}
+
 	dir := "database/migrations"
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		fmt.Printf("❌ Failed to create directory: %v\n", err)
 		os.Exit(1)
 	}
 
+	// Aynı adlı iki migration aynı struct'ı tanımlar ve paket derlenmez
+	if existing, _ := filepath.Glob(filepath.Join(dir, "*_"+name+".go")); len(existing) > 0 {
+		fmt.Printf("❌ Migration already exists: %s\n", existing[0])
+		os.Exit(1)
+	}
+
 	// Generate timestamp-based filename
 	timestamp := time.Now().Format("2006_01_02_150405")
-	filename := filepath.Join(dir, fmt.Sprintf("%s_%s.go", timestamp, name))
+	migrationName := fmt.Sprintf("%s_%s", timestamp, name)
+	filename := filepath.Join(dir, migrationName+".go")
 
 	structName := toPascalCase(name)
 
+	var up, down string
+	switch {
+	case table != "" && create:
+		up = fmt.Sprintf(`return migrator.CreateTable("%s", func(t *migration.Blueprint) {
+		t.ID()
+		t.Timestamps()
+	})`, table)
+		down = fmt.Sprintf(`return migrator.DropTable("%s")`, table)
+
+	case table != "":
+		up = fmt.Sprintf(`return migrator.AlterTable("%s", func(t *migration.Blueprint) {
+		// t.String("avatar", 255).Nullable()
+	})`, table)
+		down = fmt.Sprintf(`return migrator.AlterTable("%s", func(t *migration.Blueprint) {
+		// t.DropColumn("avatar")
+	})`, table)
+
+	default:
+		up = `// Example:
+	// return migrator.CreateTable("posts", func(t *migration.Blueprint) {
+	//     t.ID()
+	//     t.String("title", 255)
+	//     t.Timestamps()
+	// })
+
+	return nil`
+		down = `// Example:
+	// return migrator.DropTable("posts")
+
+	return nil`
+	}
+
 	content := fmt.Sprintf(`package migrations
 
 import (
 	"github.com/biyonik/conduit-go/pkg/database/migration"
 )
 
+func init() {
+	migration.Register("%s", &%s{})
+}
+
 // %s migration
 type %s struct{}
 
 // Up runs the migration.
 func (m *%s) Up(migrator *migration.Migrator) error {
-	// TODO: Implement migration logic
+	%s
+}
+
+// Down reverses the migration.
+func (m *%s) Down(migrator *migration.Migrator) error {
+	%s
+}
+`, migrationName, structName, structName, structName, structName, up, structName, down)
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		fmt.Printf("❌ Failed to create migration file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Migration created: %s\n", filename)
+	fmt.Println("   Registered as " + migrationName + " (rebuild conduit, then run: conduit migrate)")
+	return filename
+}
+
+// -----------------------------------------------------------------------------
+// Policy Generator
+// -----------------------------------------------------------------------------
+
+func generatePolicy(name string, model string) {
+	// Ensure Policy suffix
+	if !strings.HasSuffix(name, "Policy") {
+		name = name + "Policy"
+	}
+
+	dir := "internal/policies"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Printf("❌ Failed to create directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	filename := filepath.Join(dir, toSnakeCase(name)+".go")
+	if _, err := os.Stat(filename); err == nil {
+		fmt.Printf("❌ Policy already exists: %s\n", filename)
+		os.Exit(1)
+	}
+
+	modelImport := ""
+	modelType := "interface{}"
+	modelVar := "resource"
+	ownerExample := "resource.(*models.Post).UserID == user.GetID()"
+	if model != "" {
+		modelImport = "\n\t\"github.com/biyonik/conduit-go/internal/models\""
+		modelType = "*models." + model
+		modelVar = strings.ToLower(model[:1]) + model[1:]
+		if modelVar == "user" {
+			// The authenticated user is already named user
+			modelVar = "target"
+		}
+		ownerExample = modelVar + ".UserID == user.GetID()"
+	}
+
+	content := fmt.Sprintf(`package policies
+
+import (%s
+	"github.com/biyonik/conduit-go/pkg/auth"
+)
+
+// %s authorizes actions on %s.
+type %s struct {
+	// TODO: Add dependencies (e.g., repository)
+}
+
+// New%s creates a new %s instance.
+func New%s() *%s {
+	return &%s{}
+}
+
+// View determines whether the user can view the resource.
+func (p *%s) View(user auth.User, %s %s) bool {
+	return true
+}
+
+// Create determines whether the user can create a resource.
+func (p *%s) Create(user auth.User) bool {
+	return true
+}
+
+// Update determines whether the user can update the resource.
+func (p *%s) Update(user auth.User, %s %s) bool {
+	// TODO: Allow owners, e.g. return %s
+	return user.GetRole() == "admin"
+}
+
+// Delete determines whether the user can delete the resource.
+func (p *%s) Delete(user auth.User, %s %s) bool {
+	// TODO: Allow owners, e.g. return %s
+	return user.GetRole() == "admin"
+}
+`, modelImport,
+		name, modelType, name,
+		name, name, name, name, name,
+		name, modelVar, modelType,
+		name,
+		name, modelVar, modelType, ownerExample,
+		name, modelVar, modelType, ownerExample)
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		fmt.Printf("❌ Failed to create file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Policy created: %s\n", filename)
+	fmt.Println("💡 Register it in cmd/api/main.go:")
+	fmt.Printf("     c.Register(func(c *container.Container) (*policies.%s, error) {\n", name)
+	fmt.Printf("         return policies.New%s(), nil\n", name)
+	fmt.Println("     })")
+	fmt.Println("   and check it on a route with middleware.Can:")
+	fmt.Printf("     policy := c.MustGet(reflect.TypeOf((*policies.%s)(nil))).(*policies.%s)\n", name, name)
+	fmt.Printf("     middleware.Can(\"update\", func(r *http.Request) bool {\n")
+	fmt.Printf("         %s := ... // Load from the route parameter\n", modelVar)
+	fmt.Printf("         return policy.Update(middleware.GetAuthUser(r.Context()), %s)\n", modelVar)
+	fmt.Println("     })")
+}
+
+// -----------------------------------------------------------------------------
+// Factory Generator
+// -----------------------------------------------------------------------------
+
+// modelColumn is a db-tagged field of a model struct.
+type modelColumn struct {
+	Column string
+	Type   string
+}
+
+// modelColumns parses internal/models and returns the db-tagged fields of
+// the given model (embedded BaseModel fields are skipped).
+func modelColumns(model string) ([]modelColumn, bool) {
+	fset := token.NewFileSet()
+	files, _ := filepath.Glob(filepath.Join("internal/models", "*.go"))
+
+	for _, file := range files {
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			continue
+		}
+
+		for _, decl := range parsed.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if typeSpec.Name.Name != model || !ok {
+					continue
+				}
+
+				var columns []modelColumn
+				for _, field := range structType.Fields.List {
+					if len(field.Names) == 0 || field.Tag == nil {
+						continue
+					}
+					tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+					column := strings.Split(tag.Get("db"), ",")[0]
+					if column == "" || column == "-" {
+						continue
+					}
+					columns = append(columns, modelColumn{Column: column, Type: exprString(field.Type)})
+				}
+				return columns, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// exprString renders a field type expression (string, *time.Time, ...).
+func exprString(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + exprString(e.X)
+	case *ast.SelectorExpr:
+		return exprString(e.X) + "." + e.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + exprString(e.Elt)
+	default:
+		return "interface{}"
+	}
+}
+
+// factoryValue returns a Go literal for a column's default value.
+func factoryValue(model string, col modelColumn) string {
+	switch {
+	case strings.HasPrefix(col.Type, "*"), strings.HasPrefix(col.Type, "[]"):
+		return "nil"
+	case col.Type == "time.Time":
+		return "time.Now()"
+	case col.Type == "bool":
+		return "false"
+	case strings.HasPrefix(col.Type, "int"), strings.HasPrefix(col.Type, "uint"):
+		return "0"
+	case strings.HasPrefix(col.Type, "float"):
+		return "0.0"
+	case col.Type != "string":
+		return "nil"
+	case strings.Contains(col.Column, "email"):
+		return `"user@example.com"`
+	case strings.Contains(col.Column, "password"):
+		// Hashed "password", so seeded users can log in
+		return fmt.Sprintf("%q", auth.MustHash("password"))
+	case col.Column == "name" || col.Column == "title":
+		return fmt.Sprintf("%q", "Test "+model)
+	default:
+		return fmt.Sprintf("%q", col.Column)
+	}
+}
+
+func generateFactory(name string, model string) {
+	// Ensure Factory suffix
+	if !strings.HasSuffix(name, "Factory") {
+		name = name + "Factory"
+	}
+	if model == "" {
+		model = strings.TrimSuffix(name, "Factory")
+	}
+
+	dir := "database/factories"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Printf("❌ Failed to create directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	filename := filepath.Join(dir, toSnakeCase(name)+".go")
+	if _, err := os.Stat(filename); err == nil {
+		fmt.Printf("❌ Factory already exists: %s\n", filename)
+		os.Exit(1)
+	}
+
+	columns, found := modelColumns(model)
+
+	var attributes strings.Builder
+	usesTime := false
+	if len(columns) == 0 {
+		attributes.WriteString("\t\t// TODO: Add default attributes (db column -> value)\n")
+		attributes.WriteString("\t\t// \"name\":  \"Test " + model + "\",\n")
+	}
+	width := 0
+	for _, col := range columns {
+		width = max(width, len(col.Column)+3)
+	}
+	for _, col := range columns {
+		value := factoryValue(model, col)
+		usesTime = usesTime || value == "time.Now()"
+		attributes.WriteString(fmt.Sprintf("\t\t%-*s %s,\n", width, fmt.Sprintf("%q:", col.Column), value))
+	}
+
+	imports := "\tconduittesting \"github.com/biyonik/conduit-go/pkg/testing\"\n"
+	if usesTime {
+		imports = "\t\"time\"\n\n" + imports
+	}
+
+	content := fmt.Sprintf(`package factories
+
+import (
+%s)
+
+// %s returns a factory with default attributes for models.%s.
+//
+// Usage (seeders and tests):
+//
+//	attrs := factories.%s().Make(map[string]interface{}{
+//	    // Overrides
+//	})
+//	database.NewBuilder(db, grammar).Table("%s").ExecInsert(attrs)
+func %s() *conduittesting.Factory {
+	return conduittesting.NewFactory(map[string]interface{}{
+%s	})
+}
+`, imports, name, model, name, pluralize(toSnakeCase(model)), name, attributes.String())
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		fmt.Printf("❌ Failed to create file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Factory created: %s\n", filename)
+	if !found {
+		fmt.Printf("⚠️  Model %s not found in internal/models; add the attributes by hand\n", model)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Seeder Generator
+// -----------------------------------------------------------------------------
+
+func generateSeeder(name string) {
+	// Ensure Seeder suffix
+	if !strings.HasSuffix(name, "Seeder") {
+		name = name + "Seeder"
+	}
+
+	dir := "database/seeders"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Printf("❌ Failed to create directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	filename := filepath.Join(dir, toSnakeCase(name)+".go")
+	if _, err := os.Stat(filename); err == nil {
+		fmt.Printf("❌ Seeder already exists: %s\n", filename)
+		os.Exit(1)
+	}
+
+	content := fmt.Sprintf(`package seeders
+
+import (
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database/seeder"
+)
+
+func init() {
+	seeder.Register(&%s{})
+}
+
+// %s seeds the database.
+type %s struct{}
+
+// Run seeds the database.
+func (s *%s) Run(c *container.Container) error {
 	// Example:
-	// return migrator.CreateTable("%s", func(t *migration.Blueprint) {
-	//     t.ID()
-	//     t.String("name", 255)
-	//     t.String("email", 255).Unique()
-	//     t.Timestamps()
+	// db, grammar := container.GetDatabaseAndGrammar(c)
+	// _, err := database.NewBuilder(db, grammar).Table("posts").ExecInsert(map[string]interface{}{
+	//     "title":      "Hello World",
+	//     "created_at": time.Now(),
 	// })
+	// return err
 
 	return nil
 }
+`, name, name, name, name)
 
-// Down reverses the migration.
-func (m *%s) Down(migrator *migration.Migrator) error {
-	// TODO: Implement rollback logic
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		fmt.Printf("❌ Failed to create file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Seeder created: %s\n", filename)
+	fmt.Printf("   Add &%s{} to DatabaseSeeder.Run, or run it alone: conduit db:seed --class=%s\n", name, name)
+}
+
+// -----------------------------------------------------------------------------
+// Command Generator
+// -----------------------------------------------------------------------------
+
+// generateCommand creates a console command in internal/commands.
+//
+// The command name defaults to "app:" + the kebab-cased type name
+// (SyncExchangeRates -> app:sync-exchange-rates).
+func generateCommand(name string, commandName string) {
+	if commandName == "" {
+		commandName = "app:" + strings.ReplaceAll(toSnakeCase(name), "_", "-")
+	}
+	if _, exists := command.Get(commandName); exists {
+		fmt.Printf("❌ Command already registered: %s\n", commandName)
+		os.Exit(1)
+	}
+
+	dir := "internal/commands"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Printf("❌ Failed to create directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	filename := filepath.Join(dir, toSnakeCase(name)+".go")
+	if _, err := os.Stat(filename); err == nil {
+		fmt.Printf("❌ Command already exists: %s\n", filename)
+		os.Exit(1)
+	}
+
+	content := fmt.Sprintf(`package commands
+
+import (
+	"flag"
+
+	"github.com/biyonik/conduit-go/pkg/console"
+	"github.com/biyonik/conduit-go/pkg/console/command"
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+func init() {
+	command.Register(&%[1]s{})
+}
+
+// %[1]s is the `+"`"+`conduit %[2]s`+"`"+` command.
+type %[1]s struct {
+	force bool
+}
+
+// Name returns the name the command is called with.
+func (cmd *%[1]s) Name() string {
+	return "%[2]s"
+}
+
+// Description is shown in conduit help.
+func (cmd *%[1]s) Description() string {
+	return "TODO: Describe the command"
+}
+
+// Configure defines the command's flags.
+func (cmd *%[1]s) Configure(fs *flag.FlagSet) {
+	fs.BoolVar(&cmd.force, "force", false, "Run without asking for confirmation")
+}
+
+// Handle runs the command. args holds the positional arguments.
+func (cmd *%[1]s) Handle(c *container.Container, args []string) error {
 	// Example:
-	// return migrator.DropTable("%s")
+	// db, grammar := container.GetDatabaseAndGrammar(c)
+	// logger := container.GetLogger(c)
 
+	console.Success("%[2]s done")
 	return nil
 }
-`, structName, structName, structName, table, structName, table)
+`, name, commandName)
 
 	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
-		fmt.Printf("❌ Failed to create migration file: %v\n", err)
+		fmt.Printf("❌ Failed to create file: %v\n", err)
 		os.Exit(1)
 	}
 
-	fmt.Printf("✅ Migration created: %s\n", filename)
-	return filename
+	fmt.Printf("✅ Command created: %s\n", filename)
+	fmt.Printf("   Run it with: conduit %s\n", commandName)
 }
 
 // -----------------------------------------------------------------------------
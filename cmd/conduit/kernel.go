@@ -0,0 +1,134 @@
+// -----------------------------------------------------------------------------
+// Command Kernel
+// -----------------------------------------------------------------------------
+// Built-in komutlar ve internal/commands altında kayıtlı uygulama komutları
+// burada kernel'e eklenir. Help çıktısı bu listeden üretilir; yeni bir
+// built-in komut eklemek için tek yapılması gereken buraya bir Definition
+// eklemektir.
+// -----------------------------------------------------------------------------
+
+package main
+
+import (
+	"fmt"
+
+	_ "github.com/biyonik/conduit-go/internal/commands" // Uygulama komutları init ile kaydedilir
+	"github.com/biyonik/conduit-go/pkg/console/command"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/version"
+)
+
+// Help bölümleri
+const (
+	groupMake      = "MAKE COMMANDS"
+	groupMigration = "MIGRATION COMMANDS"
+	groupDatabase  = "DATABASE COMMANDS"
+	groupSchedule  = "SCHEDULE COMMANDS"
+	groupKey       = "KEY COMMANDS"
+	groupEnv       = "ENV COMMANDS"
+	groupConfig    = "CONFIG COMMANDS"
+	groupRoute     = "ROUTE COMMANDS"
+	groupCache     = "CACHE COMMANDS"
+	groupQueue     = "QUEUE COMMANDS"
+	groupEvent     = "EVENT COMMANDS"
+	groupOther     = "OTHER COMMANDS"
+)
+
+// noArgs, argüman almayan komut fonksiyonlarını Definition.Run'a uyarlar.
+func noArgs(fn func()) func([]string) {
+	return func([]string) { fn() }
+}
+
+// newKernel, built-in ve uygulama komutlarını içeren kernel'i oluşturur.
+func newKernel() *command.Kernel {
+	k := command.NewKernel()
+
+	for _, def := range []command.Definition{
+		{Name: "make:controller", Usage: "<name>", Description: "Create a new controller", Group: groupMake, Run: handleMakeController},
+		{Name: "make:model", Usage: "<name>", Description: "Create a new model", Group: groupMake, Run: handleMakeModel},
+		{Name: "make:middleware", Usage: "<name>", Description: "Create a new middleware", Group: groupMake, Run: handleMakeMiddleware},
+		{Name: "make:job", Usage: "<name>", Description: "Create a new job", Group: groupMake, Run: handleMakeJob},
+		{Name: "make:event", Usage: "<name>", Description: "Create a new event", Group: groupMake, Run: handleMakeEvent},
+		{Name: "make:listener", Usage: "<name>", Description: "Create a new event listener", Group: groupMake, Run: handleMakeListener},
+		{Name: "make:request", Usage: "<name>", Description: "Create a new form request", Group: groupMake, Run: handleMakeRequest},
+		{Name: "make:mail", Usage: "<name>", Description: "Create a new mailable (and its email template)", Group: groupMake, Run: handleMakeMail},
+		{Name: "make:migration", Usage: "<name>", Description: "Create a new migration (--create=<table> or --table=<table>)", Group: groupMake, Run: handleMakeMigration},
+		{Name: "make:seeder", Usage: "<name>", Description: "Create a new database seeder", Group: groupMake, Run: handleMakeSeeder},
+		{Name: "make:policy", Usage: "<name>", Description: "Create a new authorization policy (--model=<Model>)", Group: groupMake, Run: handleMakePolicy},
+		{Name: "make:factory", Usage: "<name>", Description: "Create a new model factory (--model=<Model>)", Group: groupMake, Run: handleMakeFactory},
+		{Name: "make:command", Usage: "<name>", Description: "Create a new console command (--command=<name:action>)", Group: groupMake, Run: handleMakeCommand},
+
+		{Name: "migrate", Description: "Run pending migrations (--step=N --pretend)", Group: groupMigration, Run: handleMigrate},
+		{Name: "migrate:rollback", Description: "Rollback the last batch (--step=N --pretend)", Group: groupMigration, Run: handleMigrateRollback},
+		{Name: "migrate:reset", Description: "Rollback all migrations (--pretend)", Group: groupMigration, Run: handleMigrateReset},
+		{Name: "migrate:fresh", Description: "Drop all tables and re-run migrations (--force --pretend)", Group: groupMigration, Run: handleMigrateFresh},
+		{Name: "migrate:status", Description: "Show which migrations have run", Group: groupMigration, Run: handleMigrateStatus},
+
+		{Name: "db:seed", Description: "Seed the database (--class=UserSeeder, default DatabaseSeeder; asks in production, --force)", Group: groupDatabase, Run: handleDBSeed},
+		{Name: "db:show", Description: "Show connection info, tables, row estimates and sizes", Group: groupDatabase, Run: noArgs(showDatabase)},
+		{Name: "db:table", Usage: "<table>", Description: "Show a table's columns, indexes and foreign keys", Group: groupDatabase, Run: handleDBTable},
+		{Name: "db:wipe", Description: "Drop all tables without running migrations (--force --pretend)", Group: groupDatabase, Run: handleDBWipe},
+
+		{Name: "schedule:run", Description: "Run the scheduled tasks that are due (call every minute from cron)", Group: groupSchedule, Run: noArgs(runSchedule)},
+		{Name: "schedule:work", Description: "Run the scheduler in the foreground", Group: groupSchedule, Run: noArgs(workSchedule)},
+		{Name: "schedule:list", Description: "List scheduled tasks with their next run time", Group: groupSchedule, Run: noArgs(listSchedule)},
+
+		{Name: "key:generate", Description: "Generate APP_KEY and write it to .env (--show --force --env=<file>)", Group: groupKey, Run: handleKeyGenerate},
+
+		{Name: "env:get", Usage: "[key]", Description: "Print a value from .env, or list all keys with secrets redacted (--env=<file> --reveal)", Group: groupEnv, Run: handleEnvGet},
+		{Name: "env:set", Usage: "<KEY=VALUE...>", Description: "Update or add keys in .env, keeping comments and a .backup copy (--env=<file> --no-backup)", Group: groupEnv, Run: handleEnvSet},
+		{Name: "env:encrypt", Usage: "<KEY...>", Description: "Encrypt values in .env with APP_KEY as ENC(...) (--env=<file> --value=<plaintext> --no-backup)", Group: groupEnv, Run: handleEnvEncrypt},
+		{Name: "env:decrypt", Usage: "<KEY...>", Description: "Decrypt ENC(...) values in .env (--env=<file> --show --value=<ENC(...)> --no-backup)", Group: groupEnv, Run: handleEnvDecrypt},
+
+		{Name: "config:show", Usage: "[key]", Description: "Show the resolved configuration, secrets redacted (--json)", Group: groupConfig, Run: handleConfigShow},
+		{Name: "config:cache", Description: "Cache the configuration for faster, deterministic boots", Group: groupConfig, Run: noArgs(cacheConfig)},
+		{Name: "config:clear", Description: "Remove the configuration cache", Group: groupConfig, Run: noArgs(clearConfigCache)},
+		{Name: "optimize", Description: "Cache the bootstrap files for deployment (config:cache, route:cache, event:cache)", Group: groupConfig, Run: noArgs(optimizeApplication)},
+		{Name: "optimize:clear", Description: "Remove the cached bootstrap files", Group: groupConfig, Run: noArgs(clearOptimization)},
+
+		{Name: "route:list", Description: "List the route table, from the route cache if present (--method=GET --path=<filter>)", Group: groupRoute, Run: handleRouteList},
+		{Name: "route:cache", Description: "Write the route table to the route cache (boots the app, needs the database)", Group: groupRoute, Run: noArgs(cacheRoutes)},
+		{Name: "route:clear", Description: "Remove the route cache", Group: groupRoute, Run: noArgs(clearRouteCache)},
+
+		{Name: "cache:clear", Description: "Clear all cache", Group: groupCache, Run: handleCacheClear},
+		{Name: "cache:forget", Usage: "<key>", Description: "Remove specific cache key", Group: groupCache, Run: handleCacheForget},
+		{Name: "cache:stats", Description: "Show cache driver statistics", Group: groupCache, Run: handleCacheStats},
+		{Name: "cache:prune", Description: "Remove expired cache entries (file driver)", Group: groupCache, Run: handleCachePrune},
+
+		{Name: "queue:work", Description: "Start queue worker (--queue=critical,default,low or critical:5,default:1 --strategy=priority|weighted|round-robin --concurrency=N)", Group: groupQueue, Run: handleQueueWork},
+		{Name: "queue:listen", Description: "Start queue listener", Group: groupQueue, Run: handleQueueListen},
+		{Name: "queue:restart", Description: "Gracefully restart queue workers after their current job", Group: groupQueue, Run: handleQueueRestart},
+		{Name: "queue:jobs", Description: "List registered job types", Group: groupQueue, Run: handleQueueJobs},
+		{Name: "queue:monitor", Description: "Show queue depth, oldest job age, rates and workers (--queue=a,b --interval=N --json)", Group: groupQueue, Run: handleQueueMonitor},
+		{Name: "queue:failed", Description: "List failed jobs", Group: groupQueue, Run: handleQueueFailed},
+		{Name: "queue:retry", Usage: "<id|--all>", Description: "Push failed job(s) back onto their queue", Group: groupQueue, Run: handleQueueRetry},
+		{Name: "queue:forget", Usage: "<id>", Description: "Delete a failed job", Group: groupQueue, Run: handleQueueForget},
+		{Name: "queue:flush", Description: "Delete all failed jobs (--force skips the prompt)", Group: groupQueue, Run: handleQueueFlush},
+
+		{Name: "event:list", Description: "List the event → listener mapping, from the event cache if present (--event=<filter>)", Group: groupEvent, Run: handleEventList},
+		{Name: "event:cache", Description: "Write the event → listener mapping to the event cache", Group: groupEvent, Run: noArgs(cacheEvents)},
+		{Name: "event:clear", Description: "Remove the event cache", Group: groupEvent, Run: noArgs(clearEventCache)},
+
+		{Name: "serve", Description: "Start development server", Group: groupOther, Run: handleServe},
+		{Name: "help", Aliases: []string{"--help", "-h"}, Description: "Show this help message", Group: groupOther, Run: func([]string) { printHelp(k) }},
+		{Name: "version", Aliases: []string{"--version", "-v"}, Description: "Show version", Group: groupOther, Run: noArgs(printVersion)},
+	} {
+		k.Add(def)
+	}
+
+	for _, cmd := range command.Registered() {
+		k.AddCommand(cmd, bootCommandContainer)
+	}
+	return k
+}
+
+// bootCommandContainer, uygulama komutları için container'ı kurar.
+func bootCommandContainer() (*container.Container, func(), error) {
+	c, _, closeFn, err := bootAppContainer()
+	return c, closeFn, err
+}
+
+// printVersion, CLI sürümünü yazdırır.
+func printVersion() {
+	fmt.Printf("Conduit CLI %s\n", version.String())
+}
@@ -13,17 +13,60 @@
 //   make:job           - Job oluşturur
 //   make:event         - Event oluşturur
 //   make:listener      - Event Listener oluşturur
+//   make:request       - Form Request oluşturur
+//   make:mail          - Mailable oluşturur
+//   make:migration     - Migration oluşturur
+//   make:seeder        - Seeder oluşturur
+//   make:policy        - Authorization policy oluşturur
+//   make:factory       - Model factory oluşturur
+//   make:command       - Uygulama komutu oluşturur
 //   migrate            - Veritabanı migration'larını çalıştırır
-//   migrate:rollback   - Son migration'ı geri alır
+//   migrate:rollback   - Son migration batch'ini geri alır
+//   migrate:reset      - Tüm migration'ları geri alır
 //   migrate:fresh      - Tüm tabloları siler ve migration'ları tekrar çalıştırır
 //   migrate:status     - Migration durumunu gösterir
+//   db:seed            - Seeder'ları çalıştırır
+//   db:show            - Veritabanı bağlantısını ve tablolarını gösterir
+//   db:table           - Tablonun kolon, index ve foreign key'lerini gösterir
+//   db:wipe            - Migration çalıştırmadan tüm tabloları siler
+//   schedule:run       - Due zamanlanmış görevleri bir kez çalıştırır (cron)
+//   schedule:work      - Zamanlanmış görevleri process içinde çalıştırır
+//   schedule:list      - Zamanlanmış görevleri listeler
+//   key:generate       - APP_KEY üretir ve .env'e yazar
+//   env:get            - .env'deki bir değeri okur
+//   env:set            - .env'deki değerleri günceller (yedekleyerek)
+//   env:encrypt        - .env değerlerini APP_KEY ile şifreler (ENC)
+//   env:decrypt        - ENC(...) değerlerini çözer
+//   config:show        - Çözümlenmiş config'i gösterir (secret'lar maskeli)
+//   config:cache       - Config'i cache dosyasına yazar
+//   config:clear       - Config cache'ini siler
+//   optimize           - Deploy için bootstrap cache'lerini oluşturur
+//   optimize:clear     - Bootstrap cache'lerini siler
+//   route:list         - Route tablosunu listeler
+//   route:cache        - Route tablosunu cache dosyasına yazar
+//   route:clear        - Route cache'ini siler
 //   cache:clear        - Cache'i temizler
 //   cache:forget       - Belirli bir cache key'ini siler
+//   cache:stats        - Cache driver istatistiklerini gösterir
+//   cache:prune        - Expired cache girdilerini temizler
 //   queue:work         - Queue worker başlatır
 //   queue:listen       - Queue listener başlatır
 //   queue:restart      - Queue worker'ları yeniden başlatır
+//   queue:jobs         - Register edilmiş job tiplerini listeler
+//   queue:monitor      - Queue derinliği, oranlar ve worker sayılarını gösterir
+//   queue:failed       - Failed job'ları listeler
+//   queue:retry        - Failed job'ları tekrar kuyruğa alır
+//   queue:forget       - Failed job kaydını siler
+//   queue:flush        - Tüm failed job kayıtlarını siler
+//   event:list         - Event → listener eşlemesini listeler
+//   event:cache        - Event → listener eşlemesini cache dosyasına yazar
+//   event:clear        - Event cache'ini siler
 //   serve              - Development sunucusunu başlatır
 //   help               - Yardım gösterir
+//
+// Komutlar kernel.go'da kernel'e eklenir; internal/commands altında
+// command.Register ile kaydedilen uygulama komutları da kernel'e eklenir ve
+// help'te APPLICATION COMMANDS altında listelenir.
 // -----------------------------------------------------------------------------
 
 package main
@@ -32,105 +75,49 @@ import (
 	"flag"
 	"fmt"
 	"os"
-)
+	"strings"
 
-const Version = "1.0.0"
+	"github.com/biyonik/conduit-go/internal/config"
+	"github.com/biyonik/conduit-go/pkg/console"
+	"github.com/biyonik/conduit-go/pkg/console/command"
+	"github.com/biyonik/conduit-go/pkg/database/seeder"
+	"github.com/biyonik/conduit-go/pkg/version"
+)
 
 func main() {
+	kernel := newKernel()
+
 	if len(os.Args) < 2 {
-		printHelp()
+		printHelp(kernel)
 		os.Exit(0)
 	}
 
 	command := os.Args[1]
-
-	switch command {
-	case "make:controller":
-		handleMakeController(os.Args[2:])
-	case "make:model":
-		handleMakeModel(os.Args[2:])
-	case "make:middleware":
-		handleMakeMiddleware(os.Args[2:])
-	case "make:job":
-		handleMakeJob(os.Args[2:])
-	case "make:event":
-		handleMakeEvent(os.Args[2:])
-	case "make:listener":
-		handleMakeListener(os.Args[2:])
-	case "migrate":
-		handleMigrate(os.Args[2:])
-	case "migrate:rollback":
-		handleMigrateRollback(os.Args[2:])
-	case "migrate:fresh":
-		handleMigrateFresh(os.Args[2:])
-	case "migrate:status":
-		handleMigrateStatus(os.Args[2:])
-	case "cache:clear":
-		handleCacheClear(os.Args[2:])
-	case "cache:forget":
-		handleCacheForget(os.Args[2:])
-	case "queue:work":
-		handleQueueWork(os.Args[2:])
-	case "queue:listen":
-		handleQueueListen(os.Args[2:])
-	case "queue:restart":
-		handleQueueRestart(os.Args[2:])
-	case "serve":
-		handleServe(os.Args[2:])
-	case "help", "--help", "-h":
-		printHelp()
-	case "version", "--version", "-v":
-		fmt.Printf("Conduit CLI v%s\n", Version)
-	default:
+	if !kernel.Run(command, os.Args[2:]) {
 		fmt.Printf("❌ Unknown command: %s\n\n", command)
-		printHelp()
+		printHelp(kernel)
 		os.Exit(1)
 	}
 }
 
 // printHelp displays help information.
-func printHelp() {
+func printHelp(kernel *command.Kernel) {
 	fmt.Println(`
 ╔══════════════════════════════════════════════════════════════════════╗
 ║                   CONDUIT CLI - Laravel-Inspired                     ║
-║                          Version ` + Version + `                              ║
+║                          ` + fmt.Sprintf("%-44s", "Version "+version.Version) + `║
 ╚══════════════════════════════════════════════════════════════════════╝
 
 USAGE:
   conduit <command> [arguments] [flags]
-
-MAKE COMMANDS:
-  make:controller <name>     Create a new controller
-  make:model <name>          Create a new model
-  make:middleware <name>     Create a new middleware
-  make:job <name>            Create a new job
-  make:event <name>          Create a new event
-  make:listener <name>       Create a new event listener
-
-MIGRATION COMMANDS:
-  migrate                    Run database migrations
-  migrate:rollback           Rollback the last migration
-  migrate:fresh              Drop all tables and re-run migrations
-  migrate:status             Show migration status
-
-CACHE COMMANDS:
-  cache:clear                Clear all cache
-  cache:forget <key>         Remove specific cache key
-
-QUEUE COMMANDS:
-  queue:work                 Start queue worker
-  queue:listen               Start queue listener
-  queue:restart              Restart queue workers
-
-OTHER COMMANDS:
-  serve                      Start development server
-  help                       Show this help message
-  version                    Show version
-
+`)
+	kernel.Help(os.Stdout)
+	fmt.Print(`
 EXAMPLES:
   conduit make:controller UserController
   conduit make:model User
   conduit migrate
+  conduit migrate --pretend
   conduit serve --port=8080
 
 For more information about a specific command:
@@ -221,45 +208,349 @@ func handleMakeListener(args []string) {
 	generateListener(name, *event)
 }
 
+func handleMakeRequest(args []string) {
+	if len(args) < 1 {
+		fmt.Println("❌ Request name required")
+		fmt.Println("Usage: conduit make:request <name>")
+		os.Exit(1)
+	}
+
+	name := args[0]
+	generateRequest(name)
+}
+
+func handleMakeMail(args []string) {
+	if len(args) < 1 {
+		fmt.Println("❌ Mail name required")
+		fmt.Println("Usage: conduit make:mail <name>")
+		os.Exit(1)
+	}
+
+	name := args[0]
+	generateMail(name)
+}
+
+func handleMakeMigration(args []string) {
+	fs := flag.NewFlagSet("make:migration", flag.ExitOnError)
+	create := fs.String("create", "", "The table to be created")
+	table := fs.String("table", "", "The table to migrate")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fmt.Println("❌ Migration name required")
+		fmt.Println("Usage: conduit make:migration <name> [--create=<table>] [--table=<table>]")
+		os.Exit(1)
+	}
+
+	// Flag'ler addan sonra da verilebilir (make:migration create_posts_table --create=posts)
+	name := fs.Arg(0)
+	fs.Parse(fs.Args()[1:])
+
+	if *create != "" && *table != "" {
+		fmt.Println("❌ Use either --create or --table, not both")
+		os.Exit(1)
+	}
+
+	switch {
+	case *create != "":
+		generateMigration(name, *create, true)
+	case *table != "":
+		generateMigration(name, *table, false)
+	default:
+		guessed, isCreate := guessMigrationTable(name)
+		generateMigration(name, guessed, isCreate)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Migration Commands
 // -----------------------------------------------------------------------------
 
 func handleMigrate(args []string) {
 	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
-	step := fs.Int("step", 0, "Number of migrations to run")
+	step := fs.Int("step", 0, "Number of pending migrations to run (0: all)")
+	pretend := fs.Bool("pretend", false, "Print the SQL without executing it")
 	fs.Parse(args)
 
-	runMigrations(*step)
+	runMigrations(*step, *pretend)
 }
 
 func handleMigrateRollback(args []string) {
 	fs := flag.NewFlagSet("migrate:rollback", flag.ExitOnError)
-	step := fs.Int("step", 1, "Number of migrations to rollback")
+	step := fs.Int("step", 0, "Number of migrations to rollback (0: the last batch)")
+	pretend := fs.Bool("pretend", false, "Print the SQL without executing it")
 	fs.Parse(args)
 
-	rollbackMigrations(*step)
+	rollbackMigrations(*step, *pretend)
 }
 
-func handleMigrateFresh(args []string) {
-	fmt.Println("⚠️  WARNING: This will drop all tables and re-run all migrations!")
-	fmt.Print("Are you sure? (yes/no): ")
+func handleMigrateReset(args []string) {
+	fs := flag.NewFlagSet("migrate:reset", flag.ExitOnError)
+	pretend := fs.Bool("pretend", false, "Print the SQL without executing it")
+	fs.Parse(args)
 
-	var confirm string
-	fmt.Scanln(&confirm)
+	resetMigrations(*pretend)
+}
 
-	if confirm != "yes" {
-		fmt.Println("Operation cancelled")
-		return
+func handleMigrateFresh(args []string) {
+	fs := flag.NewFlagSet("migrate:fresh", flag.ExitOnError)
+	force := fs.Bool("force", false, "Skip the confirmation prompt")
+	pretend := fs.Bool("pretend", false, "Print the SQL without executing it")
+	fs.Parse(args)
+
+	if !*force && !*pretend {
+		console.Warn("This will drop all tables and re-run all migrations!")
+		if !console.Confirm("Are you sure?", false) {
+			console.Line("Operation cancelled")
+			return
+		}
 	}
 
-	freshMigrations()
+	freshMigrations(*pretend)
 }
 
 func handleMigrateStatus(args []string) {
 	showMigrationStatus()
 }
 
+// -----------------------------------------------------------------------------
+// Database Commands
+// -----------------------------------------------------------------------------
+
+func handleMakePolicy(args []string) {
+	fs := flag.NewFlagSet("make:policy", flag.ExitOnError)
+	model := fs.String("model", "", "The model the policy applies to")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fmt.Println("❌ Policy name required")
+		fmt.Println("Usage: conduit make:policy <name> [--model=<Model>]")
+		os.Exit(1)
+	}
+
+	// Flag'ler addan sonra da verilebilir (make:policy PostPolicy --model=Post)
+	name := fs.Arg(0)
+	fs.Parse(fs.Args()[1:])
+
+	generatePolicy(name, *model)
+}
+
+func handleMakeFactory(args []string) {
+	fs := flag.NewFlagSet("make:factory", flag.ExitOnError)
+	model := fs.String("model", "", "The model the factory creates (default: name without Factory)")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fmt.Println("❌ Factory name required")
+		fmt.Println("Usage: conduit make:factory <name> [--model=<Model>]")
+		os.Exit(1)
+	}
+
+	// Flag'ler addan sonra da verilebilir (make:factory UserFactory --model=User)
+	name := fs.Arg(0)
+	fs.Parse(fs.Args()[1:])
+
+	generateFactory(name, *model)
+}
+
+func handleMakeCommand(args []string) {
+	fs := flag.NewFlagSet("make:command", flag.ExitOnError)
+	name := fs.String("command", "", "The name the command is called with (default: app:<kebab-name>)")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fmt.Println("❌ Command name required")
+		fmt.Println("Usage: conduit make:command <name> [--command=<name:action>]")
+		os.Exit(1)
+	}
+
+	// Flag'ler addan sonra da verilebilir (make:command SyncRates --command=rates:sync)
+	typeName := fs.Arg(0)
+	fs.Parse(fs.Args()[1:])
+
+	generateCommand(typeName, *name)
+}
+
+func handleMakeSeeder(args []string) {
+	if len(args) < 1 {
+		fmt.Println("❌ Seeder name required")
+		fmt.Println("Usage: conduit make:seeder <name>")
+		os.Exit(1)
+	}
+
+	generateSeeder(args[0])
+}
+
+func handleDBSeed(args []string) {
+	fs := flag.NewFlagSet("db:seed", flag.ExitOnError)
+	class := fs.String("class", seeder.DefaultSeeder, "The seeder to run")
+	force := fs.Bool("force", false, "Skip the confirmation prompt in production")
+	fs.Parse(args)
+
+	seedDatabase(*class, *force)
+}
+
+func handleDBTable(args []string) {
+	if len(args) < 1 {
+		fmt.Println("❌ Table name required")
+		fmt.Println("Usage: conduit db:table <table>")
+		os.Exit(1)
+	}
+
+	showTable(args[0])
+}
+
+func handleDBWipe(args []string) {
+	fs := flag.NewFlagSet("db:wipe", flag.ExitOnError)
+	force := fs.Bool("force", false, "Skip the confirmation prompt")
+	pretend := fs.Bool("pretend", false, "Print the SQL without executing it")
+	fs.Parse(args)
+
+	if !*force && !*pretend {
+		console.Warn("This will drop all tables in the database!")
+		if !console.Confirm("Are you sure?", false) {
+			console.Line("Operation cancelled")
+			return
+		}
+	}
+
+	wipeDatabase(*pretend)
+}
+
+// -----------------------------------------------------------------------------
+// Key Commands
+// -----------------------------------------------------------------------------
+
+func handleKeyGenerate(args []string) {
+	fs := flag.NewFlagSet("key:generate", flag.ExitOnError)
+	show := fs.Bool("show", false, "Display the key instead of writing it")
+	force := fs.Bool("force", false, "Replace an existing APP_KEY")
+	envFile := fs.String("env", ".env", "The env file to write")
+	fs.Parse(args)
+
+	generateAppKey(*envFile, *show, *force)
+}
+
+// -----------------------------------------------------------------------------
+// Env Commands
+// -----------------------------------------------------------------------------
+
+func handleEnvGet(args []string) {
+	fs := flag.NewFlagSet("env:get", flag.ExitOnError)
+	envFile := fs.String("env", config.DefaultEnvFile, "The env file to read")
+	reveal := fs.Bool("reveal", false, "Show secret values when listing all keys")
+	fs.Parse(args)
+
+	// Flag'ler key'den sonra da verilebilir (env:get APP_ENV --env=.env.production)
+	key := fs.Arg(0)
+	if fs.NArg() > 0 {
+		fs.Parse(fs.Args()[1:])
+	}
+
+	if key == "" {
+		listEnvKeys(*envFile, *reveal)
+		return
+	}
+	getEnvValue(*envFile, key)
+}
+
+func handleEnvSet(args []string) {
+	fs := flag.NewFlagSet("env:set", flag.ExitOnError)
+	envFile := fs.String("env", config.DefaultEnvFile, "The env file to update")
+	noBackup := fs.Bool("no-backup", false, "Do not save the previous file as <file>.backup")
+	fs.Parse(args)
+
+	// Flag'ler atamalardan sonra da verilebilir
+	pairs := restArgs(fs)
+
+	// "env:set KEY value" yazımı da kabul edilir
+	if len(pairs) == 2 && !strings.Contains(pairs[0], "=") {
+		pairs = []string{pairs[0] + "=" + pairs[1]}
+	}
+
+	if len(pairs) == 0 {
+		fmt.Println("❌ KEY=VALUE required")
+		fmt.Println("Usage: conduit env:set KEY=VALUE [KEY=VALUE...] [--env=.env] [--no-backup]")
+		os.Exit(1)
+	}
+
+	setEnvValues(*envFile, pairs, !*noBackup)
+}
+
+func handleEnvEncrypt(args []string) {
+	fs := flag.NewFlagSet("env:encrypt", flag.ExitOnError)
+	envFile := fs.String("env", config.DefaultEnvFile, "The env file to update (and read APP_KEY from)")
+	value := fs.String("value", "", "Encrypt this value and print it instead of updating the file")
+	noBackup := fs.Bool("no-backup", false, "Do not save the previous file as <file>.backup")
+	fs.Parse(args)
+
+	keys := restArgs(fs)
+	if len(keys) == 0 && *value == "" {
+		fmt.Println("❌ KEY or --value required")
+		fmt.Println("Usage: conduit env:encrypt KEY [KEY...] [--env=.env] [--no-backup]")
+		fmt.Println("       conduit env:encrypt --value=<plaintext>")
+		os.Exit(1)
+	}
+
+	if *value != "" {
+		printEncryptedValue(*envFile, *value)
+		return
+	}
+	encryptEnvValues(*envFile, keys, !*noBackup)
+}
+
+func handleEnvDecrypt(args []string) {
+	fs := flag.NewFlagSet("env:decrypt", flag.ExitOnError)
+	envFile := fs.String("env", config.DefaultEnvFile, "The env file to update (and read APP_KEY from)")
+	value := fs.String("value", "", "Decrypt this ENC(...) value and print it")
+	show := fs.Bool("show", false, "Print the decrypted values instead of updating the file")
+	noBackup := fs.Bool("no-backup", false, "Do not save the previous file as <file>.backup")
+	fs.Parse(args)
+
+	keys := restArgs(fs)
+	if len(keys) == 0 && *value == "" {
+		fmt.Println("❌ KEY or --value required")
+		fmt.Println("Usage: conduit env:decrypt KEY [KEY...] [--env=.env] [--show] [--no-backup]")
+		fmt.Println("       conduit env:decrypt --value='ENC(...)'")
+		os.Exit(1)
+	}
+
+	if *value != "" {
+		printDecryptedValue(*envFile, *value)
+		return
+	}
+	decryptEnvValues(*envFile, keys, *show, !*noBackup)
+}
+
+// restArgs, flag'lerle karışık verilmiş pozisyonel argümanları toplar
+// (env:encrypt KEY --env=.env.production KEY2).
+func restArgs(fs *flag.FlagSet) []string {
+	var rest []string
+	for fs.NArg() > 0 {
+		rest = append(rest, fs.Arg(0))
+		fs.Parse(fs.Args()[1:])
+	}
+	return rest
+}
+
+// -----------------------------------------------------------------------------
+// Config Commands
+// -----------------------------------------------------------------------------
+
+func handleConfigShow(args []string) {
+	fs := flag.NewFlagSet("config:show", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Output as JSON")
+	fs.Parse(args)
+
+	// Flag'ler key'den sonra da verilebilir (config:show db --json)
+	key := fs.Arg(0)
+	if fs.NArg() > 0 {
+		fs.Parse(fs.Args()[1:])
+	}
+
+	showConfig(key, *asJSON)
+}
+
 // -----------------------------------------------------------------------------
 // Cache Commands
 // -----------------------------------------------------------------------------
@@ -279,18 +570,28 @@ func handleCacheForget(args []string) {
 	forgetCacheKey(key)
 }
 
+func handleCacheStats(args []string) {
+	showCacheStats()
+}
+
+func handleCachePrune(args []string) {
+	pruneCache()
+}
+
 // -----------------------------------------------------------------------------
 // Queue Commands
 // -----------------------------------------------------------------------------
 
 func handleQueueWork(args []string) {
 	fs := flag.NewFlagSet("queue:work", flag.ExitOnError)
-	queue := fs.String("queue", "default", "The queue to listen on")
+	queue := fs.String("queue", "default", "The queue(s) to listen on, comma separated in priority order (name:weight for weights)")
+	strategy := fs.String("strategy", "", "Multi-queue strategy: priority, weighted or round-robin (default: priority, weighted if weights given)")
 	maxJobs := fs.Int("max-jobs", 0, "Maximum number of jobs to process")
-	timeout := fs.Int("timeout", 60, "Job timeout in seconds")
+	timeout := fs.Int("timeout", 60, "Job timeout in seconds for jobs without their own Timeout (0 = no limit)")
+	concurrency := fs.Int("concurrency", 1, "Number of concurrent workers")
 	fs.Parse(args)
 
-	startQueueWorker(*queue, *maxJobs, *timeout)
+	startQueueWorker(*queue, *strategy, *maxJobs, *timeout, *concurrency)
 }
 
 func handleQueueListen(args []string) {
@@ -305,6 +606,81 @@ func handleQueueRestart(args []string) {
 	restartQueueWorkers()
 }
 
+func handleQueueJobs(args []string) {
+	listQueueJobs()
+}
+
+func handleQueueMonitor(args []string) {
+	fs := flag.NewFlagSet("queue:monitor", flag.ExitOnError)
+	queue := fs.String("queue", "default", "The queue(s) to monitor, comma separated")
+	interval := fs.Int("interval", 0, "Refresh every N seconds (0 = show once)")
+	asJSON := fs.Bool("json", false, "Print stats as JSON")
+	fs.Parse(args)
+
+	monitorQueues(*queue, *interval, *asJSON)
+}
+
+func handleQueueFailed(args []string) {
+	listFailedJobs()
+}
+
+func handleQueueRetry(args []string) {
+	if len(args) < 1 {
+		fmt.Println("❌ Failed job id required")
+		fmt.Println("Usage: conduit queue:retry <id...|--all>")
+		os.Exit(1)
+	}
+
+	// --all, eski "all" yazımıyla aynıdır
+	if args[0] == "--all" {
+		args = []string{"all"}
+	}
+	retryFailedJobs(args)
+}
+
+func handleQueueForget(args []string) {
+	if len(args) < 1 {
+		fmt.Println("❌ Failed job id required")
+		fmt.Println("Usage: conduit queue:forget <id>")
+		os.Exit(1)
+	}
+
+	forgetFailedJob(args[0])
+}
+
+func handleQueueFlush(args []string) {
+	fs := flag.NewFlagSet("queue:flush", flag.ExitOnError)
+	force := fs.Bool("force", false, "Skip the confirmation prompt")
+	fs.Parse(args)
+
+	flushFailedJobs(*force)
+}
+
+// -----------------------------------------------------------------------------
+// Route Commands
+// -----------------------------------------------------------------------------
+
+func handleRouteList(args []string) {
+	fs := flag.NewFlagSet("route:list", flag.ExitOnError)
+	method := fs.String("method", "", "Only show routes with this HTTP method")
+	path := fs.String("path", "", "Only show routes whose path contains this value")
+	fs.Parse(args)
+
+	listRoutes(*method, *path)
+}
+
+// -----------------------------------------------------------------------------
+// Event Commands
+// -----------------------------------------------------------------------------
+
+func handleEventList(args []string) {
+	fs := flag.NewFlagSet("event:list", flag.ExitOnError)
+	event := fs.String("event", "", "Only show events whose name contains this value")
+	fs.Parse(args)
+
+	listEvents(*event)
+}
+
 // -----------------------------------------------------------------------------
 // Serve Command
 // -----------------------------------------------------------------------------
@@ -1,409 +1,132 @@
-// cmd/api/main.go
+// cmd/worker/main.go
 package main
 
 import (
 	"context"
-	"database/sql"
+	"flag"
 	"fmt"
 	"log"
-	"net/http"
-	"os"
-	"os/signal"
-	"reflect"
-	"strings"
-	"syscall"
 	"time"
 
 	"github.com/biyonik/conduit-go/internal/config"
-	"github.com/biyonik/conduit-go/internal/controllers"
-	"github.com/biyonik/conduit-go/internal/jobs"
-	"github.com/biyonik/conduit-go/internal/middleware"
-	"github.com/biyonik/conduit-go/internal/router"
-	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/internal/providers"
 	"github.com/biyonik/conduit-go/pkg/container"
-	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/foundation"
 	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/version"
 )
 
 // -----------------------------------------------------------------------------
-// Application Entry Point (ALL MEMORY LEAKS FIXED)
+// Queue Worker Entry Point
 // -----------------------------------------------------------------------------
-// FIXES:
-// ✅ Scanner cache cleanup goroutine gracefully durdurulabiliyor
-// ✅ File cache GC goroutine gracefully durdurulabiliyor
-// ✅ Rate limiter cleanup goroutine'leri gracefully durdurulabiliyor
-// ✅ Tüm goroutine'ler için context-based shutdown
+// API ile aynı service provider'ları kullanarak queue worker'ı başlatır; job'lar
+// API'dekiyle aynı config, cache, mailer, event dispatcher ve broadcaster'ı
+// görür. HTTP sunucusu ve route'lar başlatılmaz.
+//
+// Kullanım:
+//
+//	go run cmd/worker/main.go                               # default queue
+//	go run cmd/worker/main.go emails notifications          # öncelik sırasıyla
+//	go run cmd/worker/main.go -concurrency=4 critical:5,default:1
 // -----------------------------------------------------------------------------
 
 func main() {
+	concurrency := flag.Int("concurrency", 1, "Aynı anda işlenecek job sayısı")
+	timeout := flag.Int("timeout", 60, "Job başına timeout (saniye)")
+	maxJobs := flag.Int("max-jobs", 0, "Bu kadar job işledikten sonra çık (0 = limitsiz)")
+	flag.Parse()
+
 	// =========================================================================
 	// 1. DEPENDENCY INJECTION CONTAINER'I BAŞLAT
 	// =========================================================================
 	c := container.New()
 
-	// =========================================================================
-	// 2. SERVİSLERİ KONTEYNERE KAYDET
-	// =========================================================================
-
-	// Config servisi
-	c.Register(func(c *container.Container) (*config.Config, error) {
-		return config.Load(), nil
-	})
-
-	// Logger servisi
-	c.Register(func(c *container.Container) (*log.Logger, error) {
-		return log.New(os.Stdout, "[Conduit-Go] ", log.Ldate|log.Ltime|log.Lshortfile), nil
-	})
-
-	// Veritabanı Bağlantısı
-	c.Register(func(c *container.Container) (*sql.DB, error) {
-		cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
-		db, err := database.Connect(cfg.DB.DSN)
-		if err != nil {
-			return nil, err
-		}
-		return db, nil
-	})
-
-	// SQL Grammar
-	c.Register(func(c *container.Container) (database.Grammar, error) {
-		return database.NewMySQLGrammar(), nil
-	})
-
-	// =========================================================================
-	// 3. SCANNER CACHE SYSTEM INITIALIZATION (MEMORY LEAK FIX)
-	// =========================================================================
-	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
-
-	logger.Println("🔄 Scanner cache başlatılıyor...")
-	scanner := database.InitScanner(10*time.Minute, 30*time.Minute)
-	logger.Println("✅ Scanner cache başlatıldı (cleanup: 10m, max age: 30m)")
-
-	// Scanner'ı container'a kaydet (shutdown için gerekli)
-	c.Register(func(c *container.Container) (*database.Scanner, error) {
-		return scanner, nil
-	})
-
-	// =========================================================================
-	// 4. CACHE SYSTEM INITIALIZATION
-	// =========================================================================
-	cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
-
-	c.Register(func(c *container.Container) (cache.Cache, error) {
-		logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
-
-		switch cfg.Cache.Driver {
-		case "redis":
-			logger.Println("🔄 Redis cache başlatılıyor...")
-
-			redisConfig := &database.RedisConfig{
-				Host:         cfg.Redis.Host,
-				Port:         cfg.Redis.Port,
-				Password:     cfg.Redis.Password,
-				DB:           cfg.Redis.DB,
-				PoolSize:     10,
-				MinIdleConns: 2,
-				MaxRetries:   3,
-				DialTimeout:  5 * time.Second,
-				ReadTimeout:  3 * time.Second,
-				WriteTimeout: 3 * time.Second,
-			}
-
-			redisClient, err := database.NewRedisClient(redisConfig, logger)
-			if err != nil {
-				logger.Printf("⚠️  Redis bağlantısı başarısız, file cache'e geçiliyor: %v", err)
-				return cache.NewFileCache(cfg.Cache.FileDir, logger)
-			}
-
-			c.Register(func(c *container.Container) (*database.RedisClient, error) {
-				return redisClient, nil
-			})
-
-			logger.Printf("✅ Redis cache başlatıldı (prefix: %s)", cfg.Cache.Prefix)
-			return cache.NewRedisCache(redisClient.Client(), logger, cfg.Cache.Prefix), nil
-
-		case "file":
-			logger.Println("🔄 File cache başlatılıyor...")
-			fileCache, err := cache.NewFileCache(cfg.Cache.FileDir, logger)
-			if err != nil {
-				return nil, fmt.Errorf("file cache oluşturulamadı: %w", err)
-			}
-
-			// File cache'i container'a kaydet (shutdown için gerekli)
-			c.Register(func(c *container.Container) (*cache.FileCache, error) {
-				return fileCache, nil
-			})
-
-			logger.Printf("✅ File cache başlatıldı (dir: %s)", cfg.Cache.FileDir)
-			return fileCache, nil
-
-		case "memory":
-			logger.Println("🔄 Memory cache başlatılıyor...")
-			if cfg.IsProduction() {
-				logger.Println("⚠️  UYARI: Memory cache production ortamı için önerilmez!")
-			}
-			logger.Println("✅ Memory cache başlatıldı")
-			return cache.NewMemoryCache(logger), nil
-
-		default:
-			return nil, fmt.Errorf("geçersiz cache driver: %s", cfg.Cache.Driver)
-		}
-	})
-
-	c.Register(func(c *container.Container) (queue.Queue, error) {
-		logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
-
-		switch cfg.Queue.Driver {
-		case "redis":
-			logger.Println("🔄 Redis queue başlatılıyor...")
-
-			redisClient, err := c.Get(reflect.TypeOf((*database.RedisClient)(nil)))
-			if err != nil {
-				logger.Printf("⚠️  Redis bağlantısı yok, sync queue'e geçiliyor")
-				return queue.NewSyncQueue(logger), nil
-			}
-
-			rc := redisClient.(*database.RedisClient)
-			logger.Printf("✅ Redis queue başlatıldı (prefix: %s)", cfg.Cache.Prefix)
-			return queue.NewRedisQueue(rc.Client(), logger, cfg.Cache.Prefix), nil
-
-		case "sync":
-			logger.Println("✅ Sync queue başlatıldı (immediate execution)")
-			return queue.NewSyncQueue(logger), nil
-
-		default:
-			return nil, fmt.Errorf("geçersiz queue driver: %s", cfg.Queue.Driver)
-		}
-	})
-
-	// Controller'lar
-	c.Register(controllers.NewAppController)
-	c.Register(controllers.NewAuthController)
-	c.Register(controllers.NewPasswordController)
-
-	// =========================================================================
-	// 5. GEREKLI SERVİSLERİ RESOLVE ET
-	// =========================================================================
-	cacheDriver := c.MustGet(reflect.TypeOf((*cache.Cache)(nil)).Elem()).(cache.Cache)
-
-	logger.Println("📋 Registering job types...")
-
-	queue.RegisterJob("*jobs.SendEmailJob", func() queue.Job {
-		return &jobs.SendEmailJob{}
-	})
-	queue.RegisterJob("*jobs.ProcessUploadJob", func() queue.Job {
-		return &jobs.ProcessUploadJob{}
+	appConfig, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	container.Register(c, func(c *container.Container) (*config.Config, error) {
+		return appConfig, nil
 	})
 
-	logger.Println("✅ Job types registered")
-
-	appController := c.MustGet(reflect.TypeOf((*controllers.AppController)(nil))).(*controllers.AppController)
-	authController := c.MustGet(reflect.TypeOf((*controllers.AuthController)(nil))).(*controllers.AuthController)
-	passwordController := c.MustGet(reflect.TypeOf((*controllers.PasswordController)(nil))).(*controllers.PasswordController)
-
-	// =========================================================================
-	// 6. CACHE DEMO (Opsiyonel)
 	// =========================================================================
-	if cfg.IsDevelopment() {
-		logger.Println("\n📝 Cache System Demo:")
-
-		err := cacheDriver.Set("app:version", "1.0.0-phase3-fixed", 10*time.Minute)
-		if err != nil {
-			logger.Printf("⚠️  Cache set hatası: %v", err)
-		} else {
-			logger.Println("✅ Cache set: app:version = 1.0.0-phase3-fixed")
-		}
-
-		version, err := cacheDriver.Get("app:version")
-		if err != nil {
-			logger.Printf("⚠️  Cache get hatası: %v", err)
-		} else if version != nil {
-			logger.Printf("✅ Cache get: app:version = %v", version)
-		}
-
-		startTime := time.Now()
-		data, err := cacheDriver.Remember("demo:expensive", 5*time.Minute, func() (interface{}, error) {
-			logger.Println("   🔄 Expensive operation simulating...")
-			time.Sleep(100 * time.Millisecond)
-			return map[string]string{"result": "computed"}, nil
-		})
-		elapsed := time.Since(startTime)
-		if err != nil {
-			logger.Printf("⚠️  Remember hatası: %v", err)
-		} else {
-			logger.Printf("✅ Remember: %v (took: %v)", data, elapsed)
-		}
-
-		startTime = time.Now()
-		data2, _ := cacheDriver.Remember("demo:expensive", 5*time.Minute, func() (interface{}, error) {
-			logger.Println("   ❌ Bu mesaj görünmemeli!")
-			return nil, nil
-		})
-		elapsed2 := time.Since(startTime)
-		logger.Printf("✅ Remember (cached): %v (took: %v)\n", data2, elapsed2)
+	// 2. SERVICE PROVIDER'LAR
+	// =========================================================================
+	// RouteServiceProvider hariç API ile aynı provider'lar. Bkz: internal/providers
+	app := foundation.New(c,
+		providers.AppServiceProvider{},
+		providers.CacheServiceProvider{},
+		providers.QueueServiceProvider{},
+		providers.MailServiceProvider{},
+		providers.AuthServiceProvider{},
+		providers.DispatcherServiceProvider{},
+		&providers.BroadcastServiceProvider{},
+	)
+	if err := app.Boot(); err != nil {
+		log.Fatalf("❌ %v", err)
 	}
 
-	// =========================================================================
-	// 7. ROUTER'I OLUŞTUR VE MIDDLEWARE'LERI KAYDET
-	// =========================================================================
-	r := router.New()
-
-	r.Use(middleware.PanicRecovery(logger))
-	r.Use(middleware.Logging)
-	r.Use(middleware.CORSMiddleware("*"))
-	r.Use(middleware.RateLimit(100, 60))
+	logger := container.GetLogger(c)
+	logger.Printf("🚀 Conduit-Go Worker %s", version.String())
 
 	// =========================================================================
-	// 8. ROTALARI TANIMLA
+	// 3. WORKER'I YAPILANDIR
 	// =========================================================================
-	r.GET("/", appController.HomeHandler)
-	r.GET("/health", appController.HealthHandler)
-
-	authGroup := r.Group("/api/auth")
-	authGroup.Use(middleware.CSRFProtection())
-	authGroup.Use(middleware.RateLimit(10, 60))
-
-	authGroup.POST("/register", authController.Register)
-	authGroup.POST("/login", authController.Login)
-	authGroup.POST("/refresh", authController.RefreshToken)
-	authGroup.POST("/forgot-password", passwordController.ForgotPassword)
-	authGroup.POST("/reset-password", passwordController.ResetPassword)
-
-	r.POST("/api/auth/logout", authController.Logout).
-		Middleware(middleware.Auth())
-
-	r.GET("/api/auth/profile", authController.Profile).
-		Middleware(middleware.Auth())
-
-	r.PUT("/api/auth/profile", authController.UpdateProfile).
-		Middleware(middleware.Auth()).
-		Middleware(middleware.CSRFProtection())
+	q := container.GetQueue(c)
+	worker := queue.NewWorker(q, logger).
+		SetConcurrency(*concurrency).
+		SetTimeout(time.Duration(*timeout) * time.Second).
+		SetMaxJobs(*maxJobs).
+		SetRestartSignal(container.GetCache(c))
 
-	r.PUT("/api/auth/password", authController.ChangePassword).
-		Middleware(middleware.Auth()).
-		Middleware(middleware.CSRFProtection())
-
-	apiV1 := r.Group("/api/v1")
-	apiV1.Use(middleware.Auth())
-	apiV1.Use(middleware.RateLimit(50, 60))
-
-	apiV1.GET("/check", appController.CheckHandler)
-	apiV1.GET("/testquery", appController.TestQueryHandler)
-
-	adminGroup := r.Group("/api/admin")
-	adminGroup.Use(middleware.Auth())
-	adminGroup.Use(middleware.Admin())
-	adminGroup.Use(middleware.RateLimit(30, 60))
+	if provider, err := container.Resolve[queue.FailedJobProvider](c); err != nil {
+		logger.Printf("⚠️  Failed jobs will not be persisted: %v", err)
+	} else {
+		worker.SetFailedJobProvider(provider)
+	}
 
-	// =========================================================================
-	// 9. HTTP SUNUCUSUNU YAPILANDIR
-	// =========================================================================
-	srv := &http.Server{
-		Addr:           ":" + cfg.Server.Port,
-		Handler:        r,
-		ReadTimeout:    15 * time.Second,
-		WriteTimeout:   15 * time.Second,
-		IdleTimeout:    60 * time.Second,
-		MaxHeaderBytes: 1 << 20,
+	if sink, err := deadLetterSink(appConfig, q); err != nil {
+		logger.Printf("⚠️  Dead letter disabled: %v", err)
+	} else if sink != nil {
+		worker.SetDeadLetterSink(sink)
 	}
 
 	// =========================================================================
-	// 10. SUNUCUYU GOROUTINE'DE BAŞLAT
+	// 4. WORKER'I ÇALIŞTIR
 	// =========================================================================
-	go func() {
-		logger.Println("\n" + strings.Repeat("=", 70))
-		logger.Printf("🚀 Conduit-Go Framework v1.0.0 (Phase 3 - ALL LEAKS FIXED)")
-		logger.Println(strings.Repeat("=", 70))
-		logger.Printf("📍 Server: http://localhost:%s", cfg.Server.Port)
-		logger.Printf("🌐 Environment: %s", cfg.App.Env)
-		logger.Printf("💾 Cache Driver: %s", cfg.Cache.Driver)
-		if cfg.Cache.Driver == "redis" {
-			logger.Printf("🔗 Redis: %s:%d (DB: %d)", cfg.Redis.Host, cfg.Redis.Port, cfg.Redis.DB)
-		}
-		logger.Println(strings.Repeat("-", 70))
-		logger.Println("🔒 Security Features:")
-		logger.Println("   ✅ Scanner cache cleanup (graceful shutdown)")
-		logger.Println("   ✅ File cache GC (graceful shutdown)")
-		logger.Println("   ✅ Rate limiter cleanup (graceful shutdown)")
-		logger.Println("   ✅ No memory leaks")
-		logger.Println("   ✅ No panic risks")
-		logger.Println("   ✅ Race condition fixed")
-		logger.Println(strings.Repeat("=", 70))
-
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			logger.Fatalf("❌ Sunucu başlatılamadı: %v", err)
-		}
-	}()
+	// Blocking; SIGINT/SIGTERM ile tüm goroutine'ler mevcut job'u bitirip durur
+	worker.Work(flag.Args()...)
 
 	// =========================================================================
-	// 11. GRACEFUL SHUTDOWN (MEMORY LEAK FIX)
+	// 5. GRACEFUL SHUTDOWN
 	// =========================================================================
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
-	<-quit
-
-	logger.Println("\n🛑 Kapanma sinyali alındı, graceful shutdown başlatılıyor...")
-
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
-	// 1. HTTP sunucusu kapat
-	logger.Println("⏳ HTTP sunucusu kapatılıyor...")
-	if err := srv.Shutdown(shutdownCtx); err != nil {
-		logger.Printf("⚠️  HTTP sunucusu zorla kapatıldı: %v", err)
-	} else {
-		logger.Println("✅ HTTP sunucusu gracefully kapatıldı")
+	// Async event'ler, SMTP, Redis ve veritabanı bağlantıları provider'lar
+	// tarafından ters sırayla kapatılır
+	if err := app.Terminate(shutdownCtx); err != nil {
+		logger.Printf("⚠️  %v", err)
 	}
 
-	// 2. Rate limiter'ları durdur (MEMORY LEAK FIX)
-	logger.Println("⏳ Rate limiter cleanup goroutine'leri durduruluyor...")
-	middleware.StopAllLimiters()
-	logger.Println("✅ Rate limiter'lar durduruldu")
-
-	// 3. Scanner cache cleanup'ı durdur (MEMORY LEAK FIX)
-	logger.Println("⏳ Scanner cache cleanup goroutine'i durduruluyor...")
-	scanner.Stop()
-	logger.Println("✅ Scanner cache cleanup durduruldu")
-
-	// 4. File cache GC'yi durdur (MEMORY LEAK FIX)
-	if cfg.Cache.Driver == "file" {
-		logger.Println("⏳ File cache GC goroutine'i durduruluyor...")
-		if fileCache, err := c.Get(reflect.TypeOf((*cache.FileCache)(nil))); err == nil {
-			if fc, ok := fileCache.(*cache.FileCache); ok {
-				fc.Stop()
-				logger.Println("✅ File cache GC durduruldu")
-			}
-		}
-	}
+	logger.Println("👋 Worker temiz bir şekilde kapatıldı")
+}
 
-	// 5. Redis client kapat (varsa)
-	if cfg.Cache.Driver == "redis" {
-		logger.Println("⏳ Redis bağlantısı kapatılıyor...")
-		if redisClient, _ := c.Get(reflect.TypeOf((*database.RedisClient)(nil))); redisClient != nil {
-			if rc, ok := redisClient.(*database.RedisClient); ok {
-				if err := rc.Close(); err != nil {
-					logger.Printf("⚠️  Redis kapatılamadı: %v", err)
-				} else {
-					logger.Println("✅ Redis bağlantısı kapatıldı")
-				}
-			}
+// deadLetterSink, QUEUE_DEAD_LETTER_DRIVER'a göre dead letter hedefini
+// oluşturur (kapalıysa nil döner).
+func deadLetterSink(cfg *config.Config, q queue.Queue) (queue.DeadLetterSink, error) {
+	switch cfg.Queue.DeadLetterDriver {
+	case "":
+		return nil, nil
+	case "queue":
+		return queue.NewQueueDeadLetterSink(q, cfg.Queue.DeadLetter), nil
+	case "stream":
+		redisQueue, ok := q.(*queue.RedisQueue)
+		if !ok {
+			return nil, fmt.Errorf("stream dead letter için QUEUE_DRIVER=redis gerekli")
 		}
+		return queue.NewRedisDeadLetterSink(redisQueue.Client(), cfg.Cache.Prefix, cfg.Queue.DeadLetter, int64(cfg.Queue.DeadLetterMaxLen)), nil
+	default:
+		return nil, fmt.Errorf("geçersiz dead letter driver: %s", cfg.Queue.DeadLetterDriver)
 	}
-
-	// 6. Database bağlantıları kapat
-	logger.Println("⏳ Database bağlantıları kapatılıyor...")
-	db := c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
-	if err := db.Close(); err != nil {
-		logger.Printf("⚠️  Database kapatılamadı: %v", err)
-	} else {
-		logger.Println("✅ Database bağlantıları kapatıldı")
-	}
-
-	logger.Println("\n" + strings.Repeat("=", 70))
-	logger.Println("👋 Uygulama temiz bir şekilde kapatıldı.")
-	logger.Println("   ✅ Tüm goroutine'ler gracefully durduruldu")
-	logger.Println("   ✅ Hiçbir memory leak yok")
-	logger.Println("   ✅ Tüm bağlantılar kapatıldı")
-	logger.Println(strings.Repeat("=", 70))
-	logger.Println("Hoşça kal! 🚀")
 }
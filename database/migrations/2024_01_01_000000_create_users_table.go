@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"github.com/biyonik/conduit-go/pkg/database/migration"
+)
+
+func init() {
+	migration.Register("2024_01_01_000000_create_users_table", &CreateUsersTable{})
+}
+
+// CreateUsersTable migration
+type CreateUsersTable struct{}
+
+// Up runs the migration.
+func (m *CreateUsersTable) Up(migrator *migration.Migrator) error {
+	return migrator.CreateTable("users", func(t *migration.Blueprint) {
+		t.ID()
+		t.String("name", 255)
+		t.String("email", 255).Unique()
+		t.String("password", 255)
+		t.String("status", 20).Default("active")
+		t.Timestamp("email_verified_at").Nullable()
+		t.String("remember_token", 100).Nullable()
+		t.Timestamps()
+		t.SoftDeletes()
+		t.Index("status")
+	})
+}
+
+// Down reverses the migration.
+func (m *CreateUsersTable) Down(migrator *migration.Migrator) error {
+	return migrator.DropTable("users")
+}
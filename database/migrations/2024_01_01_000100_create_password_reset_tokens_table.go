@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/biyonik/conduit-go/pkg/database/migration"
+)
+
+func init() {
+	migration.Register("2024_01_01_000100_create_password_reset_tokens_table", &CreatePasswordResetTokensTable{})
+}
+
+// CreatePasswordResetTokensTable migration
+type CreatePasswordResetTokensTable struct{}
+
+// Up runs the migration.
+func (m *CreatePasswordResetTokensTable) Up(migrator *migration.Migrator) error {
+	return migrator.CreateTable("password_reset_tokens", func(t *migration.Blueprint) {
+		t.ID()
+		t.String("email", 255)
+		t.String("token", 64) // SHA-256 hex
+		t.Timestamp("created_at").Nullable()
+		t.Index("email")
+	})
+}
+
+// Down reverses the migration.
+func (m *CreatePasswordResetTokensTable) Down(migrator *migration.Migrator) error {
+	return migrator.DropTable("password_reset_tokens")
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/biyonik/conduit-go/pkg/database/migration"
+)
+
+func init() {
+	migration.Register("2024_01_01_000200_add_expires_at_to_password_reset_tokens_table", &AddExpiresAtToPasswordResetTokensTable{})
+}
+
+// AddExpiresAtToPasswordResetTokensTable migration
+//
+// Davet token'ları şifre sıfırlama token'larından daha uzun geçerlidir;
+// süre token ile birlikte saklanır. Eski kayıtlarda (NULL) created_at'ten
+// itibaren 1 saat kullanılır.
+type AddExpiresAtToPasswordResetTokensTable struct{}
+
+// Up runs the migration.
+func (m *AddExpiresAtToPasswordResetTokensTable) Up(migrator *migration.Migrator) error {
+	return migrator.AlterTable("password_reset_tokens", func(t *migration.Blueprint) {
+		t.Timestamp("expires_at").Nullable()
+	})
+}
+
+// Down reverses the migration.
+func (m *AddExpiresAtToPasswordResetTokensTable) Down(migrator *migration.Migrator) error {
+	return migrator.AlterTable("password_reset_tokens", func(t *migration.Blueprint) {
+		t.DropColumn("expires_at")
+	})
+}
@@ -0,0 +1,11 @@
+// -----------------------------------------------------------------------------
+// Database Migrations
+// -----------------------------------------------------------------------------
+// Uygulamanın şema migration'ları. Her dosya init fonksiyonunda
+// migration.Register ile kendini kaydeder; conduit CLI bu paketi import
+// ettiği için `conduit migrate` tüm migration'ları görür.
+//
+// Yeni migration: conduit make:migration create_posts_table
+// -----------------------------------------------------------------------------
+
+package migrations
@@ -0,0 +1,21 @@
+package seeders
+
+import (
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database/seeder"
+)
+
+func init() {
+	seeder.Register(&DatabaseSeeder{})
+}
+
+// DatabaseSeeder, `conduit db:seed`'in varsayılan seeder'ıdır. Diğer
+// seeder'lar burada çalışma sırasıyla çağrılır.
+type DatabaseSeeder struct{}
+
+// Run seeds the database.
+func (s *DatabaseSeeder) Run(c *container.Container) error {
+	return seeder.Call(c, []seeder.Seeder{
+		// &UserSeeder{},
+	}...)
+}
@@ -0,0 +1,13 @@
+// -----------------------------------------------------------------------------
+// Database Seeders
+// -----------------------------------------------------------------------------
+// Uygulamanın seeder'ları. Her seeder init fonksiyonunda seeder.Register ile
+// kendini kaydeder; conduit CLI bu paketi import eder.
+//
+//	conduit db:seed                    → DatabaseSeeder
+//	conduit db:seed --class=UserSeeder → Sadece UserSeeder
+//
+// Yeni seeder: conduit make:seeder UserSeeder
+// -----------------------------------------------------------------------------
+
+package seeders
@@ -0,0 +1,144 @@
+// -----------------------------------------------------------------------------
+// AWS Signature Version 4
+// -----------------------------------------------------------------------------
+// AWS SDK bağımlılığı olmadan AWS API'lerini çağıran driver'ların (SQS queue,
+// SES mail, Secrets Manager) ortak istek imzalayıcısı.
+//
+// Kullanım:
+//
+//	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+//	awssig.Sign(req, body, awssig.Credentials{
+//	    AccessKeyID:     cfg.AccessKeyID,
+//	    SecretAccessKey: cfg.SecretAccessKey,
+//	}, cfg.Region, "sqs", time.Now())
+//
+// Host, Content-Type ve X-Amz-* header'ları, path, query string ve body
+// hash'i imzalanır. İmzalanacak header'lar Sign'dan önce ayarlanmalıdır.
+// -----------------------------------------------------------------------------
+
+package awssig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Credentials, istekleri imzalamak için kullanılan AWS kimlik bilgileridir.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // Geçici credential'lar için (opsiyonel)
+}
+
+// Sign, isteği AWS Signature Version 4 ile imzalar; X-Amz-Date,
+// X-Amz-Security-Token (varsa) ve Authorization header'larını ayarlar.
+//
+// Parametreler:
+//   - req: İmzalanacak istek
+//   - body: İstek gövdesi (req.Body okunmaz)
+//   - creds: AWS kimlik bilgileri
+//   - region: AWS region (örn: eu-central-1)
+//   - service: İmza scope'undaki servis adı (örn: "sqs", "ses")
+//   - now: İmza zamanı
+func Sign(req *http.Request, body []byte, creds Credentials, region, service string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	// Canonical header'lar (küçük harf, sıralı)
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-type" || strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		sha256Hex(body),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+// canonicalQuery, query parametrelerini SigV4 biçiminde sıralar.
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		vals := append([]string(nil), values[key]...)
+		sort.Strings(vals)
+		for _, val := range vals {
+			parts = append(parts, url.QueryEscape(key)+"="+url.QueryEscape(val))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// sha256Hex, verinin SHA-256 hash'ini hex olarak döndürür.
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hmacSHA256, HMAC-SHA256 hesaplar.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
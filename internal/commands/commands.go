@@ -0,0 +1,13 @@
+// -----------------------------------------------------------------------------
+// Console Commands
+// -----------------------------------------------------------------------------
+// Uygulamanın CLI komutları. Her komut init fonksiyonunda command.Register ile
+// kendini kaydeder; conduit CLI bu paketi import eder ve komutları help'te
+// APPLICATION COMMANDS altında listeler.
+//
+//	conduit app:sync-exchange-rates --force
+//
+// Yeni komut: conduit make:command SyncExchangeRates
+// -----------------------------------------------------------------------------
+
+package commands
@@ -0,0 +1,40 @@
+// -----------------------------------------------------------------------------
+// Broadcast Configuration
+// -----------------------------------------------------------------------------
+// ShouldBroadcast event'lerinin yayın ayarları:
+//
+//	BROADCAST_DRIVER=local               # redis, local (tek instance), log veya null
+//	BROADCAST_REDIS_PREFIX=conduit:broadcast: # Redis pub/sub kanal prefix'i
+//	BROADCAST_BUFFER=64                  # Client başına bekleyebilecek mesaj sayısı
+//	BROADCAST_HEARTBEAT=25s              # SSE/WebSocket bağlantısını açık tutan ping aralığı
+//
+// redis driver'ında her instance prefix'e abone olur; hangi instance
+// yayınlarsa yayınlasın tüm bağlı client'lar mesajı alır.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/broadcast"
+)
+
+// BroadcastConfig, event broadcasting ayarlarıdır.
+type BroadcastConfig struct {
+	Driver      string        // redis, local, log, null
+	RedisPrefix string        // Redis kanal prefix'i
+	Buffer      int           // Abonelik başına mesaj buffer'ı
+	Heartbeat   time.Duration // SSE/WebSocket ping aralığı
+}
+
+// loadBroadcast, broadcast ayarlarını ortam değişkenlerinden okur.
+func loadBroadcast() BroadcastConfig {
+	return BroadcastConfig{
+		Driver:      strings.ToLower(storeEnv("BROADCAST_DRIVER", "local")),
+		RedisPrefix: storeEnv("BROADCAST_REDIS_PREFIX", "conduit:broadcast:"),
+		Buffer:      policyInt("BROADCAST_BUFFER", broadcast.DefaultSubscriptionBuffer),
+		Heartbeat:   policyDuration("BROADCAST_HEARTBEAT", 25*time.Second),
+	}
+}
@@ -0,0 +1,64 @@
+// -----------------------------------------------------------------------------
+// Named Cache Store Configuration
+// -----------------------------------------------------------------------------
+// Varsayılan cache dışında, amaca özel store'lar tanımlanabilir. Her store'un
+// driver'ı, key prefix'i ve varsayılan TTL'i ayrı ayarlanır:
+//
+//	CACHE_STORES=sessions,responses
+//	CACHE_STORE_SESSIONS_DRIVER=redis
+//	CACHE_STORE_SESSIONS_PREFIX=conduit:sessions:
+//	CACHE_STORE_SESSIONS_TTL=2h
+//	CACHE_STORE_RESPONSES_DRIVER=memory
+//	CACHE_STORE_RESPONSES_TTL=30s
+//
+// Tanımsız değerler varsayılan cache ayarlarından türetilir: driver
+// CACHE_DRIVER, prefix CACHE_PREFIX + "<isim>:", file dizini
+// CACHE_FILE_DIR/<isim>.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CacheStoreConfig, isimlendirilmiş tek bir cache store'unun ayarlarıdır.
+type CacheStoreConfig struct {
+	Driver  string        // redis, file, memory
+	Prefix  string        // Redis key prefix'i
+	FileDir string        // File driver dizini
+	TTL     time.Duration // Varsayılan TTL (0 = yok)
+}
+
+// loadCacheStores, CACHE_STORES ile tanımlanan store'ları ortam
+// değişkenlerinden okur.
+//
+// Geçersiz süreler uyarı loglanarak varsayılana (TTL yok) düşer; geçersiz
+// driver'lar Validate tarafından reddedilir.
+func loadCacheStores(cfg *Config) map[string]CacheStoreConfig {
+	stores := make(map[string]CacheStoreConfig)
+
+	for _, name := range splitAndTrim(getenv("CACHE_STORES")) {
+		name = strings.ToLower(name)
+		prefix := "CACHE_STORE_" + strings.ToUpper(name) + "_"
+
+		stores[name] = CacheStoreConfig{
+			Driver:  strings.ToLower(storeEnv(prefix+"DRIVER", cfg.Cache.Driver)),
+			Prefix:  storeEnv(prefix+"PREFIX", cfg.Cache.Prefix+name+":"),
+			FileDir: storeEnv(prefix+"FILE_DIR", filepath.Join(cfg.Cache.FileDir, name)),
+			TTL:     policyDuration(prefix+"TTL", 0),
+		}
+	}
+
+	return stores
+}
+
+// storeEnv, tanımlıysa değişkeni, değilse varsayılanı döndürür (sessiz).
+func storeEnv(key, defaultValue string) string {
+	if value := getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
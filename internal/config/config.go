@@ -8,7 +8,9 @@
 //
 // Config yapısı, uygulamanın tüm kritik parametrelerini tip güvenli bir şekilde
 // taşır ve varsayılan değerler ile birlikte çalışır. Eksik ortam değişkenleri
-// olduğunda log üzerinden uyarı verir ve default değerleri kullanır.
+// olduğunda log üzerinden uyarı verir ve default değerleri kullanır; ortam
+// için zorunlu değişkenler ve okunamayan değerler Validate'te hata olur
+// (bkz: validate.go).
 //
 // Phase 2: JWT, Authentication yapılandırması eklendi
 // Phase 3: Redis, Cache, Queue, Mail yapılandırması eklendi
@@ -17,11 +19,16 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/biyonik/conduit-go/pkg/crypt"
 )
 
 // Config, uygulamanın merkezi yapılandırma nesnesidir.
@@ -31,19 +38,41 @@ import (
 //   - Server: Sunucu ayarları
 //   - DB: Veritabanı ayarları
 //   - JWT: Authentication token ayarları (Phase 2)
+//   - MagicLink: Şifresiz giriş linki ayarları
+//   - PasswordReset: Şifre sıfırlama ve davet linki ayarları
+//   - OIDC: OpenID Connect (SSO) giriş ayarları
 //   - Redis: Redis bağlantı ayarları (Phase 3)
 //   - Cache: Cache sistem ayarları (Phase 3)
 //   - RateLimit: Rate limiting ayarları
 //   - Mail: Mail gönderim ayarları (Phase 3)
+//   - Security: Ortama duyarlı cookie/HSTS/CORS varsayılanları
+//   - Policies: Dış servis çağrıları için timeout/retry/circuit policy'leri
+//   - CacheStores: İsimlendirilmiş cache store'ları (sessions, responses, ...)
+//   - SQS: Amazon SQS queue driver ayarları
+//   - NATS: NATS JetStream queue driver ayarları
+//   - Broadcast: ShouldBroadcast event'lerinin yayın ayarları
+//   - EventStore: Event kaydı ve replay ayarları
+//   - View: HTML view (server-rendered sayfa) ayarları
 type Config struct {
 	App struct {
 		Name string // Uygulama adı
 		Env  string // Ortam (development, production, test)
 		URL  string // Uygulama URL'si
+		Key  string // Şifreleme anahtarı (APP_KEY, conduit key:generate ile üretilir)
+
+		ExposeVersion bool // Response'lara X-App-Version header'ı eklensin mi
+
+		// Hata yanıtı formatı (response.Error): problem, legacy
+		ErrorFormat string
+
+		// Doğrulama hata yanıtı formatı: problem, default, flat, jsonapi
+		ValidationErrorFormat string
 	}
 
 	Server struct {
 		Port string // Sunucunun çalışacağı port
+
+		UploadMaxMemoryMB int // Multipart upload'larda bellekte tutulacak boyut (MB); aşan kısım geçici dosyaya yazılır
 	}
 
 	DB struct {
@@ -53,12 +82,17 @@ type Config struct {
 		ConnMaxLifetime time.Duration // Bağlantı maksimum ömrü
 	}
 
-	// Phase 2: JWT Authentication
-	JWT struct {
-		Secret            string        // JWT secret key
-		Expiration        time.Duration // Access token süresi
-		RefreshExpiration time.Duration // Refresh token süresi
-	}
+	// Phase 2: JWT Authentication. Bkz: jwt.go
+	JWT JWTConfig
+
+	// Şifresiz (magic link) giriş ayarları. Bkz: magic_link.go
+	MagicLink MagicLinkConfig
+
+	// Şifre sıfırlama ve davet linki ayarları. Bkz: password_reset.go
+	PasswordReset PasswordResetConfig
+
+	// OpenID Connect (SSO) giriş ayarları. Bkz: oidc.go
+	OIDC OIDCConfig
 
 	// Phase 3: Redis Configuration
 	Redis struct {
@@ -73,6 +107,11 @@ type Config struct {
 		Driver  string // Cache driver: redis, file, memory
 		Prefix  string // Cache key prefix (namespace)
 		FileDir string // File cache dizini (file driver için)
+
+		Serializer string // Değer serializer'ı: json (varsayılan), gob, msgpack
+
+		MemoryMaxEntries int // Memory driver: maksimum entry sayısı (0 = limitsiz)
+		MemoryMaxMB      int // Memory driver: maksimum tahmini boyut MB (0 = limitsiz)
 	}
 
 	// Rate Limiting
@@ -83,25 +122,76 @@ type Config struct {
 	}
 
 	// Phase 3: Mail Configuration
-	Mail struct {
-		Driver      string // Mail driver: smtp, sendmail
-		Host        string // SMTP host
-		Port        int    // SMTP port
-		FromAddress string // Gönderici email adresi
+	Mail MailConfig
+
+	// Security Defaults (environment-aware)
+	// Production'da sıkı, development'ta esnek varsayılanlar kullanılır.
+	// Her değer ilgili ortam değişkeniyle açıkça override edilebilir.
+	Security struct {
+		CookieSecure          bool     // Cookie'ler sadece HTTPS üzerinden mi gönderilsin?
+		CookieSameSite        string   // SameSite politikası: strict, lax, none
+		HSTSEnabled           bool     // Strict-Transport-Security header'ı gönderilsin mi?
+		HSTSMaxAge            int      // HSTS max-age (saniye)
+		HSTSIncludeSubdomains bool     // HSTS includeSubDomains direktifi
+		CORSAllowedOrigins    []string // İzin verilen origin'ler ("*" = hepsi)
+		CORSAllowCredentials  bool     // Access-Control-Allow-Credentials
+		CORSAdminOrigins      []string // Admin API (dashboard) için izin verilen origin'ler
 	}
 
 	Queue struct {
-		Driver      string // Queue driver: redis, database, sync
+		Driver      string // Queue driver: redis, sqs, nats, sync
 		Default     string // Default queue name
 		RetryAfter  int    // Retry after seconds
 		MaxAttempts int    // Maximum attempts
+
+		DeadLetterDriver string // Dead letter hedefi: "" (kapalı), stream, queue
+		DeadLetter       string // Dead letter stream/kuyruk adı
+		DeadLetterMaxLen int    // Stream'de tutulacak yaklaşık kayıt sayısı (0 = limitsiz)
 	} `json:"queue"`
+
+	// Outbound Policies: dış servis çağrıları için timeout/retry/circuit
+	// ayarları (isim -> ayarlar). Bkz: policies.go
+	Policies map[string]PolicyConfig
+
+	// Named Cache Stores: varsayılan cache dışındaki store'lar
+	// (isim -> ayarlar). Bkz: cache_stores.go
+	CacheStores map[string]CacheStoreConfig
+
+	// Amazon SQS: QUEUE_DRIVER=sqs için bağlantı ayarları. Bkz: sqs.go
+	SQS SQSConfig
+
+	// NATS JetStream: QUEUE_DRIVER=nats için bağlantı ayarları. Bkz: nats.go
+	NATS NATSConfig
+
+	// Event Broadcasting: ShouldBroadcast event'leri. Bkz: broadcast.go
+	Broadcast BroadcastConfig
+
+	// Event Store: dispatch edilen event'lerin kaydı. Bkz: event_store.go
+	EventStore EventStoreConfig
+
+	// HTML view'lar: response.View ile render edilen sayfalar. Bkz: view.go
+	View ViewConfig
+
+	// config/*.yaml'dan okunan ham değerler (değişken adı -> değer).
+	// Struct'ta karşılığı olmayan key'ler config.Get ile buradan okunur.
+	// Bkz: get.go
+	Values map[string]string `json:"values,omitempty" config:"-"`
+
+	// LoadFromEnv'in bulduğu eksik zorunlu ve okunamayan değişkenler.
+	// Cache'e yazılmaz; cache yazılırken zaten doğrulanmıştır.
+	loadErrors []error
 }
 
-// Load, ortam değişkenlerini okuyarak Config nesnesini döndürür.
+// Load, Config nesnesini döndürür.
 //
-// Eksik değişkenlerde varsayılan değerleri kullanır ve log mesajı üretir.
-// Tüm ayarlar environment variable'lardan okunur (.env dosyası veya sistem).
+// `conduit config:cache` ile oluşturulmuş bir config cache'i varsa
+// (CONFIG_CACHE_PATH, varsayılan: bootstrap/cache/config.json) ortam
+// değişkenleri okunmaz ve cache'teki değerler kullanılır. Aksi halde
+// LoadFromEnv çağrılır.
+//
+// Doğrulama hataları sadece loglanır; uygulama başlangıcında hatalı config
+// ile çalışmamak için LoadConfig kullanılır. Yüklenen config, config.Get
+// gibi paket fonksiyonlarının varsayılanı olur (bkz: get.go).
 //
 // Döndürür:
 //   - *Config: Yapılandırma nesnesi
@@ -112,11 +202,72 @@ type Config struct {
 //	log.Printf("Environment: %s", cfg.App.Env)
 //	log.Printf("Cache Driver: %s", cfg.Cache.Driver)
 func Load() *Config {
-	cfg := &Config{}
+	cfg := load()
+	SetDefault(cfg)
+	if err := cfg.Validate(); err != nil {
+		log.Printf("❌ Config validation hatası: %v", err)
+	}
+	return cfg
+}
+
+// load, config'i cache'ten veya ortam değişkenlerinden doğrulamadan yükler.
+func load() *Config {
+	path := CachePath()
+	cfg, err := ReadCache(path)
+	if err == nil {
+		log.Printf("📦 Config cache kullanılıyor: %s", path)
+		return cfg
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("⚠️  Config cache okunamadı, ortam değişkenleri kullanılıyor: %v", err)
+	}
+
+	return loadFromEnv()
+}
+
+// LoadFromEnv, ortam değişkenlerini okuyarak Config nesnesini döndürür.
+//
+// Eksik değişkenlerde varsayılan değerleri kullanır ve log mesajı üretir.
+// Tüm ayarlar environment variable'lardan (.env dosyası veya sistem) ve
+// config/ dizinindeki YAML dosyalarından okunur; ortam değişkenleri
+// dosyalardaki değerleri ezer (bkz: files.go). secret:// ve vault://
+// referansları secret manager'dan okunur (bkz: secrets.go). Config cache'i
+// yok sayılır.
+func LoadFromEnv() *Config {
+	cfg := loadFromEnv()
+
+	// Validation
+	if err := cfg.Validate(); err != nil {
+		log.Printf("❌ Config validation hatası: %v", err)
+	}
+
+	return cfg
+}
+
+// loadFromEnv, LoadFromEnv'in doğrulama yapmayan gövdesidir.
+func loadFromEnv() *Config {
+	loadMu.Lock()
+	defer loadMu.Unlock()
+	envIssues = nil
+
+	// config/*.yaml değerleri ortamda tanımlı olmayan değişkenleri sağlar
+	values, err := LoadFiles(ConfigPath(), os.Getenv("APP_ENV"))
+	if err != nil {
+		log.Printf("❌ Config dosyaları okunamadı: %v", err)
+		envIssues = append(envIssues, err)
+	}
+	fileEnv = values
+	resolvedEnv = make(map[string]string)
+	defer func() { fileEnv, resolvedEnv = nil, nil }()
+
+	// secret://, vault:// referansları için yerleşik provider'lar
+	registerSecretResolvers()
+
+	cfg := &Config{Values: values}
 
 	// Helper function: Ortam değişkenini oku, yoksa default kullan
 	getEnv := func(key, defaultValue string) string {
-		if value, exists := os.LookupEnv(key); exists {
+		if value, exists := lookupEnv(key); exists {
 			return value
 		}
 		log.Printf("⚠️  Uyarı: %s ortam değişkeni bulunamadı, varsayılan (%s) kullanılıyor.", key, defaultValue)
@@ -125,7 +276,7 @@ func Load() *Config {
 
 	// Helper function: Integer ortam değişkeni
 	getEnvAsInt := func(key string, defaultValue int) int {
-		valueStr := os.Getenv(key)
+		valueStr := getenv(key)
 		if valueStr == "" {
 			log.Printf("⚠️  Uyarı: %s ortam değişkeni bulunamadı, varsayılan (%d) kullanılıyor.", key, defaultValue)
 			return defaultValue
@@ -134,6 +285,7 @@ func Load() *Config {
 		value, err := strconv.Atoi(valueStr)
 		if err != nil {
 			log.Printf("⚠️  Uyarı: %s için geçersiz değer: %s, varsayılan (%d) kullanılıyor.", key, valueStr, defaultValue)
+			invalidEnv(key, valueStr, "sayı")
 			return defaultValue
 		}
 
@@ -142,7 +294,7 @@ func Load() *Config {
 
 	// Helper function: Boolean ortam değişkeni
 	getEnvAsBool := func(key string, defaultValue bool) bool {
-		valueStr := os.Getenv(key)
+		valueStr := getenv(key)
 		if valueStr == "" {
 			return defaultValue
 		}
@@ -150,6 +302,7 @@ func Load() *Config {
 		value, err := strconv.ParseBool(valueStr)
 		if err != nil {
 			log.Printf("⚠️  Uyarı: %s için geçersiz boolean değer: %s, varsayılan (%t) kullanılıyor.", key, valueStr, defaultValue)
+			invalidEnv(key, valueStr, "boolean (true/false)")
 			return defaultValue
 		}
 
@@ -166,9 +319,15 @@ func Load() *Config {
 	cfg.App.Name = getEnv("APP_NAME", "Conduit-Go")
 	cfg.App.Env = getEnv("APP_ENV", "development")
 	cfg.App.URL = getEnv("APP_URL", "http://localhost:8000")
+	cfg.App.Key = getEnv("APP_KEY", "")
+	// Sürüm bilgisi production'da varsayılan olarak gizlenir
+	cfg.App.ExposeVersion = getEnvAsBool("APP_EXPOSE_VERSION", cfg.App.Env != "production")
+	cfg.App.ErrorFormat = getEnv("ERROR_FORMAT", "problem")
+	cfg.App.ValidationErrorFormat = getEnv("VALIDATION_ERROR_FORMAT", "problem")
 
 	// Server Configuration
 	cfg.Server.Port = getEnv("PORT", "8000")
+	cfg.Server.UploadMaxMemoryMB = getEnvAsInt("UPLOAD_MAX_MEMORY_MB", 32)
 
 	// Database Configuration
 	cfg.DB.DSN = getEnv("DB_DSN", "root:password@tcp(127.0.0.1:3306)/conduit_go?parseTime=true")
@@ -177,9 +336,10 @@ func Load() *Config {
 	cfg.DB.ConnMaxLifetime = getEnvAsDuration("DB_CONN_MAX_LIFETIME", 300) // 5 dakika
 
 	// JWT Configuration (Phase 2)
-	cfg.JWT.Secret = getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-this-in-production")
-	cfg.JWT.Expiration = getEnvAsDuration("JWT_EXPIRATION", 3600)                  // 1 saat
-	cfg.JWT.RefreshExpiration = getEnvAsDuration("JWT_REFRESH_EXPIRATION", 604800) // 7 gün
+	cfg.JWT = loadJWT()
+	cfg.MagicLink = loadMagicLink(cfg.App.URL)
+	cfg.PasswordReset = loadPasswordReset(cfg.App.URL)
+	cfg.OIDC = loadOIDC(cfg.App.URL)
 
 	// Redis Configuration (Phase 3)
 	cfg.Redis.Host = getEnv("REDIS_HOST", "127.0.0.1")
@@ -191,6 +351,9 @@ func Load() *Config {
 	cfg.Cache.Driver = getEnv("CACHE_DRIVER", "memory") // redis, file, memory
 	cfg.Cache.Prefix = getEnv("CACHE_PREFIX", "conduit:")
 	cfg.Cache.FileDir = getEnv("CACHE_FILE_DIR", "./storage/cache")
+	cfg.Cache.Serializer = strings.ToLower(getEnv("CACHE_SERIALIZER", "json")) // json, gob, msgpack
+	cfg.Cache.MemoryMaxEntries = getEnvAsInt("CACHE_MEMORY_MAX_ENTRIES", 0)
+	cfg.Cache.MemoryMaxMB = getEnvAsInt("CACHE_MEMORY_MAX_MB", 0)
 
 	// Rate Limiting Configuration
 	cfg.RateLimit.Enabled = getEnvAsBool("RATE_LIMIT_ENABLED", true)
@@ -198,46 +361,101 @@ func Load() *Config {
 	cfg.RateLimit.WindowSeconds = getEnvAsInt("RATE_LIMIT_WINDOW_SECONDS", 60)
 
 	// Mail Configuration (Phase 3)
-	cfg.Mail.Driver = getEnv("MAIL_DRIVER", "smtp")
-	cfg.Mail.Host = getEnv("MAIL_HOST", "localhost")
-	cfg.Mail.Port = getEnvAsInt("MAIL_PORT", 1025)
-	cfg.Mail.FromAddress = getEnv("MAIL_FROM_ADDRESS", "noreply@conduit-go.local")
-
-	cfg.Queue.Driver = getEnv("QUEUE_DRIVER", "redis") // redis, database, sync
+	cfg.Mail = loadMail(cfg.App.Name)
+
+	// Security Defaults
+	// Profil: production -> strict, diğer ortamlar -> relaxed
+	strict := cfg.App.Env == "production"
+	sameSiteDefault, corsOriginsDefault := "lax", "*"
+	if strict {
+		sameSiteDefault, corsOriginsDefault = "strict", cfg.App.URL
+	}
+	cfg.Security.CookieSecure = getEnvAsBool("SECURITY_COOKIE_SECURE", strict)
+	cfg.Security.CookieSameSite = strings.ToLower(getEnv("SECURITY_COOKIE_SAMESITE", sameSiteDefault))
+	cfg.Security.HSTSEnabled = getEnvAsBool("SECURITY_HSTS_ENABLED", strict)
+	cfg.Security.HSTSMaxAge = getEnvAsInt("SECURITY_HSTS_MAX_AGE", 31536000) // 1 yıl
+	cfg.Security.HSTSIncludeSubdomains = getEnvAsBool("SECURITY_HSTS_INCLUDE_SUBDOMAINS", strict)
+	cfg.Security.CORSAllowedOrigins = splitAndTrim(getEnv("CORS_ALLOWED_ORIGINS", corsOriginsDefault))
+	cfg.Security.CORSAllowCredentials = getEnvAsBool("CORS_ALLOW_CREDENTIALS", false)
+	cfg.Security.CORSAdminOrigins = splitAndTrim(getEnv("CORS_ADMIN_ALLOWED_ORIGINS", cfg.App.URL))
+
+	cfg.Queue.Driver = getEnv("QUEUE_DRIVER", "redis") // redis, sqs, nats, sync
 	cfg.Queue.Default = getEnv("QUEUE_DEFAULT", "default")
 	cfg.Queue.RetryAfter = getEnvAsInt("QUEUE_RETRY_AFTER", 90)
 	cfg.Queue.MaxAttempts = getEnvAsInt("QUEUE_MAX_ATTEMPTS", 3)
+	cfg.Queue.DeadLetterDriver = strings.ToLower(getEnv("QUEUE_DEAD_LETTER_DRIVER", ""))
+	cfg.Queue.DeadLetter = getEnv("QUEUE_DEAD_LETTER", "dead-letter")
+	cfg.Queue.DeadLetterMaxLen = getEnvAsInt("QUEUE_DEAD_LETTER_MAXLEN", 10000)
 
-	// Validation
-	if err := cfg.Validate(); err != nil {
-		log.Printf("❌ Config validation hatası: %v", err)
-	}
+	// Outbound Policies (mail, http, webhook + OUTBOUND_POLICIES)
+	cfg.Policies = loadPolicies()
+
+	// Named Cache Stores (CACHE_STORES)
+	cfg.CacheStores = loadCacheStores(cfg)
+
+	// Amazon SQS (QUEUE_DRIVER=sqs)
+	cfg.SQS = loadSQS()
+
+	// NATS JetStream (QUEUE_DRIVER=nats)
+	cfg.NATS = loadNATS()
+
+	// Event Broadcasting (BROADCAST_DRIVER)
+	cfg.Broadcast = loadBroadcast()
+
+	// Event Store (EVENT_STORE_ENABLED)
+	cfg.EventStore = loadEventStore()
+
+	// HTML view'lar (VIEW_PATH)
+	cfg.View = loadView()
+
+	// Eksik zorunlu ve okunamayan değişkenler Validate'te raporlanır
+	cfg.loadErrors = append(missingRequiredEnv(cfg.App.Env), envIssues...)
+	envIssues = nil
 
 	return cfg
 }
 
 // Validate, config değerlerinin geçerliliğini kontrol eder.
 //
-// Production ortamı için kritik kontroller yapar:
-// - JWT secret uzunluğu (min 32 karakter)
-// - Cache driver geçerliliği
-// - Production'da default secret kontrolü
+// İlk hatada durmaz; tüm hatalar tek bir *ValidationError'da toplanır:
+// - Ortam için zorunlu olup tanımlanmamış ve okunamayan değişkenler
+// - JWT secret uzunluğu (min 32 karakter) ve production'da default secret
+// - Port ve sayısal limitlerin aralıkları
+// - Driver, serializer ve SameSite değerlerinin geçerliliği
 //
 // Döndürür:
-//   - error: Validation hatası (varsa)
+//   - error: Doğrulama hataları (*ValidationError) veya nil
 func (c *Config) Validate() error {
-	// JWT secret kontrolü (Production)
+	errs := append([]error(nil), c.loadErrors...)
+
+	// JWT secret kontrolü (Production): boş, kısa veya default secret
 	if c.IsProduction() {
-		if len(c.JWT.Secret) < 32 {
-			return fmt.Errorf("JWT_SECRET production'da en az 32 karakter olmalı")
+		if err := c.JWT.Auth().Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("production JWT ayarı geçersiz: %w", err))
 		}
+	}
 
-		// Default secret kontrolü
-		if c.JWT.Secret == "your-super-secret-jwt-key-change-this-in-production" {
-			return fmt.Errorf("JWT_SECRET production'da değiştirilmelidir")
+	// APP_KEY tanımlıysa geçerli bir AES anahtarı olmalı
+	if c.App.Key != "" {
+		if _, err := crypt.ParseKey(c.App.Key); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
+	// Port ve sayısal limit kontrolleri
+	if port, err := strconv.Atoi(c.Server.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("geçersiz PORT: %q (1-65535 arası sayı olmalı)", c.Server.Port))
+	}
+	if c.Redis.Port < 1 || c.Redis.Port > 65535 {
+		errs = append(errs, fmt.Errorf("geçersiz REDIS_PORT: %d (1-65535 arası olmalı)", c.Redis.Port))
+	}
+	if c.DB.MaxOpenConns < 0 || c.DB.MaxIdleConns < 0 || c.DB.ConnMaxLifetime < 0 {
+		errs = append(errs, fmt.Errorf("DB_MAX_OPEN_CONNS, DB_MAX_IDLE_CONNS ve DB_CONN_MAX_LIFETIME negatif olamaz"))
+	}
+	if c.RateLimit.Enabled && (c.RateLimit.MaxRequests < 1 || c.RateLimit.WindowSeconds < 1) {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_MAX_REQUESTS ve RATE_LIMIT_WINDOW_SECONDS pozitif olmalı"))
+	}
+
 	// Cache driver kontrolü
 	validDrivers := map[string]bool{
 		"redis":  true,
@@ -245,16 +463,120 @@ func (c *Config) Validate() error {
 		"memory": true,
 	}
 	if !validDrivers[c.Cache.Driver] {
-		return fmt.Errorf("geçersiz CACHE_DRIVER: %s (redis, file veya memory olmalı)", c.Cache.Driver)
+		errs = append(errs, fmt.Errorf("geçersiz CACHE_DRIVER: %s (redis, file veya memory olmalı)", c.Cache.Driver))
+	}
+
+	// Cache serializer kontrolü
+	switch c.Cache.Serializer {
+	case "json", "gob", "msgpack":
+	default:
+		errs = append(errs, fmt.Errorf("geçersiz CACHE_SERIALIZER: %s (json, gob veya msgpack olmalı)", c.Cache.Serializer))
+	}
+
+	// Named cache store driver kontrolü
+	for name, store := range c.CacheStores {
+		if !validDrivers[store.Driver] {
+			errs = append(errs, fmt.Errorf("geçersiz CACHE_STORE_%s_DRIVER: %s (redis, file veya memory olmalı)", strings.ToUpper(name), store.Driver))
+		}
+	}
+
+	// SQS bağlantı bilgileri
+	if c.Queue.Driver == "sqs" {
+		if c.SQS.Prefix == "" {
+			errs = append(errs, fmt.Errorf("QUEUE_DRIVER=sqs için SQS_PREFIX gerekli"))
+		}
+		if c.SQS.AccessKeyID == "" || c.SQS.SecretAccessKey == "" {
+			errs = append(errs, fmt.Errorf("QUEUE_DRIVER=sqs için AWS_ACCESS_KEY_ID ve AWS_SECRET_ACCESS_KEY gerekli"))
+		}
+	}
+
+	// Mail driver ve API credential kontrolü
+	switch c.Mail.Driver {
+	case "smtp", "log", "array":
+	case "ses":
+		if c.Mail.SES.AccessKeyID == "" || c.Mail.SES.SecretAccessKey == "" {
+			errs = append(errs, fmt.Errorf("MAIL_DRIVER=ses için AWS_ACCESS_KEY_ID ve AWS_SECRET_ACCESS_KEY gerekli"))
+		}
+	case "mailgun":
+		if c.Mail.Mailgun.Domain == "" || c.Mail.Mailgun.Secret == "" {
+			errs = append(errs, fmt.Errorf("MAIL_DRIVER=mailgun için MAILGUN_DOMAIN ve MAILGUN_SECRET gerekli"))
+		}
+	case "postmark":
+		if c.Mail.Postmark.Token == "" {
+			errs = append(errs, fmt.Errorf("MAIL_DRIVER=postmark için POSTMARK_TOKEN gerekli"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("geçersiz MAIL_DRIVER: %s (smtp, ses, mailgun, postmark, log veya array olmalı)", c.Mail.Driver))
+	}
+
+	// Broadcast driver kontrolü
+	switch c.Broadcast.Driver {
+	case "redis", "local", "log", "null":
+	default:
+		errs = append(errs, fmt.Errorf("geçersiz BROADCAST_DRIVER: %s (redis, local, log veya null olmalı)", c.Broadcast.Driver))
+	}
+
+	// Dead letter hedefi kontrolü
+	switch c.Queue.DeadLetterDriver {
+	case "", "stream", "queue":
+	default:
+		errs = append(errs, fmt.Errorf("geçersiz QUEUE_DEAD_LETTER_DRIVER: %s (stream veya queue olmalı)", c.Queue.DeadLetterDriver))
+	}
+
+	// SameSite kontrolü
+	switch c.Security.CookieSameSite {
+	case "strict", "lax", "none":
+	default:
+		errs = append(errs, fmt.Errorf("geçersiz SECURITY_COOKIE_SAMESITE: %s (strict, lax veya none olmalı)", c.Security.CookieSameSite))
+	}
+
+	// Tarayıcılar SameSite=None cookie'lerini Secure olmadan reddeder
+	if c.Security.CookieSameSite == "none" && !c.Security.CookieSecure {
+		errs = append(errs, fmt.Errorf("SECURITY_COOKIE_SAMESITE=none için SECURITY_COOKIE_SECURE=true olmalı"))
+	}
+
+	// Admin API credential'lı çalışır; wildcard origin CSRF'e kapı açar
+	for _, origin := range c.Security.CORSAdminOrigins {
+		if origin == "*" {
+			errs = append(errs, fmt.Errorf("CORS_ADMIN_ALLOWED_ORIGINS '*' içeremez, dashboard origin'i açıkça belirtilmeli"))
+			break
+		}
+	}
+
+	// Wildcard + credentials her siteye cookie'li erişim verir
+	if c.Security.CORSAllowCredentials {
+		for _, origin := range c.Security.CORSAllowedOrigins {
+			if origin == "*" {
+				errs = append(errs, fmt.Errorf("CORS_ALLOWED_ORIGINS '*' iken CORS_ALLOW_CREDENTIALS=true kullanılamaz, origin'ler açıkça belirtilmeli"))
+				break
+			}
+		}
 	}
 
 	// Production uyarıları
 	if c.IsProduction() {
 		if c.Cache.Driver == "memory" {
 			log.Println("⚠️  UYARI: Memory cache production ortamı için önerilmez!")
+			if c.Cache.MemoryMaxEntries == 0 && c.Cache.MemoryMaxMB == 0 {
+				log.Println("⚠️  UYARI: Memory cache limitsiz! CACHE_MEMORY_MAX_ENTRIES veya CACHE_MEMORY_MAX_MB ayarlayın.")
+			}
+		}
+		if c.App.Key == "" {
+			log.Println("⚠️  UYARI: APP_KEY tanımlı değil, şifreleme kullanılamaz! conduit key:generate çalıştırın.")
+		}
+		if !c.Security.CookieSecure {
+			log.Println("⚠️  UYARI: Production'da Secure olmayan cookie kullanılıyor!")
+		}
+		for _, origin := range c.Security.CORSAllowedOrigins {
+			if origin == "*" {
+				log.Println("⚠️  UYARI: Production'da CORS tüm origin'lere açık!")
+			}
 		}
 	}
 
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
 	return nil
 }
 
@@ -300,13 +622,40 @@ func (c *Config) IsTest() bool {
 	return c.App.Env == "test"
 }
 
-// LoadConfig, Load() fonksiyonunun alias'ıdır (backward compatibility).
+// splitAndTrim, virgülle ayrılmış bir listeyi parçalar ve boş elemanları atar.
+//
+// Örnek:
+//
+//	splitAndTrim("https://a.com, https://b.com") // ["https://a.com", "https://b.com"]
+func splitAndTrim(value string) []string {
+	parts := strings.Split(value, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// LoadConfig, config'i yükler ve doğrular (fail-fast).
 //
-// Bazı kod parçaları LoadConfig() kullanabilir, bu yüzden ikisini de destekliyoruz.
+// Load'dan farkı, doğrulama hatalarını loglamak yerine tek bir
+// *ValidationError olarak döndürmesidir; hata varsa uygulama varsayılan
+// değerlerle başlamak yerine çıkmalıdır.
 //
 // Döndürür:
-//   - *Config: Yapılandırma nesnesi
-//   - error: Her zaman nil (Load() hata döndürmez)
+//   - *Config: Yapılandırma nesnesi (hata olsa da dolu döner)
+//   - error: Tüm doğrulama hataları (*ValidationError)
+//
+// Örnek:
+//
+//	cfg, err := config.LoadConfig()
+//	if err != nil {
+//	    log.Fatalf("❌ %v", err)
+//	}
 func LoadConfig() (*Config, error) {
-	return Load(), nil
+	cfg := load()
+	SetDefault(cfg)
+	return cfg, cfg.Validate()
 }
@@ -0,0 +1,87 @@
+// -----------------------------------------------------------------------------
+// Config Cache
+// -----------------------------------------------------------------------------
+// `conduit config:cache` çözümlenmiş config'i JSON olarak diske yazar; Load
+// bu dosya varken ortam değişkenlerini okumaz. Deploy sırasında bir kez
+// oluşturulan cache, tüm process'lerin (api, worker) aynı değerlerle
+// başlamasını sağlar.
+//
+// Cache secret'ları düz metin içerir; dosya 0600 izniyle yazılır ve
+// repository'e eklenmemelidir. Env değiştiğinde `conduit config:cache`
+// tekrar çalıştırılmalı veya `conduit config:clear` ile silinmelidir.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// DefaultCachePath, config cache dosyasının varsayılan yoludur.
+const DefaultCachePath = "bootstrap/cache/config.json"
+
+// CachePath, config cache dosyasının yolunu döndürür (CONFIG_CACHE_PATH).
+func CachePath() string {
+	return storeEnv("CONFIG_CACHE_PATH", DefaultCachePath)
+}
+
+// WriteCache, config'i path'e JSON olarak yazar.
+//
+// Dosya önce geçici bir dosyaya yazılır ve rename edilir; yazım sırasında
+// başlayan process yarım dosya okumaz.
+func WriteCache(cfg *Config, path string) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("config serialize edilemedi: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// ReadCache, path'teki config cache'ini okur.
+//
+// Dosya yoksa fs.ErrNotExist ile sarılmış hata döner.
+func ReadCache(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("config cache bozuk (%s): %w", path, err)
+	}
+	return cfg, nil
+}
+
+// ClearCache, config cache dosyasını siler.
+//
+// Döndürür:
+//   - bool: Dosya var mıydı
+//   - error: Silme hatası
+func ClearCache(path string) (bool, error) {
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,129 @@
+// -----------------------------------------------------------------------------
+// Config Dump
+// -----------------------------------------------------------------------------
+// Çözümlenmiş config'i `conduit config:show` için düz key/value listesine
+// çevirir. Key'ler struct alanlarından türetilir (DB.ConnMaxLifetime ->
+// db.conn_max_lifetime, Policies["http"].Timeout -> policies.http.timeout).
+//
+// Secret içeren alanlar (şifre, secret, token, DSN, anahtar) maskelenir;
+// boş secret'lar ayarlanmadığı görülsün diye boş bırakılır.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// RedactedValue, maskelenen secret değerlerin yerine yazılır.
+const RedactedValue = "********"
+
+// Entry, config'in tek bir değeridir.
+type Entry struct {
+	Key    string
+	Value  string
+	Secret bool // Değer maskelendi mi
+}
+
+// Entries, config'in tüm değerlerini struct sırasıyla döndürür; secret'lar
+// maskelenir.
+//
+// Örnek:
+//
+//	for _, e := range cfg.Entries() {
+//	    fmt.Printf("%s = %s\n", e.Key, e.Value)
+//	}
+func (c *Config) Entries() []Entry {
+	var entries []Entry
+	flatten(reflect.ValueOf(*c), "", false, &entries)
+	return entries
+}
+
+// flatten, v'nin alanlarını prefix altında entries'e ekler.
+func flatten(v reflect.Value, prefix string, secret bool, entries *[]Entry) {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || field.Tag.Get("config") == "-" {
+				continue
+			}
+			flatten(v.Field(i), joinKey(prefix, snakeKey(field.Name)), secret || isSecretField(field.Name), entries)
+		}
+
+	case reflect.Map:
+		keys := make([]string, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			keys = append(keys, fmt.Sprint(k.Interface()))
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			flatten(v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())), joinKey(prefix, k), secret, entries)
+		}
+
+	default:
+		value := formatValue(v)
+		if secret && value != "" {
+			value = RedactedValue
+		}
+		*entries = append(*entries, Entry{Key: prefix, Value: value, Secret: secret})
+	}
+}
+
+// formatValue, skaler değeri string'e çevirir.
+func formatValue(v reflect.Value) string {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+	if v.Kind() == reflect.Slice {
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// isSecretField, alan adının secret içerip içermediğini belirler.
+func isSecretField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, word := range []string{"password", "secret", "token"} {
+		if strings.Contains(lower, word) {
+			return true
+		}
+	}
+	return name == "DSN" || name == "Key" || strings.HasSuffix(name, "AccessKey")
+}
+
+// snakeKey, alan adını snake_case'e çevirir (CORSAllowedOrigins ->
+// cors_allowed_origins).
+func snakeKey(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// joinKey, prefix ile key'i nokta ile birleştirir.
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
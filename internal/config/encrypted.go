@@ -0,0 +1,113 @@
+// -----------------------------------------------------------------------------
+// Encrypted Values
+// -----------------------------------------------------------------------------
+// Yarı hassas değerler (webhook URL'leri, üçüncü parti API anahtarları)
+// APP_KEY ile şifrelenip repoya commit'lenebilir; config yüklenirken
+// çözülür:
+//
+//	MAIL_PASSWORD=ENC(Xk3m9...)          # .env / .env.production
+//	services:
+//	  stripe:
+//	    key: ENC(q8Zt1...)               # config/services.yaml
+//
+// Değerler `conduit env:encrypt` ile şifrelenir, `conduit env:decrypt` ile
+// geri açılır. Şifreleme pkg/crypt (AES-256-GCM) ile yapılır; APP_KEY'in
+// kendisi şifrelenemez ve repoya commit'lenmemelidir.
+//
+// Çözülemeyen değerler (yanlış APP_KEY, bozuk değer) Validate'te hata olur.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/crypt"
+)
+
+// Şifreli değerlerin öneki ve soneki: ENC(<base64>)
+const (
+	encryptedPrefix = "ENC("
+	encryptedSuffix = ")"
+)
+
+// IsEncrypted, değerin ENC(...) biçiminde şifreli olup olmadığını döndürür.
+func IsEncrypted(value string) bool {
+	value = strings.TrimSpace(value)
+	return len(value) > len(encryptedPrefix+encryptedSuffix) &&
+		strings.HasPrefix(value, encryptedPrefix) &&
+		strings.HasSuffix(value, encryptedSuffix)
+}
+
+// EncryptValue, değeri APP_KEY ile şifreleyip ENC(...) biçiminde döndürür.
+//
+// Parametreler:
+//   - appKey: APP_KEY değeri ("base64:..." veya ham anahtar)
+//   - plaintext: Şifrelenecek değer
+//
+// Döndürür:
+//   - string: ENC(...) değeri
+//   - error: APP_KEY geçersizse hata
+//
+// Örnek:
+//
+//	value, err := config.EncryptValue(cfg.App.Key, "sk_live_...")
+//	// value = "ENC(Xk3m9...)"
+func EncryptValue(appKey, plaintext string) (string, error) {
+	encrypter, err := crypt.NewFromAppKey(appKey)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := encrypter.EncryptString(plaintext)
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + token + encryptedSuffix, nil
+}
+
+// DecryptValue, ENC(...) değerini APP_KEY ile çözer. Şifreli olmayan
+// değerler olduğu gibi döner.
+//
+// Döndürür:
+//   - string: Çözülmüş değer
+//   - error: APP_KEY geçersizse veya değer bu anahtarla çözülemiyorsa hata
+func DecryptValue(appKey, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	encrypter, err := crypt.NewFromAppKey(appKey)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(value)
+	token = token[len(encryptedPrefix) : len(token)-len(encryptedSuffix)]
+	return encrypter.DecryptString(strings.TrimSpace(token))
+}
+
+// decryptEnv, değişkenin değeri şifreliyse ortamdaki APP_KEY ile çözer.
+// Hata config sorunu olarak kaydedilir ve boş değer döner.
+func decryptEnv(key, value string) string {
+	if !IsEncrypted(value) {
+		return value
+	}
+
+	if key == "APP_KEY" {
+		log.Printf("❌ APP_KEY şifrelenemez")
+		envIssues = append(envIssues, fmt.Errorf("APP_KEY şifreli (ENC) olamaz"))
+		return ""
+	}
+
+	appKey, _ := rawLookupEnv("APP_KEY")
+	plaintext, err := DecryptValue(appKey, value)
+	if err != nil {
+		log.Printf("❌ %s şifreli değeri çözülemedi: %v", key, err)
+		envIssues = append(envIssues, fmt.Errorf("%s: şifreli değer çözülemedi: %w", key, err))
+		return ""
+	}
+	return plaintext
+}
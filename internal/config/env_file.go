@@ -0,0 +1,264 @@
+// -----------------------------------------------------------------------------
+// Env File
+// -----------------------------------------------------------------------------
+// .env dosyasını satır satır okur ve günceller (`conduit env:get`,
+// `conduit env:set`, `conduit key:generate`). Yorumlar, boş satırlar ve
+// key sırası korunur; sadece değişen satır yeniden yazılır.
+//
+// Desteklenen satırlar:
+//
+//	# yorum
+//	APP_NAME=Conduit
+//	export APP_ENV=production
+//	MAIL_FROM_NAME="Conduit Team"   # tırnak içinde boşluk ve # kullanılabilir
+//	DB_PASSWORD='p@ss$word'         # tek tırnak: değer olduğu gibi alınır
+//	CACHE_DRIVER=redis # satır sonu yorumu
+//
+// Uygulama .env dosyasını kendisi yüklemez; değerler ortam değişkenlerinden
+// okunur (docker compose env_file, systemd EnvironmentFile vb.).
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// DefaultEnvFile, env komutlarının varsayılan dosyasıdır.
+const DefaultEnvFile = ".env"
+
+// EnvBackupSuffix, Save'in önceki içeriği yedeklediği dosyanın ekidir
+// (.env -> .env.backup).
+const EnvBackupSuffix = ".backup"
+
+// envKeyPattern, geçerli env key'leridir.
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// EnvFile, belleğe okunmuş bir .env dosyasıdır.
+type EnvFile struct {
+	path  string
+	lines []string
+}
+
+// ReadEnvFile, path'teki env dosyasını okur.
+//
+// Döndürür:
+//   - *EnvFile: Okunan dosya
+//   - error: Dosya yoksa fs.ErrNotExist ile sarılmış hata
+func ReadEnvFile(path string) (*EnvFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	content := strings.TrimSuffix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	f := &EnvFile{path: path}
+	if content != "" {
+		f.lines = strings.Split(content, "\n")
+	}
+	return f, nil
+}
+
+// ValidEnvKey, key'in geçerli bir env değişkeni adı olup olmadığını döndürür.
+func ValidEnvKey(key string) bool {
+	return envKeyPattern.MatchString(key)
+}
+
+// Path, dosyanın yolunu döndürür.
+func (f *EnvFile) Path() string {
+	return f.path
+}
+
+// Get, key'in değerini döndürür (tırnaklar ve satır sonu yorumu çıkarılır).
+// Key birden fazla kez tanımlıysa sonuncusu geçerlidir.
+func (f *EnvFile) Get(key string) (string, bool) {
+	i := f.find(key)
+	if i < 0 {
+		return "", false
+	}
+	_, raw, _ := parseEnvLine(f.lines[i])
+	value, _ := splitEnvValue(raw)
+	return value, true
+}
+
+// Keys, tanımlı key'leri dosya sırasıyla döndürür.
+func (f *EnvFile) Keys() []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, line := range f.lines {
+		if key, _, ok := parseEnvLine(line); ok && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Set, key'in değerini günceller; key yoksa dosyanın sonuna ekler. Boşluk,
+// #, $ veya tırnak içeren değerler tırnak içinde yazılır. Satırdaki `export`
+// öneki ve satır sonu yorumu korunur.
+//
+// Döndürür:
+//   - error: Key geçersizse hata
+func (f *EnvFile) Set(key, value string) error {
+	if !ValidEnvKey(key) {
+		return fmt.Errorf("geçersiz env key: %q", key)
+	}
+
+	entry := key + "=" + quoteEnvValue(value)
+	if i := f.find(key); i >= 0 {
+		if strings.HasPrefix(strings.TrimSpace(f.lines[i]), "export ") {
+			entry = "export " + entry
+		}
+		_, raw, _ := parseEnvLine(f.lines[i])
+		_, comment := splitEnvValue(raw)
+		f.lines[i] = entry + comment
+		return nil
+	}
+
+	f.lines = append(f.lines, entry)
+	return nil
+}
+
+// Save, dosyayı yazar.
+//
+// Mevcut içerik önce <path>.backup dosyasına kopyalanır (backup true ise).
+// Yeni içerik geçici bir dosyaya yazılıp rename edilir; yazım yarıda kalırsa
+// eski dosya bozulmaz. Dosya izinleri korunur.
+func (f *EnvFile) Save(backup bool) error {
+	mode := os.FileMode(0600)
+	if info, err := os.Stat(f.path); err == nil {
+		mode = info.Mode().Perm()
+
+		if backup {
+			previous, err := os.ReadFile(f.path)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(f.path+EnvBackupSuffix, previous, mode); err != nil {
+				return fmt.Errorf("yedek oluşturulamadı: %w", err)
+			}
+		}
+	}
+
+	content := strings.Join(f.lines, "\n")
+	if content != "" {
+		content += "\n"
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+// find, key'in son tanımlandığı satırın indexini döndürür (-1: yok).
+func (f *EnvFile) find(key string) int {
+	for i := len(f.lines) - 1; i >= 0; i-- {
+		if name, _, ok := parseEnvLine(f.lines[i]); ok && name == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseEnvLine, satırı key ve ham değere ayırır. Yorum ve boş satırlarda ok
+// false döner.
+func parseEnvLine(line string) (key, raw string, ok bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+	line = strings.TrimPrefix(line, "export ")
+
+	key, raw, ok = strings.Cut(line, "=")
+	key = strings.TrimSpace(key)
+	if !ok || !ValidEnvKey(key) {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(raw), true
+}
+
+// splitEnvValue, ham değeri tırnakları çıkarılmış değer ve satır sonu
+// yorumuna (" # ..." dahil) ayırır.
+func splitEnvValue(raw string) (value, comment string) {
+	if raw == "" {
+		return "", ""
+	}
+
+	rest := ""
+	switch raw[0] {
+	case '"':
+		var b strings.Builder
+		closed := false
+		i := 1
+		for ; i < len(raw) && !closed; i++ {
+			switch c := raw[i]; {
+			case c == '\\' && i+1 < len(raw):
+				i++
+				if raw[i] == 'n' {
+					b.WriteByte('\n')
+				} else {
+					b.WriteByte(raw[i])
+				}
+			case c == '"':
+				closed = true
+			default:
+				b.WriteByte(c)
+			}
+		}
+		if !closed {
+			return raw, "" // Kapanmayan tırnak: değer olduğu gibi
+		}
+		value, rest = b.String(), raw[i:]
+	case '\'':
+		end := strings.IndexByte(raw[1:], '\'')
+		if end < 0 {
+			return raw, ""
+		}
+		value, rest = raw[1:end+1], raw[end+2:]
+	default:
+		value = raw
+		if i := strings.Index(raw, " #"); i >= 0 {
+			value, rest = raw[:i], raw[i:]
+		}
+		value = strings.TrimSpace(value)
+	}
+
+	if trimmed := strings.TrimSpace(rest); strings.HasPrefix(trimmed, "#") {
+		comment = " " + trimmed
+	}
+	return value, comment
+}
+
+// quoteEnvValue, değeri gerekiyorsa tırnaklar. Tek tırnak tercih edilir:
+// içeriği ($ dahil) olduğu gibi alınır. Değer tek tırnak veya satır sonu
+// içeriyorsa çift tırnak ve kaçış karakterleri kullanılır.
+func quoteEnvValue(value string) string {
+	if value == "" || !strings.ContainsAny(value, " \t\n#\"'\\$`") {
+		return value
+	}
+	if !strings.ContainsAny(value, "'\n") {
+		return "'" + value + "'"
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,29 @@
+// -----------------------------------------------------------------------------
+// Event Store Configuration
+// -----------------------------------------------------------------------------
+// Dispatch edilen event'lerin stored_events tablosuna kaydı:
+//
+//	EVENT_STORE_ENABLED=false        # true: event'ler kaydedilir, replay API'si açılır
+//	EVENT_STORE_EVENTS=user.*,order.* # Kaydedilecek event'ler (boş: hepsi)
+//
+// Kayıtlar admin API'si ile listelenir ve tekrar oynatılır:
+//
+//	GET  /api/admin/events
+//	POST /api/admin/events/replay
+// -----------------------------------------------------------------------------
+
+package config
+
+// EventStoreConfig, event store ayarlarıdır.
+type EventStoreConfig struct {
+	Enabled bool
+	Events  []string // Event adı pattern'leri ("user.*"); boş: hepsi
+}
+
+// loadEventStore, event store ayarlarını ortam değişkenlerinden okur.
+func loadEventStore() EventStoreConfig {
+	return EventStoreConfig{
+		Enabled: oidcBool("EVENT_STORE_ENABLED", false),
+		Events:  splitAndTrim(storeEnv("EVENT_STORE_EVENTS", "")),
+	}
+}
@@ -0,0 +1,244 @@
+// -----------------------------------------------------------------------------
+// Config Files (YAML)
+// -----------------------------------------------------------------------------
+// Çok sayıda ortam değişkeniyle ifade etmesi zor ayarlar (cache store'ları,
+// queue bağlantıları, CORS origin listeleri) config/ dizinindeki YAML
+// dosyalarına yazılabilir:
+//
+//	config/app.yaml               # Tüm ortamlar
+//	config/cache.yaml
+//	config/database.yaml
+//	config/cache.production.yaml  # Sadece APP_ENV=production
+//
+// YAML'daki iç içe key'ler "_" ile birleştirilip büyük harfe çevrilerek
+// ortam değişkeni adına dönüşür; listeler virgülle birleştirilir. Her
+// değişken, .env'de olduğu gibi aynı anlamı taşır:
+//
+//	cache:
+//	  driver: redis                # CACHE_DRIVER=redis
+//	  stores: [sessions]           # CACHE_STORES=sessions
+//	  store:
+//	    sessions:
+//	      ttl: 2h                  # CACHE_STORE_SESSIONS_TTL=2h
+//	cors:
+//	  allowed_origins:             # CORS_ALLOWED_ORIGINS=https://a.com,https://b.com
+//	    - https://a.com
+//	    - https://b.com
+//
+// Öncelik (düşükten yükseğe): varsayılanlar, config/*.yaml,
+// config/*.<APP_ENV>.yaml, ortam değişkenleri. Böylece secret'lar ve
+// deploy'a özel değerler env'de kalırken yapısal ayarlar dosyada tutulur.
+// APP_ENV ortamda yoksa app.yaml'daki app.env kullanılır.
+//
+//	CONFIG_PATH=config    # YAML dizini (yoksa dosya okunmaz)
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LoadFromEnv sırasında loadMu tutulurken yazılır ve okunur:
+//   - fileEnv: Config dosyalarından okunan değerler (değişken adı -> değer)
+//   - resolvedEnv: Çözülmüş ENC değerleri ve secret referansları (aynı
+//     değişken bir kez çözülür)
+var (
+	fileEnv     map[string]string
+	resolvedEnv map[string]string
+)
+
+// ConfigPath, YAML config dizinini döndürür (CONFIG_PATH, varsayılan: config).
+func ConfigPath() string {
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		return path
+	}
+	return "config"
+}
+
+// lookupEnv, değişkeni ortamda ve config dosyalarında arar; değer şifreliyse
+// (ENC) veya bir secret referansıysa çözülmüş halini döndürür (bkz:
+// encrypted.go, secrets.go).
+func lookupEnv(key string) (string, bool) {
+	value, exists := rawLookupEnv(key)
+	if value == "" {
+		return value, exists
+	}
+
+	if resolved, ok := resolvedEnv[key]; ok {
+		return resolved, true
+	}
+	resolved := resolveSecret(key, decryptEnv(key, value))
+	if resolvedEnv != nil {
+		resolvedEnv[key] = resolved
+	}
+	return resolved, true
+}
+
+// rawLookupEnv, değişkeni önce ortamda, sonra config dosyalarında arar.
+// Ortamda boş tanımlı değişkenler tanımsız sayılır ve dosyadaki değer
+// kullanılır. Secret referansları çözülmez.
+func rawLookupEnv(key string) (string, bool) {
+	value, exists := os.LookupEnv(key)
+	if exists && value != "" {
+		return value, true
+	}
+	if fileValue, ok := fileEnv[key]; ok {
+		return fileValue, true
+	}
+	return value, exists
+}
+
+// getenv, os.Getenv'in config dosyalarını da okuyan karşılığıdır.
+func getenv(key string) string {
+	value, _ := lookupEnv(key)
+	return value
+}
+
+// LoadFiles, dir'deki YAML config dosyalarını okuyup ortam değişkeni
+// adlarına düzleştirir. Önce ortam eki olmayan dosyalar, sonra env'e ait
+// *.<env>.yaml dosyaları alfabetik sırayla uygulanır; sonraki dosya
+// öncekinin değerini ezer. Dizin yoksa boş map döner.
+//
+// Parametreler:
+//   - dir: Config dizini (genellikle ConfigPath())
+//   - env: Ortam; boşsa dosyalardaki APP_ENV, o da yoksa "development"
+//
+// Döndürür:
+//   - map[string]string: Değişken adı -> değer
+//   - error: Okuma veya parse hatası (dosya adı ve satır numarasıyla)
+//
+// Örnek:
+//
+//	values, err := config.LoadFiles("config", "production")
+//	fmt.Println(values["CACHE_DRIVER"])
+func LoadFiles(dir, env string) (map[string]string, error) {
+	values := make(map[string]string)
+
+	base, overrides, err := configFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range base {
+		if err := loadFile(file, values); err != nil {
+			return nil, err
+		}
+	}
+
+	if env == "" {
+		env = values["APP_ENV"]
+	}
+	if env == "" {
+		env = "development"
+	}
+
+	for _, file := range overrides[env] {
+		if err := loadFile(file, values); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// configFiles, dir'deki .yaml/.yml dosyalarını ortam eki olmayanlar ve
+// ortama göre gruplanmış override'lar olarak döndürür.
+func configFiles(dir string) (base []string, overrides map[string][]string, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("config dizini okunamadı: %w", err)
+	}
+
+	overrides = make(map[string][]string)
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		file := filepath.Join(dir, entry.Name())
+		name := strings.TrimSuffix(entry.Name(), ext)
+		if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+			env := name[dot+1:]
+			overrides[env] = append(overrides[env], file)
+			continue
+		}
+		base = append(base, file)
+	}
+
+	sort.Strings(base)
+	for env := range overrides {
+		sort.Strings(overrides[env])
+	}
+	return base, overrides, nil
+}
+
+// loadFile, tek bir YAML dosyasını okuyup values'a düzleştirir.
+func loadFile(file string, values map[string]string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("config dosyası okunamadı: %w", err)
+	}
+
+	root, err := parseYAML(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+
+	if err := flattenYAML("", root, values); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
+
+// flattenYAML, YAML düğümünü ortam değişkeni adlarına düzleştirir.
+func flattenYAML(prefix string, node any, values map[string]string) error {
+	switch v := node.(type) {
+	case map[string]any:
+		for key, child := range v {
+			name := strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(key))
+			if prefix != "" {
+				name = prefix + "_" + name
+			}
+			if err := flattenYAML(name, child, values); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("%s: liste elemanları düz değer olmalı", prefix)
+			}
+			parts = append(parts, s)
+		}
+		return setFileEnv(prefix, strings.Join(parts, ","), values)
+
+	case string:
+		return setFileEnv(prefix, v, values)
+
+	case nil:
+		return nil
+	}
+	return fmt.Errorf("%s: desteklenmeyen değer", prefix)
+}
+
+// setFileEnv, değişken adını doğrulayıp değeri yazar.
+func setFileEnv(key, value string, values map[string]string) error {
+	if !ValidEnvKey(key) {
+		return fmt.Errorf("geçersiz config key'i: %s", key)
+	}
+	values[key] = value
+	return nil
+}
@@ -0,0 +1,307 @@
+// -----------------------------------------------------------------------------
+// Dot-Notation Config Access
+// -----------------------------------------------------------------------------
+// Paketlerin ortam değişkeni string'lerini kendileri parse etmeden ayar
+// okuyabilmesi için tipli, nokta notasyonlu erişim (Laravel config('...')):
+//
+//	ttl := config.GetDuration("cache.stores.sessions.ttl", time.Hour)
+//	retries := config.GetInt("policies.mail.max_retries", 2)
+//	key := config.Get("services.stripe.key", "")
+//
+// Key'ler `conduit config:show` ile aynıdır (DB.ConnMaxLifetime ->
+// db.conn_max_lifetime). Alan adındaki alt çizgi yerine nokta da
+// kullanılabilir: cache.stores.sessions, cache_stores.sessions ile aynıdır.
+//
+// Config struct'ında olmayan key'ler (uygulamaya özel ayarlar) ortam
+// değişkeninden (services.stripe.key -> SERVICES_STRIPE_KEY), o da yoksa
+// config/*.yaml dosyalarından okunur; ENC(...) değerleri ve secret
+// referansları çözülür.
+//
+// Paket fonksiyonları Load/LoadConfig'in ayarladığı varsayılan config'i
+// kullanır; DI ile alınan *Config üzerinde aynı metodlar çağrılabilir.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"context"
+	"log"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/secrets"
+)
+
+var (
+	defaultMu  sync.RWMutex
+	defaultCfg *Config
+)
+
+// SetDefault, paket seviyesindeki Get* fonksiyonlarının kullanacağı
+// config'i ayarlar. Load ve LoadConfig bunu otomatik yapar.
+func SetDefault(cfg *Config) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	defaultCfg = cfg
+}
+
+// Default, varsayılan config'i döndürür (yüklenmediyse nil).
+func Default() *Config {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+
+	return defaultCfg
+}
+
+// Has, key'in tanımlı olup olmadığını döndürür.
+func Has(key string) bool { return Default().Has(key) }
+
+// Get, key'in değerini string olarak döndürür; yoksa defaultValue.
+func Get(key, defaultValue string) string { return Default().Get(key, defaultValue) }
+
+// GetInt, key'in değerini int olarak döndürür; yoksa veya geçersizse defaultValue.
+func GetInt(key string, defaultValue int) int { return Default().GetInt(key, defaultValue) }
+
+// GetBool, key'in değerini bool olarak döndürür; yoksa veya geçersizse defaultValue.
+func GetBool(key string, defaultValue bool) bool { return Default().GetBool(key, defaultValue) }
+
+// GetDuration, key'in değerini süre olarak döndürür; yoksa veya geçersizse defaultValue.
+func GetDuration(key string, defaultValue time.Duration) time.Duration {
+	return Default().GetDuration(key, defaultValue)
+}
+
+// GetStrings, virgülle ayrılmış veya liste değerini döndürür; yoksa defaultValue.
+func GetStrings(key string, defaultValue []string) []string {
+	return Default().GetStrings(key, defaultValue)
+}
+
+// Has, key'in config'te, ortamda veya config dosyalarında tanımlı olup
+// olmadığını döndürür.
+func (c *Config) Has(key string) bool {
+	_, ok := c.value(key)
+	return ok
+}
+
+// Get, key'in değerini string olarak döndürür; yoksa defaultValue.
+// Listeler virgülle birleştirilir, süreler "1h30m0s" biçimindedir.
+//
+// Örnek:
+//
+//	cfg.Get("app.name", "Conduit")
+//	cfg.Get("services.stripe.key", "") // SERVICES_STRIPE_KEY
+func (c *Config) Get(key, defaultValue string) string {
+	value, ok := c.value(key)
+	if !ok {
+		return defaultValue
+	}
+	if v, ok := value.(reflect.Value); ok {
+		return formatValue(v)
+	}
+	return value.(string)
+}
+
+// GetInt, key'in değerini int olarak döndürür; yoksa veya geçersizse defaultValue.
+//
+// Örnek:
+//
+//	cfg.GetInt("db.max_open_conns", 25)
+func (c *Config) GetInt(key string, defaultValue int) int {
+	value, ok := c.value(key)
+	if !ok {
+		return defaultValue
+	}
+
+	if v, ok := value.(reflect.Value); ok {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return int(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return int(v.Uint())
+		}
+		value = formatValue(v)
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(value.(string)))
+	if err != nil {
+		log.Printf("⚠️  Uyarı: %s için geçersiz sayı: %v, varsayılan (%d) kullanılıyor.", key, value, defaultValue)
+		return defaultValue
+	}
+	return n
+}
+
+// GetBool, key'in değerini bool olarak döndürür; yoksa veya geçersizse defaultValue.
+//
+// Örnek:
+//
+//	cfg.GetBool("rate_limit.enabled", true)
+func (c *Config) GetBool(key string, defaultValue bool) bool {
+	value, ok := c.value(key)
+	if !ok {
+		return defaultValue
+	}
+
+	if v, ok := value.(reflect.Value); ok {
+		if v.Kind() == reflect.Bool {
+			return v.Bool()
+		}
+		value = formatValue(v)
+	}
+
+	b, err := strconv.ParseBool(strings.TrimSpace(value.(string)))
+	if err != nil {
+		log.Printf("⚠️  Uyarı: %s için geçersiz boolean: %v, varsayılan (%t) kullanılıyor.", key, value, defaultValue)
+		return defaultValue
+	}
+	return b
+}
+
+// GetDuration, key'in değerini süre olarak döndürür; yoksa veya
+// geçersizse defaultValue. String değerler Go duration formatında
+// ("30s", "2h") veya saniye cinsinden tam sayı olabilir.
+//
+// Örnek:
+//
+//	cfg.GetDuration("cache.stores.sessions.ttl", time.Hour)
+func (c *Config) GetDuration(key string, defaultValue time.Duration) time.Duration {
+	value, ok := c.value(key)
+	if !ok {
+		return defaultValue
+	}
+
+	if v, ok := value.(reflect.Value); ok {
+		if d, ok := v.Interface().(time.Duration); ok {
+			return d
+		}
+		value = formatValue(v)
+	}
+
+	s := strings.TrimSpace(value.(string))
+	if d, err := time.ParseDuration(s); err == nil {
+		return d
+	}
+	if seconds, err := strconv.Atoi(s); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	log.Printf("⚠️  Uyarı: %s için geçersiz süre: %s, varsayılan (%s) kullanılıyor.", key, s, defaultValue)
+	return defaultValue
+}
+
+// GetStrings, liste değerini döndürür; string değerler virgülden bölünür.
+//
+// Örnek:
+//
+//	cfg.GetStrings("security.cors_allowed_origins", nil)
+func (c *Config) GetStrings(key string, defaultValue []string) []string {
+	value, ok := c.value(key)
+	if !ok {
+		return defaultValue
+	}
+
+	if v, ok := value.(reflect.Value); ok {
+		if v.Kind() == reflect.Slice {
+			items := make([]string, v.Len())
+			for i := range items {
+				items[i] = formatValue(v.Index(i))
+			}
+			return items
+		}
+		value = formatValue(v)
+	}
+	return splitAndTrim(value.(string))
+}
+
+// value, key'i önce config struct'ında (reflect.Value), sonra ortamda ve
+// config dosyalarında (string) arar.
+func (c *Config) value(key string) (any, bool) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	if key == "" {
+		return nil, false
+	}
+
+	if c != nil {
+		if v, ok := walkConfig(reflect.ValueOf(*c), strings.Split(key, ".")); ok {
+			return v, true
+		}
+	}
+
+	name := strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+	raw := os.Getenv(name)
+	if raw == "" && c != nil {
+		raw = c.Values[name]
+	}
+	if raw == "" {
+		return nil, false
+	}
+
+	if IsEncrypted(raw) {
+		appKey := os.Getenv("APP_KEY")
+		if c != nil && c.App.Key != "" {
+			appKey = c.App.Key
+		}
+		decrypted, err := DecryptValue(appKey, raw)
+		if err != nil {
+			log.Printf("❌ %s şifreli değeri çözülemedi: %v", key, err)
+			return nil, false
+		}
+		raw = decrypted
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resolved, err := secrets.Resolve(ctx, raw)
+	if err != nil {
+		log.Printf("❌ %s secret'ı çözülemedi: %v", key, err)
+		return nil, false
+	}
+	return resolved, true
+}
+
+// walkConfig, segmentleri struct alanları ve map key'leri üzerinden izler.
+// Bir alan adı birden fazla segmentten oluşabilir (cache.stores ->
+// cache_stores). Sadece skaler değerler ve listeler bulunmuş sayılır.
+func walkConfig(v reflect.Value, segments []string) (reflect.Value, bool) {
+	if len(segments) == 0 {
+		switch v.Kind() {
+		case reflect.Struct, reflect.Map, reflect.Invalid:
+			return reflect.Value{}, false
+		}
+		return v, true
+	}
+
+	for i := 1; i <= len(segments); i++ {
+		name := strings.Join(segments[:i], "_")
+
+		switch v.Kind() {
+		case reflect.Struct:
+			t := v.Type()
+			for f := 0; f < t.NumField(); f++ {
+				field := t.Field(f)
+				if !field.IsExported() || field.Tag.Get("config") == "-" || snakeKey(field.Name) != name {
+					continue
+				}
+				if found, ok := walkConfig(v.Field(f), segments[i:]); ok {
+					return found, true
+				}
+			}
+
+		case reflect.Map:
+			if v.Type().Key().Kind() != reflect.String {
+				return reflect.Value{}, false
+			}
+			if child := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key())); child.IsValid() {
+				if found, ok := walkConfig(child, segments[i:]); ok {
+					return found, true
+				}
+			}
+
+		default:
+			return reflect.Value{}, false
+		}
+	}
+	return reflect.Value{}, false
+}
@@ -0,0 +1,89 @@
+// -----------------------------------------------------------------------------
+// JWT Configuration
+// -----------------------------------------------------------------------------
+// Access/refresh token ayarları. Süreler Go duration formatındadır:
+//
+//	JWT_SECRET=...                 # En az 32 karakter (production'da zorunlu)
+//	JWT_TTL=1h                     # Access token ömrü
+//	JWT_REFRESH_TTL=168h           # Refresh token ömrü
+//	JWT_ISSUER=conduit-go          # iss claim'i
+//	JWT_AUDIENCE=                  # aud claim'i (opsiyonel)
+//
+// Eski JWT_EXPIRATION / JWT_REFRESH_EXPIRATION (saniye) değişkenleri, yeni
+// değişkenler tanımlı değilse okunmaya devam eder.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+)
+
+// JWTConfig, JWT authentication ayarlarıdır.
+type JWTConfig struct {
+	Secret            string        // JWT secret key
+	Expiration        time.Duration // Access token süresi
+	RefreshExpiration time.Duration // Refresh token süresi
+	Issuer            string        // Token issuer (iss)
+	Audience          string        // Token audience (aud, opsiyonel)
+}
+
+// Auth, ayarları auth.JWTConfig'e çevirir.
+//
+// Örnek:
+//
+//	jwtConfig := cfg.JWT.Auth()
+//	token, err := auth.GenerateToken(user.ID, user.Email, user.GetRole(), jwtConfig)
+func (j JWTConfig) Auth() *auth.JWTConfig {
+	return &auth.JWTConfig{
+		Secret:           j.Secret,
+		Issuer:           j.Issuer,
+		Audience:         j.Audience,
+		ExpirationTime:   j.Expiration,
+		RefreshExpiresIn: j.RefreshExpiration,
+	}
+}
+
+// loadJWT, JWT ayarlarını ortam değişkenlerinden okur.
+func loadJWT() JWTConfig {
+	defaults := auth.DefaultJWTConfig()
+
+	secret := getenv("JWT_SECRET")
+	if secret == "" {
+		log.Println("⚠️  Uyarı: JWT_SECRET ortam değişkeni bulunamadı, development secret'ı kullanılıyor.")
+		secret = defaults.Secret
+	}
+
+	return JWTConfig{
+		Secret:            secret,
+		Expiration:        jwtTTL("JWT_TTL", "JWT_EXPIRATION", defaults.ExpirationTime),
+		RefreshExpiration: jwtTTL("JWT_REFRESH_TTL", "JWT_REFRESH_EXPIRATION", defaults.RefreshExpiresIn),
+		Issuer:            storeEnv("JWT_ISSUER", defaults.Issuer),
+		Audience:          storeEnv("JWT_AUDIENCE", ""),
+	}
+}
+
+// jwtTTL, duration değişkenini okur; tanımlı değilse saniye cinsinden eski
+// değişkene bakar.
+func jwtTTL(key, legacyKey string, defaultValue time.Duration) time.Duration {
+	if getenv(key) != "" {
+		return policyDuration(key, defaultValue)
+	}
+
+	valueStr := getenv(legacyKey)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	seconds, err := strconv.Atoi(valueStr)
+	if err != nil || seconds <= 0 {
+		log.Printf("⚠️  Uyarı: %s için geçersiz değer: %s, varsayılan (%s) kullanılıyor.", legacyKey, valueStr, defaultValue)
+		invalidEnv(legacyKey, valueStr, "süre (saniye)")
+		return defaultValue
+	}
+	return time.Duration(seconds) * time.Second
+}
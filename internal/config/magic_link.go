@@ -0,0 +1,49 @@
+// -----------------------------------------------------------------------------
+// Magic Link Configuration
+// -----------------------------------------------------------------------------
+// Şifresiz (magic link) giriş ayarları:
+//
+//	MAGIC_LINK_URL=http://localhost:3000/auth/magic-link # Token'ın ?token= ile ekleneceği frontend sayfası
+//	MAGIC_LINK_TTL=15m                                   # Link'in geçerlilik süresi
+//	MAGIC_LINK_MAX_PER_WINDOW=3                          # Email başına pencere içinde link sayısı (0 = limitsiz)
+//	MAGIC_LINK_WINDOW=15m                                # Throttle penceresi
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+)
+
+// MagicLinkConfig, şifresiz giriş ayarlarıdır.
+type MagicLinkConfig struct {
+	URL          string        // Email'deki linkin hedefi (token query'ye eklenir)
+	TTL          time.Duration // Link'in geçerlilik süresi
+	MaxPerWindow int           // Email başına pencere içinde üretilebilecek link sayısı
+	Window       time.Duration // Throttle penceresi
+}
+
+// Auth, ayarları auth.MagicLinkConfig'e çevirir.
+func (m MagicLinkConfig) Auth() *auth.MagicLinkConfig {
+	return &auth.MagicLinkConfig{
+		TTL:          m.TTL,
+		MaxPerWindow: m.MaxPerWindow,
+		Window:       m.Window,
+	}
+}
+
+// loadMagicLink, magic link ayarlarını ortam değişkenlerinden okur.
+// Link hedefi tanımlı değilse uygulama URL'si kullanılır.
+func loadMagicLink(appURL string) MagicLinkConfig {
+	defaults := auth.DefaultMagicLinkConfig()
+
+	return MagicLinkConfig{
+		URL:          storeEnv("MAGIC_LINK_URL", strings.TrimRight(appURL, "/")+"/auth/magic-link"),
+		TTL:          policyDuration("MAGIC_LINK_TTL", defaults.TTL),
+		MaxPerWindow: policyInt("MAGIC_LINK_MAX_PER_WINDOW", defaults.MaxPerWindow),
+		Window:       policyDuration("MAGIC_LINK_WINDOW", defaults.Window),
+	}
+}
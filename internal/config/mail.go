@@ -0,0 +1,192 @@
+// -----------------------------------------------------------------------------
+// Mail Configuration
+// -----------------------------------------------------------------------------
+// Mail driver ve SMTP bağlantı ayarları:
+//
+//	MAIL_DRIVER=smtp                  # smtp, ses, mailgun, postmark, log veya array (testler)
+//	MAIL_HOST=smtp.example.com
+//	MAIL_PORT=587
+//	MAIL_USERNAME=apikey              # Boş veya "null": AUTH yapılmaz
+//	MAIL_PASSWORD=secret
+//	MAIL_ENCRYPTION=starttls          # starttls, tls (465), none (boş: otomatik)
+//	MAIL_FROM_ADDRESS=noreply@example.com
+//	MAIL_FROM_NAME="Conduit"          # Varsayılan: APP_NAME
+//	MAIL_TIMEOUT=30s                  # Bağlantı ve komut timeout'u
+//	MAIL_MAX_IDLE_CONNS=2             # Havuzdaki boşta bağlantı sayısı (0 = havuz kapalı)
+//	MAIL_IDLE_TIMEOUT=30s             # Boşta bağlantının yeniden kullanılabileceği süre
+//	MAIL_TEMPLATE_PATH=resources/views # Şablonlar diskten okunur (boş: binary'ye gömülü kopya)
+//	MAIL_QUEUE=emails                 # Mailer.Queue / SendAsync'in varsayılan kuyruğu
+//
+// HTTP API driver'ları:
+//
+//	AWS_ACCESS_KEY_ID=AKIA...         # ses (SQS ile ortak)
+//	AWS_SECRET_ACCESS_KEY=...
+//	AWS_SESSION_TOKEN=
+//	AWS_DEFAULT_REGION=eu-central-1
+//	SES_CONFIGURATION_SET=            # Event destination'ları (bounce/delivery) için
+//	SES_ENDPOINT=                     # LocalStack vb. için
+//	MAILGUN_DOMAIN=mg.example.com     # mailgun
+//	MAILGUN_SECRET=key-...
+//	MAILGUN_ENDPOINT=https://api.mailgun.net   # EU: https://api.eu.mailgun.net
+//	POSTMARK_TOKEN=...                # postmark
+//	POSTMARK_MESSAGE_STREAM_ID=outbound
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/mail"
+)
+
+// MailConfig, mail gönderim ayarlarıdır.
+type MailConfig struct {
+	Driver       string        // Mail driver: smtp, ses, mailgun, postmark, log, array
+	Host         string        // SMTP host
+	Port         int           // SMTP port
+	Username     string        // SMTP kullanıcı adı
+	Password     string        // SMTP şifre
+	Encryption   string        // starttls, tls, none (boş: otomatik)
+	FromAddress  string        // Gönderici email adresi
+	FromName     string        // Gönderici adı
+	Timeout      time.Duration // Bağlantı ve komut timeout'u
+	MaxIdleConns int           // Havuzdaki boşta bağlantı sayısı (0 = havuz kapalı)
+	IdleTimeout  time.Duration // Boşta bağlantının yeniden kullanılabileceği süre
+	TemplatePath string        // Email şablonlarının disk dizini (boş: gömülü şablonlar)
+	Queue        string        // Mailer.Queue / SendAsync'in varsayılan kuyruğu
+
+	SES      SESMailConfig      // MAIL_DRIVER=ses
+	Mailgun  MailgunMailConfig  // MAIL_DRIVER=mailgun
+	Postmark PostmarkMailConfig // MAIL_DRIVER=postmark
+}
+
+// SESMailConfig, Amazon SES API ayarlarıdır.
+type SESMailConfig struct {
+	Region           string
+	AccessKeyID      string
+	SecretAccessKey  string
+	SessionToken     string
+	ConfigurationSet string
+	Endpoint         string
+}
+
+// MailgunMailConfig, Mailgun API ayarlarıdır.
+type MailgunMailConfig struct {
+	Domain   string
+	Secret   string
+	Endpoint string
+}
+
+// PostmarkMailConfig, Postmark API ayarlarıdır.
+type PostmarkMailConfig struct {
+	Token         string
+	MessageStream string
+}
+
+// SMTP, ayarları mail.SMTPConfig'e çevirir.
+func (m MailConfig) SMTP() *mail.SMTPConfig {
+	maxIdle := m.MaxIdleConns
+	if maxIdle == 0 {
+		maxIdle = -1 // mail paketinde 0 varsayılan demektir, -1 havuzu kapatır
+	}
+
+	return &mail.SMTPConfig{
+		Host:         m.Host,
+		Port:         m.Port,
+		Username:     m.Username,
+		Password:     m.Password,
+		Encryption:   m.Encryption,
+		From:         m.from(),
+		Timeout:      m.Timeout,
+		MaxIdleConns: maxIdle,
+		IdleTimeout:  m.IdleTimeout,
+	}
+}
+
+// from, varsayılan gönderici adresini döndürür.
+func (m MailConfig) from() mail.Address {
+	return mail.Address{Email: m.FromAddress, Name: m.FromName}
+}
+
+// SESConfig, ayarları mail.SESConfig'e çevirir.
+func (m MailConfig) SESConfig() *mail.SESConfig {
+	return &mail.SESConfig{
+		Region:           m.SES.Region,
+		AccessKeyID:      m.SES.AccessKeyID,
+		SecretAccessKey:  m.SES.SecretAccessKey,
+		SessionToken:     m.SES.SessionToken,
+		ConfigurationSet: m.SES.ConfigurationSet,
+		Endpoint:         m.SES.Endpoint,
+		From:             m.from(),
+		Timeout:          m.Timeout,
+	}
+}
+
+// MailgunConfig, ayarları mail.MailgunConfig'e çevirir.
+func (m MailConfig) MailgunConfig() *mail.MailgunConfig {
+	return &mail.MailgunConfig{
+		Domain:   m.Mailgun.Domain,
+		APIKey:   m.Mailgun.Secret,
+		Endpoint: m.Mailgun.Endpoint,
+		From:     m.from(),
+		Timeout:  m.Timeout,
+	}
+}
+
+// PostmarkConfig, ayarları mail.PostmarkConfig'e çevirir.
+func (m MailConfig) PostmarkConfig() *mail.PostmarkConfig {
+	return &mail.PostmarkConfig{
+		Token:         m.Postmark.Token,
+		MessageStream: m.Postmark.MessageStream,
+		From:          m.from(),
+		Timeout:       m.Timeout,
+	}
+}
+
+// loadMail, mail ayarlarını ortam değişkenlerinden okur. Gönderici adı
+// tanımlı değilse uygulama adı kullanılır.
+func loadMail(appName string) MailConfig {
+	return MailConfig{
+		Driver:       storeEnv("MAIL_DRIVER", "smtp"),
+		Host:         storeEnv("MAIL_HOST", "localhost"),
+		Port:         policyInt("MAIL_PORT", 1025),
+		Username:     mailEnv("MAIL_USERNAME"),
+		Password:     mailEnv("MAIL_PASSWORD"),
+		Encryption:   strings.ToLower(mailEnv("MAIL_ENCRYPTION")),
+		FromAddress:  storeEnv("MAIL_FROM_ADDRESS", "noreply@conduit-go.local"),
+		FromName:     storeEnv("MAIL_FROM_NAME", appName),
+		Timeout:      policyDuration("MAIL_TIMEOUT", 30*time.Second),
+		MaxIdleConns: policyInt("MAIL_MAX_IDLE_CONNS", 2),
+		IdleTimeout:  policyDuration("MAIL_IDLE_TIMEOUT", 30*time.Second),
+		TemplatePath: storeEnv("MAIL_TEMPLATE_PATH", ""),
+		Queue:        storeEnv("MAIL_QUEUE", mail.DefaultMailQueue),
+		SES: SESMailConfig{
+			Region:           storeEnv("AWS_DEFAULT_REGION", "us-east-1"),
+			AccessKeyID:      storeEnv("AWS_ACCESS_KEY_ID", ""),
+			SecretAccessKey:  storeEnv("AWS_SECRET_ACCESS_KEY", ""),
+			SessionToken:     storeEnv("AWS_SESSION_TOKEN", ""),
+			ConfigurationSet: storeEnv("SES_CONFIGURATION_SET", ""),
+			Endpoint:         storeEnv("SES_ENDPOINT", ""),
+		},
+		Mailgun: MailgunMailConfig{
+			Domain:   storeEnv("MAILGUN_DOMAIN", ""),
+			Secret:   storeEnv("MAILGUN_SECRET", ""),
+			Endpoint: storeEnv("MAILGUN_ENDPOINT", ""),
+		},
+		Postmark: PostmarkMailConfig{
+			Token:         storeEnv("POSTMARK_TOKEN", ""),
+			MessageStream: storeEnv("POSTMARK_MESSAGE_STREAM_ID", ""),
+		},
+	}
+}
+
+// mailEnv, opsiyonel mail değişkenini okur; .env'deki "null" boş sayılır.
+func mailEnv(key string) string {
+	value := storeEnv(key, "")
+	if strings.EqualFold(value, "null") {
+		return ""
+	}
+	return value
+}
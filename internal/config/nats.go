@@ -0,0 +1,62 @@
+// -----------------------------------------------------------------------------
+// NATS JetStream Configuration
+// -----------------------------------------------------------------------------
+// QUEUE_DRIVER=nats için bağlantı ayarları:
+//
+//	NATS_URL=nats://localhost:4222    # user:pass@ ile kullanıcı/şifre
+//	NATS_TOKEN=                       # Token auth
+//	NATS_STREAM=CONDUIT
+//	NATS_SUBJECT_PREFIX=conduit.jobs
+//	NATS_CONSUMER_GROUP=workers       # Aynı grubu kullanan worker'lar işi paylaşır
+//	NATS_ACK_WAIT=60s
+//	NATS_WAIT_TIME=5s
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/queue"
+)
+
+// NATSConfig, NATS JetStream queue driver ayarlarıdır.
+type NATSConfig struct {
+	URL           string
+	Token         string
+	Stream        string        // JetStream stream adı
+	SubjectPrefix string        // Job subject prefix'i
+	ConsumerGroup string        // Durable consumer prefix'i
+	AckWait       time.Duration // Ack edilmeyen mesajın tekrar teslim süresi
+	WaitTime      time.Duration // Pop için bekleme süresi
+}
+
+// Queue, ayarları queue.NATSConfig'e çevirir.
+//
+// Örnek:
+//
+//	q := queue.NewNATSQueue(cfg.NATS.Queue(), logger)
+func (n NATSConfig) Queue() queue.NATSConfig {
+	return queue.NATSConfig{
+		URL:           n.URL,
+		Token:         n.Token,
+		Stream:        n.Stream,
+		SubjectPrefix: n.SubjectPrefix,
+		Group:         n.ConsumerGroup,
+		AckWait:       n.AckWait,
+		WaitTime:      n.WaitTime,
+	}
+}
+
+// loadNATS, NATS ayarlarını ortam değişkenlerinden okur.
+func loadNATS() NATSConfig {
+	return NATSConfig{
+		URL:           storeEnv("NATS_URL", "nats://localhost:4222"),
+		Token:         storeEnv("NATS_TOKEN", ""),
+		Stream:        storeEnv("NATS_STREAM", "CONDUIT"),
+		SubjectPrefix: storeEnv("NATS_SUBJECT_PREFIX", "conduit.jobs"),
+		ConsumerGroup: storeEnv("NATS_CONSUMER_GROUP", "workers"),
+		AckWait:       policyDuration("NATS_ACK_WAIT", 60*time.Second),
+		WaitTime:      policyDuration("NATS_WAIT_TIME", 5*time.Second),
+	}
+}
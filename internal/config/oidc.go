@@ -0,0 +1,95 @@
+// -----------------------------------------------------------------------------
+// OIDC (SSO) Configuration
+// -----------------------------------------------------------------------------
+// OpenID Connect ile giriş ayarları. OIDC_ISSUER tanımlı değilse SSO
+// kapalıdır:
+//
+//	OIDC_ISSUER=https://dev-123.okta.com/oauth2/default   # Sağlayıcı issuer URL'si
+//	OIDC_CLIENT_ID=0oa...                                   # Client ID
+//	OIDC_CLIENT_SECRET=...                                  # Client secret
+//	OIDC_REDIRECT_URL=http://localhost:8000/api/auth/oidc/callback
+//	OIDC_SCOPES=openid,email,profile                        # İstenen scope'lar
+//	OIDC_EMAIL_CLAIM=email                                  # Azure AD için: preferred_username
+//	OIDC_NAME_CLAIM=name                                    # Görünen ad claim'i
+//	OIDC_REQUIRE_VERIFIED_EMAIL=true                        # email_verified=true zorunlu mu
+//	OIDC_ALLOWED_DOMAINS=example.com,example.org            # Boş: tüm domain'ler
+//	OIDC_AUTO_PROVISION=true                                # İlk girişte kullanıcı oluştur
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+)
+
+// OIDCConfig, OpenID Connect giriş ayarlarıdır.
+type OIDCConfig struct {
+	Issuer       string // Sağlayıcı issuer URL'si (boşsa SSO kapalı)
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string   // Callback URL'si (sağlayıcıda kayıtlı olmalı)
+	Scopes       []string // İstenen scope'lar
+
+	EmailClaim           string   // Email olarak kullanılacak claim
+	NameClaim            string   // Ad olarak kullanılacak claim
+	RequireVerifiedEmail bool     // email_verified=true zorunlu mu
+	AllowedDomains       []string // İzin verilen email domain'leri
+	AutoProvision        bool     // İlk girişte kullanıcı oluşturulsun mu
+}
+
+// Enabled, OIDC girişinin yapılandırılıp yapılandırılmadığını döndürür.
+func (o OIDCConfig) Enabled() bool {
+	return o.Issuer != "" && o.ClientID != ""
+}
+
+// Auth, ayarları auth.OIDCConfig'e çevirir.
+func (o OIDCConfig) Auth() *auth.OIDCConfig {
+	return &auth.OIDCConfig{
+		Issuer:               o.Issuer,
+		ClientID:             o.ClientID,
+		ClientSecret:         o.ClientSecret,
+		RedirectURL:          o.RedirectURL,
+		Scopes:               o.Scopes,
+		EmailClaim:           o.EmailClaim,
+		NameClaim:            o.NameClaim,
+		RequireVerifiedEmail: o.RequireVerifiedEmail,
+		AllowedDomains:       o.AllowedDomains,
+	}
+}
+
+// loadOIDC, OIDC ayarlarını ortam değişkenlerinden okur.
+// Callback URL'si tanımlı değilse uygulama URL'si kullanılır.
+func loadOIDC(appURL string) OIDCConfig {
+	return OIDCConfig{
+		Issuer:               strings.TrimRight(getenv("OIDC_ISSUER"), "/"),
+		ClientID:             getenv("OIDC_CLIENT_ID"),
+		ClientSecret:         getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:          storeEnv("OIDC_REDIRECT_URL", strings.TrimRight(appURL, "/")+"/api/auth/oidc/callback"),
+		Scopes:               splitAndTrim(strings.ReplaceAll(storeEnv("OIDC_SCOPES", "openid,email,profile"), " ", ",")),
+		EmailClaim:           storeEnv("OIDC_EMAIL_CLAIM", "email"),
+		NameClaim:            storeEnv("OIDC_NAME_CLAIM", "name"),
+		RequireVerifiedEmail: oidcBool("OIDC_REQUIRE_VERIFIED_EMAIL", true),
+		AllowedDomains:       splitAndTrim(getenv("OIDC_ALLOWED_DOMAINS")),
+		AutoProvision:        oidcBool("OIDC_AUTO_PROVISION", true),
+	}
+}
+
+// oidcBool, boolean değişkeni okur.
+func oidcBool(key string, defaultValue bool) bool {
+	valueStr := getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		log.Printf("⚠️  Uyarı: %s için geçersiz değer: %s, varsayılan (%t) kullanılıyor.", key, valueStr, defaultValue)
+		invalidEnv(key, valueStr, "boolean (true/false)")
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,32 @@
+// -----------------------------------------------------------------------------
+// Password Reset Configuration
+// -----------------------------------------------------------------------------
+// Şifre sıfırlama ve hesap daveti linklerinin ayarları:
+//
+//	PASSWORD_RESET_URL=http://localhost:3000/reset-password # Token'ın ?token= ile ekleneceği frontend sayfası
+//	INVITE_TTL=72h                                          # Davet (ilk şifre belirleme) linkinin geçerlilik süresi
+//
+// Şifre sıfırlama linkleri 1 saat geçerlidir; davetler kullanıcının email'i
+// görmesi daha uzun sürebileceği için ayrı bir süre kullanır.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+// PasswordResetConfig, şifre sıfırlama ve davet linki ayarlarıdır.
+type PasswordResetConfig struct {
+	URL       string        // Email'deki linkin hedefi (token query'ye eklenir)
+	InviteTTL time.Duration // Davet linkinin geçerlilik süresi
+}
+
+// loadPasswordReset, şifre sıfırlama ayarlarını environment'tan okur.
+func loadPasswordReset(appURL string) PasswordResetConfig {
+	return PasswordResetConfig{
+		URL:       storeEnv("PASSWORD_RESET_URL", strings.TrimRight(appURL, "/")+"/reset-password"),
+		InviteTTL: policyDuration("INVITE_TTL", 72*time.Hour),
+	}
+}
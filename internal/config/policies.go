@@ -0,0 +1,127 @@
+// -----------------------------------------------------------------------------
+// Outbound Policy Configuration
+// -----------------------------------------------------------------------------
+// Dış servis çağrıları (mail, HTTP API, webhook) için timeout, retry ve
+// circuit breaker ayarları. Her policy ortam değişkenleriyle ayarlanır:
+//
+//	POLICY_MAIL_TIMEOUT=10s
+//	POLICY_MAIL_RETRIES=2
+//	POLICY_MAIL_BACKOFF=500ms
+//	POLICY_MAIL_MAX_BACKOFF=5s
+//	POLICY_MAIL_CIRCUIT_THRESHOLD=5
+//	POLICY_MAIL_CIRCUIT_COOLDOWN=30s
+//
+// Yerleşik policy'ler dışında isimler OUTBOUND_POLICIES ile eklenir
+// (örn: OUTBOUND_POLICIES=payment,crm -> POLICY_PAYMENT_TIMEOUT, ...).
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/resilience"
+)
+
+// PolicyConfig, tek bir outbound policy'nin ayarlarıdır.
+type PolicyConfig struct {
+	Timeout          time.Duration // Deneme başına timeout
+	MaxRetries       int           // Ek deneme sayısı
+	Backoff          time.Duration // İlk retry öncesi bekleme (exponential)
+	MaxBackoff       time.Duration // Bekleme üst sınırı
+	CircuitThreshold int           // Art arda kaç hatada circuit açılır (0 = kapalı)
+	CircuitCooldown  time.Duration // Circuit açık kalma süresi
+}
+
+// Options, policy ayarlarını resilience.Options'a çevirir.
+//
+// Örnek:
+//
+//	for name, policy := range cfg.Policies {
+//	    resilience.Register(name, policy.Options())
+//	}
+func (p PolicyConfig) Options() resilience.Options {
+	return resilience.Options{
+		Timeout:          p.Timeout,
+		MaxRetries:       p.MaxRetries,
+		Backoff:          p.Backoff,
+		MaxBackoff:       p.MaxBackoff,
+		CircuitThreshold: p.CircuitThreshold,
+		CircuitCooldown:  p.CircuitCooldown,
+	}
+}
+
+// defaultPolicies, yerleşik policy'lerin varsayılan değerleridir.
+func defaultPolicies() map[string]PolicyConfig {
+	return map[string]PolicyConfig{
+		resilience.PolicyDefault: {Timeout: 10 * time.Second, MaxRetries: 2, Backoff: 200 * time.Millisecond, MaxBackoff: 5 * time.Second, CircuitThreshold: 5, CircuitCooldown: 30 * time.Second},
+		resilience.PolicyMail:    {Timeout: 30 * time.Second, MaxRetries: 2, Backoff: 1 * time.Second, MaxBackoff: 10 * time.Second, CircuitThreshold: 5, CircuitCooldown: 60 * time.Second},
+		resilience.PolicyHTTP:    {Timeout: 10 * time.Second, MaxRetries: 2, Backoff: 200 * time.Millisecond, MaxBackoff: 5 * time.Second, CircuitThreshold: 10, CircuitCooldown: 30 * time.Second},
+		resilience.PolicyWebhook: {Timeout: 5 * time.Second, MaxRetries: 3, Backoff: 1 * time.Second, MaxBackoff: 30 * time.Second, CircuitThreshold: 20, CircuitCooldown: 60 * time.Second},
+	}
+}
+
+// loadPolicies, yerleşik ve OUTBOUND_POLICIES ile tanımlanan policy'leri
+// ortam değişkenlerinden okur.
+//
+// Tanımsız değişkenler sessizce varsayılanı kullanır; geçersiz değerler
+// uyarı loglanarak varsayılana düşer.
+func loadPolicies() map[string]PolicyConfig {
+	policies := defaultPolicies()
+
+	for _, name := range splitAndTrim(getenv("OUTBOUND_POLICIES")) {
+		if _, exists := policies[name]; !exists {
+			policies[name] = policies[resilience.PolicyDefault]
+		}
+	}
+
+	for name, policy := range policies {
+		prefix := "POLICY_" + strings.ToUpper(name) + "_"
+
+		policy.Timeout = policyDuration(prefix+"TIMEOUT", policy.Timeout)
+		policy.MaxRetries = policyInt(prefix+"RETRIES", policy.MaxRetries)
+		policy.Backoff = policyDuration(prefix+"BACKOFF", policy.Backoff)
+		policy.MaxBackoff = policyDuration(prefix+"MAX_BACKOFF", policy.MaxBackoff)
+		policy.CircuitThreshold = policyInt(prefix+"CIRCUIT_THRESHOLD", policy.CircuitThreshold)
+		policy.CircuitCooldown = policyDuration(prefix+"CIRCUIT_COOLDOWN", policy.CircuitCooldown)
+
+		policies[name] = policy
+	}
+
+	return policies
+}
+
+// policyDuration, Go duration formatındaki (10s, 500ms) değişkeni okur.
+func policyDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := time.ParseDuration(valueStr)
+	if err != nil || value < 0 {
+		log.Printf("⚠️  Uyarı: %s için geçersiz süre: %s, varsayılan (%s) kullanılıyor.", key, valueStr, defaultValue)
+		invalidEnv(key, valueStr, "süre (örn: 10s, 500ms)")
+		return defaultValue
+	}
+	return value
+}
+
+// policyInt, negatif olmayan tam sayı değişkenini okur.
+func policyInt(key string, defaultValue int) int {
+	valueStr := getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil || value < 0 {
+		log.Printf("⚠️  Uyarı: %s için geçersiz değer: %s, varsayılan (%d) kullanılıyor.", key, valueStr, defaultValue)
+		invalidEnv(key, valueStr, "sayı (negatif olmayan tam sayı)")
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,108 @@
+// -----------------------------------------------------------------------------
+// Secret References
+// -----------------------------------------------------------------------------
+// Config değerleri düz metin yerine bir secret manager referansı olabilir;
+// referanslar config yüklenirken (uygulama başlarken) çözülür:
+//
+//	DB_DSN=secret://aws-sm/prod/app#db_dsn
+//	JWT_SECRET=vault://secret/app#jwt_secret
+//
+// Yerleşik provider'ların bağlantı ayarları (bunlar referans olamaz):
+//
+//	AWS_ACCESS_KEY_ID=AKIA...       # aws-sm (SQS/SES ile ortak)
+//	AWS_SECRET_ACCESS_KEY=...
+//	AWS_SESSION_TOKEN=
+//	AWS_DEFAULT_REGION=eu-central-1
+//	SECRETS_AWS_ENDPOINT=           # LocalStack vb. için
+//	VAULT_ADDR=https://vault.example.com:8200
+//	VAULT_TOKEN=...
+//	VAULT_NAMESPACE=                # Vault Enterprise
+//	VAULT_KV_VERSION=2              # KV secrets engine sürümü (1 veya 2)
+//	SECRETS_TIMEOUT=10s             # Tek bir secret okuma süresi
+//
+// Bağlantı ayarları ENC(...) ile şifrelenebilir (bkz: encrypted.go).
+//
+// Çözülemeyen referanslar Validate'te hata olur; uygulama secret'sız
+// başlamaz. secrets.Register ile aynı adla kaydedilen resolver'lar
+// yerleşik olanların yerine kullanılır.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/secrets"
+)
+
+// builtinResolvers, config'in kaydettiği yerleşik resolver'lardır.
+// Kullanıcı aynı adla kendi resolver'ını kaydettiyse ezilmez.
+var builtinResolvers = map[string]secrets.Resolver{}
+
+// registerSecretResolvers, yerleşik provider'ları güncel bağlantı
+// ayarlarıyla kaydeder. loadMu tutulurken çağrılır.
+func registerSecretResolvers() {
+	raw := func(key, defaultValue string) string {
+		if value, _ := rawLookupEnv(key); value != "" {
+			return decryptEnv(key, value)
+		}
+		return defaultValue
+	}
+
+	kvVersion, _ := strconv.Atoi(raw("VAULT_KV_VERSION", "2"))
+
+	resolvers := map[string]secrets.Resolver{
+		"aws-sm": secrets.NewAWSSecretsManager(secrets.AWSConfig{
+			Region:          raw("AWS_DEFAULT_REGION", "us-east-1"),
+			AccessKeyID:     raw("AWS_ACCESS_KEY_ID", ""),
+			SecretAccessKey: raw("AWS_SECRET_ACCESS_KEY", ""),
+			SessionToken:    raw("AWS_SESSION_TOKEN", ""),
+			Endpoint:        raw("SECRETS_AWS_ENDPOINT", ""),
+		}),
+		"vault": secrets.NewVault(secrets.VaultConfig{
+			Address:   raw("VAULT_ADDR", ""),
+			Token:     raw("VAULT_TOKEN", ""),
+			Namespace: raw("VAULT_NAMESPACE", ""),
+			KVVersion: kvVersion,
+		}),
+	}
+
+	for name, resolver := range resolvers {
+		current, registered := secrets.Get(name)
+		if registered && current != builtinResolvers[name] {
+			continue
+		}
+		secrets.Register(name, resolver)
+		builtinResolvers[name] = resolver
+	}
+}
+
+// resolveSecret, value bir secret referansıysa çözer. Hata config
+// sorunu olarak kaydedilir ve boş değer döner.
+func resolveSecret(key, value string) string {
+	if _, isRef, _ := secrets.Parse(value); !isRef {
+		return value
+	}
+
+	timeout := 10 * time.Second
+	if raw, _ := rawLookupEnv("SECRETS_TIMEOUT"); raw != "" {
+		if parsed, err := time.ParseDuration(raw); err == nil && parsed > 0 {
+			timeout = parsed
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	secret, err := secrets.Resolve(ctx, value)
+	if err != nil {
+		log.Printf("❌ %s secret'ı çözülemedi: %v", key, err)
+		envIssues = append(envIssues, fmt.Errorf("%s: %w", key, err))
+		return ""
+	}
+	return secret
+}
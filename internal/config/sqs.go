@@ -0,0 +1,67 @@
+// -----------------------------------------------------------------------------
+// Amazon SQS Configuration
+// -----------------------------------------------------------------------------
+// QUEUE_DRIVER=sqs için bağlantı ayarları. Credential'lar standart AWS
+// değişkenlerinden okunur:
+//
+//	AWS_ACCESS_KEY_ID=AKIA...
+//	AWS_SECRET_ACCESS_KEY=...
+//	AWS_SESSION_TOKEN=           # Geçici credential'lar için
+//	AWS_DEFAULT_REGION=eu-central-1
+//	SQS_PREFIX=https://sqs.eu-central-1.amazonaws.com/123456789012
+//	SQS_ENDPOINT=                # LocalStack vb. için (örn: http://localhost:4566)
+//	SQS_VISIBILITY_TIMEOUT=60s
+//	SQS_WAIT_TIME=20s            # Long polling (en fazla 20s)
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/queue"
+)
+
+// SQSConfig, SQS queue driver ayarlarıdır.
+type SQSConfig struct {
+	Region            string
+	AccessKeyID       string
+	SecretAccessKey   string
+	SessionToken      string
+	Prefix            string        // Kuyruk URL prefix'i (account URL'i)
+	Endpoint          string        // API endpoint override'ı
+	VisibilityTimeout time.Duration // Varsayılan visibility timeout
+	WaitTime          time.Duration // Long polling süresi
+}
+
+// Queue, ayarları queue.SQSConfig'e çevirir.
+//
+// Örnek:
+//
+//	q := queue.NewSQSQueue(cfg.SQS.Queue(), logger)
+func (s SQSConfig) Queue() queue.SQSConfig {
+	return queue.SQSConfig{
+		Region:          s.Region,
+		AccessKeyID:     s.AccessKeyID,
+		SecretAccessKey: s.SecretAccessKey,
+		SessionToken:    s.SessionToken,
+		Prefix:          s.Prefix,
+		Endpoint:        s.Endpoint,
+		Visibility:      s.VisibilityTimeout,
+		WaitTime:        s.WaitTime,
+	}
+}
+
+// loadSQS, SQS ayarlarını ortam değişkenlerinden okur.
+func loadSQS() SQSConfig {
+	return SQSConfig{
+		Region:            storeEnv("AWS_DEFAULT_REGION", "us-east-1"),
+		AccessKeyID:       storeEnv("AWS_ACCESS_KEY_ID", ""),
+		SecretAccessKey:   storeEnv("AWS_SECRET_ACCESS_KEY", ""),
+		SessionToken:      storeEnv("AWS_SESSION_TOKEN", ""),
+		Prefix:            storeEnv("SQS_PREFIX", ""),
+		Endpoint:          storeEnv("SQS_ENDPOINT", ""),
+		VisibilityTimeout: policyDuration("SQS_VISIBILITY_TIMEOUT", 60*time.Second),
+		WaitTime:          policyDuration("SQS_WAIT_TIME", 20*time.Second),
+	}
+}
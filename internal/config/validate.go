@@ -0,0 +1,99 @@
+// -----------------------------------------------------------------------------
+// Config Validation
+// -----------------------------------------------------------------------------
+// Uygulama başlarken config'in eksik veya hatalı olup olmadığını tek seferde
+// raporlar. LoadFromEnv iki tür sorunu kaydeder:
+//
+//   - Ortam için zorunlu olup tanımlanmamış değişkenler (production:
+//     APP_URL, DB_DSN, JWT_SECRET; RequireEnv ile genişletilir)
+//   - Sayı, boolean veya süre olarak okunamayan değerler (örn: PORT=abc,
+//     HTTP_TIMEOUT=10 saniye)
+//
+// Bu değerler varsayılana düşse de Validate hata döndürür; böylece
+// production'da sessizce güvensiz varsayılanlarla çalışılmaz. LoadConfig,
+// cmd/api ve cmd/worker'ın başlangıçta kullandığı fail-fast yoludur.
+// -----------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ValidationError, config doğrulamasında bulunan tüm hataları taşır.
+// errors.Is/As her bir hataya ulaşabilir.
+type ValidationError struct {
+	Errors []error
+}
+
+// Error, hataları madde madde listeler.
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "config geçersiz (%d hata):", len(e.Errors))
+	for _, err := range e.Errors {
+		b.WriteString("\n  - ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap, errors.Is/As için alt hataları döndürür.
+func (e *ValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+var (
+	requiredMu  sync.RWMutex
+	requiredEnv = map[string][]string{
+		"production": {"APP_URL", "DB_DSN", "JWT_SECRET"},
+	}
+)
+
+// RequireEnv, verilen ortamda tanımlı olması zorunlu değişkenler ekler.
+// LoadFromEnv'den önce (genellikle main'in başında) çağrılmalıdır.
+//
+// Örnek:
+//
+//	config.RequireEnv("production", "APP_KEY", "REDIS_PASSWORD")
+//	cfg, err := config.LoadConfig()
+func RequireEnv(env string, keys ...string) {
+	requiredMu.Lock()
+	defer requiredMu.Unlock()
+
+	requiredEnv[env] = append(requiredEnv[env], keys...)
+}
+
+// RequiredEnv, ortam için zorunlu değişkenlerin listesini döndürür.
+func RequiredEnv(env string) []string {
+	requiredMu.RLock()
+	defer requiredMu.RUnlock()
+
+	return append([]string(nil), requiredEnv[env]...)
+}
+
+// missingRequiredEnv, ortam için zorunlu olup boş olan değişkenleri
+// hata olarak döndürür.
+func missingRequiredEnv(env string) []error {
+	var errs []error
+	for _, key := range RequiredEnv(env) {
+		if strings.TrimSpace(getenv(key)) == "" {
+			errs = append(errs, fmt.Errorf("%s ortamında %s zorunlu", env, key))
+		}
+	}
+	return errs
+}
+
+// envIssues, LoadFromEnv sırasında okunamayan değerleri toplar. loadMu,
+// aynı anda birden fazla LoadFromEnv'in sorunlarının karışmasını önler.
+var (
+	loadMu    sync.Mutex
+	envIssues []error
+)
+
+// invalidEnv, key'in değerinin beklenen tipte okunamadığını kaydeder.
+// Sadece LoadFromEnv (loadMu tutulurken) içinden çağrılır.
+func invalidEnv(key, value, expected string) {
+	envIssues = append(envIssues, fmt.Errorf("%s geçersiz %s: %q", key, expected, value))
+}
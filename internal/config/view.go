@@ -0,0 +1,24 @@
+// -----------------------------------------------------------------------------
+// View Configuration
+// -----------------------------------------------------------------------------
+// Sunucu tarafında render edilen HTML sayfalarının (pkg/view) ayarları:
+//
+//	VIEW_PATH=resources/views # View'lar diskten okunur (boş: binary'ye gömülü kopya)
+//
+// VIEW_PATH verildiğinde development ortamında view'lar her render'da
+// yeniden okunur (hot reload); diğer ortamlarda derlenmiş şablonlar cache'lenir.
+// -----------------------------------------------------------------------------
+
+package config
+
+// ViewConfig, HTML view ayarlarıdır.
+type ViewConfig struct {
+	Path string // View'ların disk dizini (boş: gömülü view'lar)
+}
+
+// loadView, view ayarlarını ortam değişkenlerinden okur.
+func loadView() ViewConfig {
+	return ViewConfig{
+		Path: storeEnv("VIEW_PATH", ""),
+	}
+}
@@ -0,0 +1,327 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseYAML, config dosyaları için YAML'ın bir alt kümesini parse eder.
+//
+// Harici bağımlılık olmadan, config dosyalarında kullanılan yapılar için
+// yazılmış minimal bir implementasyondur:
+//   - Blok map'ler (key: value, key: + girintili blok)
+//   - Blok listeler (- eleman) ve flow listeler ([a, b, "c"])
+//   - Düz, tek tırnaklı ve çift tırnaklı scalar'lar, null / ~
+//   - # yorumları, --- doküman ayracı (tek doküman)
+//
+// Anchor/alias, flow map ({a: 1}) ve blok scalar'lar (|, >) desteklenmez;
+// bunlarla karşılaşıldığında satır numarasıyla hata döner. Scalar'lar
+// string olarak döner (tipler ortam değişkenlerinde olduğu gibi okunurken
+// çözülür); map'ler map[string]any, listeler []any olur.
+func parseYAML(data []byte) (map[string]any, error) {
+	var lines []yamlLine
+	for i, raw := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		body := strings.TrimLeft(raw, " ")
+		if strings.HasPrefix(body, "\t") {
+			return nil, fmt.Errorf("satır %d: girintide tab kullanılamaz", i+1)
+		}
+		text := stripYAMLComment(body)
+		if text == "" || text == "---" || text == "..." {
+			continue
+		}
+		lines = append(lines, yamlLine{indent: len(raw) - len(body), text: text, num: i + 1})
+	}
+
+	if len(lines) == 0 {
+		return map[string]any{}, nil
+	}
+
+	p := &yamlParser{lines: lines}
+	node, err := p.parseBlock(lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(lines) {
+		return nil, fmt.Errorf("satır %d: beklenmeyen girinti", lines[p.pos].num)
+	}
+
+	root, ok := node.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("satır %d: dosya kökü bir map olmalı", lines[0].num)
+	}
+	return root, nil
+}
+
+// yamlLine, yorumları ve boşlukları atılmış tek bir YAML satırıdır.
+type yamlLine struct {
+	indent int
+	text   string
+	num    int
+}
+
+// yamlParser, satırları sırayla tüketen recursive descent parser'dır.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseBlock, indent seviyesindeki map veya listeyi parse eder.
+func (p *yamlParser) parseBlock(indent int) (any, error) {
+	if isYAMLSeqItem(p.lines[p.pos].text) {
+		return p.parseSeq(indent)
+	}
+	return p.parseMap(indent)
+}
+
+// parseMap, aynı girintideki key: value satırlarını okur.
+func (p *yamlParser) parseMap(indent int) (map[string]any, error) {
+	m := make(map[string]any)
+
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("satır %d: beklenmeyen girinti", line.num)
+		}
+		if isYAMLSeqItem(line.text) {
+			return nil, fmt.Errorf("satır %d: map içinde liste elemanı", line.num)
+		}
+
+		key, rest, ok := splitYAMLKey(line.text)
+		if !ok {
+			return nil, fmt.Errorf("satır %d: \"key: value\" bekleniyor: %s", line.num, line.text)
+		}
+		if _, exists := m[key]; exists {
+			return nil, fmt.Errorf("satır %d: %s key'i tekrar tanımlanmış", line.num, key)
+		}
+		p.pos++
+
+		if rest != "" {
+			value, err := parseYAMLScalar(rest)
+			if err != nil {
+				return nil, fmt.Errorf("satır %d: %w", line.num, err)
+			}
+			m[key] = value
+			continue
+		}
+
+		// Değer bir alt blok: daha girintili satırlar veya aynı girintide liste
+		if p.pos < len(p.lines) {
+			next := p.lines[p.pos]
+			if next.indent > indent || (next.indent == indent && isYAMLSeqItem(next.text)) {
+				child, err := p.parseBlock(next.indent)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = child
+				continue
+			}
+		}
+		m[key] = nil
+	}
+
+	return m, nil
+}
+
+// parseSeq, aynı girintideki "- eleman" satırlarını okur.
+func (p *yamlParser) parseSeq(indent int) ([]any, error) {
+	var items []any
+
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent != indent || !isYAMLSeqItem(line.text) {
+			if line.indent > indent {
+				return nil, fmt.Errorf("satır %d: beklenmeyen girinti", line.num)
+			}
+			break
+		}
+
+		item := strings.TrimLeft(line.text[1:], " ")
+		if item == "" {
+			p.pos++
+			if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+				child, err := p.parseBlock(p.lines[p.pos].indent)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, child)
+				continue
+			}
+			items = append(items, nil)
+			continue
+		}
+
+		// "- key: value": eleman, "-"den sonraki sütunda başlayan bir map
+		if _, _, ok := splitYAMLKey(item); ok && !strings.HasPrefix(item, "[") {
+			p.lines[p.pos] = yamlLine{indent: indent + len(line.text) - len(item), text: item, num: line.num}
+			child, err := p.parseMap(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, child)
+			continue
+		}
+
+		value, err := parseYAMLScalar(item)
+		if err != nil {
+			return nil, fmt.Errorf("satır %d: %w", line.num, err)
+		}
+		items = append(items, value)
+		p.pos++
+	}
+
+	return items, nil
+}
+
+// isYAMLSeqItem, satırın bir liste elemanı olup olmadığını döndürür.
+func isYAMLSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitYAMLKey, "key: value" satırını tırnak dışındaki ilk ": " ayracından
+// böler. Tırnaklı key'ler açılır.
+func splitYAMLKey(text string) (key, rest string, ok bool) {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 {
+				quote = c
+			}
+		case c == ':' && (i+1 == len(text) || text[i+1] == ' '):
+			key = strings.TrimSpace(text[:i])
+			if key == "" {
+				return "", "", false
+			}
+			if key[0] == '"' || key[0] == '\'' {
+				unquoted, err := parseYAMLScalar(key)
+				if err != nil {
+					return "", "", false
+				}
+				key, _ = unquoted.(string)
+			}
+			return key, strings.TrimSpace(text[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+// parseYAMLScalar, tek satırlık bir değeri (scalar veya flow liste) parse eder.
+func parseYAMLScalar(s string) (any, error) {
+	switch {
+	case s == "~" || s == "null" || s == "Null" || s == "NULL":
+		return nil, nil
+
+	case s[0] == '"':
+		value, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz çift tırnaklı değer: %s", s)
+		}
+		return value, nil
+
+	case s[0] == '\'':
+		if len(s) < 2 || s[len(s)-1] != '\'' {
+			return nil, fmt.Errorf("kapanmamış tek tırnak: %s", s)
+		}
+		inner := s[1 : len(s)-1]
+		if strings.Contains(strings.ReplaceAll(inner, "''", ""), "'") {
+			return nil, fmt.Errorf("geçersiz tek tırnaklı değer: %s", s)
+		}
+		return strings.ReplaceAll(inner, "''", "'"), nil
+
+	case s[0] == '[':
+		if s[len(s)-1] != ']' {
+			return nil, fmt.Errorf("kapanmamış liste: %s", s)
+		}
+		return parseYAMLFlowSeq(s[1 : len(s)-1])
+
+	case s[0] == '{':
+		return nil, fmt.Errorf("flow map desteklenmiyor, blok map kullanın: %s", s)
+
+	case s[0] == '|' || s[0] == '>':
+		return nil, fmt.Errorf("blok scalar desteklenmiyor, tırnaklı değer kullanın: %s", s)
+
+	case s[0] == '&' || s[0] == '*':
+		return nil, fmt.Errorf("anchor/alias desteklenmiyor: %s", s)
+	}
+	return s, nil
+}
+
+// parseYAMLFlowSeq, [a, "b, c", d] listesinin içini virgüllerden böler.
+func parseYAMLFlowSeq(s string) ([]any, error) {
+	items := []any{}
+	if strings.TrimSpace(s) == "" {
+		return items, nil
+	}
+
+	var quote byte
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) {
+			c := s[i]
+			if quote != 0 {
+				if c == '\\' && quote == '"' {
+					i++
+				} else if c == quote {
+					quote = 0
+				}
+				continue
+			}
+			if c == '"' || c == '\'' {
+				quote = c
+				continue
+			}
+			if c == '[' {
+				return nil, fmt.Errorf("iç içe liste desteklenmiyor: [%s]", s)
+			}
+			if c != ',' {
+				continue
+			}
+		}
+
+		part := strings.TrimSpace(s[start:i])
+		if part == "" {
+			return nil, fmt.Errorf("boş liste elemanı: [%s]", s)
+		}
+		value, err := parseYAMLScalar(part)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, value)
+		start = i + 1
+	}
+	return items, nil
+}
+
+// stripYAMLComment, tırnak dışındaki " #" yorumunu ve sondaki boşlukları atar.
+func stripYAMLComment(text string) string {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.IndexByte(" [,:-", text[i-1]) >= 0 {
+				quote = c
+			}
+		case c == '#' && (i == 0 || text[i-1] == ' '):
+			return strings.TrimRight(text[:i], " ")
+		}
+	}
+	return strings.TrimRight(text, " ")
+}
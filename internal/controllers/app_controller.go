@@ -15,6 +15,8 @@ import (
 	"github.com/biyonik/conduit-go/pkg/cache"
 	"github.com/biyonik/conduit-go/pkg/container"
 	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/version"
 )
 
 // AppController, temel uygulama endpoint'lerini yönetir.
@@ -24,6 +26,7 @@ type AppController struct {
 	Grammar database.Grammar
 	Config  *config.Config
 	Cache   cache.Cache // Phase 3
+	Queue   queue.Queue // Opsiyonel; varsa health çıktısında queue durumu
 	AppName string
 }
 
@@ -41,30 +44,40 @@ func NewAppController(c *container.Container) (*AppController, error) {
 	cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
 	cacheDriver := c.MustGet(reflect.TypeOf((*cache.Cache)(nil)).Elem()).(cache.Cache)
 
+	var q queue.Queue
+	if resolved, err := c.Get(reflect.TypeOf((*queue.Queue)(nil)).Elem()); err == nil {
+		q = resolved.(queue.Queue)
+	}
+
 	return &AppController{
 		Logger:  logger,
 		DB:      db,
 		Grammar: grammar,
 		Config:  cfg,
 		Cache:   cacheDriver,
+		Queue:   q,
 		AppName: "Conduit Go",
 	}, nil
 }
 
-// HomeHandler, ana sayfa handler'ı.
+// HomeHandler, ana sayfa handler'ı. JSON istemeyen client'lara "welcome"
+// view'ı render edilir.
 func (ac *AppController) HomeHandler(w http.ResponseWriter, r *conduitReq.Request) {
 	if r.IsJSON() {
 		conduitRes.Success(w, 200, "JSON istediniz, JSON geldi!", nil)
 		return
 	}
-	fmt.Fprintf(w, "Merhaba! Burası %s, Adres: %s", ac.AppName, r.URL.Path)
+	if err := conduitRes.View(w, r.Request, "welcome", nil); err != nil {
+		ac.Logger.Printf("❌ Welcome view render edilemedi: %v", err)
+	}
 }
 
 // HealthHandler, sistem sağlık kontrolü endpoint'i.
 func (ac *AppController) HealthHandler(w http.ResponseWriter, r *conduitReq.Request) {
 	healthData := map[string]interface{}{
 		"status":  "healthy",
-		"version": "1.0.0-phase3",
+		"version": version.Version,
+		"build":   version.Get(),
 		"env":     ac.Config.App.Env,
 	}
 
@@ -87,6 +100,17 @@ func (ac *AppController) HealthHandler(w http.ResponseWriter, r *conduitReq.Requ
 		ac.Cache.Delete(testKey)
 	}
 
+	// Queue check (derinlik, en eski job, worker sayısı)
+	if ac.Queue != nil {
+		healthData["queue_driver"] = ac.Config.Queue.Driver
+		if stats, err := queue.Stats(ac.Queue, ac.Config.Queue.Default); err != nil {
+			ac.Logger.Printf("Health check: queue stats error: %v", err)
+			healthData["queue"] = "error"
+		} else {
+			healthData["queue"] = stats
+		}
+	}
+
 	conduitRes.Success(w, 200, healthData, nil)
 }
 
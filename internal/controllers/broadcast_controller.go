@@ -0,0 +1,175 @@
+// -----------------------------------------------------------------------------
+// Broadcast Controller (Server-Sent Events)
+// -----------------------------------------------------------------------------
+// Tarayıcıların ShouldBroadcast event'lerini gerçek zamanlı almasını sağlar.
+// Client, EventSource ile kanallara abone olur:
+//
+//	GET /broadcasting/events?channels=orders,private-users.42
+//
+//	const source = new EventSource("/broadcasting/events?channels=orders")
+//	source.addEventListener("order.shipped", (e) => {
+//	    const { channel, data } = JSON.parse(e.data)
+//	})
+//
+// Private kanallar için istek Authorization header'ı taşımalıdır (rota
+// OptionalAuth kullanır); yetki Hub.Channel callback'leriyle kontrol edilir.
+// Hub transport'tan bağımsızdır; WebSocket handler'ları da Subscribe ile
+// aynı aboneliklere erişebilir.
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/broadcast"
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+// MaxBroadcastChannels, tek bağlantıda abone olunabilecek kanal sayısıdır.
+const MaxBroadcastChannels = 20
+
+// BroadcastController, SSE broadcast endpoint'ini yönetir.
+type BroadcastController struct {
+	Logger    *log.Logger
+	Hub       *broadcast.Hub
+	Heartbeat time.Duration // Ping aralığı (0: ping gönderilmez)
+}
+
+// NewBroadcastController, DI Container için fabrika fonksiyonu.
+func NewBroadcastController(c *container.Container) (*BroadcastController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
+	hub := c.MustGet(reflect.TypeOf((*broadcast.Hub)(nil))).(*broadcast.Hub)
+
+	return &BroadcastController{
+		Logger:    logger,
+		Hub:       hub,
+		Heartbeat: cfg.Broadcast.Heartbeat,
+	}, nil
+}
+
+// Events, istenen kanallara abone olur ve mesajları SSE olarak yayınlar.
+//
+// Her mesaj event adıyla ("event: order.shipped") ve
+// {"channel": "...", "data": ...} gövdesiyle gönderilir. Bağlantı client
+// kapatana veya sunucu kapanana kadar açık kalır.
+//
+// Hatalar:
+//   - 400: channels parametresi boş veya çok fazla kanal
+//   - 401: Guest kullanıcı private kanala abone olmak istedi
+//   - 403: Kullanıcının private kanala yetkisi yok
+func (bc *BroadcastController) Events(w http.ResponseWriter, r *conduitReq.Request) {
+	channels := parseChannels(r.Query("channels", ""))
+	if len(channels) == 0 {
+		conduitRes.BadRequest(w, "En az bir kanal belirtilmelidir (?channels=...)")
+		return
+	}
+	if len(channels) > MaxBroadcastChannels {
+		conduitRes.BadRequest(w, fmt.Sprintf("En fazla %d kanala abone olunabilir", MaxBroadcastChannels))
+		return
+	}
+
+	var user auth.User
+	if authUser, err := r.AuthUser(); err == nil {
+		user = authUser
+	}
+	for _, channel := range channels {
+		if err := bc.Hub.Authorize(user, channel); err != nil {
+			if user == nil {
+				conduitRes.Unauthorized(w, "Private kanallar için giriş yapılmalıdır")
+			} else {
+				conduitRes.Forbidden(w, "Bu kanala abone olma yetkiniz yok: "+channel)
+			}
+			return
+		}
+	}
+
+	// Stream, sunucunun WriteTimeout'u ile kesilmemeli
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		bc.Logger.Printf("⚠️  Broadcast stream write deadline: %v", err)
+	}
+
+	sub := bc.Hub.Subscribe(channels...)
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // nginx buffering'i kapat
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, ": subscribed %s\n\n", strings.Join(channels, ","))
+	if err := controller.Flush(); err != nil {
+		bc.Logger.Printf("❌ Broadcast stream flush error: %v", err)
+		return
+	}
+
+	var heartbeat <-chan time.Time
+	if bc.Heartbeat > 0 {
+		ticker := time.NewTicker(bc.Heartbeat)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-heartbeat:
+			fmt.Fprint(w, ": ping\n\n")
+
+		case message, ok := <-sub.Messages():
+			if !ok {
+				return // Hub kapatıldı (graceful shutdown)
+			}
+
+			body, err := json.Marshal(map[string]interface{}{
+				"channel": message.Channel,
+				"data":    message.Data,
+			})
+			if err != nil {
+				bc.Logger.Printf("⚠️  Broadcast message encode error (%s): %v", message.Event, err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", sseField(message.Event), body)
+		}
+
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// parseChannels, virgülle ayrılmış kanal listesini tekilleştirerek döndürür.
+func parseChannels(value string) []string {
+	seen := make(map[string]bool)
+	var channels []string
+	for _, channel := range strings.Split(value, ",") {
+		channel = strings.TrimSpace(channel)
+		if channel == "" || seen[channel] {
+			continue
+		}
+		seen[channel] = true
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// sseField, SSE alanını bozacak satır sonlarını temizler.
+func sseField(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
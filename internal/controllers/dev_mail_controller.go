@@ -0,0 +1,263 @@
+// -----------------------------------------------------------------------------
+// Development Mail Preview
+// -----------------------------------------------------------------------------
+// Log veya array mail driver'ının yakaladığı mesajları tarayıcıda listeler ve
+// HTML gövdelerini render edilmiş olarak gösterir. Tasarımcılar şablonları
+// gerçek email göndermeden deneyebilir.
+//
+// Rotalar sadece development ortamında ve MAIL_DRIVER=log/array iken
+// kaydedilir:
+//
+//	GET    /dev/mail            → Mesaj listesi + seçili mesajın önizlemesi
+//	GET    /dev/mail/{id}/html  → HTML gövde (sandbox iframe içinde gösterilir)
+//	GET    /dev/mail/{id}/text  → Düz metin gövde
+//	DELETE /dev/mail            → Yakalanan mesajları temizler
+//
+// Accept: application/json ile /dev/mail mesaj listesini JSON döndürür.
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/mail"
+)
+
+// DevMailController, development mail önizleme endpoint'lerini yönetir.
+type DevMailController struct {
+	Logger  *log.Logger
+	Mailbox mail.Mailbox
+}
+
+// NewDevMailController, DI Container için fabrika fonksiyonu.
+//
+// Container'daki mailer mesajları saklamıyorsa (smtp, ses...) hata döner.
+func NewDevMailController(c *container.Container) (*DevMailController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	mailer := c.MustGet(reflect.TypeOf((*mail.Mailer)(nil)).Elem()).(mail.Mailer)
+
+	mailbox, ok := mailer.(mail.Mailbox)
+	if !ok {
+		return nil, fmt.Errorf("mail önizlemesi için log veya array driver gerekir (%T)", mailer)
+	}
+
+	return &DevMailController{
+		Logger:  logger,
+		Mailbox: mailbox,
+	}, nil
+}
+
+// devMailSummary, listede gösterilen mesaj özetidir.
+type devMailSummary struct {
+	ID          string    `json:"id"`
+	From        string    `json:"from"`
+	To          []string  `json:"to"`
+	Cc          []string  `json:"cc,omitempty"`
+	Subject     string    `json:"subject"`
+	Date        time.Time `json:"date"`
+	Queue       string    `json:"queue,omitempty"` // Sadece array driver'ın kuyruğa aldıkları
+	HasHTML     bool      `json:"has_html"`
+	Attachments int       `json:"attachments"`
+
+	message *mail.Message
+}
+
+// Index, yakalanan mesajları listeler (yeniden eskiye).
+//
+// Tarayıcıda ?id= ile seçilen (varsayılan: en yeni) mesajın önizlemesini
+// gösteren HTML sayfası, Accept: application/json ile mesaj listesi döner.
+func (dc *DevMailController) Index(w http.ResponseWriter, r *conduitReq.Request) {
+	messages := dc.summaries()
+
+	accept := r.Header.Get("Accept")
+	if strings.Contains(accept, "application/json") && !strings.Contains(accept, "text/html") {
+		conduitRes.Success(w, 200, messages, map[string]int{"total": len(messages)})
+		return
+	}
+
+	page := devMailPage{Messages: messages}
+	selected := r.Query("id", "")
+	for i := range messages {
+		if messages[i].ID == selected || (selected == "" && i == 0) {
+			page.Selected = &messages[i]
+			page.Text = messages[i].message.GetBody()
+			break
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := devMailTemplate.Execute(w, page); err != nil {
+		dc.Logger.Printf("❌ Mail preview render error: %v", err)
+	}
+}
+
+// HTML, mesajın HTML gövdesini döndürür. HTML gövde yoksa düz metin
+// gövde <pre> içinde gösterilir.
+//
+// Gövde uygulama origin'inde script çalıştıramasın diye CSP sandbox ile
+// servis edilir.
+func (dc *DevMailController) HTML(w http.ResponseWriter, r *conduitReq.Request) {
+	message := dc.find(r.RouteParam("id"))
+	if message == nil {
+		conduitRes.NotFound(w, "Mesaj bulunamadı")
+		return
+	}
+
+	body := message.GetHtmlBody()
+	if body == "" {
+		body = "<pre>" + template.HTMLEscapeString(message.GetBody()) + "</pre>"
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write([]byte(body))
+}
+
+// Text, mesajın düz metin gövdesini döndürür.
+func (dc *DevMailController) Text(w http.ResponseWriter, r *conduitReq.Request) {
+	message := dc.find(r.RouteParam("id"))
+	if message == nil {
+		conduitRes.NotFound(w, "Mesaj bulunamadı")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write([]byte(message.GetBody()))
+}
+
+// Clear, yakalanan tüm mesajları siler.
+func (dc *DevMailController) Clear(w http.ResponseWriter, r *conduitReq.Request) {
+	dc.Mailbox.Flush()
+	dc.Logger.Println("🗑️  Mail preview temizlendi")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// summaries, gönderilen (ve array driver'da kuyruğa alınan) mesajları
+// yeniden eskiye sıralı döndürür.
+func (dc *DevMailController) summaries() []devMailSummary {
+	var summaries []devMailSummary
+
+	add := func(message *mail.Message, queueName string) {
+		summaries = append(summaries, devMailSummary{
+			ID:          message.GetID(),
+			From:        message.GetFrom().String(),
+			To:          addressStrings(message.GetTo()),
+			Cc:          addressStrings(message.GetCc()),
+			Subject:     message.GetSubject(),
+			Date:        message.GetDate(),
+			Queue:       queueName,
+			HasHTML:     message.GetHtmlBody() != "",
+			Attachments: len(message.GetAttachments()),
+			message:     message,
+		})
+	}
+
+	for _, message := range dc.Mailbox.Messages() {
+		add(message, "")
+	}
+	if array, ok := dc.Mailbox.(*mail.ArrayMailer); ok {
+		for _, queued := range array.Queued() {
+			add(queued.Message, queued.Queue)
+		}
+	}
+
+	// Gönderim sırasını ters çevir: en yeni mesaj üstte
+	for i, j := 0, len(summaries)-1; i < j; i, j = i+1, j-1 {
+		summaries[i], summaries[j] = summaries[j], summaries[i]
+	}
+	return summaries
+}
+
+// find, ID'si verilen mesajı döndürür (yoksa nil).
+func (dc *DevMailController) find(id string) *mail.Message {
+	for _, summary := range dc.summaries() {
+		if summary.ID == id {
+			return summary.message
+		}
+	}
+	return nil
+}
+
+// addressStrings, adresleri "Ad <email>" biçiminde döndürür.
+func addressStrings(addresses []mail.Address) []string {
+	result := make([]string, len(addresses))
+	for i, address := range addresses {
+		result[i] = address.String()
+	}
+	return result
+}
+
+// devMailPage, önizleme sayfasının template verisidir.
+type devMailPage struct {
+	Messages []devMailSummary
+	Selected *devMailSummary
+	Text     string
+}
+
+// devMailTemplate, önizleme sayfasıdır. Mesaj HTML'i sayfaya gömülmez;
+// sandbox iframe'de /dev/mail/{id}/html adresinden yüklenir.
+var devMailTemplate = template.Must(template.New("dev-mail").Funcs(template.FuncMap{
+	"join": strings.Join,
+}).Parse(`<!DOCTYPE html>
+<html lang="tr">
+<head>
+<meta charset="UTF-8">
+<title>Mail Preview</title>
+<style>
+body { margin: 0; font-family: -apple-system, "Segoe UI", Roboto, sans-serif; font-size: 14px; color: #1f2933; display: flex; height: 100vh; }
+aside { width: 340px; border-right: 1px solid #d9dee3; overflow-y: auto; background: #f7f8fa; }
+aside header { display: flex; justify-content: space-between; align-items: center; padding: 12px 16px; border-bottom: 1px solid #d9dee3; }
+aside a { display: block; padding: 10px 16px; border-bottom: 1px solid #e4e7eb; color: inherit; text-decoration: none; }
+aside a.active { background: #e3ecfa; }
+aside small { color: #616e7c; display: block; }
+main { flex: 1; display: flex; flex-direction: column; min-width: 0; }
+main dl { margin: 0; padding: 12px 16px; border-bottom: 1px solid #d9dee3; display: grid; grid-template-columns: 80px 1fr; gap: 4px 8px; }
+main dt { color: #616e7c; }
+main dd { margin: 0; }
+iframe { flex: 1; border: 0; width: 100%; }
+details { border-top: 1px solid #d9dee3; padding: 8px 16px; max-height: 30vh; overflow: auto; }
+.empty { padding: 24px 16px; color: #616e7c; }
+.badge { font-size: 11px; background: #fce8b2; padding: 1px 6px; border-radius: 8px; }
+</style>
+</head>
+<body>
+<aside>
+<header><strong>📧 {{len .Messages}} mesaj</strong>{{if .Messages}}<button onclick="fetch('/dev/mail', {method: 'DELETE'}).then(() => location.href = '/dev/mail')">Temizle</button>{{end}}</header>
+{{range .Messages}}<a href="/dev/mail?id={{.ID}}"{{if and $.Selected (eq .ID $.Selected.ID)}} class="active"{{end}}>
+<strong>{{if .Subject}}{{.Subject}}{{else}}(konu yok){{end}}</strong>
+<small>{{join .To ", "}}</small>
+<small>{{.Date.Format "2006-01-02 15:04:05"}}{{if .Queue}} <span class="badge">kuyruk: {{.Queue}}</span>{{end}}</small>
+</a>
+{{else}}<div class="empty">Henüz email gönderilmedi.</div>
+{{end}}
+</aside>
+<main>
+{{with .Selected}}<dl>
+<dt>From</dt><dd>{{.From}}</dd>
+<dt>To</dt><dd>{{join .To ", "}}</dd>
+{{if .Cc}}<dt>Cc</dt><dd>{{join .Cc ", "}}</dd>{{end}}
+<dt>Subject</dt><dd>{{.Subject}}</dd>
+<dt>Date</dt><dd>{{.Date.Format "2006-01-02 15:04:05 -0700"}}</dd>
+{{if .Attachments}}<dt>Ekler</dt><dd>{{.Attachments}}</dd>{{end}}
+<dt>ID</dt><dd><code>{{.ID}}</code> · <a href="/dev/mail/{{.ID}}/html" target="_blank">HTML</a> · <a href="/dev/mail/{{.ID}}/text" target="_blank">Text</a></dd>
+</dl>
+<iframe sandbox src="/dev/mail/{{.ID}}/html" title="Önizleme"></iframe>
+{{if $.Text}}<details><summary>Düz metin</summary><pre>{{$.Text}}</pre></details>{{end}}
+{{else}}<div class="empty">Önizlemek için bir mesaj seçin.</div>
+{{end}}</main>
+</body>
+</html>
+`))
@@ -0,0 +1,214 @@
+// -----------------------------------------------------------------------------
+// Event Store Controller
+// -----------------------------------------------------------------------------
+// Kayıtlı event'leri listeler ve bir aralığı tekrar dispatch eder. Rotalar
+// sadece EVENT_STORE_ENABLED=true iken admin grubuna kaydedilir:
+//
+//	GET  /api/admin/events?event=order.placed&from_id=100&limit=50
+//	POST /api/admin/events/replay
+//	     {"from_id": 100, "to_id": 250, "events": ["order.placed"]}
+//
+// Replay projection'ları yeniden oluşturmak içindir; listener'lar
+// events.IsReplay ile yan etkilerini (email, webhook) atlamalıdır.
+// "dry_run": true ile hangi kayıtların oynatılacağı dispatch etmeden görülür.
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+const (
+	// DefaultEventListLimit, listede varsayılan kayıt sayısıdır.
+	DefaultEventListLimit = 50
+
+	// MaxEventListLimit, listede ve dry run'da dönen en fazla kayıt sayısıdır.
+	MaxEventListLimit = 500
+)
+
+// EventStoreController, event store admin endpoint'lerini yönetir.
+type EventStoreController struct {
+	Logger     *log.Logger
+	Store      events.Store
+	Dispatcher *events.Dispatcher
+}
+
+// NewEventStoreController, DI Container için fabrika fonksiyonu.
+func NewEventStoreController(c *container.Container) (*EventStoreController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	dispatcher := c.MustGet(reflect.TypeOf((*events.Dispatcher)(nil))).(*events.Dispatcher)
+
+	store, err := c.Get(reflect.TypeOf((*events.Store)(nil)).Elem())
+	if err != nil {
+		return nil, fmt.Errorf("event store çözülemedi: %w", err)
+	}
+
+	return &EventStoreController{
+		Logger:     logger,
+		Store:      store.(events.Store),
+		Dispatcher: dispatcher,
+	}, nil
+}
+
+// replayRequest, replay isteğinin gövdesidir.
+type replayRequest struct {
+	FromID int64     `json:"from_id"`
+	ToID   int64     `json:"to_id"`
+	Since  time.Time `json:"since"`
+	Until  time.Time `json:"until"`
+	Events []string  `json:"events"`
+	Limit  int       `json:"limit"`
+	DryRun bool      `json:"dry_run"`
+}
+
+// Index, kayıtlı event'leri ID sırasıyla listeler.
+//
+// Query parametreleri: from_id, to_id, event (virgülle ayrılmış),
+// since/until (RFC3339), limit (varsayılan 50, en fazla 500).
+// Sonraki sayfa meta.next_from_id ile istenir.
+func (ec *EventStoreController) Index(w http.ResponseWriter, r *conduitReq.Request) {
+	query, err := parseStoreQuery(r)
+	if err != nil {
+		conduitRes.BadRequest(w, err.Error())
+		return
+	}
+
+	stored, err := ec.Store.Range(query)
+	if err != nil {
+		ec.Logger.Printf("❌ Event store list error: %v", err)
+		conduitRes.Error(w, http.StatusInternalServerError, "Event kayıtları okunamadı")
+		return
+	}
+
+	meta := map[string]interface{}{"count": len(stored)}
+	if len(stored) == query.Limit {
+		meta["next_from_id"] = stored[len(stored)-1].ID + 1
+	}
+	conduitRes.Success(w, http.StatusOK, stored, meta)
+}
+
+// Replay, istenen aralıktaki kayıtları tekrar dispatch eder.
+//
+// Aralık en az bir filtre (from_id, to_id, since, until, events) içermelidir;
+// tüm store'un yanlışlıkla oynatılması engellenir. Replay istek süresince
+// çalışır; client bağlantıyı keserse durur ve o ana kadarki sonuç loglanır.
+func (ec *EventStoreController) Replay(w http.ResponseWriter, r *conduitReq.Request) {
+	var reqData replayRequest
+	if err := r.ParseJSON(&reqData); err != nil {
+		conduitRes.BadRequest(w, "Geçersiz JSON formatı")
+		return
+	}
+
+	query := events.StoreQuery{
+		FromID: reqData.FromID,
+		ToID:   reqData.ToID,
+		Since:  reqData.Since,
+		Until:  reqData.Until,
+		Names:  reqData.Events,
+		Limit:  reqData.Limit,
+	}
+	if query.FromID == 0 && query.ToID == 0 && query.Since.IsZero() && query.Until.IsZero() && len(query.Names) == 0 {
+		conduitRes.BadRequest(w, "Replay için en az bir filtre gereklidir (from_id, to_id, since, until, events)")
+		return
+	}
+
+	if reqData.DryRun {
+		if query.Limit <= 0 || query.Limit > MaxEventListLimit {
+			query.Limit = MaxEventListLimit
+		}
+		stored, err := ec.Store.Range(query)
+		if err != nil {
+			ec.Logger.Printf("❌ Event store list error: %v", err)
+			conduitRes.Error(w, http.StatusInternalServerError, "Event kayıtları okunamadı")
+			return
+		}
+		conduitRes.Success(w, http.StatusOK, stored, map[string]interface{}{
+			"dry_run": true,
+			"count":   len(stored),
+		})
+		return
+	}
+
+	result, err := ec.Dispatcher.Replay(r.Context(), ec.Store, query)
+	if err != nil {
+		ec.Logger.Printf("❌ Event replay stopped after %d events (last id: %d): %v", result.Replayed, result.LastID, err)
+		conduitRes.Error(w, http.StatusInternalServerError, "Replay tamamlanamadı")
+		return
+	}
+
+	ec.Logger.Printf("🔁 Event replay: %d events (failed: %d, last id: %d)", result.Replayed, result.Failed, result.LastID)
+	conduitRes.Success(w, http.StatusOK, result, nil)
+}
+
+// parseStoreQuery, liste query parametrelerini StoreQuery'e çevirir.
+func parseStoreQuery(r *conduitReq.Request) (events.StoreQuery, error) {
+	var query events.StoreQuery
+	var err error
+
+	if query.FromID, err = parseQueryID(r, "from_id"); err != nil {
+		return query, err
+	}
+	if query.ToID, err = parseQueryID(r, "to_id"); err != nil {
+		return query, err
+	}
+	if query.Since, err = parseQueryTime(r, "since"); err != nil {
+		return query, err
+	}
+	if query.Until, err = parseQueryTime(r, "until"); err != nil {
+		return query, err
+	}
+
+	for _, name := range strings.Split(r.Query("event", ""), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			query.Names = append(query.Names, name)
+		}
+	}
+
+	query.Limit = DefaultEventListLimit
+	if value := r.Query("limit", ""); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return query, fmt.Errorf("geçersiz limit: %s", value)
+		}
+		query.Limit = min(limit, MaxEventListLimit)
+	}
+	return query, nil
+}
+
+// parseQueryID, opsiyonel pozitif ID parametresini okur.
+func parseQueryID(r *conduitReq.Request, key string) (int64, error) {
+	value := r.Query(key, "")
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("geçersiz %s: %s", key, value)
+	}
+	return id, nil
+}
+
+// parseQueryTime, opsiyonel RFC3339 zaman parametresini okur.
+func parseQueryTime(r *conduitReq.Request, key string) (time.Time, error) {
+	value := r.Query(key, "")
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("geçersiz %s (RFC3339 bekleniyor): %s", key, value)
+	}
+	return t, nil
+}
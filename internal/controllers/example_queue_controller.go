@@ -6,22 +6,26 @@ import (
 
 	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
 	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
-	"github.com/biyonik/conduit-go/internal/jobs"
+	"github.com/biyonik/conduit-go/internal/mails"
 	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/mail"
 	"github.com/biyonik/conduit-go/pkg/queue"
 )
 
 // ExampleQueueController, queue kullanım örneği.
 type ExampleQueueController struct {
-	Queue queue.Queue
+	Queue  queue.Queue
+	Mailer mail.Mailer
 }
 
 // NewExampleQueueController, controller oluşturur.
 func NewExampleQueueController(c *container.Container) (*ExampleQueueController, error) {
 	queueDriver := c.MustGet(reflect.TypeOf((*queue.Queue)(nil)).Elem()).(queue.Queue)
+	mailer := c.MustGet(reflect.TypeOf((*mail.Mailer)(nil)).Elem()).(mail.Mailer)
 
 	return &ExampleQueueController{
-		Queue: queueDriver,
+		Queue:  queueDriver,
+		Mailer: mailer,
 	}, nil
 }
 
@@ -40,21 +44,14 @@ func (ec *ExampleQueueController) SendWelcomeEmail(w http.ResponseWriter, r *con
 		return
 	}
 
-	// Email job oluştur
-	emailJob := jobs.NewSendEmailJob(
-		reqData.Email,
-		"Welcome to Conduit-Go",
-		"Hello "+reqData.Name+"! Welcome to our platform.",
-	)
-
-	// Queue'ya ekle
-	if err := ec.Queue.Push(emailJob, "emails"); err != nil {
-		conduitRes.Error(w, 500, "Job queue'ya eklenemedi")
+	// Mesaj render edilip SendMessageJob olarak serialize edilir, worker'da gönderilir
+	welcome := &mails.WelcomeMail{Email: reqData.Email, Name: reqData.Name}
+	if err := mail.Queue(ec.Mailer, welcome, "emails"); err != nil {
+		conduitRes.Error(w, 500, "Email queue'ya eklenemedi")
 		return
 	}
 
 	conduitRes.Success(w, 200, map[string]interface{}{
-		"message": "Email job queued successfully",
-		"job_id":  emailJob.GetID(),
+		"message": "Email queued successfully",
 	}, nil)
 }
@@ -0,0 +1,202 @@
+// -----------------------------------------------------------------------------
+// Impersonation Controller
+// -----------------------------------------------------------------------------
+// Bu controller, admin'lerin destek amaçlı bir kullanıcı adına oturum
+// açmasını yönetir:
+// - Start (Admin, kullanıcı adına kısa ömürlü token alır)
+// - Stop (Impersonation token'ı iptal edilir, admin kendi oturumuna döner)
+//
+// Akış:
+// 1. Admin POST /api/admin/users/{id}/impersonate çağırır
+// 2. Frontend kendi access token'ını saklar, dönen token'ı kullanır
+// 3. Her istek impersonation audit log'una yazılır
+// 4. DELETE /api/auth/impersonate ile token iptal edilir, frontend saklanan
+//    admin token'ına geri döner
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+)
+
+// ImpersonationController, kullanıcı impersonation işlemlerini yönetir.
+type ImpersonationController struct {
+	Logger    *log.Logger
+	Users     auth.UserProvider // Hedef kullanıcıyı yükler
+	JWTConfig *auth.JWTConfig
+}
+
+// NewImpersonationController, DI Container için factory function.
+func NewImpersonationController(c *container.Container) (*ImpersonationController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	db := c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
+	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
+	grammar := c.MustGet(grammarType).(database.Grammar)
+
+	// Kayıtlı değilse users tablosu (UserRepository) kullanılır
+	var users auth.UserProvider = models.NewUserRepository(db, grammar)
+	if resolved, err := c.Get(reflect.TypeOf((*auth.UserProvider)(nil)).Elem()); err == nil {
+		users = resolved.(auth.UserProvider)
+	}
+
+	jwtConfig := auth.DefaultJWTConfig()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.JWTConfig)(nil))); err == nil {
+		jwtConfig = resolved.(*auth.JWTConfig)
+	}
+
+	return &ImpersonationController{
+		Logger:    logger,
+		Users:     users,
+		JWTConfig: jwtConfig,
+	}, nil
+}
+
+// Start, admin için hedef kullanıcı adına bir impersonation token'ı üretir.
+//
+// POST /api/admin/users/{id}/impersonate
+// Authorization: Bearer {admin_token}
+//
+// Response (200 OK):
+//
+//	{
+//	  "success": true,
+//	  "data": {
+//	    "access_token": "eyJhbGc...",
+//	    "token_type": "Bearer",
+//	    "expires_in": 1800,
+//	    "impersonator_id": 1,
+//	    "user": { "id": 123, "name": "John Doe", "email": "john@example.com" }
+//	  }
+//	}
+//
+// Refresh token verilmez; süre dolunca admin tekrar başlatmalıdır.
+// Admin kullanıcılar ve pasif hesaplar impersonate edilemez.
+func (ic *ImpersonationController) Start(w http.ResponseWriter, r *conduitReq.Request) {
+	claims, _ := r.Context().Value("token_claims").(*auth.JWTClaims)
+	if claims == nil {
+		conduitRes.Error(w, 401, "Unauthorized")
+		return
+	}
+
+	// Zincirleme impersonation yok
+	if claims.IsImpersonated() {
+		conduitRes.Error(w, 403, "Impersonation sırasında başka bir kullanıcıya geçilemez")
+		return
+	}
+
+	targetID, err := strconv.ParseInt(r.RouteParam("id"), 10, 64)
+	if err != nil {
+		conduitRes.Error(w, 400, "Geçersiz kullanıcı ID")
+		return
+	}
+	if targetID == claims.UserID {
+		conduitRes.Error(w, 422, "Kendinizi impersonate edemezsiniz")
+		return
+	}
+
+	user, err := ic.Users.RetrieveByID(targetID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		conduitRes.Error(w, 404, "Kullanıcı bulunamadı")
+		return
+	}
+	if err != nil {
+		ic.Logger.Printf("❌ User lookup error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	if user.GetRole() == "admin" {
+		conduitRes.Error(w, 403, "Admin kullanıcılar impersonate edilemez")
+		return
+	}
+	if !auth.IsActiveUser(user) {
+		conduitRes.Error(w, 403, "Pasif kullanıcılar impersonate edilemez")
+		return
+	}
+
+	token, tokenClaims, err := auth.GenerateImpersonationToken(user, claims.UserID, ic.JWTConfig)
+	if err != nil {
+		ic.Logger.Printf("❌ Impersonation token error: %v", err)
+		conduitRes.Error(w, 500, "Token oluşturulamadı")
+		return
+	}
+
+	auth.AuditImpersonation(auth.ImpersonationEvent{
+		Action:         auth.ImpersonationStarted,
+		ImpersonatorID: claims.UserID,
+		UserID:         user.GetID(),
+		TokenID:        tokenClaims.ID,
+		IPAddress:      r.GetIP(),
+		UserAgent:      r.UserAgent(),
+	})
+
+	conduitRes.Success(w, 200, map[string]interface{}{
+		"access_token":    token,
+		"token_type":      "Bearer",
+		"expires_in":      int(tokenClaims.ExpiresAt.Sub(tokenClaims.IssuedAt.Time).Seconds()),
+		"impersonator_id": claims.UserID,
+		"user":            userPayload(user),
+	}, nil)
+}
+
+// Stop, impersonation'ı sonlandırır ve token'ı iptal eder.
+//
+// DELETE /api/auth/impersonate
+// Authorization: Bearer {impersonation_token}
+//
+// Response (200 OK):
+//
+//	{
+//	  "success": true,
+//	  "data": {
+//	    "message": "Impersonation sonlandırıldı",
+//	    "impersonator_id": 1
+//	  }
+//	}
+//
+// Yeni bir admin token'ı verilmez (impersonation token'ı çalınırsa admin
+// yetkisine yükseltilemesin diye); frontend saklanan admin token'ına döner.
+func (ic *ImpersonationController) Stop(w http.ResponseWriter, r *conduitReq.Request) {
+	claims, _ := r.Context().Value("token_claims").(*auth.JWTClaims)
+	if claims == nil {
+		conduitRes.Error(w, 401, "Unauthorized")
+		return
+	}
+	if !claims.IsImpersonated() {
+		conduitRes.Error(w, 400, "Aktif bir impersonation yok")
+		return
+	}
+
+	if err := auth.RevokeToken(claims); err != nil {
+		ic.Logger.Printf("❌ Impersonation token revoke error: %v", err)
+		conduitRes.Error(w, 500, "Impersonation sonlandırılamadı")
+		return
+	}
+
+	auth.AuditImpersonation(auth.ImpersonationEvent{
+		Action:         auth.ImpersonationStopped,
+		ImpersonatorID: claims.ImpersonatorID,
+		UserID:         claims.UserID,
+		TokenID:        claims.ID,
+		IPAddress:      r.GetIP(),
+		UserAgent:      r.UserAgent(),
+	})
+
+	conduitRes.Success(w, 200, map[string]interface{}{
+		"message":         "Impersonation sonlandırıldı",
+		"impersonator_id": claims.ImpersonatorID,
+	}, nil)
+}
@@ -0,0 +1,340 @@
+// -----------------------------------------------------------------------------
+// Magic Link Controller
+// -----------------------------------------------------------------------------
+// Bu controller, şifre yerine email ile gönderilen tek kullanımlık link ile
+// giriş (passwordless login) işlemlerini yönetir:
+// - Send (Email'e giriş linki gönder)
+// - Consume (Link'teki token ile giriş yap, JWT'leri al)
+//
+// Akış:
+// 1. Kullanıcı email girer (POST /api/auth/magic-link)
+// 2. Email'e MAGIC_LINK_URL?token=... linki gönderilir
+// 3. Frontend token'ı POST /api/auth/magic-link/consume'a gönderir
+// 4. Token tüketilir, Login ile aynı access/refresh token'lar döner
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"reflect"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/mails"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/biyonik/conduit-go/pkg/validation"
+	"github.com/biyonik/conduit-go/pkg/validation/types"
+)
+
+// MagicLinkController, şifresiz giriş işlemlerini yönetir.
+type MagicLinkController struct {
+	Logger          *log.Logger
+	Users           auth.UserProvider // Kullanıcıyı email ve ID ile yükler
+	Mailer          mail.Mailer
+	MagicLinks      auth.MagicLinkStore
+	RefreshTokens   auth.RefreshTokenStore
+	JWTConfig       *auth.JWTConfig
+	MagicLinkConfig *auth.MagicLinkConfig
+	LinkURL         string // Token'ın ?token= ile ekleneceği sayfa
+	FromAddress     string
+	FromName        string
+}
+
+// NewMagicLinkController, DI Container için factory function.
+func NewMagicLinkController(c *container.Container) (*MagicLinkController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
+	db := c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
+	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
+	grammar := c.MustGet(grammarType).(database.Grammar)
+
+	// Kayıtlı değilse users tablosu (UserRepository) kullanılır
+	var users auth.UserProvider = models.NewUserRepository(db, grammar)
+	if resolved, err := c.Get(reflect.TypeOf((*auth.UserProvider)(nil)).Elem()); err == nil {
+		users = resolved.(auth.UserProvider)
+	}
+
+	// Mailer kayıtlı değilse (worker, testler) link sadece loglanır
+	var mailer mail.Mailer = mail.NewLogMailer(logger)
+	if resolved, err := c.Get(reflect.TypeOf((*mail.Mailer)(nil)).Elem()); err == nil {
+		mailer = resolved.(mail.Mailer)
+	}
+
+	var magicLinks auth.MagicLinkStore = auth.NewMemoryMagicLinkStore()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.MagicLinkStore)(nil)).Elem()); err == nil {
+		magicLinks = resolved.(auth.MagicLinkStore)
+	}
+
+	var refreshTokens auth.RefreshTokenStore = auth.NewMemoryRefreshTokenStore()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.RefreshTokenStore)(nil)).Elem()); err == nil {
+		refreshTokens = resolved.(auth.RefreshTokenStore)
+	}
+
+	jwtConfig := auth.DefaultJWTConfig()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.JWTConfig)(nil))); err == nil {
+		jwtConfig = resolved.(*auth.JWTConfig)
+	}
+
+	return &MagicLinkController{
+		Logger:          logger,
+		Users:           users,
+		Mailer:          mailer,
+		MagicLinks:      magicLinks,
+		RefreshTokens:   refreshTokens,
+		JWTConfig:       jwtConfig,
+		MagicLinkConfig: cfg.MagicLink.Auth(),
+		LinkURL:         cfg.MagicLink.URL,
+		FromAddress:     cfg.Mail.FromAddress,
+		FromName:        cfg.Mail.FromName,
+	}, nil
+}
+
+// Send, email adresine tek kullanımlık giriş linki gönderir.
+//
+// POST /api/auth/magic-link
+//
+// Request Body:
+//
+//	{
+//	  "email": "john@example.com"
+//	}
+//
+// Response (200 OK):
+//
+//	{
+//	  "success": true,
+//	  "data": {
+//	    "message": "Email adresiniz kayıtlıysa giriş linki gönderildi"
+//	  }
+//	}
+//
+// Güvenlik Notu:
+// Email bulunamasa, hesap pasif olsa veya email için link limiti aşılmış
+// olsa bile aynı cevap dönülür (user enumeration attack koruması). Email
+// arka planda gönderilir, böylece cevap süresi de hesabın varlığını
+// belli etmez.
+func (mc *MagicLinkController) Send(w http.ResponseWriter, r *conduitReq.Request) {
+	mc.Logger.Println("✉️  Magic link request...")
+
+	// 1. Request body'yi parse et
+	var reqData struct {
+		Email string `json:"email"`
+	}
+
+	if err := r.ParseJSON(&reqData); err != nil {
+		conduitRes.Error(w, 400, "Geçersiz JSON formatı")
+		return
+	}
+
+	// 2. Validation
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email": types.String().
+			Required().
+			Email().
+			Label("Email").
+			Trim(),
+	})
+
+	result := schema.Validate(map[string]any{
+		"email": reqData.Email,
+	})
+
+	if result.HasErrors() {
+		conduitRes.Error(w, 422, result.Errors())
+		return
+	}
+
+	email := result.ValidData()["email"].(string)
+
+	// 3. Kullanıcıyı bul
+	user, err := auth.RetrieveByEmail(mc.Users, email)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		mc.Logger.Printf("⚠️  Magic link requested for non-existent email: %s", email)
+		mc.sendSuccessResponse(w)
+		return
+	}
+
+	if err != nil {
+		mc.Logger.Printf("❌ User provider error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	if !auth.IsActiveUser(user) {
+		mc.Logger.Printf("⚠️  Magic link requested for inactive user: %s", email)
+		mc.sendSuccessResponse(w)
+		return
+	}
+
+	// 4. Token oluştur (email başına throttle)
+	token, err := auth.CreateMagicLink(mc.MagicLinks, user.GetID(), user.GetEmail(), r.GetIP(), mc.MagicLinkConfig)
+	if errors.Is(err, auth.ErrMagicLinkThrottled) {
+		mc.Logger.Printf("⚠️  Magic link throttled for: %s", email)
+		mc.sendSuccessResponse(w)
+		return
+	}
+
+	if err != nil {
+		mc.Logger.Printf("❌ Magic link creation error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	// 5. Email gönder
+	message := mc.buildMessage(user, mc.linkFor(token))
+	go func() {
+		if err := mc.Mailer.Send(message); err != nil {
+			mc.Logger.Printf("❌ Magic link email error (%s): %v", user.GetEmail(), err)
+		}
+	}()
+
+	mc.Logger.Printf("✅ Magic link created for: %s", user.GetEmail())
+
+	mc.sendSuccessResponse(w)
+}
+
+// Consume, link'teki token ile giriş yapar.
+//
+// POST /api/auth/magic-link/consume
+//
+// Request Body:
+//
+//	{
+//	  "token": "9f86d081884c7d65..."
+//	}
+//
+// Response (200 OK): Login ile aynı (user, access_token, refresh_token)
+//
+// Response (401 Unauthorized):
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "Unauthorized",
+//	  "status": 401,
+//	  "detail": "Giriş linki geçersiz veya süresi dolmuş"
+//	}
+func (mc *MagicLinkController) Consume(w http.ResponseWriter, r *conduitReq.Request) {
+	mc.Logger.Println("🔐 Magic link login attempt...")
+
+	// 1. Request body'yi parse et
+	var reqData struct {
+		Token string `json:"token"`
+	}
+
+	if err := r.ParseJSON(&reqData); err != nil {
+		conduitRes.Error(w, 400, "Geçersiz JSON formatı")
+		return
+	}
+
+	// 2. Validation
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"token": types.String().
+			Required().
+			Min(32).
+			Label("Token").
+			Trim(),
+	})
+
+	result := schema.Validate(map[string]any{
+		"token": reqData.Token,
+	})
+
+	if result.HasErrors() {
+		conduitRes.Error(w, 422, result.Errors())
+		return
+	}
+
+	// 3. Token'ı tüket (tek kullanımlık)
+	link, err := auth.ConsumeMagicLink(mc.MagicLinks, result.ValidData()["token"].(string))
+	if errors.Is(err, auth.ErrMagicLinkInvalid) || errors.Is(err, auth.ErrMagicLinkExpired) || errors.Is(err, auth.ErrMagicLinkUsed) {
+		mc.Logger.Printf("⚠️  Magic link login failed: %v", err)
+		conduitRes.Error(w, 401, "Giriş linki geçersiz veya süresi dolmuş")
+		return
+	}
+
+	if err != nil {
+		mc.Logger.Printf("❌ Magic link error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	// 4. Kullanıcıyı yükle
+	user, err := mc.Users.RetrieveByID(link.UserID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		conduitRes.Error(w, 401, "Giriş linki geçersiz veya süresi dolmuş")
+		return
+	}
+
+	if err != nil {
+		mc.Logger.Printf("❌ User provider error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	if !auth.IsActiveUser(user) {
+		mc.Logger.Printf("⚠️  Magic link login failed: User inactive (%s)", user.GetEmail())
+		conduitRes.Error(w, 403, "Hesabınız aktif değil. Lütfen yönetici ile iletişime geçin.")
+		return
+	}
+
+	// 5. JWT token'lar oluştur
+	accessToken, err := auth.GenerateToken(user.GetID(), user.GetEmail(), user.GetRole(), mc.JWTConfig)
+	if err != nil {
+		mc.Logger.Printf("❌ Token generation error: %v", err)
+		conduitRes.Error(w, 500, "Token oluşturulamadı")
+		return
+	}
+
+	refreshToken, err := auth.IssueRefreshToken(mc.RefreshTokens, user.GetID(), user.GetEmail(), refreshDevice(r), mc.JWTConfig)
+	if err != nil {
+		mc.Logger.Printf("❌ Refresh token generation error: %v", err)
+		conduitRes.Error(w, 500, "Token oluşturulamadı")
+		return
+	}
+
+	mc.Logger.Printf("✅ User logged in via magic link: %s (ID: %d)", user.GetEmail(), user.GetID())
+
+	conduitRes.Success(w, 200, map[string]interface{}{
+		"user":          userPayload(user),
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    int(mc.JWTConfig.ExpirationTime.Seconds()),
+	}, nil)
+}
+
+// linkFor, token'ı link adresine query parametresi olarak ekler.
+func (mc *MagicLinkController) linkFor(token string) string {
+	return auth.TokenLink(mc.LinkURL, token)
+}
+
+// buildMessage, giriş linki email'ini oluşturur.
+func (mc *MagicLinkController) buildMessage(user auth.User, link string) *mail.Message {
+	var name string
+	if u, ok := user.(*models.User); ok {
+		name = u.Name
+	}
+
+	return mail.Make(&mails.MagicLinkMail{
+		Email: user.GetEmail(),
+		Name:  name,
+		Link:  link,
+		TTL:   mc.MagicLinkConfig.TTL,
+	}).From(mc.FromAddress, mc.FromName)
+}
+
+// sendSuccessResponse, link isteği için başarılı response gönderir.
+func (mc *MagicLinkController) sendSuccessResponse(w http.ResponseWriter) {
+	conduitRes.Success(w, 200, map[string]string{
+		"message": "Email adresiniz kayıtlıysa giriş linki gönderildi",
+	}, nil)
+}
@@ -0,0 +1,335 @@
+// -----------------------------------------------------------------------------
+// OIDC Controller
+// -----------------------------------------------------------------------------
+// Bu controller, OpenID Connect sağlayıcısı (Okta, Azure AD, Keycloak, ...)
+// üzerinden SSO girişini yönetir:
+// - Redirect (Kullanıcıyı sağlayıcının login sayfasına yönlendir)
+// - Callback (Sağlayıcıdan dönen code ile giriş yap, JWT'leri al)
+//
+// Akış:
+// 1. Frontend kullanıcıyı GET /api/auth/oidc/redirect'e yönlendirir
+// 2. State/nonce/PKCE verifier HttpOnly cookie'ye yazılır, sağlayıcıya 302
+// 3. Sağlayıcı GET /api/auth/oidc/callback?code=...&state=... çağırır
+// 4. ID token doğrulanır, kullanıcı email ile bulunur (yoksa oluşturulur)
+// 5. Login ile aynı access/refresh token'lar döner
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/token"
+)
+
+const (
+	// oidcCookieName, login isteğinin state/nonce/verifier değerlerini
+	// callback'e kadar taşıyan cookie.
+	oidcCookieName = "conduit_oidc"
+
+	// oidcCookiePath, cookie'nin sadece OIDC endpoint'lerine gönderilmesi için.
+	oidcCookiePath = "/api/auth/oidc"
+
+	// oidcCookieMaxAge, login işleminin tamamlanması için verilen süre.
+	oidcCookieMaxAge = 10 * time.Minute
+)
+
+// OIDCController, OpenID Connect giriş işlemlerini yönetir.
+type OIDCController struct {
+	Logger         *log.Logger
+	Users          auth.UserProvider      // Mevcut kullanıcıyı email ile bulur
+	UserRepository *models.UserRepository // AutoProvision ile kullanıcı oluşturur
+	Provider       *auth.OIDCProvider
+	RefreshTokens  auth.RefreshTokenStore
+	JWTConfig      *auth.JWTConfig
+	AutoProvision  bool // İlk girişte kullanıcı oluşturulsun mu
+	CookieSecure   bool
+}
+
+// NewOIDCController, DI Container için factory function.
+func NewOIDCController(c *container.Container) (*OIDCController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
+	db := c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
+	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
+	grammar := c.MustGet(grammarType).(database.Grammar)
+
+	userRepository := models.NewUserRepository(db, grammar)
+
+	// Kayıtlı değilse users tablosu (UserRepository) kullanılır
+	var users auth.UserProvider = userRepository
+	if resolved, err := c.Get(reflect.TypeOf((*auth.UserProvider)(nil)).Elem()); err == nil {
+		users = resolved.(auth.UserProvider)
+	}
+
+	provider := auth.NewOIDCProvider(cfg.OIDC.Auth())
+	if resolved, err := c.Get(reflect.TypeOf((*auth.OIDCProvider)(nil))); err == nil {
+		provider = resolved.(*auth.OIDCProvider)
+	}
+
+	var refreshTokens auth.RefreshTokenStore = auth.NewMemoryRefreshTokenStore()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.RefreshTokenStore)(nil)).Elem()); err == nil {
+		refreshTokens = resolved.(auth.RefreshTokenStore)
+	}
+
+	jwtConfig := auth.DefaultJWTConfig()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.JWTConfig)(nil))); err == nil {
+		jwtConfig = resolved.(*auth.JWTConfig)
+	}
+
+	return &OIDCController{
+		Logger:         logger,
+		Users:          users,
+		UserRepository: userRepository,
+		Provider:       provider,
+		RefreshTokens:  refreshTokens,
+		JWTConfig:      jwtConfig,
+		AutoProvision:  cfg.OIDC.AutoProvision,
+		CookieSecure:   cfg.Security.CookieSecure,
+	}, nil
+}
+
+// Redirect, kullanıcıyı sağlayıcının login sayfasına yönlendirir.
+//
+// GET /api/auth/oidc/redirect
+//
+// Response (302 Found): Location: {authorization_endpoint}?client_id=...
+func (oc *OIDCController) Redirect(w http.ResponseWriter, r *conduitReq.Request) {
+	authReq, err := auth.NewOIDCAuthRequest()
+	if err != nil {
+		oc.Logger.Printf("❌ OIDC auth request error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	loginURL, err := oc.Provider.AuthCodeURL(r.Context(), authReq)
+	if err != nil {
+		oc.Logger.Printf("❌ OIDC discovery error: %v", err)
+		conduitRes.Error(w, 503, "SSO sağlayıcısına ulaşılamıyor")
+		return
+	}
+
+	// SameSite=Lax: sağlayıcıdan dönen top-level GET yönlendirmesinde
+	// cookie gönderilmeli (Strict'te gönderilmez)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookieName,
+		Value:    strings.Join([]string{authReq.State, authReq.Nonce, authReq.CodeVerifier}, "."),
+		Path:     oidcCookiePath,
+		MaxAge:   int(oidcCookieMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   oc.CookieSecure,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r.Request, loginURL, http.StatusFound)
+}
+
+// Callback, sağlayıcıdan dönen authorization code ile giriş yapar.
+//
+// GET /api/auth/oidc/callback?code=...&state=...
+//
+// Response (200 OK): Login ile aynı (user, access_token, refresh_token)
+//
+// Response (401 Unauthorized):
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "Unauthorized",
+//	  "status": 401,
+//	  "detail": "SSO girişi doğrulanamadı"
+//	}
+//
+// Güvenlik Notu:
+// Mevcut bir hesap sadece sağlayıcı email'i doğrulanmış olarak
+// işaretlediyse bağlanır; aksi halde başkasının email'ini kendi
+// sağlayıcı hesabına yazan biri o hesabı ele geçirebilirdi.
+func (oc *OIDCController) Callback(w http.ResponseWriter, r *conduitReq.Request) {
+	oc.Logger.Println("🔐 OIDC login attempt...")
+
+	// 1. Login isteğini cookie'den oku (tek kullanımlık, hemen silinir)
+	cookie, err := r.Cookie(oidcCookieName)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookieName,
+		Value:    "",
+		Path:     oidcCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   oc.CookieSecure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	if err != nil {
+		conduitRes.Error(w, 400, "SSO oturumu bulunamadı veya süresi dolmuş")
+		return
+	}
+
+	parts := strings.Split(cookie.Value, ".")
+	if len(parts) != 3 {
+		conduitRes.Error(w, 400, "SSO oturumu bulunamadı veya süresi dolmuş")
+		return
+	}
+	state, nonce, verifier := parts[0], parts[1], parts[2]
+
+	// 2. Sağlayıcı hata döndüyse (örn: kullanıcı izni reddetti)
+	if providerErr := r.Query("error", ""); providerErr != "" {
+		oc.Logger.Printf("⚠️  OIDC provider error: %s (%s)", providerErr, r.Query("error_description", ""))
+		conduitRes.Error(w, 401, "SSO girişi iptal edildi")
+		return
+	}
+
+	// 3. CSRF kontrolü
+	if subtle.ConstantTimeCompare([]byte(r.Query("state", "")), []byte(state)) != 1 {
+		oc.Logger.Println("⚠️  OIDC login failed: State mismatch")
+		conduitRes.Error(w, 400, "Geçersiz SSO state")
+		return
+	}
+
+	code := r.Query("code", "")
+	if code == "" {
+		conduitRes.Error(w, 400, "Authorization code eksik")
+		return
+	}
+
+	// 4. Code -> ID token, doğrulama ve claim eşlemesi
+	tokens, err := oc.Provider.Exchange(r.Context(), code, verifier)
+	if err != nil {
+		oc.Logger.Printf("❌ OIDC exchange error: %v", err)
+		conduitRes.Error(w, 401, "SSO girişi doğrulanamadı")
+		return
+	}
+
+	claims, err := oc.Provider.VerifyIDToken(r.Context(), tokens.IDToken, nonce)
+	if err != nil {
+		oc.Logger.Printf("⚠️  OIDC login failed: %v", err)
+		conduitRes.Error(w, 401, "SSO girişi doğrulanamadı")
+		return
+	}
+
+	identity, err := oc.Provider.Identity(claims)
+	if errors.Is(err, auth.ErrOIDCEmailMissing) || errors.Is(err, auth.ErrOIDCEmailNotVerified) || errors.Is(err, auth.ErrOIDCDomainNotAllowed) {
+		oc.Logger.Printf("⚠️  OIDC login rejected: %v", err)
+		conduitRes.Error(w, 403, err.Error())
+		return
+	}
+
+	if err != nil {
+		oc.Logger.Printf("❌ OIDC identity error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	// 5. Kullanıcıyı bul veya oluştur
+	user, status, message := oc.resolveUser(identity)
+	if user == nil {
+		conduitRes.Error(w, status, message)
+		return
+	}
+
+	if !auth.IsActiveUser(user) {
+		oc.Logger.Printf("⚠️  OIDC login failed: User inactive (%s)", user.GetEmail())
+		conduitRes.Error(w, 403, "Hesabınız aktif değil. Lütfen yönetici ile iletişime geçin.")
+		return
+	}
+
+	// 6. JWT token'lar oluştur
+	accessToken, err := auth.GenerateToken(user.GetID(), user.GetEmail(), user.GetRole(), oc.JWTConfig)
+	if err != nil {
+		oc.Logger.Printf("❌ Token generation error: %v", err)
+		conduitRes.Error(w, 500, "Token oluşturulamadı")
+		return
+	}
+
+	refreshToken, err := auth.IssueRefreshToken(oc.RefreshTokens, user.GetID(), user.GetEmail(), refreshDevice(r), oc.JWTConfig)
+	if err != nil {
+		oc.Logger.Printf("❌ Refresh token generation error: %v", err)
+		conduitRes.Error(w, 500, "Token oluşturulamadı")
+		return
+	}
+
+	oc.Logger.Printf("✅ User logged in via OIDC: %s (ID: %d, sub: %s)", user.GetEmail(), user.GetID(), identity.Subject)
+
+	conduitRes.Success(w, 200, map[string]interface{}{
+		"user":          userPayload(user),
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    int(oc.JWTConfig.ExpirationTime.Seconds()),
+	}, nil)
+}
+
+// resolveUser, kimliğe ait kullanıcıyı bulur; yoksa ve AutoProvision
+// açıksa oluşturur. Kullanıcı döndürülemezse HTTP status ve mesaj döner.
+func (oc *OIDCController) resolveUser(identity *auth.OIDCIdentity) (auth.User, int, string) {
+	existing, err := auth.RetrieveByEmail(oc.Users, identity.Email)
+	if err == nil {
+		if !identity.EmailVerified {
+			oc.Logger.Printf("⚠️  OIDC login rejected: Unverified email for existing account (%s)", identity.Email)
+			return nil, 403, auth.ErrOIDCEmailNotVerified.Error()
+		}
+		return existing, 0, ""
+	}
+
+	if !errors.Is(err, auth.ErrUserNotFound) {
+		oc.Logger.Printf("❌ User provider error: %v", err)
+		return nil, 500, "Sunucu hatası"
+	}
+
+	if !oc.AutoProvision {
+		oc.Logger.Printf("⚠️  OIDC login rejected: No account for %s", identity.Email)
+		return nil, 403, "Bu email ile kayıtlı bir hesap yok"
+	}
+
+	// SSO kullanıcısının şifresi bilinmez; rastgele şifre ile oluşturulur
+	// (gerekirse şifre sıfırlama ile belirlenebilir)
+	password, err := token.GenerateSecureTokenHex(32)
+	if err != nil {
+		oc.Logger.Printf("❌ Password generation error: %v", err)
+		return nil, 500, "Sunucu hatası"
+	}
+
+	hashedPassword, err := auth.Hash(password)
+	if err != nil {
+		oc.Logger.Printf("❌ Password hashing error: %v", err)
+		return nil, 500, "Sunucu hatası"
+	}
+
+	user := &models.User{
+		Name:     identity.Name,
+		Email:    identity.Email,
+		Password: hashedPassword,
+		Status:   "active",
+	}
+
+	userID, err := oc.UserRepository.Create(user)
+	if err != nil {
+		oc.Logger.Printf("❌ User creation error: %v", err)
+		return nil, 500, "Kullanıcı oluşturulamadı"
+	}
+	user.ID = userID
+
+	if identity.EmailVerified {
+		if err := oc.UserRepository.VerifyEmail(userID); err != nil {
+			oc.Logger.Printf("⚠️  Email verification update error: %v", err)
+		} else {
+			now := time.Now()
+			user.EmailVerifiedAt = &now
+		}
+	}
+
+	oc.Logger.Printf("✅ User provisioned via OIDC: %s (ID: %d)", user.Email, user.ID)
+
+	return user, 0, ""
+}
@@ -26,20 +26,33 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/biyonik/conduit-go/internal/config"
 	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
 	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/mails"
 	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/auth"
 	"github.com/biyonik/conduit-go/pkg/container"
 	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/mail"
 	"github.com/biyonik/conduit-go/pkg/validation"
 	"github.com/biyonik/conduit-go/pkg/validation/types"
 )
 
 // PasswordResetToken, şifre sıfırlama token'larını temsil eder.
 type PasswordResetToken struct {
-	Email     string    `db:"email"`
-	Token     string    `db:"token"`
-	CreatedAt time.Time `db:"created_at"`
+	Email     string     `db:"email"`
+	Token     string     `db:"token"`
+	CreatedAt time.Time  `db:"created_at"`
+	ExpiresAt *time.Time `db:"expires_at"` // Davetler daha uzun geçerlidir; NULL ise passwordResetTTL
+}
+
+// Expired, token'ın süresinin dolup dolmadığını döndürür.
+func (t PasswordResetToken) Expired() bool {
+	if t.ExpiresAt != nil {
+		return time.Now().After(*t.ExpiresAt)
+	}
+	return time.Since(t.CreatedAt) > passwordResetTTL
 }
 
 // PasswordController, şifre yönetimi işlemlerini yönetir.
@@ -48,8 +61,15 @@ type PasswordController struct {
 	DB             *sql.DB
 	Grammar        database.Grammar
 	UserRepository *models.UserRepository
+	Mailer         mail.Mailer
+	ResetURL       string // Token'ın ?token= ile ekleneceği sayfa
+	FromAddress    string
+	FromName       string
 }
 
+// passwordResetTTL, reset token'ının geçerlilik süresidir.
+const passwordResetTTL = 1 * time.Hour
+
 // NewPasswordController, DI Container için factory function.
 func NewPasswordController(c *container.Container) (*PasswordController, error) {
 	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
@@ -57,11 +77,29 @@ func NewPasswordController(c *container.Container) (*PasswordController, error)
 	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
 	grammar := c.MustGet(grammarType).(database.Grammar)
 
+	// Mailer kayıtlı değilse (worker, testler) link sadece loglanır
+	var mailer mail.Mailer = mail.NewLogMailer(logger)
+	if resolved, err := c.Get(reflect.TypeOf((*mail.Mailer)(nil)).Elem()); err == nil {
+		mailer = resolved.(mail.Mailer)
+	}
+
+	resetURL := "http://localhost:3000/reset-password"
+	fromAddress, fromName := "", ""
+	if resolved, err := c.Get(reflect.TypeOf((*config.Config)(nil))); err == nil {
+		cfg := resolved.(*config.Config)
+		resetURL = cfg.PasswordReset.URL
+		fromAddress, fromName = cfg.Mail.FromAddress, cfg.Mail.FromName
+	}
+
 	return &PasswordController{
 		Logger:         logger,
 		DB:             db,
 		Grammar:        grammar,
 		UserRepository: models.NewUserRepository(db, grammar),
+		Mailer:         mailer,
+		ResetURL:       resetURL,
+		FromAddress:    fromAddress,
+		FromName:       fromName,
 	}, nil
 }
 
@@ -165,10 +203,12 @@ func (pc *PasswordController) ForgotPassword(w http.ResponseWriter, r *conduitRe
 		ExecDelete()
 
 	// 7. Yeni token'ı kaydet
-	_, err = pc.newBuilder().ExecInsert(map[string]interface{}{
+	now := time.Now()
+	_, err = pc.newBuilder().Table("password_reset_tokens").ExecInsert(map[string]interface{}{
 		"email":      email,
 		"token":      pc.hashToken(token), // Token hash'lenmiş olarak saklanır
-		"created_at": time.Now(),
+		"created_at": now,
+		"expires_at": now.Add(passwordResetTTL),
 	})
 
 	if err != nil {
@@ -177,18 +217,15 @@ func (pc *PasswordController) ForgotPassword(w http.ResponseWriter, r *conduitRe
 		return
 	}
 
-	// 8. Email gönder (Phase 3'te implement edilecek)
-	pc.Logger.Printf("✅ Password reset token created for: %s", email)
-	pc.Logger.Printf("🔗 Reset link: http://localhost:3000/reset-password?token=%s", token)
+	// 8. Email gönder (arka planda, cevap süresi hesabın varlığını belli etmez)
+	message := pc.buildResetMessage(user, pc.linkFor(token))
+	go func() {
+		if err := pc.Mailer.Send(message); err != nil {
+			pc.Logger.Printf("❌ Password reset email error (%s): %v", user.Email, err)
+		}
+	}()
 
-	// TODO (Phase 3): Mail sistemini kullanarak reset link gönder
-	// mail.To(email).
-	//     Subject("Şifre Sıfırlama").
-	//     Template("password-reset", map[string]string{
-	//         "name": user.Name,
-	//         "link": "http://localhost:3000/reset-password?token=" + token,
-	//     }).
-	//     Send()
+	pc.Logger.Printf("✅ Password reset token created for: %s", email)
 
 	pc.sendSuccessResponse(w)
 }
@@ -218,8 +255,10 @@ func (pc *PasswordController) ForgotPassword(w http.ResponseWriter, r *conduitRe
 // Response (422 Invalid Token):
 //
 //	{
-//	  "success": false,
-//	  "error": "Geçersiz veya süresi dolmuş token"
+//	  "type": "about:blank",
+//	  "title": "Unprocessable Entity",
+//	  "status": 422,
+//	  "detail": "Geçersiz veya süresi dolmuş token"
 //	}
 func (pc *PasswordController) ResetPassword(w http.ResponseWriter, r *conduitReq.Request) {
 	pc.Logger.Println("🔄 Password reset attempt...")
@@ -307,8 +346,8 @@ func (pc *PasswordController) ResetPassword(w http.ResponseWriter, r *conduitReq
 		return
 	}
 
-	// 4. Token expire kontrolü (1 saat geçerli)
-	if time.Since(resetToken.CreatedAt) > 1*time.Hour {
+	// 4. Token expire kontrolü (sıfırlama 1 saat, davet INVITE_TTL)
+	if resetToken.Expired() {
 		pc.Logger.Printf("⚠️  Expired reset token for email: %s", validData["email"])
 		conduitRes.Error(w, 422, "Token süresi dolmuş. Lütfen yeni bir şifre sıfırlama isteği oluşturun.")
 		return
@@ -360,6 +399,21 @@ func (pc *PasswordController) hashToken(token string) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// linkFor, token'ı reset sayfası URL'sine ekler.
+func (pc *PasswordController) linkFor(token string) string {
+	return auth.TokenLink(pc.ResetURL, token)
+}
+
+// buildResetMessage, şifre sıfırlama email'ini oluşturur.
+func (pc *PasswordController) buildResetMessage(user *models.User, link string) *mail.Message {
+	return mail.Make(&mails.PasswordResetMail{
+		Email: user.Email,
+		Name:  user.Name,
+		Link:  link,
+		TTL:   passwordResetTTL,
+	}).From(pc.FromAddress, pc.FromName)
+}
+
 // sendSuccessResponse, standart başarı mesajı döner.
 func (pc *PasswordController) sendSuccessResponse(w http.ResponseWriter) {
 	response := map[string]string{
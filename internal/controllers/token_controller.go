@@ -0,0 +1,253 @@
+// -----------------------------------------------------------------------------
+// Personal Access Token Controller
+// -----------------------------------------------------------------------------
+// Bu controller, kullanıcıların CLI/API entegrasyonları için personal access
+// token (API key) yönetimini sağlar:
+// - List (Token'ları listele)
+// - Create (Yeni token oluştur; düz token sadece bir kez gösterilir)
+// - Revoke (Token'ı iptal et)
+//
+// Token'lar middleware.AuthToken() ile korunan route'larda
+// "Authorization: Bearer {id}|{secret}" header'ı ile kullanılır.
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/validation"
+	"github.com/biyonik/conduit-go/pkg/validation/types"
+)
+
+// TokenController, personal access token işlemlerini yönetir.
+type TokenController struct {
+	Logger         *log.Logger
+	UserRepository *models.UserRepository
+}
+
+// NewTokenController, DI Container için factory function.
+func NewTokenController(c *container.Container) (*TokenController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	db := c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
+	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
+	grammar := c.MustGet(grammarType).(database.Grammar)
+
+	return &TokenController{
+		Logger:         logger,
+		UserRepository: models.NewUserRepository(db, grammar),
+	}, nil
+}
+
+// CreateTokenRequest, token oluşturma isteğidir.
+type CreateTokenRequest struct {
+	Name          string   `json:"name"`
+	Abilities     []string `json:"abilities"`
+	ExpiresInDays int      `json:"expires_in_days"` // 0: süresiz
+}
+
+// Index, authenticated user'ın token'larını listeler.
+//
+// GET /api/auth/tokens
+// Authorization: Bearer {token}
+//
+// Response (200 OK):
+//
+//	{
+//	  "success": true,
+//	  "data": [
+//	    {
+//	      "id": 12,
+//	      "name": "deploy-cli",
+//	      "abilities": ["servers:deploy"],
+//	      "last_used_at": "2024-01-15T10:30:00Z",
+//	      "expires_at": null,
+//	      "created_at": "2024-01-01T10:00:00Z"
+//	    }
+//	  ]
+//	}
+func (tc *TokenController) Index(w http.ResponseWriter, r *conduitReq.Request) {
+	userID, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		conduitRes.Error(w, 401, "Unauthorized")
+		return
+	}
+
+	tokens, err := auth.PersonalAccessTokens(userID)
+	if err != nil {
+		tc.Logger.Printf("❌ Token list error: %v", err)
+		conduitRes.Error(w, 500, "Token'lar listelenemedi")
+		return
+	}
+	if tokens == nil {
+		tokens = []auth.PersonalAccessToken{}
+	}
+
+	conduitRes.Success(w, 200, tokens, nil)
+}
+
+// Store, yeni bir personal access token oluşturur.
+//
+// POST /api/auth/tokens
+// Authorization: Bearer {token}
+//
+// Request Body:
+//
+//	{
+//	  "name": "deploy-cli",
+//	  "abilities": ["servers:deploy"], // Opsiyonel, varsayılan ["*"]
+//	  "expires_in_days": 90            // Opsiyonel, 0: süresiz
+//	}
+//
+// Response (201 Created):
+//
+//	{
+//	  "success": true,
+//	  "data": {
+//	    "access_token": { "id": 12, "name": "deploy-cli", ... },
+//	    "plain_text_token": "12|9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"
+//	  }
+//	}
+//
+// plain_text_token sadece bu cevapta döner; veritabanında hash'i saklanır.
+func (tc *TokenController) Store(w http.ResponseWriter, r *conduitReq.Request) {
+	userID, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		conduitRes.Error(w, 401, "Unauthorized")
+		return
+	}
+
+	// 1. Request body'yi parse et
+	var reqData CreateTokenRequest
+	if err := r.ParseJSON(&reqData); err != nil {
+		conduitRes.Error(w, 400, "Geçersiz JSON formatı")
+		return
+	}
+
+	// 2. Validation
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name": types.String().
+			Required().
+			Min(1).
+			Max(255).
+			Label("Token adı").
+			Trim(),
+	})
+
+	result := schema.Validate(map[string]any{"name": reqData.Name})
+	if result.HasErrors() {
+		conduitRes.Error(w, 422, result.Errors())
+		return
+	}
+	if reqData.ExpiresInDays < 0 {
+		conduitRes.FieldError(w, "expires_in_days", "Geçerlilik süresi negatif olamaz")
+		return
+	}
+
+	// 3. Token sahibi hâlâ aktif mi kontrol et
+	user, err := tc.UserRepository.FindByID(userID)
+	if err != nil {
+		tc.Logger.Printf("❌ User not found: %v", err)
+		conduitRes.Error(w, 404, "Kullanıcı bulunamadı")
+		return
+	}
+	if !user.IsActive() {
+		conduitRes.Error(w, 403, "Hesabınız aktif değil")
+		return
+	}
+
+	// 4. Token oluştur
+	var expiresAt *time.Time
+	if reqData.ExpiresInDays > 0 {
+		expires := time.Now().AddDate(0, 0, reqData.ExpiresInDays)
+		expiresAt = &expires
+	}
+
+	newToken, err := auth.CreatePersonalAccessToken(user.ID, result.ValidData()["name"].(string), reqData.Abilities, expiresAt)
+	if err != nil {
+		tc.Logger.Printf("❌ Token creation error: %v", err)
+		conduitRes.Error(w, 500, "Token oluşturulamadı")
+		return
+	}
+
+	tc.Logger.Printf("🔑 Personal access token created: %s (user: %d, token: %d)", newToken.AccessToken.Name, user.ID, newToken.AccessToken.ID)
+
+	conduitRes.Success(w, 201, newToken, nil)
+}
+
+// Destroy, authenticated user'ın token'ını iptal eder.
+//
+// DELETE /api/auth/tokens/{id}
+// Authorization: Bearer {token}
+//
+// Response (200 OK):
+//
+//	{
+//	  "success": true,
+//	  "data": {
+//	    "message": "Token iptal edildi"
+//	  }
+//	}
+func (tc *TokenController) Destroy(w http.ResponseWriter, r *conduitReq.Request) {
+	userID, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		conduitRes.Error(w, 401, "Unauthorized")
+		return
+	}
+
+	tokenID, err := strconv.ParseInt(r.RouteParam("id"), 10, 64)
+	if err != nil {
+		conduitRes.Error(w, 400, "Geçersiz token ID")
+		return
+	}
+
+	// Başka kullanıcının token'ı için de 404 döner (varlığı sızdırılmaz)
+	err = auth.RevokePersonalAccessToken(userID, tokenID)
+	if errors.Is(err, auth.ErrAccessTokenNotFound) {
+		conduitRes.Error(w, 404, "Token bulunamadı")
+		return
+	}
+	if err != nil {
+		tc.Logger.Printf("❌ Token revoke error: %v", err)
+		conduitRes.Error(w, 500, "Token iptal edilemedi")
+		return
+	}
+
+	tc.Logger.Printf("🗑️  Personal access token revoked (user: %d, token: %d)", userID, tokenID)
+
+	conduitRes.Success(w, 200, map[string]string{"message": "Token iptal edildi"}, nil)
+}
+
+// DestroyAll, authenticated user'ın tüm token'larını iptal eder.
+//
+// DELETE /api/auth/tokens
+// Authorization: Bearer {token}
+func (tc *TokenController) DestroyAll(w http.ResponseWriter, r *conduitReq.Request) {
+	userID, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		conduitRes.Error(w, 401, "Unauthorized")
+		return
+	}
+
+	if err := auth.RevokeAllPersonalAccessTokens(userID); err != nil {
+		tc.Logger.Printf("❌ Token revoke error: %v", err)
+		conduitRes.Error(w, 500, "Token'lar iptal edilemedi")
+		return
+	}
+
+	tc.Logger.Printf("🗑️  All personal access tokens revoked (user: %d)", userID)
+
+	conduitRes.Success(w, 200, map[string]string{"message": "Tüm token'lar iptal edildi"}, nil)
+}
@@ -0,0 +1,464 @@
+// -----------------------------------------------------------------------------
+// User Admin Controller
+// -----------------------------------------------------------------------------
+// Bu controller, admin paneli için toplu kullanıcı işlemlerini yönetir:
+// - List (Kullanıcıları sayfalı listeler)
+// - Export (Kullanıcıları CSV olarak stream eder)
+// - Import (CSV'den kullanıcı yükler, queue üzerinden işler)
+// - Import Status (Import ilerlemesini döndürür)
+//
+// Import akışı:
+// 1. Admin CSV yükler (name,email[,status])
+// 2. Satırlar doğrulanır, hatalı satırlar raporlanır
+// 3. Geçerli satırlar ImportUsersJob olarak "imports" queue'suna eklenir
+// 4. Admin import_id ile ilerlemeyi takip eder
+// -----------------------------------------------------------------------------
+
+package controllers
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	conduitRes "github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/jobs"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/validation"
+	"github.com/biyonik/conduit-go/pkg/validation/types"
+)
+
+const (
+	// MaxImportFileSize, import edilebilecek maksimum CSV boyutu (10 MB).
+	MaxImportFileSize = 10 << 20
+
+	// MaxImportRows, tek bir import'taki maksimum satır sayısı.
+	MaxImportRows = 10000
+
+	// MaxUsersPerPage, liste endpoint'inde istenebilecek maksimum sayfa boyutu.
+	MaxUsersPerPage = 100
+
+	// exportChunkSize, export sırasında veritabanından okunan sayfa boyutu.
+	exportChunkSize = 500
+)
+
+// UserAdminController, admin kullanıcı import/export işlemlerini yönetir.
+type UserAdminController struct {
+	Logger         *log.Logger
+	UserRepository *models.UserRepository
+	Queue          queue.Queue
+	Cache          cache.Cache
+	Mailer         mail.Mailer
+	Config         *config.Config
+}
+
+// NewUserAdminController, DI Container için factory function.
+func NewUserAdminController(c *container.Container) (*UserAdminController, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	db := c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
+	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
+	grammar := c.MustGet(grammarType).(database.Grammar)
+	queueDriver := c.MustGet(reflect.TypeOf((*queue.Queue)(nil)).Elem()).(queue.Queue)
+	cacheDriver := c.MustGet(reflect.TypeOf((*cache.Cache)(nil)).Elem()).(cache.Cache)
+	mailer := c.MustGet(reflect.TypeOf((*mail.Mailer)(nil)).Elem()).(mail.Mailer)
+	cfg := c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
+
+	return &UserAdminController{
+		Logger:         logger,
+		UserRepository: models.NewUserRepository(db, grammar),
+		Queue:          queueDriver,
+		Cache:          cacheDriver,
+		Mailer:         mailer,
+		Config:         cfg,
+	}, nil
+}
+
+// ListUsers, kullanıcıları sayfalı olarak listeler.
+//
+// GET /api/admin/users?page=2&per_page=20
+//
+// Response (200 OK):
+//
+//	{
+//	  "success": true,
+//	  "data": [{"id": 1, "name": "John Doe", ...}],
+//	  "meta": {"total": 42, "per_page": 20, "current_page": 2, "last_page": 3},
+//	  "links": {"first": "/api/admin/users?page=1&per_page=20", ...}
+//	}
+func (uc *UserAdminController) ListUsers(w http.ResponseWriter, r *conduitReq.Request) {
+	page, _ := strconv.Atoi(r.Query("page", "1"))
+	perPage, _ := strconv.Atoi(r.Query("per_page", strconv.Itoa(database.DefaultPerPage)))
+	if perPage > MaxUsersPerPage {
+		perPage = MaxUsersPerPage
+	}
+
+	users, paginator, err := uc.UserRepository.Paginate(page, perPage)
+	if err != nil {
+		uc.Logger.Printf("❌ User list failed: %v", err)
+		conduitRes.Error(w, http.StatusInternalServerError, "Kullanıcılar alınamadı")
+		return
+	}
+
+	conduitRes.Paginated(w, users, paginator, r.Request)
+}
+
+// ExportUsers, tüm kullanıcıları CSV olarak stream eder.
+//
+// GET /api/admin/users/export
+//
+// Kullanıcılar sayfa sayfa okunur ve response.Stream ile parça parça
+// gönderilir; böylece büyük tablolar belleğe yüklenmeden indirilebilir.
+//
+// Response (200 OK, text/csv):
+//
+//	id,name,email,status,email_verified_at,created_at
+//	1,John Doe,john@example.com,active,2024-01-01T10:00:00Z,2024-01-01T09:00:00Z
+func (uc *UserAdminController) ExportUsers(w http.ResponseWriter, r *conduitReq.Request) {
+	uc.Logger.Println("📤 User export started...")
+
+	filename := fmt.Sprintf("users-%s.csv", time.Now().Format("20060102-150405"))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", conduitRes.ContentDisposition("attachment", filename))
+	w.Header().Set("Cache-Control", "no-store")
+
+	exported := 0
+	err := conduitRes.Stream(w, func(out io.Writer) error {
+		writer := csv.NewWriter(out)
+		writer.Write([]string{"id", "name", "email", "status", "email_verified_at", "created_at"})
+
+		err := uc.UserRepository.Chunk(exportChunkSize, func(users []models.User) error {
+			for _, user := range users {
+				verifiedAt := ""
+				if user.EmailVerifiedAt != nil {
+					verifiedAt = user.EmailVerifiedAt.Format(time.RFC3339)
+				}
+
+				if err := writer.Write([]string{
+					strconv.FormatInt(user.ID, 10),
+					SafeCSVCell(user.Name),
+					SafeCSVCell(user.Email),
+					SafeCSVCell(user.Status),
+					verifiedAt,
+					user.CreatedAt.Format(time.RFC3339),
+				}); err != nil {
+					return err
+				}
+			}
+
+			writer.Flush()
+			exported += len(users)
+			return writer.Error()
+		})
+
+		writer.Flush()
+		return errors.Join(err, writer.Error())
+	})
+
+	if err != nil {
+		// Stream başladıysa yanıt yarıda kalır; sadece loglanabilir
+		uc.Logger.Printf("❌ User export interrupted after %d rows: %v", exported, err)
+		return
+	}
+
+	uc.Logger.Printf("✅ User export completed: %d users", exported)
+}
+
+// SafeCSVCell, spreadsheet'lerin formül olarak çalıştıracağı hücreleri
+// (=, +, -, @, tab, CR ile başlayanlar) başına ' ekleyerek etkisizleştirir.
+//
+// Kullanıcının kontrol ettiği alanlar (name, email) export'ta bu fonksiyondan
+// geçirilmelidir; aksi halde "=HYPERLINK(...)" gibi bir isim admin'in
+// spreadsheet'inde çalışır (CSV injection).
+func SafeCSVCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// ImportUsers, CSV dosyasından kullanıcı import işlemini başlatır.
+//
+// POST /api/admin/users/import?strategy=skip|update|fail&invite=true
+//
+// CSV multipart "file" alanı olarak veya doğrudan request body olarak
+// gönderilebilir. İlk satır header olmalıdır: name,email[,status]
+//
+// Query Parametreleri:
+//   - strategy: Mevcut email'ler için davranış (varsayılan: skip)
+//   - invite: Yeni hesaplara davet email'i gönderilsin mi (varsayılan: false)
+//
+// Response (202 Accepted):
+//
+//	{
+//	  "success": true,
+//	  "data": {
+//	    "import_id": "5f0c...",
+//	    "status": "queued",
+//	    "total": 120,
+//	    "rejected": [{"line": 4, "email": "bad", "error": "email: ..."}]
+//	  }
+//	}
+func (uc *UserAdminController) ImportUsers(w http.ResponseWriter, r *conduitReq.Request) {
+	strategy := r.Query("strategy", jobs.DuplicateSkip)
+	if strategy != jobs.DuplicateSkip && strategy != jobs.DuplicateUpdate && strategy != jobs.DuplicateFail {
+		conduitRes.Error(w, 422, map[string][]string{
+			"strategy": {"Strateji skip, update veya fail olmalıdır"},
+		})
+		return
+	}
+	sendInvites, _ := strconv.ParseBool(r.Query("invite", "false"))
+
+	source, closeSource, err := importSource(w, r)
+	if err != nil {
+		conduitRes.Error(w, 400, err.Error())
+		return
+	}
+	defer closeSource()
+
+	rows, rejected, err := ParseUserImportCSV(source, MaxImportRows)
+	if err != nil {
+		conduitRes.Error(w, 400, err.Error())
+		return
+	}
+
+	if len(rows) == 0 {
+		conduitRes.Error(w, 422, map[string]interface{}{
+			"message":  "Import edilecek geçerli satır bulunamadı",
+			"rejected": rejected,
+		})
+		return
+	}
+
+	importID := uuid.New().String()
+
+	// İlk progress kaydı (job başlamadan önce de sorgulanabilsin)
+	if err := jobs.SaveImportProgress(uc.Cache, &jobs.ImportProgress{
+		ImportID: importID,
+		Status:   jobs.ImportStatusQueued,
+		Strategy: strategy,
+		Total:    len(rows),
+		Errors:   []jobs.ImportRowError{},
+	}); err != nil {
+		uc.Logger.Printf("⚠️  Import progress kaydedilemedi: %v", err)
+	}
+
+	job := jobs.NewImportUsersJob(importID, strategy, rows, uc.UserRepository, uc.Cache, uc.Mailer)
+	job.SendInvites = sendInvites
+	job.InviteURL = uc.Config.PasswordReset.URL
+	job.InviteTTL = uc.Config.PasswordReset.InviteTTL
+	job.FromAddress = uc.Config.Mail.FromAddress
+
+	if err := queue.Dispatch(r.Context(), uc.Queue, job, "imports"); err != nil {
+		uc.Logger.Printf("❌ Import job queue error: %v", err)
+		conduitRes.Error(w, 500, "Import işlemi başlatılamadı")
+		return
+	}
+
+	uc.Logger.Printf("📥 User import queued: %s (%d rows, %d rejected)", importID, len(rows), len(rejected))
+
+	conduitRes.Success(w, 202, map[string]interface{}{
+		"import_id": importID,
+		"status":    jobs.ImportStatusQueued,
+		"strategy":  strategy,
+		"total":     len(rows),
+		"rejected":  rejected,
+	}, nil)
+}
+
+// ImportStatus, bir import işleminin ilerlemesini döndürür.
+//
+// GET /api/admin/users/import/{id}
+func (uc *UserAdminController) ImportStatus(w http.ResponseWriter, r *conduitReq.Request) {
+	importID := r.RouteParam("id")
+
+	progress, err := jobs.LoadImportProgress(uc.Cache, importID)
+	if err != nil {
+		uc.Logger.Printf("❌ Import progress read error: %v", err)
+		conduitRes.Error(w, 500, "Sunucu hatası")
+		return
+	}
+
+	if progress == nil {
+		conduitRes.Error(w, 404, "Import bulunamadı")
+		return
+	}
+
+	conduitRes.Success(w, 200, progress, nil)
+}
+
+// importSource, CSV içeriğini multipart "file" alanından veya request
+// body'den okur. Boyut MaxImportFileSize ile sınırlandırılır.
+func importSource(w http.ResponseWriter, r *conduitReq.Request) (io.Reader, func(), error) {
+	r.Body = http.MaxBytesReader(w, r.Body, MaxImportFileSize)
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		upload, err := r.File("file")
+		switch {
+		case errors.Is(err, http.ErrMissingFile):
+			return nil, nil, errors.New("CSV dosyası 'file' alanında gönderilmelidir")
+		case err != nil:
+			return nil, nil, errors.New("Dosya okunamadı veya boyut limiti aşıldı")
+		}
+
+		file, err := upload.Open()
+		if err != nil {
+			return nil, nil, errors.New("Dosya okunamadı veya boyut limiti aşıldı")
+		}
+		return file, func() { file.Close() }, nil
+	}
+
+	return r.Body, func() {}, nil
+}
+
+// ParseUserImportCSV, import CSV'sini okur ve satırları doğrular.
+//
+// İlk satır header'dır; "name" ve "email" kolonları zorunlu, "status"
+// opsiyoneldir (varsayılan: active). Kolon sırası serbesttir.
+// Aynı dosyada tekrar eden email'ler reddedilir.
+//
+// Parametreler:
+//   - source: CSV içeriği
+//   - maxRows: İzin verilen maksimum veri satırı sayısı
+//
+// Döndürür:
+//   - []jobs.ImportUserRow: Geçerli satırlar
+//   - []jobs.ImportRowError: Reddedilen satırlar ve nedenleri
+//   - error: CSV okunamazsa, header eksikse veya satır limiti aşılırsa
+func ParseUserImportCSV(source io.Reader, maxRows int) ([]jobs.ImportUserRow, []jobs.ImportRowError, error) {
+	reader := csv.NewReader(source)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, errors.New("CSV header satırı okunamadı")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+	for _, required := range []string{"name", "email"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("CSV header'ında '%s' kolonu eksik", required)
+		}
+	}
+
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name":   types.String().Required().Min(2).Max(255).Label("Ad Soyad").Trim(),
+		"email":  types.String().Required().Email().Max(255).Label("Email").Trim(),
+		"status": types.String().Required().Label("Durum").Trim(),
+	})
+
+	column := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []jobs.ImportUserRow
+	var rejected []jobs.ImportRowError
+	seen := make(map[string]int)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("CSV okunamadı: %w", err)
+		}
+
+		// csv.Reader boş satırları atladığı için gerçek satır numarası okunur
+		line, _ := reader.FieldPos(0)
+
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		if len(rows)+len(rejected) >= maxRows {
+			return nil, nil, fmt.Errorf("CSV en fazla %d satır içerebilir", maxRows)
+		}
+
+		status := strings.ToLower(column(record, "status"))
+		if status == "" {
+			status = "active"
+		}
+
+		result := schema.Validate(map[string]any{
+			"name":   column(record, "name"),
+			"email":  column(record, "email"),
+			"status": status,
+		})
+
+		email := strings.ToLower(column(record, "email"))
+
+		if result.HasErrors() {
+			rejected = append(rejected, jobs.ImportRowError{
+				Line:  line,
+				Email: email,
+				Error: formatRowErrors(result.Errors()),
+			})
+			continue
+		}
+
+		if status != "active" && status != "inactive" {
+			rejected = append(rejected, jobs.ImportRowError{
+				Line:  line,
+				Email: email,
+				Error: "status: active veya inactive olmalıdır",
+			})
+			continue
+		}
+
+		if firstLine, duplicate := seen[email]; duplicate {
+			rejected = append(rejected, jobs.ImportRowError{
+				Line:  line,
+				Email: email,
+				Error: fmt.Sprintf("email dosyada tekrar ediyor (ilk: %d. satır)", firstLine),
+			})
+			continue
+		}
+		seen[email] = line
+
+		valid := result.ValidData()
+		rows = append(rows, jobs.ImportUserRow{
+			Line:   line,
+			Name:   valid["name"].(string),
+			Email:  email,
+			Status: status,
+		})
+	}
+
+	return rows, rejected, nil
+}
+
+// formatRowErrors, validation hatalarını tek satırlık bir mesaja çevirir.
+func formatRowErrors(fieldErrors map[string][]string) string {
+	fields := make([]string, 0, len(fieldErrors))
+	for _, field := range []string{"name", "email", "status"} {
+		if messages, ok := fieldErrors[field]; ok {
+			fields = append(fields, field+": "+strings.Join(messages, ", "))
+		}
+	}
+	return strings.Join(fields, "; ")
+}
@@ -15,6 +15,7 @@ package controllers
 
 import (
 	"database/sql"
+	"errors"
 	"log"
 	"net/http"
 	"reflect"
@@ -34,6 +35,8 @@ type AuthController struct {
 	Logger         *log.Logger
 	UserRepository *models.UserRepository
 	JWTConfig      *auth.JWTConfig
+	RefreshTokens  auth.RefreshTokenStore // refresh_tokens tablosu (rotation + reuse detection)
+	Users          auth.UserProvider      // Login/refresh'te kullanıcıyı doğrular ve yükler
 }
 
 // NewAuthController, DI Container için factory function.
@@ -43,13 +46,60 @@ func NewAuthController(c *container.Container) (*AuthController, error) {
 	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
 	grammar := c.MustGet(grammarType).(database.Grammar)
 
+	userRepository := models.NewUserRepository(db, grammar)
+
+	// Kayıtlı değilse users tablosu (UserRepository) kullanılır
+	var users auth.UserProvider = userRepository
+	if resolved, err := c.Get(reflect.TypeOf((*auth.UserProvider)(nil)).Elem()); err == nil {
+		users = resolved.(auth.UserProvider)
+	}
+
+	// Container'da kayıtlı değilse (testler, minimal kurulum) bellek içi store
+	var refreshTokens auth.RefreshTokenStore = auth.NewMemoryRefreshTokenStore()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.RefreshTokenStore)(nil)).Elem()); err == nil {
+		refreshTokens = resolved.(auth.RefreshTokenStore)
+	}
+
+	// Environment'tan oluşturulan config (kayıtlı değilse varsayılan)
+	jwtConfig := auth.DefaultJWTConfig()
+	if resolved, err := c.Get(reflect.TypeOf((*auth.JWTConfig)(nil))); err == nil {
+		jwtConfig = resolved.(*auth.JWTConfig)
+	}
+
 	return &AuthController{
 		Logger:         logger,
-		UserRepository: models.NewUserRepository(db, grammar),
-		JWTConfig:      auth.DefaultJWTConfig(),
+		UserRepository: userRepository,
+		JWTConfig:      jwtConfig,
+		RefreshTokens:  refreshTokens,
+		Users:          users,
 	}, nil
 }
 
+// userPayload, login response'undaki user alanını oluşturur. Provider
+// *models.User döndürüyorsa profil alanları da eklenir.
+func userPayload(user auth.User) map[string]interface{} {
+	payload := map[string]interface{}{
+		"id":    user.GetID(),
+		"email": user.GetEmail(),
+		"role":  user.GetRole(),
+	}
+
+	if u, ok := user.(*models.User); ok {
+		payload["name"] = u.Name
+		payload["status"] = u.Status
+		payload["email_verified_at"] = u.EmailVerifiedAt
+	}
+	return payload
+}
+
+// refreshDevice, refresh token kaydına yazılacak cihaz bilgisini döndürür.
+func refreshDevice(r *conduitReq.Request) auth.RefreshDevice {
+	return auth.RefreshDevice{
+		UserAgent: r.UserAgent(),
+		IPAddress: r.GetIP(),
+	}
+}
+
 // RegisterRequest, registration validation için schema.
 type RegisterRequest struct {
 	Name            string `json:"name"`
@@ -91,11 +141,11 @@ type RegisterRequest struct {
 // Response (422 Validation Error):
 //
 //	{
-//	  "success": false,
-//	  "error": "Doğrulama hatası",
-//	  "data": {
-//	    "email": ["Email zaten kullanımda"]
-//	  }
+//	  "type": "about:blank",
+//	  "title": "Doğrulama hatası",
+//	  "status": 422,
+//	  "detail": "1 alanda doğrulama hatası var",
+//	  "invalid-params": [{"name": "email", "reason": "Email zaten kullanımda"}]
 //	}
 func (ac *AuthController) Register(w http.ResponseWriter, r *conduitReq.Request) {
 	ac.Logger.Println("📝 User registration attempt...")
@@ -210,7 +260,7 @@ func (ac *AuthController) Register(w http.ResponseWriter, r *conduitReq.Request)
 		return
 	}
 
-	refreshToken, err := auth.GenerateRefreshToken(user.ID, user.Email, ac.JWTConfig)
+	refreshToken, err := auth.IssueRefreshToken(ac.RefreshTokens, user.ID, user.Email, refreshDevice(r), ac.JWTConfig)
 	if err != nil {
 		ac.Logger.Printf("❌ Refresh token generation error: %v", err)
 		conduitRes.Error(w, 500, "Token oluşturulamadı")
@@ -237,10 +287,11 @@ func (ac *AuthController) Register(w http.ResponseWriter, r *conduitReq.Request)
 	conduitRes.Success(w, 201, response, nil)
 }
 
-// LoginRequest, login validation için schema.
+// LoginRequest, login isteğinin gövdesidir. Doğrulama kuralları
+// validate tag'lerinden okunur (bkz. validation.ValidateStruct).
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" validate:"required,email,trim,label=Email"`
+	Password string `json:"password" validate:"required,label=Şifre"`
 }
 
 // Login, kullanıcı girişi yapar.
@@ -269,8 +320,10 @@ type LoginRequest struct {
 // Response (401 Unauthorized):
 //
 //	{
-//	  "success": false,
-//	  "error": "Email veya şifre hatalı"
+//	  "type": "about:blank",
+//	  "title": "Unauthorized",
+//	  "status": 401,
+//	  "detail": "Email veya şifre hatalı"
 //	}
 func (ac *AuthController) Login(w http.ResponseWriter, r *conduitReq.Request) {
 	ac.Logger.Println("🔐 Login attempt...")
@@ -283,25 +336,7 @@ func (ac *AuthController) Login(w http.ResponseWriter, r *conduitReq.Request) {
 	}
 
 	// 2. Validation
-	schema := validation.Make().Shape(map[string]validation.Type{
-		"email": types.String().
-			Required().
-			Email().
-			Label("Email").
-			Trim(),
-
-		"password": types.String().
-			Required().
-			Min(1).
-			Label("Şifre"),
-	})
-
-	dataMap := map[string]any{
-		"email":    reqData.Email,
-		"password": reqData.Password,
-	}
-
-	result := schema.Validate(dataMap)
+	result := validation.ValidateStruct(&reqData)
 	if result.HasErrors() {
 		conduitRes.Error(w, 422, result.Errors())
 		return
@@ -309,73 +344,52 @@ func (ac *AuthController) Login(w http.ResponseWriter, r *conduitReq.Request) {
 
 	validData := result.ValidData()
 
-	// 3. Kullanıcıyı email ile bul
-	user, err := ac.UserRepository.FindByEmail(validData["email"].(string))
-	if err == sql.ErrNoRows {
-		// Güvenlik: Email var mı yok mu belli etme (timing attack koruması)
-		ac.Logger.Printf("⚠️  Login failed: User not found (%s)", validData["email"])
+	// 3. Kullanıcıyı provider ile doğrula (şifre kontrolü ve hash
+	// güncellemesi provider'ın sorumluluğundadır)
+	user, err := ac.Users.RetrieveByCredentials(auth.Credentials{
+		"email":    validData["email"].(string),
+		"password": validData["password"].(string),
+	})
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		// Güvenlik: Email var mı yok mu belli etme
+		ac.Logger.Printf("⚠️  Login failed: Invalid credentials (%s)", validData["email"])
 		conduitRes.Error(w, 401, "Email veya şifre hatalı")
 		return
 	}
 
 	if err != nil {
-		ac.Logger.Printf("❌ Database error: %v", err)
+		ac.Logger.Printf("❌ User provider error: %v", err)
 		conduitRes.Error(w, 500, "Sunucu hatası")
 		return
 	}
 
-	// 4. Şifreyi kontrol et
-	if !user.CheckPassword(validData["password"].(string)) {
-		ac.Logger.Printf("⚠️  Login failed: Invalid password (%s)", user.Email)
-		conduitRes.Error(w, 401, "Email veya şifre hatalı")
-		return
-	}
-
-	// 5. Kullanıcı aktif mi kontrol et
-	if !user.IsActive() {
-		ac.Logger.Printf("⚠️  Login failed: User inactive (%s)", user.Email)
+	// 4. Kullanıcı aktif mi kontrol et
+	if !auth.IsActiveUser(user) {
+		ac.Logger.Printf("⚠️  Login failed: User inactive (%s)", user.GetEmail())
 		conduitRes.Error(w, 403, "Hesabınız aktif değil. Lütfen yönetici ile iletişime geçin.")
 		return
 	}
 
-	// 6. Şifre hash'i güncellenmeye ihtiyaç duyuyor mu kontrol et
-	// (Güvenlik: Zaman içinde hash cost artırılabilir)
-	if auth.NeedsRehash(user.Password) {
-		newHash, _ := auth.Hash(validData["password"].(string))
-		if newHash != "" {
-			user.Password = newHash
-			ac.UserRepository.Update(user)
-			ac.Logger.Printf("🔄 Password hash updated for user: %s", user.Email)
-		}
-	}
-
-	// 7. JWT token'lar oluştur
-	accessToken, err := auth.GenerateToken(user.ID, user.Email, user.GetRole(), ac.JWTConfig)
+	// 5. JWT token'lar oluştur
+	accessToken, err := auth.GenerateToken(user.GetID(), user.GetEmail(), user.GetRole(), ac.JWTConfig)
 	if err != nil {
 		ac.Logger.Printf("❌ Token generation error: %v", err)
 		conduitRes.Error(w, 500, "Token oluşturulamadı")
 		return
 	}
 
-	refreshToken, err := auth.GenerateRefreshToken(user.ID, user.Email, ac.JWTConfig)
+	refreshToken, err := auth.IssueRefreshToken(ac.RefreshTokens, user.GetID(), user.GetEmail(), refreshDevice(r), ac.JWTConfig)
 	if err != nil {
 		ac.Logger.Printf("❌ Refresh token generation error: %v", err)
 		conduitRes.Error(w, 500, "Token oluşturulamadı")
 		return
 	}
 
-	// 8. Response hazırla
-	ac.Logger.Printf("✅ User logged in successfully: %s (ID: %d)", user.Email, user.ID)
+	// 6. Response hazırla
+	ac.Logger.Printf("✅ User logged in successfully: %s (ID: %d)", user.GetEmail(), user.GetID())
 
 	response := map[string]interface{}{
-		"user": map[string]interface{}{
-			"id":                user.ID,
-			"name":              user.Name,
-			"email":             user.Email,
-			"status":            user.Status,
-			"role":              user.GetRole(),
-			"email_verified_at": user.EmailVerifiedAt,
-		},
+		"user":          userPayload(user),
 		"access_token":  accessToken,
 		"refresh_token": refreshToken,
 		"token_type":    "Bearer",
@@ -390,8 +404,13 @@ func (ac *AuthController) Login(w http.ResponseWriter, r *conduitReq.Request) {
 // POST /api/auth/logout
 // Authorization: Bearer {token}
 //
-// JWT stateless olduğu için server tarafında bir şey yapmaya gerek yok.
-// Client token'ı silmeli. İleride token blacklist eklenebilir.
+// Kullanılan access token denylist'e eklenir ve süresi dolana kadar
+// reddedilir (bkz: pkg/auth/denylist.go). Body opsiyoneldir:
+//
+//	{
+//	  "refresh_token": "eyJhbGc...", // Verilirse o ve rotation ailesi de iptal edilir
+//	  "all": true                    // Tüm cihazlardaki oturumları kapat
+//	}
 //
 // Response (200 OK):
 //
@@ -402,16 +421,61 @@ func (ac *AuthController) Login(w http.ResponseWriter, r *conduitReq.Request) {
 //	  }
 //	}
 func (ac *AuthController) Logout(w http.ResponseWriter, r *conduitReq.Request) {
-	// Context'ten user bilgisini al (middleware tarafından set edilmiş)
-	user := r.Context().Value("user")
-	if user != nil {
-		if authUser, ok := user.(auth.User); ok {
-			ac.Logger.Printf("👋 User logged out: %s", authUser.GetEmail())
+	// Context'ten token bilgisini al (Auth middleware tarafından set edilmiş)
+	claims, _ := r.Context().Value("token_claims").(*auth.JWTClaims)
+	if claims == nil {
+		conduitRes.Error(w, 401, "Unauthorized")
+		return
+	}
+
+	// Body opsiyonel; boş veya hatalı body sadece access token'ı iptal eder
+	var reqData struct {
+		RefreshToken string `json:"refresh_token"`
+		All          bool   `json:"all"`
+	}
+	r.ParseJSON(&reqData)
+
+	if reqData.All {
+		// Admin, impersonate ettiği kullanıcının tüm oturumlarını kapatamaz
+		if claims.IsImpersonated() {
+			conduitRes.Error(w, 403, "Bu işlem impersonation sırasında yapılamaz")
+			return
+		}
+		if err := ac.RefreshTokens.RevokeUser(claims.UserID); err != nil {
+			ac.Logger.Printf("❌ Refresh token revoke error: %v", err)
+			conduitRes.Error(w, 500, "Çıkış yapılamadı")
+			return
+		}
+		if err := auth.RevokeAllForUser(claims.UserID, ac.JWTConfig); err != nil {
+			ac.Logger.Printf("❌ Token revoke error: %v", err)
+			conduitRes.Error(w, 500, "Çıkış yapılamadı")
+			return
+		}
+		ac.Logger.Printf("👋 User logged out from all devices: %s", claims.Email)
+		conduitRes.Success(w, 200, map[string]string{"message": "Tüm oturumlar kapatıldı"}, nil)
+		return
+	}
+
+	if err := auth.RevokeToken(claims); err != nil {
+		ac.Logger.Printf("❌ Token revoke error: %v", err)
+		conduitRes.Error(w, 500, "Çıkış yapılamadı")
+		return
+	}
+
+	// Refresh token (ve rotation ailesi) sadece aynı kullanıcıya aitse iptal edilir
+	if reqData.RefreshToken != "" {
+		refreshClaims, err := auth.ParseToken(reqData.RefreshToken, ac.JWTConfig)
+		if err == nil && refreshClaims.Role == "refresh" && refreshClaims.UserID == claims.UserID {
+			if err := auth.RevokeRefreshToken(ac.RefreshTokens, reqData.RefreshToken); err != nil {
+				ac.Logger.Printf("⚠️  Refresh token revoke error: %v", err)
+			}
+			if err := auth.RevokeToken(refreshClaims); err != nil {
+				ac.Logger.Printf("⚠️  Refresh token revoke error: %v", err)
+			}
 		}
 	}
 
-	// TODO (Phase 3): Token blacklist'e ekle (Redis)
-	// tokenBlacklist.Add(token, expirationTime)
+	ac.Logger.Printf("👋 User logged out: %s", claims.Email)
 
 	response := map[string]string{
 		"message": "Çıkış başarılı",
@@ -441,9 +505,11 @@ func (ac *AuthController) Logout(w http.ResponseWriter, r *conduitReq.Request) {
 //	  }
 //	}
 //
-// Güvenlik: Refresh Token Rotation
-// Her refresh token kullanıldığında yeni bir refresh token oluşturulur.
-// Bu sayede çalınan token'ların kullanımı minimize edilir.
+// Güvenlik: Refresh Token Rotation + Reuse Detection
+// Her refresh token kullanıldığında yeni bir refresh token oluşturulur ve eskisi
+// tüketilir (bkz: pkg/auth/refresh_tokens.go). Kullanılmış bir token tekrar
+// gelirse token'ın çalındığı varsayılır; login ile başlayan tüm zincir iptal
+// edilir ve kullanıcı yeniden giriş yapmak zorunda kalır.
 func (ac *AuthController) RefreshToken(w http.ResponseWriter, r *conduitReq.Request) {
 	ac.Logger.Println("🔄 Token refresh attempt...")
 
@@ -462,23 +528,35 @@ func (ac *AuthController) RefreshToken(w http.ResponseWriter, r *conduitReq.Requ
 		return
 	}
 
-	// 2. Refresh token'ı parse et
-	claims, err := auth.ParseToken(reqData.RefreshToken, ac.JWTConfig)
+	// 2. Refresh token'ı doğrula, tüket ve aynı aileden yenisini al (rotation).
+	// Daha önce kullanılmış bir token gelirse tüm aile iptal edilir.
+	claims, newRefreshToken, err := auth.RotateRefreshToken(ac.RefreshTokens, reqData.RefreshToken, refreshDevice(r), ac.JWTConfig)
+	if errors.Is(err, auth.ErrRefreshTokenReused) {
+		ac.Logger.Printf("🚨 Refresh token reuse detected, session family revoked (user: %d, ip: %s)", claims.UserID, r.GetIP())
+		conduitRes.Error(w, 401, "Oturum güvenlik nedeniyle sonlandırıldı, lütfen tekrar giriş yapın")
+		return
+	}
 	if err != nil {
 		ac.Logger.Printf("⚠️  Invalid refresh token: %v", err)
 		conduitRes.Error(w, 401, "Geçersiz veya süresi dolmuş refresh token")
 		return
 	}
 
-	// 3. Token'ın refresh token olduğunu kontrol et
-	if claims.Role != "refresh" {
-		ac.Logger.Printf("⚠️  Token is not a refresh token")
-		conduitRes.Error(w, 401, "Geçersiz token tipi")
+	// 3. "Tüm cihazlardan çıkış" (RevokeAllForUser) sonrası verilmiş olanlar hariç
+	revoked, err := auth.IsRevoked(claims)
+	if err != nil {
+		ac.Logger.Printf("❌ Token denylist error: %v", err)
+		conduitRes.Error(w, 503, "Token doğrulanamadı, lütfen tekrar deneyin")
+		return
+	}
+	if revoked {
+		ac.Logger.Printf("⚠️  Revoked refresh token used (user: %d)", claims.UserID)
+		conduitRes.Error(w, 401, "Geçersiz veya süresi dolmuş refresh token")
 		return
 	}
 
-	// 4. Kullanıcıyı database'den al (token'da user bilgisi olabilir ama güncel olmayabilir)
-	user, err := ac.UserRepository.FindByID(claims.UserID)
+	// 4. Kullanıcıyı provider'dan al (token'da user bilgisi olabilir ama güncel olmayabilir)
+	user, err := ac.Users.RetrieveByID(claims.UserID)
 	if err != nil {
 		ac.Logger.Printf("⚠️  User not found: %v", err)
 		conduitRes.Error(w, 401, "Kullanıcı bulunamadı")
@@ -486,30 +564,21 @@ func (ac *AuthController) RefreshToken(w http.ResponseWriter, r *conduitReq.Requ
 	}
 
 	// 5. Kullanıcı aktif mi kontrol et
-	if !user.IsActive() {
+	if !auth.IsActiveUser(user) {
 		conduitRes.Error(w, 403, "Hesabınız aktif değil")
 		return
 	}
 
-	// 6. Yeni token'lar oluştur (hem access hem refresh - rotation)
-	newAccessToken, err := auth.GenerateToken(user.ID, user.Email, user.GetRole(), ac.JWTConfig)
+	// 6. Yeni access token oluştur
+	newAccessToken, err := auth.GenerateToken(user.GetID(), user.GetEmail(), user.GetRole(), ac.JWTConfig)
 	if err != nil {
 		ac.Logger.Printf("❌ Token generation error: %v", err)
 		conduitRes.Error(w, 500, "Token oluşturulamadı")
 		return
 	}
 
-	newRefreshToken, err := auth.GenerateRefreshToken(user.ID, user.Email, ac.JWTConfig)
-	if err != nil {
-		ac.Logger.Printf("❌ Refresh token generation error: %v", err)
-		conduitRes.Error(w, 500, "Token oluşturulamadı")
-		return
-	}
-
 	// 7. Response hazırla
-	ac.Logger.Printf("✅ Token refreshed for user: %s (ID: %d)", user.Email, user.ID)
-
-	// TODO (Phase 3): Eski refresh token'ı blacklist'e ekle
+	ac.Logger.Printf("✅ Token refreshed for user: %s (ID: %d)", user.GetEmail(), user.GetID())
 
 	response := map[string]interface{}{
 		"access_token":  newAccessToken,
@@ -540,6 +609,8 @@ func (ac *AuthController) RefreshToken(w http.ResponseWriter, r *conduitReq.Requ
 //	    "created_at": "2024-01-01T10:00:00Z"
 //	  }
 //	}
+//
+// Impersonation token'ı ile çağrılırsa "impersonator_id" alanı da döner.
 func (ac *AuthController) Profile(w http.ResponseWriter, r *conduitReq.Request) {
 	// Context'ten user'ı al (Auth middleware tarafından set edilmiş)
 	contextUser := r.Context().Value("user")
@@ -573,14 +644,24 @@ func (ac *AuthController) Profile(w http.ResponseWriter, r *conduitReq.Request)
 		"updated_at":        user.UpdatedAt,
 	}
 
+	// Impersonation durumu (UI'da banner göstermek için)
+	if impersonatorID, ok := r.Context().Value("impersonator_id").(int64); ok {
+		response["impersonator_id"] = impersonatorID
+	}
+
 	conduitRes.Success(w, 200, response, nil)
 }
 
 // UpdateProfile, authenticated user'ın profil bilgilerini günceller.
 //
-// PUT /api/auth/profile
+// PUT   /api/auth/profile -> Tam güncelleme (tüm alanlar zorunlu)
+// PATCH /api/auth/profile -> Kısmi güncelleme (sadece gönderilen alanlar)
 // Authorization: Bearer {token}
 //
+// PATCH isteğinde gönderilmeyen alanlara dokunulmaz; gönderilen alanlar
+// (boş string dahil) normal kurallarla doğrulanır. Böylece profile yeni
+// alanlar eklendiğinde istemcilerin tüm body'yi göndermesi gerekmez.
+//
 // Request Body:
 //
 //	{
@@ -610,12 +691,9 @@ func (ac *AuthController) UpdateProfile(w http.ResponseWriter, r *conduitReq.Req
 		return
 	}
 
-	// 1. Request body'yi parse et
-	var reqData struct {
-		Name string `json:"name"`
-	}
-
-	if err := r.ParseJSON(&reqData); err != nil {
+	// 1. Request body'yi parse et (map: gönderilmeyen alan != sıfır değer)
+	payload, err := r.ParseJSONMap()
+	if err != nil {
 		conduitRes.Error(w, 400, "Geçersiz JSON formatı")
 		return
 	}
@@ -629,31 +707,39 @@ func (ac *AuthController) UpdateProfile(w http.ResponseWriter, r *conduitReq.Req
 			Label("Ad Soyad"),
 	})
 
-	result := schema.Validate(map[string]any{
-		"name": reqData.Name,
-	})
+	var result *validation.ValidationResult
+	if r.Method == http.MethodPatch {
+		result = schema.ValidatePartial(payload)
+	} else {
+		result = schema.Validate(payload)
+	}
 
 	if result.HasErrors() {
 		conduitRes.Error(w, 422, result.Errors())
 		return
 	}
 
-	// 3. User'ı database'den çek
-	user, err := ac.UserRepository.FindByID(authUser.GetID())
-	if err != nil {
-		conduitRes.Error(w, 404, "Kullanıcı bulunamadı")
+	fields := result.ValidData()
+	if len(fields) == 0 {
+		conduitRes.Error(w, 422, "Güncellenecek alan bulunamadı")
 		return
 	}
 
-	// 4. Güncelle
-	user.Name = result.ValidData()["name"].(string)
-	if err := ac.UserRepository.Update(user); err != nil {
+	// 3. Sadece doğrulanan alanları güncelle
+	if err := ac.UserRepository.UpdateFields(authUser.GetID(), fields); err != nil {
 		ac.Logger.Printf("❌ Profile update error: %v", err)
 		conduitRes.Error(w, 500, "Profil güncellenemedi")
 		return
 	}
 
-	ac.Logger.Printf("✅ Profile updated: %s (ID: %d)", user.Email, user.ID)
+	// 4. Güncel user'ı database'den çek
+	user, err := ac.UserRepository.FindByID(authUser.GetID())
+	if err != nil {
+		conduitRes.Error(w, 404, "Kullanıcı bulunamadı")
+		return
+	}
+
+	ac.Logger.Printf("✅ Profile updated: %s (ID: %d, fields: %d)", user.Email, user.ID, len(fields))
 
 	response := map[string]interface{}{
 		"message": "Profil başarıyla güncellendi",
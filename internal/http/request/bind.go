@@ -0,0 +1,280 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultTimeLayouts, time_format tag'i olmayan time.Time alanlarında
+// sırayla denenen formatlardır.
+var defaultTimeLayouts = []string{time.RFC3339, "2006-01-02"}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// BindError, bind sırasında dönüştürülemeyen alanları içerir. Fields,
+// response.ValidationError ile doğrudan 422 olarak döndürülebilir.
+type BindError struct {
+	Fields map[string][]string // Alan adı -> hata mesajları
+}
+
+// Error, error interface'ini implement eder.
+func (e *BindError) Error() string {
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("bind hatası: %s", strings.Join(names, ", "))
+}
+
+// BindQuery, URL query parametrelerini dest struct'ına doldurur.
+//
+// Alan adı `query` tag'inden, yoksa `json` tag'inden alınır; ikisi de yoksa
+// (veya tag "-" ise) alan atlanır. Gönderilmeyen veya boş gönderilen
+// alanlara `default` tag'i uygulanır; default yoksa alan değişmez.
+//
+// Desteklenen tipler: string, bool (true/false, 1/0, on/off, yes/no),
+// int*, uint*, float*, time.Time (`time_format` tag'i, varsayılan RFC3339
+// veya 2006-01-02), time.Duration ("1h30m"), bunların pointer'ları (alan
+// gönderilmezse nil kalır) ve slice'ları (?status=a&status=b). Gömülü
+// struct'ların alanları da doldurulur.
+//
+// Parametre:
+//   - dest: Struct pointer'ı
+//
+// Döndürür:
+//   - error: Dönüştürülemeyen alanlar varsa *BindError, dest struct pointer'ı
+//     değilse hata
+//
+// Örnek:
+//
+//	type UserFilter struct {
+//	    Search  string    `query:"q"`
+//	    Page    int       `query:"page" default:"1"`
+//	    PerPage int       `query:"per_page" default:"20"`
+//	    Active  *bool     `query:"active"`
+//	    Roles   []string  `query:"role"`
+//	    Since   time.Time `query:"since" time_format:"2006-01-02"`
+//	}
+//
+//	var filter UserFilter
+//	if err := r.BindQuery(&filter); err != nil {
+//	    var bindErr *request.BindError
+//	    if errors.As(err, &bindErr) {
+//	        response.ValidationError(w, bindErr.Fields)
+//	        return
+//	    }
+//	    response.BadRequest(w, "")
+//	    return
+//	}
+func (r *Request) BindQuery(dest any) error {
+	return bindValues(r.URL.Query(), "query", dest)
+}
+
+// BindForm, form gövdesini (application/x-www-form-urlencoded veya
+// multipart/form-data) dest struct'ına doldurur. Query string dahil
+// edilmez. Alan adları `form` tag'inden, yoksa `json` tag'inden alınır;
+// kurallar BindQuery ile aynıdır.
+//
+// Örnek:
+//
+//	type ContactForm struct {
+//	    Name      string `form:"name"`
+//	    Subscribe bool   `form:"subscribe"` // checkbox: "on"
+//	}
+func (r *Request) BindForm(dest any) error {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.parseMultipart(); err != nil {
+			return err
+		}
+	} else if err := r.ParseForm(); err != nil {
+		return err
+	}
+	return bindValues(r.PostForm, "form", dest)
+}
+
+// bindValues, values'u tag ile eşlenen dest alanlarına doldurur.
+func bindValues(values url.Values, tag string, dest any) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind hedefi struct pointer'ı olmalıdır, %T verildi", dest)
+	}
+
+	errs := make(map[string][]string)
+	bindStruct(v.Elem(), values, tag, errs)
+	if len(errs) > 0 {
+		return &BindError{Fields: errs}
+	}
+	return nil
+}
+
+// bindStruct, struct'ın alanlarını doldurur; hataları errs'e ekler.
+func bindStruct(v reflect.Value, values url.Values, tag string, errs map[string][]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			bindStruct(v.Field(i), values, tag, errs)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name := bindFieldName(field, tag)
+		if name == "" {
+			continue
+		}
+
+		raw := nonEmpty(values[name])
+		if len(raw) == 0 {
+			def, ok := field.Tag.Lookup("default")
+			if !ok {
+				continue
+			}
+			raw = []string{def}
+			if field.Type.Kind() == reflect.Slice {
+				raw = strings.Split(def, ",")
+			}
+		}
+
+		if err := setField(v.Field(i), raw, field.Tag.Get("time_format")); err != nil {
+			errs[name] = append(errs[name], fmt.Sprintf("%s alanı %s", name, err))
+		}
+	}
+}
+
+// bindFieldName, alanın query/form adını döndürür ("" = atla).
+func bindFieldName(field reflect.StructField, tag string) string {
+	name, ok := field.Tag.Lookup(tag)
+	if !ok {
+		name, _, _ = strings.Cut(field.Tag.Get("json"), ",")
+	}
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// nonEmpty, boş değerleri çıkarır.
+func nonEmpty(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// setField, raw değerleri alanın tipine çevirip atar. Slice olmayan
+// alanlarda ilk değer kullanılır.
+func setField(v reflect.Value, raw []string, layout string) error {
+	if v.Kind() == reflect.Slice {
+		slice := reflect.MakeSlice(v.Type(), len(raw), len(raw))
+		for i, s := range raw {
+			if err := setValue(slice.Index(i), s, layout); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+	return setValue(v, raw[0], layout)
+}
+
+// setValue, s'yi v'nin tipine çevirip atar. Dönen hata, alan adından sonra
+// gelecek mesajdır (örn: "tam sayı olmalıdır").
+func setValue(v reflect.Value, s, layout string) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := setValue(elem.Elem(), s, layout); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	switch v.Type() {
+	case timeType:
+		t, err := parseBindTime(s, layout)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	case durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return errors.New("geçerli bir süre olmalıdır (örn: 30s, 1h)")
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := parseBindBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("tam sayı olmalıdır")
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(s), 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("negatif olmayan tam sayı olmalıdır")
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), v.Type().Bits())
+		if err != nil {
+			return errors.New("sayı olmalıdır")
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("desteklenmeyen tipte (%s)", v.Type())
+	}
+	return nil
+}
+
+// parseBindBool, checkbox değerlerini de kabul eden bool dönüşümüdür.
+func parseBindBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "true", "on", "yes":
+		return true, nil
+	case "0", "false", "off", "no":
+		return false, nil
+	}
+	return false, errors.New("boolean olmalıdır")
+}
+
+// parseBindTime, s'yi layout ile (boşsa varsayılan formatlarla) parse eder.
+func parseBindTime(s, layout string) (time.Time, error) {
+	layouts := defaultTimeLayouts
+	if layout != "" {
+		layouts = []string{layout}
+	}
+	for _, l := range layouts {
+		if t, err := time.Parse(l, strings.TrimSpace(s)); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("geçerli bir tarih olmalıdır (%s)", layouts[0])
+}
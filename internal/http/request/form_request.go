@@ -0,0 +1,100 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/biyonik/conduit-go/pkg/validation"
+)
+
+// ErrFormUnauthorized, FormRequest.Authorize false döndüğünde döner.
+var ErrFormUnauthorized = errors.New("form request yetkilendirilmedi")
+
+// FormRequest, bir endpoint'in yetkilendirme ve doğrulama kurallarını tek
+// bir struct'ta toplar (Laravel Form Request).
+//
+// Doğrulama başarılıysa validData, json tag'lerine göre struct alanlarına
+// doldurulur; handler tipli alanları doğrudan kullanır.
+//
+// Örnek:
+//
+//	type StorePostRequest struct {
+//	    Title string `json:"title"`
+//	    Body  string `json:"body"`
+//	}
+//
+//	func (f *StorePostRequest) Authorize(r *request.Request) bool {
+//	    return r.IsAuthenticated()
+//	}
+//
+//	func (f *StorePostRequest) Rules() map[string]validation.Type {
+//	    return map[string]validation.Type{
+//	        "title": types.String().Required().Max(255),
+//	        "body":  types.String().Required(),
+//	    }
+//	}
+type FormRequest interface {
+	// Authorize, isteği yapan kullanıcının bu işlemi yapıp yapamayacağını
+	// belirler. false dönerse doğrulama yapılmaz (403).
+	Authorize(r *Request) bool
+
+	// Rules, alan adı -> doğrulama tipi eşlemesini döndürür.
+	Rules() map[string]validation.Type
+}
+
+// ValidateForm, form request'i yetkilendirir, body'yi kurallara göre
+// doğrular ve geçerli veriyi form'a doldurur.
+//
+// JSON isteklerde body, diğerlerinde query string ve form alanları
+// doğrulanır.
+//
+// Parametreler:
+//   - form: FormRequest implementasyonu (struct pointer'ı)
+//
+// Döndürür:
+//   - *validation.ValidationResult: Doğrulama sonucu
+//   - error: ErrFormUnauthorized, body parse hatası veya form doldurma hatası
+//
+// Örnek:
+//
+//	var form StorePostRequest
+//	result, err := r.ValidateForm(&form)
+func (r *Request) ValidateForm(form FormRequest) (*validation.ValidationResult, error) {
+	if !form.Authorize(r) {
+		return nil, ErrFormUnauthorized
+	}
+
+	data, err := r.formInput()
+	if err != nil {
+		return nil, err
+	}
+
+	result := validation.Make().Shape(form.Rules()).Validate(data)
+	if result.HasErrors() {
+		return result, nil
+	}
+
+	// validData'yı json tag'leri üzerinden struct alanlarına aktar
+	encoded, err := json.Marshal(result.ValidData())
+	if err != nil {
+		return nil, fmt.Errorf("form verisi encode edilemedi: %w", err)
+	}
+	if err := json.Unmarshal(encoded, form); err != nil {
+		return nil, fmt.Errorf("form verisi %T'ye aktarılamadı: %w", form, err)
+	}
+
+	return result, nil
+}
+
+// formInput, doğrulanacak girdiyi map olarak döndürür.
+func (r *Request) formInput() (map[string]any, error) {
+	if r.IsJSON() {
+		return r.ParseJSONMap()
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	return valuesToMap(r.Form), nil
+}
@@ -0,0 +1,187 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// All, isteğin tüm girdisini tek bir map'te döndürür (Laravel $request->all()).
+//
+// Kaynaklar düşükten yükseğe öncelikle birleştirilir:
+//  1. Query string
+//  2. Form gövdesi (urlencoded veya multipart) ya da JSON gövde
+//  3. Route parametreleri (URL'deki {id} gövdeyle ezilemez)
+//
+// Tek değerli query/form alanları string, çok değerliler []any olur. JSON
+// gövde ilk çağrıda bir kez okunup Request üzerinde cache'lenir ve
+// r.Body geri yüklenir; sonrasında ParseJSON/ParseJSONMap çalışmaya devam
+// eder. Geçersiz veya object olmayan JSON gövde yok sayılır (hatayı görmek
+// için ParseJSONMap kullanılır).
+//
+// Döndürülen map bir kopyadır; değiştirmek cache'i etkilemez.
+//
+// Örnek:
+//
+//	// PUT /users/42?notify=1  {"name": "Ada"}
+//	r.All() // {"id": "42", "notify": "1", "name": "Ada"}
+func (r *Request) All() map[string]any {
+	input := r.inputValues()
+
+	all := make(map[string]any, len(input))
+	for key, value := range input {
+		all[key] = value
+	}
+	return all
+}
+
+// Input, key'in değerini döndürür; gönderilmemişse defaultValue. Nokta
+// ile iç içe JSON alanlarına ve dizi elemanlarına erişilir
+// ("user.address.city", "items.0.sku").
+//
+// Örnek:
+//
+//	name := r.Input("name", "").(string)
+//	city := r.Input("user.address.city", "İstanbul")
+//	page := r.Input("page", "1") // Query'den gelen değerler string'dir
+func (r *Request) Input(key string, defaultValue any) any {
+	input := r.inputValues()
+
+	if value, ok := input[key]; ok {
+		return value
+	}
+	if !strings.Contains(key, ".") {
+		return defaultValue
+	}
+
+	var current any = input
+	for _, segment := range strings.Split(key, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[segment]
+			if !ok {
+				return defaultValue
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return defaultValue
+			}
+			current = node[index]
+		default:
+			return defaultValue
+		}
+	}
+	return current
+}
+
+// Only, sadece verilen alanları içeren bir map döndürür; gönderilmeyen
+// alanlar map'e eklenmez. Mass assignment'tan korunmak için kullanılır.
+//
+// Örnek:
+//
+//	data := r.Only("name", "email") // role, is_admin gibi alanlar atılır
+func (r *Request) Only(keys ...string) map[string]any {
+	input := r.inputValues()
+
+	only := make(map[string]any, len(keys))
+	for _, key := range keys {
+		if value, ok := input[key]; ok {
+			only[key] = value
+		}
+	}
+	return only
+}
+
+// Except, verilen alanlar dışındaki tüm girdiyi döndürür.
+//
+// Örnek:
+//
+//	data := r.Except("password", "password_confirmation")
+func (r *Request) Except(keys ...string) map[string]any {
+	except := r.All()
+	for _, key := range keys {
+		delete(except, key)
+	}
+	return except
+}
+
+// inputValues, birleştirilmiş girdiyi bir kez oluşturup cache'ler.
+func (r *Request) inputValues() map[string]any {
+	if r.input != nil {
+		return r.input
+	}
+
+	input := valuesToMap(r.URL.Query())
+
+	switch {
+	case r.IsJSON():
+		for key, value := range r.jsonBody() {
+			input[key] = value
+		}
+	case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"):
+		if r.parseMultipart() == nil {
+			for key, value := range valuesToMap(r.PostForm) {
+				input[key] = value
+			}
+		}
+	default:
+		if r.ParseForm() == nil {
+			for key, value := range valuesToMap(r.PostForm) {
+				input[key] = value
+			}
+		}
+	}
+
+	if params, ok := r.Context().Value(RequestParamsKey).(map[string]string); ok {
+		for key, value := range params {
+			input[key] = value
+		}
+	}
+
+	r.input = input
+	return input
+}
+
+// jsonBody, JSON gövdeyi okur, r.Body'yi geri yükler ve object ise map
+// olarak döndürür.
+func (r *Request) jsonBody() map[string]any {
+	if r.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, 10<<20))
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+	return payload
+}
+
+// valuesToMap, url.Values'u tek değerliler string, çok değerliler []any
+// olacak şekilde map'e çevirir.
+func valuesToMap(values url.Values) map[string]any {
+	data := make(map[string]any, len(values))
+	for key, items := range values {
+		if len(items) == 1 {
+			data[key] = items[0]
+			continue
+		}
+		list := make([]any, len(items))
+		for i, item := range items {
+			list[i] = item
+		}
+		data[key] = list
+	}
+	return data
+}
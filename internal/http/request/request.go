@@ -4,12 +4,14 @@
 package request
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"strings"
 
+	"github.com/biyonik/conduit-go/internal/http/response"
 	"github.com/biyonik/conduit-go/pkg/auth"
 )
 
@@ -28,6 +30,8 @@ var RequestParamsKey = RequestParamsKeyType{}
 // Request yapısı, http.Request yapısının üzerine inşa edilmiş bir sarmalayıcıdır.
 type Request struct {
 	*http.Request
+
+	input map[string]any // All/Input için birleştirilmiş girdi (ilk erişimde doldurulur)
 }
 
 // New, alınan *http.Request nesnesini bizim Request modelimize dönüştüren
@@ -69,6 +73,21 @@ func (r *Request) Query(key string, defaultValue string) string {
 	return vals[0]
 }
 
+// CookieValue, cookie'nin değerini döndürür; cookie yoksa defaultValue.
+// EncryptCookies middleware'i kullanılıyorsa değer çözülmüş haldedir;
+// değiştirilmiş (tamper) cookie'ler yok sayılır.
+//
+// Örnek:
+//
+//	locale := r.CookieValue("locale", "tr")
+func (r *Request) CookieValue(name string, defaultValue string) string {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return defaultValue
+	}
+	return cookie.Value
+}
+
 // RouteParam, route parametrelerini almak için kullanılır.
 func (r *Request) RouteParam(key string) string {
 	params, ok := r.Context().Value(RequestParamsKey).(map[string]string)
@@ -112,6 +131,41 @@ func (r *Request) ParseJSON(dest interface{}) error {
 	return nil
 }
 
+// ParseJSONMap, request body'sini map olarak parse eder.
+//
+// Struct'a parse etmenin aksine, gönderilmeyen alan ile sıfır değerle
+// ("", 0, false, null) gönderilen alan ayırt edilebilir. PATCH endpoint'lerinde
+// validation.ValidatePartial ile birlikte kullanılır.
+//
+// Döndürür:
+//   - map[string]any: Payload (body boşsa boş map)
+//   - error: JSON object değilse veya parse hatası varsa
+//
+// Örnek:
+//
+//	payload, err := r.ParseJSONMap()
+//	if _, sent := payload["name"]; sent {
+//	    // name alanı gönderilmiş (boş string bile olsa)
+//	}
+func (r *Request) ParseJSONMap() (map[string]any, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 10<<20))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	payload := make(map[string]any)
+	if len(bytes.TrimSpace(body)) == 0 {
+		return payload, nil
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
 // GetIP, client'ın IP adresini döndürür.
 // Reverse proxy arkasındaysa X-Forwarded-For header'ını kontrol eder.
 //
@@ -168,6 +222,32 @@ func (r *Request) Accepts(contentType string) bool {
 	return strings.Contains(accept, contentType) || strings.Contains(accept, "*/*")
 }
 
+// AcceptedTypes, Accept header'ındaki media type'ları tercih sırasıyla
+// döndürür (bkz: response.ParseAccept).
+//
+// Örnek:
+//
+//	// Accept: text/html;q=0.8, application/xml, */*;q=0.1
+//	r.AcceptedTypes() // ["application/xml", "text/html", "*/*"]
+func (r *Request) AcceptedTypes() []string {
+	return response.ParseAccept(r.Header.Get("Accept"))
+}
+
+// WantsJSON, client'ın en çok tercih ettiği yanıt tipinin JSON
+// (application/json veya +json ile biten bir tip) olup olmadığını döndürür.
+//
+// Örnek:
+//
+//	if r.WantsJSON() {
+//	    response.Error(w, 401, "Unauthorized")
+//	    return
+//	}
+//	http.Redirect(w, r.Request, "/login", http.StatusFound)
+func (r *Request) WantsJSON() bool {
+	types := r.AcceptedTypes()
+	return len(types) > 0 && (strings.HasSuffix(types[0], "/json") || strings.HasSuffix(types[0], "+json"))
+}
+
 // AuthUser retrieves the authenticated user from the request context.
 //
 // This method extracts the user that was set by the Auth middleware.
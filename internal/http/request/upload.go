@@ -0,0 +1,290 @@
+package request
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/biyonik/conduit-go/pkg/storage"
+)
+
+// DefaultMultipartMaxMemory, multipart form'un bellekte tutulacak varsayılan
+// boyutudur (32 MB, net/http ile aynı). Aşan dosya parçaları geçici
+// dosyalara yazılır.
+const DefaultMultipartMaxMemory int64 = 32 << 20
+
+// sniffLength, MIME tespiti için okunan byte sayısıdır
+// (http.DetectContentType en fazla 512 byte'a bakar).
+const sniffLength = 512
+
+var multipartMaxMemory atomic.Int64
+
+func init() {
+	multipartMaxMemory.Store(DefaultMultipartMaxMemory)
+}
+
+// SetMultipartMaxMemory, File/Files çağrılarında multipart form'un bellekte
+// tutulacak maksimum boyutunu ayarlar (UPLOAD_MAX_MEMORY_MB). Uygulama
+// başlangıcında bir kez çağrılır; 0 veya negatif değer varsayılana döner.
+//
+// Not: Bu bir boyut limiti değildir; toplam istek boyutunu sınırlamak için
+// http.MaxBytesReader kullanılmalıdır.
+func SetMultipartMaxMemory(bytes int64) {
+	if bytes <= 0 {
+		bytes = DefaultMultipartMaxMemory
+	}
+	multipartMaxMemory.Store(bytes)
+}
+
+// MultipartMaxMemory, aktif multipart bellek limitini döndürür.
+func MultipartMaxMemory() int64 {
+	return multipartMaxMemory.Load()
+}
+
+// UploadedFile, multipart istekle yüklenmiş bir dosyadır.
+type UploadedFile struct {
+	header   *multipart.FileHeader
+	mimeType string // MimeType ile tespit edildikten sonra saklanır
+}
+
+// File, key alanında yüklenen dosyayı döndürür. Alanda birden fazla dosya
+// varsa ilki döner.
+//
+// Parametre:
+//   - key: Form alanının adı
+//
+// Döndürür:
+//   - *UploadedFile: Yüklenen dosya
+//   - error: Alan boşsa http.ErrMissingFile, istek multipart değilse
+//     http.ErrNotMultipart, form okunamazsa parse hatası
+//
+// Örnek:
+//
+//	avatar, err := r.File("avatar")
+//	if errors.Is(err, http.ErrMissingFile) {
+//	    response.Error(w, 422, "Avatar gönderilmelidir")
+//	    return
+//	}
+//	path, err := avatar.Store("storage/avatars")
+func (r *Request) File(key string) (*UploadedFile, error) {
+	files, err := r.Files(key)
+	if err != nil {
+		return nil, err
+	}
+	return files[0], nil
+}
+
+// Files, key alanında yüklenen tüm dosyaları döndürür
+// (<input type="file" name="photos" multiple>).
+func (r *Request) Files(key string) ([]*UploadedFile, error) {
+	if err := r.parseMultipart(); err != nil {
+		return nil, err
+	}
+
+	headers := r.MultipartForm.File[key]
+	if len(headers) == 0 {
+		return nil, http.ErrMissingFile
+	}
+
+	files := make([]*UploadedFile, len(headers))
+	for i, header := range headers {
+		files[i] = &UploadedFile{header: header}
+	}
+	return files, nil
+}
+
+// HasFile, key alanında en az bir dosya yüklenip yüklenmediğini döndürür.
+func (r *Request) HasFile(key string) bool {
+	_, err := r.Files(key)
+	return err == nil
+}
+
+// parseMultipart, multipart form'u bir kez parse eder.
+func (r *Request) parseMultipart() error {
+	if r.MultipartForm != nil {
+		return nil
+	}
+	if err := r.ParseMultipartForm(MultipartMaxMemory()); err != nil {
+		if errors.Is(err, http.ErrNotMultipart) {
+			return err
+		}
+		return fmt.Errorf("multipart form okunamadı: %w", err)
+	}
+	return nil
+}
+
+// Name, client'ın gönderdiği dosya adıdır (dizin kısmı çıkarılmış).
+// Kullanıcı girdisidir; diske yazarken dosya adı olarak kullanılmamalıdır.
+func (f *UploadedFile) Name() string {
+	return path.Base(strings.ReplaceAll(f.header.Filename, `\`, "/"))
+}
+
+// Extension, orijinal dosya adının küçük harfli uzantısıdır (nokta olmadan,
+// örn: "png"). Harf ve rakam dışında karakter içeren uzantılar yok sayılır.
+func (f *UploadedFile) Extension() string {
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(f.Name()), "."))
+	for _, c := range ext {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return ""
+		}
+	}
+	return ext
+}
+
+// Size, dosyanın byte cinsinden boyutudur.
+func (f *UploadedFile) Size() int64 {
+	return f.header.Size
+}
+
+// ClientMimeType, client'ın gönderdiği Content-Type'tır. Kullanıcı
+// girdisidir; doğrulama için MimeType kullanılmalıdır.
+func (f *UploadedFile) ClientMimeType() string {
+	return f.header.Header.Get("Content-Type")
+}
+
+// MimeType, dosyanın içeriğinden tespit edilen MIME tipidir
+// (http.DetectContentType, parametreler olmadan; örn: "image/png").
+// Tanınmayan içerik için "application/octet-stream" döner.
+//
+// Örnek:
+//
+//	mimeType, err := avatar.MimeType()
+//	if err != nil || (mimeType != "image/png" && mimeType != "image/jpeg") {
+//	    response.Error(w, 422, "Avatar PNG veya JPEG olmalıdır")
+//	    return
+//	}
+func (f *UploadedFile) MimeType() (string, error) {
+	if f.mimeType != "" {
+		return f.mimeType, nil
+	}
+
+	file, err := f.header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	mimeType, _, _ := strings.Cut(http.DetectContentType(buf[:n]), ";")
+	f.mimeType = strings.TrimSpace(mimeType)
+	return f.mimeType, nil
+}
+
+// Open, dosya içeriğini okumak için açar. Dönen dosya kapatılmalıdır.
+func (f *UploadedFile) Open() (multipart.File, error) {
+	return f.header.Open()
+}
+
+// Header, alttaki multipart.FileHeader'ı döndürür.
+func (f *UploadedFile) Header() *multipart.FileHeader {
+	return f.header
+}
+
+// Store, dosyayı dir dizinine rastgele bir adla (40 hex karakter + orijinal
+// uzantı) kaydeder. Dizin yoksa oluşturulur.
+//
+// Döndürür:
+//   - string: Kaydedilen dosyanın yolu (örn: "storage/avatars/3f9c...e1.png")
+//   - error: Yazma hatası
+func (f *UploadedFile) Store(dir string) (string, error) {
+	name, err := f.hashName()
+	if err != nil {
+		return "", err
+	}
+	return f.StoreAs(dir, name)
+}
+
+// StoreAs, dosyayı dir dizinine name adıyla kaydeder; aynı adlı dosyanın
+// üzerine yazılır. name dizin içeremez.
+func (f *UploadedFile) StoreAs(dir, name string) (string, error) {
+	if err := validateStoreName(name); err != nil {
+		return "", err
+	}
+
+	src, err := f.header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("upload dizini oluşturulamadı: %w", err)
+	}
+
+	dest := filepath.Join(dir, name)
+	out, err := os.Create(dest)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(out, src); err != nil {
+		out.Close()
+		os.Remove(dest)
+		return "", fmt.Errorf("dosya kaydedilemedi: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
+
+// StoreOn, dosyayı disk'te dir altına rastgele bir adla kaydeder
+// (local veya S3 storage).
+//
+// Döndürür:
+//   - string: Storage içindeki yol (örn: "avatars/3f9c...e1.png"); disk.Url
+//     ile URL'e çevrilebilir
+//   - error: Yazma hatası
+func (f *UploadedFile) StoreOn(disk storage.Storage, dir string) (string, error) {
+	name, err := f.hashName()
+	if err != nil {
+		return "", err
+	}
+
+	src, err := f.header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dest := path.Join(dir, name)
+	if err := disk.PutFile(dest, src); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
+
+// hashName, Store için rastgele bir dosya adı üretir.
+func (f *UploadedFile) hashName() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	name := hex.EncodeToString(buf)
+	if ext := f.Extension(); ext != "" {
+		name += "." + ext
+	}
+	return name, nil
+}
+
+// validateStoreName, StoreAs'e verilen adın dizin dışına çıkmamasını sağlar.
+func validateStoreName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) || strings.ContainsRune(name, 0) {
+		return fmt.Errorf("geçersiz dosya adı: %q", name)
+	}
+	return nil
+}
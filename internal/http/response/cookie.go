@@ -0,0 +1,105 @@
+// -----------------------------------------------------------------------------
+// Cookie Helpers
+// -----------------------------------------------------------------------------
+// Ortama duyarlı varsayılanlarla (Path, Secure, SameSite, HttpOnly) cookie
+// set etme ve silme. Değerler EncryptCookies middleware'i aktifse client'a
+// şifreli gider.
+//
+//	response.Cookie(w, "locale", "tr", 30*24*time.Hour)
+//	response.ForgetCookie(w, "locale")
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CookieDefaults, Cookie ve NewCookie ile oluşturulan cookie'lerin ortama
+// duyarlı varsayılanlarıdır (SECURITY_COOKIE_SECURE, SECURITY_COOKIE_SAMESITE).
+type CookieDefaults struct {
+	Path     string        // Boşsa "/"
+	Domain   string        // Boşsa host-only cookie
+	Secure   bool          // Sadece HTTPS üzerinden gönderilir
+	SameSite http.SameSite // SameSite politikası
+}
+
+var (
+	cookieDefaultsMu sync.RWMutex
+	cookieDefaults   = CookieDefaults{Path: "/", SameSite: http.SameSiteLaxMode}
+)
+
+// SetCookieDefaults, cookie varsayılanlarını değiştirir. Uygulamada
+// middleware.SetSecurityProfile tarafından çağrılır; doğrudan çağırmak
+// genellikle gerekmez.
+func SetCookieDefaults(defaults CookieDefaults) {
+	if defaults.Path == "" {
+		defaults.Path = "/"
+	}
+
+	cookieDefaultsMu.Lock()
+	defer cookieDefaultsMu.Unlock()
+	cookieDefaults = defaults
+}
+
+// GetCookieDefaults, aktif cookie varsayılanlarını döndürür.
+func GetCookieDefaults() CookieDefaults {
+	cookieDefaultsMu.RLock()
+	defer cookieDefaultsMu.RUnlock()
+	return cookieDefaults
+}
+
+// NewCookie, varsayılanlar uygulanmış bir cookie oluşturur: HttpOnly,
+// Path/Domain/Secure/SameSite CookieDefaults'tan. Alanlar set edilmeden
+// önce değiştirilebilir (örn: JavaScript'in okuyacağı cookie için
+// HttpOnly = false).
+//
+// Parametreler:
+//   - name: Cookie adı
+//   - value: Cookie değeri
+//   - maxAge: Ömür (0: tarayıcı kapanınca silinen session cookie'si)
+//
+// Örnek:
+//
+//	cookie := response.NewCookie("csrf_token", token, 2*time.Hour)
+//	cookie.HttpOnly = false
+//	http.SetCookie(w, cookie)
+func NewCookie(name, value string, maxAge time.Duration) *http.Cookie {
+	defaults := GetCookieDefaults()
+
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     defaults.Path,
+		Domain:   defaults.Domain,
+		HttpOnly: true,
+		Secure:   defaults.Secure,
+		SameSite: defaults.SameSite,
+	}
+	if maxAge > 0 {
+		cookie.MaxAge = int(maxAge.Seconds())
+		cookie.Expires = time.Now().Add(maxAge)
+	}
+	return cookie
+}
+
+// Cookie, varsayılanlarla bir cookie set eder. EncryptCookies middleware'i
+// kullanılıyorsa değer client'a şifreli gider.
+//
+// Örnek:
+//
+//	response.Cookie(w, "locale", "tr", 365*24*time.Hour)
+func Cookie(w http.ResponseWriter, name, value string, maxAge time.Duration) {
+	http.SetCookie(w, NewCookie(name, value, maxAge))
+}
+
+// ForgetCookie, cookie'yi client'tan siler. Path ve Domain, cookie set
+// edilirken kullanılanlarla (varsayılanlar) aynı olmalıdır.
+func ForgetCookie(w http.ResponseWriter, name string) {
+	cookie := NewCookie(name, "", 0)
+	cookie.MaxAge = -1
+	cookie.Expires = time.Unix(0, 0)
+	http.SetCookie(w, cookie)
+}
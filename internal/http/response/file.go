@@ -0,0 +1,210 @@
+// -----------------------------------------------------------------------------
+// File Responses
+// -----------------------------------------------------------------------------
+// Diskteki dosyaları ve io.Reader içeriklerini indirme (attachment) veya
+// tarayıcıda gösterme (inline) olarak gönderir. Range, If-Modified-Since ve
+// HEAD istekleri http.ServeContent üzerinden desteklenir; dosya adı RFC 5987
+// ile Content-Disposition'a yazılır.
+//
+//	response.Download(w, r.Request, "storage/reports/2024.pdf", "rapor.pdf")
+//	response.File(w, r.Request, "storage/avatars/42.png")
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Download, diskteki dosyayı indirme (Content-Disposition: attachment)
+// olarak gönderir. Content-Type dosya adının uzantısından belirlenir;
+// Range (kısmi içerik, indirme devam ettirme), If-Modified-Since ve HEAD
+// istekleri desteklenir. Dosya belleğe alınmaz, sunucunun WriteTimeout'u
+// büyük dosyalar için kaldırılır.
+//
+// Dosya yoksa 404, açılamıyorsa 500 JSON hata yanıtı gönderilir ve hata
+// döndürülür. path kullanıcı girdisinden oluşturulmamalıdır (path
+// traversal); kullanıcıya ait dosyalar ID ile bulunup sunucu tarafında
+// path'e çevrilmelidir.
+//
+// Parametreler:
+//   - w: Yanıt yazıcısı
+//   - r: İstek (Range ve koşullu header'lar için)
+//   - path: Gönderilecek dosyanın yolu
+//   - filename: Client'ın göreceği dosya adı (boşsa path'in son elemanı)
+//
+// Örnek:
+//
+//	path := filepath.Join("storage", "reports", report.File)
+//	if err := response.Download(w, r.Request, path, "rapor-2024.pdf"); err != nil {
+//	    logger.Printf("❌ Report download failed: %v", err)
+//	}
+func Download(w http.ResponseWriter, r *http.Request, path, filename string) error {
+	return serveFile(w, r, path, "attachment", filename)
+}
+
+// File, diskteki dosyayı tarayıcıda gösterilecek şekilde (Content-Disposition:
+// inline) gönderir; PDF ve görsel önizlemeleri için kullanılır. Davranış
+// Download ile aynıdır.
+//
+// Örnek:
+//
+//	response.File(w, r.Request, filepath.Join("storage", "invoices", "1001.pdf"))
+func File(w http.ResponseWriter, r *http.Request, path string) error {
+	return serveFile(w, r, path, "inline", "")
+}
+
+// DownloadReader, bir reader'ın içeriğini indirme olarak gönderir (örn:
+// bellekte üretilen bir rapor, object storage'dan okunan bir dosya).
+//
+// content io.ReadSeeker ise (bytes.Reader, *os.File) Download gibi Range
+// desteklenir ve Content-Length gönderilir; değilse içerik Stream ile parça
+// parça gönderilir. Content-Type dosya adının uzantısından, bilinmiyorsa
+// içeriğin ilk 512 byte'ından belirlenir. content'i kapatmak çağıranın
+// sorumluluğundadır.
+//
+// Örnek:
+//
+//	var buf bytes.Buffer
+//	report.WriteTo(&buf)
+//	response.DownloadReader(w, r.Request, bytes.NewReader(buf.Bytes()), "rapor.xlsx")
+func DownloadReader(w http.ResponseWriter, r *http.Request, content io.Reader, filename string) error {
+	header := w.Header()
+	header.Set("Content-Disposition", ContentDisposition("attachment", filename))
+	setFileContentType(header, filename)
+
+	if seeker, ok := content.(io.ReadSeeker); ok {
+		clearWriteDeadline(w)
+		http.ServeContent(w, r, filename, time.Time{}, seeker)
+		return nil
+	}
+
+	reader := bufio.NewReader(content)
+	if header.Get("Content-Type") == "" {
+		head, _ := reader.Peek(512)
+		header.Set("Content-Type", http.DetectContentType(head))
+	}
+
+	return Stream(w, func(out io.Writer) error {
+		_, err := io.Copy(out, reader)
+		return err
+	})
+}
+
+// ContentDisposition, filename için bir Content-Disposition değeri üretir
+// (kind: "attachment" veya "inline"). ASCII olmayan adlar RFC 6266'ya göre
+// filename* ile UTF-8 olarak, eski client'lar için ASCII karşılığıyla
+// birlikte yazılır.
+//
+// Örnek:
+//
+//	response.ContentDisposition("attachment", "özet.csv")
+//	// attachment; filename="_zet.csv"; filename*=UTF-8''%C3%B6zet.csv
+func ContentDisposition(kind, filename string) string {
+	if filename == "" {
+		return kind
+	}
+
+	fallback := make([]byte, 0, len(filename))
+	plain := true
+	for i := 0; i < len(filename); i++ {
+		c := filename[i]
+		if c < 0x20 || c >= 0x7f || c == '"' || c == '\\' {
+			plain = false
+			if c >= 0x80 && c < 0xc0 {
+				continue // UTF-8 devam byte'ı: karakter başına tek '_'
+			}
+			c = '_'
+		}
+		fallback = append(fallback, c)
+	}
+
+	value := kind + `; filename="` + string(fallback) + `"`
+	if !plain {
+		value += "; filename*=UTF-8''" + encodeRFC5987(filename)
+	}
+	return value
+}
+
+// encodeRFC5987, s'yi RFC 5987 attr-char dışındaki byte'ları
+// percent-encode ederek kodlar.
+func encodeRFC5987(s string) string {
+	const hex = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+			strings.IndexByte("!#$&+-.^_`|~", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hex[c>>4])
+		b.WriteByte(hex[c&0x0f])
+	}
+	return b.String()
+}
+
+// serveFile, Download ve File'ın ortak gövdesidir.
+func serveFile(w http.ResponseWriter, r *http.Request, path, kind, filename string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			Error(w, http.StatusNotFound, "Dosya bulunamadı")
+		} else {
+			Error(w, http.StatusInternalServerError, "Dosya okunamadı")
+		}
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "Dosya okunamadı")
+		return err
+	}
+	if info.IsDir() {
+		Error(w, http.StatusNotFound, "Dosya bulunamadı")
+		return &fs.PathError{Op: "open", Path: path, Err: errors.New("is a directory")}
+	}
+
+	if filename == "" {
+		filename = filepath.Base(path)
+	}
+
+	header := w.Header()
+	header.Set("Content-Disposition", ContentDisposition(kind, filename))
+	setFileContentType(header, filename)
+
+	clearWriteDeadline(w)
+	http.ServeContent(w, r, filename, info.ModTime(), f)
+	return nil
+}
+
+// setFileContentType, Content-Type ayarlanmamışsa dosya adının
+// uzantısından belirler. Bilinmeyen uzantılarda header boş bırakılır
+// (ServeContent içerikten tespit eder).
+func setFileContentType(header http.Header, filename string) {
+	if header.Get("Content-Type") != "" {
+		return
+	}
+	if ctype := mime.TypeByExtension(filepath.Ext(filename)); ctype != "" {
+		header.Set("Content-Type", ctype)
+	}
+}
+
+// clearWriteDeadline, büyük dosyaların WriteTimeout'a takılmaması için
+// yazma deadline'ını kaldırır.
+func clearWriteDeadline(w http.ResponseWriter) {
+	http.NewResponseController(w).SetWriteDeadline(time.Time{})
+}
@@ -34,11 +34,14 @@ import (
 //   - Error: İşlem başarısızsa hata mesajı buraya yazılır.
 //   - Meta: Sayfalama, istatistik, toplam kayıt vb. ek bilgiler için
 //     kullanılan, isteğe bağlı meta veri alanıdır.
+//   - Links: Sayfalama linkleri (first, last, prev, next) için
+//     isteğe bağlı alan (bkz: Paginated).
 type JSONResponse struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
 	Error   string      `json:"error,omitempty"`
 	Meta    interface{} `json:"meta,omitempty"`
+	Links   interface{} `json:"links,omitempty"`
 }
 
 // Send, HTTP yanıtını istenen statü kodu ve JSONResponse yapısı ile
@@ -95,30 +98,39 @@ func Success(w http.ResponseWriter, status int, data interface{}, meta interface
 // hata durumunda manuel olarak JSONResponse oluşturma yükünü ortadan
 // kaldırır ve API genelinde standartlaşmış bir hata yapısı sağlar.
 //
+// Hata, framework'ün diğer hatalarıyla aynı application/problem+json
+// zarfıyla yazılır (bkz: problem.go); ERROR_FORMAT=legacy ise eski
+// {"success":false,"error":"..."} zarfı kullanılır.
+//
 // Parametreler:
 //   - w: Yanıt yazıcısı.
 //   - status: HTTP durum kodu (400, 404, 422, 500 vs.).
-//   - errMsg: Döndürülecek olan açıklayıcı hata mesajı.
+//   - errData: Hata mesajı (string), error veya doğrulama hataları
+//     (map[string][]string).
 //
 // Döndürür:
 //   - error: Gönderim veya encode sürecinde oluşan hata.
 func Error(w http.ResponseWriter, status int, errData any) error {
-	payload := JSONResponse{
-		Success: false,
-	}
+	var message string
 
-	// Gelen hatanın tipine göre JSONResponse'u doldur
+	// Gelen hatanın tipine göre mesajı belirle
 	switch e := errData.(type) {
 	case string:
-		payload.Error = e
+		message = e
 	case error:
-		payload.Error = e.Error()
+		message = e.Error()
 	case map[string][]string:
-		payload.Error = "Doğrulama hatası" // Genel mesaj
-		payload.Data = e                   // Detaylı hataları 'data' alanına koy
+		// Doğrulama hataları yapılandırılmış formatter ile yazılır
+		// (bkz: validation.go)
+		return sendValidationErrors(w, status, e)
 	default:
-		payload.Error = "Bilinmeyen bir sunucu hatası oluştu"
+		message = "Bilinmeyen bir sunucu hatası oluştu"
+	}
+
+	if GetErrorFormat() == ErrorFormatLegacy {
+		return Send(w, status, JSONResponse{Success: false, Error: message})
 	}
 
-	return Send(w, status, payload)
+	// İstek burada yok; request ID RequestID middleware'inin header'ından alınır
+	return NewProblem(status).WithDetail(message).Send(w, nil)
 }
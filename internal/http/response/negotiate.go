@@ -0,0 +1,309 @@
+// -----------------------------------------------------------------------------
+// Content Negotiation
+// -----------------------------------------------------------------------------
+// Yanıt formatını Accept header'ına göre seçer (q değerleri dahil). JSON ve
+// XML yerleşiktir; diğer formatlar RegisterEncoder ile eklenir. Eşleşme
+// yoksa JSON kullanılır.
+//
+//	response.Negotiate(w, r.Request, user) // Accept: application/xml -> XML
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Encoder, yanıt gövdesini bir formatta (JSON, XML, ...) w'ye yazar.
+type Encoder func(w io.Writer, v any) error
+
+// registeredEncoder, media type ile eşlenmiş encoder'dır.
+type registeredEncoder struct {
+	mediaType string
+	encode    Encoder
+}
+
+var (
+	encodersMu sync.RWMutex
+	// Kayıt sırası önemlidir: */* ve eşleşme olmadığında ilk encoder
+	// (application/json) kullanılır.
+	encoders = []registeredEncoder{
+		{"application/json", EncodeJSON},
+		{"application/xml", EncodeXML},
+		{"text/xml", EncodeXML},
+	}
+)
+
+// RegisterEncoder, Negotiate'in kullanabileceği yeni bir format ekler.
+// Aynı media type ikinci kez kaydedilirse panic oluşur.
+//
+// Parametreler:
+//   - mediaType: Yanıtın Content-Type'ı (örn: "application/x-yaml")
+//   - encoder: Gövdeyi yazan fonksiyon
+//
+// Örnek:
+//
+//	response.RegisterEncoder("application/x-msgpack", func(w io.Writer, v any) error {
+//	    return msgpack.NewEncoder(w).Encode(v)
+//	})
+func RegisterEncoder(mediaType string, encoder Encoder) {
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+
+	mediaType = strings.ToLower(mediaType)
+	for _, e := range encoders {
+		if e.mediaType == mediaType {
+			panic(fmt.Sprintf("response: %s encoder'ı zaten kayıtlı", mediaType))
+		}
+	}
+	encoders = append(encoders, registeredEncoder{mediaType, encoder})
+}
+
+// UnregisterEncoder, media type'ın encoder'ını kaldırır.
+func UnregisterEncoder(mediaType string) {
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+
+	mediaType = strings.ToLower(mediaType)
+	for i, e := range encoders {
+		if e.mediaType == mediaType {
+			encoders = append(encoders[:i:i], encoders[i+1:]...)
+			return
+		}
+	}
+}
+
+// RegisteredEncoders, kayıtlı media type'ları kayıt sırasıyla döndürür.
+func RegisteredEncoders() []string {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+
+	types := make([]string, len(encoders))
+	for i, e := range encoders {
+		types[i] = e.mediaType
+	}
+	return types
+}
+
+// Negotiate, data'yı client'ın Accept header'ına göre JSON, XML veya
+// kayıtlı başka bir formatta 200 ile gönderir. Gövde Success ile aynı
+// zarftır ({"success": true, "data": ...}).
+//
+// Accept'teki tipler tercih sırasıyla denenir; "application/*" gibi
+// wildcard'lar ve "+json"/"+xml" ekli tipler (application/vnd.api+json)
+// de eşleşir. Accept yoksa veya hiçbir tip desteklenmiyorsa JSON gönderilir.
+//
+// Örnek:
+//
+//	func (uc *UserController) Show(w http.ResponseWriter, r *conduitReq.Request) {
+//	    user, _ := uc.find(r.RouteParam("id"))
+//	    response.Negotiate(w, r.Request, user)
+//	}
+//
+//	// Accept: application/xml
+//	// <?xml version="1.0" encoding="UTF-8"?>
+//	// <response><success>true</success><data><id>1</id>...</data></response>
+func Negotiate(w http.ResponseWriter, r *http.Request, data any) error {
+	return NegotiateStatus(w, r, http.StatusOK, data)
+}
+
+// NegotiateStatus, Negotiate'in status kodu alan halidir (örn: 201).
+func NegotiateStatus(w http.ResponseWriter, r *http.Request, status int, data any) error {
+	mediaType, encode := negotiateEncoder(ParseAccept(r.Header.Get("Accept")))
+
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Add("Vary", "Accept")
+	w.WriteHeader(status)
+
+	return encode(w, JSONResponse{Success: true, Data: data})
+}
+
+// ParseAccept, Accept header'ındaki media type'ları tercih sırasıyla
+// döndürür: önce q değeri yüksek olanlar, eşitlikte daha belirli olanlar
+// (application/json > application/* > */*), sonra header'daki sıra.
+// q=0 ile reddedilen ve parse edilemeyen değerler atlanır.
+//
+// Örnek:
+//
+//	response.ParseAccept("text/html;q=0.8, application/xml, */*;q=0.1")
+//	// ["application/xml", "text/html", "*/*"]
+func ParseAccept(header string) []string {
+	type accepted struct {
+		mediaType   string
+		q           float64
+		specificity int
+	}
+
+	var types []accepted
+	for _, part := range strings.Split(header, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+
+		specificity := 2
+		switch {
+		case mediaType == "*/*":
+			specificity = 0
+		case strings.HasSuffix(mediaType, "/*"):
+			specificity = 1
+		}
+		types = append(types, accepted{mediaType, q, specificity})
+	}
+
+	sort.SliceStable(types, func(i, j int) bool {
+		if types[i].q != types[j].q {
+			return types[i].q > types[j].q
+		}
+		return types[i].specificity > types[j].specificity
+	})
+
+	result := make([]string, len(types))
+	for i, t := range types {
+		result[i] = t.mediaType
+	}
+	return result
+}
+
+// negotiateEncoder, kabul edilen tiplerden ilk desteklenenin encoder'ını
+// döndürür; yoksa ilk kayıtlı encoder'ı (JSON).
+func negotiateEncoder(accepted []string) (string, Encoder) {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+
+	for _, mediaType := range accepted {
+		for _, e := range encoders {
+			if matchMediaType(mediaType, e.mediaType) {
+				return e.mediaType, e.encode
+			}
+		}
+	}
+	return encoders[0].mediaType, encoders[0].encode
+}
+
+// matchMediaType, Accept'teki tipin kayıtlı tiple eşleşip eşleşmediğini
+// döndürür.
+func matchMediaType(accepted, registered string) bool {
+	switch {
+	case accepted == registered || accepted == "*/*":
+		return true
+	case strings.HasSuffix(accepted, "/*"):
+		return strings.HasPrefix(registered, strings.TrimSuffix(accepted, "*"))
+	}
+
+	// Yapılandırılmış sözdizimi eki: application/vnd.api+json -> application/json
+	if i := strings.LastIndexByte(accepted, '+'); i >= 0 {
+		return registered == "application/"+accepted[i+1:]
+	}
+	return false
+}
+
+// EncodeJSON, v'yi JSON olarak yazar.
+func EncodeJSON(w io.Writer, v any) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+// xmlNamePattern, element adı olarak kullanılabilecek key'lerdir.
+var xmlNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9._-]*$`)
+
+// EncodeXML, v'yi XML olarak yazar.
+//
+// Değer önce JSON'a çevrilir; böylece XML, JSON ile aynı alan adlarını
+// (json tag'leri) ve sırayı kullanır ve map'ler de desteklenir. Kök element
+// <response>'tur; dizi elemanları <item>, element adı olamayan key'ler
+// <entry key="..."> olarak yazılır, null değerler boş element olur.
+// Farklı bir XML yapısı gerekiyorsa "application/xml" UnregisterEncoder ile
+// kaldırılıp kendi encoder'ınız kaydedilir.
+func EncodeXML(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	enc := xml.NewEncoder(w)
+	if err := writeXMLValue(dec, enc, "response"); err != nil {
+		return err
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// writeXMLValue, dec'ten sıradaki JSON değerini name elementi olarak yazar.
+func writeXMLValue(dec *json.Decoder, enc *xml.Encoder, name string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if !xmlNamePattern.MatchString(name) || strings.HasPrefix(strings.ToLower(name), "xml") {
+		start = xml.StartElement{
+			Name: xml.Name{Local: "entry"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: name}},
+		}
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		for dec.More() {
+			child := "item"
+			if t == '{' {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				child = keyTok.(string)
+			}
+			if err := writeXMLValue(dec, enc, child); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil { // Kapanan } veya ]
+			return err
+		}
+	case nil:
+		// null: boş element
+	default:
+		if err := enc.EncodeToken(xml.CharData(fmt.Sprint(t))); err != nil {
+			return err
+		}
+	}
+
+	return enc.EncodeToken(start.End())
+}
@@ -0,0 +1,114 @@
+// -----------------------------------------------------------------------------
+// Paginated Responses
+// -----------------------------------------------------------------------------
+// Sayfalı listeleri data, meta (total, per_page, current_page, last_page) ve
+// links (first, last, prev, next) alanlarıyla gönderir.
+//
+//	paginator, err := builder.Paginate(page, 20, &users)
+//	response.Paginated(w, users, paginator, r.Request)
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+)
+
+// Pager, Paginated'ın ihtiyaç duyduğu sayfalama bilgisidir.
+// *database.Paginator bu arayüzü implement eder.
+type Pager interface {
+	Total() int64
+	PerPage() int
+	CurrentPage() int
+	LastPage() int
+	HasMorePages() bool
+}
+
+// PaginationMeta, sayfalı yanıtların meta alanıdır.
+type PaginationMeta struct {
+	Total       int64 `json:"total"`
+	PerPage     int   `json:"per_page"`
+	CurrentPage int   `json:"current_page"`
+	LastPage    int   `json:"last_page"`
+}
+
+// PaginationLinks, sayfalı yanıtların links alanıdır. İlk sayfada prev,
+// son sayfada next null'dır.
+type PaginationLinks struct {
+	First string  `json:"first"`
+	Last  string  `json:"last"`
+	Prev  *string `json:"prev"`
+	Next  *string `json:"next"`
+}
+
+// Paginated, sayfalı bir listeyi data, meta ve links alanlarıyla 200 OK
+// olarak gönderir. Linkler mevcut isteğin path'i ve query parametreleri
+// korunarak yalnızca "page" değiştirilerek üretilir (örn: filtre ve sıralama
+// parametreleri sonraki sayfada da geçerli kalır).
+//
+// Parametreler:
+//   - w: Yanıt yazıcısı
+//   - items: Sayfanın kayıtları (nil slice boş liste olarak yazılır)
+//   - pager: Sayfalama bilgisi (genellikle QueryBuilder.Paginate sonucu)
+//   - r: Linklerin üretileceği istek
+//
+// Örnek:
+//
+//	var users []models.User
+//	paginator, err := qb.Table("users").Paginate(page, 15, &users)
+//	if err != nil {
+//	    response.Error(w, 500, "Kullanıcılar alınamadı")
+//	    return
+//	}
+//	response.Paginated(w, users, paginator, r.Request)
+//
+//	// {
+//	//   "success": true,
+//	//   "data": [...],
+//	//   "meta": {"total": 42, "per_page": 15, "current_page": 2, "last_page": 3},
+//	//   "links": {
+//	//     "first": "/api/users?page=1", "last": "/api/users?page=3",
+//	//     "prev": "/api/users?page=1", "next": "/api/users?page=3"
+//	//   }
+//	// }
+func Paginated(w http.ResponseWriter, items any, pager Pager, r *http.Request) error {
+	if v := reflect.ValueOf(items); items == nil || (v.Kind() == reflect.Slice && v.IsNil()) {
+		items = []any{}
+	}
+
+	current := pager.CurrentPage()
+	links := PaginationLinks{
+		First: pageURL(r, 1),
+		Last:  pageURL(r, pager.LastPage()),
+	}
+	if current > 1 {
+		prev := pageURL(r, min(current-1, pager.LastPage()))
+		links.Prev = &prev
+	}
+	if pager.HasMorePages() {
+		next := pageURL(r, current+1)
+		links.Next = &next
+	}
+
+	return Send(w, http.StatusOK, JSONResponse{
+		Success: true,
+		Data:    items,
+		Meta: PaginationMeta{
+			Total:       pager.Total(),
+			PerPage:     pager.PerPage(),
+			CurrentPage: current,
+			LastPage:    pager.LastPage(),
+		},
+		Links: links,
+	})
+}
+
+// pageURL, isteğin path'ini ve query parametrelerini koruyarak "page"
+// parametresini değiştirir.
+func pageURL(r *http.Request, page int) string {
+	query := r.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+	return r.URL.Path + "?" + query.Encode()
+}
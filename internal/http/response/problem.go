@@ -0,0 +1,206 @@
+// -----------------------------------------------------------------------------
+// Problem Details (RFC 7807)
+// -----------------------------------------------------------------------------
+// Framework'ün ürettiği hata yanıtları (panic, 404, 405, Error() ile yazılan
+// 401/403/429/503'ler ve doğrulama hataları) tek bir application/problem+json
+// zarfı kullanır:
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "Not Found",
+//	  "status": 404,
+//	  "detail": "İstenen kaynak bulunamadı",
+//	  "instance": "/api/v1/orders/42",
+//	  "request_id": "5f0c..."
+//	}
+//
+// Controller'lar da aynı zarfı builder ile üretebilir:
+//
+//	response.NewProblem(http.StatusConflict).
+//	    WithType("https://example.com/problems/out-of-stock").
+//	    WithDetail("Ürün stokta yok").
+//	    With("product_id", 42).
+//	    Send(w, r.Request)
+//
+// Eski {"success":false,"error":"..."} zarfına bağımlı client'lar için
+// ERROR_FORMAT=legacy Error() yanıtlarını eski formatta bırakır.
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/requestid"
+)
+
+// ProblemContentType, Problem Details yanıtlarının Content-Type'ıdır.
+const ProblemContentType = "application/problem+json"
+
+// Error() yanıt formatları (ERROR_FORMAT).
+const (
+	ErrorFormatProblem = "problem" // application/problem+json (varsayılan)
+	ErrorFormatLegacy  = "legacy"  // {"success":false,"error":"..."}
+)
+
+var (
+	errorFormatMu sync.RWMutex
+	errorFormat   = ErrorFormatProblem
+)
+
+// SetErrorFormat, Error() ve ona dayanan yardımcıların (Unauthorized,
+// Forbidden, TooManyRequests...) yanıt formatını ayarlar. Uygulama
+// başlangıcında bir kez çağrılır.
+//
+// Döndürür:
+//   - error: Bilinmeyen format adı
+func SetErrorFormat(name string) error {
+	format := strings.ToLower(strings.TrimSpace(name))
+	switch format {
+	case "", "rfc7807":
+		format = ErrorFormatProblem
+	case ErrorFormatProblem, ErrorFormatLegacy:
+	default:
+		return fmt.Errorf("bilinmeyen hata formatı: %q (problem, legacy)", name)
+	}
+
+	errorFormatMu.Lock()
+	defer errorFormatMu.Unlock()
+	errorFormat = format
+	return nil
+}
+
+// GetErrorFormat, aktif Error() yanıt formatını döndürür.
+func GetErrorFormat() string {
+	errorFormatMu.RLock()
+	defer errorFormatMu.RUnlock()
+	return errorFormat
+}
+
+// Problem, RFC 7807 Problem Details nesnesidir. Extensions'taki alanlar
+// standart alanlarla aynı seviyede yazılır.
+type Problem struct {
+	Type       string         // Problem tipini tanımlayan URI (varsayılan: about:blank)
+	Title      string         // Kısa özet (varsayılan: status metni, örn: "Not Found")
+	Status     int            // HTTP durum kodu
+	Detail     string         // Bu oluşuma özel açıklama
+	Instance   string         // Problemin oluştuğu kaynak (Send'de boşsa istek path'i)
+	RequestID  string         // İstek ID'si (Send'de boşsa context'ten)
+	Extensions map[string]any // Ek alanlar (örn: "invalid-params")
+}
+
+// NewProblem, status için varsayılan type ve title ile bir Problem
+// oluşturur.
+//
+// Örnek:
+//
+//	response.NewProblem(http.StatusNotFound).
+//	    WithDetail("Sipariş bulunamadı").
+//	    Send(w, r.Request)
+func NewProblem(status int) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+}
+
+// WithType, problem tipinin URI'sini ayarlar.
+func (p *Problem) WithType(uri string) *Problem {
+	p.Type = uri
+	return p
+}
+
+// WithTitle, başlığı ayarlar.
+func (p *Problem) WithTitle(title string) *Problem {
+	p.Title = title
+	return p
+}
+
+// WithDetail, açıklamayı ayarlar.
+func (p *Problem) WithDetail(detail string) *Problem {
+	p.Detail = detail
+	return p
+}
+
+// WithInstance, problemin oluştuğu kaynağı ayarlar.
+func (p *Problem) WithInstance(instance string) *Problem {
+	p.Instance = instance
+	return p
+}
+
+// With, bir extension alanı ekler. Standart alanlarla aynı isimdeki
+// key'ler yok sayılır.
+func (p *Problem) With(key string, value any) *Problem {
+	if p.Extensions == nil {
+		p.Extensions = make(map[string]any)
+	}
+	p.Extensions[key] = value
+	return p
+}
+
+// MarshalJSON, extension alanlarını standart alanlarla birlikte yazar.
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	body := make(map[string]any, len(p.Extensions)+6)
+	for key, value := range p.Extensions {
+		body[key] = value
+	}
+
+	body["type"] = p.Type
+	body["title"] = p.Title
+	body["status"] = p.Status
+	if p.Detail != "" {
+		body["detail"] = p.Detail
+	} else {
+		delete(body, "detail")
+	}
+	if p.Instance != "" {
+		body["instance"] = p.Instance
+	} else {
+		delete(body, "instance")
+	}
+	if p.RequestID != "" {
+		body["request_id"] = p.RequestID
+	} else {
+		delete(body, "request_id")
+	}
+	return json.Marshal(body)
+}
+
+// Send, problemi application/problem+json olarak yazar. Instance boşsa
+// istek path'i, RequestID boşsa RequestID middleware'inin ID'si kullanılır.
+//
+// Parametreler:
+//   - w: Yanıt yazıcısı
+//   - r: İstek (nil olabilir; instance ve request ID eklenmez)
+func (p *Problem) Send(w http.ResponseWriter, r *http.Request) error {
+	if r != nil {
+		if p.Instance == "" {
+			p.Instance = r.URL.Path
+		}
+		if p.RequestID == "" {
+			p.RequestID = requestid.FromContext(r.Context())
+		}
+	}
+	if p.RequestID == "" {
+		p.RequestID = w.Header().Get(requestid.HeaderName)
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
+
+// ProblemError, status ve detail ile bir Problem gönderir (NewProblem +
+// WithDetail + Send kısayolu).
+//
+// Örnek:
+//
+//	response.ProblemError(w, r.Request, http.StatusForbidden, "Bu siparişe erişiminiz yok")
+func ProblemError(w http.ResponseWriter, r *http.Request, status int, detail string) error {
+	return NewProblem(status).WithDetail(detail).Send(w, r)
+}
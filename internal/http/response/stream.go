@@ -0,0 +1,180 @@
+// -----------------------------------------------------------------------------
+// Streaming Responses
+// -----------------------------------------------------------------------------
+// Büyük veya süresi belirsiz yanıtları (CSV export, NDJSON, JSON dizileri)
+// belleğe almadan parça parça gönderir. Her parça client'a flush edilir ve
+// sunucunun WriteTimeout'u stream süresince kaldırılır.
+//
+//	response.Stream(w, func(out io.Writer) error {
+//	    return writeCSV(out)
+//	})
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// streamBufferSize, Stream'in client'a flush etmeden önce biriktirdiği
+// byte sayısıdır.
+const streamBufferSize = 32 << 10
+
+// streamWriter, yazılan her parçayı client'a flush eden writer'dır. Header'lar
+// ilk yazımda gönderilir.
+type streamWriter struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	started    bool
+}
+
+// start, header'ları ve 200 status'unu gönderir.
+func (s *streamWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	header := s.w.Header()
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "application/octet-stream")
+	}
+	header.Del("Content-Length")          // Chunked transfer
+	header.Set("X-Accel-Buffering", "no") // nginx buffering'i kapat
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// Write, p'yi yazar ve client'a flush eder.
+func (s *streamWriter) Write(p []byte) (int, error) {
+	s.start()
+
+	n, err := s.w.Write(p)
+	if err != nil {
+		return n, err
+	}
+	if err := s.controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return n, err
+	}
+	return n, nil
+}
+
+// Stream, fn'in yazdığı içeriği belleğe almadan, 32 KB'lık parçalar halinde
+// client'a flush ederek gönderir (chunked transfer). Büyük CSV export'ları
+// ve listeler için kullanılır.
+//
+// Content-Type ve Content-Disposition gibi header'lar Stream'den önce
+// ayarlanır (varsayılan: application/octet-stream). Sunucunun WriteTimeout'u
+// stream için kaldırılır. fn'e verilen writer `Flush() error` metodu sunar;
+// yavaş üretilen veride beklemeden göndermek için çağrılabilir.
+//
+// fn, client'a henüz hiçbir şey gönderilmeden hata döndürürse 500 JSON hata
+// yanıtı gönderilir; gönderim başladıktan sonraki hatalarda yanıt yarıda
+// kalır. Her iki durumda da hata döndürülür ve loglanmalıdır.
+//
+// Parametreler:
+//   - w: Yanıt yazıcısı
+//   - fn: Gövdeyi yazan fonksiyon
+//
+// Döndürür:
+//   - error: fn'in veya yazımın hatası (client bağlantıyı kapattıysa dahil)
+//
+// Örnek:
+//
+//	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+//	w.Header().Set("Content-Disposition", `attachment; filename="orders.csv"`)
+//	err := response.Stream(w, func(out io.Writer) error {
+//	    writer := csv.NewWriter(out)
+//	    err := orders.Chunk(500, func(page []models.Order) error {
+//	        for _, order := range page {
+//	            writer.Write(order.CSVRow())
+//	        }
+//	        writer.Flush()
+//	        return writer.Error()
+//	    })
+//	    writer.Flush()
+//	    return errors.Join(err, writer.Error())
+//	})
+func Stream(w http.ResponseWriter, fn func(io.Writer) error) error {
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	sw := &streamWriter{w: w, controller: controller}
+	buf := bufio.NewWriterSize(sw, streamBufferSize)
+
+	if err := fn(buf); err != nil {
+		if !sw.started {
+			w.Header().Del("Content-Disposition")
+			Error(w, http.StatusInternalServerError, "Sunucu hatası")
+		}
+		return err
+	}
+
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	sw.start() // Boş gövdede de header'lar gönderilir
+	return nil
+}
+
+// StreamJSONArray, fn'in emit ile verdiği elemanları bir JSON dizisi olarak
+// stream eder ([{...},{...}]). Elemanlar tek tek encode edilir; liste
+// belleğe alınmaz. Gövde Success zarfı olmadan düz bir dizidir.
+//
+// Hata davranışı Stream ile aynıdır; gönderim başladıktan sonraki bir hatada
+// client geçersiz (kapanmamış) bir JSON alır.
+//
+// Örnek:
+//
+//	err := response.StreamJSONArray(w, func(emit func(any) error) error {
+//	    return users.Chunk(1000, func(page []models.User) error {
+//	        for _, user := range page {
+//	            if err := emit(user); err != nil {
+//	                return err
+//	            }
+//	        }
+//	        return nil
+//	    })
+//	})
+func StreamJSONArray(w http.ResponseWriter, fn func(emit func(item any) error) error) error {
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+
+	return Stream(w, func(out io.Writer) error {
+		first := true
+		emit := func(item any) error {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+
+			separator := ","
+			if first {
+				separator, first = "[", false
+			}
+			if _, err := io.WriteString(out, separator); err != nil {
+				return err
+			}
+			_, err = out.Write(data)
+			return err
+		}
+
+		if err := fn(emit); err != nil {
+			return err
+		}
+
+		closing := "]\n"
+		if first {
+			closing = "[]\n"
+		}
+		_, err := io.WriteString(out, closing)
+		return err
+	})
+}
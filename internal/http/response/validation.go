@@ -0,0 +1,180 @@
+// -----------------------------------------------------------------------------
+// Validation Error Formatters
+// -----------------------------------------------------------------------------
+// Doğrulama hatalarının (422) yanıt gövdesi uygulama genelinde tek noktadan
+// seçilir. Error(w, 422, result.Errors()), ValidationError ve FormRequest
+// katmanı aktif formatter'ı kullanır.
+//
+//	VALIDATION_ERROR_FORMAT=problem   # RFC 7807 application/problem+json (varsayılan)
+//	VALIDATION_ERROR_FORMAT=default   # {"success":false,"error":"Doğrulama hatası","data":{...}}
+//	VALIDATION_ERROR_FORMAT=flat      # {"message":"Doğrulama hatası","errors":{...}}
+//	VALIDATION_ERROR_FORMAT=jsonapi   # {"errors":[{"status":"422","source":{"pointer":...},...}]}
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ValidationErrorMessage, doğrulama hataları için genel mesajdır.
+const ValidationErrorMessage = "Doğrulama hatası"
+
+// ValidationErrorFormatter, doğrulama hatalarından yanıt gövdesini üretir.
+//
+// Parametreler:
+//   - status: HTTP durum kodu (genellikle 422)
+//   - errors: Alan -> hata mesajları (örn: {"address.zip": ["..."]})
+//
+// Döndürür:
+//   - contentType: Yanıtın Content-Type'ı
+//   - body: JSON olarak encode edilecek gövde
+type ValidationErrorFormatter func(status int, errors map[string][]string) (contentType string, body any)
+
+var (
+	validationFormatterMu sync.RWMutex
+	validationFormatter   ValidationErrorFormatter = ProblemValidationFormatter
+)
+
+// SetValidationFormatter, doğrulama hataları için kullanılacak formatter'ı
+// ayarlar. Uygulama başlangıcında bir kez çağrılır.
+func SetValidationFormatter(formatter ValidationErrorFormatter) {
+	validationFormatterMu.Lock()
+	defer validationFormatterMu.Unlock()
+
+	if formatter == nil {
+		formatter = ProblemValidationFormatter
+	}
+	validationFormatter = formatter
+}
+
+// GetValidationFormatter, aktif formatter'ı döndürür.
+func GetValidationFormatter() ValidationErrorFormatter {
+	validationFormatterMu.RLock()
+	defer validationFormatterMu.RUnlock()
+
+	return validationFormatter
+}
+
+// ValidationFormatterFor, isimle (problem, default, flat, jsonapi)
+// yerleşik formatter'ı döndürür. Boş isim problem formatını seçer.
+//
+// Döndürür:
+//   - ValidationErrorFormatter: Formatter
+//   - error: Bilinmeyen format adı
+func ValidationFormatterFor(name string) (ValidationErrorFormatter, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "default":
+		return DefaultValidationFormatter, nil
+	case "flat":
+		return FlatValidationFormatter, nil
+	case "jsonapi", "json:api":
+		return JSONAPIValidationFormatter, nil
+	case "", "problem", "rfc7807":
+		return ProblemValidationFormatter, nil
+	default:
+		return nil, fmt.Errorf("bilinmeyen doğrulama hata formatı: %q (default, flat, jsonapi, problem)", name)
+	}
+}
+
+// DefaultValidationFormatter, eski JSONResponse zarfını kullanır
+// (VALIDATION_ERROR_FORMAT=default).
+//
+//	{"success": false, "error": "Doğrulama hatası", "data": {"email": ["..."]}}
+func DefaultValidationFormatter(status int, errors map[string][]string) (string, any) {
+	return "application/json", JSONResponse{
+		Success: false,
+		Error:   ValidationErrorMessage,
+		Data:    errors,
+	}
+}
+
+// FlatValidationFormatter, hataları zarfsız bir map olarak döndürür.
+//
+//	{"message": "Doğrulama hatası", "errors": {"email": ["..."]}}
+func FlatValidationFormatter(status int, errors map[string][]string) (string, any) {
+	return "application/json", map[string]any{
+		"message": ValidationErrorMessage,
+		"errors":  errors,
+	}
+}
+
+// jsonAPIError, JSON:API hata nesnesidir.
+type jsonAPIError struct {
+	Status string            `json:"status"`
+	Title  string            `json:"title"`
+	Detail string            `json:"detail"`
+	Source map[string]string `json:"source"`
+}
+
+// JSONAPIValidationFormatter, hataları JSON:API errors dizisi olarak
+// döndürür. Her mesaj ayrı bir hata nesnesidir; alan yolu JSON Pointer'a
+// çevrilir ("address.zip" -> "/data/attributes/address/zip").
+func JSONAPIValidationFormatter(status int, errors map[string][]string) (string, any) {
+	items := make([]jsonAPIError, 0, len(errors))
+	for _, field := range sortedFields(errors) {
+		pointer := "/data/attributes/" + strings.ReplaceAll(field, ".", "/")
+		for _, message := range errors[field] {
+			items = append(items, jsonAPIError{
+				Status: strconv.Itoa(status),
+				Title:  ValidationErrorMessage,
+				Detail: message,
+				Source: map[string]string{"pointer": pointer},
+			})
+		}
+	}
+	return "application/vnd.api+json", map[string]any{"errors": items}
+}
+
+// problemParam, RFC 7807 invalid-params elemanıdır.
+type problemParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ProblemValidationFormatter, hataları RFC 7807 Problem Details olarak
+// döndürür (bkz: problem.go); alan hataları "invalid-params" uzantısında
+// yer alır.
+func ProblemValidationFormatter(status int, errors map[string][]string) (string, any) {
+	params := make([]problemParam, 0, len(errors))
+	for _, field := range sortedFields(errors) {
+		for _, message := range errors[field] {
+			params = append(params, problemParam{Name: field, Reason: message})
+		}
+	}
+	problem := NewProblem(status).
+		WithTitle(ValidationErrorMessage).
+		WithDetail(fmt.Sprintf("%d alanda doğrulama hatası var", len(errors))).
+		With("invalid-params", params)
+	return ProblemContentType, problem
+}
+
+// sendValidationErrors, hataları aktif formatter ile yazar.
+func sendValidationErrors(w http.ResponseWriter, status int, errors map[string][]string) error {
+	contentType, body := GetValidationFormatter()(status, errors)
+
+	// Problem gövdesine request ID eklenir (RequestID middleware'i header'ı)
+	if problem, ok := body.(*Problem); ok {
+		return problem.Send(w, nil)
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(body)
+}
+
+// sortedFields, hata alanlarını deterministik sırada döndürür.
+func sortedFields(errors map[string][]string) []string {
+	fields := make([]string, 0, len(errors))
+	for field := range errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
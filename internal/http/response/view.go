@@ -0,0 +1,55 @@
+// -----------------------------------------------------------------------------
+// HTML View Responses
+// -----------------------------------------------------------------------------
+// pkg/view engine'i ile server-rendered HTML sayfaları gönderir. Engine
+// uygulama başlarken view.SetEngine ile ayarlanır (AppServiceProvider).
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/biyonik/conduit-go/pkg/view"
+)
+
+// View, view.SetEngine ile ayarlanmış engine ile bir HTML view'ı render
+// eder ve 200 OK olarak gönderir. View önce buffer'a render edilir; şablon
+// hatasında yarım sayfa yerine 500 hata yanıtı gönderilir ve hata
+// döndürülür (loglanmalıdır).
+//
+// Parametreler:
+//   - w: Yanıt yazıcısı
+//   - r: İstek (auth_user, csrf_token gibi isteğe özel paylaşılan veri için)
+//   - name: Uzantısız view adı (örn: "users/index")
+//   - data: Şablona geçirilecek veri (map ise paylaşılan veriyle birleştirilir)
+//
+// Örnek:
+//
+//	err := response.View(w, r.Request, "users/index", map[string]any{
+//	    "users": users,
+//	})
+func View(w http.ResponseWriter, r *http.Request, name string, data any) error {
+	return ViewStatus(w, r, http.StatusOK, name, data)
+}
+
+// ViewStatus, View'i verilen status ile gönderir (örn: 404 sayfası).
+func ViewStatus(w http.ResponseWriter, r *http.Request, status int, name string, data any) error {
+	engine := view.GetEngine()
+	if engine == nil {
+		Error(w, http.StatusInternalServerError, "Sunucu hatası")
+		return view.ErrNotConfigured
+	}
+
+	var body bytes.Buffer
+	if err := engine.Render(&body, r, name, data); err != nil {
+		Error(w, http.StatusInternalServerError, "Sunucu hatası")
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, err := body.WriteTo(w)
+	return err
+}
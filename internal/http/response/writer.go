@@ -0,0 +1,185 @@
+// -----------------------------------------------------------------------------
+// Response Writer
+// -----------------------------------------------------------------------------
+// Router'ın her isteği sardığı http.ResponseWriter. Status ve gövde boyutunu
+// kaydeder; middleware'ler header'lar gönderilmeden önce ve istek bittikten
+// sonra çalışacak hook'lar ekler.
+// -----------------------------------------------------------------------------
+
+package response
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+)
+
+// Writer, framework'ün http.ResponseWriter sarmalayıcısıdır. Router her
+// isteği bir Writer ile sarar; middleware'ler kendi wrapper'larını yazmak
+// yerine WrapWriter ile aynı Writer'a erişip status, gövde boyutu ve
+// header'ların gönderilip gönderilmediğini okur veya hook ekler.
+//
+// Hook'lar:
+//   - BeforeWriteHeader: Header'lar gönderilmeden hemen önce (WriteHeader,
+//     ilk Write veya Flush) bir kez çalışır; header değiştirmenin son anıdır
+//     (örn: cookie şifreleme, ETag).
+//   - AfterWrite: Handler zinciri bittiğinde (Finish) çalışır; status ve
+//     boyut kesinleşmiştir (örn: access log, metrikler).
+//
+// Writer Unwrap'i implement eder; http.ResponseController ile Flush,
+// Hijack ve deadline ayarları alttaki writer'a ulaşır.
+type Writer struct {
+	http.ResponseWriter
+	status   int
+	size     int64
+	finished bool
+	before   []func(*Writer)
+	after    []func(*Writer)
+}
+
+// NewWriter, w'yi yeni bir Writer ile sarar.
+func NewWriter(w http.ResponseWriter) *Writer {
+	return &Writer{ResponseWriter: w}
+}
+
+// WrapWriter, w zaten bir *Writer ise onu, değilse w'yi saran yeni bir
+// Writer döndürür. Middleware'ler dönen Writer'ı zincirde bir sonraki
+// handler'a geçirmelidir.
+//
+// Örnek:
+//
+//	func Metrics(next http.Handler) http.Handler {
+//	    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//	        rw := response.WrapWriter(w)
+//	        rw.AfterWrite(func(rw *response.Writer) {
+//	            metrics.Observe(r.URL.Path, rw.Status(), rw.Size())
+//	        })
+//	        next.ServeHTTP(rw, r)
+//	    })
+//	}
+func WrapWriter(w http.ResponseWriter) *Writer {
+	if rw, ok := w.(*Writer); ok {
+		return rw
+	}
+	return NewWriter(w)
+}
+
+// Status, gönderilen HTTP status'unu döndürür. Header'lar henüz
+// gönderilmediyse 0, bağlantı hijack edildiyse (WebSocket) 101 döner.
+func (w *Writer) Status() int {
+	return w.status
+}
+
+// Size, gövdeye yazılan byte sayısını döndürür.
+func (w *Writer) Size() int64 {
+	return w.size
+}
+
+// Written, header'ların gönderilip gönderilmediğini döndürür. true ise
+// artık status veya header değiştirilemez.
+func (w *Writer) Written() bool {
+	return w.status != 0
+}
+
+// BeforeWriteHeader, header'lar gönderilmeden hemen önce çalışacak bir
+// hook ekler. Header'lar zaten gönderildiyse hook hiç çalışmaz.
+func (w *Writer) BeforeWriteHeader(fn func(*Writer)) {
+	w.before = append(w.before, fn)
+}
+
+// AfterWrite, yanıt tamamlandığında (Finish) çalışacak bir hook ekler.
+func (w *Writer) AfterWrite(fn func(*Writer)) {
+	w.after = append(w.after, fn)
+}
+
+// WriteHeader, before hook'larını çalıştırıp status'u gönderir. İkinci ve
+// sonraki çağrılar yok sayılır. 1xx ara yanıtları (örn: 103 Early Hints)
+// header'ları göndermiş sayılmaz.
+func (w *Writer) WriteHeader(status int) {
+	if w.status != 0 {
+		return
+	}
+	if status >= 100 && status < 200 && status != http.StatusSwitchingProtocols {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+
+	// Hook'lar WriteHeader çağırsa bile tekrar çalışmasın
+	w.status = status
+	hooks := w.before
+	w.before = nil
+	for _, hook := range hooks {
+		hook(w)
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write, header'lar gönderilmediyse önce 200 gönderir ve gövdeyi yazar.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// ReadFrom, io.Copy'nin alttaki writer'ın optimizasyonlarını (sendfile)
+// kullanmasını sağlar.
+func (w *Writer) ReadFrom(r io.Reader) (int64, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	n, err := io.Copy(w.ResponseWriter, r)
+	w.size += n
+	return n, err
+}
+
+// FlushError, header'lar gönderilmediyse önce 200 gönderir ve buffer'ı
+// client'a flush eder (http.ResponseController).
+func (w *Writer) FlushError() error {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Flush, http.Flusher'ı implement eder.
+func (w *Writer) Flush() {
+	w.FlushError()
+}
+
+// Hijack, bağlantıyı devralır (WebSocket). Başarılı olursa status 101
+// olarak kaydedilir.
+func (w *Writer) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := http.NewResponseController(w.ResponseWriter).Hijack()
+	if err == nil {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return conn, brw, err
+}
+
+// Unwrap, http.ResponseController'ın alttaki writer'a erişmesini sağlar.
+func (w *Writer) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Finish, after hook'larını bir kez çalıştırır. Router tarafından handler
+// zinciri bittiğinde çağrılır; Writer'ı kendisi oluşturan kod da çağırmalıdır.
+//
+// Handler hiçbir şey yazmadıysa net/http 200 gönderir; Status bu durumda
+// hook'larda 200 olarak görünür.
+func (w *Writer) Finish() {
+	if w.finished {
+		return
+	}
+	w.finished = true
+
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	for _, hook := range w.after {
+		hook(w)
+	}
+}
@@ -0,0 +1,349 @@
+// -----------------------------------------------------------------------------
+// Import Users Job
+// -----------------------------------------------------------------------------
+// CSV'den toplu kullanıcı import job'u.
+//
+// Admin panelinden yüklenen ve controller'da doğrulanan satırları işler:
+// - Yeni kullanıcıları oluşturur (rastgele şifre ile)
+// - Mevcut email'ler için duplicate stratejisini uygular (skip/update/fail)
+// - Yeni hesaplara davet (invitation) email'i gönderir
+// - İlerlemeyi (progress) cache'e yazar, admin polling ile takip eder
+// -----------------------------------------------------------------------------
+
+package jobs
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/mails"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/token"
+)
+
+// Duplicate handling stratejileri.
+const (
+	DuplicateSkip   = "skip"   // Mevcut kullanıcıya dokunma
+	DuplicateUpdate = "update" // Mevcut kullanıcının ad/durum bilgisini güncelle
+	DuplicateFail   = "fail"   // Satırı hatalı olarak işaretle
+)
+
+// Import durumları.
+const (
+	ImportStatusQueued     = "queued"
+	ImportStatusProcessing = "processing"
+	ImportStatusCompleted  = "completed"
+	ImportStatusFailed     = "failed"
+)
+
+// importProgressTTL, progress kaydının cache'de tutulma süresi.
+const importProgressTTL = 24 * time.Hour
+
+// ImportUserRow, CSV'den okunmuş ve doğrulanmış tek bir kullanıcı satırı.
+type ImportUserRow struct {
+	Line   int    `json:"line"` // CSV satır numarası (hata raporları için)
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
+}
+
+// ImportRowError, işlenemeyen bir satırın hatası.
+type ImportRowError struct {
+	Line  int    `json:"line"`
+	Email string `json:"email,omitempty"`
+	Error string `json:"error"`
+}
+
+// ImportProgress, import işleminin anlık durumunu temsil eder.
+type ImportProgress struct {
+	ImportID   string           `json:"import_id"`
+	Status     string           `json:"status"`
+	Strategy   string           `json:"strategy"`
+	Total      int              `json:"total"`
+	Processed  int              `json:"processed"`
+	Created    int              `json:"created"`
+	Updated    int              `json:"updated"`
+	Skipped    int              `json:"skipped"`
+	Failed     int              `json:"failed"`
+	Invited    int              `json:"invited"`
+	Errors     []ImportRowError `json:"errors"`
+	StartedAt  *time.Time       `json:"started_at,omitempty"`
+	FinishedAt *time.Time       `json:"finished_at,omitempty"`
+}
+
+// ImportProgressKey, import progress'inin cache key'ini döndürür.
+func ImportProgressKey(importID string) string {
+	return "user_import:" + importID
+}
+
+// SaveImportProgress, progress'i cache'e yazar.
+func SaveImportProgress(c cache.Cache, progress *ImportProgress) error {
+	return c.Set(ImportProgressKey(progress.ImportID), progress, importProgressTTL)
+}
+
+// LoadImportProgress, progress'i cache'den okur.
+//
+// Cache driver'ları değerleri JSON üzerinden sakladığı için (redis, file)
+// okunan değer tekrar ImportProgress'e decode edilir.
+//
+// Döndürür:
+//   - *ImportProgress: Progress (bulunamazsa nil)
+//   - error: Okuma/decode hatası
+func LoadImportProgress(c cache.Cache, importID string) (*ImportProgress, error) {
+	value, err := c.Get(ImportProgressKey(importID))
+	if err != nil || value == nil {
+		return nil, err
+	}
+
+	if progress, ok := value.(*ImportProgress); ok {
+		copied := *progress
+		return &copied, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var progress ImportProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+// init, job tipini global registry'ye kaydeder.
+//
+// Dependency'ler (Users, Cache, Mailer) burada inject edilemez; container'a
+// erişen uygulama main.go'da aynı tipi DI'lı bir factory ile yeniden kaydeder.
+func init() {
+	queue.RegisterType(func() *ImportUsersJob { return &ImportUsersJob{} })
+}
+
+// defaultInviteTTL, InviteTTL verilmemişse davet linkinin geçerlilik süresi.
+const defaultInviteTTL = 72 * time.Hour
+
+// ImportUsersJob, CSV'den kullanıcı import eden job.
+type ImportUsersJob struct {
+	queue.BaseJob
+	ImportID    string          `json:"import_id"`
+	Strategy    string          `json:"strategy"`
+	Rows        []ImportUserRow `json:"rows"`
+	SendInvites bool            `json:"send_invites"`
+	InviteURL   string          `json:"invite_url"` // Token'ın ?token= ile ekleneceği sayfa (PASSWORD_RESET_URL)
+	InviteTTL   time.Duration   `json:"invite_ttl"` // Davet linkinin geçerlilik süresi (0: defaultInviteTTL)
+	FromAddress string          `json:"from_address"`
+
+	// Dependency injection için (serialize edilmez)
+	Users  *models.UserRepository `json:"-"`
+	Cache  cache.Cache            `json:"-"`
+	Mailer mail.Mailer            `json:"-"`
+}
+
+// NewImportUsersJob, yeni bir ImportUsersJob oluşturur.
+//
+// Örnek:
+//
+//	job := jobs.NewImportUsersJob(importID, jobs.DuplicateSkip, rows, userRepo, cache, mailer)
+//	job.SendInvites = true
+//	job.InviteURL = cfg.PasswordReset.URL
+//	job.InviteTTL = cfg.PasswordReset.InviteTTL
+//	queue.Push(job, "imports")
+func NewImportUsersJob(importID, strategy string, rows []ImportUserRow, users *models.UserRepository, c cache.Cache, mailer mail.Mailer) *ImportUsersJob {
+	return &ImportUsersJob{
+		BaseJob: queue.BaseJob{
+			MaxAttempts: 1, // Kısmi import'un tekrar çalışması duplicate üretir
+		},
+		ImportID: importID,
+		Strategy: strategy,
+		Rows:     rows,
+		Users:    users,
+		Cache:    c,
+		Mailer:   mailer,
+	}
+}
+
+// Handle, satırları sırayla işler ve progress'i günceller.
+func (j *ImportUsersJob) Handle() error {
+	if j.Users == nil {
+		return fmt.Errorf("import job: user repository inject edilmemiş")
+	}
+
+	now := time.Now()
+	progress := &ImportProgress{
+		ImportID:  j.ImportID,
+		Status:    ImportStatusProcessing,
+		Strategy:  j.Strategy,
+		Total:     len(j.Rows),
+		Errors:    []ImportRowError{},
+		StartedAt: &now,
+	}
+	j.saveProgress(progress)
+
+	log.Printf("📥 Importing %d users (import: %s, strategy: %s)", len(j.Rows), j.ImportID, j.Strategy)
+
+	for _, row := range j.Rows {
+		if err := j.importRow(row, progress); err != nil {
+			progress.Failed++
+			progress.Errors = append(progress.Errors, ImportRowError{
+				Line:  row.Line,
+				Email: row.Email,
+				Error: err.Error(),
+			})
+		}
+
+		progress.Processed++
+		j.saveProgress(progress)
+	}
+
+	finished := time.Now()
+	progress.Status = ImportStatusCompleted
+	progress.FinishedAt = &finished
+	j.saveProgress(progress)
+
+	log.Printf("✅ User import completed: %s (created: %d, updated: %d, skipped: %d, failed: %d)",
+		j.ImportID, progress.Created, progress.Updated, progress.Skipped, progress.Failed)
+
+	return nil
+}
+
+// importRow, tek bir satırı duplicate stratejisine göre işler.
+func (j *ImportUsersJob) importRow(row ImportUserRow, progress *ImportProgress) error {
+	existing, err := j.Users.FindByEmail(row.Email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("kullanıcı sorgulanamadı: %w", err)
+	}
+
+	if existing != nil {
+		switch j.Strategy {
+		case DuplicateUpdate:
+			existing.Name = row.Name
+			existing.Status = row.Status
+			existing.Password = "" // Şifreye dokunma
+			if err := j.Users.Update(existing); err != nil {
+				return fmt.Errorf("kullanıcı güncellenemedi: %w", err)
+			}
+			progress.Updated++
+			return nil
+		case DuplicateFail:
+			return fmt.Errorf("bu email adresi zaten kayıtlı")
+		default:
+			progress.Skipped++
+			return nil
+		}
+	}
+
+	// Yeni kullanıcı: rastgele şifre, kullanıcı davet linki ile kendi belirler
+	randomPassword, err := token.GenerateSecureToken(24)
+	if err != nil {
+		return fmt.Errorf("şifre üretilemedi: %w", err)
+	}
+	hashedPassword, err := auth.Hash(randomPassword)
+	if err != nil {
+		return fmt.Errorf("şifre hash'lenemedi: %w", err)
+	}
+
+	user := &models.User{
+		Name:     row.Name,
+		Email:    row.Email,
+		Password: hashedPassword,
+		Status:   row.Status,
+	}
+	if _, err := j.Users.Create(user); err != nil {
+		return fmt.Errorf("kullanıcı oluşturulamadı: %w", err)
+	}
+	progress.Created++
+
+	if j.SendInvites {
+		if err := j.sendInvitation(row); err != nil {
+			// Kullanıcı oluşturuldu; davet hatası import'u bozmaz, raporlanır
+			progress.Errors = append(progress.Errors, ImportRowError{
+				Line:  row.Line,
+				Email: row.Email,
+				Error: "davet email'i gönderilemedi: " + err.Error(),
+			})
+			return nil
+		}
+		progress.Invited++
+	}
+
+	return nil
+}
+
+// sendInvitation, yeni hesaba şifre belirleme linki içeren davet email'i gönderir.
+func (j *ImportUsersJob) sendInvitation(row ImportUserRow) error {
+	if j.Mailer == nil {
+		log.Printf("⚠️  No mailer configured, skipping invitation for: %s", row.Email)
+		return nil
+	}
+
+	ttl := j.InviteTTL
+	if ttl <= 0 {
+		ttl = defaultInviteTTL
+	}
+
+	resetToken, err := j.Users.CreatePasswordResetToken(row.Email, ttl)
+	if err != nil {
+		return err
+	}
+
+	message := mail.Make(&mails.AccountInvitationMail{
+		Email: row.Email,
+		Name:  row.Name,
+		Link:  auth.TokenLink(j.InviteURL, resetToken),
+		TTL:   ttl,
+	})
+
+	if j.FromAddress != "" {
+		message.From(j.FromAddress, "")
+	}
+
+	return j.Mailer.Send(message)
+}
+
+// saveProgress, progress'i cache'e yazar (cache yoksa sadece loglar).
+func (j *ImportUsersJob) saveProgress(progress *ImportProgress) {
+	if j.Cache == nil {
+		return
+	}
+	if err := SaveImportProgress(j.Cache, progress); err != nil {
+		log.Printf("⚠️  Import progress kaydedilemedi [%s]: %v", j.ImportID, err)
+	}
+}
+
+// Failed, job başarısız olduğunda çağrılır.
+func (j *ImportUsersJob) Failed(err error) error {
+	log.Printf("❌ User import failed: %s (import: %s, request: %s, error: %v)", j.ID, j.ImportID, j.RequestID, err)
+
+	if j.Cache != nil {
+		progress, _ := LoadImportProgress(j.Cache, j.ImportID)
+		if progress == nil {
+			progress = &ImportProgress{ImportID: j.ImportID, Strategy: j.Strategy, Total: len(j.Rows)}
+		}
+		finished := time.Now()
+		progress.Status = ImportStatusFailed
+		progress.FinishedAt = &finished
+		progress.Errors = append(progress.Errors, ImportRowError{Error: err.Error()})
+		j.saveProgress(progress)
+	}
+
+	return nil
+}
+
+// GetPayload, job'ı serialize eder.
+func (j *ImportUsersJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j)
+}
+
+// SetPayload, job'ı deserialize eder.
+func (j *ImportUsersJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, j)
+}
@@ -20,6 +20,11 @@ import (
 	"github.com/biyonik/conduit-go/pkg/queue"
 )
 
+func init() {
+	// Worker'ın payload'ı deserialize edebilmesi için kendini kaydeder
+	queue.RegisterType(func() *ProcessUploadJob { return &ProcessUploadJob{} })
+}
+
 // ProcessUploadJob, dosya upload işleme job'u.
 type ProcessUploadJob struct {
 	queue.BaseJob
@@ -61,7 +66,7 @@ func (j *ProcessUploadJob) Handle() error {
 
 // Failed, job başarısız olduğunda çağrılır.
 func (j *ProcessUploadJob) Failed(err error) error {
-	log.Printf("❌ Upload processing failed: %s (file: %s, error: %v)", j.ID, j.FilePath, err)
+	log.Printf("❌ Upload processing failed: %s (file: %s, request: %s, error: %v)", j.ID, j.FilePath, j.RequestID, err)
 
 	// TODO: Temp file'ı sil
 	// TODO: User'a hata notification gönder
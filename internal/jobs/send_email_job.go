@@ -22,6 +22,11 @@ import (
 	"github.com/biyonik/conduit-go/pkg/queue"
 )
 
+func init() {
+	// Worker'ın payload'ı deserialize edebilmesi için kendini kaydeder
+	queue.RegisterType(func() *SendEmailJob { return &SendEmailJob{} })
+}
+
 // SendEmailJob, email gönderme job'u.
 type SendEmailJob struct {
 	queue.BaseJob
@@ -85,12 +90,11 @@ func (j *SendEmailJob) Handle() error {
 }
 
 // Failed, job başarısız olduğunda çağrılır.
+//
+// Job, worker tarafından failed_jobs tablosuna kaydedilir;
+// "conduit queue:retry <id>" ile tekrar kuyruğa alınabilir.
 func (j *SendEmailJob) Failed(err error) error {
-	log.Printf("❌ Email job failed: %s (to: %s, error: %v)", j.ID, j.To, err)
-
-	// TODO: Failed job'ları database'e kaydet
-	// TODO: Admin'e notification gönder
-
+	log.Printf("❌ Email job failed: %s (to: %s, request: %s, error: %v)", j.ID, j.To, j.RequestID, err)
 	return nil
 }
 
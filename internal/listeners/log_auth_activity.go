@@ -0,0 +1,31 @@
+package listeners
+
+import (
+	"log"
+	"reflect"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// LogAuthActivity, kayıt, giriş ve çıkış event'lerini loglar.
+type LogAuthActivity struct {
+	Logger *log.Logger
+}
+
+// NewLogAuthActivity, DI Container için fabrika fonksiyonu.
+func NewLogAuthActivity(c *container.Container) (*LogAuthActivity, error) {
+	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	return &LogAuthActivity{Logger: logger}, nil
+}
+
+// Handle, event'i kullanıcı bilgisiyle loglar.
+func (l *LogAuthActivity) Handle(event events.Event) error {
+	if user, ok := event.Payload().(auth.User); ok {
+		l.Logger.Printf("🔐 %s: user #%d (%s)", event.Name(), user.GetID(), user.GetEmail())
+		return nil
+	}
+	l.Logger.Printf("🔐 %s", event.Name())
+	return nil
+}
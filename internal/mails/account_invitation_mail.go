@@ -0,0 +1,28 @@
+package mails
+
+import (
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/mail"
+)
+
+// AccountInvitationMail, toplu içe aktarmada oluşturulan hesaba gönderilen
+// şifre belirleme davetidir.
+type AccountInvitationMail struct {
+	Email string
+	Name  string
+	Link  string        // Şifre belirleme URL'si (reset token dahil)
+	TTL   time.Duration // Linkin geçerlilik süresi
+}
+
+// Build, mesajı emails/account-invitation şablonuyla oluşturur.
+func (m *AccountInvitationMail) Build(message *mail.Message) error {
+	message.
+		To(m.Email, m.Name).
+		Template("emails/account-invitation", map[string]any{
+			"Name":  m.Name,
+			"Link":  m.Link,
+			"Hours": int(m.TTL.Hours()),
+		})
+	return nil
+}
@@ -0,0 +1,27 @@
+package mails
+
+import (
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/mail"
+)
+
+// MagicLinkMail, şifresiz giriş linki email'idir.
+type MagicLinkMail struct {
+	Email string
+	Name  string
+	Link  string        // Token içeren giriş URL'si
+	TTL   time.Duration // Linkin geçerlilik süresi
+}
+
+// Build, mesajı emails/magic-link şablonuyla oluşturur.
+func (m *MagicLinkMail) Build(message *mail.Message) error {
+	message.
+		To(m.Email, m.Name).
+		Template("emails/magic-link", map[string]any{
+			"Name":    m.Name,
+			"Link":    m.Link,
+			"Minutes": int(m.TTL.Minutes()),
+		})
+	return nil
+}
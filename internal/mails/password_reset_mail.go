@@ -0,0 +1,37 @@
+// -----------------------------------------------------------------------------
+// Application Mailables
+// -----------------------------------------------------------------------------
+// Bu package, uygulamanın gönderdiği email'leri mail.Mailable olarak içerir.
+// Her mailable email'in ihtiyaç duyduğu veriyi tutar ve Build ile mesajı
+// (alıcı, şablon, şablon verisi) oluşturur.
+//
+// Yeni mailable için: conduit make:mail <Name>
+// -----------------------------------------------------------------------------
+
+package mails
+
+import (
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/mail"
+)
+
+// PasswordResetMail, şifre sıfırlama linki email'idir.
+type PasswordResetMail struct {
+	Email string
+	Name  string
+	Link  string        // Token içeren reset sayfası URL'si
+	TTL   time.Duration // Linkin geçerlilik süresi
+}
+
+// Build, mesajı emails/password-reset şablonuyla oluşturur.
+func (m *PasswordResetMail) Build(message *mail.Message) error {
+	message.
+		To(m.Email, m.Name).
+		Template("emails/password-reset", map[string]any{
+			"Name":    m.Name,
+			"Link":    m.Link,
+			"Minutes": int(m.TTL.Minutes()),
+		})
+	return nil
+}
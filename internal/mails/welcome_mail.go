@@ -0,0 +1,19 @@
+package mails
+
+import "github.com/biyonik/conduit-go/pkg/mail"
+
+// WelcomeMail, yeni kullanıcıya gönderilen hoş geldin email'idir.
+type WelcomeMail struct {
+	Email string
+	Name  string
+}
+
+// Build, mesajı emails/welcome şablonuyla oluşturur.
+func (m *WelcomeMail) Build(message *mail.Message) error {
+	message.
+		To(m.Email, m.Name).
+		Template("emails/welcome", map[string]any{
+			"Name": m.Name,
+		})
+	return nil
+}
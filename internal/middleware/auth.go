@@ -11,9 +11,11 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
+	"github.com/biyonik/conduit-go/internal/http/request"
 	"github.com/biyonik/conduit-go/internal/http/response"
 	"github.com/biyonik/conduit-go/pkg/auth"
 )
@@ -47,10 +49,17 @@ import (
 //	})
 //
 // Context'e Eklenen Değerler:
-// - "user": auth.User interface implementasyonu
+// - "user": auth.User interface implementasyonu (auth.SetUserProvider ile
+// provider ayarlanmışsa provider'ın döndürdüğü kullanıcı, örn: *models.User;
+// değilse token claims'inden oluşturulan *auth.AuthenticatedUser)
 // - "user_id": int64 (kullanıcı ID'si)
 // - "user_email": string (kullanıcı email'i)
 // - "user_role": string (kullanıcı rolü)
+// - "token_claims": *auth.JWTClaims (logout'ta token'ı iptal etmek için)
+// - "impersonator_id": int64 (sadece impersonation token'larında; bkz: IsImpersonating)
+//
+// auth.RevokeToken / auth.RevokeAllForUser ile iptal edilen token'lar
+// reddedilir (bkz: pkg/auth/denylist.go).
 func Auth() Middleware {
 	return AuthWithConfig(nil)
 }
@@ -101,20 +110,43 @@ func AuthWithConfig(config *auth.JWTConfig) Middleware {
 				return
 			}
 
-			// 5. User bilgisini context'e ekle
-			user := &auth.AuthenticatedUser{
-				ID:    claims.UserID,
-				Email: claims.Email,
-				Role:  claims.Role,
+			// 5. İptal edilmiş token'ları reddet (logout, revoke-all).
+			// Denylist okunamazsa güvenli tarafta kalınır.
+			revoked, err := auth.IsRevoked(claims)
+			if err != nil {
+				response.Error(w, http.StatusServiceUnavailable, "Token doğrulanamadı, lütfen tekrar deneyin")
+				return
+			}
+			if revoked {
+				response.Error(w, http.StatusUnauthorized, "Token iptal edilmiş")
+				return
 			}
 
+			// 6. User'ı yükle (auth.SetUserProvider ayarlıysa provider'dan)
+			user, err := auth.UserFromClaims(claims)
+			if errors.Is(err, auth.ErrUserNotFound) {
+				response.Error(w, http.StatusUnauthorized, "Kullanıcı bulunamadı")
+				return
+			}
+			if err != nil {
+				response.Error(w, http.StatusServiceUnavailable, "Kullanıcı yüklenemedi, lütfen tekrar deneyin")
+				return
+			}
+
+			// 7. User bilgisini context'e ekle
+
 			ctx := r.Context()
 			ctx = context.WithValue(ctx, "user", user)
 			ctx = context.WithValue(ctx, "user_id", claims.UserID)
 			ctx = context.WithValue(ctx, "user_email", claims.Email)
 			ctx = context.WithValue(ctx, "user_role", claims.Role)
+			ctx = context.WithValue(ctx, "token_claims", claims)
+			if claims.IsImpersonated() {
+				ctx = context.WithValue(ctx, "impersonator_id", claims.ImpersonatorID)
+				auditImpersonatedRequest(r, claims)
+			}
 
-			// 6. Request'i güncellenmiş context ile devam ettir
+			// 8. Request'i güncellenmiş context ile devam ettir
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -172,32 +204,85 @@ func OptionalAuthWithConfig(config *auth.JWTConfig) Middleware {
 				return
 			}
 
-			// Token'ı doğrula
-			claims, err := auth.ParseToken(token, config)
+			// Geçersiz, iptal edilmiş veya silinmiş kullanıcıya ait token'la
+			// guest olarak devam et
+			user, claims, err := authenticateToken(token, config)
 			if err != nil {
-				// Token geçersiz, guest olarak devam et
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			// Token geçerli, user bilgisini context'e ekle
-			user := &auth.AuthenticatedUser{
-				ID:    claims.UserID,
-				Email: claims.Email,
-				Role:  claims.Role,
-			}
-
 			ctx := r.Context()
 			ctx = context.WithValue(ctx, "user", user)
 			ctx = context.WithValue(ctx, "user_id", claims.UserID)
 			ctx = context.WithValue(ctx, "user_email", claims.Email)
 			ctx = context.WithValue(ctx, "user_role", claims.Role)
+			ctx = context.WithValue(ctx, "token_claims", claims)
+			if claims.IsImpersonated() {
+				ctx = context.WithValue(ctx, "impersonator_id", claims.ImpersonatorID)
+				auditImpersonatedRequest(r, claims)
+			}
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// AuthenticateToken, bir access token'ı OptionalAuth ile aynı kurallarla
+// doğrular ve kullanıcıyı döndürür: imza ve süre kontrolü, denylist ve
+// kullanıcının hâlâ var olması; refresh token'lar reddedilir. Token'ı Authorization header'ı dışında
+// alan transport'lar (WebSocket bağlantısı içinde gönderilen token) içindir.
+//
+// Parametreler:
+//   - token: "Bearer " öneki olmadan JWT
+//   - config: JWT config (nil: auth.DefaultJWTConfig())
+//
+// Döndürür:
+//   - auth.User: Token'ın sahibi
+//   - error: Token geçersiz, iptal edilmiş veya kullanıcı bulunamadıysa
+//
+// Örnek:
+//
+//	server.Authenticate = func(token string) (auth.User, error) {
+//	    return middleware.AuthenticateToken(token, nil)
+//	}
+func AuthenticateToken(token string, config *auth.JWTConfig) (auth.User, error) {
+	if config == nil {
+		config = auth.DefaultJWTConfig()
+	}
+	user, claims, err := authenticateToken(token, config)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role == "refresh" {
+		return nil, errors.New("refresh token ile kimlik doğrulanamaz")
+	}
+	return user, nil
+}
+
+// authenticateToken, token'ı doğrulayıp kullanıcıyı ve claims'i döndürür.
+func authenticateToken(token string, config *auth.JWTConfig) (auth.User, *auth.JWTClaims, error) {
+	claims, err := auth.ParseToken(token, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Denylist okunamıyorsa da token reddedilir
+	revoked, err := auth.IsRevoked(claims)
+	if err != nil {
+		return nil, nil, err
+	}
+	if revoked {
+		return nil, nil, errors.New("token iptal edilmiş")
+	}
+
+	user, err := auth.UserFromClaims(claims)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, claims, nil
+}
+
 // extractBearerToken, Authorization header'ından Bearer token'ı çıkarır.
 //
 // Header formatı: "Bearer eyJhbGc..."
@@ -298,3 +383,74 @@ func GetUserRole(ctx context.Context) string {
 
 	return str
 }
+
+// GetTokenClaims, context'ten doğrulanmış token'ın claims'ini döndürür
+// (nil ise user authenticate değil).
+//
+// Örnek:
+//
+//	auth.RevokeToken(middleware.GetTokenClaims(r.Context()))
+func GetTokenClaims(ctx context.Context) *auth.JWTClaims {
+	claims, _ := ctx.Value("token_claims").(*auth.JWTClaims)
+	return claims
+}
+
+// GetImpersonatorID, istek impersonation token'ı ile yapılıyorsa admin'in
+// ID'sini döndürür (normal oturumda 0).
+func GetImpersonatorID(ctx context.Context) int64 {
+	id, _ := ctx.Value("impersonator_id").(int64)
+	return id
+}
+
+// IsImpersonating, isteğin bir admin tarafından impersonation ile yapılıp
+// yapılmadığını döndürür.
+//
+// Örnek:
+//
+//	if middleware.IsImpersonating(r.Context()) {
+//	    // UI'da "Kullanıcı adına görüntülüyorsunuz" banner'ı göster
+//	}
+func IsImpersonating(ctx context.Context) bool {
+	return GetImpersonatorID(ctx) != 0
+}
+
+// NotImpersonating, impersonation token'ı ile yapılan istekleri reddeden
+// middleware döndürür (403 Forbidden).
+//
+// Şifre değişikliği, API key üretimi gibi kullanıcının kendisinin yapması
+// gereken işlemlerde Auth()'tan sonra kullanılır.
+//
+// Örnek:
+//
+//	r.PUT("/api/auth/password", authController.ChangePassword).
+//	    Middleware(middleware.Auth()).
+//	    Middleware(middleware.NotImpersonating())
+func NotImpersonating() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if IsImpersonating(r.Context()) {
+				response.Error(w, http.StatusForbidden, "Bu işlem impersonation sırasında yapılamaz")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// auditImpersonatedRequest, impersonation token'ı ile yapılan isteği audit
+// log'a yazar.
+func auditImpersonatedRequest(r *http.Request, claims *auth.JWTClaims) {
+	req := request.New(r)
+
+	auth.AuditImpersonation(auth.ImpersonationEvent{
+		Action:         auth.ImpersonationRequest,
+		ImpersonatorID: claims.ImpersonatorID,
+		UserID:         claims.UserID,
+		TokenID:        claims.ID,
+		Method:         r.Method,
+		Path:           r.URL.Path,
+		IPAddress:      req.GetIP(),
+		UserAgent:      req.UserAgent(),
+	})
+}
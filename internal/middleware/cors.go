@@ -22,6 +22,9 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 // CORSMiddleware, belirli bir origin'e izin veren CORS yapılandırmasını geri
@@ -60,3 +63,165 @@ func CORSMiddleware(allowedOrigin string) Middleware {
 		})
 	}
 }
+
+// -----------------------------------------------------------------------------
+// CORS Policy Registry
+// -----------------------------------------------------------------------------
+// Tek bir global CORSMiddleware("*") yerine, her route grubu isimli bir CORS
+// policy'si kullanabilir. Örneğin public API tüm origin'lere açıkken, admin
+// API sadece dashboard origin'ine credential'lı erişim verir.
+//
+// Policy'ler isimle kaydedilir ve request anında çözülür; böylece config
+// değiştiğinde route tanımlarına dokunmadan policy güncellenebilir.
+// -----------------------------------------------------------------------------
+
+// Yerleşik policy isimleri.
+const (
+	CORSPolicyPublic = "public" // Public API (varsayılan)
+	CORSPolicyAdmin  = "admin"  // Admin/dashboard API
+)
+
+// CORSPolicy, bir route grubu için CORS kurallarını tanımlar.
+type CORSPolicy struct {
+	AllowedOrigins   []string // İzin verilen origin'ler ("*" = hepsi)
+	AllowedMethods   []string // Preflight'ta izin verilen method'lar
+	AllowedHeaders   []string // Preflight'ta izin verilen header'lar
+	ExposedHeaders   []string // Tarayıcının okuyabileceği response header'ları
+	AllowCredentials bool     // Cookie/Authorization ile istek izni ("*" ile uygulanmaz)
+	MaxAge           int      // Preflight cache süresi (saniye, 0 = gönderme)
+}
+
+// Varsayılan method ve header listeleri.
+var (
+	defaultCORSMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	defaultCORSHeaders = []string{"Content-Type", "Authorization", "X-CSRF-Token"}
+)
+
+// allowedOrigin, request origin'i için dönülecek Access-Control-Allow-Origin
+// değerini belirler. İzin yoksa boş string döner.
+func (p *CORSPolicy) allowedOrigin(origin string) string {
+	for _, allowed := range p.AllowedOrigins {
+		if allowed == "*" {
+			// Wildcard hiçbir zaman credential'lı değildir; origin yansıtılırsa
+			// her site cookie'li istek atabilir (bkz: apply)
+			return "*"
+		}
+		if origin != "" && strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// apply, policy header'larını response'a yazar.
+//
+// Preflight (OPTIONS) isteği ise 204 ile yanıtlar ve true döner;
+// bu durumda zincir devam etmemelidir.
+func (p *CORSPolicy) apply(w http.ResponseWriter, r *http.Request) bool {
+	allowed := p.allowedOrigin(r.Header.Get("Origin"))
+
+	if allowed != "" {
+		w.Header().Set("Access-Control-Allow-Origin", allowed)
+		if allowed != "*" {
+			w.Header().Add("Vary", "Origin")
+		}
+		if p.AllowCredentials && allowed != "*" {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		if len(p.ExposedHeaders) > 0 {
+			w.Header().Set("Access-Control-Expose-Headers", strings.Join(p.ExposedHeaders, ", "))
+		}
+	}
+
+	if r.Method != "OPTIONS" {
+		return false
+	}
+
+	if allowed != "" {
+		methods, headers := p.AllowedMethods, p.AllowedHeaders
+		if len(methods) == 0 {
+			methods = defaultCORSMethods
+		}
+		if len(headers) == 0 {
+			headers = defaultCORSHeaders
+		}
+		w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+		if p.MaxAge > 0 {
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(p.MaxAge))
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
+
+// Global policy registry (SetSecurityProfile ile aynı pattern)
+var (
+	corsPolicies   = make(map[string]*CORSPolicy)
+	corsPoliciesMu sync.RWMutex
+)
+
+// RegisterCORSPolicy, isimli bir CORS policy'si kaydeder (varsa değiştirir).
+//
+// Örnek:
+//
+//	middleware.RegisterCORSPolicy("partner", &middleware.CORSPolicy{
+//	    AllowedOrigins: []string{"https://partner.example.com"},
+//	    MaxAge:         600,
+//	})
+func RegisterCORSPolicy(name string, policy *CORSPolicy) {
+	corsPoliciesMu.Lock()
+	defer corsPoliciesMu.Unlock()
+	corsPolicies[name] = policy
+}
+
+// GetCORSPolicy, isimle kayıtlı policy'yi döndürür.
+func GetCORSPolicy(name string) (*CORSPolicy, bool) {
+	corsPoliciesMu.RLock()
+	defer corsPoliciesMu.RUnlock()
+	policy, ok := corsPolicies[name]
+	return policy, ok
+}
+
+// RegisterDefaultCORSPolicies, güvenlik profilinden yerleşik "public" ve
+// "admin" policy'lerini kaydeder.
+//
+// - public: Profilin CORS origin'leri (development: "*", production: APP_URL)
+// - admin: Sadece dashboard origin'leri, credential'lı
+func RegisterDefaultCORSPolicies(profile *SecurityProfile) {
+	RegisterCORSPolicy(CORSPolicyPublic, &CORSPolicy{
+		AllowedOrigins:   profile.CORSAllowedOrigins,
+		AllowCredentials: profile.CORSAllowCredentials,
+	})
+	RegisterCORSPolicy(CORSPolicyAdmin, &CORSPolicy{
+		AllowedOrigins:   profile.CORSAdminOrigins,
+		AllowCredentials: true,
+		MaxAge:           600,
+	})
+}
+
+// CORSWithPolicy, isimli policy'yi uygulayan middleware'dir.
+//
+// Policy request anında registry'den çözülür. Kayıtlı olmayan bir policy
+// hiçbir origin'e izin vermez (güvenli varsayılan).
+//
+// Genelde doğrudan değil, router üzerinden kullanılır:
+//
+//	adminGroup := r.Group("/api/admin")
+//	adminGroup.CORS(middleware.CORSPolicyAdmin)
+func CORSWithPolicy(name string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			policy, ok := GetCORSPolicy(name)
+			if !ok {
+				policy = &CORSPolicy{}
+			}
+
+			if policy.apply(w, r) {
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/subtle"
 	"encoding/base64"
@@ -21,6 +22,31 @@ import (
 // ✅ Production ready - Redis store interface'i için hazır
 // -----------------------------------------------------------------------------
 
+// CSRF middleware'inin cookie adları.
+const (
+	// CSRFCookieName, JavaScript'in okuyup X-CSRF-Token header'ına koyduğu
+	// token cookie'sidir; bu yüzden EncryptCookies tarafından şifrelenmez.
+	CSRFCookieName = "csrf_token"
+
+	// SessionCookieName, CSRF token'ının bağlandığı session ID cookie'sidir.
+	SessionCookieName = "session_id"
+)
+
+// csrfTokenKey, isteğin CSRF token'ının context anahtarıdır.
+type csrfTokenKey struct{}
+
+// CSRFTokenFromRequest, CSRFProtection middleware'inin isteğe bağladığı
+// token'ı döndürür (middleware çalışmadıysa boş string). Sunucu tarafında
+// render edilen formlarda _token alanı olarak kullanılır.
+//
+// Örnek:
+//
+//	<input type="hidden" name="_token" value="{{.csrf_token}}">
+func CSRFTokenFromRequest(r *http.Request) string {
+	token, _ := r.Context().Value(csrfTokenKey{}).(string)
+	return token
+}
+
 type CSRFToken struct {
 	Value     string
 	ExpiresAt time.Time
@@ -52,7 +78,8 @@ var csrfStore CSRFStore = NewInMemoryCSRFStore()
 
 // SetCSRFStore, global CSRF store'u değiştirir.
 // Production'da Redis store inject etmek için kullan:
-//   SetCSRFStore(NewRedisCSRFStore(redisClient))
+//
+//	SetCSRFStore(NewRedisCSRFStore(redisClient))
 func SetCSRFStore(store CSRFStore) {
 	csrfStore = store
 }
@@ -146,7 +173,7 @@ func (cs *InMemoryCSRFStore) DeleteToken(sessionID string) error {
 
 // getSessionID, request'ten session ID'yi çıkarır.
 func getSessionID(r *http.Request) string {
-	cookie, err := r.Cookie("session_id")
+	cookie, err := r.Cookie(SessionCookieName)
 	if err != nil {
 		return ""
 	}
@@ -155,15 +182,8 @@ func getSessionID(r *http.Request) string {
 
 // setSessionID, response'a session ID cookie'sini ekler.
 func setSessionID(w http.ResponseWriter, sessionID string) {
-	http.SetCookie(w, &http.Cookie{
-		Name:     "session_id",
-		Value:    sessionID,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // PRODUCTION'DA true olmalı (HTTPS için)
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   7200, // 2 saat
-	})
+	// Secure/SameSite değerleri ortama duyarlı güvenlik profilinden gelir
+	response.Cookie(w, SessionCookieName, sessionID, 2*time.Hour)
 }
 
 // CSRFProtection, CSRF token doğrulaması yapan middleware'i döndürür.
@@ -191,15 +211,10 @@ func CSRFProtection() Middleware {
 			}
 
 			// Token'ı cookie olarak set et (JavaScript'ten erişilebilir olması için)
-			http.SetCookie(w, &http.Cookie{
-				Name:     "csrf_token",
-				Value:    csrfToken,
-				Path:     "/",
-				HttpOnly: false, // JavaScript erişimi için false
-				Secure:   false, // PRODUCTION'DA true olmalı
-				SameSite: http.SameSiteStrictMode,
-				MaxAge:   7200, // 2 saat
-			})
+			cookie := response.NewCookie(CSRFCookieName, csrfToken, 2*time.Hour)
+			cookie.HttpOnly = false
+			http.SetCookie(w, cookie)
+			r = r.WithContext(context.WithValue(r.Context(), csrfTokenKey{}, csrfToken))
 
 			// Safe metodlar (GET, HEAD, OPTIONS) için doğrulama yapma
 			if r.Method == "GET" || r.Method == "HEAD" || r.Method == "OPTIONS" {
@@ -296,4 +311,4 @@ func CSRFProtection() Middleware {
 //     key := r.prefix + sessionID
 //     return r.client.Del(ctx, key).Err()
 // }
-// -----------------------------------------------------------------------------
\ No newline at end of file
+// -----------------------------------------------------------------------------
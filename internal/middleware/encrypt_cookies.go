@@ -0,0 +1,133 @@
+// -----------------------------------------------------------------------------
+// Encrypt Cookies Middleware
+// -----------------------------------------------------------------------------
+// Response cookie'lerini APP_KEY ile şifreler, gelen cookie'leri çözer.
+// Çözülemeyen (değiştirilmiş veya kopyalanmış) cookie'ler istekten çıkarılır.
+// -----------------------------------------------------------------------------
+
+package middleware
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/pkg/crypt"
+)
+
+// EncryptCookies, response cookie'lerini APP_KEY ile (AES-GCM) şifreleyen
+// ve gelen cookie'leri çözen middleware'dir (Laravel EncryptCookies).
+//
+// Her değer cookie adına bağlanarak şifrelenir; client'ın değiştirdiği veya
+// başka bir cookie'den kopyaladığı değerler çözülemez ve istekten çıkarılır
+// (handler cookie hiç gönderilmemiş gibi görür). Handler'lar düz değerlerle
+// çalışır: r.Cookie / r.CookieValue çözülmüş değeri, response.Cookie ile
+// set edilen değer client'a şifreli gider.
+//
+// JavaScript'in okuması gereken cookie'ler except ile hariç tutulur; CSRF
+// cookie'si (csrf_token) her zaman hariçtir. Şifreleme değeri yaklaşık
+// 1.3 kat + 38 byte büyütür (4 KB cookie limitine dikkat).
+//
+// Parametreler:
+//   - encrypter: APP_KEY'den oluşturulmuş Encrypter
+//   - except: Şifrelenmeyecek cookie adları
+//
+// Örnek:
+//
+//	encrypter, _ := crypt.NewFromAppKey(cfg.App.Key)
+//	r.Use(middleware.EncryptCookies(encrypter, "locale"))
+func EncryptCookies(encrypter *crypt.Encrypter, except ...string) Middleware {
+	skip := map[string]bool{CSRFCookieName: true}
+	for _, name := range except {
+		skip[name] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(r.Header.Values("Cookie")) > 0 {
+				r = decryptRequestCookies(r, encrypter, skip)
+			}
+			rw := response.WrapWriter(w)
+			rw.BeforeWriteHeader(encryptResponseCookies(encrypter, skip))
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+// decryptRequestCookies, şifreli cookie'leri çözülmüş değerleriyle
+// değiştirir; çözülemeyenler çıkarılır.
+func decryptRequestCookies(r *http.Request, encrypter *crypt.Encrypter, skip map[string]bool) *http.Request {
+	var pairs []string
+	for _, cookie := range r.Cookies() {
+		value := cookie.Value
+		if !skip[cookie.Name] {
+			plain, ok := decryptCookieValue(encrypter, cookie.Name, value)
+			if !ok {
+				continue // Değiştirilmiş veya şifresiz cookie
+			}
+			value = plain
+		}
+		pairs = append(pairs, cookie.Name+"="+value)
+	}
+
+	r = r.Clone(r.Context())
+	r.Header.Del("Cookie")
+	if len(pairs) > 0 {
+		r.Header.Set("Cookie", strings.Join(pairs, "; "))
+	}
+	return r
+}
+
+// encryptCookieValue, değeri cookie adına bağlı olarak şifreler.
+func encryptCookieValue(encrypter *crypt.Encrypter, name, value string) (string, error) {
+	sealed, err := encrypter.Encrypt([]byte(value), []byte(name))
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// decryptCookieValue, encryptCookieValue ile şifrelenmiş değeri çözer.
+func decryptCookieValue(encrypter *crypt.Encrypter, name, value string) (string, bool) {
+	sealed, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return "", false
+	}
+	plain, err := encrypter.Decrypt(sealed, []byte(name))
+	if err != nil {
+		return "", false
+	}
+	return string(plain), true
+}
+
+// encryptResponseCookies, header'lar gönderilmeden hemen önce (bkz:
+// response.Writer.BeforeWriteHeader) Set-Cookie değerlerini şifreler.
+// Silme cookie'leri (boş değer) olduğu gibi bırakılır; şifrelenemeyen
+// cookie düz gönderilmek yerine çıkarılır.
+func encryptResponseCookies(encrypter *crypt.Encrypter, skip map[string]bool) func(*response.Writer) {
+	return func(w *response.Writer) {
+		header := w.Header()
+		lines := header.Values("Set-Cookie")
+		if len(lines) == 0 {
+			return
+		}
+
+		encrypted := make([]string, 0, len(lines))
+		for _, line := range lines {
+			cookie, err := http.ParseSetCookie(line)
+			if err != nil || cookie.Value == "" || skip[cookie.Name] {
+				encrypted = append(encrypted, line)
+				continue
+			}
+
+			value, err := encryptCookieValue(encrypter, cookie.Name, cookie.Value)
+			if err != nil {
+				continue
+			}
+			cookie.Value = value
+			encrypted = append(encrypted, cookie.String())
+		}
+		header["Set-Cookie"] = encrypted
+	}
+}
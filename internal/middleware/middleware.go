@@ -22,6 +22,8 @@ import (
 	"log"
 	"net/http"
 	"time"
+
+	"github.com/biyonik/conduit-go/internal/http/response"
 )
 
 // Middleware, bir sonraki http.Handler'ı alıp onu yeni bir handler olarak
@@ -36,16 +38,24 @@ type Middleware func(next http.Handler) http.Handler
 //
 // Bu sayede hangi isteğin ne kadar sürede işlendiği gerçek zamanlı olarak takip
 // edilebilir. Uygulama performansı, debugging ihtiyaçları ve API izleme açısından
-// oldukça değerlidir.
+// oldukça değerlidir. Çıkış logunda status ve gövde boyutu response.Writer'dan
+// okunur.
 func Logging(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now() // İşlem başlangıç zamanı
 
-		log.Printf("-> %s %s", r.Method, r.URL.Path) // İstek girişi logu
+		log.Printf("-> %s %s%s", r.Method, r.URL.Path, requestTag(r)) // İstek girişi logu
+
+		rw := response.WrapWriter(w)
+		next.ServeHTTP(rw, r) // Bir sonraki handler'ı çalıştır
 
-		next.ServeHTTP(w, r) // Bir sonraki handler'ı çalıştır
+		// Handler hiçbir şey yazmadıysa net/http 200 gönderir
+		status := rw.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
 
-		// İşlem bitiş logu, toplam süre ile birlikte
-		log.Printf("<- %s %s (%s)", r.Method, r.URL.Path, time.Since(start))
+		// İşlem bitiş logu, status, boyut ve toplam süre ile birlikte
+		log.Printf("<- %s %s %d %dB (%s)%s", r.Method, r.URL.Path, status, rw.Size(), time.Since(start), requestTag(r))
 	})
 }
@@ -6,19 +6,24 @@ import (
 	"runtime/debug"
 
 	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/pkg/version"
 )
 
 // PanicRecovery, bir handler'da panic oluştuğunda sunucunun çökmesini engeller
-// ve istemciye standart bir JSON 500 hatası döndürür.
+// ve istemciye application/problem+json formatında 500 hatası döndürür.
+//
+// Panic logu, hatanın hangi build'de oluştuğunu gösteren sürüm ve commit
+// bilgisini ve (RequestID middleware'i aktifse) request ID'yi içerir.
 func PanicRecovery(logger *log.Logger) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer func() {
 				if err := recover(); err != nil {
 
-					logger.Printf("PANIC: %v\n%s", err, debug.Stack())
+					logger.Printf("PANIC [version: %s, commit: %s]%s %s %s: %v\n%s",
+						version.Version, version.Commit, requestTag(r), r.Method, r.URL.Path, err, debug.Stack())
 
-					response.Error(w, http.StatusInternalServerError, "Sunucuda beklenmedik bir hata oluştu")
+					response.ProblemError(w, r, http.StatusInternalServerError, "Sunucuda beklenmedik bir hata oluştu")
 				}
 			}()
 
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/biyonik/conduit-go/pkg/requestid"
+)
+
+// RequestID, her isteğe bir request ID atar ve context'e ekler.
+//
+// İstek geçerli bir X-Request-ID header'ı ile geldiyse (örn: load balancer
+// veya upstream servis tarafından eklenmiş) aynı ID kullanılır; aksi halde
+// yeni bir UUID üretilir. ID response header'ına da yazılır.
+//
+// Loglar, panic kayıtları ve istek içinden dispatch edilen job'lar bu ID'yi
+// taşır; bu yüzden middleware zincirinin en başında olmalıdır.
+//
+// Örnek:
+//
+//	r.Use(middleware.RequestID())
+//	r.Use(middleware.PanicRecovery(logger))
+func RequestID() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(requestid.HeaderName)
+			if !requestid.Valid(id) {
+				id = requestid.New()
+			}
+
+			w.Header().Set(requestid.HeaderName, id)
+			next.ServeHTTP(w, r.WithContext(requestid.WithID(r.Context(), id)))
+		})
+	}
+}
+
+// requestTag, log satırlarına eklenecek " [request-id]" etiketini döndürür.
+//
+// RequestID middleware'i kullanılmıyorsa boş string döner.
+func requestTag(r *http.Request) string {
+	if id := requestid.FromContext(r.Context()); id != "" {
+		return " [" + id + "]"
+	}
+	return ""
+}
@@ -152,7 +152,12 @@ func RequireEmailVerification() Middleware {
 //	        return post.UserID == userID || middleware.GetUserRole(r.Context()) == "admin"
 //	    }))
 //
-// NOT: Bu Phase 3'te daha da geliştirilecek (Policy sınıfları eklenecek)
+// Policy struct'ları `conduit make:policy PostPolicy --model=Post` ile
+// internal/policies altında üretilir; policyCheck policy metodunu çağırır:
+//
+//	middleware.Can("update-post", func(r *http.Request) bool {
+//	    return postPolicy.Update(middleware.GetAuthUser(r.Context()), post)
+//	})
 func Can(action string, policyCheck func(r *http.Request) bool) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
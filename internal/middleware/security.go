@@ -0,0 +1,184 @@
+// -----------------------------------------------------------------------------
+// Security Defaults Profile
+// -----------------------------------------------------------------------------
+// Bu dosya, cookie (Secure/SameSite), HSTS ve CORS gibi güvenlik ayarlarını
+// ortama duyarlı tek bir profilde toplar. Daha önce bu değerler middleware'ler
+// içine "PRODUCTION'DA true olmalı" yorumlarıyla hard-coded yazılıydı.
+//
+// Profil config üzerinden oluşturulur: production ortamında sıkı (strict),
+// development ortamında esnek (relaxed) varsayılanlar kullanılır ve her değer
+// ortam değişkenleriyle açıkça override edilebilir.
+//
+// Kullanım:
+//
+//	middleware.SetSecurityProfile(middleware.SecurityProfileFromConfig(cfg))
+//	r.Use(middleware.SecurityHeaders())
+//	r.Use(middleware.CORS())
+// -----------------------------------------------------------------------------
+
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	"github.com/biyonik/conduit-go/internal/http/response"
+)
+
+// SecurityProfile, ortama göre belirlenen güvenlik varsayılanlarını tutar.
+type SecurityProfile struct {
+	CookieSecure          bool          // Cookie'ler sadece HTTPS üzerinden gönderilir
+	CookieSameSite        http.SameSite // Cookie SameSite politikası
+	HSTSEnabled           bool          // Strict-Transport-Security header'ı
+	HSTSMaxAge            int           // HSTS max-age (saniye)
+	HSTSIncludeSubdomains bool          // HSTS includeSubDomains direktifi
+	CORSAllowedOrigins    []string      // İzin verilen origin'ler ("*" = hepsi)
+	CORSAllowCredentials  bool          // Access-Control-Allow-Credentials
+	CORSAdminOrigins      []string      // Admin API (dashboard) için izin verilen origin'ler
+}
+
+// DevelopmentSecurityProfile, local geliştirme için esnek varsayılanları döndürür.
+//
+// Config yüklenmeden önce (örn: testlerde) kullanılan varsayılan profildir.
+func DevelopmentSecurityProfile() *SecurityProfile {
+	return &SecurityProfile{
+		CookieSecure:       false,
+		CookieSameSite:     http.SameSiteLaxMode,
+		HSTSEnabled:        false,
+		HSTSMaxAge:         31536000,
+		CORSAllowedOrigins: []string{"*"},
+	}
+}
+
+// SecurityProfileFromConfig, config'deki ortama duyarlı güvenlik ayarlarından
+// bir profil oluşturur.
+//
+// Örnek:
+//
+//	cfg := config.Load() // APP_ENV=production -> Secure cookie, HSTS, strict SameSite
+//	middleware.SetSecurityProfile(middleware.SecurityProfileFromConfig(cfg))
+func SecurityProfileFromConfig(cfg *config.Config) *SecurityProfile {
+	return &SecurityProfile{
+		CookieSecure:          cfg.Security.CookieSecure,
+		CookieSameSite:        parseSameSite(cfg.Security.CookieSameSite),
+		HSTSEnabled:           cfg.Security.HSTSEnabled,
+		HSTSMaxAge:            cfg.Security.HSTSMaxAge,
+		HSTSIncludeSubdomains: cfg.Security.HSTSIncludeSubdomains,
+		CORSAllowedOrigins:    cfg.Security.CORSAllowedOrigins,
+		CORSAllowCredentials:  cfg.Security.CORSAllowCredentials,
+		CORSAdminOrigins:      cfg.Security.CORSAdminOrigins,
+	}
+}
+
+// parseSameSite, config string'ini http.SameSite değerine çevirir.
+func parseSameSite(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
+
+// Global güvenlik profili (SetCSRFStore ile aynı pattern)
+var (
+	securityProfile   = DevelopmentSecurityProfile()
+	securityProfileMu sync.RWMutex
+)
+
+// SetSecurityProfile, global güvenlik profilini değiştirir.
+// Uygulama başlatılırken (main.go) config yüklendikten sonra çağrılmalı.
+//
+// Profilin Secure/SameSite değerleri response.Cookie varsayılanlarına da
+// uygulanır.
+func SetSecurityProfile(profile *SecurityProfile) {
+	securityProfileMu.Lock()
+	defer securityProfileMu.Unlock()
+	securityProfile = profile
+
+	defaults := response.GetCookieDefaults()
+	defaults.Secure = profile.CookieSecure
+	defaults.SameSite = profile.CookieSameSite
+	response.SetCookieDefaults(defaults)
+}
+
+// GetSecurityProfile, aktif güvenlik profilini döndürür.
+func GetSecurityProfile() *SecurityProfile {
+	securityProfileMu.RLock()
+	defer securityProfileMu.RUnlock()
+	return securityProfile
+}
+
+// ApplyCookieDefaults, cookie'ye profilin Secure ve SameSite değerlerini uygular.
+//
+// Middleware'ler cookie set ederken bu metodu kullanır; böylece cookie
+// güvenliği tek bir yerden yönetilir.
+func (p *SecurityProfile) ApplyCookieDefaults(cookie *http.Cookie) *http.Cookie {
+	cookie.Secure = p.CookieSecure
+	cookie.SameSite = p.CookieSameSite
+	return cookie
+}
+
+// hstsHeader, Strict-Transport-Security header değerini üretir.
+func (p *SecurityProfile) hstsHeader() string {
+	value := "max-age=" + strconv.Itoa(p.HSTSMaxAge)
+	if p.HSTSIncludeSubdomains {
+		value += "; includeSubDomains"
+	}
+	return value
+}
+
+// corsPolicy, profilin genel CORS ayarlarından bir policy oluşturur.
+func (p *SecurityProfile) corsPolicy() *CORSPolicy {
+	return &CORSPolicy{
+		AllowedOrigins:   p.CORSAllowedOrigins,
+		AllowCredentials: p.CORSAllowCredentials,
+	}
+}
+
+// SecurityHeaders, profil bazlı güvenlik header'larını ekleyen middleware'dir.
+//
+// Eklenen header'lar:
+//   - Strict-Transport-Security (sadece HSTS aktifse)
+//   - X-Content-Type-Options: nosniff
+//   - X-Frame-Options: DENY
+//   - Referrer-Policy: strict-origin-when-cross-origin
+func SecurityHeaders() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			profile := GetSecurityProfile()
+
+			if profile.HSTSEnabled {
+				w.Header().Set("Strict-Transport-Security", profile.hstsHeader())
+			}
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+			w.Header().Set("X-Frame-Options", "DENY")
+			w.Header().Set("Referrer-Policy", "strict-origin-when-cross-origin")
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// CORS, aktif güvenlik profilindeki origin listesine göre CORS header'larını
+// ekleyen middleware'dir.
+//
+// CORSMiddleware'den farkı, izin verilen origin'lerin ortama göre config'den
+// gelmesidir (production: APP_URL, development: "*"). Route grubu bazında
+// farklı policy gerekiyorsa router'ın CORS(policyName) metodu kullanılır.
+func CORS() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if GetSecurityProfile().corsPolicy().apply(w, r) {
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
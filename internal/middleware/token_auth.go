@@ -0,0 +1,137 @@
+// -----------------------------------------------------------------------------
+// Personal Access Token Middleware
+// -----------------------------------------------------------------------------
+// CLI ve API entegrasyonlarının personal access token (bkz:
+// pkg/auth/personal_access_tokens.go) ile kimlik doğrulaması için
+// middleware'ler. Laravel Sanctum'daki auth:sanctum ve abilities
+// middleware'lerine benzer.
+// -----------------------------------------------------------------------------
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/pkg/auth"
+)
+
+// AuthToken, personal access token veya JWT kabul eden authentication
+// middleware'ini döndürür.
+//
+// Authorization header'ındaki bearer token {id}|{secret} formatındaysa
+// personal access token olarak doğrulanır; değilse Auth() ile aynı JWT
+// doğrulaması yapılır. Böylece aynı route hem tarayıcıdan (JWT) hem de
+// CLI/CI'dan (API key) kullanılabilir.
+//
+// Kullanım:
+//
+//	apiV1 := r.Group("/api/v1")
+//	apiV1.Use(middleware.AuthToken())
+//
+//	// CLI:
+//	// curl -H "Authorization: Bearer 12|9f86d0818..." https://app/api/v1/servers
+//
+// Context'e Eklenen Değerler (personal access token için):
+// - "user": auth.SetUserProvider ayarlıysa provider'ın döndürdüğü kullanıcı;
+// değilse *auth.AuthenticatedUser (sadece ID dolu)
+// - "user_id": int64
+// - "access_token": *auth.PersonalAccessToken (bkz: GetAccessToken)
+func AuthToken() Middleware {
+	return AuthTokenWithConfig(nil)
+}
+
+// AuthTokenWithConfig, özel JWT config ile AuthToken middleware'ini döndürür.
+func AuthTokenWithConfig(config *auth.JWTConfig) Middleware {
+	jwtAuth := AuthWithConfig(config)
+
+	return func(next http.Handler) http.Handler {
+		jwtNext := jwtAuth(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := extractBearerToken(r.Header.Get("Authorization"))
+			if !auth.IsPersonalAccessToken(token) {
+				jwtNext.ServeHTTP(w, r)
+				return
+			}
+
+			accessToken, err := auth.AuthenticatePersonalAccessToken(token)
+			if errors.Is(err, auth.ErrAccessTokenExpired) {
+				response.Error(w, http.StatusUnauthorized, "Token süresi dolmuş")
+				return
+			}
+			if errors.Is(err, auth.ErrAccessTokenNotFound) {
+				response.Error(w, http.StatusUnauthorized, "Geçersiz token")
+				return
+			}
+			if err != nil {
+				response.Error(w, http.StatusServiceUnavailable, "Token doğrulanamadı, lütfen tekrar deneyin")
+				return
+			}
+
+			// Provider ayarlıysa token sahibi yüklenir (silinmiş kullanıcı reddedilir)
+			var user auth.User = &auth.AuthenticatedUser{ID: accessToken.UserID}
+			if provider := auth.GetUserProvider(); provider != nil {
+				user, err = provider.RetrieveByID(accessToken.UserID)
+				if errors.Is(err, auth.ErrUserNotFound) {
+					response.Error(w, http.StatusUnauthorized, "Geçersiz token")
+					return
+				}
+				if err != nil {
+					response.Error(w, http.StatusServiceUnavailable, "Token doğrulanamadı, lütfen tekrar deneyin")
+					return
+				}
+			}
+
+			ctx := r.Context()
+			ctx = context.WithValue(ctx, "user", user)
+			ctx = context.WithValue(ctx, "user_id", accessToken.UserID)
+			ctx = context.WithValue(ctx, "access_token", accessToken)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// TokenAbilities, personal access token'ın verilen yetkilerin hepsine sahip
+// olmasını zorunlu kılar (403 Forbidden).
+//
+// JWT ile gelen istekler (tarayıcı oturumu) tüm yetkilere sahip sayılır.
+// AuthToken() middleware'inden sonra kullanılmalıdır.
+//
+// Örnek:
+//
+//	r.POST("/api/v1/deploy", DeployHandler).
+//	    Middleware(middleware.AuthToken()).
+//	    Middleware(middleware.TokenAbilities("servers:deploy"))
+func TokenAbilities(abilities ...string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if accessToken := GetAccessToken(r.Context()); accessToken != nil {
+				for _, ability := range abilities {
+					if accessToken.Cant(ability) {
+						response.Error(w, http.StatusForbidden, "Token bu işlem için yetkili değil: "+ability)
+						return
+					}
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// GetAccessToken, context'ten personal access token'ı döndürür (istek JWT
+// ile doğrulanmışsa veya authenticate değilse nil).
+//
+// Örnek:
+//
+//	if token := middleware.GetAccessToken(r.Context()); token != nil && token.Cant("servers:read") {
+//	    conduitRes.Error(w, 403, "Yetkisiz")
+//	}
+func GetAccessToken(ctx context.Context) *auth.PersonalAccessToken {
+	accessToken, _ := ctx.Value("access_token").(*auth.PersonalAccessToken)
+	return accessToken
+}
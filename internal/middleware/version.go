@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/biyonik/conduit-go/pkg/version"
+)
+
+// VersionHeaderName, uygulama sürümünü taşıyan response header'ı.
+const VersionHeaderName = "X-App-Version"
+
+// VersionHeader, her response'a uygulama sürümünü (X-App-Version) ekler.
+//
+// Canary/rolling deploy sırasında hangi sürümün isteğe cevap verdiğini
+// görmek için kullanışlıdır. Sürüm bilgisi saldırganlara hedef
+// daraltmada yardımcı olabileceğinden opsiyoneldir (APP_EXPOSE_VERSION).
+//
+// Örnek:
+//
+//	if cfg.App.ExposeVersion {
+//	    r.Use(middleware.VersionHeader())
+//	}
+func VersionHeader() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(VersionHeaderName, version.Version)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -8,12 +8,16 @@
 package models
 
 import (
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/biyonik/conduit-go/pkg/auth"
 	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/token"
 )
 
 // User, users tablosunu temsil eden modeldir.
@@ -133,6 +137,81 @@ func (r *UserRepository) GetAll(page, perPage int) ([]User, error) {
 	return users, nil
 }
 
+// Paginate, silinmemiş kullanıcıları sayfalar (en yeni önce).
+//
+// Parametreler:
+//   - page: Sayfa numarası (1'den başlar)
+//   - perPage: Sayfa başına kayıt sayısı
+//
+// Döndürür:
+//   - []User: Sayfadaki kullanıcılar
+//   - *database.Paginator: Sayfalama bilgisi (response.Paginated ile kullanılır)
+//   - error: Hata varsa
+func (r *UserRepository) Paginate(page, perPage int) ([]User, *database.Paginator, error) {
+	var users []User
+
+	paginator, err := r.newBuilder().
+		Table("users").
+		Where("deleted_at", "IS", nil).
+		OrderBy("created_at", "DESC").
+		Paginate(page, perPage, &users)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return users, paginator, nil
+}
+
+// Chunk, tüm kullanıcıları sabit boyutlu parçalar halinde callback'e verir.
+//
+// Tüm tabloyu belleğe almadan büyük veri setleri üzerinde işlem yapmak
+// (örn: CSV export) için kullanılır. Callback hata dönerse iterasyon durur.
+//
+// Parametreler:
+//   - size: Parça başına kayıt sayısı
+//   - fn: Her parça için çağrılacak fonksiyon
+//
+// Döndürür:
+//   - error: Sorgu veya callback hatası
+//
+// Örnek:
+//
+//	err := userRepo.Chunk(500, func(users []User) error {
+//	    for _, u := range users {
+//	        writer.Write([]string{u.Name, u.Email})
+//	    }
+//	    return nil
+//	})
+func (r *UserRepository) Chunk(size int, fn func(users []User) error) error {
+	for page := 1; ; page++ {
+		var users []User
+
+		err := r.newBuilder().
+			Table("users").
+			Where("deleted_at", "IS", nil).
+			OrderBy("id", "ASC").
+			Limit(size).
+			Offset((page - 1) * size).
+			Get(&users)
+		if err != nil {
+			return err
+		}
+
+		if len(users) == 0 {
+			return nil
+		}
+
+		if err := fn(users); err != nil {
+			return err
+		}
+
+		if len(users) < size {
+			return nil
+		}
+	}
+}
+
 // Create, yeni bir kullanıcı oluşturur.
 //
 // Parametre:
@@ -159,7 +238,7 @@ func (r *UserRepository) Create(user *User) (int64, error) {
 	user.CreatedAt = now
 	user.UpdatedAt = now
 
-	result, err := r.newBuilder().ExecInsert(map[string]interface{}{
+	result, err := r.newBuilder().Table("users").ExecInsert(map[string]interface{}{
 		"name":       user.Name,
 		"email":      user.Email,
 		"password":   user.Password,
@@ -215,6 +294,56 @@ func (r *UserRepository) Update(user *User) error {
 	return err
 }
 
+// userUpdatableColumns, UpdateFields ile kısmi güncellenebilecek kolonlar.
+//
+// Whitelist, PATCH payload'undan gelen beklenmeyen alanların (id, role,
+// password vb.) yanlışlıkla veritabanına yazılmasını engeller.
+var userUpdatableColumns = map[string]bool{
+	"name":              true,
+	"email":             true,
+	"status":            true,
+	"email_verified_at": true,
+}
+
+// UpdateFields, kullanıcının sadece verilen alanlarını günceller (PATCH).
+//
+// Update'ten farkı, struct'taki tüm alanları değil sadece map'te bulunan
+// kolonları yazmasıdır; böylece gönderilmeyen alanların sıfır değerle
+// ezilmesi engellenir. updated_at otomatik set edilir.
+//
+// Parametreler:
+//   - id: Güncellenecek kullanıcının ID'si
+//   - fields: Kolon -> değer (örn: validation.ValidatePartial sonucu)
+//
+// Döndürür:
+//   - error: Whitelist dışında bir kolon varsa veya veritabanı hatası
+//
+// Örnek:
+//
+//	err := userRepo.UpdateFields(123, map[string]interface{}{"name": "Jane"})
+func (r *UserRepository) UpdateFields(id int64, fields map[string]interface{}) error {
+	data := make(map[string]interface{}, len(fields)+1)
+	for column, value := range fields {
+		if !userUpdatableColumns[column] {
+			return fmt.Errorf("kolon kısmi güncellenemez: %s", column)
+		}
+		data[column] = value
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+	data["updated_at"] = time.Now()
+
+	_, err := r.newBuilder().
+		Table("users").
+		Where("id", "=", id).
+		Where("deleted_at", "IS", nil).
+		ExecUpdate(data)
+
+	return err
+}
+
 // Delete, kullanıcıyı soft delete yapar.
 //
 // Soft Delete nedir?
@@ -331,6 +460,48 @@ func (r *UserRepository) ExistsByEmail(email string) (bool, error) {
 	return true, nil
 }
 
+// CreatePasswordResetToken, kullanıcı için yeni bir şifre belirleme token'ı
+// oluşturur ve hash'lenmiş halini password_reset_tokens tablosuna kaydeder.
+//
+// Aynı email için önceki token'lar silinir. Düz token sadece çağırana döner
+// (email ile gönderilmek üzere), veritabanında SHA-256 hash'i saklanır.
+//
+// Parametre:
+//   - email: Kullanıcı email'i
+//   - ttl: Token'ın geçerlilik süresi (expires_at)
+//
+// Döndürür:
+//   - string: Düz (hash'lenmemiş) token
+//   - error: Hata varsa
+//
+// Kullanım:
+// Davet (invitation) email'lerinde kullanıcının ilk şifresini belirlemesi için.
+func (r *UserRepository) CreatePasswordResetToken(email string, ttl time.Duration) (string, error) {
+	plain, err := token.GeneratePasswordResetToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, _ = r.newBuilder().
+		Table("password_reset_tokens").
+		Where("email", "=", email).
+		ExecDelete()
+
+	hash := sha256.Sum256([]byte(plain))
+	now := time.Now()
+	_, err = r.newBuilder().Table("password_reset_tokens").ExecInsert(map[string]interface{}{
+		"email":      email,
+		"token":      hex.EncodeToString(hash[:]),
+		"created_at": now,
+		"expires_at": now.Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return plain, nil
+}
+
 // GetID, auth.User interface implementasyonu için.
 func (u *User) GetID() int64 {
 	return u.ID
@@ -366,3 +537,23 @@ func (u *User) IsEmailVerified() bool {
 func (u *User) CheckPassword(password string) bool {
 	return auth.Check(password, u.Password)
 }
+
+// CreateToken, kullanıcı için yeni bir personal access token (API key)
+// oluşturur. Düz token (PlainTextToken) sadece bu dönüşte bilinir.
+//
+// Parametreler:
+//   - name: Token adı ("deploy-cli", "CI")
+//   - abilities: Yetkiler (boşsa tüm yetkiler: ["*"])
+//
+// Örnek:
+//
+//	newToken, err := user.CreateToken("deploy-cli", []string{"servers:deploy"})
+//	fmt.Println(newToken.PlainTextToken)
+func (u *User) CreateToken(name string, abilities []string) (*auth.NewAccessToken, error) {
+	return auth.CreatePersonalAccessToken(u.ID, name, abilities, nil)
+}
+
+// Tokens, kullanıcının personal access token'larını döndürür.
+func (u *User) Tokens() ([]auth.PersonalAccessToken, error) {
+	return auth.PersonalAccessTokens(u.ID)
+}
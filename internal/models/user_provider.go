@@ -0,0 +1,83 @@
+// -----------------------------------------------------------------------------
+// Database User Provider
+// -----------------------------------------------------------------------------
+// UserRepository, users tablosunu kullanan varsayılan auth.UserProvider
+// implementasyonudur (bkz: pkg/auth/user_provider.go).
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+)
+
+// UserRepository, auth.UserProvider ve auth.EmailUserProvider arayüzlerini
+// implement eder.
+var (
+	_ auth.UserProvider      = (*UserRepository)(nil)
+	_ auth.EmailUserProvider = (*UserRepository)(nil)
+)
+
+// RetrieveByID, ID ile kullanıcıyı döndürür (*User).
+func (r *UserRepository) RetrieveByID(id int64) (auth.User, error) {
+	user, err := r.FindByID(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// RetrieveByEmail, email ile kullanıcıyı döndürür (*User). Magic link ve
+// OIDC girişleri için auth.EmailUserProvider'ı implement eder.
+func (r *UserRepository) RetrieveByEmail(email string) (auth.User, error) {
+	user, err := r.FindByEmail(email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// RetrieveByCredentials, "email" ve "password" ile kullanıcıyı doğrular ve
+// döndürür (*User).
+//
+// Şifre hash'i eski bir cost ile üretilmişse başarılı login'de güncellenir.
+//
+// Örnek:
+//
+//	user, err := userRepo.RetrieveByCredentials(auth.Credentials{
+//	    "email":    "john@example.com",
+//	    "password": "Secret123!",
+//	})
+func (r *UserRepository) RetrieveByCredentials(credentials auth.Credentials) (auth.User, error) {
+	user, err := r.FindByEmail(credentials["email"])
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, auth.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	password := credentials["password"]
+	if !user.CheckPassword(password) {
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	// Güvenlik: Zaman içinde hash cost artırılabilir
+	if auth.NeedsRehash(user.Password) {
+		if newHash, _ := auth.Hash(password); newHash != "" {
+			user.Password = newHash
+			r.Update(user)
+		}
+	}
+
+	return user, nil
+}
@@ -0,0 +1,122 @@
+package providers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/crypt"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/resilience"
+	"github.com/biyonik/conduit-go/pkg/view"
+	"github.com/biyonik/conduit-go/resources/views"
+)
+
+// AppServiceProvider, uygulamanın temel servislerini (logger, veritabanı,
+// grammar, şifreleme) kaydeder ve global ayarları (resilience policy'leri,
+// doğrulama formatı, upload limiti, HTML view'lar) yapar.
+//
+// *config.Config provider'lardan önce container'a kaydedilmelidir.
+type AppServiceProvider struct{}
+
+// Register, temel servisleri kaydeder.
+func (AppServiceProvider) Register(c *container.Container) error {
+	// Logger servisi
+	container.Register(c, func(c *container.Container) (*log.Logger, error) {
+		return log.New(os.Stdout, "[Conduit-Go] ", log.Ldate|log.Ltime|log.Lshortfile), nil
+	})
+
+	// Veritabanı Bağlantısı
+	container.Register(c, func(c *container.Container) (*sql.DB, error) {
+		return database.Connect(container.GetConfig(c).DB.DSN)
+	})
+
+	// SQL Grammar
+	container.Register(c, func(c *container.Container) (database.Grammar, error) {
+		return database.NewMySQLGrammar(), nil
+	})
+
+	// APP_KEY ile şifreleme (cookie, cache, queue payload). APP_KEY yoksa
+	// çözümleme hata verir; şifreleme kullanan servisler başlamaz.
+	container.Register(c, func(c *container.Container) (*crypt.Encrypter, error) {
+		return crypt.NewFromAppKey(container.GetConfig(c).App.Key)
+	})
+	return nil
+}
+
+// Boot, config'e bağlı global ayarları yapar.
+func (AppServiceProvider) Boot(c *container.Container) error {
+	cfg := container.GetConfig(c)
+
+	// Outbound policy'leri (timeout/retry/circuit) config'den kaydet
+	for name, policy := range cfg.Policies {
+		resilience.Register(name, policy.Options())
+	}
+
+	// Hata yanıtı formatı (response.Error ve middleware'lerin 401/403/429'ları)
+	if err := response.SetErrorFormat(cfg.App.ErrorFormat); err != nil {
+		return err
+	}
+
+	// Doğrulama hata yanıtı formatı (controller'lar ve FormRequest'ler)
+	validationFormatter, err := response.ValidationFormatterFor(cfg.App.ValidationErrorFormat)
+	if err != nil {
+		return err
+	}
+	response.SetValidationFormatter(validationFormatter)
+
+	// ShouldBeEncrypted job payload'ları APP_KEY ile şifrelenir; APP_KEY
+	// yoksa bu job'lar kuyruğa eklenirken hata verir
+	if encrypter, err := container.Resolve[*crypt.Encrypter](c); err == nil {
+		queue.SetEncrypter(encrypter)
+	}
+
+	// Multipart upload'larda bellekte tutulacak boyut (r.File)
+	conduitReq.SetMultipartMaxMemory(int64(cfg.Server.UploadMaxMemoryMB) << 20)
+
+	// HTML view'lar (response.View): VIEW_PATH verilirse diskten, yoksa
+	// gömülü kopyadan. Sayfalar giriş yapmış kullanıcıya ve CSRF token'ına
+	// {{.auth_user}} / {{.csrf_token}} ile erişir.
+	var viewFiles fs.FS = views.FS
+	if cfg.View.Path != "" {
+		viewFiles = os.DirFS(cfg.View.Path)
+	}
+	view.SetEngine(view.New(viewFiles).
+		Share("app_name", cfg.App.Name).
+		BaseURL(cfg.App.URL).
+		Composer(func(req *http.Request) map[string]any {
+			shared := map[string]any{"csrf_token": middleware.CSRFTokenFromRequest(req)}
+			if user, err := conduitReq.New(req).AuthUser(); err == nil {
+				shared["auth_user"] = user
+			}
+			return shared
+		}).
+		Reload(cfg.View.Path != "" && cfg.App.Env == "development"))
+
+	return nil
+}
+
+// Terminate, veritabanı bağlantılarını kapatır.
+func (AppServiceProvider) Terminate(ctx context.Context, c *container.Container) error {
+	logger := container.GetLogger(c)
+
+	logger.Println("⏳ Database bağlantıları kapatılıyor...")
+	db, err := container.Resolve[*sql.DB](c)
+	if err != nil {
+		return nil
+	}
+	if err := db.Close(); err != nil {
+		return fmt.Errorf("database kapatılamadı: %w", err)
+	}
+	logger.Println("✅ Database bağlantıları kapatıldı")
+	return nil
+}
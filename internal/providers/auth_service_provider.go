@@ -0,0 +1,104 @@
+package providers
+
+import (
+	"fmt"
+
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+// AuthServiceProvider, JWT ayarlarını, kullanıcı kaynağını ve token
+// store'larını kaydeder; middleware.Auth() / AuthToken()'ın kullandığı
+// global auth ayarlarını yapar.
+type AuthServiceProvider struct{}
+
+// Register, auth servislerini kaydeder.
+func (AuthServiceProvider) Register(c *container.Container) error {
+	// JWT ayarları (JWT_SECRET, JWT_TTL, JWT_REFRESH_TTL, JWT_ISSUER, JWT_AUDIENCE).
+	// Production'da zayıf veya eksik secret ile uygulama başlamaz.
+	container.Register(c, func(c *container.Container) (*auth.JWTConfig, error) {
+		cfg := container.GetConfig(c)
+
+		jwtConfig := cfg.JWT.Auth()
+		if err := jwtConfig.Validate(); err != nil {
+			if cfg.IsProduction() {
+				return nil, fmt.Errorf("JWT config geçersiz: %w", err)
+			}
+			container.GetLogger(c).Printf("⚠️  JWT config production için uygun değil: %v", err)
+		}
+		return jwtConfig, nil
+	})
+
+	// Kullanıcı kaynağı: users tablosu. LDAP / harici API ile çalışmak için
+	// burada kendi auth.UserProvider implementasyonunuzu döndürün.
+	container.Register(c, func(c *container.Container) (auth.UserProvider, error) {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+		return models.NewUserRepository(db, grammar), nil
+	})
+
+	// Refresh token kayıtları (refresh_tokens tablosu, rotation + reuse detection)
+	container.Register(c, func(c *container.Container) (auth.RefreshTokenStore, error) {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+
+		store := auth.NewDatabaseRefreshTokenStore(db, grammar)
+		if err := store.CreateTable(); err != nil {
+			container.GetLogger(c).Printf("⚠️  %v", err)
+		}
+		return store, nil
+	})
+
+	// Magic link'ler (magic_login_tokens tablosu, şifresiz giriş)
+	container.Register(c, func(c *container.Container) (auth.MagicLinkStore, error) {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+
+		store := auth.NewDatabaseMagicLinkStore(db, grammar)
+		if err := store.CreateTable(); err != nil {
+			container.GetLogger(c).Printf("⚠️  %v", err)
+		}
+		return store, nil
+	})
+
+	// Personal access token'lar (personal_access_tokens tablosu, CLI/API key'leri)
+	container.Register(c, func(c *container.Container) (auth.PersonalAccessTokenStore, error) {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+
+		store := auth.NewDatabasePersonalAccessTokenStore(db, grammar)
+		if err := store.CreateTable(); err != nil {
+			container.GetLogger(c).Printf("⚠️  %v", err)
+		}
+		return store, nil
+	})
+	return nil
+}
+
+// Boot, auth paketinin global ayarlarını container'daki servislere bağlar.
+func (AuthServiceProvider) Boot(c *container.Container) error {
+	// middleware.Auth() ve config verilmeyen tüm JWT işlemleri bu ayarları kullanır
+	jwtConfig, err := container.Resolve[*auth.JWTConfig](c)
+	if err != nil {
+		return err
+	}
+	auth.SetDefaultJWTConfig(jwtConfig)
+
+	// Logout / refresh rotation ile iptal edilen JWT'ler (jti denylist)
+	auth.SetTokenDenylist(container.GetCache(c))
+
+	// middleware.Auth() / AuthToken() kullanıcıyı bu provider'dan yükler
+	userProvider, err := container.Resolve[auth.UserProvider](c)
+	if err != nil {
+		return err
+	}
+	auth.SetUserProvider(userProvider)
+
+	// Impersonation başlat/bitir ve impersonation token'ı ile yapılan istekler
+	auth.SetImpersonationAuditor(auth.LogImpersonationAuditor(container.GetLogger(c)))
+
+	// middleware.AuthToken() ve user.CreateToken() bu store'u kullanır
+	tokens, err := container.Resolve[auth.PersonalAccessTokenStore](c)
+	if err != nil {
+		return err
+	}
+	auth.SetPersonalAccessTokenStore(tokens)
+	return nil
+}
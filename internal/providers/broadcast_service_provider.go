@@ -0,0 +1,120 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/broadcast"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/events"
+	"github.com/biyonik/conduit-go/pkg/ws"
+)
+
+// BroadcastServiceProvider, SSE/WebSocket hub'ını, WebSocket sunucusunu ve
+// BROADCAST_DRIVER'a göre broadcaster'ı kaydeder. Redis driver'ında diğer
+// instance'ların yayınlarını bu instance'ın hub'ına aktaran listener'ı
+// başlatır.
+type BroadcastServiceProvider struct {
+	stop context.CancelFunc
+}
+
+// Register, broadcast servislerini kaydeder.
+func (p *BroadcastServiceProvider) Register(c *container.Container) error {
+	// Broadcast hub - SSE ve WebSocket client'larının kanal abonelikleri
+	// ve private kanal yetkilendirmesi
+	container.Register(c, func(c *container.Container) (*broadcast.Hub, error) {
+		hub := broadcast.NewHub(container.GetLogger(c)).SetBuffer(container.GetConfig(c).Broadcast.Buffer)
+
+		// Kullanıcıya özel kanal: sadece kanal sahibi abone olabilir
+		hub.Channel("private-users.{id}", func(user auth.User, params map[string]string) bool {
+			return strconv.FormatInt(user.GetID(), 10) == params["id"]
+		})
+		return hub, nil
+	})
+
+	// WebSocket server - Hub kanallarını WebSocket üzerinden sunar; token
+	// bağlantı içinde {"action": "auth"} ile de gönderilebilir
+	container.Register(c, func(c *container.Container) (*ws.Server, error) {
+		cfg := container.GetConfig(c)
+
+		server := ws.NewServer(container.MustResolve[*broadcast.Hub](c), container.GetLogger(c))
+		server.PingInterval = cfg.Broadcast.Heartbeat
+		server.CheckOrigin = ws.AllowOrigins(cfg.Security.CORSAllowedOrigins)
+		server.Authenticate = func(token string) (auth.User, error) {
+			return middleware.AuthenticateToken(token, nil)
+		}
+		return server, nil
+	})
+
+	// Broadcaster - ShouldBroadcast event'lerinin yayınlandığı driver
+	container.Register(c, func(c *container.Container) (events.Broadcaster, error) {
+		cfg := container.GetConfig(c)
+		logger := container.GetLogger(c)
+
+		switch cfg.Broadcast.Driver {
+		case "redis":
+			// Cache redis ise client paylaşılır; değilse oluşturulur
+			rc, err := sharedRedisClient(c, "broadcast")
+			if err != nil {
+				return nil, err
+			}
+			logger.Printf("✅ Redis broadcaster başlatıldı (prefix: %s)", cfg.Broadcast.RedisPrefix)
+			return broadcast.NewRedisBroadcaster(rc.Client(), cfg.Broadcast.RedisPrefix, logger), nil
+
+		case "local":
+			logger.Println("✅ Local broadcaster başlatıldı (tek instance)")
+			return container.MustResolve[*broadcast.Hub](c), nil
+
+		case "log":
+			logger.Println("✅ Log broadcaster başlatıldı")
+			return broadcast.NewLogBroadcaster(logger), nil
+
+		case "null":
+			return broadcast.NullBroadcaster{}, nil
+
+		default:
+			return nil, fmt.Errorf("geçersiz broadcast driver: %s", cfg.Broadcast.Driver)
+		}
+	})
+	return nil
+}
+
+// Boot, ShouldBroadcast event'lerini broadcaster'a bağlar.
+func (p *BroadcastServiceProvider) Boot(c *container.Container) error {
+	dispatcher, err := container.Resolve[*events.Dispatcher](c)
+	if err != nil {
+		return err
+	}
+	broadcaster, err := container.Resolve[events.Broadcaster](c)
+	if err != nil {
+		return err
+	}
+	dispatcher.SetBroadcaster(broadcaster)
+
+	// Redis driver'ında diğer instance'ların yayınları da bu instance'ın
+	// hub'ına aktarılır
+	if redisBroadcaster, ok := broadcaster.(*broadcast.RedisBroadcaster); ok {
+		hub := container.MustResolve[*broadcast.Hub](c)
+		logger := container.GetLogger(c)
+
+		ctx, stop := context.WithCancel(context.Background())
+		p.stop = stop
+		go func() {
+			if err := redisBroadcaster.Listen(ctx, hub); err != nil {
+				logger.Printf("❌ %v", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// Terminate, Redis broadcast listener'ını durdurur.
+func (p *BroadcastServiceProvider) Terminate(ctx context.Context, c *container.Container) error {
+	if p.stop != nil {
+		p.stop()
+	}
+	return nil
+}
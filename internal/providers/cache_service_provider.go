@@ -0,0 +1,216 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/version"
+	"github.com/redis/go-redis/v9"
+)
+
+// CacheServiceProvider, CACHE_DRIVER'a göre varsayılan cache'i ve
+// CACHE_STORES ile tanımlanan isimli store'ları kaydeder.
+type CacheServiceProvider struct{}
+
+// Register, cache.Cache ve *cache.Manager servislerini kaydeder.
+func (CacheServiceProvider) Register(c *container.Container) error {
+	// Cache servisi - driver'a göre oluştur
+	container.Register(c, func(c *container.Container) (cache.Cache, error) {
+		cfg := container.GetConfig(c)
+		logger := container.GetLogger(c)
+
+		// Redis/File driver'ları için değer serializer'ı (json, gob, msgpack)
+		serializer, err := cache.SerializerByName(cfg.Cache.Serializer)
+		if err != nil {
+			return nil, err
+		}
+
+		switch cfg.Cache.Driver {
+		case "redis":
+			// Redis Cache
+			logger.Println("🔄 Redis cache başlatılıyor...")
+
+			redisClient, err := newRedisClient(cfg, logger)
+			if err != nil {
+				logger.Printf("⚠️  Redis bağlantısı başarısız, file cache'e geçiliyor: %v", err)
+				// Fallback to file cache
+				fallback, err := cache.NewFileCache(cfg.Cache.FileDir, logger)
+				if err != nil {
+					return nil, err
+				}
+				fallback.SetSerializer(serializer)
+				return fallback, nil
+			}
+
+			// Redis client'ı container'a kaydet (shutdown için gerekli)
+			container.Register(c, func(c *container.Container) (*database.RedisClient, error) {
+				return redisClient, nil
+			})
+
+			redisCache := cache.NewRedisCache(redisClient.Client(), logger, cfg.Cache.Prefix)
+			redisCache.SetSerializer(serializer)
+
+			logger.Printf("✅ Redis cache başlatıldı (prefix: %s, serializer: %s)", cfg.Cache.Prefix, serializer.Name())
+			return redisCache, nil
+
+		case "file":
+			// File Cache
+			logger.Println("🔄 File cache başlatılıyor...")
+			fileCache, err := cache.NewFileCache(cfg.Cache.FileDir, logger)
+			if err != nil {
+				return nil, fmt.Errorf("file cache oluşturulamadı: %w", err)
+			}
+			fileCache.SetSerializer(serializer)
+			logger.Printf("✅ File cache başlatıldı (dir: %s, serializer: %s)", cfg.Cache.FileDir, serializer.Name())
+			return fileCache, nil
+
+		case "memory":
+			// Memory Cache
+			logger.Println("🔄 Memory cache başlatılıyor...")
+			if cfg.IsProduction() {
+				logger.Println("⚠️  UYARI: Memory cache production ortamı için önerilmez!")
+			}
+			memoryCache := cache.NewMemoryCache(logger)
+			memoryCache.SetLimits(cache.MemoryLimits{
+				MaxEntries: cfg.Cache.MemoryMaxEntries,
+				MaxBytes:   int64(cfg.Cache.MemoryMaxMB) << 20,
+			})
+			logger.Printf("✅ Memory cache başlatıldı (max entries: %d, max MB: %d)", cfg.Cache.MemoryMaxEntries, cfg.Cache.MemoryMaxMB)
+			return memoryCache, nil
+
+		default:
+			return nil, fmt.Errorf("geçersiz cache driver: %s", cfg.Cache.Driver)
+		}
+	})
+
+	// İsimlendirilmiş cache store'ları (CACHE_STORES) - cache.Store("sessions")
+	container.Register(c, func(c *container.Container) (*cache.Manager, error) {
+		cfg := container.GetConfig(c)
+		logger := container.GetLogger(c)
+
+		manager := cache.NewManager(container.GetCache(c))
+
+		for name, store := range cfg.CacheStores {
+			var client *redis.Client
+			if store.Driver == "redis" {
+				// Varsayılan cache redis ise client paylaşılır; değilse oluşturulur
+				rc, err := sharedRedisClient(c, fmt.Sprintf("cache store '%s'", name))
+				if err != nil {
+					return nil, err
+				}
+				client = rc.Client()
+			}
+
+			s, err := cache.NewStore(cache.StoreConfig{
+				Driver:     store.Driver,
+				Prefix:     store.Prefix,
+				FileDir:    store.FileDir,
+				Serializer: cfg.Cache.Serializer,
+				TTL:        store.TTL,
+				Limits: cache.MemoryLimits{
+					MaxEntries: cfg.Cache.MemoryMaxEntries,
+					MaxBytes:   int64(cfg.Cache.MemoryMaxMB) << 20,
+				},
+			}, client, logger)
+			if err != nil {
+				return nil, fmt.Errorf("cache store '%s' oluşturulamadı: %w", name, err)
+			}
+
+			manager.Register(name, s)
+			logger.Printf("✅ Cache store '%s' başlatıldı (driver: %s, ttl: %s)", name, store.Driver, store.TTL)
+		}
+
+		return manager, nil
+	})
+	return nil
+}
+
+// Boot, isimli store'ları ve unique job lock'larını cache'e bağlar.
+func (CacheServiceProvider) Boot(c *container.Container) error {
+	cacheDriver, err := container.Resolve[cache.Cache](c)
+	if err != nil {
+		return err
+	}
+
+	// cache.Store("<isim>") ile named store'lara erişim
+	manager, err := container.Resolve[*cache.Manager](c)
+	if err != nil {
+		return err
+	}
+	cache.SetManager(manager)
+
+	// Unique job lock'ları (ShouldBeUnique) uygulama cache'inde tutulur
+	queue.SetUniqueLockStore(cacheDriver)
+
+	if container.GetConfig(c).IsDevelopment() {
+		cacheDemo(c, cacheDriver)
+	}
+	return nil
+}
+
+// Terminate, cache, store'lar veya broadcaster için açılmış Redis
+// bağlantısını kapatır.
+func (CacheServiceProvider) Terminate(ctx context.Context, c *container.Container) error {
+	rc, err := container.Resolve[*database.RedisClient](c)
+	if err != nil {
+		return nil
+	}
+
+	logger := container.GetLogger(c)
+	logger.Println("⏳ Redis bağlantısı kapatılıyor...")
+	if err := rc.Close(); err != nil {
+		return fmt.Errorf("redis kapatılamadı: %w", err)
+	}
+	logger.Println("✅ Redis bağlantısı kapatıldı")
+	return nil
+}
+
+// cacheDemo, development'ta cache'in çalıştığını loglarla gösterir.
+func cacheDemo(c *container.Container, cacheDriver cache.Cache) {
+	logger := container.GetLogger(c)
+	logger.Println("\n📝 Cache System Demo:")
+
+	// Set example
+	err := cacheDriver.Set("app:version", version.Version, 10*time.Minute)
+	if err != nil {
+		logger.Printf("⚠️  Cache set hatası: %v", err)
+	} else {
+		logger.Printf("✅ Cache set: app:version = %s", version.Version)
+	}
+
+	// Get example
+	cached, err := cacheDriver.Get("app:version")
+	if err != nil {
+		logger.Printf("⚠️  Cache get hatası: %v", err)
+	} else if cached != nil {
+		logger.Printf("✅ Cache get: app:version = %v", cached)
+	}
+
+	// Remember pattern example
+	startTime := time.Now()
+	data, err := cacheDriver.Remember("demo:expensive", 5*time.Minute, func() (interface{}, error) {
+		logger.Println("   🔄 Expensive operation simulating...")
+		time.Sleep(100 * time.Millisecond)
+		return map[string]string{"result": "computed"}, nil
+	})
+	elapsed := time.Since(startTime)
+	if err != nil {
+		logger.Printf("⚠️  Remember hatası: %v", err)
+	} else {
+		logger.Printf("✅ Remember: %v (took: %v)", data, elapsed)
+	}
+
+	// Second call (should be cached)
+	startTime = time.Now()
+	data2, _ := cacheDriver.Remember("demo:expensive", 5*time.Minute, func() (interface{}, error) {
+		logger.Println("   ❌ Bu mesaj görünmemeli!")
+		return nil, nil
+	})
+	elapsed2 := time.Since(startTime)
+	logger.Printf("✅ Remember (cached): %v (took: %v)\n", data2, elapsed2)
+}
@@ -0,0 +1,82 @@
+package providers
+
+import (
+	"context"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/listeners"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// DispatcherServiceProvider, event dispatcher'ı, event store'u ve
+// listener'ları kaydeder; boot sırasında EventServiceProvider eşlemesini
+// ve container'daki subscriber'ları dispatcher'a bağlar.
+type DispatcherServiceProvider struct{}
+
+// Register, event servislerini ve listener'ları kaydeder.
+func (DispatcherServiceProvider) Register(c *container.Container) error {
+	// Event dispatcher - events.Subscriber servisleri otomatik eklenir
+	container.Register(c, func(c *container.Container) (*events.Dispatcher, error) {
+		return events.NewDispatcher(container.GetLogger(c)), nil
+	})
+
+	// Event store - dispatch edilen event'lerin kalıcı kaydı (EVENT_STORE_ENABLED)
+	container.Register(c, func(c *container.Container) (events.Store, error) {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+
+		store := database.NewEventStore(db, grammar)
+		if err := store.CreateTable(); err != nil {
+			return nil, err
+		}
+		return store, nil
+	})
+
+	// Event listener'ları - EventServiceProvider eşlemesinden çözülür
+	c.Register(listeners.NewLogAuthActivity)
+	return nil
+}
+
+// Boot, listener'ları ve event store'u dispatcher'a bağlar.
+func (DispatcherServiceProvider) Boot(c *container.Container) error {
+	cfg := container.GetConfig(c)
+	dispatcher, err := container.Resolve[*events.Dispatcher](c)
+	if err != nil {
+		return err
+	}
+
+	// Container'a kayıtlı event subscriber'larını dispatcher'a ekle
+	if err := container.RegisterSubscribers(c, dispatcher); err != nil {
+		return err
+	}
+
+	// Event → listener eşlemesi; `conduit event:list`
+	if err := container.RegisterEventProvider(c, dispatcher, EventServiceProvider()); err != nil {
+		return err
+	}
+
+	// QueryBuilder yazmaları model.created/updated/deleted event'leri yayınlar
+	database.SetEventDispatcher(dispatcher)
+
+	// Event store açıksa dispatch edilen event'ler kaydedilir
+	// (EVENT_STORE_EVENTS boş: tüm event'ler)
+	if cfg.EventStore.Enabled {
+		store, err := container.Resolve[events.Store](c)
+		if err != nil {
+			return err
+		}
+		dispatcher.SetStore(store, cfg.EventStore.Events...)
+		container.GetLogger(c).Printf("✅ Event store aktif (%s)", database.EventStoreTable)
+	}
+	return nil
+}
+
+// Terminate, bekleyen async event'lerin bitmesini bekler.
+func (DispatcherServiceProvider) Terminate(ctx context.Context, c *container.Container) error {
+	dispatcher, err := container.Resolve[*events.Dispatcher](c)
+	if err != nil {
+		return nil
+	}
+	return dispatcher.ShutdownWithTimeout(5 * time.Second)
+}
@@ -0,0 +1,27 @@
+package providers
+
+import (
+	"github.com/biyonik/conduit-go/internal/listeners"
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// EventServiceProvider, uygulamanın event → listener eşlemesini döndürür.
+//
+// Nil pointer listener'lar boot sırasında container'dan çözülür; bu yüzden
+// listener'ın fabrika fonksiyonu container'a kayıtlı olmalıdır. Eşleme
+// `conduit event:list` ile görüntülenir.
+func EventServiceProvider() *events.EventServiceProvider {
+	return &events.EventServiceProvider{
+		Listen: map[string][]events.Listener{
+			events.EventUserRegistered: {
+				(*listeners.LogAuthActivity)(nil),
+			},
+			events.EventUserLoggedIn: {
+				(*listeners.LogAuthActivity)(nil),
+			},
+			events.EventUserLoggedOut: {
+				(*listeners.LogAuthActivity)(nil),
+			},
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/resources/views"
+)
+
+// MailServiceProvider, MAIL_DRIVER'a göre mailer'ı kaydeder ve email
+// şablonlarını yükler.
+type MailServiceProvider struct{}
+
+// Register, mail.Mailer servisini kaydeder.
+func (MailServiceProvider) Register(c *container.Container) error {
+	// Mail servisi - driver'a göre oluştur
+	container.Register(c, func(c *container.Container) (mail.Mailer, error) {
+		cfg := container.GetConfig(c)
+		logger := container.GetLogger(c)
+
+		// mailer.Queue() / SendAsync() job'ları bu kuyruğa ekler
+		q := container.MustResolve[queue.Queue](c)
+
+		switch cfg.Mail.Driver {
+		case "log":
+			logger.Println("✅ Log mailer başlatıldı (email'ler sadece loglanır)")
+			mailer := mail.NewLogMailer(logger).SetFrom(cfg.Mail.FromAddress, cfg.Mail.FromName)
+			mailer.SetQueue(q, cfg.Mail.Queue)
+			if cfg.IsDevelopment() {
+				// /dev/mail önizlemesi için son mesajları bellekte tut
+				mailer.Capture(mail.DefaultCaptureLimit)
+			}
+			return mailer, nil
+
+		case "array":
+			logger.Println("✅ Array mailer başlatıldı (email'ler bellekte tutulur, gönderilmez)")
+			return mail.NewArrayMailer().SetFrom(cfg.Mail.FromAddress, cfg.Mail.FromName), nil
+
+		case "smtp":
+			encryption := cfg.Mail.Encryption
+			if encryption == "" {
+				encryption = "auto"
+			}
+			logger.Printf("✅ SMTP mailer başlatıldı (%s:%d, encryption: %s)", cfg.Mail.Host, cfg.Mail.Port, encryption)
+			mailer := mail.NewSMTPMailer(cfg.Mail.SMTP(), logger)
+			mailer.SetQueue(q, cfg.Mail.Queue)
+			return mailer, nil
+
+		case "ses":
+			logger.Printf("✅ SES mailer başlatıldı (region: %s)", cfg.Mail.SES.Region)
+			mailer := mail.NewSESMailer(cfg.Mail.SESConfig(), logger)
+			mailer.SetQueue(q, cfg.Mail.Queue)
+			return mailer, nil
+
+		case "mailgun":
+			logger.Printf("✅ Mailgun mailer başlatıldı (domain: %s)", cfg.Mail.Mailgun.Domain)
+			mailer := mail.NewMailgunMailer(cfg.Mail.MailgunConfig(), logger)
+			mailer.SetQueue(q, cfg.Mail.Queue)
+			return mailer, nil
+
+		case "postmark":
+			logger.Println("✅ Postmark mailer başlatıldı")
+			mailer := mail.NewPostmarkMailer(cfg.Mail.PostmarkConfig(), logger)
+			mailer.SetQueue(q, cfg.Mail.Queue)
+			return mailer, nil
+
+		default:
+			return nil, fmt.Errorf("geçersiz mail driver: %s", cfg.Mail.Driver)
+		}
+	})
+	return nil
+}
+
+// Boot, email şablonlarını yükler.
+func (MailServiceProvider) Boot(c *container.Container) error {
+	cfg := container.GetConfig(c)
+
+	// Email şablonları: MAIL_TEMPLATE_PATH verilirse diskten (development'ta
+	// her render'da yeniden okunur), yoksa binary'ye gömülü kopyadan
+	var mailTemplates fs.FS = views.FS
+	if cfg.Mail.TemplatePath != "" {
+		mailTemplates = os.DirFS(cfg.Mail.TemplatePath)
+	}
+	mail.SetTemplates(mail.NewTemplates(mailTemplates).
+		Share("app_name", cfg.App.Name).
+		Share("app_url", cfg.App.URL).
+		Reload(cfg.Mail.TemplatePath != "" && cfg.App.Env == "development"))
+	return nil
+}
+
+// Terminate, SMTP havuzundaki bağlantıları kapatır.
+func (MailServiceProvider) Terminate(ctx context.Context, c *container.Container) error {
+	if container.GetConfig(c).Mail.Driver != "smtp" {
+		return nil
+	}
+
+	mailer, err := container.Resolve[mail.Mailer](c)
+	if err != nil {
+		return nil
+	}
+	if smtpMailer, ok := mailer.(*mail.SMTPMailer); ok {
+		if err := smtpMailer.Close(); err != nil {
+			return fmt.Errorf("SMTP bağlantıları kapatılamadı: %w", err)
+		}
+		container.GetLogger(c).Println("✅ SMTP bağlantıları kapatıldı")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package providers
+
+import (
+	"fmt"
+
+	"github.com/biyonik/conduit-go/internal/jobs"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/biyonik/conduit-go/pkg/queue"
+)
+
+// QueueServiceProvider, QUEUE_DRIVER'a göre kuyruğu ve failed job
+// kayıtlarını kaydeder; container'dan dependency alan job tiplerini
+// tanımlar.
+type QueueServiceProvider struct{}
+
+// Register, queue servislerini kaydeder.
+func (QueueServiceProvider) Register(c *container.Container) error {
+	// Failed job kayıtları (failed_jobs tablosu, queue:failed / queue:retry)
+	container.Register(c, func(c *container.Container) (queue.FailedJobProvider, error) {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+
+		provider := queue.NewDatabaseFailedJobProvider(db, grammar)
+		if err := provider.CreateTable(); err != nil {
+			container.GetLogger(c).Printf("⚠️  %v", err)
+		}
+		return provider, nil
+	})
+
+	container.Register(c, func(c *container.Container) (queue.Queue, error) {
+		cfg := container.GetConfig(c)
+		logger := container.GetLogger(c)
+
+		failed := container.MustResolve[queue.FailedJobProvider](c)
+
+		switch cfg.Queue.Driver {
+		case "redis":
+			logger.Println("🔄 Redis queue başlatılıyor...")
+
+			// Redis client'ı al
+			rc, err := container.Resolve[*database.RedisClient](c)
+			if err != nil {
+				logger.Printf("⚠️  Redis bağlantısı yok, sync queue'e geçiliyor")
+				// Fallback to sync queue
+				return queue.NewSyncQueue(logger).SetFailedJobProvider(failed), nil
+			}
+
+			// Batch ilerlemesi ve queue metrikleri API ve worker arasında paylaşılır
+			queue.SetBatchStore(queue.NewRedisBatchStore(rc.Client(), cfg.Cache.Prefix))
+			queue.SetMetricsStore(queue.NewRedisMetricsStore(rc.Client(), cfg.Cache.Prefix))
+
+			logger.Printf("✅ Redis queue başlatıldı (prefix: %s)", cfg.Cache.Prefix)
+			return queue.NewRedisQueue(rc.Client(), logger, cfg.Cache.Prefix), nil
+
+		case "sqs":
+			logger.Printf("✅ SQS queue başlatıldı (prefix: %s)", cfg.SQS.Prefix)
+			return queue.NewSQSQueue(cfg.SQS.Queue(), logger), nil
+
+		case "nats":
+			logger.Printf("✅ NATS JetStream queue başlatıldı (stream: %s, group: %s)", cfg.NATS.Stream, cfg.NATS.ConsumerGroup)
+			return queue.NewNATSQueue(cfg.NATS.Queue(), logger), nil
+
+		case "sync":
+			logger.Println("✅ Sync queue başlatıldı (immediate execution)")
+			return queue.NewSyncQueue(logger).SetFailedJobProvider(failed), nil
+
+		default:
+			return nil, fmt.Errorf("geçersiz queue driver: %s", cfg.Queue.Driver)
+		}
+	})
+	return nil
+}
+
+// Boot, container'dan dependency gerektiren job tiplerini kaydeder.
+func (QueueServiceProvider) Boot(c *container.Container) error {
+	logger := container.GetLogger(c)
+	logger.Println("📋 Registering job types...")
+
+	// SendEmailJob, ProcessUploadJob vb. internal/jobs içindeki init
+	// fonksiyonlarıyla kendini kaydeder. Sadece container'dan dependency
+	// gerektiren job'lar burada DI'lı factory ile yeniden kaydedilir.
+	queue.RegisterType(func() *jobs.ImportUsersJob {
+		// Dependency'ler serialize edilmez, worker tarafında inject edilir
+		db, grammar := container.GetDatabaseAndGrammar(c)
+		return &jobs.ImportUsersJob{
+			Users:  models.NewUserRepository(db, grammar),
+			Cache:  container.GetCache(c),
+			Mailer: container.MustResolve[mail.Mailer](c),
+		}
+	})
+
+	queue.RegisterType(func() *mail.SendMessageJob {
+		return &mail.SendMessageJob{
+			Mailer: container.MustResolve[mail.Mailer](c),
+		}
+	})
+
+	logger.Printf("✅ %d job types registered", len(queue.JobRegistry.Types()))
+	return nil
+}
@@ -0,0 +1,45 @@
+package providers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+)
+
+// newRedisClient, REDIS_* ayarlarıyla yeni bir Redis client'ı oluşturur.
+func newRedisClient(cfg *config.Config, logger *log.Logger) (*database.RedisClient, error) {
+	return database.NewRedisClient(&database.RedisConfig{
+		Host:         cfg.Redis.Host,
+		Port:         cfg.Redis.Port,
+		Password:     cfg.Redis.Password,
+		DB:           cfg.Redis.DB,
+		PoolSize:     10,
+		MinIdleConns: 2,
+		MaxRetries:   3,
+		DialTimeout:  5 * time.Second,
+		ReadTimeout:  3 * time.Second,
+		WriteTimeout: 3 * time.Second,
+	}, logger)
+}
+
+// sharedRedisClient, container'daki Redis client'ını döndürür; yoksa
+// oluşturup kaydeder. Cache, cache store'ları ve broadcaster aynı
+// bağlantıyı paylaşır; shutdown'da CacheServiceProvider kapatır.
+func sharedRedisClient(c *container.Container, purpose string) (*database.RedisClient, error) {
+	if rc, err := container.Resolve[*database.RedisClient](c); err == nil {
+		return rc, nil
+	}
+
+	redisClient, err := newRedisClient(container.GetConfig(c), container.GetLogger(c))
+	if err != nil {
+		return nil, fmt.Errorf("%s için redis bağlantısı kurulamadı: %w", purpose, err)
+	}
+	container.Register(c, func(c *container.Container) (*database.RedisClient, error) {
+		return redisClient, nil
+	})
+	return redisClient, nil
+}
@@ -0,0 +1,237 @@
+package providers
+
+import (
+	"net/http"
+
+	"github.com/biyonik/conduit-go/internal/controllers"
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/internal/router"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/crypt"
+	"github.com/biyonik/conduit-go/pkg/ws"
+)
+
+// RouteServiceProvider, controller'ları ve router'ı kaydeder; boot
+// sırasında global middleware'leri ve uygulamanın route'larını tanımlar.
+// HTTP sunucusu router'ı container'dan alır (*router.Router).
+type RouteServiceProvider struct{}
+
+// Register, router'ı ve controller'ları kaydeder.
+func (RouteServiceProvider) Register(c *container.Container) error {
+	container.Register(c, func(c *container.Container) (*router.Router, error) {
+		return router.New(), nil
+	})
+
+	// Controller'lar
+	c.Register(controllers.NewAppController)
+	c.Register(controllers.NewAuthController)
+	c.Register(controllers.NewPasswordController)
+	c.Register(controllers.NewMagicLinkController)
+	c.Register(controllers.NewOIDCController)
+	c.Register(controllers.NewTokenController)
+	c.Register(controllers.NewImpersonationController)
+	c.Register(controllers.NewUserAdminController)
+	c.Register(controllers.NewDevMailController)
+	c.Register(controllers.NewBroadcastController)
+	c.Register(controllers.NewEventStoreController)
+	return nil
+}
+
+// Boot, middleware'leri ve route'ları tanımlar.
+func (RouteServiceProvider) Boot(c *container.Container) error {
+	cfg := container.GetConfig(c)
+	logger := container.GetLogger(c)
+	r := container.MustResolve[*router.Router](c)
+
+	appController := container.MustResolve[*controllers.AppController](c)
+	authController := container.MustResolve[*controllers.AuthController](c)
+	passwordController := container.MustResolve[*controllers.PasswordController](c)
+	magicLinkController := container.MustResolve[*controllers.MagicLinkController](c)
+	tokenController := container.MustResolve[*controllers.TokenController](c)
+	impersonationController := container.MustResolve[*controllers.ImpersonationController](c)
+	userAdminController := container.MustResolve[*controllers.UserAdminController](c)
+
+	// =========================================================================
+	// GLOBAL MIDDLEWARE'LER (Sıralama önemli!)
+	// =========================================================================
+
+	// Ortama duyarlı güvenlik varsayılanları (cookie, HSTS, CORS)
+	securityProfile := middleware.SecurityProfileFromConfig(cfg)
+	middleware.SetSecurityProfile(securityProfile)
+
+	// CORS policy'leri: public API (varsayılan) ve admin API (dashboard origin)
+	middleware.RegisterDefaultCORSPolicies(securityProfile)
+	r.CORS(middleware.CORSPolicyPublic)
+
+	r.Use(middleware.RequestID())           // 0. Request ID (log ve job izleme)
+	r.Use(middleware.PanicRecovery(logger)) // 1. Panic yakalama
+	r.Use(middleware.Logging)               // 2. Request logging
+	r.Use(middleware.SecurityHeaders())     // 3. HSTS & güvenlik header'ları
+	r.Use(middleware.RateLimit(100, 60))    // 4. Rate limiting: 100 req/min
+	if cfg.App.ExposeVersion {
+		r.Use(middleware.VersionHeader()) // 5. X-App-Version header'ı
+	}
+
+	// 6. Cookie şifreleme (APP_KEY); csrf_token JavaScript için şifrelenmez
+	if encrypter, err := container.Resolve[*crypt.Encrypter](c); err == nil {
+		r.Use(middleware.EncryptCookies(encrypter))
+	} else {
+		logger.Printf("⚠️  Cookie'ler şifrelenmiyor: %v", err)
+	}
+
+	// =========================================================================
+	// PUBLIC ROTALAR
+	// =========================================================================
+
+	// Genel endpoint'ler
+	r.GET("/", appController.HomeHandler)
+
+	// Health check endpoint - Cache status dahil
+	r.GET("/health", appController.HealthHandler)
+
+	// Mail önizlemesi - sadece development + log/array driver
+	if devMailEnabled(c) {
+		devMailController := container.MustResolve[*controllers.DevMailController](c)
+		r.GET("/dev/mail", devMailController.Index)
+		r.DELETE("/dev/mail", devMailController.Clear)
+		r.GET("/dev/mail/{id}/html", devMailController.HTML)
+		r.GET("/dev/mail/{id}/text", devMailController.Text)
+	}
+
+	// Event broadcasting (SSE); private kanallar için Authorization header'ı
+	broadcastController := container.MustResolve[*controllers.BroadcastController](c)
+	r.GET("/broadcasting/events", broadcastController.Events).
+		Middleware(middleware.OptionalAuth())
+
+	// Event broadcasting (WebSocket); aynı Hub ve kanal yetkileri
+	wsServer := container.MustResolve[*ws.Server](c)
+	r.GET("/broadcasting/ws", func(w http.ResponseWriter, req *conduitReq.Request) {
+		wsServer.ServeHTTP(w, req.Request)
+	}).Middleware(middleware.OptionalAuth())
+
+	// =========================================================================
+	// AUTH ROTALARI (PUBLIC - Authentication gerektirmez)
+	// =========================================================================
+	authGroup := r.Group("/api/auth")
+
+	// CSRF koruması ekle (POST/PUT/DELETE için)
+	authGroup.Use(middleware.CSRFProtection())
+
+	// Daha sıkı rate limit (brute force koruması)
+	authGroup.Use(middleware.RateLimit(10, 60)) // 10 req/min
+
+	// Authentication endpoint'leri
+	authGroup.POST("/register", authController.Register)
+	authGroup.POST("/login", authController.Login)
+	authGroup.POST("/refresh", authController.RefreshToken)
+
+	// Password reset endpoint'leri
+	authGroup.POST("/forgot-password", passwordController.ForgotPassword)
+	authGroup.POST("/reset-password", passwordController.ResetPassword)
+
+	// Magic link (şifresiz giriş); link isteği IP başına ayrıca sınırlanır
+	authGroup.POST("/magic-link", magicLinkController.Send).
+		Middleware(middleware.RateLimit(5, 300))
+	authGroup.POST("/magic-link/consume", magicLinkController.Consume)
+
+	// OpenID Connect (SSO); sadece OIDC_ISSUER tanımlıysa
+	if cfg.OIDC.Enabled() {
+		oidcController := container.MustResolve[*controllers.OIDCController](c)
+		authGroup.GET("/oidc/redirect", oidcController.Redirect)
+		authGroup.GET("/oidc/callback", oidcController.Callback)
+	}
+
+	// =========================================================================
+	// PROTECTED ROTALAR (Authentication gerekir)
+	// =========================================================================
+
+	// Authenticated user endpoint'leri
+	r.POST("/api/auth/logout", authController.Logout).
+		Middleware(middleware.Auth())
+
+	r.GET("/api/auth/profile", authController.Profile).
+		Middleware(middleware.Auth())
+
+	r.PUT("/api/auth/profile", authController.UpdateProfile).
+		Middleware(middleware.Auth()).
+		Middleware(middleware.CSRFProtection())
+
+	r.PATCH("/api/auth/profile", authController.UpdateProfile).
+		Middleware(middleware.Auth()).
+		Middleware(middleware.CSRFProtection())
+
+	r.PUT("/api/auth/password", authController.ChangePassword).
+		Middleware(middleware.Auth()).
+		Middleware(middleware.NotImpersonating()).
+		Middleware(middleware.CSRFProtection())
+
+	// Impersonation'ı bitir (impersonation token'ı ile çağrılır)
+	r.DELETE("/api/auth/impersonate", impersonationController.Stop).
+		Middleware(middleware.Auth()).
+		Middleware(middleware.CSRFProtection())
+
+	// Personal access token (API key) yönetimi
+	r.GET("/api/auth/tokens", tokenController.Index).
+		Middleware(middleware.Auth())
+
+	r.POST("/api/auth/tokens", tokenController.Store).
+		Middleware(middleware.Auth()).
+		Middleware(middleware.NotImpersonating()).
+		Middleware(middleware.CSRFProtection())
+
+	r.DELETE("/api/auth/tokens", tokenController.DestroyAll).
+		Middleware(middleware.Auth()).
+		Middleware(middleware.NotImpersonating()).
+		Middleware(middleware.CSRFProtection())
+
+	r.DELETE("/api/auth/tokens/{id}", tokenController.Destroy).
+		Middleware(middleware.Auth()).
+		Middleware(middleware.NotImpersonating()).
+		Middleware(middleware.CSRFProtection())
+
+	// =========================================================================
+	// API V1 ROUTES (Authenticated + Stricter Limits)
+	// =========================================================================
+	apiV1 := r.Group("/api/v1")
+	apiV1.Use(middleware.AuthToken())       // JWT veya personal access token
+	apiV1.Use(middleware.RateLimit(50, 60)) // API için daha sıkı limit: 50 req/min
+
+	// Test endpoint (authenticated)
+	apiV1.GET("/check", appController.CheckHandler)
+	apiV1.GET("/testquery", appController.TestQueryHandler)
+
+	// =========================================================================
+	// ADMIN ROTALARI (Sadece admin'ler erişebilir)
+	// =========================================================================
+	adminGroup := r.Group("/api/admin")
+	adminGroup.CORS(middleware.CORSPolicyAdmin)  // Sadece dashboard origin'i (credential'lı)
+	adminGroup.Use(middleware.Auth())            // Authentication gerekli
+	adminGroup.Use(middleware.Admin())           // Admin role gerekli
+	adminGroup.Use(middleware.RateLimit(30, 60)) // Admin için limit: 30 req/min
+
+	// Kullanıcı listesi ve toplu import/export
+	adminGroup.GET("/users", userAdminController.ListUsers)
+	adminGroup.GET("/users/export", userAdminController.ExportUsers)
+	adminGroup.POST("/users/import", userAdminController.ImportUsers)
+	adminGroup.GET("/users/import/{id}", userAdminController.ImportStatus)
+	adminGroup.POST("/users/{id}/impersonate", impersonationController.Start)
+
+	// Event store: kayıtlı event'ler ve replay
+	if cfg.EventStore.Enabled {
+		eventStoreController := container.MustResolve[*controllers.EventStoreController](c)
+		adminGroup.GET("/events", eventStoreController.Index)
+		adminGroup.POST("/events/replay", eventStoreController.Replay)
+	}
+
+	// Admin endpoint'leri (Phase 3'te eklenecek)
+	// adminGroup.DELETE("/users/{id}", adminController.DeleteUser)
+	return nil
+}
+
+// devMailEnabled, /dev/mail önizlemesinin açık olup olmadığını döndürür
+// (development + log/array mail driver).
+func devMailEnabled(c *container.Container) bool {
+	cfg := container.GetConfig(c)
+	return cfg.IsDevelopment() && (cfg.Mail.Driver == "log" || cfg.Mail.Driver == "array")
+}
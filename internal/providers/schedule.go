@@ -0,0 +1,52 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/scheduler"
+)
+
+// Schedule, uygulamanın zamanlanmış görevlerini tanımlar.
+//
+// Görevler `conduit schedule:run` (cron, her dakika) veya `conduit
+// schedule:work` ile çalışır ve `conduit schedule:list` ile listelenir.
+// Bağımlılıklar görev çalışırken container'dan çözülür; container'da config,
+// logger, veritabanı, grammar ve cache kayıtlıdır.
+func Schedule(s *scheduler.Scheduler, c *container.Container) {
+	s.Call("auth:prune-refresh-tokens", func(ctx context.Context) error {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+		removed, err := auth.NewDatabaseRefreshTokenStore(db, grammar).PruneExpired()
+		if err != nil {
+			return err
+		}
+		container.GetLogger(c).Printf("🧹 Pruned %d expired refresh tokens", removed)
+		return nil
+	}).Daily().WithoutOverlapping().Describe("Süresi dolmuş refresh token'ları siler")
+
+	s.Call("auth:prune-magic-links", func(ctx context.Context) error {
+		db, grammar := container.GetDatabaseAndGrammar(c)
+		removed, err := auth.NewDatabaseMagicLinkStore(db, grammar).PruneExpired()
+		if err != nil {
+			return err
+		}
+		container.GetLogger(c).Printf("🧹 Pruned %d expired magic links", removed)
+		return nil
+	}).Hourly().WithoutOverlapping().Describe("Süresi dolmuş magic link'leri siler")
+
+	s.Call("cache:prune", func(ctx context.Context) error {
+		// Redis key'leri kendiliğinden expire olur; sadece file/memory temizlenir
+		pruner, ok := container.GetCache(c).(cache.Pruner)
+		if !ok {
+			return nil
+		}
+		removed, err := pruner.Prune()
+		if err != nil {
+			return err
+		}
+		container.GetLogger(c).Printf("🧹 Pruned %d expired cache entries", removed)
+		return nil
+	}).HourlyAt(30).WithoutOverlapping().Describe("Süresi dolmuş cache girdilerini siler")
+}
@@ -0,0 +1,43 @@
+package providers
+
+import (
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/foundation"
+	"github.com/biyonik/conduit-go/pkg/scheduler"
+)
+
+// ScheduleServiceProvider, scheduler'ı kaydeder ve boot sırasında Schedule
+// ile görevleri tanımlar (`conduit schedule:run`, `schedule:work`).
+//
+// WithoutOverlapping lock'ları uygulamanın cache'inde tutulur; böylece cron'un
+// ayrı process'lerde başlattığı schedule:run'lar birbirini görür.
+type ScheduleServiceProvider struct {
+	foundation.BaseProvider
+}
+
+// Register, *scheduler.Scheduler servisini kaydeder.
+func (ScheduleServiceProvider) Register(c *container.Container) error {
+	container.Register(c, func(c *container.Container) (*scheduler.Scheduler, error) {
+		logger := container.GetLogger(c)
+
+		s := scheduler.New(logger)
+		if store, err := container.Resolve[cache.Cache](c); err == nil {
+			s.SetLockStore(store)
+		} else {
+			logger.Printf("⚠️  Cache unavailable, overlap locks are per process: %v", err)
+		}
+		return s, nil
+	})
+	return nil
+}
+
+// Boot, zamanlanmış görevleri tanımlar.
+func (ScheduleServiceProvider) Boot(c *container.Container) error {
+	s, err := container.Resolve[*scheduler.Scheduler](c)
+	if err != nil {
+		return err
+	}
+	Schedule(s, c)
+	return nil
+}
@@ -0,0 +1,53 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+)
+
+// Form, handler'ı çalıştırmadan önce form request'i oluşturan, yetkilendiren
+// ve doğrulayan bir HandlerFunc döndürür.
+//
+// Authorize false dönerse 403, body parse edilemezse 400, doğrulama
+// başarısızsa 422 döner; handler yalnızca geçerli veriyle çağrılır.
+//
+// Parametreler:
+//   - handler: Doldurulmuş form request'i alan handler
+//
+// Döndürür:
+//   - HandlerFunc: Router'a kaydedilebilecek handler
+//
+// Örnek:
+//
+//	func (pc *PostController) Store(w http.ResponseWriter, r *conduitReq.Request, form *requests.StorePostRequest) {
+//	    post := models.Post{Title: form.Title, Body: form.Body}
+//	    ...
+//	}
+//
+//	api.POST("/posts", router.Form(postController.Store))
+func Form[T any, F interface {
+	*T
+	conduitReq.FormRequest
+}](handler func(http.ResponseWriter, *conduitReq.Request, F)) HandlerFunc {
+	return func(w http.ResponseWriter, r *conduitReq.Request) {
+		form := F(new(T))
+
+		result, err := r.ValidateForm(form)
+		switch {
+		case errors.Is(err, conduitReq.ErrFormUnauthorized):
+			response.Forbidden(w, "")
+			return
+		case err != nil:
+			response.BadRequest(w, "Geçersiz istek gövdesi")
+			return
+		case result.HasErrors():
+			response.ValidationError(w, result.Errors())
+			return
+		}
+
+		handler(w, r, form)
+	}
+}
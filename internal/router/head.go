@@ -0,0 +1,106 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// headWriter, HEAD isteği için çalıştırılan GET handler'ının yanıtını
+// sarar. Gövde client'a gönderilmez ama byte sayısı tutulur; header'lar
+// handler bitene kadar bekletilir ve Content-Length GET yanıtıyla aynı
+// olacak şekilde ayarlanır.
+//
+// Handler Content-Length'i kendisi ayarladıysa (örn: http.ServeContent)
+// dokunulmaz. Flush çağrılırsa (SSE, Stream) header'lar o an
+// Content-Length'siz gönderilir; yanıtın boyutu önceden bilinemez.
+type headWriter struct {
+	http.ResponseWriter
+	status int
+	size   int64
+	sent   bool
+}
+
+// WriteHeader, status'u kaydeder; header'lar finish'te gönderilir.
+// 1xx ara yanıtları doğrudan iletilir.
+func (w *headWriter) WriteHeader(status int) {
+	if w.status != 0 || w.sent {
+		return
+	}
+	if status >= 100 && status < 200 && status != http.StatusSwitchingProtocols {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.status = status
+}
+
+// Write, gövdeyi atar ve sadece boyutunu sayar.
+func (w *headWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.size += int64(len(p))
+	return len(p), nil
+}
+
+// ReadFrom, io.Copy ile yazılan gövdeyi okuyup atar.
+func (w *headWriter) ReadFrom(r io.Reader) (int64, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := io.Copy(io.Discard, r)
+	w.size += n
+	return n, err
+}
+
+// FlushError, header'ları Content-Length'siz gönderip flush eder.
+func (w *headWriter) FlushError() error {
+	w.sendHeader(false)
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Flush, http.Flusher'ı implement eder.
+func (w *headWriter) Flush() {
+	w.FlushError()
+}
+
+// Unwrap, http.ResponseController'ın alttaki writer'a erişmesini sağlar.
+func (w *headWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// finish, handler bittiğinde bekletilen header'ları gönderir.
+func (w *headWriter) finish() {
+	w.sendHeader(true)
+}
+
+// sendHeader, header'ları bir kez gönderir. withLength true ise ve
+// handler ayarlamadıysa Content-Length sayılan boyutla eklenir.
+func (w *headWriter) sendHeader(withLength bool) {
+	if w.sent {
+		return
+	}
+	w.sent = true
+
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	header := w.Header()
+	if withLength && header.Get("Content-Length") == "" && bodyAllowedForStatus(w.status) {
+		header.Set("Content-Length", strconv.FormatInt(w.size, 10))
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// bodyAllowedForStatus, status'un gövde taşıyıp taşıyamayacağını döndürür
+// (RFC 9110: 1xx, 204 ve 304 gövdesizdir).
+func bodyAllowedForStatus(status int) bool {
+	switch {
+	case status >= 100 && status <= 199:
+		return false
+	case status == http.StatusNoContent, status == http.StatusNotModified:
+		return false
+	}
+	return true
+}
@@ -4,10 +4,14 @@ package router
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"reflect"
+	"runtime"
 	"strings"
 
 	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
 	"github.com/biyonik/conduit-go/internal/middleware"
 )
 
@@ -20,6 +24,7 @@ type Router struct {
 	routes      []*Route
 	middlewares []middleware.Middleware
 	groups      []*RouteGroup
+	corsPolicy  string // Varsayılan CORS policy (grup policy'si yoksa)
 }
 
 // Route, tek bir HTTP route'unu temsil eder.
@@ -29,6 +34,7 @@ type Route struct {
 	handler     HandlerFunc // Artık kendi type'ımız
 	middlewares []middleware.Middleware
 	router      *Router
+	corsPolicy  string // Grup'tan gelen CORS policy (boşsa router varsayılanı)
 }
 
 // RouteGroup, route gruplarını temsil eder.
@@ -36,6 +42,7 @@ type RouteGroup struct {
 	prefix      string
 	middlewares []middleware.Middleware
 	router      *Router
+	corsPolicy  string
 }
 
 // New, yeni bir Router instance'ı oluşturur.
@@ -52,6 +59,19 @@ func (r *Router) Use(middleware middleware.Middleware) {
 	r.middlewares = append(r.middlewares, middleware)
 }
 
+// CORS, router'ın varsayılan CORS policy'sini belirler.
+//
+// Kendi policy'si olmayan tüm route'lar (ve hiçbir route'a uymayan
+// preflight istekleri) bu policy ile yanıtlanır.
+//
+// Kullanım:
+//
+//	middleware.RegisterDefaultCORSPolicies(profile)
+//	r.CORS(middleware.CORSPolicyPublic)
+func (r *Router) CORS(policy string) {
+	r.corsPolicy = policy
+}
+
 // GET, GET metodu için route tanımlar ve Route objesi döndürür.
 func (r *Router) GET(path string, handler HandlerFunc) *Route {
 	return r.addRoute("GET", path, handler)
@@ -107,6 +127,42 @@ func (route *Route) Middleware(m middleware.Middleware) *Route {
 	return route
 }
 
+// RouteInfo, route tablosundaki bir route'un özetidir (route:list ve
+// route cache'i için).
+type RouteInfo struct {
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Handler    string `json:"handler"`     // Handler fonksiyonunun adı
+	Middleware int    `json:"middleware"`  // Route ve grup middleware'lerinin sayısı
+	CORSPolicy string `json:"cors_policy"` // Geçerli CORS policy'si
+}
+
+// Routes, tanımlı route'ları tanımlanma sırasıyla döndürür.
+func (r *Router) Routes() []RouteInfo {
+	routes := make([]RouteInfo, len(r.routes))
+	for i, route := range r.routes {
+		routes[i] = RouteInfo{
+			Method:     route.method,
+			Path:       route.path,
+			Handler:    handlerName(route.handler),
+			Middleware: len(route.middlewares),
+			CORSPolicy: r.routeCORSPolicy(route),
+		}
+	}
+	return routes
+}
+
+// handlerName, handler'ın paket yolu olmadan fonksiyon adını döndürür
+// (örn: controllers.(*AuthController).Login).
+func handlerName(handler HandlerFunc) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer())
+	if fn == nil {
+		return "?"
+	}
+	name := strings.TrimSuffix(fn.Name(), "-fm")
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
 // Group, route grubu oluşturur.
 //
 // Kullanım:
@@ -129,45 +185,53 @@ func (g *RouteGroup) Use(middleware middleware.Middleware) {
 	g.middlewares = append(g.middlewares, middleware)
 }
 
-// GET, grup içinde GET route tanımlar.
-func (g *RouteGroup) GET(path string, handler HandlerFunc) *Route {
-	fullPath := g.prefix + path
-	route := g.router.addRoute("GET", fullPath, handler)
+// CORS, grup için isimli bir CORS policy'si belirler.
+//
+// Use gibi, grup route'ları tanımlanmadan önce çağrılmalıdır. Policy,
+// grup middleware'lerinden önce çalışır; böylece preflight istekleri
+// Auth gibi middleware'lere takılmaz.
+//
+// Kullanım:
+//
+//	admin := r.Group("/api/admin")
+//	admin.CORS(middleware.CORSPolicyAdmin)
+//	admin.Use(middleware.Auth())
+func (g *RouteGroup) CORS(policy string) {
+	g.corsPolicy = policy
+}
+
+// addRoute, grup prefix'i, middleware'leri ve CORS policy'si ile route ekler.
+func (g *RouteGroup) addRoute(method, path string, handler HandlerFunc) *Route {
+	route := g.router.addRoute(method, g.prefix+path, handler)
 	// Grup middleware'lerini route'a ekle
-	route.middlewares = append(g.middlewares, route.middlewares...)
+	route.middlewares = append(append([]middleware.Middleware{}, g.middlewares...), route.middlewares...)
+	route.corsPolicy = g.corsPolicy
 	return route
 }
 
+// GET, grup içinde GET route tanımlar.
+func (g *RouteGroup) GET(path string, handler HandlerFunc) *Route {
+	return g.addRoute("GET", path, handler)
+}
+
 // POST, grup içinde POST route tanımlar.
 func (g *RouteGroup) POST(path string, handler HandlerFunc) *Route {
-	fullPath := g.prefix + path
-	route := g.router.addRoute("POST", fullPath, handler)
-	route.middlewares = append(g.middlewares, route.middlewares...)
-	return route
+	return g.addRoute("POST", path, handler)
 }
 
 // PUT, grup içinde PUT route tanımlar.
 func (g *RouteGroup) PUT(path string, handler HandlerFunc) *Route {
-	fullPath := g.prefix + path
-	route := g.router.addRoute("PUT", fullPath, handler)
-	route.middlewares = append(g.middlewares, route.middlewares...)
-	return route
+	return g.addRoute("PUT", path, handler)
 }
 
 // DELETE, grup içinde DELETE route tanımlar.
 func (g *RouteGroup) DELETE(path string, handler HandlerFunc) *Route {
-	fullPath := g.prefix + path
-	route := g.router.addRoute("DELETE", fullPath, handler)
-	route.middlewares = append(g.middlewares, route.middlewares...)
-	return route
+	return g.addRoute("DELETE", path, handler)
 }
 
 // PATCH, grup içinde PATCH route tanımlar.
 func (g *RouteGroup) PATCH(path string, handler HandlerFunc) *Route {
-	fullPath := g.prefix + path
-	route := g.router.addRoute("PATCH", fullPath, handler)
-	route.middlewares = append(g.middlewares, route.middlewares...)
-	return route
+	return g.addRoute("PATCH", path, handler)
 }
 
 // ServeHTTP, http.Handler interface'ini implement eder.
@@ -182,23 +246,38 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		handler = r.middlewares[i](handler)
 	}
 
-	handler.ServeHTTP(w, req)
+	// Middleware'ler status/boyut ve hook'lar için aynı Writer'ı kullanır
+	// (bkz: response.WrapWriter). Dışarıdan zaten sarılmışsa Finish'i
+	// Writer'ı oluşturan çağırır.
+	rw, wrapped := w.(*response.Writer)
+	if !wrapped {
+		rw = response.NewWriter(w)
+	}
+
+	handler.ServeHTTP(rw, req)
+
+	if !wrapped {
+		rw.Finish()
+	}
 }
 
 // handleRequest, gelen isteği uygun route'a yönlendirir.
+//
+// HEAD istekleri için ayrı route tanımlanmadıysa aynı path'in GET route'u
+// çalıştırılır; gövde atılır ve Content-Length GET yanıtının boyutuyla
+// gönderilir (bkz: headWriter).
 func (r *Router) handleRequest(w http.ResponseWriter, req *http.Request) {
-	// Route'ları kontrol et
-	for _, route := range r.routes {
-		if route.method != req.Method {
-			continue
-		}
+	route, params := r.findRoute(req.Method, req.URL.Path)
 
-		// Route parametrelerini match et
-		params, matched := r.matchRoute(route.path, req.URL.Path)
-		if !matched {
-			continue
+	var head *headWriter
+	if route == nil && req.Method == http.MethodHead {
+		if route, params = r.findRoute(http.MethodGet, req.URL.Path); route != nil {
+			head = &headWriter{ResponseWriter: w}
+			w = head
 		}
+	}
 
+	if route != nil {
 		// Route parametrelerini context'e ekle
 		ctx := context.WithValue(req.Context(), conduitReq.RequestParamsKey, params)
 		req = req.WithContext(ctx)
@@ -216,12 +295,98 @@ func (r *Router) handleRequest(w http.ResponseWriter, req *http.Request) {
 			handler = route.middlewares[i](handler)
 		}
 
+		// CORS policy zincirin en dışında çalışır
+		if policy := r.routeCORSPolicy(route); policy != "" {
+			handler = middleware.CORSWithPolicy(policy)(handler)
+		}
+
 		handler.ServeHTTP(w, req)
+
+		// Panic'te çağrılmaz; Recovery 500'ü henüz gönderilmemiş header'larla yazabilir
+		if head != nil {
+			head.finish()
+		}
+		return
+	}
+
+	// Preflight: OPTIONS route'u tanımlı değilse, path'e uyan route'un
+	// CORS policy'si ile yanıtla
+	if req.Method == "OPTIONS" {
+		if policy := r.preflightCORSPolicy(req.URL.Path); policy != "" {
+			middleware.CORSWithPolicy(policy)(http.HandlerFunc(http.NotFound)).ServeHTTP(w, req)
+			return
+		}
+	}
+
+	// Path başka bir method ile tanımlıysa 405 Method Not Allowed
+	if allowed := r.allowedMethods(req.URL.Path); len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		response.ProblemError(w, req, http.StatusMethodNotAllowed,
+			fmt.Sprintf("%s metodu bu kaynak için desteklenmiyor", req.Method))
 		return
 	}
 
 	// 404 Not Found
-	http.NotFound(w, req)
+	response.ProblemError(w, req, http.StatusNotFound, "İstenen kaynak bulunamadı")
+}
+
+// findRoute, method ve path'e uyan ilk route'u ve parametrelerini
+// döndürür; uyan route yoksa nil döner.
+func (r *Router) findRoute(method, path string) (*Route, map[string]string) {
+	for _, route := range r.routes {
+		if route.method != method {
+			continue
+		}
+		if params, matched := r.matchRoute(route.path, path); matched {
+			return route, params
+		}
+	}
+	return nil, nil
+}
+
+// allowedMethods, path'e uyan route'ların method'larını tanımlanma
+// sırasıyla döndürür (Allow header'ı için). GET route'ları otomatik
+// HEAD desteği de verdiğinden GET'in ardından HEAD eklenir.
+func (r *Router) allowedMethods(path string) []string {
+	seen := make(map[string]bool)
+	var methods []string
+	add := func(method string) {
+		if !seen[method] {
+			seen[method] = true
+			methods = append(methods, method)
+		}
+	}
+	for _, route := range r.routes {
+		if seen[route.method] {
+			continue
+		}
+		if _, matched := r.matchRoute(route.path, path); matched {
+			add(route.method)
+			if route.method == http.MethodGet {
+				add(http.MethodHead)
+			}
+		}
+	}
+	return methods
+}
+
+// routeCORSPolicy, route için geçerli CORS policy'sini döndürür.
+func (r *Router) routeCORSPolicy(route *Route) string {
+	if route.corsPolicy != "" {
+		return route.corsPolicy
+	}
+	return r.corsPolicy
+}
+
+// preflightCORSPolicy, preflight isteğinin path'ine uyan ilk route'un
+// CORS policy'sini döndürür. Uyan route yoksa router varsayılanı kullanılır.
+func (r *Router) preflightCORSPolicy(path string) string {
+	for _, route := range r.routes {
+		if _, matched := r.matchRoute(route.path, path); matched {
+			return r.routeCORSPolicy(route)
+		}
+	}
+	return r.corsPolicy
 }
 
 // matchRoute, route pattern'i ile URL path'ini karşılaştırır.
@@ -0,0 +1,184 @@
+// -----------------------------------------------------------------------------
+// JWT Denylist (Token Revocation)
+// -----------------------------------------------------------------------------
+// JWT'ler stateless olduğu için süresi dolmadan geçersiz kılınamaz; bu dosya
+// logout ve refresh rotation için cache tabanlı bir denylist ekler.
+//
+// İki mekanizma vardır:
+// - RevokeToken: Tek bir token'ı jti (JWT ID) ile iptal eder. Kayıt token'ın
+//   kalan ömrü kadar tutulur, sonra cache'ten kendiliğinden düşer.
+// - RevokeAllForUser: Kullanıcının o ana kadar aldığı tüm token'ları iptal
+//   eder ("tüm cihazlardan çıkış", şifre değişikliği, hesap ele geçirme).
+//   iat değeri kayıttaki zamandan eski olan token'lar reddedilir.
+//
+// Cache Key'leri:
+// - auth:denylist:{jti}
+// - auth:revoked_user:{user_id}
+//
+// Birden fazla API instance'ı varsa paylaşılan bir cache (Redis)
+// kullanılmalıdır:
+//
+//	auth.SetTokenDenylist(cacheDriver)
+// -----------------------------------------------------------------------------
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/cache"
+)
+
+// ErrTokenRevoked, iptal edilmiş token kullanıldığında döner.
+var ErrTokenRevoked = errors.New("token iptal edilmiş")
+
+// Global denylist store
+var (
+	denylist   cache.Cache
+	denylistMu sync.RWMutex
+)
+
+// SetTokenDenylist, iptal edilen token'ların tutulacağı cache'i ayarlar.
+//
+// Ayarlanmazsa process içi bir memory cache kullanılır (sadece tek process
+// için doğru sonuç verir).
+func SetTokenDenylist(store cache.Cache) {
+	denylistMu.Lock()
+	defer denylistMu.Unlock()
+
+	denylist = store
+}
+
+// getTokenDenylist, aktif denylist cache'ini döndürür (gerekirse oluşturur).
+func getTokenDenylist() cache.Cache {
+	denylistMu.RLock()
+	store := denylist
+	denylistMu.RUnlock()
+
+	if store != nil {
+		return store
+	}
+
+	denylistMu.Lock()
+	defer denylistMu.Unlock()
+
+	if denylist == nil {
+		denylist = cache.NewMemoryCache(log.New(io.Discard, "", 0))
+	}
+	return denylist
+}
+
+// denylistKey, token'ın denylist key'ini döndürür.
+func denylistKey(jti string) string {
+	return "auth:denylist:" + jti
+}
+
+// revokedUserKey, kullanıcının "tümünü iptal et" kaydının key'ini döndürür.
+func revokedUserKey(userID int64) string {
+	return "auth:revoked_user:" + strconv.FormatInt(userID, 10)
+}
+
+// RevokeToken, token'ı süresi dolana kadar geçersiz kılar.
+//
+// Parametreler:
+//   - claims: ParseToken ile elde edilen claims (jti içermeli)
+//
+// Döndürür:
+//   - error: jti yoksa veya cache'e yazılamazsa
+//
+// Örnek:
+//
+//	claims := middleware.GetTokenClaims(r.Context())
+//	if err := auth.RevokeToken(claims); err != nil {
+//	    logger.Printf("token iptal edilemedi: %v", err)
+//	}
+func RevokeToken(claims *JWTClaims) error {
+	if claims == nil || claims.ID == "" {
+		return errors.New("token jti içermiyor, iptal edilemez")
+	}
+
+	// Süresi dolmuş token zaten reddedilir
+	ttl := time.Hour
+	if claims.ExpiresAt != nil {
+		ttl = time.Until(claims.ExpiresAt.Time)
+		if ttl <= 0 {
+			return nil
+		}
+	}
+
+	if err := getTokenDenylist().Set(denylistKey(claims.ID), true, ttl); err != nil {
+		return fmt.Errorf("token denylist'e eklenemedi: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllForUser, kullanıcının şu ana kadar aldığı tüm token'ları
+// (access ve refresh) geçersiz kılar.
+//
+// Parametreler:
+//   - userID: Kullanıcı ID'si
+//   - config: JWT config; kayıt en uzun token ömrü (RefreshExpiresIn) kadar
+//     tutulur (nil ise default kullanılır)
+//
+// Örnek:
+//
+//	// Şifre değiştiğinde tüm oturumları kapat
+//	auth.RevokeAllForUser(user.ID, jwtConfig)
+func RevokeAllForUser(userID int64, config *JWTConfig) error {
+	if config == nil {
+		config = DefaultJWTConfig()
+	}
+
+	ttl := config.RefreshExpiresIn
+	if config.ExpirationTime > ttl {
+		ttl = config.ExpirationTime
+	}
+
+	// iat saniye hassasiyetindedir; aynı saniyede verilen token'lar da
+	// iptal edilmiş sayılır (bkz: IsRevoked)
+	revokedAt := strconv.FormatInt(time.Now().Unix(), 10)
+	if err := getTokenDenylist().Set(revokedUserKey(userID), revokedAt, ttl); err != nil {
+		return fmt.Errorf("kullanıcı token'ları iptal edilemedi: %w", err)
+	}
+	return nil
+}
+
+// IsRevoked, token'ın RevokeToken veya RevokeAllForUser ile iptal edilip
+// edilmediğini kontrol eder.
+//
+// Döndürür:
+//   - bool: Token iptal edilmişse true
+//   - error: Cache okunamazsa (çağıran güvenli tarafta kalıp reddetmelidir)
+func IsRevoked(claims *JWTClaims) (bool, error) {
+	store := getTokenDenylist()
+
+	if claims.ID != "" {
+		revoked, err := store.Has(denylistKey(claims.ID))
+		if err != nil {
+			return false, fmt.Errorf("token denylist okunamadı: %w", err)
+		}
+		if revoked {
+			return true, nil
+		}
+	}
+
+	value, err := store.Get(revokedUserKey(claims.UserID))
+	if err != nil {
+		return false, fmt.Errorf("token denylist okunamadı: %w", err)
+	}
+	if value == nil || claims.IssuedAt == nil {
+		return value != nil, nil
+	}
+
+	revokedAt, err := strconv.ParseInt(fmt.Sprint(value), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("geçersiz revoke kaydı: %v", value)
+	}
+	return claims.IssuedAt.Unix() <= revokedAt, nil
+}
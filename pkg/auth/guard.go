@@ -66,13 +66,9 @@ func NewJWTGuard(config *JWTConfig) *JWTGuard {
 
 // Authenticate, JWT token'ı doğrular ve user bilgilerini döndürür.
 //
-// NOT: Bu implementasyon sadece token'ı parse eder. Gerçek bir user
-// nesnesi döndürmez çünkü database'e erişimi yok. Controller'da
-// claims.UserID ile database'den user çekilmelidir.
-//
-// İleride bu metod şu şekilde geliştirilecek:
-//
-//	user, err := jwtGuard.Authenticate(token, userRepository)
+// SetUserProvider ile bir provider ayarlanmışsa kullanıcı provider'dan
+// yüklenir; değilse token claims'inden basit bir AuthenticatedUser
+// oluşturulur (bkz: UserFromClaims).
 func (g *JWTGuard) Authenticate(tokenString string) (User, error) {
 	// Token'ı parse et
 	claims, err := ParseToken(tokenString, g.config)
@@ -80,12 +76,18 @@ func (g *JWTGuard) Authenticate(tokenString string) (User, error) {
 		return nil, errors.New("invalid or expired token")
 	}
 
-	// Claims'den basit bir user objesi oluştur
-	// (Gerçek implementasyonda database'den user çekilir)
-	user := &AuthenticatedUser{
-		ID:    claims.UserID,
-		Email: claims.Email,
-		Role:  claims.Role,
+	// İptal edilmiş token (logout, refresh rotation)
+	revoked, err := IsRevoked(claims)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, ErrTokenRevoked
+	}
+
+	user, err := UserFromClaims(claims)
+	if err != nil {
+		return nil, err
 	}
 
 	// Cache'e kaydet
@@ -116,9 +118,10 @@ func (g *JWTGuard) Logout() error {
 
 // AuthenticatedUser, guard'dan dönen basit user implementasyonudur.
 type AuthenticatedUser struct {
-	ID    int64
-	Email string
-	Role  string
+	ID             int64
+	Email          string
+	Role           string
+	ImpersonatorID int64 // Impersonation token'ı ise admin'in ID'si (0: normal oturum)
 }
 
 func (u *AuthenticatedUser) GetID() int64 {
@@ -0,0 +1,168 @@
+// -----------------------------------------------------------------------------
+// User Impersonation
+// -----------------------------------------------------------------------------
+// Admin'lerin destek amaçlı bir kullanıcının gözünden uygulamayı görebilmesi
+// için kısa ömürlü, kapsamı daraltılmış token'lar.
+//
+// Impersonation token'ı:
+// - Hedef kullanıcının ID/email/rolünü taşır, impersonator_id claim'i ile
+//   işaretlenir (middleware.IsImpersonating)
+// - Refresh token ile yenilenemez; en fazla ImpersonationTTL geçerlidir
+// - Hassas işlemlerde (şifre değişikliği, API key üretimi, tekrar
+//   impersonation) middleware.NotImpersonating ile reddedilir
+//
+// Audit:
+// Başlatma, bitirme ve impersonation token'ı ile yapılan her istek
+// ImpersonationAuditor'a bildirilir (varsayılan: standart log).
+//
+//	auth.SetImpersonationAuditor(auth.LogImpersonationAuditor(logger))
+// -----------------------------------------------------------------------------
+
+package auth
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ImpersonationTTL, impersonation token'larının en uzun geçerlilik süresi.
+const ImpersonationTTL = 30 * time.Minute
+
+// Impersonation audit aksiyonları
+const (
+	ImpersonationStarted = "started" // Admin impersonation başlattı
+	ImpersonationRequest = "request" // Impersonation token'ı ile istek yapıldı
+	ImpersonationStopped = "stopped" // Impersonation sonlandırıldı
+)
+
+// ImpersonationEvent, audit log'a yazılan impersonation olayıdır.
+type ImpersonationEvent struct {
+	Action         string    `json:"action"`
+	ImpersonatorID int64     `json:"impersonator_id"`
+	UserID         int64     `json:"user_id"`  // Impersonate edilen kullanıcı
+	TokenID        string    `json:"token_id"` // Impersonation token'ının jti'si
+	Method         string    `json:"method,omitempty"`
+	Path           string    `json:"path,omitempty"`
+	IPAddress      string    `json:"ip_address,omitempty"`
+	UserAgent      string    `json:"user_agent,omitempty"`
+	At             time.Time `json:"at"`
+}
+
+// ImpersonationAuditor, impersonation olaylarını kaydeden fonksiyondur.
+type ImpersonationAuditor func(event ImpersonationEvent)
+
+// Global impersonation auditor
+var (
+	impersonationAuditor   ImpersonationAuditor
+	impersonationAuditorMu sync.RWMutex
+)
+
+// SetImpersonationAuditor, impersonation olaylarının yazılacağı auditor'ı
+// ayarlar (nil: standart log).
+//
+// Örnek:
+//
+//	// Veritabanına yazmak için
+//	auth.SetImpersonationAuditor(func(e auth.ImpersonationEvent) {
+//	    auditRepo.Insert(e)
+//	})
+func SetImpersonationAuditor(auditor ImpersonationAuditor) {
+	impersonationAuditorMu.Lock()
+	defer impersonationAuditorMu.Unlock()
+
+	impersonationAuditor = auditor
+}
+
+// LogImpersonationAuditor, olayları verilen logger'a yazan auditor döndürür.
+func LogImpersonationAuditor(logger *log.Logger) ImpersonationAuditor {
+	return func(e ImpersonationEvent) {
+		switch e.Action {
+		case ImpersonationRequest:
+			logger.Printf("🕵️  [impersonation] admin %d as user %d: %s %s (token: %s, ip: %s)",
+				e.ImpersonatorID, e.UserID, e.Method, e.Path, e.TokenID, e.IPAddress)
+		default:
+			logger.Printf("🕵️  [impersonation] %s: admin %d -> user %d (token: %s, ip: %s, ua: %q)",
+				e.Action, e.ImpersonatorID, e.UserID, e.TokenID, e.IPAddress, e.UserAgent)
+		}
+	}
+}
+
+// AuditImpersonation, olayı aktif auditor'a bildirir.
+func AuditImpersonation(event ImpersonationEvent) {
+	if event.At.IsZero() {
+		event.At = time.Now()
+	}
+
+	impersonationAuditorMu.RLock()
+	auditor := impersonationAuditor
+	impersonationAuditorMu.RUnlock()
+
+	if auditor == nil {
+		auditor = LogImpersonationAuditor(log.Default())
+	}
+	auditor(event)
+}
+
+// IsImpersonated, token'ın bir admin tarafından impersonation ile verilip
+// verilmediğini döndürür.
+func (c *JWTClaims) IsImpersonated() bool {
+	return c.ImpersonatorID != 0
+}
+
+// GenerateImpersonationToken, admin'in hedef kullanıcı adına kullanacağı
+// access token'ı üretir.
+//
+// Parametreler:
+//   - user: Impersonate edilecek kullanıcı
+//   - impersonatorID: İşlemi yapan admin'in ID'si
+//   - config: JWT configuration (nil ise default kullanılır)
+//
+// Döndürür:
+//   - string: Access token (refresh token verilmez)
+//   - *JWTClaims: Token'ın claims'i (jti ve bitiş zamanı audit için)
+//   - error: İmzalama hatası veya impersonatorID geçersizse
+//
+// Örnek:
+//
+//	token, claims, err := auth.GenerateImpersonationToken(target, admin.GetID(), jwtConfig)
+func GenerateImpersonationToken(user User, impersonatorID int64, config *JWTConfig) (string, *JWTClaims, error) {
+	if config == nil {
+		config = DefaultJWTConfig()
+	}
+	if impersonatorID == 0 || impersonatorID == user.GetID() {
+		return "", nil, errors.New("geçersiz impersonator")
+	}
+
+	ttl := ImpersonationTTL
+	if config.ExpirationTime > 0 && config.ExpirationTime < ttl {
+		ttl = config.ExpirationTime
+	}
+
+	now := time.Now()
+	claims := &JWTClaims{
+		UserID:         user.GetID(),
+		Email:          user.GetEmail(),
+		Role:           user.GetRole(),
+		ImpersonatorID: impersonatorID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    config.Issuer,
+			Audience:  config.audience(),
+			Subject:   user.GetEmail(),
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.Secret))
+	if err != nil {
+		return "", nil, err
+	}
+	return token, claims, nil
+}
@@ -19,7 +19,7 @@
 // - Microservice'ler arası auth için kullanılabilir
 //
 // JWT Dezavantajları:
-// - Revoke etmek zor (expire olmadan geçersiz kılamazsınız)
+// - Revoke etmek zor (bkz: denylist.go - jti ile cache tabanlı iptal)
 // - Payload boyutu büyük (her istekte gönderilir)
 // - XSS saldırılarına karşı hassas (localStorage kullanımında)
 //
@@ -35,9 +35,12 @@ package auth
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // JWTClaims, JWT token'ın payload'ında taşınan bilgileri temsil eder.
@@ -55,33 +58,108 @@ import (
 //   - UserID: Kullanıcı ID'si (veritabanından user çekmek için)
 //   - Email: Kullanıcı email'i
 //   - Role: Kullanıcı rolü (authorization için)
+//   - ImpersonatorID: Token impersonation ile verildiyse admin'in ID'si
+//     (bkz: impersonation.go)
 type JWTClaims struct {
-	UserID int64  `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID         int64  `json:"user_id"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	ImpersonatorID int64  `json:"impersonator_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // JWTConfig, JWT token oluşturma ve doğrulama ayarlarını içerir.
 type JWTConfig struct {
 	Secret           string        // Token imzalama için secret key
-	Issuer           string        // Token issuer (genellikle app adı)
+	Issuer           string        // Token issuer (genellikle app adı); doğrulamada kontrol edilir
+	Audience         string        // Token audience (opsiyonel); boş değilse doğrulamada kontrol edilir
 	ExpirationTime   time.Duration // Access token geçerlilik süresi
 	RefreshExpiresIn time.Duration // Refresh token geçerlilik süresi
 }
 
-// DefaultJWTConfig, varsayılan JWT ayarlarını döndürür.
+// insecureDefaultSecret, sadece development için kullanılan varsayılan secret.
+const insecureDefaultSecret = "your-super-secret-jwt-key-change-this-in-production"
+
+// minSecretLength, HS256 için kabul edilen en kısa secret (256 bit).
+const minSecretLength = 32
+
+// Global default JWT config (SetDefaultJWTConfig)
+var (
+	defaultJWTConfig   *JWTConfig
+	defaultJWTConfigMu sync.RWMutex
+)
+
+// SetDefaultJWTConfig, config parametresi nil verildiğinde (middleware.Auth(),
+// ParseToken(token, nil), denylist, guard) kullanılacak ayarları belirler.
+//
+// Uygulama başlangıcında environment'tan oluşturulan config ile çağrılmalıdır;
+// aksi halde hardcoded development secret'ı kullanılır.
+//
+// Örnek:
 //
-// Production'da bu ayarlar environment variable'lardan okunmalıdır!
+//	jwtConfig := cfg.JWT.Auth()
+//	if err := jwtConfig.Validate(); err != nil && cfg.IsProduction() {
+//	    log.Fatal(err)
+//	}
+//	auth.SetDefaultJWTConfig(jwtConfig)
+func SetDefaultJWTConfig(config *JWTConfig) {
+	defaultJWTConfigMu.Lock()
+	defer defaultJWTConfigMu.Unlock()
+
+	defaultJWTConfig = config
+}
+
+// DefaultJWTConfig, varsayılan JWT ayarlarının bir kopyasını döndürür.
+//
+// SetDefaultJWTConfig ile ayar yapılmamışsa development değerleri döner
+// (hardcoded secret, production'da Validate'ten geçmez).
 func DefaultJWTConfig() *JWTConfig {
+	defaultJWTConfigMu.RLock()
+	configured := defaultJWTConfig
+	defaultJWTConfigMu.RUnlock()
+
+	if configured != nil {
+		copied := *configured
+		return &copied
+	}
+
 	return &JWTConfig{
-		Secret:           "your-super-secret-jwt-key-change-this-in-production",
+		Secret:           insecureDefaultSecret,
 		Issuer:           "conduit-go",
 		ExpirationTime:   1 * time.Hour,      // 1 saat
 		RefreshExpiresIn: 7 * 24 * time.Hour, // 7 gün
 	}
 }
 
+// Validate, config'in güvenli kullanılabilir olup olmadığını kontrol eder.
+//
+// Hata Durumları:
+//   - Secret boş, 32 karakterden kısa veya development varsayılanı
+//   - Token süreleri pozitif değil
+func (c *JWTConfig) Validate() error {
+	switch {
+	case c.Secret == "":
+		return errors.New("JWT_SECRET tanımlı değil")
+	case c.Secret == insecureDefaultSecret:
+		return errors.New("JWT_SECRET varsayılan development değerinde, değiştirilmelidir")
+	case len(c.Secret) < minSecretLength:
+		return fmt.Errorf("JWT_SECRET en az %d karakter olmalı", minSecretLength)
+	case c.ExpirationTime <= 0:
+		return errors.New("JWT_TTL pozitif olmalı")
+	case c.RefreshExpiresIn <= 0:
+		return errors.New("JWT_REFRESH_TTL pozitif olmalı")
+	}
+	return nil
+}
+
+// audience, config'teki audience'ı claim formatına çevirir (boşsa nil).
+func (c *JWTConfig) audience() jwt.ClaimStrings {
+	if c.Audience == "" {
+		return nil
+	}
+	return jwt.ClaimStrings{c.Audience}
+}
+
 // GenerateToken, kullanıcı bilgileri ile yeni bir JWT access token oluşturur.
 //
 // Parametreler:
@@ -121,7 +199,9 @@ func GenerateToken(userID int64, email, role string, config *JWTConfig) (string,
 		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    config.Issuer,
+			Audience:  config.audience(),
 			Subject:   email,
+			ID:        uuid.New().String(), // jti: denylist için (bkz: denylist.go)
 			ExpiresAt: jwt.NewNumericDate(now.Add(config.ExpirationTime)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -169,7 +249,9 @@ func GenerateRefreshToken(userID int64, email string, config *JWTConfig) (string
 		Role:   "refresh", // Refresh token'ı ayırt etmek için
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    config.Issuer,
+			Audience:  config.audience(),
 			Subject:   email,
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(now.Add(config.RefreshExpiresIn)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -208,11 +290,22 @@ func GenerateRefreshToken(userID int64, email string, config *JWTConfig) (string
 // - İmza doğrulama hatası (tampered token)
 // - Expire olmuş token
 // - Not before zamanı henüz gelmemiş
+// - Issuer veya audience config ile eşleşmiyor
 func ParseToken(tokenString string, config *JWTConfig) (*JWTClaims, error) {
 	if config == nil {
 		config = DefaultJWTConfig()
 	}
 
+	// Issuer/audience tanımlıysa başka bir uygulama için verilmiş token'lar
+	// (aynı secret paylaşılsa bile) reddedilir
+	var options []jwt.ParserOption
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
 	// Token'ı parse et
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
 		// İmza algoritmasını kontrol et (algorithm confusion attack koruması)
@@ -220,7 +313,7 @@ func ParseToken(tokenString string, config *JWTConfig) (*JWTClaims, error) {
 			return nil, errors.New("unexpected signing method")
 		}
 		return []byte(config.Secret), nil
-	})
+	}, options...)
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,411 @@
+// -----------------------------------------------------------------------------
+// Magic Link (Passwordless) Login
+// -----------------------------------------------------------------------------
+// Şifre yerine kullanıcının email adresine gönderilen tek kullanımlık giriş
+// linki. Link'teki token 256 bit rastgele değerdir; veritabanında sadece
+// SHA-256 hash'i saklanır, kısa sürede (varsayılan 15 dakika) geçersiz olur ve
+// ilk kullanımda tüketilir.
+//
+// Akış:
+//
+//	POST /api/auth/magic-link         -> CreateMagicLink, link email'le gönderilir
+//	POST /api/auth/magic-link/consume -> ConsumeMagicLink, JWT'ler verilir
+//
+// Throttle:
+// Aynı email için pencere içinde en fazla MaxPerWindow link üretilir
+// (ErrMagicLinkThrottled). IP bazlı sınır route'taki RateLimit middleware'i
+// ile uygulanır.
+// -----------------------------------------------------------------------------
+
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/token"
+)
+
+// MagicLinksTable, magic link kayıtlarının tutulduğu tablo.
+const MagicLinksTable = "magic_login_tokens"
+
+var (
+	// ErrMagicLinkInvalid, token store'da yoksa döner.
+	ErrMagicLinkInvalid = errors.New("geçersiz giriş linki")
+
+	// ErrMagicLinkExpired, süresi dolmuş link kullanıldığında döner.
+	ErrMagicLinkExpired = errors.New("giriş linkinin süresi dolmuş")
+
+	// ErrMagicLinkUsed, daha önce kullanılmış (veya yeni link ile geçersiz
+	// kılınmış) link tekrar kullanıldığında döner.
+	ErrMagicLinkUsed = errors.New("giriş linki daha önce kullanılmış")
+
+	// ErrMagicLinkThrottled, email için pencere içindeki link limiti
+	// aşıldığında döner.
+	ErrMagicLinkThrottled = errors.New("çok fazla giriş linki istendi")
+)
+
+// MagicLinkConfig, magic link ayarlarıdır.
+type MagicLinkConfig struct {
+	TTL          time.Duration // Link'in geçerlilik süresi
+	MaxPerWindow int           // Email başına pencere içinde üretilebilecek link sayısı (0 = limitsiz)
+	Window       time.Duration // Throttle penceresi
+}
+
+// DefaultMagicLinkConfig, varsayılan magic link ayarlarını döndürür
+// (15 dakika geçerli, email başına 15 dakikada 3 link).
+func DefaultMagicLinkConfig() *MagicLinkConfig {
+	return &MagicLinkConfig{
+		TTL:          15 * time.Minute,
+		MaxPerWindow: 3,
+		Window:       15 * time.Minute,
+	}
+}
+
+// MagicLink, magic_login_tokens tablosundaki bir kayıttır.
+type MagicLink struct {
+	ID        int64      `json:"id" db:"id"`
+	UserID    int64      `json:"user_id" db:"user_id"`
+	Email     string     `json:"email" db:"email"`
+	TokenHash string     `json:"-" db:"token_hash"` // SHA-256 (hex); token'ın kendisi saklanmaz
+	IPAddress string     `json:"ip_address" db:"ip_address"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty" db:"used_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// MagicLinkStore, magic link kayıtlarını saklayan arayüzdür.
+type MagicLinkStore interface {
+	// Create, yeni kaydı ekler.
+	Create(link *MagicLink) error
+
+	// FindByHash, token hash'i ile kaydı döndürür (yoksa
+	// ErrMagicLinkInvalid).
+	FindByHash(hash string) (*MagicLink, error)
+
+	// MarkUsed, kaydı kullanıldı olarak işaretler. Kayıt daha önce
+	// kullanılmışsa false döner (eşzamanlı iki istekten sadece biri kazanır).
+	MarkUsed(id int64, at time.Time) (bool, error)
+
+	// InvalidateEmail, email'in kullanılmamış tüm linklerini geçersiz kılar.
+	InvalidateEmail(email string) error
+
+	// CountSince, email için verilen andan sonra oluşturulan link sayısını
+	// döndürür.
+	CountSince(email string, since time.Time) (int, error)
+}
+
+// CreateMagicLink, kullanıcı için yeni bir giriş linki token'ı üretir ve
+// kaydeder. Email'in önceki kullanılmamış linkleri geçersiz kılınır.
+//
+// Parametreler:
+//   - store: Magic link store
+//   - userID, email: Link sahibi
+//   - ipAddress: İsteği yapan IP (audit için)
+//   - config: Magic link ayarları (nil ise default kullanılır)
+//
+// Döndürür:
+//   - string: Link'e eklenecek düz token (sadece email'de bulunur)
+//   - error: ErrMagicLinkThrottled veya store hatası
+//
+// Örnek:
+//
+//	token, err := auth.CreateMagicLink(store, user.ID, user.Email, r.GetIP(), nil)
+//	link := "https://app.example.com/auth/magic-link?token=" + token
+func CreateMagicLink(store MagicLinkStore, userID int64, email, ipAddress string, config *MagicLinkConfig) (string, error) {
+	if config == nil {
+		config = DefaultMagicLinkConfig()
+	}
+	email = strings.ToLower(email)
+	now := time.Now()
+
+	if config.MaxPerWindow > 0 {
+		count, err := store.CountSince(email, now.Add(-config.Window))
+		if err != nil {
+			return "", fmt.Errorf("magic link sayısı okunamadı: %w", err)
+		}
+		if count >= config.MaxPerWindow {
+			return "", ErrMagicLinkThrottled
+		}
+	}
+
+	plain, err := token.GenerateSecureTokenHex(32)
+	if err != nil {
+		return "", err
+	}
+
+	if err := store.InvalidateEmail(email); err != nil {
+		return "", fmt.Errorf("önceki magic link'ler geçersiz kılınamadı: %w", err)
+	}
+
+	link := &MagicLink{
+		UserID:    userID,
+		Email:     email,
+		TokenHash: hashToken(plain),
+		IPAddress: ipAddress,
+		ExpiresAt: now.Add(config.TTL),
+		CreatedAt: now,
+	}
+	if err := store.Create(link); err != nil {
+		return "", fmt.Errorf("magic link kaydedilemedi: %w", err)
+	}
+
+	return plain, nil
+}
+
+// ConsumeMagicLink, token'ı doğrular ve tek kullanımlık olarak tüketir.
+//
+// Döndürür:
+//   - *MagicLink: Tüketilen kayıt (UserID ile kullanıcı yüklenir)
+//   - error: ErrMagicLinkInvalid, ErrMagicLinkExpired veya ErrMagicLinkUsed
+//
+// Örnek:
+//
+//	link, err := auth.ConsumeMagicLink(store, reqData.Token)
+//	if err != nil {
+//	    conduitRes.Error(w, 401, "Geçersiz veya süresi dolmuş link")
+//	    return
+//	}
+//	user, err := userRepo.FindByID(link.UserID)
+func ConsumeMagicLink(store MagicLinkStore, plain string) (*MagicLink, error) {
+	if plain == "" {
+		return nil, ErrMagicLinkInvalid
+	}
+
+	link, err := store.FindByHash(hashToken(plain))
+	if err != nil {
+		return nil, err
+	}
+	if link.UsedAt != nil {
+		return nil, ErrMagicLinkUsed
+	}
+
+	now := time.Now()
+	if now.After(link.ExpiresAt) {
+		return nil, ErrMagicLinkExpired
+	}
+
+	won, err := store.MarkUsed(link.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("magic link güncellenemedi: %w", err)
+	}
+	if !won {
+		return nil, ErrMagicLinkUsed
+	}
+
+	link.UsedAt = &now
+	return link, nil
+}
+
+// DatabaseMagicLinkStore, magic link'leri veritabanında saklar.
+type DatabaseMagicLinkStore struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+// NewDatabaseMagicLinkStore, yeni bir DatabaseMagicLinkStore oluşturur.
+//
+// Örnek:
+//
+//	store := auth.NewDatabaseMagicLinkStore(db, grammar)
+//	store.CreateTable()
+func NewDatabaseMagicLinkStore(db *sql.DB, grammar database.Grammar) *DatabaseMagicLinkStore {
+	return &DatabaseMagicLinkStore{
+		db:      db,
+		grammar: grammar,
+	}
+}
+
+// newBuilder, store için yeni bir QueryBuilder oluşturur.
+func (s *DatabaseMagicLinkStore) newBuilder() *database.QueryBuilder {
+	return database.NewBuilder(s.db, s.grammar).Table(MagicLinksTable)
+}
+
+// CreateTable, magic_login_tokens tablosunu yoksa oluşturur.
+func (s *DatabaseMagicLinkStore) CreateTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS magic_login_tokens (
+			id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+			user_id BIGINT UNSIGNED NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			token_hash CHAR(64) NOT NULL,
+			ip_address VARCHAR(45) NOT NULL DEFAULT '',
+			expires_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP NULL DEFAULT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE KEY magic_login_tokens_token_hash_unique (token_hash),
+			INDEX magic_login_tokens_email_created_at_index (email, created_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`)
+	if err != nil {
+		return fmt.Errorf("magic_login_tokens tablosu oluşturulamadı: %w", err)
+	}
+	return nil
+}
+
+// Create, yeni kaydı ekler.
+func (s *DatabaseMagicLinkStore) Create(link *MagicLink) error {
+	result, err := s.newBuilder().ExecInsert(map[string]interface{}{
+		"user_id":    link.UserID,
+		"email":      link.Email,
+		"token_hash": link.TokenHash,
+		"ip_address": truncate(link.IPAddress, 45),
+		"expires_at": link.ExpiresAt,
+		"created_at": link.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	link.ID, err = result.LastInsertId()
+	return err
+}
+
+// FindByHash, token hash'i ile kaydı döndürür.
+func (s *DatabaseMagicLinkStore) FindByHash(hash string) (*MagicLink, error) {
+	var link MagicLink
+	err := s.newBuilder().Where("token_hash", "=", hash).First(&link)
+	if err == sql.ErrNoRows {
+		return nil, ErrMagicLinkInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// MarkUsed, kaydı kullanıldı olarak işaretler (koşullu UPDATE ile atomik).
+func (s *DatabaseMagicLinkStore) MarkUsed(id int64, at time.Time) (bool, error) {
+	result, err := s.newBuilder().
+		Where("id", "=", id).
+		WhereNull("used_at").
+		ExecUpdate(map[string]interface{}{"used_at": at})
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// InvalidateEmail, email'in kullanılmamış linklerini kullanıldı olarak
+// işaretler.
+func (s *DatabaseMagicLinkStore) InvalidateEmail(email string) error {
+	_, err := s.newBuilder().
+		Where("email", "=", email).
+		WhereNull("used_at").
+		ExecUpdate(map[string]interface{}{"used_at": time.Now()})
+	return err
+}
+
+// CountSince, email için verilen andan sonra oluşturulan link sayısını
+// döndürür.
+func (s *DatabaseMagicLinkStore) CountSince(email string, since time.Time) (int, error) {
+	var links []MagicLink
+	err := s.newBuilder().
+		Select("id").
+		Where("email", "=", email).
+		Where("created_at", ">=", since).
+		Get(&links)
+	if err != nil {
+		return 0, err
+	}
+	return len(links), nil
+}
+
+// PruneExpired, süresi dolmuş kayıtları siler.
+func (s *DatabaseMagicLinkStore) PruneExpired() (int64, error) {
+	result, err := s.newBuilder().Where("expires_at", "<", time.Now()).ExecDelete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// MemoryMagicLinkStore, magic link'leri bellekte saklar (test için).
+type MemoryMagicLinkStore struct {
+	mu     sync.Mutex
+	nextID int64
+	links  map[int64]*MagicLink
+}
+
+// NewMemoryMagicLinkStore, yeni bir MemoryMagicLinkStore oluşturur.
+func NewMemoryMagicLinkStore() *MemoryMagicLinkStore {
+	return &MemoryMagicLinkStore{
+		links: make(map[int64]*MagicLink),
+	}
+}
+
+// Create, yeni kaydı ekler.
+func (s *MemoryMagicLinkStore) Create(link *MagicLink) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	link.ID = s.nextID
+	stored := *link
+	s.links[link.ID] = &stored
+	return nil
+}
+
+// FindByHash, token hash'i ile kaydın kopyasını döndürür.
+func (s *MemoryMagicLinkStore) FindByHash(hash string) (*MagicLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, link := range s.links {
+		if link.TokenHash == hash {
+			copied := *link
+			return &copied, nil
+		}
+	}
+	return nil, ErrMagicLinkInvalid
+}
+
+// MarkUsed, kaydı kullanıldı olarak işaretler.
+func (s *MemoryMagicLinkStore) MarkUsed(id int64, at time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	link, ok := s.links[id]
+	if !ok || link.UsedAt != nil {
+		return false, nil
+	}
+	link.UsedAt = &at
+	return true, nil
+}
+
+// InvalidateEmail, email'in kullanılmamış linklerini kullanıldı olarak
+// işaretler.
+func (s *MemoryMagicLinkStore) InvalidateEmail(email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, link := range s.links {
+		if link.Email == email && link.UsedAt == nil {
+			link.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+// CountSince, email için verilen andan sonra oluşturulan link sayısını
+// döndürür.
+func (s *MemoryMagicLinkStore) CountSince(email string, since time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, link := range s.links {
+		if link.Email == email && !link.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
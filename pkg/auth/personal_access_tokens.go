@@ -0,0 +1,511 @@
+// -----------------------------------------------------------------------------
+// Personal Access Tokens (API Keys)
+// -----------------------------------------------------------------------------
+// CLI araçları, CI pipeline'ları ve üçüncü parti entegrasyonlar için uzun
+// ömürlü, kullanıcı tarafından yönetilen token'lar (Laravel Sanctum gibi).
+//
+// Token Formatı:
+//
+//	{id}|{40 karakter hex secret}
+//
+// Veritabanında (personal_access_tokens) sadece secret'ın SHA-256 hash'i
+// saklanır; düz token oluşturulduğu anda bir kez kullanıcıya gösterilir.
+// JWT'ler "|" içermediği için aynı Authorization header'ında iki tip
+// ayırt edilebilir (bkz: middleware.AuthToken).
+//
+// Abilities:
+// Her token bir yetki listesi taşır ("servers:read", "servers:deploy").
+// "*" tüm yetkileri verir.
+//
+// Kullanım:
+//
+//	auth.SetPersonalAccessTokenStore(auth.NewDatabasePersonalAccessTokenStore(db, grammar))
+//
+//	newToken, err := user.CreateToken("deploy-cli", []string{"servers:deploy"})
+//	fmt.Println(newToken.PlainTextToken) // 12|9f86d081884c7d659a2feaa0c55ad015a3bf4f1b
+// -----------------------------------------------------------------------------
+
+package auth
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/token"
+)
+
+// PersonalAccessTokensTable, personal access token kayıtlarının tutulduğu tablo.
+const PersonalAccessTokensTable = "personal_access_tokens"
+
+var (
+	// ErrAccessTokenNotFound, token yoksa, silinmişse veya secret eşleşmezse döner.
+	ErrAccessTokenNotFound = errors.New("personal access token bulunamadı")
+
+	// ErrAccessTokenExpired, süresi dolmuş token kullanıldığında döner.
+	ErrAccessTokenExpired = errors.New("personal access token süresi dolmuş")
+)
+
+// TokenAbilities, token'ın yetki listesidir (veritabanında JSON olarak saklanır).
+type TokenAbilities []string
+
+// Value, driver.Valuer implementasyonu.
+func (a TokenAbilities) Value() (driver.Value, error) {
+	if a == nil {
+		a = TokenAbilities{}
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan, sql.Scanner implementasyonu.
+func (a *TokenAbilities) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return fmt.Errorf("geçersiz abilities değeri: %T", src)
+	}
+}
+
+// PersonalAccessToken, personal_access_tokens tablosundaki bir kayıttır.
+type PersonalAccessToken struct {
+	ID         int64          `json:"id" db:"id"`
+	UserID     int64          `json:"user_id" db:"user_id"`
+	Name       string         `json:"name" db:"name"`
+	TokenHash  string         `json:"-" db:"token"` // SHA-256 (hex); secret'ın kendisi saklanmaz
+	Abilities  TokenAbilities `json:"abilities" db:"abilities"`
+	LastUsedAt *time.Time     `json:"last_used_at" db:"last_used_at"`
+	ExpiresAt  *time.Time     `json:"expires_at" db:"expires_at"` // nil: süresiz
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+}
+
+// Can, token'ın verilen yetkiye sahip olup olmadığını kontrol eder.
+//
+// Örnek:
+//
+//	if !token.Can("servers:deploy") {
+//	    conduitRes.Error(w, 403, "Bu token deploy yetkisine sahip değil")
+//	}
+func (t *PersonalAccessToken) Can(ability string) bool {
+	for _, a := range t.Abilities {
+		if a == "*" || a == ability {
+			return true
+		}
+	}
+	return false
+}
+
+// Cant, Can'in tersidir.
+func (t *PersonalAccessToken) Cant(ability string) bool {
+	return !t.Can(ability)
+}
+
+// IsExpired, token'ın süresinin dolup dolmadığını kontrol eder.
+func (t *PersonalAccessToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().After(*t.ExpiresAt)
+}
+
+// NewAccessToken, yeni oluşturulan token'dır. PlainTextToken sadece bu
+// noktada bilinir; kullanıcıya bir kez gösterilmelidir.
+type NewAccessToken struct {
+	AccessToken    *PersonalAccessToken `json:"access_token"`
+	PlainTextToken string               `json:"plain_text_token"`
+}
+
+// PersonalAccessTokenStore, personal access token kayıtlarını saklayan arayüzdür.
+type PersonalAccessTokenStore interface {
+	// Create, yeni kaydı ekler ve ID'sini token'a yazar.
+	Create(token *PersonalAccessToken) error
+
+	// Find, ID ile kaydı döndürür (yoksa ErrAccessTokenNotFound).
+	Find(id int64) (*PersonalAccessToken, error)
+
+	// ForUser, kullanıcının token'larını (yeniden eskiye) döndürür.
+	ForUser(userID int64) ([]PersonalAccessToken, error)
+
+	// Touch, token'ın son kullanım zamanını günceller.
+	Touch(id int64, at time.Time) error
+
+	// Delete, kaydı siler.
+	Delete(id int64) error
+
+	// DeleteForUser, kullanıcının tüm token'larını siler.
+	DeleteForUser(userID int64) error
+}
+
+// Global personal access token store
+var (
+	accessTokenStore   PersonalAccessTokenStore
+	accessTokenStoreMu sync.RWMutex
+)
+
+// SetPersonalAccessTokenStore, personal access token'ların saklanacağı
+// store'u ayarlar.
+//
+// Ayarlanmazsa process içi bir memory store kullanılır (restart'ta token'lar
+// kaybolur; sadece test için).
+func SetPersonalAccessTokenStore(store PersonalAccessTokenStore) {
+	accessTokenStoreMu.Lock()
+	defer accessTokenStoreMu.Unlock()
+
+	accessTokenStore = store
+}
+
+// getPersonalAccessTokenStore, aktif store'u döndürür (gerekirse oluşturur).
+func getPersonalAccessTokenStore() PersonalAccessTokenStore {
+	accessTokenStoreMu.RLock()
+	store := accessTokenStore
+	accessTokenStoreMu.RUnlock()
+
+	if store != nil {
+		return store
+	}
+
+	accessTokenStoreMu.Lock()
+	defer accessTokenStoreMu.Unlock()
+
+	if accessTokenStore == nil {
+		accessTokenStore = NewMemoryPersonalAccessTokenStore()
+	}
+	return accessTokenStore
+}
+
+// IsPersonalAccessToken, bearer token'ın personal access token formatında
+// ({id}|{secret}) olup olmadığını kontrol eder.
+func IsPersonalAccessToken(plain string) bool {
+	return strings.Contains(plain, "|")
+}
+
+// CreatePersonalAccessToken, kullanıcı için yeni bir personal access token
+// oluşturur.
+//
+// Parametreler:
+//   - userID: Token sahibi
+//   - name: Kullanıcının token'ı tanıması için ad ("deploy-cli", "CI")
+//   - abilities: Yetkiler (boşsa ["*"])
+//   - expiresAt: Son kullanma zamanı (nil: süresiz)
+//
+// Döndürür:
+//   - *NewAccessToken: Kayıt ve düz token ({id}|{secret})
+//   - error: Store hatası
+//
+// Örnek:
+//
+//	expires := time.Now().AddDate(0, 0, 90)
+//	newToken, err := auth.CreatePersonalAccessToken(user.ID, "CI", []string{"deploy"}, &expires)
+func CreatePersonalAccessToken(userID int64, name string, abilities []string, expiresAt *time.Time) (*NewAccessToken, error) {
+	if len(abilities) == 0 {
+		abilities = []string{"*"}
+	}
+
+	secret, err := token.GenerateSecureTokenHex(20)
+	if err != nil {
+		return nil, fmt.Errorf("personal access token üretilemedi: %w", err)
+	}
+
+	record := &PersonalAccessToken{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashToken(secret),
+		Abilities: abilities,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+	if err := getPersonalAccessTokenStore().Create(record); err != nil {
+		return nil, fmt.Errorf("personal access token kaydedilemedi: %w", err)
+	}
+
+	return &NewAccessToken{
+		AccessToken:    record,
+		PlainTextToken: strconv.FormatInt(record.ID, 10) + "|" + secret,
+	}, nil
+}
+
+// FindPersonalAccessToken, düz token'ı doğrular ve kaydı döndürür.
+//
+// Döndürür:
+//   - error: Format/secret hatalıysa ErrAccessTokenNotFound, süresi
+//     dolmuşsa ErrAccessTokenExpired, diğer durumlarda store hatası
+func FindPersonalAccessToken(plain string) (*PersonalAccessToken, error) {
+	idPart, secret, ok := strings.Cut(plain, "|")
+	if !ok || secret == "" {
+		return nil, ErrAccessTokenNotFound
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil {
+		return nil, ErrAccessTokenNotFound
+	}
+
+	record, err := getPersonalAccessTokenStore().Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Timing attack'e karşı sabit zamanlı karşılaştırma
+	if subtle.ConstantTimeCompare([]byte(record.TokenHash), []byte(hashToken(secret))) != 1 {
+		return nil, ErrAccessTokenNotFound
+	}
+	if record.IsExpired() {
+		return nil, ErrAccessTokenExpired
+	}
+
+	return record, nil
+}
+
+// AuthenticatePersonalAccessToken, düz token'ı doğrular ve son kullanım
+// zamanını günceller (middleware.AuthToken tarafından kullanılır).
+//
+// last_used_at yazılamazsa istek reddedilmez; bu alan sadece bilgi amaçlıdır.
+func AuthenticatePersonalAccessToken(plain string) (*PersonalAccessToken, error) {
+	record, err := FindPersonalAccessToken(plain)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := getPersonalAccessTokenStore().Touch(record.ID, now); err == nil {
+		record.LastUsedAt = &now
+	}
+	return record, nil
+}
+
+// PersonalAccessTokens, kullanıcının token'larını döndürür.
+func PersonalAccessTokens(userID int64) ([]PersonalAccessToken, error) {
+	return getPersonalAccessTokenStore().ForUser(userID)
+}
+
+// RevokePersonalAccessToken, kullanıcının token'ını siler.
+//
+// Token başka bir kullanıcıya aitse ErrAccessTokenNotFound döner (varlığı
+// sızdırılmaz).
+func RevokePersonalAccessToken(userID, tokenID int64) error {
+	store := getPersonalAccessTokenStore()
+
+	record, err := store.Find(tokenID)
+	if err != nil {
+		return err
+	}
+	if record.UserID != userID {
+		return ErrAccessTokenNotFound
+	}
+	return store.Delete(tokenID)
+}
+
+// RevokeAllPersonalAccessTokens, kullanıcının tüm token'larını siler.
+func RevokeAllPersonalAccessTokens(userID int64) error {
+	return getPersonalAccessTokenStore().DeleteForUser(userID)
+}
+
+// DatabasePersonalAccessTokenStore, token'ları veritabanında saklar.
+type DatabasePersonalAccessTokenStore struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+// NewDatabasePersonalAccessTokenStore, yeni bir DatabasePersonalAccessTokenStore
+// oluşturur.
+//
+// Örnek:
+//
+//	store := auth.NewDatabasePersonalAccessTokenStore(db, grammar)
+//	store.CreateTable()
+//	auth.SetPersonalAccessTokenStore(store)
+func NewDatabasePersonalAccessTokenStore(db *sql.DB, grammar database.Grammar) *DatabasePersonalAccessTokenStore {
+	return &DatabasePersonalAccessTokenStore{
+		db:      db,
+		grammar: grammar,
+	}
+}
+
+// newBuilder, store için yeni bir QueryBuilder oluşturur.
+func (s *DatabasePersonalAccessTokenStore) newBuilder() *database.QueryBuilder {
+	return database.NewBuilder(s.db, s.grammar).Table(PersonalAccessTokensTable)
+}
+
+// CreateTable, personal_access_tokens tablosunu yoksa oluşturur.
+func (s *DatabasePersonalAccessTokenStore) CreateTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS personal_access_tokens (
+			id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+			user_id BIGINT UNSIGNED NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			token CHAR(64) NOT NULL,
+			abilities TEXT NULL,
+			last_used_at TIMESTAMP NULL DEFAULT NULL,
+			expires_at TIMESTAMP NULL DEFAULT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE KEY personal_access_tokens_token_unique (token),
+			INDEX personal_access_tokens_user_id_index (user_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`)
+	if err != nil {
+		return fmt.Errorf("personal_access_tokens tablosu oluşturulamadı: %w", err)
+	}
+	return nil
+}
+
+// Create, yeni kaydı ekler.
+func (s *DatabasePersonalAccessTokenStore) Create(token *PersonalAccessToken) error {
+	result, err := s.newBuilder().ExecInsert(map[string]interface{}{
+		"user_id":    token.UserID,
+		"name":       token.Name,
+		"token":      token.TokenHash,
+		"abilities":  token.Abilities,
+		"expires_at": token.ExpiresAt,
+		"created_at": token.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	token.ID, err = result.LastInsertId()
+	return err
+}
+
+// Find, ID ile kaydı döndürür.
+func (s *DatabasePersonalAccessTokenStore) Find(id int64) (*PersonalAccessToken, error) {
+	var token PersonalAccessToken
+	err := s.newBuilder().Where("id", "=", id).First(&token)
+	if err == sql.ErrNoRows {
+		return nil, ErrAccessTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// ForUser, kullanıcının token'larını döndürür.
+func (s *DatabasePersonalAccessTokenStore) ForUser(userID int64) ([]PersonalAccessToken, error) {
+	var tokens []PersonalAccessToken
+	err := s.newBuilder().
+		Where("user_id", "=", userID).
+		OrderBy("id", "DESC").
+		Get(&tokens)
+	return tokens, err
+}
+
+// Touch, son kullanım zamanını günceller.
+func (s *DatabasePersonalAccessTokenStore) Touch(id int64, at time.Time) error {
+	_, err := s.newBuilder().
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{"last_used_at": at})
+	return err
+}
+
+// Delete, kaydı siler.
+func (s *DatabasePersonalAccessTokenStore) Delete(id int64) error {
+	_, err := s.newBuilder().Where("id", "=", id).ExecDelete()
+	return err
+}
+
+// DeleteForUser, kullanıcının tüm kayıtlarını siler.
+func (s *DatabasePersonalAccessTokenStore) DeleteForUser(userID int64) error {
+	_, err := s.newBuilder().Where("user_id", "=", userID).ExecDelete()
+	return err
+}
+
+// MemoryPersonalAccessTokenStore, token'ları bellekte saklar (test için).
+type MemoryPersonalAccessTokenStore struct {
+	mu     sync.RWMutex
+	nextID int64
+	tokens map[int64]PersonalAccessToken
+}
+
+// NewMemoryPersonalAccessTokenStore, yeni bir MemoryPersonalAccessTokenStore
+// oluşturur.
+func NewMemoryPersonalAccessTokenStore() *MemoryPersonalAccessTokenStore {
+	return &MemoryPersonalAccessTokenStore{
+		tokens: make(map[int64]PersonalAccessToken),
+	}
+}
+
+// Create, yeni kaydı ekler.
+func (s *MemoryPersonalAccessTokenStore) Create(token *PersonalAccessToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	token.ID = s.nextID
+	s.tokens[token.ID] = *token
+	return nil
+}
+
+// Find, ID ile kaydın kopyasını döndürür.
+func (s *MemoryPersonalAccessTokenStore) Find(id int64) (*PersonalAccessToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, ok := s.tokens[id]
+	if !ok {
+		return nil, ErrAccessTokenNotFound
+	}
+	return &token, nil
+}
+
+// ForUser, kullanıcının token'larını döndürür.
+func (s *MemoryPersonalAccessTokenStore) ForUser(userID int64) ([]PersonalAccessToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var tokens []PersonalAccessToken
+	for _, token := range s.tokens {
+		if token.UserID == userID {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].ID > tokens[j].ID })
+	return tokens, nil
+}
+
+// Touch, son kullanım zamanını günceller.
+func (s *MemoryPersonalAccessTokenStore) Touch(id int64, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if token, ok := s.tokens[id]; ok {
+		token.LastUsedAt = &at
+		s.tokens[id] = token
+	}
+	return nil
+}
+
+// Delete, kaydı siler.
+func (s *MemoryPersonalAccessTokenStore) Delete(id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tokens, id)
+	return nil
+}
+
+// DeleteForUser, kullanıcının tüm kayıtlarını siler.
+func (s *MemoryPersonalAccessTokenStore) DeleteForUser(userID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, token := range s.tokens {
+		if token.UserID == userID {
+			delete(s.tokens, id)
+		}
+	}
+	return nil
+}
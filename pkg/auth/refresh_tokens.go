@@ -0,0 +1,436 @@
+// -----------------------------------------------------------------------------
+// Persisted Refresh Tokens (Rotation + Reuse Detection)
+// -----------------------------------------------------------------------------
+// Refresh token'lar refresh_tokens tablosunda (SHA-256 hash'i ile) saklanır.
+// Her login yeni bir "aile" (family) başlatır; her refresh'te kullanılan token
+// iptal edilir ve aynı aileden yeni bir token verilir (rotation).
+//
+// Reuse Detection:
+// Rotation sonrası eski token tekrar gelirse ya token çalınmıştır ya da
+// saldırgan ile kullanıcıdan biri token'ı önce kullanmıştır; hangisinin
+// meşru olduğu bilinemez. Bu durumda ailenin tüm token'ları iptal edilir ve
+// kullanıcı yeniden login olmak zorunda kalır. Aileden verilmiş access
+// token'lar kısa ömürlüdür ve kendi süreleri dolunca düşer.
+//
+// Akış:
+//
+//	login   -> IssueRefreshToken (yeni aile)
+//	refresh -> RotateRefreshToken (eskiyi iptal et, yenisini ver)
+//	logout  -> RevokeRefreshToken / store.RevokeUser
+// -----------------------------------------------------------------------------
+
+package auth
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/google/uuid"
+)
+
+// RefreshTokensTable, refresh token kayıtlarının tutulduğu tablo.
+const RefreshTokensTable = "refresh_tokens"
+
+var (
+	// ErrRefreshTokenNotFound, token store'da yoksa (hiç verilmemiş veya
+	// silinmiş) döner.
+	ErrRefreshTokenNotFound = errors.New("refresh token bulunamadı")
+
+	// ErrRefreshTokenRevoked, logout veya aile iptaliyle geçersiz kılınmış
+	// token kullanıldığında döner.
+	ErrRefreshTokenRevoked = errors.New("refresh token iptal edilmiş")
+
+	// ErrRefreshTokenReused, rotation ile kullanılmış token tekrar
+	// kullanıldığında döner; ailenin tüm token'ları iptal edilmiştir.
+	ErrRefreshTokenReused = errors.New("refresh token tekrar kullanıldı, oturum iptal edildi")
+)
+
+// RefreshDevice, refresh token'ın verildiği cihaz bilgisidir.
+type RefreshDevice struct {
+	UserAgent string
+	IPAddress string
+}
+
+// RefreshToken, refresh_tokens tablosundaki bir kayıttır.
+type RefreshToken struct {
+	ID        int64      `json:"id" db:"id"`
+	UserID    int64      `json:"user_id" db:"user_id"`
+	FamilyID  string     `json:"family_id" db:"family_id"`   // Login ile başlayan rotation zinciri
+	TokenHash string     `json:"-" db:"token_hash"`          // SHA-256 (hex); token'ın kendisi saklanmaz
+	UserAgent string     `json:"user_agent" db:"user_agent"` // Cihaz bilgisi
+	IPAddress string     `json:"ip_address" db:"ip_address"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty" db:"used_at"`       // Rotation ile kullanıldığı an
+	RevokedAt *time.Time `json:"revoked_at,omitempty" db:"revoked_at"` // Logout / aile iptali
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// RefreshTokenStore, refresh token kayıtlarını saklayan arayüzdür.
+type RefreshTokenStore interface {
+	// Create, yeni kaydı ekler.
+	Create(token *RefreshToken) error
+
+	// FindByHash, token hash'i ile kaydı döndürür (yoksa
+	// ErrRefreshTokenNotFound).
+	FindByHash(hash string) (*RefreshToken, error)
+
+	// MarkUsed, kaydı kullanıldı olarak işaretler. Kayıt daha önce
+	// kullanılmış veya iptal edilmişse false döner (eşzamanlı iki refresh'ten
+	// sadece biri kazanır).
+	MarkUsed(id int64, at time.Time) (bool, error)
+
+	// RevokeFamily, ailenin tüm kayıtlarını iptal eder.
+	RevokeFamily(familyID string) error
+
+	// RevokeUser, kullanıcının tüm kayıtlarını iptal eder.
+	RevokeUser(userID int64) error
+}
+
+// HashRefreshToken, token'ın store'da saklanan SHA-256 hash'ini döndürür.
+func HashRefreshToken(token string) string {
+	return hashToken(token)
+}
+
+// hashToken, veritabanında saklanacak token'ların SHA-256 (hex) hash'i.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IssueRefreshToken, yeni bir aile başlatan refresh token üretir ve kaydeder.
+//
+// Parametreler:
+//   - store: Refresh token store
+//   - userID, email: Token sahibi
+//   - device: Cihaz bilgisi (User-Agent, IP)
+//   - config: JWT configuration (nil ise default kullanılır)
+//
+// Örnek:
+//
+//	refreshToken, err := auth.IssueRefreshToken(store, user.ID, user.Email,
+//	    auth.RefreshDevice{UserAgent: r.UserAgent(), IPAddress: r.GetIP()}, jwtConfig)
+func IssueRefreshToken(store RefreshTokenStore, userID int64, email string, device RefreshDevice, config *JWTConfig) (string, error) {
+	return issueRefreshToken(store, userID, email, uuid.New().String(), device, config)
+}
+
+// issueRefreshToken, verilen aileye yeni bir refresh token ekler.
+func issueRefreshToken(store RefreshTokenStore, userID int64, email, familyID string, device RefreshDevice, config *JWTConfig) (string, error) {
+	if config == nil {
+		config = DefaultJWTConfig()
+	}
+
+	token, err := GenerateRefreshToken(userID, email, config)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	record := &RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: HashRefreshToken(token),
+		UserAgent: device.UserAgent,
+		IPAddress: device.IPAddress,
+		ExpiresAt: now.Add(config.RefreshExpiresIn),
+		CreatedAt: now,
+	}
+	if err := store.Create(record); err != nil {
+		return "", fmt.Errorf("refresh token kaydedilemedi: %w", err)
+	}
+
+	return token, nil
+}
+
+// RotateRefreshToken, refresh token'ı doğrular, tek kullanımlık olarak
+// tüketir ve aynı aileden yeni bir refresh token döndürür.
+//
+// Daha önce kullanılmış bir token gelirse ailenin tamamı iptal edilir ve
+// ErrRefreshTokenReused döner; istemci yeniden login olmalıdır.
+//
+// Döndürür:
+//   - *JWTClaims: Eski token'ın claims'i (kullanıcıyı yüklemek için)
+//   - string: Yeni refresh token
+//   - error: Geçersiz/süresi dolmuş token, ErrRefreshTokenNotFound,
+//     ErrRefreshTokenRevoked veya ErrRefreshTokenReused
+//
+// Örnek:
+//
+//	claims, newRefresh, err := auth.RotateRefreshToken(store, oldRefresh, device, jwtConfig)
+//	if errors.Is(err, auth.ErrRefreshTokenReused) {
+//	    // Muhtemel token hırsızlığı: logla, alarm üret
+//	}
+func RotateRefreshToken(store RefreshTokenStore, tokenString string, device RefreshDevice, config *JWTConfig) (*JWTClaims, string, error) {
+	claims, err := ParseToken(tokenString, config)
+	if err != nil {
+		return nil, "", err
+	}
+	if claims.Role != "refresh" {
+		return nil, "", errors.New("token bir refresh token değil")
+	}
+
+	record, err := store.FindByHash(HashRefreshToken(tokenString))
+	if err != nil {
+		return nil, "", err
+	}
+	if record.UserID != claims.UserID {
+		return nil, "", ErrRefreshTokenNotFound
+	}
+
+	// Kullanılmış token'ın tekrar gelmesi: aileyi iptal et
+	if record.UsedAt != nil {
+		if err := store.RevokeFamily(record.FamilyID); err != nil {
+			return nil, "", fmt.Errorf("refresh token ailesi iptal edilemedi: %w", err)
+		}
+		return claims, "", ErrRefreshTokenReused
+	}
+	if record.RevokedAt != nil {
+		return nil, "", ErrRefreshTokenRevoked
+	}
+
+	// Eşzamanlı iki istek aynı token'ı kullanırsa sadece biri kazanır;
+	// kaybeden tekrar kullanım sayılır
+	won, err := store.MarkUsed(record.ID, time.Now())
+	if err != nil {
+		return nil, "", fmt.Errorf("refresh token güncellenemedi: %w", err)
+	}
+	if !won {
+		if err := store.RevokeFamily(record.FamilyID); err != nil {
+			return nil, "", fmt.Errorf("refresh token ailesi iptal edilemedi: %w", err)
+		}
+		return claims, "", ErrRefreshTokenReused
+	}
+
+	next, err := issueRefreshToken(store, claims.UserID, claims.Email, record.FamilyID, device, config)
+	if err != nil {
+		return nil, "", err
+	}
+	return claims, next, nil
+}
+
+// RevokeRefreshToken, refresh token'ın ailesini iptal eder (logout).
+//
+// Store'da olmayan token için hata dönmez.
+func RevokeRefreshToken(store RefreshTokenStore, tokenString string) error {
+	record, err := store.FindByHash(HashRefreshToken(tokenString))
+	if errors.Is(err, ErrRefreshTokenNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return store.RevokeFamily(record.FamilyID)
+}
+
+// DatabaseRefreshTokenStore, refresh token'ları veritabanında saklar.
+type DatabaseRefreshTokenStore struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+// NewDatabaseRefreshTokenStore, yeni bir DatabaseRefreshTokenStore oluşturur.
+//
+// Örnek:
+//
+//	store := auth.NewDatabaseRefreshTokenStore(db, grammar)
+//	store.CreateTable()
+func NewDatabaseRefreshTokenStore(db *sql.DB, grammar database.Grammar) *DatabaseRefreshTokenStore {
+	return &DatabaseRefreshTokenStore{
+		db:      db,
+		grammar: grammar,
+	}
+}
+
+// newBuilder, store için yeni bir QueryBuilder oluşturur.
+func (s *DatabaseRefreshTokenStore) newBuilder() *database.QueryBuilder {
+	return database.NewBuilder(s.db, s.grammar).Table(RefreshTokensTable)
+}
+
+// CreateTable, refresh_tokens tablosunu yoksa oluşturur.
+func (s *DatabaseRefreshTokenStore) CreateTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS refresh_tokens (
+			id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+			user_id BIGINT UNSIGNED NOT NULL,
+			family_id VARCHAR(64) NOT NULL,
+			token_hash CHAR(64) NOT NULL,
+			user_agent VARCHAR(512) NOT NULL DEFAULT '',
+			ip_address VARCHAR(45) NOT NULL DEFAULT '',
+			expires_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP NULL DEFAULT NULL,
+			revoked_at TIMESTAMP NULL DEFAULT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE KEY refresh_tokens_token_hash_unique (token_hash),
+			INDEX refresh_tokens_family_id_index (family_id),
+			INDEX refresh_tokens_user_id_index (user_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`)
+	if err != nil {
+		return fmt.Errorf("refresh_tokens tablosu oluşturulamadı: %w", err)
+	}
+	return nil
+}
+
+// Create, yeni kaydı ekler.
+func (s *DatabaseRefreshTokenStore) Create(token *RefreshToken) error {
+	result, err := s.newBuilder().ExecInsert(map[string]interface{}{
+		"user_id":    token.UserID,
+		"family_id":  token.FamilyID,
+		"token_hash": token.TokenHash,
+		"user_agent": truncate(token.UserAgent, 512),
+		"ip_address": truncate(token.IPAddress, 45),
+		"expires_at": token.ExpiresAt,
+		"created_at": token.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	token.ID, err = result.LastInsertId()
+	return err
+}
+
+// FindByHash, token hash'i ile kaydı döndürür.
+func (s *DatabaseRefreshTokenStore) FindByHash(hash string) (*RefreshToken, error) {
+	var token RefreshToken
+	err := s.newBuilder().Where("token_hash", "=", hash).First(&token)
+	if err == sql.ErrNoRows {
+		return nil, ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkUsed, kaydı kullanıldı olarak işaretler (koşullu UPDATE ile atomik).
+func (s *DatabaseRefreshTokenStore) MarkUsed(id int64, at time.Time) (bool, error) {
+	result, err := s.newBuilder().
+		Where("id", "=", id).
+		WhereNull("used_at").
+		WhereNull("revoked_at").
+		ExecUpdate(map[string]interface{}{"used_at": at})
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// RevokeFamily, ailenin iptal edilmemiş kayıtlarını iptal eder.
+func (s *DatabaseRefreshTokenStore) RevokeFamily(familyID string) error {
+	_, err := s.newBuilder().
+		Where("family_id", "=", familyID).
+		WhereNull("revoked_at").
+		ExecUpdate(map[string]interface{}{"revoked_at": time.Now()})
+	return err
+}
+
+// RevokeUser, kullanıcının iptal edilmemiş kayıtlarını iptal eder.
+func (s *DatabaseRefreshTokenStore) RevokeUser(userID int64) error {
+	_, err := s.newBuilder().
+		Where("user_id", "=", userID).
+		WhereNull("revoked_at").
+		ExecUpdate(map[string]interface{}{"revoked_at": time.Now()})
+	return err
+}
+
+// PruneExpired, süresi dolmuş kayıtları siler.
+func (s *DatabaseRefreshTokenStore) PruneExpired() (int64, error) {
+	result, err := s.newBuilder().Where("expires_at", "<", time.Now()).ExecDelete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// truncate, değeri kolon uzunluğuna kısaltır.
+func truncate(value string, max int) string {
+	if len(value) > max {
+		return value[:max]
+	}
+	return value
+}
+
+// MemoryRefreshTokenStore, refresh token'ları bellekte saklar (test için).
+type MemoryRefreshTokenStore struct {
+	mu     sync.Mutex
+	nextID int64
+	tokens map[int64]*RefreshToken
+}
+
+// NewMemoryRefreshTokenStore, yeni bir MemoryRefreshTokenStore oluşturur.
+func NewMemoryRefreshTokenStore() *MemoryRefreshTokenStore {
+	return &MemoryRefreshTokenStore{
+		tokens: make(map[int64]*RefreshToken),
+	}
+}
+
+// Create, yeni kaydı ekler.
+func (s *MemoryRefreshTokenStore) Create(token *RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	token.ID = s.nextID
+	stored := *token
+	s.tokens[token.ID] = &stored
+	return nil
+}
+
+// FindByHash, token hash'i ile kaydın kopyasını döndürür.
+func (s *MemoryRefreshTokenStore) FindByHash(hash string) (*RefreshToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, token := range s.tokens {
+		if token.TokenHash == hash {
+			copied := *token
+			return &copied, nil
+		}
+	}
+	return nil, ErrRefreshTokenNotFound
+}
+
+// MarkUsed, kaydı kullanıldı olarak işaretler.
+func (s *MemoryRefreshTokenStore) MarkUsed(id int64, at time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[id]
+	if !ok || token.UsedAt != nil || token.RevokedAt != nil {
+		return false, nil
+	}
+	token.UsedAt = &at
+	return true, nil
+}
+
+// RevokeFamily, ailenin kayıtlarını iptal eder.
+func (s *MemoryRefreshTokenStore) RevokeFamily(familyID string) error {
+	return s.revokeWhere(func(token *RefreshToken) bool { return token.FamilyID == familyID })
+}
+
+// RevokeUser, kullanıcının kayıtlarını iptal eder.
+func (s *MemoryRefreshTokenStore) RevokeUser(userID int64) error {
+	return s.revokeWhere(func(token *RefreshToken) bool { return token.UserID == userID })
+}
+
+// revokeWhere, koşula uyan iptal edilmemiş kayıtları iptal eder.
+func (s *MemoryRefreshTokenStore) revokeWhere(match func(*RefreshToken) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, token := range s.tokens {
+		if token.RevokedAt == nil && match(token) {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
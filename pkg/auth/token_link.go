@@ -0,0 +1,26 @@
+package auth
+
+import "net/url"
+
+// TokenLink, token'ı base adresine ?token= query parametresi olarak ekler.
+// Adreste mevcut query parametreleri korunur; token her durumda escape
+// edilir.
+//
+// Şifre sıfırlama, davet ve magic link email'leri linklerini bununla
+// oluşturur.
+//
+// Örnek:
+//
+//	auth.TokenLink("https://app.com/reset-password?lang=tr", token)
+//	// https://app.com/reset-password?lang=tr&token=...
+func TokenLink(base, token string) string {
+	link, err := url.Parse(base)
+	if err != nil {
+		return base + "?token=" + url.QueryEscape(token)
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
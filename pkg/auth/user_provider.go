@@ -0,0 +1,146 @@
+// -----------------------------------------------------------------------------
+// User Provider
+// -----------------------------------------------------------------------------
+// UserProvider, authentication'ın kullanıcıları nereden yüklediğini soyutlar.
+// Laravel'deki UserProvider (EloquentUserProvider, DatabaseUserProvider)
+// konseptine benzer.
+//
+// Varsayılan implementasyon users tablosunu kullanır (models.UserRepository).
+// Kullanıcıları LDAP, harici bir API veya farklı bir veritabanında tutan
+// uygulamalar kendi provider'larını container'a kaydeder; middleware ve
+// controller'lar değiştirilmeden bu provider'ı kullanır:
+//
+//	c.Register(func(c *container.Container) (auth.UserProvider, error) {
+//	    return ldapprovider.New(ldapConfig), nil
+//	})
+//
+//	// main.go
+//	auth.SetUserProvider(provider)
+// -----------------------------------------------------------------------------
+
+package auth
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrUserNotFound, provider'da kullanıcı bulunamadığında döner.
+	ErrUserNotFound = errors.New("kullanıcı bulunamadı")
+
+	// ErrInvalidCredentials, kullanıcı bulunamadığında veya şifre yanlış
+	// olduğunda döner (hangisi olduğu belli edilmez).
+	ErrInvalidCredentials = errors.New("email veya şifre hatalı")
+
+	// ErrEmailLookupUnsupported, provider EmailUserProvider'ı implement
+	// etmiyorsa RetrieveByEmail tarafından döner.
+	ErrEmailLookupUnsupported = errors.New("user provider email ile arama desteklemiyor")
+)
+
+// Credentials, login sırasında gönderilen kimlik bilgileridir
+// (örn: "email" ve "password"; LDAP için "username" ve "password").
+type Credentials map[string]string
+
+// UserProvider, kullanıcıları yükleyen arayüzdür.
+type UserProvider interface {
+	// RetrieveByID, ID ile kullanıcıyı döndürür (yoksa ErrUserNotFound).
+	// Auth middleware'i token'daki user_id ile kullanıcıyı bu metodla yükler.
+	RetrieveByID(id int64) (User, error)
+
+	// RetrieveByCredentials, kimlik bilgileri doğruysa kullanıcıyı döndürür
+	// (değilse ErrInvalidCredentials). Şifre kontrolü provider'ın
+	// sorumluluğundadır; böylece LDAP bind gibi şifrenin uygulamada
+	// saklanmadığı yöntemler de desteklenir.
+	RetrieveByCredentials(credentials Credentials) (User, error)
+}
+
+// EmailUserProvider, kullanıcıyı şifre doğrulamadan email ile bulabilen
+// provider'ların implement ettiği opsiyonel arayüzdür. Magic link ve OIDC
+// girişleri kullanıcıyı bununla bulur.
+type EmailUserProvider interface {
+	// RetrieveByEmail, email ile kullanıcıyı döndürür (yoksa ErrUserNotFound).
+	RetrieveByEmail(email string) (User, error)
+}
+
+// RetrieveByEmail, provider EmailUserProvider'ı implement ediyorsa email
+// ile kullanıcıyı döndürür; etmiyorsa ErrEmailLookupUnsupported döner.
+//
+// Örnek:
+//
+//	user, err := auth.RetrieveByEmail(provider, "john@example.com")
+//	if errors.Is(err, auth.ErrUserNotFound) { ... }
+func RetrieveByEmail(provider UserProvider, email string) (User, error) {
+	lookup, ok := provider.(EmailUserProvider)
+	if !ok {
+		return nil, ErrEmailLookupUnsupported
+	}
+	return lookup.RetrieveByEmail(email)
+}
+
+// ActiveUser, hesabı pasifleştirilebilen kullanıcıların implement ettiği
+// opsiyonel arayüzdür. Implement etmeyen kullanıcılar aktif sayılır.
+type ActiveUser interface {
+	IsActive() bool
+}
+
+// IsActiveUser, kullanıcının aktif olup olmadığını döndürür.
+func IsActiveUser(user User) bool {
+	if active, ok := user.(ActiveUser); ok {
+		return active.IsActive()
+	}
+	return true
+}
+
+// Global user provider
+var (
+	userProvider   UserProvider
+	userProviderMu sync.RWMutex
+)
+
+// SetUserProvider, middleware'lerin kullanıcıyı yükleyeceği provider'ı
+// ayarlar.
+//
+// Provider ayarlanmamışsa (nil) middleware.Auth() kullanıcıyı yüklemez;
+// context'teki user token claims'inden oluşturulur.
+//
+// Örnek:
+//
+//	provider := c.MustGet(reflect.TypeOf((*auth.UserProvider)(nil)).Elem()).(auth.UserProvider)
+//	auth.SetUserProvider(provider)
+func SetUserProvider(provider UserProvider) {
+	userProviderMu.Lock()
+	defer userProviderMu.Unlock()
+
+	userProvider = provider
+}
+
+// GetUserProvider, ayarlanmış provider'ı döndürür (ayarlanmamışsa nil).
+func GetUserProvider() UserProvider {
+	userProviderMu.RLock()
+	defer userProviderMu.RUnlock()
+
+	return userProvider
+}
+
+// UserFromClaims, token'ın ait olduğu kullanıcıyı döndürür.
+//
+// Provider ayarlıysa kullanıcı provider'dan yüklenir (silinmiş kullanıcı
+// için ErrUserNotFound); değilse claims'ten AuthenticatedUser oluşturulur.
+//
+// Örnek:
+//
+//	claims, _ := auth.ParseToken(token, nil)
+//	user, err := auth.UserFromClaims(claims)
+func UserFromClaims(claims *JWTClaims) (User, error) {
+	if provider := GetUserProvider(); provider != nil {
+		return provider.RetrieveByID(claims.UserID)
+	}
+
+	return &AuthenticatedUser{
+		ID:             claims.UserID,
+		Email:          claims.Email,
+		Role:           claims.Role,
+		ImpersonatorID: claims.ImpersonatorID,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// -----------------------------------------------------------------------------
+// Broadcasting
+// -----------------------------------------------------------------------------
+// Bu package, events.ShouldBroadcast event'lerini isimli kanallar üzerinden
+// bağlı client'lara (SSE, WebSocket) iletir. Yapı iki parçadan oluşur:
+//
+//   - Broadcaster (events.Broadcaster): Dispatcher'ın event'i yayınladığı
+//     driver. RedisBroadcaster mesajı Redis pub/sub'a yazar; Hub aynı
+//     process'teki client'lara doğrudan iletir; LogBroadcaster sadece loglar.
+//   - Hub: Kanal abonelikleri ve private kanal yetkilendirmesi. Redis
+//     driver'ında her instance RedisBroadcaster.Listen ile Redis'teki
+//     mesajları kendi Hub'ına aktarır; böylece hangi instance yayınlarsa
+//     yayınlasın tüm client'lar mesajı alır.
+//
+// Kanal adları "private-" ile başlıyorsa private'tır; abone olmak için
+// Hub.Channel ile tanımlanmış bir yetkilendirme callback'inin kullanıcıyı
+// kabul etmesi gerekir. Diğer kanallar herkese açıktır.
+//
+// Kullanım:
+//
+//	hub := broadcast.NewHub(logger)
+//	hub.Channel("private-orders.{userID}", func(user auth.User, params map[string]string) bool {
+//	    return strconv.FormatInt(user.GetID(), 10) == params["userID"]
+//	})
+//
+//	broadcaster := broadcast.NewRedisBroadcaster(client, "conduit:broadcast:", logger)
+//	go broadcaster.Listen(ctx, hub)
+//	dispatcher.SetBroadcaster(broadcaster)
+// -----------------------------------------------------------------------------
+
+package broadcast
+
+import (
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// PrivatePrefix, yetkilendirme gerektiren kanalların önekidir.
+const PrivatePrefix = "private-"
+
+// Message, bir kanala yayınlanan event'tir.
+type Message struct {
+	Channel string      `json:"channel"`
+	Event   string      `json:"event"`
+	Data    interface{} `json:"data"` // Redis'ten gelen mesajlarda json.RawMessage
+}
+
+// IsPrivate, kanalın yetkilendirme gerektirip gerektirmediğini döndürür.
+func IsPrivate(channel string) bool {
+	return strings.HasPrefix(channel, PrivatePrefix)
+}
+
+// LogBroadcaster, yayınları sadece loglar (development ve debug için).
+type LogBroadcaster struct {
+	logger *log.Logger
+}
+
+// NewLogBroadcaster, yeni bir LogBroadcaster oluşturur.
+func NewLogBroadcaster(logger *log.Logger) *LogBroadcaster {
+	return &LogBroadcaster{logger: logger}
+}
+
+// Broadcast, yayını loglar.
+func (b *LogBroadcaster) Broadcast(channels []string, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	b.logger.Printf("📡 [broadcast] %s → %s: %s", event, strings.Join(channels, ", "), payload)
+	return nil
+}
+
+// NullBroadcaster, yayınları yok sayar.
+type NullBroadcaster struct{}
+
+// Broadcast, hiçbir şey yapmaz.
+func (NullBroadcaster) Broadcast(channels []string, event string, data interface{}) error {
+	return nil
+}
+
+// Broadcaster'ların events.Broadcaster olduğunu derleme zamanında doğrula
+var (
+	_ events.Broadcaster = (*Hub)(nil)
+	_ events.Broadcaster = (*RedisBroadcaster)(nil)
+	_ events.Broadcaster = (*LogBroadcaster)(nil)
+	_ events.Broadcaster = NullBroadcaster{}
+)
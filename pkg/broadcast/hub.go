@@ -0,0 +1,309 @@
+// -----------------------------------------------------------------------------
+// Broadcast Hub
+// -----------------------------------------------------------------------------
+// Hub, bu process'e bağlı client'ların kanal aboneliklerini tutar ve
+// yayınlanan mesajları abonelere iletir. Transport'tan bağımsızdır: SSE
+// controller'ı ve WebSocket handler'ı Subscribe ile abonelik açar,
+// Messages() kanalından okuduklarını client'a yazar.
+//
+// Yavaş client'lar yayını bloklamaz; aboneliğin buffer'ı doluysa mesaj o
+// abone için düşürülür.
+// -----------------------------------------------------------------------------
+
+package broadcast
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+)
+
+// DefaultSubscriptionBuffer, abonelik başına bekleyebilecek mesaj sayısıdır.
+const DefaultSubscriptionBuffer = 64
+
+// ErrUnauthorized, kullanıcı private kanala abone olamadığında döner.
+var ErrUnauthorized = errors.New("kanala abone olma yetkisi yok")
+
+// Authorizer, private kanal aboneliğini yetkilendirir.
+//
+// Parametreler:
+//   - user: İsteği yapan kullanıcı (nil olmaz; guest'ler private kanallara
+//     abone olamaz)
+//   - params: Kanal pattern'indeki parametreler ("private-orders.{id}" →
+//     params["id"])
+//
+// Döndürür:
+//   - bool: Abone olabiliyorsa true
+type Authorizer func(user auth.User, params map[string]string) bool
+
+// channelRoute, Hub.Channel ile tanımlanmış kanal pattern'idir.
+type channelRoute struct {
+	pattern   string
+	segments  []string
+	authorize Authorizer
+}
+
+// Hub, kanal aboneliklerini yöneten local broadcaster'dır.
+type Hub struct {
+	mu          sync.RWMutex
+	routes      []channelRoute
+	subscribers map[string]map[*Subscription]struct{}
+	buffer      int
+	closed      bool
+	logger      *log.Logger
+}
+
+// NewHub, yeni bir Hub oluşturur.
+//
+// Örnek:
+//
+//	hub := broadcast.NewHub(logger)
+//	dispatcher.SetBroadcaster(hub) // Tek instance: Redis gerekmez
+func NewHub(logger *log.Logger) *Hub {
+	return &Hub{
+		subscribers: make(map[string]map[*Subscription]struct{}),
+		buffer:      DefaultSubscriptionBuffer,
+		logger:      logger,
+	}
+}
+
+// SetBuffer, yeni aboneliklerin mesaj buffer'ını ayarlar.
+func (h *Hub) SetBuffer(size int) *Hub {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if size > 0 {
+		h.buffer = size
+	}
+	return h
+}
+
+// Channel, private kanal pattern'i için yetkilendirme callback'i tanımlar.
+//
+// Pattern segmentleri "." ile ayrılır; {isim} segmenti herhangi bir
+// değerle eşleşir ve callback'e params olarak verilir. İlk eşleşen pattern
+// kullanılır.
+//
+// Parametreler:
+//   - pattern: Kanal pattern'i (örn: "private-orders.{id}")
+//   - authorize: Yetkilendirme callback'i
+//
+// Örnek:
+//
+//	hub.Channel("private-users.{id}", func(user auth.User, params map[string]string) bool {
+//	    return strconv.FormatInt(user.GetID(), 10) == params["id"]
+//	})
+//	hub.Channel("private-admin", func(user auth.User, _ map[string]string) bool {
+//	    return user.GetRole() == "admin"
+//	})
+func (h *Hub) Channel(pattern string, authorize Authorizer) *Hub {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.routes = append(h.routes, channelRoute{
+		pattern:   pattern,
+		segments:  strings.Split(pattern, "."),
+		authorize: authorize,
+	})
+	return h
+}
+
+// Authorize, kullanıcının kanala abone olup olamayacağını kontrol eder.
+//
+// Public kanallar herkese açıktır. Private kanallar için kullanıcı giriş
+// yapmış olmalı ve kanalla eşleşen pattern'in callback'i true dönmelidir;
+// eşleşen pattern yoksa abonelik reddedilir.
+//
+// Parametreler:
+//   - user: Kullanıcı (guest için nil)
+//   - channel: Kanal adı
+//
+// Döndürür:
+//   - error: Yetki yoksa ErrUnauthorized
+func (h *Hub) Authorize(user auth.User, channel string) error {
+	if !IsPrivate(channel) {
+		return nil
+	}
+	if user == nil {
+		return fmt.Errorf("%w: %s", ErrUnauthorized, channel)
+	}
+
+	h.mu.RLock()
+	routes := h.routes
+	h.mu.RUnlock()
+
+	segments := strings.Split(channel, ".")
+	for _, route := range routes {
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		if route.authorize(user, params) {
+			return nil
+		}
+		break
+	}
+	return fmt.Errorf("%w: %s", ErrUnauthorized, channel)
+}
+
+// match, kanal segmentlerini pattern ile karşılaştırır.
+func (r channelRoute) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(r.segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range r.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if segments[i] == "" {
+				return nil, false
+			}
+			params[segment[1:len(segment)-1]] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// Subscribe, kanallara abonelik açar. Yetkilendirme çağıranın
+// sorumluluğundadır (bkz: Authorize).
+//
+// Abonelik işi bitince Close ile kapatılmalıdır. Hub kapatılmışsa
+// Messages() kanalı hemen kapanır.
+//
+// Örnek:
+//
+//	sub := hub.Subscribe("orders", "private-users.42")
+//	defer sub.Close()
+//	for message := range sub.Messages() {
+//	    // client'a yaz
+//	}
+func (h *Hub) Subscribe(channels ...string) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &Subscription{
+		hub:      h,
+		channels: channels,
+		messages: make(chan Message, h.buffer),
+	}
+	if h.closed {
+		sub.closed = true
+		close(sub.messages)
+		return sub
+	}
+
+	for _, channel := range channels {
+		if h.subscribers[channel] == nil {
+			h.subscribers[channel] = make(map[*Subscription]struct{})
+		}
+		h.subscribers[channel][sub] = struct{}{}
+	}
+	return sub
+}
+
+// Publish, mesajı kanalın abonelerine iletir.
+//
+// Döndürür:
+//   - int: Mesajın iletildiği abone sayısı (buffer'ı dolu olanlar hariç)
+func (h *Hub) Publish(message Message) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	delivered := 0
+	for sub := range h.subscribers[message.Channel] {
+		select {
+		case sub.messages <- message:
+			delivered++
+		default:
+			h.logger.Printf("⚠️  Broadcast message dropped for slow subscriber (channel: %s, event: %s)", message.Channel, message.Event)
+		}
+	}
+	return delivered
+}
+
+// Broadcast, event'i kanallara yayınlar (events.Broadcaster). Tek
+// instance'lı kurulumlarda dispatcher'a doğrudan verilebilir.
+func (h *Hub) Broadcast(channels []string, event string, data interface{}) error {
+	for _, channel := range channels {
+		h.Publish(Message{Channel: channel, Event: event, Data: data})
+	}
+	return nil
+}
+
+// Subscribers, kanalın abone sayısını döndürür.
+func (h *Hub) Subscribers(channel string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.subscribers[channel])
+}
+
+// Close, tüm abonelikleri kapatır. Açık SSE/WebSocket bağlantıları
+// Messages() kanalı kapandığında sonlanır; graceful shutdown'da
+// http.Server.RegisterOnShutdown ile çağrılır.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			if !sub.closed {
+				sub.closed = true
+				close(sub.messages)
+			}
+		}
+	}
+	h.subscribers = make(map[string]map[*Subscription]struct{})
+}
+
+// Subscription, Hub üzerindeki bir kanal aboneliğidir.
+type Subscription struct {
+	hub      *Hub
+	channels []string
+	messages chan Message
+	closed   bool // hub.mu ile korunur
+}
+
+// Channels, abone olunan kanalları döndürür.
+func (s *Subscription) Channels() []string {
+	return s.channels
+}
+
+// Messages, aboneliğe gelen mesajları döndürür. Abonelik veya Hub
+// kapatıldığında kanal kapanır.
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Close, aboneliği kapatır. Birden fazla çağrılabilir.
+func (s *Subscription) Close() {
+	h := s.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	for _, channel := range s.channels {
+		delete(h.subscribers[channel], s)
+		if len(h.subscribers[channel]) == 0 {
+			delete(h.subscribers, channel)
+		}
+	}
+	close(s.messages)
+}
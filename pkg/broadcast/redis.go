@@ -0,0 +1,113 @@
+// -----------------------------------------------------------------------------
+// Redis Broadcaster
+// -----------------------------------------------------------------------------
+// Yayınları Redis pub/sub üzerinden tüm uygulama instance'larına dağıtır.
+// Her kanal Redis'te prefix+kanal adıyla PUBLISH edilir; her instance
+// Listen ile prefix'e PSUBSCRIBE olur ve gelen mesajları kendi Hub'ına
+// aktarır. Pub/sub kalıcı değildir: o anda bağlı olmayan client'lar
+// mesajı kaçırır.
+// -----------------------------------------------------------------------------
+
+package broadcast
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisBroadcaster, Redis pub/sub kullanan broadcaster'dır.
+type RedisBroadcaster struct {
+	client *redis.Client
+	prefix string
+	logger *log.Logger
+}
+
+// NewRedisBroadcaster, yeni bir RedisBroadcaster oluşturur.
+//
+// Parametreler:
+//   - client: Redis client
+//   - prefix: Redis kanal prefix'i (örn: "conduit:broadcast:")
+//   - logger: Log instance
+func NewRedisBroadcaster(client *redis.Client, prefix string, logger *log.Logger) *RedisBroadcaster {
+	return &RedisBroadcaster{client: client, prefix: prefix, logger: logger}
+}
+
+// redisMessage, Redis'te taşınan mesaj formatıdır.
+type redisMessage struct {
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// Broadcast, event'i kanallara PUBLISH eder.
+func (b *RedisBroadcaster) Broadcast(channels []string, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("broadcast verisi serialize edilemedi (%s): %w", event, err)
+	}
+	message, err := json.Marshal(redisMessage{Event: event, Data: payload})
+	if err != nil {
+		return fmt.Errorf("broadcast mesajı serialize edilemedi (%s): %w", event, err)
+	}
+
+	ctx := context.Background()
+	pipe := b.client.Pipeline()
+	for _, channel := range channels {
+		pipe.Publish(ctx, b.prefix+channel, message)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("broadcast redis'e yazılamadı (%s): %w", event, err)
+	}
+	return nil
+}
+
+// Listen, prefix'teki tüm Redis kanallarını dinler ve gelen mesajları
+// hub'a aktarır. ctx iptal edilene kadar bloklar; goroutine'de çalıştırılır.
+//
+// Örnek:
+//
+//	go func() {
+//	    if err := broadcaster.Listen(ctx, hub); err != nil {
+//	        logger.Printf("❌ %v", err)
+//	    }
+//	}()
+func (b *RedisBroadcaster) Listen(ctx context.Context, hub *Hub) error {
+	pubsub := b.client.PSubscribe(ctx, b.prefix+"*")
+	defer pubsub.Close()
+
+	if _, err := pubsub.Receive(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("broadcast redis aboneliği başarısız: %w", err)
+	}
+	b.logger.Printf("✅ Broadcast listener started (pattern: %s*)", b.prefix)
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case raw, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			var message redisMessage
+			if err := json.Unmarshal([]byte(raw.Payload), &message); err != nil {
+				b.logger.Printf("⚠️  Invalid broadcast message on %s: %v", raw.Channel, err)
+				continue
+			}
+
+			hub.Publish(Message{
+				Channel: strings.TrimPrefix(raw.Channel, b.prefix),
+				Event:   message.Event,
+				Data:    message.Data,
+			})
+		}
+	}
+}
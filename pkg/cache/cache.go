@@ -10,8 +10,11 @@
 // - Get/Set/Delete operations
 // - TTL (Time To Live) support
 // - Remember pattern (cache or execute)
+// - Pull/Add/Forever (atomic get+delete, set-if-absent, süresiz yazma)
+// - GetWithTTL/Touch (kalan süreyi okuma, sliding expiration)
 // - Increment/Decrement for counters
 // - Flush (clear all)
+// - Tags (grouped invalidation)
 // -----------------------------------------------------------------------------
 
 package cache
@@ -20,6 +23,9 @@ import (
 	"time"
 )
 
+// NoExpiry, GetWithTTL'in süresiz (Forever) key'ler için döndürdüğü kalan süre.
+const NoExpiry time.Duration = -1
+
 // Cache, tüm cache driver'ların implement etmesi gereken interface.
 //
 // Bu interface Laravel Cache facade pattern'ini takip eder.
@@ -68,7 +74,7 @@ type Cache interface {
 	//   err := cache.Set("user:123", user, 10*time.Minute)
 	//
 	// Güvenlik Notu:
-	// - Sensitive data cache'lemeden önce encrypt edilmeli
+	// - Sensitive data cache'lemeden önce encrypt edilmeli (bkz: NewEncrypted)
 	// - TTL mutlaka belirlenmeli (memory leak önlemek için)
 	Set(key string, value interface{}, ttl time.Duration) error
 
@@ -102,6 +108,97 @@ type Cache interface {
 	//   }
 	Has(key string) (bool, error)
 
+	// Pull, key'i okur ve cache'den siler (tek kullanımlık değerler için).
+	//
+	// Okuma ve silme atomic yapılır; aynı key'i eşzamanlı Pull eden iki
+	// çağrıdan sadece biri değeri alır.
+	//
+	// Parametreler:
+	//   - key: Cache anahtarı
+	//
+	// Döndürür:
+	//   - interface{}: Silinen değer (key yoksa nil)
+	//   - error: İşlem hatası
+	//
+	// Örnek:
+	//   code, err := cache.Pull("otp:user123")
+	Pull(key string) (interface{}, error)
+
+	// Add, key sadece cache'de YOKSA yazar.
+	//
+	// Kontrol ve yazma atomic yapılır (Redis: SET NX). Basit lock'lar,
+	// idempotency key'leri ve "ilk gelen kazanır" senaryoları için kullanılır.
+	//
+	// Parametreler:
+	//   - key: Cache anahtarı
+	//   - value: Saklanacak değer
+	//   - ttl: Geçerlilik süresi (0 = süresiz)
+	//
+	// Döndürür:
+	//   - bool: Değer yazıldıysa true, key zaten varsa false
+	//   - error: İşlem hatası
+	//
+	// Örnek:
+	//   added, err := cache.Add("idempotency:"+requestID, true, time.Hour)
+	//   if !added {
+	//       // İstek daha önce işlenmiş
+	//   }
+	Add(key string, value interface{}, ttl time.Duration) (bool, error)
+
+	// Forever, değeri süresiz olarak cache'e yazar (Set(key, value, 0)).
+	//
+	// Değer sadece Delete veya Flush ile silinir.
+	//
+	// Parametreler:
+	//   - key: Cache anahtarı
+	//   - value: Saklanacak değer
+	//
+	// Döndürür:
+	//   - error: Yazma hatası
+	//
+	// Örnek:
+	//   err := cache.Forever("settings:site", settings)
+	Forever(key string, value interface{}) error
+
+	// GetWithTTL, değeri kalan geçerlilik süresiyle birlikte okur.
+	//
+	// Client'lara expiry göstermek (örn: "kodun süresi 4 dk sonra doluyor")
+	// veya sliding expiration kararları için kullanılır.
+	//
+	// Parametreler:
+	//   - key: Cache anahtarı
+	//
+	// Döndürür:
+	//   - interface{}: Cache'deki değer (key yoksa nil)
+	//   - time.Duration: Kalan süre (süresiz key'lerde NoExpiry, key yoksa 0)
+	//   - error: Okuma hatası
+	//
+	// Örnek:
+	//   value, remaining, err := cache.GetWithTTL("otp:user123")
+	//   if value != nil && remaining != cache.NoExpiry {
+	//       w.Header().Set("X-Expires-In", remaining.String())
+	//   }
+	GetWithTTL(key string) (interface{}, time.Duration, error)
+
+	// Touch, değeri yeniden yazmadan key'in TTL'ini günceller.
+	//
+	// Sliding expiration (her erişimde süreyi uzatma) için kullanılır.
+	// TTL = 0 ise key süresiz yapılır.
+	//
+	// Parametreler:
+	//   - key: Cache anahtarı
+	//   - ttl: Yeni geçerlilik süresi (şu andan itibaren)
+	//
+	// Döndürür:
+	//   - bool: Key bulunup güncellendiyse true
+	//   - error: İşlem hatası
+	//
+	// Örnek:
+	//   if ok, _ := cache.Touch("session:abc", 30*time.Minute); !ok {
+	//       // Session süresi dolmuş
+	//   }
+	Touch(key string, ttl time.Duration) (bool, error)
+
 	// Remember, cache'den okur, bulamazsa fonksiyonu çalıştırıp cache'ler.
 	//
 	// Bu Laravel'in en popüler pattern'lerinden biri:
@@ -121,9 +218,13 @@ type Cache interface {
 	//       return userRepo.GetAll()
 	//   })
 	//
+	// Stampede Koruması:
+	// - Aynı key için eşzamanlı miss'lerde callback process içinde tek kez çalışır
+	// - Redis driver'da SetStampedeOptions ile distributed lock ve stale
+	//   servis açılabilir (bkz: stampede.go)
+	//
 	// Güvenlik Notu:
 	// - Callback fonksiyonu thread-safe olmalı
-	// - Race condition'a karşı dikkatli olunmalı
 	Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error)
 
 	// Increment, sayısal değeri artırır.
@@ -218,6 +319,23 @@ type Cache interface {
 	// Örnek:
 	//   err := cache.DeleteMultiple([]string{"user:1", "user:2"})
 	DeleteMultiple(keys []string) error
+
+	// Tags, verilen tag'lere bağlı bir cache instance'ı döndürür.
+	//
+	// Tag'li yazılan key'ler, tag üzerinden toplu olarak silinebilir.
+	// Böylece ilişkili veriler için tüm cache'i flush etmek gerekmez.
+	//
+	// Parametreler:
+	//   - names: Tag isimleri
+	//
+	// Döndürür:
+	//   - *TaggedCache: Tag'li cache instance
+	//
+	// Örnek:
+	//   cache.Tags("users", "profiles").Set("user:123", user, time.Hour)
+	//   value, _ := cache.Tags("users").Get("user:123")
+	//   cache.Tags("users").Flush() // Sadece "users" tag'li key'ler silinir
+	Tags(names ...string) *TaggedCache
 }
 
 // Stats, cache istatistikleri interface.
@@ -237,3 +355,22 @@ type Stats interface {
 	//   }
 	Stats() map[string]interface{}
 }
+
+// Pruner, expired girdileri elle temizleyebilen driver'lar için opsiyonel
+// interface.
+//
+// File ve Memory driver'ları implement eder. Redis key'leri TTL ile sunucu
+// tarafında silindiği için Redis driver'ında gerekmez.
+type Pruner interface {
+	// Prune, expired girdileri siler.
+	//
+	// Döndürür:
+	//   - int: Silinen girdi sayısı
+	//   - error: Temizleme hatası
+	//
+	// Örnek:
+	//   if p, ok := cache.(Pruner); ok {
+	//       removed, _ := p.Prune()
+	//   }
+	Prune() (int, error)
+}
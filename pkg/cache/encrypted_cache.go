@@ -0,0 +1,313 @@
+// -----------------------------------------------------------------------------
+// Encrypted Cache
+// -----------------------------------------------------------------------------
+// Başka bir cache driver'ını saran ve değerleri AES-GCM ile şifreleyerek
+// saklayan wrapper. Paylaşılan Redis sunucusunda veya file driver'da kişisel
+// veri (PII) ve session verisi cache'lenirken değerlerin düz metin olarak
+// durmasını engeller.
+//
+// Kullanım:
+//
+//	key, _ := hex.DecodeString(os.Getenv("CACHE_ENCRYPTION_KEY")) // 32 byte
+//	secure, err := cache.NewEncrypted(redisCache, key)
+//	secure.Set("session:abc", sessionData, time.Hour)
+//
+//	// Veya APP_KEY ile:
+//	encrypter, err := crypt.NewFromAppKey(cfg.App.Key)
+//	secure := cache.NewEncryptedWith(redisCache, encrypter)
+//
+// Format:
+// Değer önce serializer ile encode edilir, sonra şifrelenir ve inner cache'e
+// "enc:v1:<base64(nonce|ciphertext)>" string'i olarak yazılır. Cache key'i
+// GCM additional data olarak kullanılır; böylece bir key'in şifreli değeri
+// başka bir key'e kopyalanırsa çözülemez.
+//
+// Sınırlamalar:
+// - Key isimleri şifrelenmez (key'lere PII koymayın)
+// - Increment/Decrement atomic olmak zorunda olduğu için sayaçlar inner
+//   cache'te düz sayı olarak saklanır ve Get ile okunamaz
+// -----------------------------------------------------------------------------
+
+package cache
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/crypt"
+)
+
+// encryptedPrefix, şifreli değerlerin format/sürüm önekidir.
+const encryptedPrefix = "enc:v1:"
+
+// ErrNotEncrypted, inner cache'te şifrelenmemiş bir değer bulunduğunda döner.
+//
+// Şifreli store'a dışarıdan düz değer yazılması (veya sayaç key'inin Get ile
+// okunması) durumunda oluşur; değer güvenilmez kabul edilir.
+var ErrNotEncrypted = errors.New("cache değeri şifrelenmemiş")
+
+// EncryptedCache, değerleri AES-GCM ile şifreleyen cache wrapper'ı.
+type EncryptedCache struct {
+	serializerHolder
+	inner     Cache
+	encrypter *crypt.Encrypter
+}
+
+// NewEncrypted, inner cache'i şifreleyen yeni bir EncryptedCache oluşturur.
+//
+// Parametreler:
+//   - inner: Asıl cache driver'ı (Redis, File, Memory)
+//   - key: AES anahtarı; 16, 24 veya 32 byte (AES-128/192/256)
+//
+// Döndürür:
+//   - *EncryptedCache: Şifreli cache
+//   - error: Anahtar uzunluğu geçersizse hata
+//
+// Örnek:
+//
+//	secure, err := cache.NewEncrypted(fileCache, key)
+//	if err != nil {
+//	    log.Fatalf("Encrypted cache oluşturulamadı: %v", err)
+//	}
+func NewEncrypted(inner Cache, key []byte) (*EncryptedCache, error) {
+	encrypter, err := crypt.New(key)
+	if err != nil {
+		return nil, fmt.Errorf("cache şifreleme anahtarı: %w", err)
+	}
+	return NewEncryptedWith(inner, encrypter), nil
+}
+
+// NewEncryptedWith, verilen Encrypter'ı (örn: APP_KEY'den) kullanan bir
+// EncryptedCache oluşturur.
+func NewEncryptedWith(inner Cache, encrypter *crypt.Encrypter) *EncryptedCache {
+	return &EncryptedCache{
+		inner:     inner,
+		encrypter: encrypter,
+	}
+}
+
+// Inner, sarılan cache driver'ını döndürür.
+func (e *EncryptedCache) Inner() Cache {
+	return e.inner
+}
+
+// encrypt, değeri serialize edip şifreler.
+func (e *EncryptedCache) encrypt(key string, value interface{}) (string, error) {
+	plaintext, err := encodeValue(e.getSerializer(), value)
+	if err != nil {
+		return "", fmt.Errorf("cache value encode failed: %w", err)
+	}
+
+	sealed, err := e.encrypter.Encrypt(plaintext, []byte(key))
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt, inner cache'ten okunan değeri çözer ve deserialize eder.
+//
+// nil değer (cache miss) nil olarak döner.
+func (e *EncryptedCache) decrypt(key string, stored interface{}) (interface{}, error) {
+	if stored == nil {
+		return nil, nil
+	}
+
+	encoded, ok := stored.(string)
+	if !ok || !strings.HasPrefix(encoded, encryptedPrefix) {
+		return nil, fmt.Errorf("%w [%s]", ErrNotEncrypted, key)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded[len(encryptedPrefix):])
+	if err != nil {
+		return nil, fmt.Errorf("geçersiz şifreli cache değeri [%s]", key)
+	}
+
+	plaintext, err := e.encrypter.Decrypt(sealed, []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("cache değeri çözülemedi [%s]: %w", key, err)
+	}
+
+	return decodeValue(plaintext)
+}
+
+// Get, değeri okur ve çözer.
+func (e *EncryptedCache) Get(key string) (interface{}, error) {
+	stored, err := e.inner.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return e.decrypt(key, stored)
+}
+
+// Set, değeri şifreleyerek yazar.
+func (e *EncryptedCache) Set(key string, value interface{}, ttl time.Duration) error {
+	encrypted, err := e.encrypt(key, value)
+	if err != nil {
+		return err
+	}
+	return e.inner.Set(key, encrypted, ttl)
+}
+
+// Delete, key'i siler.
+func (e *EncryptedCache) Delete(key string) error {
+	return e.inner.Delete(key)
+}
+
+// Has, key'in varlığını kontrol eder.
+func (e *EncryptedCache) Has(key string) (bool, error) {
+	return e.inner.Has(key)
+}
+
+// Pull, değeri okur, siler ve çözer.
+func (e *EncryptedCache) Pull(key string) (interface{}, error) {
+	stored, err := e.inner.Pull(key)
+	if err != nil {
+		return nil, err
+	}
+	return e.decrypt(key, stored)
+}
+
+// Add, key yoksa değeri şifreleyerek yazar.
+func (e *EncryptedCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	encrypted, err := e.encrypt(key, value)
+	if err != nil {
+		return false, err
+	}
+	return e.inner.Add(key, encrypted, ttl)
+}
+
+// Forever, değeri şifreleyerek süresiz yazar.
+func (e *EncryptedCache) Forever(key string, value interface{}) error {
+	return e.Set(key, value, 0)
+}
+
+// GetWithTTL, değeri çözer ve kalan süresiyle birlikte döndürür.
+func (e *EncryptedCache) GetWithTTL(key string) (interface{}, time.Duration, error) {
+	stored, remaining, err := e.inner.GetWithTTL(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	value, err := e.decrypt(key, stored)
+	if err != nil {
+		return nil, 0, err
+	}
+	return value, remaining, nil
+}
+
+// Touch, key'in TTL'ini günceller (şifreli değer olduğu gibi kalır).
+func (e *EncryptedCache) Touch(key string, ttl time.Duration) (bool, error) {
+	return e.inner.Touch(key, ttl)
+}
+
+// Remember, cache'den okur veya callback'i çalıştırıp şifreli olarak cache'ler.
+//
+// Inner driver'ın Remember'ı kullanıldığı için stampede koruması korunur.
+// Callback çalıştıysa şifrelenmemiş orijinal değer döner.
+func (e *EncryptedCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	var computed interface{}
+	wasComputed := false
+
+	stored, err := e.inner.Remember(key, ttl, func() (interface{}, error) {
+		value, err := callback()
+		if err != nil {
+			return nil, err
+		}
+
+		computed = value
+		wasComputed = true
+		return e.encrypt(key, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if wasComputed {
+		return computed, nil
+	}
+	return e.decrypt(key, stored)
+}
+
+// Increment, sayacı artırır.
+//
+// Sayaçlar atomic işlem gerektirdiği için inner cache'te şifrelenmeden
+// saklanır.
+func (e *EncryptedCache) Increment(key string, value int64) (int64, error) {
+	return e.inner.Increment(key, value)
+}
+
+// Decrement, sayacı azaltır (şifrelenmeden saklanır, bkz: Increment).
+func (e *EncryptedCache) Decrement(key string, value int64) (int64, error) {
+	return e.inner.Decrement(key, value)
+}
+
+// Flush, inner cache'i tamamen temizler.
+func (e *EncryptedCache) Flush() error {
+	return e.inner.Flush()
+}
+
+// GetMultiple, birden fazla key'i okur ve çözer.
+func (e *EncryptedCache) GetMultiple(keys []string) (map[string]interface{}, error) {
+	stored, err := e.inner.GetMultiple(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{}, len(stored))
+	for key, value := range stored {
+		decrypted, err := e.decrypt(key, value)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = decrypted
+	}
+	return result, nil
+}
+
+// SetMultiple, birden fazla değeri şifreleyerek yazar.
+func (e *EncryptedCache) SetMultiple(values map[string]interface{}, ttl time.Duration) error {
+	encrypted := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		data, err := e.encrypt(key, value)
+		if err != nil {
+			return err
+		}
+		encrypted[key] = data
+	}
+	return e.inner.SetMultiple(encrypted, ttl)
+}
+
+// DeleteMultiple, birden fazla key'i siler.
+func (e *EncryptedCache) DeleteMultiple(keys []string) error {
+	return e.inner.DeleteMultiple(keys)
+}
+
+// Tags, şifreli yazan tag'li bir cache instance'ı döndürür.
+//
+// Tag index'i inner driver'ınki kullanılır; değerler yine şifrelenir.
+func (e *EncryptedCache) Tags(names ...string) *TaggedCache {
+	return newTaggedCache(e, e.inner.Tags(names...).index, names)
+}
+
+// Stats, inner driver istatistik destekliyorsa onları döndürür.
+func (e *EncryptedCache) Stats() map[string]interface{} {
+	stats := map[string]interface{}{"encrypted": true}
+	if s, ok := e.inner.(Stats); ok {
+		for key, value := range s.Stats() {
+			stats[key] = value
+		}
+	}
+	return stats
+}
+
+// Prune, inner driver destekliyorsa expired girdileri temizler.
+func (e *EncryptedCache) Prune() (int, error) {
+	if p, ok := e.inner.(Pruner); ok {
+		return p.Prune()
+	}
+	return 0, nil
+}
@@ -24,9 +24,21 @@ import (
 
 type FileCacheEntry struct {
 	Value     interface{} `json:"value"`
+	Data      []byte      `json:"data,omitempty"` // JSON dışı serializer çıktısı (format önekli)
 	ExpiresAt int64       `json:"expires_at"`
 }
 
+// decode, entry'nin değerini döndürür.
+//
+// JSON serializer ile yazılan entry'lerde değer doğrudan Value alanındadır;
+// diğer serializer'larda Data alanı format önekine göre decode edilir.
+func (e *FileCacheEntry) decode() (interface{}, error) {
+	if e.Data == nil {
+		return e.Value, nil
+	}
+	return decodeValue(e.Data)
+}
+
 type FileCache struct {
 	dir    string
 	logger *log.Logger
@@ -34,6 +46,9 @@ type FileCache struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
+
+	stampedeGuard    // Remember stampede koruması
+	serializerHolder // Değer serializer'ı (varsayılan: JSON)
 }
 
 // NewFileCache, yeni bir File cache instance oluşturur.
@@ -76,7 +91,7 @@ func (f *FileCache) garbageCollectionLoop() {
 	for {
 		select {
 		case <-ticker.C:
-			f.cleanExpiredFiles()
+			f.Prune()
 		case <-f.ctx.Done():
 			// Graceful shutdown
 			f.logger.Println("🛑 File cache garbage collector durduruluyor...")
@@ -162,7 +177,13 @@ func (f *FileCache) Get(key string) (interface{}, error) {
 	// Read lock'u bırak
 	f.mu.RUnlock()
 
-	return entry.Value, nil
+	value, err := entry.decode()
+	if err != nil {
+		f.logger.Printf("❌ Decode hatası [%s]: %v", key, err)
+		return nil, fmt.Errorf("file cache decode failed: %w", err)
+	}
+
+	return value, nil
 }
 
 // Set, cache'e veri yazar.
@@ -170,16 +191,36 @@ func (f *FileCache) Set(key string, value interface{}, ttl time.Duration) error
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
+	return f.write(key, value, ttl)
+}
+
+// write, entry'yi dosyaya yazar. Çağıran write lock'u tutmalıdır.
+func (f *FileCache) write(key string, value interface{}, ttl time.Duration) error {
 	var expiresAt int64 = 0
 	if ttl > 0 {
 		expiresAt = time.Now().Add(ttl).Unix()
 	}
 
-	entry := FileCacheEntry{
-		Value:     value,
-		ExpiresAt: expiresAt,
+	entry := FileCacheEntry{ExpiresAt: expiresAt}
+
+	// JSON (varsayılan) Value alanına, diğer serializer'lar Data alanına yazılır
+	serializer := f.getSerializer()
+	if serializer.Name() == SerializerJSON {
+		entry.Value = value
+	} else {
+		encoded, err := encodeValue(serializer, value)
+		if err != nil {
+			f.logger.Printf("❌ Encode hatası [%s]: %v", key, err)
+			return fmt.Errorf("cache encode failed: %w", err)
+		}
+		entry.Data = encoded
 	}
 
+	return f.writeEntry(key, entry)
+}
+
+// writeEntry, hazır entry'yi dosyaya yazar. Lock çağıran tarafından alınmalıdır.
+func (f *FileCache) writeEntry(key string, entry FileCacheEntry) error {
 	data, err := json.Marshal(entry)
 	if err != nil {
 		f.logger.Printf("❌ JSON encode hatası [%s]: %v", key, err)
@@ -196,6 +237,26 @@ func (f *FileCache) Set(key string, value interface{}, ttl time.Duration) error
 	return nil
 }
 
+// readLive, expire olmamış entry'yi okur. Çağıran lock'u tutmalıdır.
+//
+// Dosya yoksa, bozuksa veya expire olmuşsa ok=false döner.
+func (f *FileCache) readLive(path string) (entry FileCacheEntry, ok bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return entry, false
+	}
+
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return entry, false
+	}
+
+	if entry.ExpiresAt > 0 && time.Now().Unix() > entry.ExpiresAt {
+		return entry, false
+	}
+
+	return entry, true
+}
+
 // Delete, cache'den veri siler.
 func (f *FileCache) Delete(key string) error {
 	f.mu.Lock()
@@ -220,27 +281,96 @@ func (f *FileCache) Has(key string) (bool, error) {
 	return val != nil, nil
 }
 
-// Remember, cache'den okur veya callback'i çalıştırıp cache'ler.
-func (f *FileCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
-	val, err := f.Get(key)
-	if err != nil {
-		return nil, err
+// Pull, key'i okur ve dosyayı siler (write lock altında atomic).
+func (f *FileCache) Pull(key string) (interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := f.filePath(key)
+	entry, ok := f.readLive(path)
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		f.logger.Printf("❌ File cache silme hatası [%s]: %v", key, err)
+		return nil, fmt.Errorf("file cache delete failed: %w", err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+	return entry.decode()
+}
+
+// Add, key yoksa (veya expire olmuşsa) değeri yazar (write lock altında atomic).
+//
+// Not: Atomiklik process içindir; aynı dizini paylaşan birden fazla process
+// için Redis driver kullanılmalıdır.
+func (f *FileCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.readLive(f.filePath(key)); ok {
+		return false, nil
+	}
+
+	if err := f.write(key, value, ttl); err != nil {
+		return false, err
 	}
+	return true, nil
+}
+
+// Forever, değeri süresiz olarak yazar.
+func (f *FileCache) Forever(key string, value interface{}) error {
+	return f.Set(key, value, 0)
+}
+
+// GetWithTTL, değeri kalan süresiyle birlikte okur.
+//
+// Expire zamanı saniye hassasiyetiyle saklandığı için kalan süre de saniyeye
+// yuvarlanır.
+func (f *FileCache) GetWithTTL(key string) (interface{}, time.Duration, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
 
-	if val != nil {
-		return val, nil
+	entry, ok := f.readLive(f.filePath(key))
+	if !ok {
+		return nil, 0, nil
 	}
 
-	result, err := callback()
+	value, err := entry.decode()
 	if err != nil {
-		return nil, err
+		return nil, 0, fmt.Errorf("cache decode failed: %w", err)
 	}
 
-	if err := f.Set(key, result, ttl); err != nil {
-		f.logger.Printf("⚠️  Remember cache yazma hatası [%s]: %v", key, err)
+	if entry.ExpiresAt == 0 {
+		return value, NoExpiry, nil
 	}
+	return value, time.Until(time.Unix(entry.ExpiresAt, 0)), nil
+}
 
-	return result, nil
+// Touch, key'in TTL'ini değeri yeniden encode etmeden günceller.
+func (f *FileCache) Touch(key string, ttl time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry, ok := f.readLive(f.filePath(key))
+	if !ok {
+		return false, nil
+	}
+
+	entry.ExpiresAt = 0
+	if ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(ttl).Unix()
+	}
+
+	if err := f.writeEntry(key, entry); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Remember, cache'den okur veya callback'i çalıştırıp cache'ler.
+func (f *FileCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	return f.remember(f, nil, f.logger, key, ttl, callback)
 }
 
 // Increment, sayısal değeri artırır.
@@ -248,21 +378,21 @@ func (f *FileCache) Increment(key string, value int64) (int64, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	currentVal, err := f.Get(key)
-	if err != nil {
-		return 0, err
-	}
-
+	// Lock zaten alınmış; Get/Set yerine lock'suz yardımcılar kullanılır
 	var current int64 = 0
-	if currentVal != nil {
-		if floatVal, ok := currentVal.(float64); ok {
-			current = int64(floatVal)
+	if entry, ok := f.readLive(f.filePath(key)); ok {
+		currentVal, _ := entry.decode()
+		switch v := currentVal.(type) {
+		case float64: // JSON
+			current = int64(v)
+		case int64: // gob, msgpack
+			current = v
 		}
 	}
 
 	newVal := current + value
 
-	if err := f.Set(key, newVal, 0); err != nil {
+	if err := f.write(key, newVal, 0); err != nil {
 		return 0, err
 	}
 
@@ -328,6 +458,94 @@ func (f *FileCache) DeleteMultiple(keys []string) error {
 	return nil
 }
 
+// Tags, verilen tag'lere bağlı bir cache instance'ı döndürür.
+//
+// Tag üyelikleri, her tag için ayrı bir index dosyasında
+// (normal cache entry formatında, süresiz) tutulur.
+func (f *FileCache) Tags(names ...string) *TaggedCache {
+	return newTaggedCache(f, f, names)
+}
+
+// tagIndexPath, tag'in index dosyasının yolunu döndürür.
+func (f *FileCache) tagIndexPath(tag string) string {
+	return f.filePath("tag:" + tag + ":keys")
+}
+
+// readTagIndex, tag index dosyasını okur. Lock çağıran tarafından alınmalıdır.
+func (f *FileCache) readTagIndex(tag string) ([]string, error) {
+	data, err := os.ReadFile(f.tagIndexPath(tag))
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tag index read failed: %w", err)
+	}
+
+	var entry struct {
+		Value []string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		// Corrupt index - boş kabul et
+		return []string{}, nil
+	}
+
+	return entry.Value, nil
+}
+
+// addTagMembers, key'leri tag index dosyasına ekler.
+func (f *FileCache) addTagMembers(tag string, keys ...string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	members, err := f.readTagIndex(tag)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(members))
+	for _, key := range members {
+		seen[key] = struct{}{}
+	}
+	for _, key := range keys {
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			members = append(members, key)
+		}
+	}
+
+	data, err := json.Marshal(FileCacheEntry{Value: members})
+	if err != nil {
+		return fmt.Errorf("json encode failed: %w", err)
+	}
+
+	if err := os.WriteFile(f.tagIndexPath(tag), data, 0644); err != nil {
+		f.logger.Printf("❌ Tag index yazma hatası [%s]: %v", tag, err)
+		return fmt.Errorf("tag index write failed: %w", err)
+	}
+
+	return nil
+}
+
+// tagMembers, tag index dosyasındaki key'leri döndürür.
+func (f *FileCache) tagMembers(tag string) ([]string, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.readTagIndex(tag)
+}
+
+// forgetTag, tag index dosyasını siler.
+func (f *FileCache) forgetTag(tag string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.Remove(f.tagIndexPath(tag)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("tag index delete failed: %w", err)
+	}
+
+	return nil
+}
+
 // Stats, file cache istatistiklerini döndürür.
 func (f *FileCache) Stats() map[string]interface{} {
 	f.mu.RLock()
@@ -355,8 +573,15 @@ func (f *FileCache) Stats() map[string]interface{} {
 	}
 }
 
-// cleanExpiredFiles, expired dosyaları temizler.
-func (f *FileCache) cleanExpiredFiles() {
+// Prune, expired ve bozuk cache dosyalarını siler.
+//
+// Garbage collector tarafından periyodik olarak çağrılır; `conduit cache:prune`
+// ile elle de tetiklenebilir.
+//
+// Döndürür:
+//   - int: Silinen dosya sayısı
+//   - error: Dizin tarama hatası
+func (f *FileCache) Prune() (int, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
@@ -392,7 +617,12 @@ func (f *FileCache) cleanExpiredFiles() {
 		return nil
 	})
 
-	if err == nil && cleaned > 0 {
+	if err != nil {
+		return cleaned, fmt.Errorf("cache directory walk failed: %w", err)
+	}
+
+	if cleaned > 0 {
 		f.logger.Printf("🧹 Garbage collection: %d expired file silindi", cleaned)
 	}
+	return cleaned, nil
 }
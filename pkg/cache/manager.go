@@ -0,0 +1,246 @@
+// -----------------------------------------------------------------------------
+// Cache Manager (Named Stores)
+// -----------------------------------------------------------------------------
+// Tek bir global Cache yerine, farklı amaçlar için ayrı store'lar tanımlamayı
+// sağlar. Her store'un kendi driver'ı, key prefix'i ve varsayılan TTL'i olabilir:
+//
+//	sessions  -> redis, prefix "conduit:sessions:", TTL 2 saat
+//	responses -> memory, TTL 30 saniye
+//
+// Kullanım:
+//
+//	cache.Store("sessions").Set("abc", data, 0) // 0 -> store'un varsayılan TTL'i
+//	manager := container.GetCacheManager(c)
+//	manager.Store("responses").Remember(...)
+//
+// Store'lar uygulama başlatılırken config'den (CACHE_STORES) oluşturulur ve
+// Manager container'a kaydedilir.
+// -----------------------------------------------------------------------------
+
+package cache
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultStore, Manager'daki varsayılan store'un adı.
+const DefaultStore = "default"
+
+// StoreConfig, NewStore ile oluşturulacak bir store'un ayarlarıdır.
+type StoreConfig struct {
+	Driver     string        // redis, file, memory
+	Prefix     string        // Redis key prefix'i
+	FileDir    string        // File driver dizini
+	Serializer string        // json, gob, msgpack (redis/file)
+	TTL        time.Duration // Varsayılan TTL (0 = yok)
+	Limits     MemoryLimits  // Memory driver boyut limitleri
+}
+
+// NewStore, config'e göre yeni bir store oluşturur.
+//
+// Redis driver'ı için mevcut client paylaşılır; store'lar prefix ile ayrılır.
+// TTL verilmişse store, WithDefaultTTL ile sarılır.
+//
+// Parametreler:
+//   - cfg: Store ayarları
+//   - client: Redis client (sadece redis driver'ı için gerekli)
+//   - logger: Log instance
+//
+// Döndürür:
+//   - Cache: Oluşturulan store
+//   - error: Geçersiz driver veya oluşturma hatası
+func NewStore(cfg StoreConfig, client *redis.Client, logger *log.Logger) (Cache, error) {
+	serializer, err := SerializerByName(cfg.Serializer)
+	if err != nil {
+		return nil, err
+	}
+
+	var store Cache
+	switch cfg.Driver {
+	case "redis":
+		if client == nil {
+			return nil, fmt.Errorf("redis store için redis client gerekli")
+		}
+		redisCache := NewRedisCache(client, logger, cfg.Prefix)
+		redisCache.SetSerializer(serializer)
+		store = redisCache
+
+	case "file":
+		fileCache, err := NewFileCache(filepath.Clean(cfg.FileDir), logger)
+		if err != nil {
+			return nil, err
+		}
+		fileCache.SetSerializer(serializer)
+		store = fileCache
+
+	case "memory":
+		memoryCache := NewMemoryCache(logger)
+		memoryCache.SetLimits(cfg.Limits)
+		store = memoryCache
+
+	default:
+		return nil, fmt.Errorf("geçersiz cache driver: %s", cfg.Driver)
+	}
+
+	if cfg.TTL > 0 {
+		store = WithDefaultTTL(store, cfg.TTL)
+	}
+	return store, nil
+}
+
+// defaultTTLStore, TTL verilmeyen yazmalarda store'un varsayılan TTL'ini
+// kullanan wrapper.
+type defaultTTLStore struct {
+	Cache
+	ttl time.Duration
+}
+
+// WithDefaultTTL, store'u varsayılan TTL ile sarar.
+//
+// Set, Add, Remember ve SetMultiple çağrılarında ttl = 0 verilirse varsayılan
+// TTL uygulanır. Süresiz yazmak için Forever kullanılmalıdır.
+//
+// Örnek:
+//
+//	sessions := cache.WithDefaultTTL(redisCache, 2*time.Hour)
+//	sessions.Set("abc", data, 0) // 2 saat
+func WithDefaultTTL(store Cache, ttl time.Duration) Cache {
+	return &defaultTTLStore{Cache: store, ttl: ttl}
+}
+
+func (d *defaultTTLStore) resolve(ttl time.Duration) time.Duration {
+	if ttl == 0 {
+		return d.ttl
+	}
+	return ttl
+}
+
+// Set, ttl = 0 ise varsayılan TTL ile yazar.
+func (d *defaultTTLStore) Set(key string, value interface{}, ttl time.Duration) error {
+	return d.Cache.Set(key, value, d.resolve(ttl))
+}
+
+// Add, ttl = 0 ise varsayılan TTL ile yazar.
+func (d *defaultTTLStore) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	return d.Cache.Add(key, value, d.resolve(ttl))
+}
+
+// Remember, ttl = 0 ise varsayılan TTL ile cache'ler.
+func (d *defaultTTLStore) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	return d.Cache.Remember(key, d.resolve(ttl), callback)
+}
+
+// SetMultiple, ttl = 0 ise varsayılan TTL ile yazar.
+func (d *defaultTTLStore) SetMultiple(values map[string]interface{}, ttl time.Duration) error {
+	return d.Cache.SetMultiple(values, d.resolve(ttl))
+}
+
+// Tags, yazmaları yine varsayılan TTL'den geçiren tag'li cache döndürür.
+func (d *defaultTTLStore) Tags(names ...string) *TaggedCache {
+	return newTaggedCache(d, d.Cache.Tags(names...).index, names)
+}
+
+// Manager, isimlendirilmiş cache store'larını yönetir.
+type Manager struct {
+	mu     sync.RWMutex
+	stores map[string]Cache
+}
+
+// NewManager, varsayılan store ile yeni bir Manager oluşturur.
+//
+// Örnek:
+//
+//	manager := cache.NewManager(defaultCache)
+//	manager.Register("sessions", sessionStore)
+func NewManager(defaultStore Cache) *Manager {
+	return &Manager{
+		stores: map[string]Cache{DefaultStore: defaultStore},
+	}
+}
+
+// Register, isimlendirilmiş bir store ekler (varsa üzerine yazar).
+func (m *Manager) Register(name string, store Cache) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stores[name] = store
+}
+
+// Lookup, store'u döndürür; tanımlı değilse false döner.
+func (m *Manager) Lookup(name string) (Cache, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	store, ok := m.stores[name]
+	return store, ok
+}
+
+// Store, isimlendirilmiş store'u döndürür.
+//
+// Store tanımlı değilse varsayılan store döner; böylece development'ta her
+// store'u ayrıca tanımlamak gerekmez. Ayrı prefix'in şart olduğu yerlerde
+// Lookup kullanılmalıdır.
+func (m *Manager) Store(name string) Cache {
+	if store, ok := m.Lookup(name); ok {
+		return store
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.stores[DefaultStore]
+}
+
+// Names, tanımlı store isimlerini alfabetik sırayla döndürür.
+func (m *Manager) Names() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	names := make([]string, 0, len(m.stores))
+	for name := range m.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Global manager (resilience.Register ile aynı pattern)
+var (
+	globalManager   *Manager
+	globalManagerMu sync.RWMutex
+)
+
+// SetManager, cache.Store ile kullanılacak global Manager'ı ayarlar.
+//
+// Uygulama başlatılırken (main.go) container'dan çözülen Manager ile
+// çağrılmalı.
+func SetManager(manager *Manager) {
+	globalManagerMu.Lock()
+	defer globalManagerMu.Unlock()
+
+	globalManager = manager
+}
+
+// Store, global Manager'dan isimlendirilmiş store'u döndürür.
+//
+// SetManager çağrılmadıysa panic yapar (bootstrap hatası).
+//
+// Örnek:
+//
+//	cache.Store("sessions").Set("session:abc", data, 0)
+func Store(name string) Cache {
+	globalManagerMu.RLock()
+	manager := globalManager
+	globalManagerMu.RUnlock()
+
+	if manager == nil {
+		panic("cache: Store() çağrılmadan önce SetManager ile manager ayarlanmalı")
+	}
+	return manager.Store(name)
+}
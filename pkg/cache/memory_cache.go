@@ -12,16 +12,20 @@
 // - TTL support (automatic cleanup)
 // - No serialization overhead
 // - No external dependencies
+// - Opsiyonel boyut limiti (MaxEntries/MaxBytes) ve LRU eviction
 //
 // Sınırlamalar:
 // - Non-persistent (restart'ta kaybolur)
 // - Single-server only (distributed değil)
-// - Memory leak riski (dikkatli kullan!)
+// - Limit verilmezse sadece TTL ile küçülür (production'da SetLimits kullanın)
 // -----------------------------------------------------------------------------
 
 package cache
 
 import (
+	"container/list"
+	"encoding/json"
+	"fmt"
 	"log"
 	"sync"
 	"time"
@@ -31,6 +35,10 @@ import (
 type MemoryCacheEntry struct {
 	Value     interface{} // Gerçek değer (pointer)
 	ExpiresAt time.Time   // Expire zamanı (zero value = süresiz)
+
+	key     string        // LRU listesinden map'e dönüş için
+	size    int64         // Tahmini boyut (byte)
+	element *list.Element // LRU listesindeki yeri
 }
 
 // IsExpired, entry'nin expire olup olmadığını kontrol eder.
@@ -41,11 +49,28 @@ func (e *MemoryCacheEntry) IsExpired() bool {
 	return time.Now().After(e.ExpiresAt)
 }
 
+// MemoryLimits, memory cache'in boyut limitleri.
+//
+// Limit aşıldığında en uzun süredir erişilmeyen (LRU) entry'ler silinir.
+// 0 değeri o limiti kapatır.
+type MemoryLimits struct {
+	MaxEntries int   // Maksimum entry sayısı
+	MaxBytes   int64 // Maksimum tahmini toplam boyut (byte)
+}
+
 // MemoryCache, in-memory cache implementation.
 type MemoryCache struct {
 	store  map[string]*MemoryCacheEntry
+	tags   map[string]map[string]struct{} // tag -> key set
 	mu     sync.RWMutex
 	logger *log.Logger
+
+	lru       *list.List // Ön: en son erişilen, arka: eviction adayı
+	limits    MemoryLimits
+	bytes     int64  // Tahmini toplam boyut
+	evictions uint64 // Limit nedeniyle silinen entry sayısı
+
+	stampedeGuard // Remember stampede koruması
 }
 
 // NewMemoryCache, yeni bir Memory cache instance oluşturur.
@@ -68,7 +93,9 @@ type MemoryCache struct {
 func NewMemoryCache(logger *log.Logger) *MemoryCache {
 	mc := &MemoryCache{
 		store:  make(map[string]*MemoryCacheEntry),
+		tags:   make(map[string]map[string]struct{}),
 		logger: logger,
+		lru:    list.New(),
 	}
 
 	// Garbage collection başlat
@@ -79,42 +106,153 @@ func NewMemoryCache(logger *log.Logger) *MemoryCache {
 	return mc
 }
 
-// Get, cache'den veri okur.
-func (m *MemoryCache) Get(key string) (interface{}, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+// SetLimits, boyut limitlerini ayarlar ve gerekirse hemen eviction yapar.
+//
+// Parametreler:
+//   - limits: Maksimum entry sayısı ve tahmini byte boyutu (0 = limitsiz)
+//
+// Örnek:
+//
+//	memCache.SetLimits(cache.MemoryLimits{MaxEntries: 10000, MaxBytes: 64 << 20})
+func (m *MemoryCache) SetLimits(limits MemoryLimits) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.limits = limits
+	m.evict()
+}
 
+// lookup, canlı entry'yi döndürür ve LRU'da öne taşır. Lock çağıran
+// tarafından alınmalıdır.
+func (m *MemoryCache) lookup(key string) *MemoryCacheEntry {
+	entry, exists := m.store[key]
+	if !exists || entry.IsExpired() {
+		return nil
+	}
+
+	m.lru.MoveToFront(entry.element)
+	return entry
+}
+
+// put, entry'yi yazar ve limitler aşıldıysa LRU eviction yapar. Lock çağıran
+// tarafından alınmalıdır.
+func (m *MemoryCache) put(key string, value interface{}, expiresAt time.Time) error {
+	size := estimateSize(key, value)
+	if m.limits.MaxBytes > 0 && size > m.limits.MaxBytes {
+		return fmt.Errorf("cache value too large [%s]: %d bytes (max: %d)", key, size, m.limits.MaxBytes)
+	}
+
+	m.remove(key)
+
+	entry := &MemoryCacheEntry{
+		Value:     value,
+		ExpiresAt: expiresAt,
+		key:       key,
+		size:      size,
+	}
+	entry.element = m.lru.PushFront(entry)
+	m.store[key] = entry
+	m.bytes += size
+
+	m.evict()
+	return nil
+}
+
+// remove, entry'yi map'ten ve LRU listesinden siler. Lock çağıran tarafından
+// alınmalıdır.
+func (m *MemoryCache) remove(key string) {
 	entry, exists := m.store[key]
 	if !exists {
-		return nil, nil // Cache miss
+		return
 	}
 
-	// TTL kontrolü
-	if entry.IsExpired() {
-		// Expired - silinecek (GC tarafından)
-		return nil, nil
+	m.lru.Remove(entry.element)
+	delete(m.store, key)
+	m.bytes -= entry.size
+}
+
+// evict, limitler sağlanana kadar en eski entry'leri siler. Lock çağıran
+// tarafından alınmalıdır.
+func (m *MemoryCache) evict() {
+	for {
+		overEntries := m.limits.MaxEntries > 0 && len(m.store) > m.limits.MaxEntries
+		overBytes := m.limits.MaxBytes > 0 && m.bytes > m.limits.MaxBytes
+		if !overEntries && !overBytes {
+			return
+		}
+
+		oldest := m.lru.Back()
+		if oldest == nil {
+			return
+		}
+
+		entry := oldest.Value.(*MemoryCacheEntry)
+		m.remove(entry.key)
+
+		// Expire olmuş entry'ler sayılmaz; onları GC zaten silecekti
+		if !entry.IsExpired() {
+			m.evictions++
+		}
 	}
+}
 
-	return entry.Value, nil
+// estimateSize, entry'nin bellekteki yaklaşık boyutunu hesaplar.
+//
+// Değerler serialize edilmeden saklandığı için kesin boyut bilinemez;
+// yaygın tipler doğrudan, diğerleri JSON boyutu üzerinden tahmin edilir.
+func estimateSize(key string, value interface{}) int64 {
+	const overhead = 64 // Entry struct + map/list bookkeeping
+
+	size := int64(len(key) + overhead)
+	switch v := value.(type) {
+	case nil:
+	case string:
+		size += int64(len(v))
+	case []byte:
+		size += int64(len(v))
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		size += 8
+	case time.Time:
+		size += 24
+	default:
+		if data, err := json.Marshal(v); err == nil {
+			size += int64(len(data))
+		} else {
+			size += overhead
+		}
+	}
+	return size
 }
 
-// Set, cache'e veri yazar.
-func (m *MemoryCache) Set(key string, value interface{}, ttl time.Duration) error {
+// expiryFor, TTL'den expire zamanını hesaplar (0 = süresiz).
+func expiryFor(ttl time.Duration) time.Time {
+	if ttl > 0 {
+		return time.Now().Add(ttl)
+	}
+	return time.Time{}
+}
+
+// Get, cache'den veri okur.
+//
+// LRU sırasını güncellediği için write lock alır.
+func (m *MemoryCache) Get(key string) (interface{}, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Expire zamanını hesapla
-	var expiresAt time.Time
-	if ttl > 0 {
-		expiresAt = time.Now().Add(ttl)
+	entry := m.lookup(key)
+	if entry == nil {
+		return nil, nil // Cache miss (expired entry'ler GC tarafından silinir)
 	}
 
-	m.store[key] = &MemoryCacheEntry{
-		Value:     value,
-		ExpiresAt: expiresAt,
-	}
+	return entry.Value, nil
+}
 
-	return nil
+// Set, cache'e veri yazar.
+func (m *MemoryCache) Set(key string, value interface{}, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.put(key, value, expiryFor(ttl))
 }
 
 // Delete, cache'den veri siler.
@@ -122,7 +260,7 @@ func (m *MemoryCache) Delete(key string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	delete(m.store, key)
+	m.remove(key)
 	return nil
 }
 
@@ -135,31 +273,81 @@ func (m *MemoryCache) Has(key string) (bool, error) {
 	return val != nil, nil
 }
 
-// Remember, cache'den okur veya callback'i çalıştırıp cache'ler.
-func (m *MemoryCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
-	// Önce cache'i kontrol et
-	val, err := m.Get(key)
-	if err != nil {
-		return nil, err
+// Pull, key'i okur ve siler (atomic).
+func (m *MemoryCache) Pull(key string) (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, exists := m.store[key]
+	if !exists {
+		return nil, nil
 	}
+	m.remove(key)
 
-	// Cache hit
-	if val != nil {
-		return val, nil
+	if entry.IsExpired() {
+		return nil, nil
 	}
+	return entry.Value, nil
+}
 
-	// Cache miss - callback çalıştır
-	result, err := callback()
-	if err != nil {
-		return nil, err
+// Add, key yoksa (veya expire olmuşsa) değeri yazar (atomic).
+func (m *MemoryCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lookup(key) != nil {
+		return false, nil
 	}
 
-	// Cache'e yaz
-	if err := m.Set(key, result, ttl); err != nil {
-		m.logger.Printf("⚠️  Remember cache yazma hatası [%s]: %v", key, err)
+	if err := m.put(key, value, expiryFor(ttl)); err != nil {
+		return false, err
 	}
+	return true, nil
+}
 
-	return result, nil
+// Forever, değeri süresiz olarak yazar.
+func (m *MemoryCache) Forever(key string, value interface{}) error {
+	return m.Set(key, value, 0)
+}
+
+// GetWithTTL, değeri kalan süresiyle birlikte okur.
+func (m *MemoryCache) GetWithTTL(key string) (interface{}, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.lookup(key)
+	if entry == nil {
+		return nil, 0, nil
+	}
+
+	if entry.ExpiresAt.IsZero() {
+		return entry.Value, NoExpiry, nil
+	}
+	return entry.Value, time.Until(entry.ExpiresAt), nil
+}
+
+// Touch, key'in TTL'ini değeri değiştirmeden günceller.
+func (m *MemoryCache) Touch(key string, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.lookup(key)
+	if entry == nil {
+		return false, nil
+	}
+
+	if ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(ttl)
+	} else {
+		entry.ExpiresAt = time.Time{}
+	}
+	return true, nil
+}
+
+// Remember, cache'den okur veya callback'i çalıştırıp cache'ler.
+func (m *MemoryCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	// Aynı key için eşzamanlı miss'lerde callback tek kez çalışır
+	return m.remember(m, nil, m.logger, key, ttl, callback)
 }
 
 // Increment, sayısal değeri artırır (thread-safe).
@@ -167,30 +355,24 @@ func (m *MemoryCache) Increment(key string, value int64) (int64, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	entry, exists := m.store[key]
+	entry := m.lookup(key)
 
 	var current int64 = 0
-	if exists && !entry.IsExpired() {
+	var expiresAt time.Time
+	if entry != nil {
 		// Type assertion
 		if intVal, ok := entry.Value.(int64); ok {
 			current = intVal
 		}
+		expiresAt = entry.ExpiresAt // TTL koru
 	}
 
 	// Artır
 	newVal := current + value
 
-	// Kaydet (TTL koru)
-	var expiresAt time.Time
-	if exists {
-		expiresAt = entry.ExpiresAt
-	}
-
-	m.store[key] = &MemoryCacheEntry{
-		Value:     newVal,
-		ExpiresAt: expiresAt,
+	if err := m.put(key, newVal, expiresAt); err != nil {
+		return 0, err
 	}
-
 	return newVal, nil
 }
 
@@ -205,6 +387,9 @@ func (m *MemoryCache) Flush() error {
 	defer m.mu.Unlock()
 
 	m.store = make(map[string]*MemoryCacheEntry)
+	m.tags = make(map[string]map[string]struct{})
+	m.lru.Init()
+	m.bytes = 0
 	m.logger.Println("⚠️  Memory cache tamamen temizlendi")
 
 	return nil
@@ -242,8 +427,50 @@ func (m *MemoryCache) DeleteMultiple(keys []string) error {
 	defer m.mu.Unlock()
 
 	for _, key := range keys {
-		delete(m.store, key)
+		m.remove(key)
+	}
+	return nil
+}
+
+// Tags, verilen tag'lere bağlı bir cache instance'ı döndürür.
+func (m *MemoryCache) Tags(names ...string) *TaggedCache {
+	return newTaggedCache(m, m, names)
+}
+
+// addTagMembers, key'leri tag'in üye set'ine ekler.
+func (m *MemoryCache) addTagMembers(tag string, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	members, ok := m.tags[tag]
+	if !ok {
+		members = make(map[string]struct{})
+		m.tags[tag] = members
+	}
+	for _, key := range keys {
+		members[key] = struct{}{}
+	}
+	return nil
+}
+
+// tagMembers, tag'e ait key'leri döndürür.
+func (m *MemoryCache) tagMembers(tag string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]string, 0, len(m.tags[tag]))
+	for key := range m.tags[tag] {
+		keys = append(keys, key)
 	}
+	return keys, nil
+}
+
+// forgetTag, tag'in üye set'ini siler.
+func (m *MemoryCache) forgetTag(tag string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.tags, tag)
 	return nil
 }
 
@@ -265,6 +492,10 @@ func (m *MemoryCache) Stats() map[string]interface{} {
 		"total_keys":   len(m.store),
 		"valid_keys":   validCount,
 		"expired_keys": len(m.store) - validCount,
+		"bytes":        m.bytes,
+		"max_entries":  m.limits.MaxEntries,
+		"max_bytes":    m.limits.MaxBytes,
+		"evictions":    m.evictions,
 	}
 }
 
@@ -276,19 +507,19 @@ func (m *MemoryCache) startGarbageCollection() {
 	defer ticker.Stop()
 
 	for range ticker.C {
-		m.cleanExpiredEntries()
+		m.Prune()
 	}
 }
 
-// cleanExpiredEntries, expired entry'leri temizler.
-func (m *MemoryCache) cleanExpiredEntries() {
+// Prune, expired entry'leri temizler ve silinen entry sayısını döndürür.
+func (m *MemoryCache) Prune() (int, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	cleaned := 0
 	for key, entry := range m.store {
 		if entry.IsExpired() {
-			delete(m.store, key)
+			m.remove(key)
 			cleaned++
 		}
 	}
@@ -296,6 +527,7 @@ func (m *MemoryCache) cleanExpiredEntries() {
 	if cleaned > 0 {
 		m.logger.Printf("🧹 Memory cache garbage collection: %d expired entry silindi", cleaned)
 	}
+	return cleaned, nil
 }
 
 // Size, cache'deki toplam entry sayısını döndürür.
@@ -0,0 +1,411 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// MsgpackSerializer, MessagePack (https://msgpack.org) formatında encode eder.
+//
+// Harici bağımlılık olmadan, cache'te saklanan tipik değerler için yazılmış
+// minimal bir implementasyondur:
+//   - nil, bool, string, []byte
+//   - int*/uint* (işaretli tipler int64, işaretsizler uint64 olarak döner)
+//   - float32/float64 (float32 korunur)
+//   - slice/array -> []interface{}, map -> map[string]interface{}
+//   - time.Time (timestamp extension, tip -1)
+//
+// Struct'lar önce JSON üzerinden generic map'e çevrilir (json tag'leri geçerlidir).
+type MsgpackSerializer struct{}
+
+// Name, serializer adını döndürür.
+func (MsgpackSerializer) Name() string { return SerializerMsgpack }
+
+// Marshal, değeri msgpack formatına çevirir.
+func (MsgpackSerializer) Marshal(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := msgpackEncode(&buf, reflect.ValueOf(value)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal, msgpack verisini decode eder.
+func (MsgpackSerializer) Unmarshal(data []byte) (interface{}, error) {
+	d := &msgpackDecoder{data: data}
+	value, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(data) {
+		return nil, errors.New("msgpack: fazladan veri")
+	}
+	return value, nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// msgpackEncode, reflect değeri buffer'a yazar.
+func msgpackEncode(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		buf.WriteByte(0xc0)
+		return nil
+	}
+
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		buf.Write([]byte{0xc7, 12, 0xff})
+		binary.Write(buf, binary.BigEndian, uint32(t.Nanosecond()))
+		binary.Write(buf, binary.BigEndian, t.Unix())
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			buf.WriteByte(0xc0)
+			return nil
+		}
+		return msgpackEncode(buf, v.Elem())
+
+	case reflect.Bool:
+		if v.Bool() {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeMsgpackInt(buf, v.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeMsgpackUint(buf, v.Uint())
+
+	case reflect.Float32:
+		buf.WriteByte(0xca)
+		binary.Write(buf, binary.BigEndian, math.Float32bits(float32(v.Float())))
+
+	case reflect.Float64:
+		buf.WriteByte(0xcb)
+		binary.Write(buf, binary.BigEndian, math.Float64bits(v.Float()))
+
+	case reflect.String:
+		writeMsgpackHeader(buf, len(v.String()), 0xa0, 32, 0xd9, 0xda, 0xdb)
+		buf.WriteString(v.String())
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			buf.WriteByte(0xc0)
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(data), v)
+			writeMsgpackHeader(buf, len(data), 0, 0, 0xc4, 0xc5, 0xc6)
+			buf.Write(data)
+			return nil
+		}
+		writeMsgpackHeader(buf, v.Len(), 0x90, 16, 0, 0xdc, 0xdd)
+		for i := 0; i < v.Len(); i++ {
+			if err := msgpackEncode(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		if v.IsNil() {
+			buf.WriteByte(0xc0)
+			return nil
+		}
+		writeMsgpackHeader(buf, v.Len(), 0x80, 16, 0, 0xde, 0xdf)
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := msgpackEncode(buf, iter.Key()); err != nil {
+				return err
+			}
+			if err := msgpackEncode(buf, iter.Value()); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Struct:
+		// Struct'lar JSON tag'lerine göre generic map'e çevrilir
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Errorf("msgpack: %s encode edilemedi: %w", v.Type(), err)
+		}
+		var generic interface{}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return err
+		}
+		return msgpackEncode(buf, reflect.ValueOf(generic))
+
+	default:
+		return fmt.Errorf("msgpack: desteklenmeyen tip: %s", v.Type())
+	}
+
+	return nil
+}
+
+// writeMsgpackInt, işaretli tam sayıyı en kısa formatta yazar.
+func writeMsgpackInt(buf *bytes.Buffer, n int64) {
+	switch {
+	case n >= 0 && n <= 127:
+		buf.WriteByte(byte(n)) // positive fixint
+	case n >= -32 && n < 0:
+		buf.WriteByte(byte(int8(n))) // negative fixint
+	case n >= math.MinInt8 && n <= math.MaxInt8:
+		buf.Write([]byte{0xd0, byte(int8(n))})
+	case n >= math.MinInt16 && n <= math.MaxInt16:
+		buf.WriteByte(0xd1)
+		binary.Write(buf, binary.BigEndian, int16(n))
+	case n >= math.MinInt32 && n <= math.MaxInt32:
+		buf.WriteByte(0xd2)
+		binary.Write(buf, binary.BigEndian, int32(n))
+	default:
+		buf.WriteByte(0xd3)
+		binary.Write(buf, binary.BigEndian, n)
+	}
+}
+
+// writeMsgpackUint, işaretsiz tam sayıyı yazar (tip bilgisi korunsun diye
+// fixint yerine her zaman uint formatları kullanılır).
+func writeMsgpackUint(buf *bytes.Buffer, n uint64) {
+	switch {
+	case n <= math.MaxUint8:
+		buf.Write([]byte{0xcc, byte(n)})
+	case n <= math.MaxUint16:
+		buf.WriteByte(0xcd)
+		binary.Write(buf, binary.BigEndian, uint16(n))
+	case n <= math.MaxUint32:
+		buf.WriteByte(0xce)
+		binary.Write(buf, binary.BigEndian, uint32(n))
+	default:
+		buf.WriteByte(0xcf)
+		binary.Write(buf, binary.BigEndian, n)
+	}
+}
+
+// writeMsgpackHeader, uzunluk başlığını yazar.
+//
+// fixBase/fixLimit: fix format (örn: fixstr 0xa0, < 32); fixLimit 0 ise yok.
+// code8/code16/code32: 8, 16 ve 32 bit uzunluklu formatlar; code8 0 ise yok.
+func writeMsgpackHeader(buf *bytes.Buffer, n int, fixBase byte, fixLimit int, code8, code16, code32 byte) {
+	switch {
+	case n < fixLimit:
+		buf.WriteByte(fixBase | byte(n))
+	case code8 != 0 && n <= math.MaxUint8:
+		buf.Write([]byte{code8, byte(n)})
+	case n <= math.MaxUint16:
+		buf.WriteByte(code16)
+		binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(code32)
+		binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+}
+
+// msgpackDecoder, msgpack verisini sırayla okur.
+type msgpackDecoder struct {
+	data []byte
+	pos  int
+}
+
+var errMsgpackShort = errors.New("msgpack: beklenmeyen veri sonu")
+
+// next, n byte okur.
+func (d *msgpackDecoder) next(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, errMsgpackShort
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+// uint, n byte'lık big-endian işaretsiz sayı okur.
+func (d *msgpackDecoder) uint(n int) (uint64, error) {
+	b, err := d.next(n)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v, nil
+}
+
+// decode, sıradaki değeri okur.
+func (d *msgpackDecoder) decode() (interface{}, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return nil, err
+	}
+	code := b[0]
+
+	switch {
+	case code <= 0x7f:
+		return int64(code), nil
+	case code >= 0xe0:
+		return int64(int8(code)), nil
+	case code >= 0xa0 && code <= 0xbf:
+		return d.str(int(code & 0x1f))
+	case code >= 0x90 && code <= 0x9f:
+		return d.array(int(code & 0x0f))
+	case code >= 0x80 && code <= 0x8f:
+		return d.mapping(int(code & 0x0f))
+	}
+
+	switch code {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return d.uint(1 << (code - 0xcc))
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (code - 0xd0)
+		v, err := d.uint(size)
+		if err != nil {
+			return nil, err
+		}
+		shift := uint(64 - size*8)
+		return int64(v<<shift) >> shift, nil // sign extend
+	case 0xca:
+		v, err := d.uint(4)
+		return math.Float32frombits(uint32(v)), err
+	case 0xcb:
+		v, err := d.uint(8)
+		return math.Float64frombits(v), err
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.uint(1 << (code - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.str(int(n))
+	case 0xc4, 0xc5, 0xc6:
+		n, err := d.uint(1 << (code - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		raw, err := d.next(int(n))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(nil), raw...), nil
+	case 0xdc, 0xdd:
+		n, err := d.uint(2 << (code - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.array(int(n))
+	case 0xde, 0xdf:
+		n, err := d.uint(2 << (code - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.mapping(int(n))
+	case 0xc7:
+		return d.timestamp()
+	}
+
+	return nil, fmt.Errorf("msgpack: desteklenmeyen format: 0x%02x", code)
+}
+
+func (d *msgpackDecoder) str(n int) (interface{}, error) {
+	raw, err := d.next(n)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (d *msgpackDecoder) array(n int) (interface{}, error) {
+	if n > len(d.data)-d.pos {
+		return nil, errMsgpackShort // Her eleman en az 1 byte
+	}
+	result := make([]interface{}, n)
+	for i := range result {
+		v, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// mapping, map okur. Tüm key'ler string ise map[string]interface{},
+// değilse map[interface{}]interface{} döner.
+func (d *msgpackDecoder) mapping(n int) (interface{}, error) {
+	if n > len(d.data)-d.pos {
+		return nil, errMsgpackShort
+	}
+	keys := make([]interface{}, n)
+	values := make([]interface{}, n)
+	allStrings := true
+
+	for i := 0; i < n; i++ {
+		k, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := k.(string); !ok {
+			allStrings = false
+			// Veri güvenilmez (Redis/dosya); bin, array ve map key'leri Go
+			// map'ine eklenirken panic yapar
+			if k != nil && !reflect.TypeOf(k).Comparable() {
+				return nil, fmt.Errorf("msgpack: desteklenmeyen map key tipi %T", k)
+			}
+		}
+		keys[i], values[i] = k, v
+	}
+
+	if allStrings {
+		result := make(map[string]interface{}, n)
+		for i, k := range keys {
+			result[k.(string)] = values[i]
+		}
+		return result, nil
+	}
+
+	result := make(map[interface{}]interface{}, n)
+	for i, k := range keys {
+		result[k] = values[i]
+	}
+	return result, nil
+}
+
+// timestamp, timestamp96 extension'ını (ext8, tip -1) okur.
+func (d *msgpackDecoder) timestamp() (interface{}, error) {
+	header, err := d.next(2)
+	if err != nil {
+		return nil, err
+	}
+	if header[0] != 12 || int8(header[1]) != -1 {
+		return nil, fmt.Errorf("msgpack: desteklenmeyen extension (tip %d)", int8(header[1]))
+	}
+
+	nsec, err := d.uint(4)
+	if err != nil {
+		return nil, err
+	}
+	sec, err := d.uint(8)
+	if err != nil {
+		return nil, err
+	}
+	return time.Unix(int64(sec), int64(nsec)), nil
+}
@@ -18,11 +18,11 @@ package cache
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -31,6 +31,9 @@ type RedisCache struct {
 	client *redis.Client
 	logger *log.Logger
 	prefix string // Key prefix (namespace)
+
+	stampedeGuard    // Remember stampede koruması (singleflight + opsiyonel lock)
+	serializerHolder // Değer serializer'ı (varsayılan: JSON)
 }
 
 // NewRedisCache, yeni bir Redis cache instance oluşturur.
@@ -82,11 +85,11 @@ func (r *RedisCache) Get(key string) (interface{}, error) {
 		return nil, fmt.Errorf("redis get failed: %w", err)
 	}
 
-	// JSON decode
-	var result interface{}
-	if err := json.Unmarshal([]byte(val), &result); err != nil {
-		r.logger.Printf("❌ JSON decode hatası [%s]: %v", prefixedKey, err)
-		return nil, fmt.Errorf("json decode failed: %w", err)
+	// Decode (format önekine göre JSON/gob/msgpack)
+	result, err := decodeValue([]byte(val))
+	if err != nil {
+		r.logger.Printf("❌ Decode hatası [%s]: %v", prefixedKey, err)
+		return nil, fmt.Errorf("cache decode failed: %w", err)
 	}
 
 	return result, nil
@@ -97,11 +100,11 @@ func (r *RedisCache) Set(key string, value interface{}, ttl time.Duration) error
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
-	// JSON encode
-	data, err := json.Marshal(value)
+	// Encode (aktif serializer ile)
+	data, err := encodeValue(r.getSerializer(), value)
 	if err != nil {
-		r.logger.Printf("❌ JSON encode hatası [%s]: %v", key, err)
-		return fmt.Errorf("json encode failed: %w", err)
+		r.logger.Printf("❌ Encode hatası [%s]: %v", key, err)
+		return fmt.Errorf("cache encode failed: %w", err)
 	}
 
 	prefixedKey := r.prefixKey(key)
@@ -144,34 +147,171 @@ func (r *RedisCache) Has(key string) (bool, error) {
 	return count > 0, nil
 }
 
-// Remember, cache'den okur veya callback'i çalıştırıp cache'ler.
-//
-// Thread-safe değil! Production'da lock mechanism eklenebilir.
-func (r *RedisCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
-	// Önce cache'i kontrol et
-	val, err := r.Get(key)
+// Pull, key'i GETDEL ile tek komutta okur ve siler (Redis >= 6.2).
+func (r *RedisCache) Pull(key string) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	prefixedKey := r.prefixKey(key)
+	val, err := r.client.GetDel(ctx, prefixedKey).Result()
+
+	if err == redis.Nil {
+		return nil, nil
+	}
+
 	if err != nil {
-		return nil, err
+		r.logger.Printf("❌ Redis GetDel hatası [%s]: %v", prefixedKey, err)
+		return nil, fmt.Errorf("redis getdel failed: %w", err)
 	}
 
-	// Cache hit
-	if val != nil {
-		return val, nil
+	result, err := decodeValue([]byte(val))
+	if err != nil {
+		r.logger.Printf("❌ Decode hatası [%s]: %v", prefixedKey, err)
+		return nil, fmt.Errorf("cache decode failed: %w", err)
 	}
 
-	// Cache miss - callback çalıştır
-	result, err := callback()
+	return result, nil
+}
+
+// Add, key yoksa SET NX ile yazar (atomic, tüm instance'lar arasında).
+func (r *RedisCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	data, err := encodeValue(r.getSerializer(), value)
 	if err != nil {
-		return nil, err
+		r.logger.Printf("❌ Encode hatası [%s]: %v", key, err)
+		return false, fmt.Errorf("cache encode failed: %w", err)
 	}
 
-	// Cache'e yaz
-	if err := r.Set(key, result, ttl); err != nil {
-		// Cache yazma hatası - result'u döndür ama log tut
-		r.logger.Printf("⚠️  Remember cache yazma hatası [%s]: %v", key, err)
+	prefixedKey := r.prefixKey(key)
+	added, err := r.client.SetNX(ctx, prefixedKey, data, ttl).Result()
+	if err != nil {
+		r.logger.Printf("❌ Redis SetNX hatası [%s]: %v", prefixedKey, err)
+		return false, fmt.Errorf("redis setnx failed: %w", err)
 	}
 
-	return result, nil
+	return added, nil
+}
+
+// Forever, değeri TTL olmadan yazar.
+func (r *RedisCache) Forever(key string, value interface{}) error {
+	return r.Set(key, value, 0)
+}
+
+// GetWithTTL, değeri ve kalan süreyi tek round-trip'te (pipeline) okur.
+func (r *RedisCache) GetWithTTL(key string) (interface{}, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	prefixedKey := r.prefixKey(key)
+
+	var getCmd *redis.StringCmd
+	var ttlCmd *redis.DurationCmd
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		getCmd = pipe.Get(ctx, prefixedKey)
+		ttlCmd = pipe.PTTL(ctx, prefixedKey)
+		return nil
+	})
+
+	val, getErr := getCmd.Result()
+	if getErr == redis.Nil {
+		return nil, 0, nil
+	}
+	if err != nil {
+		r.logger.Printf("❌ Redis GetWithTTL hatası [%s]: %v", prefixedKey, err)
+		return nil, 0, fmt.Errorf("redis get failed: %w", err)
+	}
+
+	result, err := decodeValue([]byte(val))
+	if err != nil {
+		r.logger.Printf("❌ Decode hatası [%s]: %v", prefixedKey, err)
+		return nil, 0, fmt.Errorf("cache decode failed: %w", err)
+	}
+
+	// PTTL: -1 = süresiz, -2 = key yok (GET ile PTTL arasında silinmiş)
+	remaining := ttlCmd.Val()
+	if remaining < 0 {
+		remaining = NoExpiry
+	}
+
+	return result, remaining, nil
+}
+
+// Touch, key'in TTL'ini günceller (EXPIRE/PERSIST); değer yeniden yazılmaz.
+func (r *RedisCache) Touch(key string, ttl time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	prefixedKey := r.prefixKey(key)
+
+	var touched bool
+	var err error
+	if ttl > 0 {
+		touched, err = r.client.PExpire(ctx, prefixedKey, ttl).Result()
+	} else {
+		// PERSIST, key zaten süresizse de false döner; varlığı ayrıca kontrol et
+		if _, err = r.client.Persist(ctx, prefixedKey).Result(); err == nil {
+			var exists int64
+			exists, err = r.client.Exists(ctx, prefixedKey).Result()
+			touched = exists > 0
+		}
+	}
+
+	if err != nil {
+		r.logger.Printf("❌ Redis Touch hatası [%s]: %v", prefixedKey, err)
+		return false, fmt.Errorf("redis touch failed: %w", err)
+	}
+
+	return touched, nil
+}
+
+// Remember, cache'den okur veya callback'i çalıştırıp cache'ler.
+//
+// Cache stampede koruması (bkz: stampede.go): aynı process'te aynı key için
+// eşzamanlı çağrılar singleflight ile tek bir callback çalıştırır.
+// SetStampedeOptions(StampedeOptions{Lock: true}) ile SET NX distributed
+// lock da açılır; lock'u alamayan instance'lar stale değeri döndürür veya
+// yeni değerin yazılmasını bekler.
+func (r *RedisCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	return r.remember(r, r, r.logger, key, ttl, callback)
+}
+
+// unlockScript, lock'u sadece token eşleşiyorsa siler (başkasının lock'unu
+// yanlışlıkla bırakmamak için atomic kontrol).
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Lock, SET NX ile distributed lock almaya çalışır.
+//
+// Lock TTL sonunda otomatik düşer; böylece lock sahibi çökse bile
+// diğer worker'lar sonsuza kadar beklemez.
+func (r *RedisCache) Lock(name string, ttl time.Duration) (string, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	token := uuid.New().String()
+	acquired, err := r.client.SetNX(ctx, r.prefixKey(name), token, ttl).Result()
+	if err != nil {
+		return "", false, fmt.Errorf("redis lock failed: %w", err)
+	}
+
+	return token, acquired, nil
+}
+
+// Unlock, token eşleşiyorsa lock'u bırakır.
+func (r *RedisCache) Unlock(name, token string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := unlockScript.Run(ctx, r.client, []string{r.prefixKey(name)}, token).Err(); err != nil {
+		return fmt.Errorf("redis unlock failed: %w", err)
+	}
+	return nil
 }
 
 // Increment, sayısal değeri artırır (atomic).
@@ -289,9 +429,9 @@ func (r *RedisCache) GetMultiple(keys []string) (map[string]interface{}, error)
 			continue
 		}
 
-		var decoded interface{}
-		if err := json.Unmarshal([]byte(val), &decoded); err != nil {
-			r.logger.Printf("⚠️  JSON decode hatası [%s]: %v", keys[i], err)
+		decoded, err := decodeValue([]byte(val))
+		if err != nil {
+			r.logger.Printf("⚠️  Decode hatası [%s]: %v", keys[i], err)
 			result[keys[i]] = nil
 			continue
 		}
@@ -313,10 +453,11 @@ func (r *RedisCache) SetMultiple(values map[string]interface{}, ttl time.Duratio
 
 	// Pipeline kullan
 	pipe := r.client.Pipeline()
+	serializer := r.getSerializer()
 	for key, value := range values {
-		data, err := json.Marshal(value)
+		data, err := encodeValue(serializer, value)
 		if err != nil {
-			r.logger.Printf("❌ JSON encode hatası [%s]: %v", key, err)
+			r.logger.Printf("❌ Encode hatası [%s]: %v", key, err)
 			continue
 		}
 
@@ -356,6 +497,69 @@ func (r *RedisCache) DeleteMultiple(keys []string) error {
 	return nil
 }
 
+// Tags, verilen tag'lere bağlı bir cache instance'ı döndürür.
+//
+// Tag üyelikleri Redis SET'lerinde tutulur:
+//
+//	{prefix}tag:{name}:keys -> {key1, key2, ...}
+func (r *RedisCache) Tags(names ...string) *TaggedCache {
+	return newTaggedCache(r, r, names)
+}
+
+// tagSetKey, tag'in üye SET'i için Redis key'ini döndürür.
+func (r *RedisCache) tagSetKey(tag string) string {
+	return r.prefixKey("tag:" + tag + ":keys")
+}
+
+// addTagMembers, key'leri tag SET'ine ekler (SADD).
+func (r *RedisCache) addTagMembers(tag string, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	members := make([]interface{}, len(keys))
+	for i, key := range keys {
+		members[i] = key
+	}
+
+	if err := r.client.SAdd(ctx, r.tagSetKey(tag), members...).Err(); err != nil {
+		r.logger.Printf("❌ Redis SAdd hatası [tag: %s]: %v", tag, err)
+		return fmt.Errorf("redis sadd failed: %w", err)
+	}
+
+	return nil
+}
+
+// tagMembers, tag SET'indeki key'leri döndürür (SMEMBERS).
+func (r *RedisCache) tagMembers(tag string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	keys, err := r.client.SMembers(ctx, r.tagSetKey(tag)).Result()
+	if err != nil && err != redis.Nil {
+		r.logger.Printf("❌ Redis SMembers hatası [tag: %s]: %v", tag, err)
+		return nil, fmt.Errorf("redis smembers failed: %w", err)
+	}
+
+	return keys, nil
+}
+
+// forgetTag, tag SET'ini siler.
+func (r *RedisCache) forgetTag(tag string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := r.client.Del(ctx, r.tagSetKey(tag)).Err(); err != nil {
+		r.logger.Printf("❌ Redis tag silme hatası [tag: %s]: %v", tag, err)
+		return fmt.Errorf("redis delete failed: %w", err)
+	}
+
+	return nil
+}
+
 // Stats, Redis cache istatistiklerini döndürür.
 func (r *RedisCache) Stats() map[string]interface{} {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
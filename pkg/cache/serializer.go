@@ -0,0 +1,228 @@
+// -----------------------------------------------------------------------------
+// Cache Serializers
+// -----------------------------------------------------------------------------
+// File ve Redis driver'ları değerleri byte dizisine çevirerek saklar. Varsayılan
+// JSON serializer uyumluluk için korunur; ancak JSON yavaştır ve tip bilgisini
+// kaybeder (int64 -> float64, []byte -> base64 string, time.Time -> string).
+//
+// Alternatifler:
+//   - gob: Go tiplerini birebir korur (özel tipler RegisterGobType ile kaydedilmeli)
+//   - msgpack: Kompakt binary format; int/uint/float/[]byte/time.Time korunur
+//
+// Kullanım:
+//
+//	redisCache.SetSerializer(cache.MsgpackSerializer{})
+//	fileCache.SetSerializer(cache.GobSerializer{})
+//
+// Format İşaretleme:
+// JSON dışındaki serializer'lar veriyi "\x00<isim>\x00" önekiyle yazar. Okurken
+// önek varsa ilgili serializer, yoksa JSON kullanılır. Böylece serializer
+// değiştirildiğinde mevcut cache girdileri okunmaya devam eder.
+// -----------------------------------------------------------------------------
+
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Serializer, cache değerlerini byte dizisine çeviren ve geri okuyan arayüzdür.
+type Serializer interface {
+	// Name, serializer'ın adı (format öneki ve config için kullanılır).
+	Name() string
+
+	// Marshal, değeri byte dizisine çevirir.
+	Marshal(value interface{}) ([]byte, error)
+
+	// Unmarshal, byte dizisini değere çevirir.
+	Unmarshal(data []byte) (interface{}, error)
+}
+
+// Serializer isimleri (CACHE_SERIALIZER değerleri).
+const (
+	SerializerJSON    = "json"
+	SerializerGob     = "gob"
+	SerializerMsgpack = "msgpack"
+)
+
+// JSONSerializer, varsayılan serializer (geriye dönük uyumlu).
+type JSONSerializer struct{}
+
+// Name, serializer adını döndürür.
+func (JSONSerializer) Name() string { return SerializerJSON }
+
+// Marshal, değeri JSON'a çevirir.
+func (JSONSerializer) Marshal(value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// Unmarshal, JSON'ı decode eder (sayılar float64 olarak döner).
+func (JSONSerializer) Unmarshal(data []byte) (interface{}, error) {
+	var result interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GobSerializer, encoding/gob ile Go tiplerini birebir korur.
+//
+// Interface içinde saklanan özel tipler (struct vb.) önceden
+// RegisterGobType ile kaydedilmelidir; aksi halde Marshal hata döner.
+type GobSerializer struct{}
+
+// gobEnvelope, interface değerlerini gob ile taşımak için kullanılır.
+type gobEnvelope struct {
+	Value interface{}
+}
+
+func init() {
+	// Generic koleksiyonlar gob'da varsayılan olarak kayıtlı değil
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+	gob.Register(time.Time{})
+}
+
+// RegisterGobType, özel bir tipi gob serializer için kaydeder.
+//
+// Örnek:
+//
+//	cache.RegisterGobType(models.User{})
+func RegisterGobType(value interface{}) {
+	gob.Register(value)
+}
+
+// Name, serializer adını döndürür.
+func (GobSerializer) Name() string { return SerializerGob }
+
+// Marshal, değeri gob ile encode eder.
+func (GobSerializer) Marshal(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(gobEnvelope{Value: value}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal, gob verisini decode eder.
+func (GobSerializer) Unmarshal(data []byte) (interface{}, error) {
+	var envelope gobEnvelope
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&envelope); err != nil {
+		return nil, err
+	}
+	return envelope.Value, nil
+}
+
+// Kayıtlı serializer'lar (isim -> serializer)
+var (
+	serializers = map[string]Serializer{
+		SerializerJSON:    JSONSerializer{},
+		SerializerGob:     GobSerializer{},
+		SerializerMsgpack: MsgpackSerializer{},
+	}
+	serializersMu sync.RWMutex
+)
+
+// RegisterSerializer, özel bir serializer kaydeder.
+//
+// Kayıtlı serializer'lar SerializerByName ile bulunur ve öneki taşıyan
+// girdiler okunurken otomatik kullanılır.
+func RegisterSerializer(serializer Serializer) {
+	serializersMu.Lock()
+	defer serializersMu.Unlock()
+	serializers[serializer.Name()] = serializer
+}
+
+// SerializerByName, isimden serializer döndürür (config için).
+//
+// Örnek:
+//
+//	s, err := cache.SerializerByName(cfg.Cache.Serializer)
+func SerializerByName(name string) (Serializer, error) {
+	serializersMu.RLock()
+	defer serializersMu.RUnlock()
+
+	if name == "" {
+		return JSONSerializer{}, nil
+	}
+
+	serializer, ok := serializers[name]
+	if !ok {
+		return nil, fmt.Errorf("bilinmeyen cache serializer: %s", name)
+	}
+	return serializer, nil
+}
+
+// encodeValue, değeri serializer ile encode eder ve gerekirse format
+// önekini ekler.
+func encodeValue(serializer Serializer, value interface{}) ([]byte, error) {
+	if serializer == nil {
+		serializer = JSONSerializer{}
+	}
+
+	data, err := serializer.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON öneksiz yazılır (eski girdilerle ve diğer client'larla uyumlu)
+	if serializer.Name() == SerializerJSON {
+		return data, nil
+	}
+
+	prefixed := make([]byte, 0, len(serializer.Name())+2+len(data))
+	prefixed = append(prefixed, 0)
+	prefixed = append(prefixed, serializer.Name()...)
+	prefixed = append(prefixed, 0)
+	return append(prefixed, data...), nil
+}
+
+// decodeValue, format önekine bakarak doğru serializer ile decode eder.
+func decodeValue(data []byte) (interface{}, error) {
+	if len(data) == 0 || data[0] != 0 {
+		return JSONSerializer{}.Unmarshal(data)
+	}
+
+	end := bytes.IndexByte(data[1:], 0)
+	if end < 0 {
+		return nil, fmt.Errorf("geçersiz cache format öneki")
+	}
+
+	serializer, err := SerializerByName(string(data[1 : end+1]))
+	if err != nil {
+		return nil, err
+	}
+	return serializer.Unmarshal(data[end+2:])
+}
+
+// serializerHolder, driver'lara gömülen serializer ayarıdır.
+type serializerHolder struct {
+	serializerMu sync.RWMutex
+	serializer   Serializer
+}
+
+// SetSerializer, store'un yazarken kullanacağı serializer'ı değiştirir.
+//
+// Okuma her zaman format önekine göre yapılır; bu yüzden serializer
+// değiştirmek mevcut girdileri geçersiz kılmaz.
+func (h *serializerHolder) SetSerializer(serializer Serializer) {
+	h.serializerMu.Lock()
+	defer h.serializerMu.Unlock()
+	h.serializer = serializer
+}
+
+// getSerializer, aktif serializer'ı döndürür (varsayılan: JSON).
+func (h *serializerHolder) getSerializer() Serializer {
+	h.serializerMu.RLock()
+	defer h.serializerMu.RUnlock()
+
+	if h.serializer == nil {
+		return JSONSerializer{}
+	}
+	return h.serializer
+}
@@ -0,0 +1,257 @@
+// -----------------------------------------------------------------------------
+// Cache Stampede Protection
+// -----------------------------------------------------------------------------
+// Remember() için "thundering herd" koruması.
+//
+// Popüler bir key expire olduğunda, aynı anda gelen tüm istekler cache miss
+// alır ve pahalı callback'i (örn: ağır bir SQL sorgusu) paralel olarak
+// çalıştırır. Bu dosya iki katmanlı koruma sağlar:
+//
+//  1. Process içi (singleflight): Aynı key için eşzamanlı Remember çağrıları
+//     tek bir callback çalışmasını paylaşır. Tüm driver'larda varsayılan
+//     olarak aktiftir.
+//  2. Distributed lock (opsiyonel): Birden fazla sunucu/worker varsa, sadece
+//     lock'u alan yeniden hesaplar; diğerleri stale veriyi döndürür veya yeni
+//     değerin yazılmasını bekler. Driver'ın Locker implement etmesi gerekir
+//     (Redis).
+//
+// Kullanım:
+//
+//	redisCache.SetStampedeOptions(cache.StampedeOptions{
+//	    Lock:        true,
+//	    LockTTL:     10 * time.Second,
+//	    WaitTimeout: 5 * time.Second,
+//	    StaleTTL:    time.Minute, // Yeniden hesaplama sürerken eski değer servis edilir
+//	})
+// -----------------------------------------------------------------------------
+
+package cache
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// Locker, distributed lock desteği sunan cache driver'lar tarafından
+// implement edilir.
+type Locker interface {
+	// Lock, lock'u almaya çalışır (non-blocking).
+	//
+	// Döndürür:
+	//   - string: Lock sahibini doğrulayan token (Unlock için gerekli)
+	//   - bool: Lock alındıysa true
+	//   - error: Driver hatası
+	Lock(name string, ttl time.Duration) (string, bool, error)
+
+	// Unlock, lock'u sadece token eşleşiyorsa bırakır.
+	Unlock(name, token string) error
+}
+
+// StampedeOptions, Remember stampede koruması ayarları.
+type StampedeOptions struct {
+	Lock         bool          // Distributed lock kullan (driver Locker değilse yok sayılır)
+	LockTTL      time.Duration // Lock'un otomatik düşme süresi (callback'ten uzun olmalı)
+	WaitTimeout  time.Duration // Lock alınamazsa yeni değer için maksimum bekleme
+	PollInterval time.Duration // Beklerken cache kontrol aralığı
+	StaleTTL     time.Duration // > 0 ise değerin bir kopyası ttl+StaleTTL boyunca tutulur
+}
+
+// DefaultStampedeOptions, varsayılan ayarları döndürür (sadece process içi koruma).
+func DefaultStampedeOptions() StampedeOptions {
+	return StampedeOptions{
+		Lock:         false,
+		LockTTL:      10 * time.Second,
+		WaitTimeout:  5 * time.Second,
+		PollInterval: 50 * time.Millisecond,
+	}
+}
+
+// withDefaults, boş bırakılan süreleri varsayılanlarla doldurur.
+func (o StampedeOptions) withDefaults() StampedeOptions {
+	defaults := DefaultStampedeOptions()
+	if o.LockTTL <= 0 {
+		o.LockTTL = defaults.LockTTL
+	}
+	if o.WaitTimeout <= 0 {
+		o.WaitTimeout = defaults.WaitTimeout
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaults.PollInterval
+	}
+	return o
+}
+
+// staleKey, stale kopyanın saklandığı key'i döndürür.
+func staleKey(key string) string {
+	return "stale:" + key
+}
+
+// lockKey, distributed lock key'ini döndürür.
+func lockKey(key string) string {
+	return "lock:remember:" + key
+}
+
+// -----------------------------------------------------------------------------
+// Singleflight
+// -----------------------------------------------------------------------------
+
+// flightCall, devam eden tek bir callback çalışması.
+type flightCall struct {
+	wg  sync.WaitGroup
+	val interface{}
+	err error
+}
+
+// flightGroup, aynı key için eşzamanlı çağrıları tek çalışmada birleştirir.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// do, key için fn'i çalıştırır; aynı anda gelen diğer çağrılar sonucu bekler.
+func (g *flightGroup) do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.val, call.err
+	}
+
+	call := &flightCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		call.wg.Done()
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+	}()
+
+	call.val, call.err = fn()
+	return call.val, call.err
+}
+
+// -----------------------------------------------------------------------------
+// Stampede Guard
+// -----------------------------------------------------------------------------
+
+// stampedeGuard, driver'lara gömülen Remember koruma katmanı.
+type stampedeGuard struct {
+	group  flightGroup
+	optsMu sync.RWMutex
+	opts   *StampedeOptions // nil = varsayılanlar
+}
+
+// SetStampedeOptions, Remember stampede koruması ayarlarını değiştirir.
+func (s *stampedeGuard) SetStampedeOptions(opts StampedeOptions) {
+	opts = opts.withDefaults()
+	s.optsMu.Lock()
+	defer s.optsMu.Unlock()
+	s.opts = &opts
+}
+
+// stampedeOptions, aktif ayarları döndürür.
+func (s *stampedeGuard) stampedeOptions() StampedeOptions {
+	s.optsMu.RLock()
+	defer s.optsMu.RUnlock()
+	if s.opts == nil {
+		return DefaultStampedeOptions()
+	}
+	return *s.opts
+}
+
+// remember, stampede korumalı Remember implementasyonu.
+//
+// store driver'ın kendisidir; locker nil ise sadece process içi koruma uygulanır.
+func (s *stampedeGuard) remember(store Cache, locker Locker, logger *log.Logger, key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	val, err := store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if val != nil {
+		return val, nil
+	}
+
+	return s.group.do(key, func() (interface{}, error) {
+		// Bu flight başlamadan hemen önce başka biri yazmış olabilir
+		if val, err := store.Get(key); err == nil && val != nil {
+			return val, nil
+		}
+
+		opts := s.stampedeOptions()
+		if opts.Lock && locker != nil {
+			return s.rememberLocked(store, locker, logger, opts, key, ttl, callback)
+		}
+		return s.compute(store, logger, opts, key, ttl, callback)
+	})
+}
+
+// rememberLocked, distributed lock ile yeniden hesaplama yapar.
+//
+// Lock alınamazsa önce stale değer denenir, yoksa lock sahibinin değeri
+// yazması beklenir. Bekleme süresi dolarsa callback yine de çalıştırılır
+// (lock sahibi çökmüş olabilir).
+func (s *stampedeGuard) rememberLocked(store Cache, locker Locker, logger *log.Logger, opts StampedeOptions, key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	token, acquired, err := locker.Lock(lockKey(key), opts.LockTTL)
+	if err != nil {
+		logger.Printf("⚠️  Remember lock hatası [%s]: %v", key, err)
+		return s.compute(store, logger, opts, key, ttl, callback)
+	}
+
+	if acquired {
+		defer func() {
+			if err := locker.Unlock(lockKey(key), token); err != nil {
+				logger.Printf("⚠️  Remember unlock hatası [%s]: %v", key, err)
+			}
+		}()
+		return s.compute(store, logger, opts, key, ttl, callback)
+	}
+
+	// Başka bir worker hesaplıyor: stale veri varsa hemen döndür
+	if opts.StaleTTL > 0 {
+		if stale, err := store.Get(staleKey(key)); err == nil && stale != nil {
+			return stale, nil
+		}
+	}
+
+	deadline := time.Now().Add(opts.WaitTimeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(opts.PollInterval)
+		if val, err := store.Get(key); err == nil && val != nil {
+			return val, nil
+		}
+	}
+
+	logger.Printf("⚠️  Remember lock bekleme süresi doldu, yeniden hesaplanıyor [%s]", key)
+	return s.compute(store, logger, opts, key, ttl, callback)
+}
+
+// compute, callback'i çalıştırır ve sonucu (gerekirse stale kopyasıyla) yazar.
+func (s *stampedeGuard) compute(store Cache, logger *log.Logger, opts StampedeOptions, key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	result, err := callback()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.Set(key, result, ttl); err != nil {
+		logger.Printf("⚠️  Remember cache yazma hatası [%s]: %v", key, err)
+	}
+
+	if opts.StaleTTL > 0 {
+		staleTTL := ttl + opts.StaleTTL
+		if ttl == 0 {
+			staleTTL = 0
+		}
+		if err := store.Set(staleKey(key), result, staleTTL); err != nil {
+			logger.Printf("⚠️  Remember stale yazma hatası [%s]: %v", key, err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,194 @@
+// -----------------------------------------------------------------------------
+// Tagged Cache
+// -----------------------------------------------------------------------------
+// Laravel-style cache tags implementation.
+//
+// İlişkili key'leri bir veya daha fazla tag altında gruplar; böylece bir
+// kullanıcı değiştiğinde tüm cache'i flush etmek yerine sadece o kullanıcıya
+// ait key'ler toplu olarak silinebilir.
+//
+// Tag üyelikleri driver'a özel bir index'te tutulur:
+// - Redis: Her tag için bir SET (SADD/SMEMBERS)
+// - Memory: tag -> key map'i
+// - File: Her tag için bir index dosyası
+// -----------------------------------------------------------------------------
+
+package cache
+
+import (
+	"fmt"
+	"time"
+)
+
+// tagIndex, tag -> key üyeliklerini saklayan driver'a özel index.
+//
+// Her cache driver bu interface'i implement eder ve Tags() metodunda
+// kendisini index olarak TaggedCache'e verir.
+type tagIndex interface {
+	// addTagMembers, key'leri tag'in üye listesine ekler.
+	addTagMembers(tag string, keys ...string) error
+
+	// tagMembers, tag'e ait tüm key'leri döndürür.
+	tagMembers(tag string) ([]string, error)
+
+	// forgetTag, tag'in üye listesini siler (key'lere dokunmaz).
+	forgetTag(tag string) error
+}
+
+// TaggedCache, belirli tag'lere bağlı cache operasyonlarını yönetir.
+//
+// Key'ler olduğu gibi (tag namespace'i olmadan) saklanır; yani tag'li
+// yazılan bir değer tag'siz Get ile de okunabilir. Tag'ler sadece toplu
+// silme (Flush) için kullanılır.
+//
+// Örnek:
+//
+//	cache.Tags("users", "profiles").Set("user:123", user, 10*time.Minute)
+//	cache.Tags("users").Flush() // user:123 ve "users" tag'li tüm key'ler silinir
+type TaggedCache struct {
+	store Cache
+	index tagIndex
+	tags  []string
+}
+
+// newTaggedCache, driver ve index ile yeni bir TaggedCache oluşturur.
+func newTaggedCache(store Cache, index tagIndex, tags []string) *TaggedCache {
+	return &TaggedCache{
+		store: store,
+		index: index,
+		tags:  tags,
+	}
+}
+
+// GetTags, bu cache instance'ının tag'lerini döndürür.
+func (t *TaggedCache) GetTags() []string {
+	return t.tags
+}
+
+// Get, cache'den veri okur.
+func (t *TaggedCache) Get(key string) (interface{}, error) {
+	return t.store.Get(key)
+}
+
+// Set, cache'e veri yazar ve key'i tüm tag'lere kaydeder.
+func (t *TaggedCache) Set(key string, value interface{}, ttl time.Duration) error {
+	if err := t.store.Set(key, value, ttl); err != nil {
+		return err
+	}
+	return t.tagKeys(key)
+}
+
+// Has, key'in varlığını kontrol eder.
+func (t *TaggedCache) Has(key string) (bool, error) {
+	return t.store.Has(key)
+}
+
+// Delete, cache'den veri siler.
+func (t *TaggedCache) Delete(key string) error {
+	return t.store.Delete(key)
+}
+
+// Pull, key'i okur ve siler.
+func (t *TaggedCache) Pull(key string) (interface{}, error) {
+	return t.store.Pull(key)
+}
+
+// Add, key yoksa yazar; yazıldıysa key'i tag'lere kaydeder.
+func (t *TaggedCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	added, err := t.store.Add(key, value, ttl)
+	if err != nil || !added {
+		return added, err
+	}
+	return true, t.tagKeys(key)
+}
+
+// Forever, değeri süresiz yazar ve key'i tag'lere kaydeder.
+func (t *TaggedCache) Forever(key string, value interface{}) error {
+	return t.Set(key, value, 0)
+}
+
+// GetWithTTL, değeri kalan süresiyle birlikte okur.
+func (t *TaggedCache) GetWithTTL(key string) (interface{}, time.Duration, error) {
+	return t.store.GetWithTTL(key)
+}
+
+// Touch, key'in TTL'ini günceller (tag üyeliği değişmez).
+func (t *TaggedCache) Touch(key string, ttl time.Duration) (bool, error) {
+	return t.store.Touch(key, ttl)
+}
+
+// Remember, cache'den okur veya callback'i çalıştırıp tag'li olarak cache'ler.
+//
+// Driver'ın Remember'ı kullanıldığı için stampede koruması burada da geçerlidir;
+// key sadece yeniden hesaplandığında tag'lere kaydedilir.
+func (t *TaggedCache) Remember(key string, ttl time.Duration, callback func() (interface{}, error)) (interface{}, error) {
+	computed := false
+	result, err := t.store.Remember(key, ttl, func() (interface{}, error) {
+		computed = true
+		return callback()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if computed {
+		if err := t.tagKeys(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// SetMultiple, birden fazla key-value'yi yazar ve tag'lere kaydeder.
+func (t *TaggedCache) SetMultiple(values map[string]interface{}, ttl time.Duration) error {
+	if err := t.store.SetMultiple(values, ttl); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	return t.tagKeys(keys...)
+}
+
+// Flush, tag'lere ait tüm key'leri ve tag index'lerini siler.
+//
+// Sadece bu instance'ın tag'lerinden en az birine sahip key'ler silinir;
+// cache'in geri kalanına dokunulmaz.
+//
+// Örnek:
+//
+//	// Kullanıcı güncellendiğinde
+//	cache.Tags("user:123").Flush()
+func (t *TaggedCache) Flush() error {
+	for _, tag := range t.tags {
+		keys, err := t.index.tagMembers(tag)
+		if err != nil {
+			return fmt.Errorf("tag members read failed [%s]: %w", tag, err)
+		}
+
+		if len(keys) > 0 {
+			if err := t.store.DeleteMultiple(keys); err != nil {
+				return fmt.Errorf("tag flush failed [%s]: %w", tag, err)
+			}
+		}
+
+		if err := t.index.forgetTag(tag); err != nil {
+			return fmt.Errorf("tag forget failed [%s]: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// tagKeys, key'leri tüm tag'lerin üye listesine ekler.
+func (t *TaggedCache) tagKeys(keys ...string) error {
+	for _, tag := range t.tags {
+		if err := t.index.addTagMembers(tag, keys...); err != nil {
+			return fmt.Errorf("tag index write failed [%s]: %w", tag, err)
+		}
+	}
+	return nil
+}
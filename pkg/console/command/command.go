@@ -0,0 +1,203 @@
+// -----------------------------------------------------------------------------
+// Console Commands
+// -----------------------------------------------------------------------------
+// Uygulamaya özel CLI komutları (Laravel Artisan command karşılığı). Her
+// komut init fonksiyonunda Register ile kaydolur; `conduit` kayıtlı
+// komutları bulur, help'te listeler ve çalıştırır.
+//
+// Komutlar `conduit make:command SyncExchangeRates` ile internal/commands
+// altında oluşturulur:
+//
+//	func init() {
+//	    command.Register(&SyncExchangeRates{})
+//	}
+//
+//	type SyncExchangeRates struct {
+//	    base string
+//	}
+//
+//	func (cmd *SyncExchangeRates) Name() string        { return "rates:sync" }
+//	func (cmd *SyncExchangeRates) Description() string { return "Sync exchange rates" }
+//
+//	func (cmd *SyncExchangeRates) Configure(fs *flag.FlagSet) {
+//	    fs.StringVar(&cmd.base, "base", "EUR", "Base currency")
+//	}
+//
+//	func (cmd *SyncExchangeRates) Handle(c *container.Container, args []string) error {
+//	    db := container.GetDatabase(c)
+//	    ...
+//	}
+//
+// Çalıştırma:
+//
+//	conduit rates:sync --base=USD
+//
+// Komutlar DI container'ı alır; config, logger, veritabanı, grammar ve
+// cache container'dan çözülür. Bağlantılar ilk kullanımda açılır.
+// -----------------------------------------------------------------------------
+
+package command
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+// Command, conduit'e eklenen bir uygulama komutudur.
+type Command interface {
+	// Name, komutun çağrıldığı addır (örn: "rates:sync").
+	Name() string
+
+	// Description, help'te gösterilen tek satırlık açıklamadır.
+	Description() string
+
+	// Handle, komutu çalıştırır. args, flag'ler ayrıldıktan sonra kalan
+	// pozisyonel argümanlardır.
+	Handle(c *container.Container, args []string) error
+}
+
+// Configurable, flag tanımlayan komutların opsiyonel arayüzüdür.
+type Configurable interface {
+	// Configure, komutun flag'lerini fs'e tanımlar.
+	Configure(fs *flag.FlagSet)
+}
+
+// Usager, help'te pozisyonel argümanları gösteren komutların opsiyonel
+// arayüzüdür.
+type Usager interface {
+	// Usage, komut adından sonraki argümanlardır (örn: "<currency>").
+	Usage() string
+}
+
+// Global command registry
+var (
+	registry   = make(map[string]Command)
+	registryMu sync.RWMutex
+)
+
+// Register, komutu adıyla kaydeder. Aynı adla ikinci kayıt panic'e yol
+// açar.
+func Register(cmd Command) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	name := cmd.Name()
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("command: %s zaten kayıtlı", name))
+	}
+	registry[name] = cmd
+}
+
+// Get, adı verilen komutu döndürür.
+func Get(name string) (Command, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	cmd, ok := registry[name]
+	return cmd, ok
+}
+
+// Registered, kayıtlı komutları ada göre sıralı döndürür.
+func Registered() []Command {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	commands := make([]Command, 0, len(registry))
+	for _, cmd := range registry {
+		commands = append(commands, cmd)
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].Name() < commands[j].Name()
+	})
+	return commands
+}
+
+// Unregister, komutu registry'den siler (testler için).
+func Unregister(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(registry, name)
+}
+
+// Execute, komutun flag'lerini args'tan ayrıştırır ve Handle'ı çağırır.
+//
+// Flag'ler pozisyonel argümanlardan önce veya sonra verilebilir
+// (`rates:sync USD --force` ve `rates:sync --force USD` aynıdır).
+// `--help` komutun kullanımını yazdırır ve nil döner.
+//
+// Parametreler:
+//   - cmd: Çalıştırılacak komut
+//   - c: Komuta verilecek container
+//   - args: Komut adından sonraki argümanlar
+//
+// Döndürür:
+//   - error: Flag hatası veya Handle'ın döndürdüğü hata
+func Execute(cmd Command, c *container.Container, args []string) error {
+	fs := NewFlagSet(cmd, os.Stderr)
+
+	positional, err := parseInterleaved(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return cmd.Handle(c, positional)
+}
+
+// NewFlagSet, komutun flag'lerini tanımlı bir FlagSet oluşturur; kullanım
+// ve hata mesajları output'a yazılır.
+func NewFlagSet(cmd Command, output io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd.Name(), flag.ContinueOnError)
+	fs.SetOutput(output)
+	if configurable, ok := cmd.(Configurable); ok {
+		configurable.Configure(fs)
+	}
+
+	fs.Usage = func() {
+		usage := cmd.Name()
+		if u, ok := cmd.(Usager); ok {
+			usage += " " + u.Usage()
+		}
+		fmt.Fprintf(output, "%s\n\nUsage:\n  conduit %s [flags]\n", cmd.Description(), usage)
+
+		hasFlags := false
+		fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintln(output, "\nFlags:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// parseInterleaved, flag'leri pozisyonel argümanların arasından da
+// ayrıştırır ve pozisyonel argümanları döndürür.
+func parseInterleaved(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+
+		// "--" sonrası her şey pozisyoneldir
+		if len(args) > fs.NArg() && args[len(args)-fs.NArg()-1] == "--" {
+			return append(positional, fs.Args()...), nil
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
@@ -0,0 +1,148 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/console"
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+// ApplicationGroup, uygulama komutlarının help'teki bölümüdür.
+const ApplicationGroup = "APPLICATION COMMANDS"
+
+// Definition, kernel'e eklenen tek bir komuttur.
+type Definition struct {
+	Name        string
+	Aliases     []string // Aynı komutu çalıştıran diğer adlar (örn: --help)
+	Usage       string   // Help'te addan sonra gösterilen argümanlar (örn: "<name>")
+	Description string
+	Group       string // Help bölümü (örn: "QUEUE COMMANDS")
+	Run         func(args []string)
+}
+
+// BootFunc, uygulama komutları için container'ı kurar. Dönen close
+// fonksiyonu komut bittikten sonra çağrılır.
+type BootFunc func() (*container.Container, func(), error)
+
+// Kernel, komut adlarını handler'lara eşler ve help çıktısını üretir.
+// Built-in komutlar Add, uygulama komutları AddCommand ile eklenir.
+type Kernel struct {
+	mu          sync.RWMutex
+	definitions []Definition
+	index       map[string]int // Ad ve alias -> definitions indexi
+}
+
+// NewKernel, boş bir Kernel oluşturur.
+func NewKernel() *Kernel {
+	return &Kernel{index: make(map[string]int)}
+}
+
+// Add, komutu kernel'e ekler. Aynı ad veya alias ikinci kez eklenirse panic
+// oluşur.
+func (k *Kernel) Add(def Definition) *Kernel {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for _, name := range append([]string{def.Name}, def.Aliases...) {
+		if _, exists := k.index[name]; exists {
+			panic(fmt.Sprintf("command: %s zaten tanımlı", name))
+		}
+		k.index[name] = len(k.definitions)
+	}
+	k.definitions = append(k.definitions, def)
+	return k
+}
+
+// AddCommand, uygulama komutunu ApplicationGroup altında ekler. Komut
+// çalıştığında boot ile container kurulur, flag'ler ayrıştırılır ve Handle
+// çağrılır; hata olursa mesaj yazılıp 1 koduyla çıkılır.
+func (k *Kernel) AddCommand(cmd Command, boot BootFunc) *Kernel {
+	usage := ""
+	if u, ok := cmd.(Usager); ok {
+		usage = u.Usage()
+	}
+
+	return k.Add(Definition{
+		Name:        cmd.Name(),
+		Usage:       usage,
+		Description: cmd.Description(),
+		Group:       ApplicationGroup,
+		Run: func(args []string) {
+			c, closeFn, err := boot()
+			if err != nil {
+				console.Fatal("Application could not be started: %v", err)
+			}
+
+			err = Execute(cmd, c, args)
+			closeFn()
+			if err != nil {
+				console.Fatal("%s: %v", cmd.Name(), err)
+			}
+		},
+	})
+}
+
+// Find, ad veya alias ile komutu bulur.
+func (k *Kernel) Find(name string) (Definition, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	i, ok := k.index[name]
+	if !ok {
+		return Definition{}, false
+	}
+	return k.definitions[i], true
+}
+
+// Definitions, komutları eklenme sırasıyla döndürür.
+func (k *Kernel) Definitions() []Definition {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return append([]Definition(nil), k.definitions...)
+}
+
+// Run, name komutunu args ile çalıştırır. Komut yoksa false döner.
+func (k *Kernel) Run(name string, args []string) bool {
+	def, ok := k.Find(name)
+	if !ok {
+		return false
+	}
+	def.Run(args)
+	return true
+}
+
+// Help, komutları bölümlere ayrılmış olarak w'ye yazar. Bölümler ilk
+// komutlarının eklenme sırasıyla gösterilir.
+//
+// Çıktı:
+//
+//	QUEUE COMMANDS:
+//	  queue:work                 Start queue worker
+//	  queue:retry <id|--all>     Push failed job(s) back onto their queue
+func (k *Kernel) Help(w io.Writer) {
+	var (
+		groups []string
+		byName = make(map[string][]Definition)
+	)
+	for _, def := range k.Definitions() {
+		if _, seen := byName[def.Group]; !seen {
+			groups = append(groups, def.Group)
+		}
+		byName[def.Group] = append(byName[def.Group], def)
+	}
+
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s:\n", group)
+		for _, def := range byName[group] {
+			usage := strings.TrimSpace(def.Name + " " + def.Usage)
+			fmt.Fprintf(w, "  %-26s %s\n", usage, def.Description)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+// -----------------------------------------------------------------------------
+// Console Output
+// -----------------------------------------------------------------------------
+// CLI komutları için ortak çıktı, prompt ve progress bar katmanı (Laravel
+// Artisan'ın $this->info/table/confirm/secret karşılığı).
+//
+// Kullanım:
+//
+//	console.Info("Running database migrations...")
+//	console.Success("%d migration(s) migrated", n)
+//	console.Table([]string{"Migration", "Status"}, rows)
+//
+//	if !console.Confirm("Drop all tables?", false) {
+//	    return
+//	}
+//
+//	bar := console.NewProgressBar(len(jobs))
+//	for _, job := range jobs {
+//	    retry(job)
+//	    bar.Advance()
+//	}
+//	bar.Finish()
+//
+// Renkler sadece çıktı bir terminale yazılıyorsa kullanılır. NO_COLOR
+// tanımlıysa veya TERM=dumb ise kapalıdır; FORCE_COLOR ile zorlanabilir.
+// Pipe'a veya dosyaya yazarken progress bar ara adımları çizmez, sadece
+// bitişte tek satır yazar; böylece log'lar okunabilir kalır.
+// -----------------------------------------------------------------------------
+
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Style, ANSI renk/biçim kodudur.
+type Style string
+
+const (
+	Reset  Style = "\033[0m"
+	Bold   Style = "\033[1m"
+	Dim    Style = "\033[2m"
+	Red    Style = "\033[31m"
+	Green  Style = "\033[32m"
+	Yellow Style = "\033[33m"
+	Cyan   Style = "\033[36m"
+)
+
+// Console, komut çıktısını ve kullanıcı girdisini yönetir. Eşzamanlı
+// kullanım için güvenlidir.
+type Console struct {
+	mu sync.Mutex
+
+	in     *bufio.Reader
+	inFile *os.File // Secret için terminal (değilse nil)
+	out    io.Writer
+	errOut io.Writer
+
+	color       bool
+	interactive bool         // out bir terminal mi (progress bar yeniden çizilir)
+	bar         *ProgressBar // Ekranda aktif progress bar
+}
+
+// std, paket seviyesindeki fonksiyonların kullandığı varsayılan console'dur.
+var std = New(os.Stdin, os.Stdout, os.Stderr)
+
+// New, yeni bir Console oluşturur.
+//
+// Parametreler:
+//   - in: Prompt cevaplarının okunacağı kaynak
+//   - out: Normal çıktı
+//   - errOut: Hata mesajları
+//
+// Döndürür:
+//   - *Console: out terminalse renkli ve interaktif console
+//
+// Örnek:
+//
+//	var buf bytes.Buffer
+//	c := console.New(strings.NewReader("yes\n"), &buf, &buf)
+func New(in io.Reader, out, errOut io.Writer) *Console {
+	c := &Console{
+		in:     bufio.NewReader(in),
+		out:    out,
+		errOut: errOut,
+	}
+	if f, ok := in.(*os.File); ok && isTerminal(f) {
+		c.inFile = f
+	}
+	if f, ok := out.(*os.File); ok && isTerminal(f) {
+		c.interactive = true
+		c.color = colorEnabled()
+	}
+	if os.Getenv("FORCE_COLOR") != "" {
+		c.color = true
+	}
+	return c
+}
+
+// Default, paket seviyesindeki fonksiyonların kullandığı console'u döndürür.
+func Default() *Console {
+	return std
+}
+
+// SetColor, renkli çıktıyı açar veya kapatır.
+func (c *Console) SetColor(enabled bool) *Console {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.color = enabled
+	return c
+}
+
+// Colorize, s'yi style ile boyar; renkler kapalıysa s'yi aynen döndürür.
+func (c *Console) Colorize(style Style, s string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.colorize(style, s)
+}
+
+// Line, biçimlendirilmiş düz bir satır yazar.
+func (c *Console) Line(format string, args ...interface{}) {
+	c.writeLine(c.out, "", "", format, args...)
+}
+
+// Info, bilgi mesajı yazar (ℹ️).
+func (c *Console) Info(format string, args ...interface{}) {
+	c.writeLine(c.out, "ℹ️  ", Cyan, format, args...)
+}
+
+// Success, başarı mesajı yazar (✅).
+func (c *Console) Success(format string, args ...interface{}) {
+	c.writeLine(c.out, "✅ ", Green, format, args...)
+}
+
+// Warn, uyarı mesajı yazar (⚠️).
+func (c *Console) Warn(format string, args ...interface{}) {
+	c.writeLine(c.out, "⚠️  ", Yellow, format, args...)
+}
+
+// Error, hata mesajını hata çıktısına yazar (❌).
+func (c *Console) Error(format string, args ...interface{}) {
+	c.writeLine(c.errOut, "❌ ", Red, format, args...)
+}
+
+// Fatal, hata mesajını yazar ve 1 koduyla çıkar.
+func (c *Console) Fatal(format string, args ...interface{}) {
+	c.Error(format, args...)
+	os.Exit(1)
+}
+
+// Title, komut başlığını kalın ve altı çizili yazar.
+func (c *Console) Title(title string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clearBar()
+	fmt.Fprintln(c.out, c.colorize(Bold, title))
+	fmt.Fprintln(c.out, strings.Repeat("═", displayWidth(title)))
+	c.redrawBar()
+}
+
+// NewLine, count kadar boş satır yazar (varsayılan 1).
+func (c *Console) NewLine(count ...int) {
+	n := 1
+	if len(count) > 0 {
+		n = count[0]
+	}
+	c.Line("%s", strings.Repeat("\n", n-1))
+}
+
+// writeLine, prefix'li ve stilli tek satır yazar. Aktif progress bar varsa
+// satır barın üstüne yazılır ve bar yeniden çizilir.
+func (c *Console) writeLine(w io.Writer, prefix string, style Style, format string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	message := fmt.Sprintf(format, args...)
+	if style != "" {
+		message = c.colorize(style, message)
+	}
+
+	c.clearBar()
+	fmt.Fprintln(w, prefix+message)
+	c.redrawBar()
+}
+
+// colorize, kilit tutulurken çağrılır.
+func (c *Console) colorize(style Style, s string) string {
+	if !c.color || s == "" {
+		return s
+	}
+	return string(style) + s + string(Reset)
+}
+
+// clearBar, aktif progress bar'ın satırını siler (kilit tutulurken).
+func (c *Console) clearBar() {
+	if c.bar != nil && c.interactive {
+		fmt.Fprint(c.out, "\r\033[K")
+	}
+}
+
+// redrawBar, aktif progress bar'ı yeniden çizer (kilit tutulurken).
+func (c *Console) redrawBar() {
+	if c.bar != nil && c.interactive {
+		fmt.Fprint(c.out, c.bar.render())
+	}
+}
+
+// isTerminal, f'nin bir karakter cihazı (terminal) olup olmadığını belirler.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// colorEnabled, ortam değişkenlerine göre renklerin açık olup olmadığını
+// belirler (https://no-color.org).
+func colorEnabled() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	return os.Getenv("TERM") != "dumb"
+}
+
+// -----------------------------------------------------------------------------
+// Package-level helpers (varsayılan console)
+// -----------------------------------------------------------------------------
+
+// Line, varsayılan console'a düz satır yazar.
+func Line(format string, args ...interface{}) { std.Line(format, args...) }
+
+// Info, varsayılan console'a bilgi mesajı yazar.
+func Info(format string, args ...interface{}) { std.Info(format, args...) }
+
+// Success, varsayılan console'a başarı mesajı yazar.
+func Success(format string, args ...interface{}) { std.Success(format, args...) }
+
+// Warn, varsayılan console'a uyarı mesajı yazar.
+func Warn(format string, args ...interface{}) { std.Warn(format, args...) }
+
+// Error, varsayılan console'a hata mesajı yazar.
+func Error(format string, args ...interface{}) { std.Error(format, args...) }
+
+// Fatal, varsayılan console'a hata mesajı yazar ve çıkar.
+func Fatal(format string, args ...interface{}) { std.Fatal(format, args...) }
+
+// Title, varsayılan console'a başlık yazar.
+func Title(title string) { std.Title(title) }
+
+// NewLine, varsayılan console'a boş satır yazar.
+func NewLine(count ...int) { std.NewLine(count...) }
+
+// Colorize, varsayılan console'un renk ayarıyla s'yi boyar.
+func Colorize(style Style, s string) string { return std.Colorize(style, s) }
+
+// Table, varsayılan console'a tablo yazar.
+func Table(headers []string, rows [][]string) { std.Table(headers, rows) }
+
+// Confirm, varsayılan console'da evet/hayır sorar.
+func Confirm(question string, defaultYes bool) bool { return std.Confirm(question, defaultYes) }
+
+// Ask, varsayılan console'da serbest metin sorar.
+func Ask(question, defaultValue string) string { return std.Ask(question, defaultValue) }
+
+// Secret, varsayılan console'da girdiyi göstermeden sorar.
+func Secret(question string) (string, error) { return std.Secret(question) }
+
+// NewProgressBar, varsayılan console'da progress bar başlatır.
+func NewProgressBar(total int) *ProgressBar { return std.NewProgressBar(total) }
@@ -0,0 +1,114 @@
+package console
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// progressBarWidth, barın karakter genişliğidir.
+const progressBarWidth = 28
+
+// ProgressBar, uzun süren işlemlerin ilerlemesini gösterir.
+//
+// Terminalde her Advance'te aynı satır yeniden çizilir; terminal değilse
+// sadece Finish'te tek satır yazılır. Bar aktifken console'a yazılan
+// mesajlar barın üstüne basılır.
+type ProgressBar struct {
+	console *Console
+	total   int
+	current int
+	start   time.Time
+	done    bool
+}
+
+// NewProgressBar, total adımlı bir progress bar başlatır ve çizer.
+//
+// Örnek:
+//
+//	bar := c.NewProgressBar(len(jobs))
+//	for _, job := range jobs {
+//	    process(job)
+//	    bar.Advance()
+//	}
+//	bar.Finish()
+func (c *Console) NewProgressBar(total int) *ProgressBar {
+	bar := &ProgressBar{console: c, total: total, start: time.Now()}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clearBar()
+	c.bar = bar
+	c.redrawBar()
+	return bar
+}
+
+// Advance, ilerlemeyi step kadar (varsayılan 1) artırır.
+func (b *ProgressBar) Advance(step ...int) {
+	n := 1
+	if len(step) > 0 {
+		n = step[0]
+	}
+
+	c := b.console
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if b.done {
+		return
+	}
+	b.current += n
+	if b.total > 0 && b.current > b.total {
+		b.current = b.total
+	}
+	if c.bar == b && c.interactive {
+		fmt.Fprint(c.out, "\r\033[K"+b.render())
+	}
+}
+
+// Current, tamamlanan adım sayısını döndürür.
+func (b *ProgressBar) Current() int {
+	b.console.mu.Lock()
+	defer b.console.mu.Unlock()
+
+	return b.current
+}
+
+// Finish, barı son haliyle yazar ve satırı kapatır. Birden fazla
+// çağrılabilir.
+func (b *ProgressBar) Finish() {
+	c := b.console
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if b.done {
+		return
+	}
+	b.done = true
+
+	c.clearBar()
+	if c.bar == b {
+		c.bar = nil
+	}
+	fmt.Fprintln(c.out, b.render())
+}
+
+// render, barın mevcut halini döndürür (kilit tutulurken):
+//
+//	12/40 [████████░░░░░░░░░░░░░░░░░░░░]  30% 2s
+func (b *ProgressBar) render() string {
+	percent := 100
+	if b.total > 0 {
+		percent = b.current * 100 / b.total
+	}
+
+	filled := progressBarWidth * percent / 100
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+
+	count := fmt.Sprintf("%d/%d", b.current, b.total)
+	elapsed := time.Since(b.start).Round(time.Second)
+
+	return fmt.Sprintf(" %*s [%s] %3d%% %s",
+		len(fmt.Sprint(b.total))*2+1, count, b.console.colorize(Green, bar), percent, elapsed)
+}
@@ -0,0 +1,124 @@
+package console
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Confirm, evet/hayır sorusu sorar.
+//
+// "y", "yes", "e", "evet" true; "n", "no", "h", "hayır" false döner. Boş cevap
+// veya girdinin bitmesi (EOF, örn: CI'da stdin kapalıysa) varsayılanı seçer.
+// Geçersiz cevapta soru tekrarlanır.
+//
+// Parametreler:
+//   - question: Soru
+//   - defaultYes: Boş cevapta dönecek değer
+//
+// Örnek:
+//
+//	if !console.Confirm("This will drop all tables. Continue?", false) {
+//	    console.Line("Operation cancelled")
+//	    return
+//	}
+func (c *Console) Confirm(question string, defaultYes bool) bool {
+	hint := "[y/N]"
+	if defaultYes {
+		hint = "[Y/n]"
+	}
+
+	for {
+		answer, err := c.prompt(fmt.Sprintf("%s %s ", question, hint))
+		switch strings.ToLower(answer) {
+		case "y", "yes", "e", "evet":
+			return true
+		case "n", "no", "h", "hayır":
+			return false
+		case "":
+			return defaultYes
+		}
+		if err != nil {
+			return defaultYes
+		}
+		c.Warn("Please answer yes or no")
+	}
+}
+
+// Ask, serbest metin sorar; boş cevapta defaultValue döner.
+//
+// Örnek:
+//
+//	name := console.Ask("Seeder name", "DatabaseSeeder")
+func (c *Console) Ask(question, defaultValue string) string {
+	label := question
+	if defaultValue != "" {
+		label += fmt.Sprintf(" [%s]", defaultValue)
+	}
+
+	answer, _ := c.prompt(label + ": ")
+	if answer == "" {
+		return defaultValue
+	}
+	return answer
+}
+
+// Secret, girdiyi ekranda göstermeden sorar (şifre, API anahtarı).
+//
+// Girdi bir terminalse yankı `stty -echo` ile kapatılır. stty yoksa (örn:
+// Windows) veya girdi terminal değilse cevap normal okunur; terminalde bu
+// durumda girdinin görüneceğine dair uyarı yazılır.
+//
+// Döndürür:
+//   - string: Cevap (sondaki satır sonu olmadan)
+//   - error: Girdi okunamazsa hata
+func (c *Console) Secret(question string) (string, error) {
+	if c.inFile != nil {
+		if err := stty(c.inFile, "-echo"); err == nil {
+			defer func() {
+				stty(c.inFile, "echo")
+				// Kullanıcının Enter'ı yankılanmadığı için satır burada biter
+				c.Line("")
+			}()
+		} else {
+			c.Warn("Input will be visible (echo could not be disabled: %v)", err)
+		}
+	}
+
+	answer, err := c.prompt(question + ": ")
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("girdi okunamadı: %w", err)
+	}
+	return answer, nil
+}
+
+// prompt, label'ı yazar ve bir satır okur. Okunan satır boşluklardan
+// arındırılır; satır sonu gelmeden EOF olursa okunan kısım ve io.EOF döner.
+func (c *Console) prompt(label string) (string, error) {
+	c.mu.Lock()
+	c.clearBar()
+	fmt.Fprint(c.out, c.colorize(Green, label))
+	c.mu.Unlock()
+
+	line, err := c.in.ReadString('\n')
+	if !c.interactiveInput() {
+		// Pipe'tan okunan cevap ekrana yankılanmaz; satırı tamamla
+		c.Line("")
+	}
+	return strings.TrimSpace(line), err
+}
+
+// interactiveInput, girdinin bir terminalden okunup okunmadığını döndürür.
+func (c *Console) interactiveInput() bool {
+	return c.inFile != nil
+}
+
+// stty, terminal ayarını değiştirir (unix).
+func stty(f *os.File, arg string) error {
+	cmd := exec.Command("stty", arg)
+	cmd.Stdin = f
+	return cmd.Run()
+}
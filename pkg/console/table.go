@@ -0,0 +1,134 @@
+package console
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ansiPattern, genişlik hesaplanırken atlanan renk kodlarıdır.
+var ansiPattern = regexp.MustCompile(`\033\[[0-9;]*m`)
+
+// Table, satırları hizalanmış bir tablo olarak yazar.
+//
+// Hücreler Colorize ile boyanabilir; renk kodları ve emoji genişliği
+// hizalamayı bozmaz.
+//
+// Örnek:
+//
+//	console.Table(
+//	    []string{"ID", "Queue", "Failed At"},
+//	    [][]string{{"1", "default", "2024-01-01 10:00:00"}},
+//	)
+//
+// Çıktı:
+//
+//	+----+---------+---------------------+
+//	| ID | Queue   | Failed At           |
+//	+----+---------+---------------------+
+//	| 1  | default | 2024-01-01 10:00:00 |
+//	+----+---------+---------------------+
+func (c *Console) Table(headers []string, rows [][]string) {
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = displayWidth(header)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) && displayWidth(cell) > widths[i] {
+				widths[i] = displayWidth(cell)
+			}
+		}
+	}
+
+	separator := "+"
+	for _, width := range widths {
+		separator += strings.Repeat("-", width+2) + "+"
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clearBar()
+	fmt.Fprintln(c.out, separator)
+	fmt.Fprintln(c.out, formatRow(headers, widths, func(s string) string { return c.colorize(Bold, s) }))
+	fmt.Fprintln(c.out, separator)
+	for _, row := range rows {
+		fmt.Fprintln(c.out, formatRow(row, widths, nil))
+	}
+	if len(rows) > 0 {
+		fmt.Fprintln(c.out, separator)
+	}
+	c.redrawBar()
+}
+
+// formatRow, hücreleri sütun genişliklerine göre doldurur. Eksik hücreler
+// boş, fazlası yok sayılır.
+func formatRow(cells []string, widths []int, style func(string) string) string {
+	var b strings.Builder
+	b.WriteString("|")
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		padding := strings.Repeat(" ", width-displayWidth(cell))
+		if style != nil {
+			cell = style(cell)
+		}
+		b.WriteString(" " + cell + padding + " |")
+	}
+	return b.String()
+}
+
+// displayWidth, s'nin terminalde kapladığı sütun sayısını yaklaşık hesaplar:
+// renk kodları ve birleştirici karakterler 0, emoji ve geniş (CJK)
+// karakterler 2 sütundur.
+func displayWidth(s string) int {
+	s = ansiPattern.ReplaceAllString(s, "")
+	if isASCII(s) {
+		return len(s)
+	}
+
+	width := 0
+	for _, r := range s {
+		switch {
+		case r == 0x200D || (r >= 0xFE00 && r <= 0xFE0F): // ZWJ, variation selector
+		case isWide(r):
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+// wideRanges, terminalde 2 sütun kaplayan karakter aralıklarıdır.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo
+	{0x231A, 0x231B},   // ⌚ ⌛
+	{0x23E9, 0x23FA},   // ⏩ - ⏺ (⏸ ⏭ dahil)
+	{0x2705, 0x2705},   // ✅
+	{0x274C, 0x274C},   // ❌
+	{0x2E80, 0xA4CF},   // CJK
+	{0xAC00, 0xD7A3},   // Hangul
+	{0xF900, 0xFAFF},   // CJK uyumluluk
+	{0xFF00, 0xFF60},   // Tam genişlik
+	{0x1F300, 0x1FAFF}, // Emoji
+}
+
+// isWide, r'nin 2 sütun kaplayıp kaplamadığını döndürür.
+func isWide(r rune) bool {
+	for _, wide := range wideRanges {
+		if r >= wide[0] && r <= wide[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// isASCII, s sadece ASCII içeriyorsa true döner.
+func isASCII(s string) bool {
+	return utf8.RuneCountInString(s) == len(s)
+}
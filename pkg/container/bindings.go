@@ -0,0 +1,129 @@
+// -----------------------------------------------------------------------------
+// Named & Contextual Bindings
+// -----------------------------------------------------------------------------
+// Çoklu store kurulumlarında (iki Redis cache'i, birden fazla mailer) tek
+// bir interface anahtarı yetmez. Aynı tip için isimli kayıtlar yapılır:
+//
+//	container.RegisterNamed(c, "sessions", func(c *container.Container) (cache.Cache, error) {
+//	    return cache.NewRedisCache(sessionsClient, "sessions:"), nil
+//	})
+//	sessions := container.MustResolveNamed[cache.Cache](c, "sessions")
+//
+// Bağlamsal kayıt, bir servisin fabrikası içinden yapılan isimsiz çözmeyi
+// isimli kayda yönlendirir; tüketicinin kodu değişmez:
+//
+//	// AuthController cache.Cache istediğinde "sessions" kaydını alır,
+//	// diğer servisler varsayılan cache.Cache'i almaya devam eder.
+//	container.WhenNeeds[*controllers.AuthController, cache.Cache](c, "sessions")
+//
+//	// reflect.Type ile:
+//	c.When(authControllerType).Needs(cacheType).Give("sessions")
+//
+// Bağlamsal kayıtlar sadece container'ın oluşturduğu servislere (fabrika
+// içindeki Get/Resolve çağrılarına) uygulanır ve tüketici ilk kez
+// çözülmeden önce tanımlanmalıdır; oluşturulmuş singleton'lar değişmez.
+// -----------------------------------------------------------------------------
+
+package container
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ContextualBinding, When ile başlayan bağlamsal kayıt tanımıdır.
+type ContextualBinding struct {
+	c          *Container
+	consumer   reflect.Type
+	dependency reflect.Type
+}
+
+// When, consumer tipindeki servis için bağlamsal kayıt başlatır.
+//
+// Örnek:
+//
+//	c.When(reflect.TypeOf((*controllers.AuthController)(nil))).
+//	    Needs(reflect.TypeOf((*cache.Cache)(nil)).Elem()).
+//	    Give("sessions")
+func (c *Container) When(consumer reflect.Type) *ContextualBinding {
+	return &ContextualBinding{c: c, consumer: consumer}
+}
+
+// Needs, yönlendirilecek bağımlılık tipini belirler.
+func (b *ContextualBinding) Needs(dependency reflect.Type) *ContextualBinding {
+	b.dependency = dependency
+	return b
+}
+
+// Give, tüketicinin bağımlılığı çözerken kullanacağı isimli kaydı belirler.
+// Boş isim bağlamsal kaydı kaldırır (varsayılan kayıt kullanılır).
+func (b *ContextualBinding) Give(name string) {
+	if b.consumer == nil || b.dependency == nil {
+		panic("container: bağlamsal kayıt için When(...).Needs(...) gerekli")
+	}
+
+	b.c.mu.Lock()
+	defer b.c.mu.Unlock()
+
+	needs := b.c.contextual[b.consumer]
+	if name == "" {
+		delete(needs, b.dependency)
+		return
+	}
+	if needs == nil {
+		needs = make(map[reflect.Type]string)
+		b.c.contextual[b.consumer] = needs
+	}
+	needs[b.dependency] = name
+}
+
+// RegisterNamed, T tipindeki servisi isimle kaydeder; c.RegisterNamed'in
+// tip güvenli karşılığıdır.
+//
+// Örnek:
+//
+//	container.RegisterNamed(c, "reports", func(c *container.Container) (*sql.DB, error) {
+//	    return sql.Open("mysql", reportsDSN)
+//	})
+func RegisterNamed[T any](c *Container, name string, factory func(*Container) (T, error)) {
+	c.RegisterNamed(name, factory)
+}
+
+// ResolveNamed, isimle kaydedilmiş T tipindeki servisi çözer.
+//
+// Örnek:
+//
+//	reports, err := container.ResolveNamed[*sql.DB](c, "reports")
+func ResolveNamed[T any](c *Container, name string) (T, error) {
+	var zero T
+
+	instance, err := c.GetNamed(TypeOf[T](), name)
+	if err != nil {
+		return zero, err
+	}
+
+	service, ok := instance.(T)
+	if !ok {
+		return zero, fmt.Errorf("container: %s tipi (%q) için kayıtlı servis %T", TypeOf[T](), name, instance)
+	}
+	return service, nil
+}
+
+// MustResolveNamed, ResolveNamed'i çağırır ama hata durumunda panic yapar.
+func MustResolveNamed[T any](c *Container, name string) T {
+	service, err := ResolveNamed[T](c, name)
+	if err != nil {
+		panic(err)
+	}
+	return service
+}
+
+// WhenNeeds, Consumer tipindeki servis Dependency istediğinde name adlı
+// kaydın verilmesini sağlar; c.When(...).Needs(...).Give(name) kısaltmasıdır.
+//
+// Örnek:
+//
+//	container.WhenNeeds[*controllers.AuthController, cache.Cache](c, "sessions")
+func WhenNeeds[Consumer, Dependency any](c *Container, name string) {
+	c.When(TypeOf[Consumer]()).Needs(TypeOf[Dependency]()).Give(name)
+}
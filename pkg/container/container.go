@@ -15,18 +15,55 @@ import (
 // Container, bağımlılıkları yöneten DI konteyneridir.
 // Servisleri (hizmetleri) "tembel" (lazy) olarak yükler ve
 // singleton (tekil) olarak saklar.
+//
+// Aynı tip için isimli birden fazla kayıt (RegisterNamed) ve tüketiciye
+// göre değişen bağlamsal kayıtlar (When) yapılabilir; bkz: bindings.go.
 type Container struct {
-	mu        sync.RWMutex
-	factories map[reflect.Type]func(*Container) (any, error)
-	instances map[reflect.Type]any
+	*registry
+
+	// consumer, fabrikası çalışmakta olan servisin tipidir. Fabrikalara
+	// verilen Container bu alanla kopyalanır; bağlamsal kayıtlar buna
+	// göre seçilir (nil: uygulama kodu).
+	consumer reflect.Type
+
+	// resolving, fabrikası çalışmakta olan kayıtların zinciridir
+	// (döngüsel bağımlılık tespiti için).
+	resolving []binding
+}
+
+// registry, Container'ın tüketiciden bağımsız, paylaşılan durumudur.
+type registry struct {
+	mu         sync.RWMutex
+	factories  map[binding]func(*Container) (any, error)
+	instances  map[binding]any
+	building   map[binding]*pending                     // Fabrikası çalışan kayıtlar
+	order      []reflect.Type                           // Kayıt sırası (Implementing için)
+	contextual map[reflect.Type]map[reflect.Type]string // tüketici -> bağımlılık -> kayıt adı
+}
+
+// binding, bir kaydın anahtarıdır; isimsiz kayıtların adı boştur.
+type binding struct {
+	serviceType reflect.Type
+	name        string
+}
+
+// pending, fabrikası çalışmakta olan bir kaydın sonucudur. Aynı kaydı
+// eşzamanlı çözen goroutine'ler fabrikayı tekrar çalıştırmak yerine done
+// kapanana kadar bekler.
+type pending struct {
+	done     chan struct{}
+	instance any
+	err      error
 }
 
 // New, yeni bir boş DI konteyneri oluşturur.
 func New() *Container {
-	return &Container{
-		factories: make(map[reflect.Type]func(*Container) (any, error)),
-		instances: make(map[reflect.Type]any),
-	}
+	return &Container{registry: &registry{
+		factories:  make(map[binding]func(*Container) (any, error)),
+		instances:  make(map[binding]any),
+		building:   make(map[binding]*pending),
+		contextual: make(map[reflect.Type]map[reflect.Type]string),
+	}}
 }
 
 // Register, bir servisi konteynere kaydeder.
@@ -40,12 +77,26 @@ func New() *Container {
 //	    return database.Connect(cfg.DB.DSN)
 //	})
 func (c *Container) Register(provider any) {
+	c.RegisterNamed("", provider)
+}
+
+// RegisterNamed, bir servisi isimle kaydeder. Aynı tip için farklı
+// isimlerle birden fazla kayıt yapılabilir; isimli kayıtlar GetNamed ile
+// veya bağlamsal kayıtlar (When) üzerinden çözülür. Boş isim Register ile
+// aynıdır.
+//
+// Örnek:
+//
+//	c.RegisterNamed("sessions", func(c *Container) (cache.Cache, error) {
+//	    return cache.NewRedisCache(sessionsClient, "sessions:"), nil
+//	})
+func (c *Container) RegisterNamed(name string, provider any) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Gelen 'provider'ın bir fonksiyon olduğunu doğrula
 	providerType := reflect.TypeOf(provider)
-	if providerType.Kind() != reflect.Func {
+	if providerType == nil || providerType.Kind() != reflect.Func {
 		panic(fmt.Sprintf("container: Register() parametresi bir fonksiyon olmalıdır, %T alındı", provider))
 	}
 
@@ -59,49 +110,136 @@ func (c *Container) Register(provider any) {
 		panic("container: Register() fonksiyonu (any, error) döndürmelidir")
 	}
 
-	// Servisin tipini (ilk dönüş değeri) anahtar olarak kullan
+	// Servisin tipini (ilk dönüş değeri) anahtar olarak kullan.
+	// Fabrika tipli (örn: func(*Container) (*sql.DB, error)) olduğu için
+	// reflection ile çağrılan genel bir sarmalayıcıya dönüştürülür.
 	serviceType := providerType.Out(0)
-	c.factories[serviceType] = provider.(func(*Container) (any, error))
+	providerValue := reflect.ValueOf(provider)
+	key := binding{serviceType: serviceType, name: name}
+	if _, exists := c.factories[key]; !exists && name == "" {
+		c.order = append(c.order, serviceType)
+	}
+	c.factories[key] = func(c *Container) (any, error) {
+		out := providerValue.Call([]reflect.Value{reflect.ValueOf(c)})
+		if errVal := out[1].Interface(); errVal != nil {
+			return nil, errVal.(error)
+		}
+		return out[0].Interface(), nil
+	}
+	delete(c.instances, key)
 }
 
 // Get, bir servisi konteynerdan tipine göre çözer (resolve).
 // Eğer servis daha önce çözüldüyse, mevcut (singleton) örnek döndürülür.
 // Eğer çözülmediyse, fabrikası çalıştırılır, sonuç saklanır ve döndürülür.
+//
+// Bir servisin fabrikası içinden çağrıldığında, o servis için tanımlı
+// bağlamsal kayıt (When) varsa isimli kayıt kullanılır.
 func (c *Container) Get(serviceType reflect.Type) (any, error) {
+	return c.resolve(binding{serviceType: serviceType, name: c.contextualName(serviceType)})
+}
+
+// GetNamed, RegisterNamed ile isimle kaydedilmiş servisi çözer.
+func (c *Container) GetNamed(serviceType reflect.Type, name string) (any, error) {
+	return c.resolve(binding{serviceType: serviceType, name: name})
+}
+
+// contextualName, çözülmekte olan servisin (consumer) serviceType için
+// bağlamsal kaydının adını döndürür; yoksa boş.
+func (c *Container) contextualName(serviceType reflect.Type) string {
+	if c.consumer == nil {
+		return ""
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.contextual[c.consumer][serviceType]
+}
+
+// resolve, kaydı singleton olarak çözer.
+//
+// Fabrika her kayıt için bir kez çalışır: eşzamanlı ilk çözümlemeler aynı
+// sonucu bekler; böylece *sql.DB gibi kaynak tutan servisler iki kez
+// oluşturulup biri kapatılmadan atılmaz.
+func (c *Container) resolve(key binding) (any, error) {
 	// Önce mevcut örnek var mı diye bak (hızlı yol)
 	c.mu.RLock()
-	instance, ok := c.instances[serviceType]
+	instance, ok := c.instances[key]
 	c.mu.RUnlock()
 
 	if ok {
 		return instance, nil
 	}
 
-	// Mevcut örnek yok, yazma kilidi al
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	// Fabrika kendi zincirindeki bir kaydı isterse beklemek kilitlenir
+	for _, parent := range c.resolving {
+		if parent == key {
+			return nil, fmt.Errorf("container: %s tipi için döngüsel bağımlılık", key.serviceType)
+		}
+	}
 
-	// Başka bir goroutine biz kilidi beklerken bu servisi oluşturmuş olabilir
-	// (double-checked locking)
-	instance, ok = c.instances[serviceType]
-	if ok {
+	c.mu.Lock()
+	if instance, ok := c.instances[key]; ok {
+		c.mu.Unlock()
 		return instance, nil
 	}
 
+	// Başka bir goroutine bu servisi oluşturuyorsa sonucunu bekle
+	if p, ok := c.building[key]; ok {
+		c.mu.Unlock()
+		<-p.done
+		return p.instance, p.err
+	}
+
 	// Servis fabrikasını bul
-	factory, ok := c.factories[serviceType]
+	factory, ok := c.factories[key]
 	if !ok {
-		return nil, fmt.Errorf("container: %s tipi için bir servis kaydı bulunamadı", serviceType)
+		c.mu.Unlock()
+		if key.name != "" {
+			return nil, fmt.Errorf("container: %s tipi için %q adlı bir servis kaydı bulunamadı", key.serviceType, key.name)
+		}
+		return nil, fmt.Errorf("container: %s tipi için bir servis kaydı bulunamadı", key.serviceType)
 	}
 
-	// Fabrikayı çalıştırarak servisi oluştur
-	instance, err := factory(c)
-	if err != nil {
-		return nil, fmt.Errorf("container: %s tipi oluşturulurken hata: %w", serviceType, err)
+	p := &pending{done: make(chan struct{})}
+	c.building[key] = p
+	c.mu.Unlock()
+
+	// Fabrikayı kilit dışında çalıştır: fabrikalar başka servisleri
+	// çözebilir (c.MustGet) veya yeni kayıt yapabilir (c.Register).
+	// Fabrikaya verilen Container, bağlamsal kayıtlar için tüketiciyi bilir.
+	resolving := append(append([]binding(nil), c.resolving...), key)
+	p.instance, p.err = c.build(factory, key, &Container{registry: c.registry, consumer: key.serviceType, resolving: resolving})
+
+	c.mu.Lock()
+	if p.err == nil {
+		// Oluşturulan örneği (singleton) sakla
+		c.instances[key] = p.instance
 	}
+	delete(c.building, key)
+	c.mu.Unlock()
+	close(p.done)
 
-	// Oluşturulan örneği (singleton) sakla
-	c.instances[serviceType] = instance
+	return p.instance, p.err
+}
+
+// build, fabrikayı çalıştırır; panic'i bekleyen goroutine'ler kilitlenmesin
+// diye hataya çevirir.
+func (c *Container) build(factory func(*Container) (any, error), key binding, scoped *Container) (instance any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("container: %s tipi oluşturulurken panic: %v", key.serviceType, r)
+		}
+	}()
+
+	instance, err = factory(scoped)
+	if err != nil {
+		if key.name != "" {
+			return nil, fmt.Errorf("container: %s tipi (%q) oluşturulurken hata: %w", key.serviceType, key.name, err)
+		}
+		return nil, fmt.Errorf("container: %s tipi oluşturulurken hata: %w", key.serviceType, err)
+	}
 	return instance, nil
 }
 
@@ -115,3 +253,38 @@ func (c *Container) MustGet(serviceType reflect.Type) any {
 	}
 	return instance
 }
+
+// Implementing, iface interface'ini implement eden tüm kayıtlı servisleri
+// kayıt sırasıyla çözer.
+//
+// Aynı rolü üstlenen servisleri (event subscriber'ları gibi) tek tek
+// bilmeden toplamak için kullanılır.
+//
+// Örnek:
+//
+//	subscriberType := reflect.TypeOf((*events.Subscriber)(nil)).Elem()
+//	subscribers, err := c.Implementing(subscriberType)
+func (c *Container) Implementing(iface reflect.Type) ([]any, error) {
+	if iface.Kind() != reflect.Interface {
+		return nil, fmt.Errorf("container: Implementing() bir interface tipi bekler, %s alındı", iface)
+	}
+
+	c.mu.RLock()
+	var matches []reflect.Type
+	for _, serviceType := range c.order {
+		if serviceType.Implements(iface) {
+			matches = append(matches, serviceType)
+		}
+	}
+	c.mu.RUnlock()
+
+	services := make([]any, 0, len(matches))
+	for _, serviceType := range matches {
+		service, err := c.Get(serviceType)
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, service)
+	}
+	return services, nil
+}
@@ -0,0 +1,85 @@
+// -----------------------------------------------------------------------------
+// Generic Resolution
+// -----------------------------------------------------------------------------
+// Tip parametresiyle kayıt ve çözme; reflect.TypeOf ve type assertion
+// gerektirmez, yanlış tip derleme zamanında yakalanır:
+//
+//	// Eskisi:
+//	logger := c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+//	provider := c.MustGet(reflect.TypeOf((*auth.UserProvider)(nil)).Elem()).(auth.UserProvider)
+//
+//	// Yenisi:
+//	logger := container.MustResolve[*log.Logger](c)
+//	provider := container.MustResolve[auth.UserProvider](c)
+//
+// Interface tipleri için kayıt anahtarı interface'in kendisidir; fabrika
+// interface döndürmelidir (Register[auth.UserProvider]).
+// -----------------------------------------------------------------------------
+
+package container
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TypeOf, T'nin container anahtarı olan reflect.Type'ını döndürür.
+// Interface'ler için interface tipinin kendisi döner (Elem gerekmez).
+//
+// Örnek:
+//
+//	c.Get(container.TypeOf[*sql.DB]())
+func TypeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Register, T tipindeki servisin fabrikasını kaydeder. c.Register'ın tip
+// güvenli karşılığıdır; fabrika imzası derleme zamanında kontrol edilir.
+//
+// Örnek:
+//
+//	container.Register(c, func(c *container.Container) (auth.UserProvider, error) {
+//	    return ldap.NewUserProvider(cfg), nil
+//	})
+func Register[T any](c *Container, factory func(*Container) (T, error)) {
+	c.Register(factory)
+}
+
+// Resolve, T tipindeki servisi çözer.
+//
+// Döndürür:
+//   - T: Servis (singleton)
+//   - error: Kayıt yoksa veya fabrika hata döndürdüyse hata
+//
+// Örnek:
+//
+//	db, err := container.Resolve[*sql.DB](c)
+func Resolve[T any](c *Container) (T, error) {
+	var zero T
+
+	instance, err := c.Get(TypeOf[T]())
+	if err != nil {
+		return zero, err
+	}
+
+	service, ok := instance.(T)
+	if !ok {
+		return zero, fmt.Errorf("container: %s tipi için kayıtlı servis %T", TypeOf[T](), instance)
+	}
+	return service, nil
+}
+
+// MustResolve, Resolve'u çağırır ama hata durumunda panic yapar.
+// Bootstrap sırasında servisin kayıtlı olduğundan emin olunduğunda
+// kullanılır.
+//
+// Örnek:
+//
+//	logger := container.MustResolve[*log.Logger](c)
+func MustResolve[T any](c *Container) T {
+	service, err := Resolve[T](c)
+	if err != nil {
+		panic(err)
+	}
+	return service
+}
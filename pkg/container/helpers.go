@@ -9,18 +9,22 @@
 //
 // And replace it with simple calls like:
 //   container.GetLogger(c)
+//
+// For services without a helper, use the generic functions in generic.go:
+//   container.MustResolve[*log.Logger](c)
 // -----------------------------------------------------------------------------
 
 package container
 
 import (
 	"database/sql"
+	"fmt"
 	"log"
-	"reflect"
 
 	"github.com/biyonik/conduit-go/internal/config"
 	"github.com/biyonik/conduit-go/pkg/cache"
 	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/events"
 	"github.com/biyonik/conduit-go/pkg/queue"
 )
 
@@ -30,7 +34,7 @@ import (
 //
 //	logger := container.GetLogger(c)
 func GetLogger(c *Container) *log.Logger {
-	return c.MustGet(reflect.TypeOf((*log.Logger)(nil))).(*log.Logger)
+	return MustResolve[*log.Logger](c)
 }
 
 // GetDatabase retrieves the database connection from the container.
@@ -39,7 +43,7 @@ func GetLogger(c *Container) *log.Logger {
 //
 //	db := container.GetDatabase(c)
 func GetDatabase(c *Container) *sql.DB {
-	return c.MustGet(reflect.TypeOf((*sql.DB)(nil))).(*sql.DB)
+	return MustResolve[*sql.DB](c)
 }
 
 // GetGrammar retrieves the SQL grammar from the container.
@@ -48,8 +52,7 @@ func GetDatabase(c *Container) *sql.DB {
 //
 //	grammar := container.GetGrammar(c)
 func GetGrammar(c *Container) database.Grammar {
-	grammarType := reflect.TypeOf((*database.Grammar)(nil)).Elem()
-	return c.MustGet(grammarType).(database.Grammar)
+	return MustResolve[database.Grammar](c)
 }
 
 // GetConfig retrieves the application config from the container.
@@ -59,7 +62,7 @@ func GetGrammar(c *Container) database.Grammar {
 //	cfg := container.GetConfig(c)
 //	env := cfg.App.Env
 func GetConfig(c *Container) *config.Config {
-	return c.MustGet(reflect.TypeOf((*config.Config)(nil))).(*config.Config)
+	return MustResolve[*config.Config](c)
 }
 
 // GetCache retrieves the cache driver from the container.
@@ -69,8 +72,17 @@ func GetConfig(c *Container) *config.Config {
 //	cache := container.GetCache(c)
 //	cache.Set("key", "value", 5*time.Minute)
 func GetCache(c *Container) cache.Cache {
-	cacheType := reflect.TypeOf((*cache.Cache)(nil)).Elem()
-	return c.MustGet(cacheType).(cache.Cache)
+	return MustResolve[cache.Cache](c)
+}
+
+// GetCacheManager retrieves the named cache store manager from the container.
+//
+// Example:
+//
+//	sessions := container.GetCacheManager(c).Store("sessions")
+//	sessions.Set("session:abc", data, 0) // store's default TTL
+func GetCacheManager(c *Container) *cache.Manager {
+	return MustResolve[*cache.Manager](c)
 }
 
 // GetQueue retrieves the queue driver from the container.
@@ -80,8 +92,7 @@ func GetCache(c *Container) cache.Cache {
 //	q := container.GetQueue(c)
 //	q.Push(job, "default")
 func GetQueue(c *Container) queue.Queue {
-	queueType := reflect.TypeOf((*queue.Queue)(nil)).Elem()
-	return c.MustGet(queueType).(queue.Queue)
+	return MustResolve[queue.Queue](c)
 }
 
 // GetDatabaseAndGrammar is a convenience function that retrieves both
@@ -98,3 +109,38 @@ func GetDatabaseAndGrammar(c *Container) (*sql.DB, database.Grammar) {
 	grammar := GetGrammar(c)
 	return db, grammar
 }
+
+// RegisterSubscribers resolves every registered service implementing
+// events.Subscriber and subscribes it to the dispatcher, in registration
+// order.
+//
+// Example:
+//
+//	c.Register(listeners.NewAuthEventSubscriber)
+//	if err := container.RegisterSubscribers(c, dispatcher); err != nil {
+//	    logger.Fatalf("❌ %v", err)
+//	}
+func RegisterSubscribers(c *Container, dispatcher *events.Dispatcher) error {
+	subscribers, err := c.Implementing(TypeOf[events.Subscriber]())
+	if err != nil {
+		return fmt.Errorf("event subscriber'ları çözülemedi: %w", err)
+	}
+
+	for _, subscriber := range subscribers {
+		dispatcher.AddSubscriber(subscriber.(events.Subscriber))
+	}
+	return nil
+}
+
+// RegisterEventProvider boots an events.EventServiceProvider, resolving its
+// nil pointer listeners from the container.
+//
+// Example:
+//
+//	c.Register(listeners.NewSendWelcomeEmail)
+//	if err := container.RegisterEventProvider(c, dispatcher, providers.EventServiceProvider()); err != nil {
+//	    logger.Fatalf("❌ %v", err)
+//	}
+func RegisterEventProvider(c *Container, dispatcher *events.Dispatcher, provider *events.EventServiceProvider) error {
+	return provider.Boot(dispatcher, c.Get)
+}
@@ -0,0 +1,182 @@
+// -----------------------------------------------------------------------------
+// Encryption
+// -----------------------------------------------------------------------------
+// APP_KEY ile AES-256-GCM şifreleme (Laravel Crypt karşılığı). Şifreli
+// cookie'ler, cache değerleri ve queue payload'ları gibi uygulama
+// tarafından üretilip tekrar okunan veriler için kullanılır.
+//
+// Anahtar `conduit key:generate` ile üretilir ve .env'e yazılır:
+//
+//	APP_KEY=base64:3q2+7w...
+//
+// Kullanım:
+//
+//	encrypter, err := crypt.NewFromAppKey(cfg.App.Key)
+//	token, err := encrypter.EncryptString("secret")
+//	plain, err := encrypter.DecryptString(token)
+//
+// Format:
+// Encrypt, rastgele nonce'u ciphertext'in önüne ekler (nonce|ciphertext|tag).
+// EncryptString bunu base64 (URL-safe, padding'siz) olarak döndürür.
+// Additional data (örn: cookie adı, cache key'i) şifreli değeri bağlama
+// bağlar; başka bir bağlama kopyalanan değer çözülemez.
+//
+// APP_KEY değiştirilirse önceden şifrelenmiş tüm veriler okunamaz hale gelir.
+// -----------------------------------------------------------------------------
+
+package crypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// KeyPrefix, APP_KEY değerinin base64 kodlandığını belirten önektir.
+const KeyPrefix = "base64:"
+
+// KeySize, üretilen anahtarların byte uzunluğudur (AES-256).
+const KeySize = 32
+
+var (
+	// ErrMissingKey, APP_KEY tanımlı değilse döner.
+	ErrMissingKey = errors.New("APP_KEY tanımlı değil (conduit key:generate ile oluşturun)")
+
+	// ErrInvalidPayload, şifreli değer çözülemediğinde döner (bozuk veri,
+	// yanlış anahtar veya farklı additional data).
+	ErrInvalidPayload = errors.New("şifreli değer çözülemedi")
+)
+
+// Encrypter, AES-GCM ile şifreleme ve çözme yapar. Eşzamanlı kullanım için
+// güvenlidir.
+type Encrypter struct {
+	aead cipher.AEAD
+}
+
+// New, verilen anahtarla yeni bir Encrypter oluşturur.
+//
+// Parametreler:
+//   - key: AES anahtarı; 16, 24 veya 32 byte (AES-128/192/256)
+//
+// Döndürür:
+//   - *Encrypter: Encrypter
+//   - error: Anahtar uzunluğu geçersizse hata
+func New(key []byte) (*Encrypter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("geçersiz şifreleme anahtarı (16, 24 veya 32 byte olmalı): %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("AES-GCM oluşturulamadı: %w", err)
+	}
+
+	return &Encrypter{aead: aead}, nil
+}
+
+// NewFromAppKey, APP_KEY değerinden Encrypter oluşturur.
+//
+// Örnek:
+//
+//	encrypter, err := crypt.NewFromAppKey(os.Getenv("APP_KEY"))
+func NewFromAppKey(appKey string) (*Encrypter, error) {
+	key, err := ParseKey(appKey)
+	if err != nil {
+		return nil, err
+	}
+	return New(key)
+}
+
+// GenerateKey, "base64:" önekli rastgele 32 byte'lık bir APP_KEY üretir.
+func GenerateKey() (string, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", fmt.Errorf("anahtar üretilemedi: %w", err)
+	}
+	return KeyPrefix + base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ParseKey, APP_KEY değerini ham anahtara çevirir.
+//
+// "base64:" önekli değerler base64 olarak çözülür; öneksiz değerler ham byte
+// olarak kullanılır. Sonuç 16, 24 veya 32 byte olmalıdır.
+func ParseKey(appKey string) ([]byte, error) {
+	appKey = strings.TrimSpace(appKey)
+	if appKey == "" {
+		return nil, ErrMissingKey
+	}
+
+	key := []byte(appKey)
+	if strings.HasPrefix(appKey, KeyPrefix) {
+		decoded, err := base64.StdEncoding.DecodeString(appKey[len(KeyPrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("APP_KEY base64 olarak çözülemedi: %w", err)
+		}
+		key = decoded
+	}
+
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("APP_KEY uzunluğu geçersiz: %d byte (16, 24 veya 32 olmalı)", len(key))
+	}
+}
+
+// Encrypt, plaintext'i şifreler ve nonce|ciphertext döndürür.
+//
+// Parametreler:
+//   - plaintext: Şifrelenecek veri
+//   - additionalData: Şifreli değerin bağlandığı bağlam (nil olabilir)
+func (e *Encrypter) Encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("nonce üretilemedi: %w", err)
+	}
+	return e.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt, Encrypt ile üretilmiş değeri çözer.
+//
+// Aynı additionalData verilmelidir; aksi halde ErrInvalidPayload döner.
+func (e *Encrypter) Decrypt(sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < e.aead.NonceSize() {
+		return nil, ErrInvalidPayload
+	}
+
+	nonce, ciphertext := sealed[:e.aead.NonceSize()], sealed[e.aead.NonceSize():]
+	plaintext, err := e.aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrInvalidPayload
+	}
+	return plaintext, nil
+}
+
+// EncryptString, string'i şifreler ve URL-safe base64 döndürür.
+func (e *Encrypter) EncryptString(plaintext string) (string, error) {
+	sealed, err := e.Encrypt([]byte(plaintext), nil)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptString, EncryptString ile üretilmiş değeri çözer.
+func (e *Encrypter) DecryptString(encoded string) (string, error) {
+	sealed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidPayload
+	}
+
+	plaintext, err := e.Decrypt(sealed, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
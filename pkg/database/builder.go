@@ -34,6 +34,7 @@ type QueryBuilder struct {
 	orders   []OrderClause
 	limit    int
 	offset   int
+	tx       *Transaction // Transaction.NewBuilder: model event'leri commit'e ertelenir
 }
 
 // NewBuilder NewBuilder, veritabanı bağlantısını alarak yeni QueryBuilder üretir.
@@ -682,6 +683,8 @@ func (qb *QueryBuilder) ToSQL() (string, []interface{}, error) {
 //	    "email": "john@example.com",
 //	})
 //	lastID, _ := result.LastInsertId()
+//
+// Başarılı insert'ten sonra model.created event'i yayınlanır (bkz: events.go).
 func (qb *QueryBuilder) ExecInsert(data map[string]interface{}) (sql.Result, error) {
 	for column := range data {
 		validateIdentifier(column, "column")
@@ -691,7 +694,12 @@ func (qb *QueryBuilder) ExecInsert(data map[string]interface{}) (sql.Result, err
 	if err != nil {
 		return nil, fmt.Errorf("insert compilation failed: %w", err)
 	}
-	return qb.executor.Exec(sqlStr, args...)
+
+	result, err := qb.executor.Exec(sqlStr, args...)
+	if err == nil {
+		fireModelEvent(qb.tx, EventModelCreated, qb.table, data[PrimaryKeyColumn], data, result)
+	}
+	return result, err
 }
 
 // ExecUpdate, UPDATE sorgusunu çalıştırır.
@@ -715,6 +723,8 @@ func (qb *QueryBuilder) ExecInsert(data map[string]interface{}) (sql.Result, err
 // Güvenlik Notu:
 // WHERE clause olmadan UPDATE çalıştırmak tehlikelidir!
 // Production'da mutlaka WHERE kontrolü eklenmelidir.
+//
+// Başarılı update'ten sonra model.updated event'i yayınlanır.
 func (qb *QueryBuilder) ExecUpdate(data map[string]interface{}) (sql.Result, error) {
 	for column := range data {
 		validateIdentifier(column, "column")
@@ -724,7 +734,12 @@ func (qb *QueryBuilder) ExecUpdate(data map[string]interface{}) (sql.Result, err
 	if err != nil {
 		return nil, fmt.Errorf("update compilation failed: %w", err)
 	}
-	return qb.executor.Exec(sqlStr, args...)
+
+	result, err := qb.executor.Exec(sqlStr, args...)
+	if err == nil {
+		fireModelEvent(qb.tx, EventModelUpdated, qb.table, primaryKeyFromWheres(qb.table, qb.wheres), data, result)
+	}
+	return result, err
 }
 
 // ExecDelete, DELETE sorgusunu çalıştırır.
@@ -743,10 +758,17 @@ func (qb *QueryBuilder) ExecUpdate(data map[string]interface{}) (sql.Result, err
 // GÜVENLİK UYARISI:
 // WHERE clause olmadan DELETE çalıştırmak TÜM TABLONUN SİLİNMESİNE sebep olur!
 // Production'da mutlaka WHERE kontrolü eklenmelidir.
+//
+// Başarılı delete'ten sonra model.deleted event'i yayınlanır.
 func (qb *QueryBuilder) ExecDelete() (sql.Result, error) {
 	sqlStr, args, err := qb.grammar.CompileDelete(qb.table, qb.wheres)
 	if err != nil {
 		return nil, fmt.Errorf("delete compilation failed: %w", err)
 	}
-	return qb.executor.Exec(sqlStr, args...)
+
+	result, err := qb.executor.Exec(sqlStr, args...)
+	if err == nil {
+		fireModelEvent(qb.tx, EventModelDeleted, qb.table, primaryKeyFromWheres(qb.table, qb.wheres), nil, result)
+	}
+	return result, err
 }
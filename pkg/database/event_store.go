@@ -0,0 +1,131 @@
+// -----------------------------------------------------------------------------
+// Database Event Store
+// -----------------------------------------------------------------------------
+// events.Store'un stored_events tablosu üzerindeki implementasyonudur.
+// Event'ler adı, JSON payload'ı ve gerçekleşme zamanıyla kaydedilir;
+// dispatcher.Replay ile tekrar oynatılabilir.
+//
+//	store := database.NewEventStore(db, grammar)
+//	if err := store.CreateTable(); err != nil { ... }
+//	dispatcher.SetStore(store)
+//
+// Kayıt, QueryBuilder.ExecInsert yerine doğrudan SQL ile yazılır; aksi
+// halde her kayıt bir model.created event'i üretir ve o da kaydedilirdi.
+// -----------------------------------------------------------------------------
+
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// EventStoreTable, event kayıtlarının tutulduğu tablo.
+const EventStoreTable = "stored_events"
+
+// EventStore, event'leri veritabanında saklar.
+type EventStore struct {
+	db      *sql.DB
+	grammar Grammar
+}
+
+// NewEventStore, yeni bir EventStore oluşturur.
+func NewEventStore(db *sql.DB, grammar Grammar) *EventStore {
+	return &EventStore{db: db, grammar: grammar}
+}
+
+// storedEventRow, stored_events tablosundaki bir satırdır.
+type storedEventRow struct {
+	ID         int64     `db:"id"`
+	Name       string    `db:"name"`
+	Payload    string    `db:"payload"`
+	OccurredAt time.Time `db:"occurred_at"`
+}
+
+// CreateTable, stored_events tablosunu yoksa oluşturur.
+func (s *EventStore) CreateTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS stored_events (
+			id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			payload LONGTEXT NOT NULL,
+			occurred_at TIMESTAMP(6) NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX stored_events_name_index (name),
+			INDEX stored_events_occurred_at_index (occurred_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`)
+	if err != nil {
+		return fmt.Errorf("stored_events tablosu oluşturulamadı: %w", err)
+	}
+	return nil
+}
+
+// Append, event'i kaydeder.
+func (s *EventStore) Append(event events.Event) (int64, error) {
+	payload, err := json.Marshal(event.Payload())
+	if err != nil {
+		return 0, fmt.Errorf("event payload serialize edilemedi (%s): %w", event.Name(), err)
+	}
+
+	result, err := s.db.Exec(
+		"INSERT INTO stored_events (name, payload, occurred_at) VALUES (?, ?, ?)",
+		event.Name(), string(payload), event.OccurredAt(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("event kaydedilemedi (%s): %w", event.Name(), err)
+	}
+	return result.LastInsertId()
+}
+
+// Range, sorguya uyan kayıtları ID sırasıyla döndürür.
+func (s *EventStore) Range(query events.StoreQuery) ([]events.StoredEvent, error) {
+	qb := NewBuilder(s.db, s.grammar).Table(EventStoreTable).
+		Select("id", "name", "payload", "occurred_at")
+
+	if query.FromID > 0 {
+		qb.Where("id", ">=", query.FromID)
+	}
+	if query.ToID > 0 {
+		qb.Where("id", "<=", query.ToID)
+	}
+	if !query.Since.IsZero() {
+		qb.Where("occurred_at", ">=", query.Since)
+	}
+	if !query.Until.IsZero() {
+		qb.Where("occurred_at", "<=", query.Until)
+	}
+	if len(query.Names) > 0 {
+		names := make([]interface{}, len(query.Names))
+		for i, name := range query.Names {
+			names[i] = name
+		}
+		qb.WhereIn("name", names)
+	}
+	if query.Limit > 0 {
+		qb.Limit(query.Limit)
+	}
+
+	var rows []storedEventRow
+	if err := qb.OrderBy("id", "ASC").Get(&rows); err != nil {
+		return nil, fmt.Errorf("event kayıtları okunamadı: %w", err)
+	}
+
+	stored := make([]events.StoredEvent, len(rows))
+	for i, row := range rows {
+		stored[i] = events.StoredEvent{
+			ID:         row.ID,
+			Name:       row.Name,
+			Payload:    json.RawMessage(row.Payload),
+			OccurredAt: row.OccurredAt,
+		}
+	}
+	return stored, nil
+}
+
+// EventStore'un events.Store olduğunu derleme zamanında doğrula
+var _ events.Store = (*EventStore)(nil)
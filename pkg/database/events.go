@@ -0,0 +1,115 @@
+// -----------------------------------------------------------------------------
+// Model Events
+// -----------------------------------------------------------------------------
+// QueryBuilder'ın yazma metodları (ExecInsert, ExecUpdate, ExecDelete) başarılı
+// olduğunda pkg/events üzerinden model event'leri yayınlar. Cache
+// invalidation ve audit listener'ları her repository'ye dokunmadan tüm
+// yazmaları izleyebilir:
+//
+//	database.SetEventDispatcher(dispatcher)
+//
+//	dispatcher.Listen(database.EventModelUpdated, events.ListenerFunc(func(e events.Event) error {
+//	    model := e.Payload().(*database.ModelEvent)
+//	    if model.Table == "users" && model.Key != nil {
+//	        return cache.Delete(fmt.Sprintf("user:%v", model.Key))
+//	    }
+//	    return nil
+//	}))
+//
+// Event'ler sorguyu çalıştıran goroutine'de senkron dispatch edilir; listener
+// hataları loglanır ama yazma sonucunu etkilemez. Transaction.NewBuilder ile
+// yapılan yazmaların event'leri commit'e kadar bekletilir; rollback'te
+// yayınlanmaz.
+// -----------------------------------------------------------------------------
+
+package database
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// Model event adları
+const (
+	EventModelCreated = "model.created" // ExecInsert
+	EventModelUpdated = "model.updated" // ExecUpdate
+	EventModelDeleted = "model.deleted" // ExecDelete
+)
+
+// PrimaryKeyColumn, model event'lerinde Key'in okunduğu kolondur.
+const PrimaryKeyColumn = "id"
+
+// ModelEvent, model event'lerinin payload'ıdır.
+type ModelEvent struct {
+	Table string
+
+	// Key, etkilenen kaydın primary key'i ("id"). Insert'te data'daki id
+	// veya LastInsertId; update/delete'te `id = ?` koşulunun değeri. Toplu
+	// yazmalarda (id koşulu yoksa veya OR içeriyorsa) nil'dir.
+	Key interface{}
+
+	Data         map[string]interface{} // Insert/update verisi (delete: nil)
+	RowsAffected int64
+}
+
+// Global event dispatcher
+var (
+	eventDispatcher   *events.Dispatcher
+	eventDispatcherMu sync.RWMutex
+)
+
+// SetEventDispatcher, model event'lerinin yayınlanacağı dispatcher'ı
+// ayarlar (nil = event yayınlanmaz).
+func SetEventDispatcher(dispatcher *events.Dispatcher) {
+	eventDispatcherMu.Lock()
+	defer eventDispatcherMu.Unlock()
+
+	eventDispatcher = dispatcher
+}
+
+// fireModelEvent, dispatcher ayarlıysa ve event'i dinleyen varsa yayınlar
+// (tx verilmişse commit'ten sonra).
+func fireModelEvent(tx *Transaction, name, table string, key interface{}, data map[string]interface{}, result sql.Result) {
+	eventDispatcherMu.RLock()
+	dispatcher := eventDispatcher
+	eventDispatcherMu.RUnlock()
+
+	if dispatcher == nil || !dispatcher.HasListeners(name) {
+		return
+	}
+
+	event := &ModelEvent{Table: table, Key: key, Data: data}
+	if result != nil {
+		event.RowsAffected, _ = result.RowsAffected()
+		if name == EventModelCreated && key == nil {
+			if id, err := result.LastInsertId(); err == nil && id > 0 {
+				event.Key = id
+			}
+		}
+	}
+
+	if tx != nil {
+		dispatcher.DispatchAfterCommit(tx, events.NewBaseEvent(name, event))
+		return
+	}
+	dispatcher.Dispatch(events.NewBaseEvent(name, event))
+}
+
+// primaryKeyFromWheres, sadece AND ile bağlı koşullarda `id = ?` değerini
+// döndürür; yoksa nil.
+func primaryKeyFromWheres(table string, wheres []WhereClause) interface{} {
+	var key interface{}
+	for _, where := range wheres {
+		if where.Boolean == "OR" {
+			return nil
+		}
+		column := strings.TrimPrefix(where.Column, table+".")
+		if column == PrimaryKeyColumn && where.Operator == "=" {
+			key = where.Value
+		}
+	}
+	return key
+}
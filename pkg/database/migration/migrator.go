@@ -8,11 +8,20 @@
 // - Schema builder (CreateTable, AlterTable, DropTable)
 // - Column types (String, Integer, Boolean, Timestamps, etc.)
 // - Indexes (primary, unique, index, foreign keys)
-// - Migration tracking (migrations table)
+// - Migration tracking (migrations table, batch'ler)
 // - Rollback support
+// - Pretend modu (SQL çalıştırılmadan yazdırılır)
+//
+// Migration'lar init fonksiyonlarında Register ile kaydedilir ve
+// `conduit migrate` komutları Migrator.Run / Rollback ile çalıştırır
+// (bkz: runner.go, registry.go).
 //
 // Kullanım:
 //
+//	func init() {
+//	    migration.Register("2024_01_15_100000_create_users_table", &CreateUsersTable{})
+//	}
+//
 //	func (m *CreateUsersTable) Up(migrator *Migrator) error {
 //	    return migrator.CreateTable("users", func(t *Blueprint) {
 //	        t.ID()
@@ -34,13 +43,13 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
-	"time"
 )
 
 // Migrator manages database migrations.
 type Migrator struct {
 	db      *sql.DB
 	grammar Grammar // SQL dialect (MySQL, PostgreSQL, etc.)
+	pretend bool    // SQL is printed instead of executed
 }
 
 // Grammar defines SQL generation interface for different databases.
@@ -61,36 +70,69 @@ func NewMigrator(db *sql.DB, grammar Grammar) *Migrator {
 	}
 }
 
+// SetPretend enables pretend mode: schema changes are printed as SQL and
+// nothing is executed or recorded in the migrations table.
+func (m *Migrator) SetPretend(pretend bool) *Migrator {
+	m.pretend = pretend
+	return m
+}
+
+// Pretending reports whether pretend mode is enabled.
+func (m *Migrator) Pretending() bool {
+	return m.pretend
+}
+
+// Exec runs a raw SQL statement (printed in pretend mode). Migrations should
+// use it instead of the *sql.DB so that --pretend shows every statement.
+func (m *Migrator) Exec(query string, args ...interface{}) error {
+	if m.pretend {
+		fmt.Printf("   %s;", strings.TrimSpace(query))
+		if len(args) > 0 {
+			fmt.Printf(" -- %v", args)
+		}
+		fmt.Println()
+		return nil
+	}
+
+	_, err := m.db.Exec(query, args...)
+	return err
+}
+
+// logf prints progress messages (suppressed in pretend mode).
+func (m *Migrator) logf(format string, args ...interface{}) {
+	if !m.pretend {
+		fmt.Printf(format, args...)
+	}
+}
+
 // CreateTable creates a new table.
 func (m *Migrator) CreateTable(tableName string, callback func(*Blueprint)) error {
 	blueprint := NewBlueprint(tableName)
 	callback(blueprint)
 
-	sql := m.grammar.CompileCreateTable(
+	query := m.grammar.CompileCreateTable(
 		blueprint.table,
 		blueprint.columns,
 		blueprint.indexes,
 	)
 
-	_, err := m.db.Exec(sql)
-	if err != nil {
+	if err := m.Exec(query); err != nil {
 		return fmt.Errorf("failed to create table %s: %w", tableName, err)
 	}
 
-	fmt.Printf("✅ Created table: %s\n", tableName)
+	m.logf("✅ Created table: %s\n", tableName)
 	return nil
 }
 
 // DropTable drops a table.
 func (m *Migrator) DropTable(tableName string) error {
-	sql := m.grammar.CompileDropTable(tableName)
+	query := m.grammar.CompileDropTable(tableName)
 
-	_, err := m.db.Exec(sql)
-	if err != nil {
+	if err := m.Exec(query); err != nil {
 		return fmt.Errorf("failed to drop table %s: %w", tableName, err)
 	}
 
-	fmt.Printf("✅ Dropped table: %s\n", tableName)
+	m.logf("✅ Dropped table: %s\n", tableName)
 	return nil
 }
 
@@ -101,21 +143,33 @@ func (m *Migrator) AlterTable(tableName string, callback func(*Blueprint)) error
 
 	// Execute column additions
 	for _, column := range blueprint.columns {
-		sql := m.grammar.CompileAddColumn(tableName, column)
-		if _, err := m.db.Exec(sql); err != nil {
+		if err := m.Exec(m.grammar.CompileAddColumn(tableName, column)); err != nil {
 			return fmt.Errorf("failed to add column %s: %w", column.Name, err)
 		}
 	}
 
+	// Execute column drops
+	for _, column := range blueprint.dropColumns {
+		if err := m.Exec(m.grammar.CompileDropColumn(tableName, column)); err != nil {
+			return fmt.Errorf("failed to drop column %s: %w", column, err)
+		}
+	}
+
 	// Execute index additions
 	for _, index := range blueprint.indexes {
-		sql := m.grammar.CompileAddIndex(tableName, index)
-		if _, err := m.db.Exec(sql); err != nil {
+		if err := m.Exec(m.grammar.CompileAddIndex(tableName, index)); err != nil {
 			return fmt.Errorf("failed to add index: %w", err)
 		}
 	}
 
-	fmt.Printf("✅ Altered table: %s\n", tableName)
+	// Execute index drops
+	for _, index := range blueprint.dropIndexes {
+		if err := m.Exec(m.grammar.CompileDropIndex(tableName, index)); err != nil {
+			return fmt.Errorf("failed to drop index %s: %w", index, err)
+		}
+	}
+
+	m.logf("✅ Altered table: %s\n", tableName)
 	return nil
 }
 
@@ -144,7 +198,7 @@ func (m *Migrator) CreateMigrationsTable() error {
 		return nil // Already exists
 	}
 
-	sql := `
+	query := `
 		CREATE TABLE migrations (
 			id INT AUTO_INCREMENT PRIMARY KEY,
 			migration VARCHAR(255) NOT NULL,
@@ -153,7 +207,7 @@ func (m *Migrator) CreateMigrationsTable() error {
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
 	`
 
-	_, err = m.db.Exec(sql)
+	_, err = m.db.Exec(query)
 	if err != nil {
 		return fmt.Errorf("failed to create migrations table: %w", err)
 	}
@@ -164,23 +218,23 @@ func (m *Migrator) CreateMigrationsTable() error {
 
 // RecordMigration records a migration as run.
 func (m *Migrator) RecordMigration(name string, batch int) error {
-	sql := "INSERT INTO migrations (migration, batch) VALUES (?, ?)"
-	_, err := m.db.Exec(sql, name, batch)
+	query := "INSERT INTO migrations (migration, batch) VALUES (?, ?)"
+	_, err := m.db.Exec(query, name, batch)
 	return err
 }
 
 // DeleteMigration removes a migration record.
 func (m *Migrator) DeleteMigration(name string) error {
-	sql := "DELETE FROM migrations WHERE migration = ?"
-	_, err := m.db.Exec(sql, name)
+	query := "DELETE FROM migrations WHERE migration = ?"
+	_, err := m.db.Exec(query, name)
 	return err
 }
 
 // GetRanMigrations returns all migrations that have been run.
 func (m *Migrator) GetRanMigrations() ([]string, error) {
-	sql := "SELECT migration FROM migrations ORDER BY id ASC"
+	query := "SELECT migration FROM migrations ORDER BY id ASC"
 
-	rows, err := m.db.Query(sql)
+	rows, err := m.db.Query(query)
 	if err != nil {
 		return nil, err
 	}
@@ -200,10 +254,10 @@ func (m *Migrator) GetRanMigrations() ([]string, error) {
 
 // GetLastBatch returns the last batch number.
 func (m *Migrator) GetLastBatch() (int, error) {
-	sql := "SELECT MAX(batch) FROM migrations"
+	query := "SELECT MAX(batch) FROM migrations"
 
 	var batch sql.NullInt64
-	err := m.db.QueryRow(sql).Scan(&batch)
+	err := m.db.QueryRow(query).Scan(&batch)
 	if err != nil {
 		return 0, err
 	}
@@ -221,9 +275,11 @@ func (m *Migrator) GetLastBatch() (int, error) {
 
 // Blueprint defines the structure of a table.
 type Blueprint struct {
-	table   string
-	columns []Column
-	indexes []Index
+	table       string
+	columns     []Column
+	indexes     []Index
+	dropColumns []string // AlterTable only
+	dropIndexes []string // AlterTable only
 }
 
 // NewBlueprint creates a new Blueprint instance.
@@ -235,6 +291,16 @@ func NewBlueprint(tableName string) *Blueprint {
 	}
 }
 
+// Columns returns the column definitions (for grammars).
+func (b *Blueprint) Columns() []Column {
+	return b.columns
+}
+
+// Indexes returns the index definitions (for grammars).
+func (b *Blueprint) Indexes() []Index {
+	return b.indexes
+}
+
 // ID adds an auto-incrementing primary key column.
 func (b *Blueprint) ID() *Column {
 	return b.addColumn(Column{
@@ -331,6 +397,16 @@ func (b *Blueprint) Index(columns ...string) {
 	})
 }
 
+// DropColumn drops columns (AlterTable only).
+func (b *Blueprint) DropColumn(columns ...string) {
+	b.dropColumns = append(b.dropColumns, columns...)
+}
+
+// DropIndex drops an index by name (AlterTable only).
+func (b *Blueprint) DropIndex(name string) {
+	b.dropIndexes = append(b.dropIndexes, name)
+}
+
 // Foreign adds a foreign key constraint.
 func (b *Blueprint) Foreign(column string) *ForeignKey {
 	return &ForeignKey{
@@ -364,35 +440,35 @@ type Column struct {
 	Name          string
 	Type          ColumnType
 	Length        int
-	Nullable      bool
-	Default       interface{}
-	Unsigned      bool
+	IsNullable    bool
+	DefaultValue  interface{}
+	IsUnsigned    bool
 	AutoIncrement bool
 	Primary       bool
-	Unique        bool
+	IsUnique      bool
 }
 
 // Nullable marks the column as nullable.
 func (c *Column) Nullable() *Column {
-	c.Nullable = true
+	c.IsNullable = true
 	return c
 }
 
 // Default sets a default value.
 func (c *Column) Default(value interface{}) *Column {
-	c.Default = value
+	c.DefaultValue = value
 	return c
 }
 
 // Unsigned marks the column as unsigned (for numeric types).
 func (c *Column) Unsigned() *Column {
-	c.Unsigned = true
+	c.IsUnsigned = true
 	return c
 }
 
 // Unique adds a unique constraint.
 func (c *Column) Unique() *Column {
-	c.Unique = true
+	c.IsUnique = true
 	return c
 }
 
@@ -419,12 +495,12 @@ type Index struct {
 
 // ForeignKey represents a foreign key constraint.
 type ForeignKey struct {
-	blueprint       *Blueprint
-	column          string
-	referencedTable string
+	blueprint        *Blueprint
+	column           string
+	referencedTable  string
 	referencedColumn string
-	onDelete        string
-	onUpdate        string
+	onDelete         string
+	onUpdate         string
 }
 
 // References sets the referenced table and column.
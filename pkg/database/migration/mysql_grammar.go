@@ -88,12 +88,12 @@ func (g *MySQLGrammar) compileColumn(column Column) string {
 	}
 
 	// Unsigned
-	if column.Unsigned {
+	if column.IsUnsigned {
 		parts = append(parts, "UNSIGNED")
 	}
 
 	// Nullable
-	if !column.Nullable {
+	if !column.IsNullable {
 		parts = append(parts, "NOT NULL")
 	} else {
 		parts = append(parts, "NULL")
@@ -105,11 +105,18 @@ func (g *MySQLGrammar) compileColumn(column Column) string {
 	}
 
 	// Default value
-	if column.Default != nil {
-		if str, ok := column.Default.(string); ok {
-			parts = append(parts, fmt.Sprintf("DEFAULT '%s'", str))
-		} else {
-			parts = append(parts, fmt.Sprintf("DEFAULT %v", column.Default))
+	if column.DefaultValue != nil {
+		switch value := column.DefaultValue.(type) {
+		case string:
+			parts = append(parts, fmt.Sprintf("DEFAULT '%s'", strings.ReplaceAll(value, "'", "''")))
+		case bool:
+			if value {
+				parts = append(parts, "DEFAULT 1")
+			} else {
+				parts = append(parts, "DEFAULT 0")
+			}
+		default:
+			parts = append(parts, fmt.Sprintf("DEFAULT %v", value))
 		}
 	}
 
@@ -118,6 +125,11 @@ func (g *MySQLGrammar) compileColumn(column Column) string {
 		parts = append(parts, "PRIMARY KEY")
 	}
 
+	// Unique
+	if column.IsUnique && !column.Primary {
+		parts = append(parts, "UNIQUE")
+	}
+
 	return strings.Join(parts, " ")
 }
 
@@ -0,0 +1,86 @@
+// -----------------------------------------------------------------------------
+// Migration Registry
+// -----------------------------------------------------------------------------
+// Go derlenmiş bir dil olduğu için migration dosyaları çalışma zamanında
+// taranamaz. Her migration kendi init fonksiyonunda Register ile kayıt olur;
+// migration paketi (database/migrations) conduit CLI'a import edildiğinde
+// tüm migration'lar registry'de hazır olur. `conduit make:migration` bu
+// init fonksiyonunu otomatik üretir.
+//
+//	func init() {
+//	    migration.Register("2024_01_15_100000_create_posts_table", &CreatePostsTable{})
+//	}
+//
+// Migration'lar adlarına göre (timestamp önekiyle) sıralı çalıştırılır.
+// -----------------------------------------------------------------------------
+
+package migration
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Migration defines a schema change and how to reverse it.
+type Migration interface {
+	// Up applies the migration.
+	Up(migrator *Migrator) error
+
+	// Down reverses the changes made by Up.
+	Down(migrator *Migrator) error
+}
+
+// Global migration registry
+var (
+	registry   = make(map[string]Migration)
+	registryMu sync.RWMutex
+)
+
+// Register records a migration under the given name
+// (e.g. 2024_01_15_100000_create_posts_table).
+//
+// Registering the same name twice panics: it would be ambiguous which
+// migration runs.
+func Register(name string, migration Migration) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if name == "" || migration == nil {
+		panic("migration: Register requires a name and a migration")
+	}
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("migration: %s is already registered", name))
+	}
+	registry[name] = migration
+}
+
+// Registered returns the registered migration names in run order.
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the migration registered under name.
+func Get(name string) (Migration, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	migration, ok := registry[name]
+	return migration, ok
+}
+
+// Unregister removes a migration from the registry (for tests).
+func Unregister(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(registry, name)
+}
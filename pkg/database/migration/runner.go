@@ -0,0 +1,278 @@
+// -----------------------------------------------------------------------------
+// Migration Runner
+// -----------------------------------------------------------------------------
+// Registry'deki migration'ları migrations tablosuyla karşılaştırarak
+// çalıştırır ve geri alır. Her `migrate` çağrısı yeni bir batch açar;
+// rollback varsayılan olarak son batch'i geri alır.
+//
+//	migrator := migration.NewMigrator(db, migration.NewMySQLGrammar())
+//	ran, err := migrator.Run(0)         // Tüm bekleyen migration'lar
+//	rolled, err := migrator.Rollback(0) // Son batch
+//	rolled, err := migrator.Rollback(2) // Son 2 migration
+//
+// SetPretend(true) ile SQL çalıştırılmadan yazdırılır ve migrations tablosu
+// değiştirilmez.
+// -----------------------------------------------------------------------------
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/biyonik/conduit-go/pkg/console"
+)
+
+// Status describes a registered migration and whether it has run.
+type Status struct {
+	Name  string
+	Ran   bool
+	Batch int // 0 if pending
+}
+
+// record is a row of the migrations table.
+type record struct {
+	name  string
+	batch int
+}
+
+// Run runs pending migrations in name order as a new batch.
+//
+// step limits the number of migrations to run (0: all pending).
+// Returns the names of the migrations that ran; on error the migrations
+// before the failing one stay recorded.
+func (m *Migrator) Run(step int) ([]string, error) {
+	records, err := m.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	ran := make(map[string]bool, len(records))
+	lastBatch := 0
+	for _, r := range records {
+		ran[r.name] = true
+		lastBatch = max(lastBatch, r.batch)
+	}
+
+	var pending []string
+	for _, name := range Registered() {
+		if !ran[name] {
+			pending = append(pending, name)
+		}
+	}
+	if step > 0 && step < len(pending) {
+		pending = pending[:step]
+	}
+
+	return m.run(pending, lastBatch+1)
+}
+
+// run runs the given migrations and records them with batch.
+func (m *Migrator) run(names []string, batch int) ([]string, error) {
+	var done []string
+	for _, name := range names {
+		migration, _ := Get(name)
+
+		console.Line("🔄 Migrating: %s", name)
+		if err := migration.Up(m); err != nil {
+			return done, fmt.Errorf("%s: %w", name, err)
+		}
+		if !m.pretend {
+			if err := m.RecordMigration(name, batch); err != nil {
+				return done, fmt.Errorf("failed to record migration %s: %w", name, err)
+			}
+		}
+		console.Success("Migrated:  %s", name)
+		done = append(done, name)
+	}
+
+	return done, nil
+}
+
+// Rollback reverses migrations in reverse run order.
+//
+// step is the number of migrations to roll back; 0 rolls back the last
+// batch. Returns the names of the migrations that were rolled back.
+func (m *Migrator) Rollback(step int) ([]string, error) {
+	records, err := m.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	// records are in run order; roll back from the end
+	var targets []record
+	for i := len(records) - 1; i >= 0; i-- {
+		r := records[i]
+		if step > 0 && len(targets) == step {
+			break
+		}
+		if step <= 0 && len(targets) > 0 && r.batch != targets[0].batch {
+			break
+		}
+		targets = append(targets, r)
+	}
+
+	return m.rollback(targets)
+}
+
+// Reset rolls back every migration that has run.
+func (m *Migrator) Reset() ([]string, error) {
+	records, err := m.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]record, 0, len(records))
+	for i := len(records) - 1; i >= 0; i-- {
+		targets = append(targets, records[i])
+	}
+	return m.rollback(targets)
+}
+
+// Fresh drops every table in the database and runs all migrations.
+func (m *Migrator) Fresh() ([]string, error) {
+	if err := m.DropAllTables(); err != nil {
+		return nil, err
+	}
+	if m.pretend {
+		// Tables were not really dropped; show every migration
+		return m.run(Registered(), 1)
+	}
+	return m.Run(0)
+}
+
+// Status returns every registered migration with its run state, in name
+// order.
+func (m *Migrator) Status() ([]Status, error) {
+	records, err := m.ranRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	batches := make(map[string]int, len(records))
+	for _, r := range records {
+		batches[r.name] = r.batch
+	}
+
+	var statuses []Status
+	for _, name := range Registered() {
+		batch, ran := batches[name]
+		statuses = append(statuses, Status{Name: name, Ran: ran, Batch: batch})
+	}
+	return statuses, nil
+}
+
+// DropAllTables drops every table in the current database (MySQL).
+func (m *Migrator) DropAllTables() error {
+	ctx := context.Background()
+
+	// FOREIGN_KEY_CHECKS is a session variable: every statement must run on
+	// the same connection
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	rows, err := conn.QueryContext(ctx,
+		"SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE'")
+	if err != nil {
+		return fmt.Errorf("failed to list tables: %w", err)
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return err
+		}
+		tables = append(tables, table)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	statements := []string{"SET FOREIGN_KEY_CHECKS = 0"}
+	for _, table := range tables {
+		statements = append(statements, m.grammar.CompileDropTable(table))
+	}
+	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 1")
+
+	for _, statement := range statements {
+		if m.pretend {
+			m.Exec(statement)
+			continue
+		}
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to drop tables: %w", err)
+		}
+	}
+
+	m.logf("✅ Dropped %d tables\n", len(tables))
+	return nil
+}
+
+// rollback runs Down for the given records in order and removes them from
+// the migrations table.
+func (m *Migrator) rollback(targets []record) ([]string, error) {
+	var done []string
+	for _, r := range targets {
+		migration, ok := Get(r.name)
+		if !ok {
+			return done, fmt.Errorf("migration %s is not registered (was its file removed or its package not imported?)", r.name)
+		}
+
+		console.Line("🔄 Rolling back: %s", r.name)
+		if err := migration.Down(m); err != nil {
+			return done, fmt.Errorf("%s: %w", r.name, err)
+		}
+		if !m.pretend {
+			if err := m.DeleteMigration(r.name); err != nil {
+				return done, fmt.Errorf("failed to delete migration record %s: %w", r.name, err)
+			}
+		}
+		console.Success("Rolled back:  %s", r.name)
+		done = append(done, r.name)
+	}
+	return done, nil
+}
+
+// prepare makes sure the migrations table exists (outside pretend mode) and
+// returns the migrations that have run.
+func (m *Migrator) prepare() ([]record, error) {
+	if !m.pretend {
+		if err := m.CreateMigrationsTable(); err != nil {
+			return nil, err
+		}
+	}
+	return m.ranRecords()
+}
+
+// ranRecords returns the rows of the migrations table in run order (empty
+// if the table does not exist yet).
+func (m *Migrator) ranRecords() ([]record, error) {
+	exists, err := m.HasTable("migrations")
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	rows, err := m.db.Query("SELECT migration, batch FROM migrations ORDER BY id ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []record
+	for rows.Next() {
+		var r record
+		if err := rows.Scan(&r.name, &r.batch); err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	return records, rows.Err()
+}
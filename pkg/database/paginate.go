@@ -0,0 +1,137 @@
+package database
+
+import "fmt"
+
+// -----------------------------------------------------------------------------
+// PAGINATION
+// -----------------------------------------------------------------------------
+// Offset tabanlı sayfalama: Paginate, sorgunun toplam satır sayısını
+// (COUNT) ve istenen sayfanın kayıtlarını tek çağrıda döndürür. Dönen
+// Paginator, response.Paginated'a doğrudan verilebilir:
+//
+//	var users []models.User
+//	page, _ := strconv.Atoi(r.Query("page", "1"))
+//	paginator, err := qb.Table("users").OrderBy("id", "ASC").Paginate(page, 15, &users)
+//	response.Paginated(w, users, paginator, r.Request)
+// -----------------------------------------------------------------------------
+
+// DefaultPerPage, perPage verilmediğinde (<= 0) kullanılan sayfa boyutudur.
+const DefaultPerPage = 15
+
+// Paginator, bir sayfalama sonucunun meta bilgisini tutar.
+type Paginator struct {
+	total       int64
+	perPage     int
+	currentPage int
+}
+
+// NewPaginator, toplam kayıt, sayfa boyutu ve mevcut sayfa ile bir
+// Paginator oluşturur. currentPage < 1 ise 1, perPage <= 0 ise
+// DefaultPerPage kullanılır.
+//
+// Örnek:
+//
+//	p := database.NewPaginator(42, 10, 2)
+//	p.LastPage()     // 5
+//	p.HasMorePages() // true
+func NewPaginator(total int64, perPage, currentPage int) *Paginator {
+	if perPage <= 0 {
+		perPage = DefaultPerPage
+	}
+	if currentPage < 1 {
+		currentPage = 1
+	}
+	return &Paginator{total: total, perPage: perPage, currentPage: currentPage}
+}
+
+// Total, sorgunun sayfalamadan bağımsız toplam kayıt sayısını döndürür.
+func (p *Paginator) Total() int64 {
+	return p.total
+}
+
+// PerPage, sayfa boyutunu döndürür.
+func (p *Paginator) PerPage() int {
+	return p.perPage
+}
+
+// CurrentPage, mevcut sayfa numarasını döndürür (1'den başlar).
+func (p *Paginator) CurrentPage() int {
+	return p.currentPage
+}
+
+// LastPage, son sayfa numarasını döndürür (kayıt yoksa 1).
+func (p *Paginator) LastPage() int {
+	if p.total <= 0 {
+		return 1
+	}
+	return int((p.total + int64(p.perPage) - 1) / int64(p.perPage))
+}
+
+// HasMorePages, mevcut sayfadan sonra sayfa olup olmadığını döndürür.
+func (p *Paginator) HasMorePages() bool {
+	return p.currentPage < p.LastPage()
+}
+
+// Offset, mevcut sayfanın ilk kaydının sıfır tabanlı konumunu döndürür.
+func (p *Paginator) Offset() int {
+	return (p.currentPage - 1) * p.perPage
+}
+
+// Count, sorgunun eşleşen satır sayısını döndürür. Order, limit ve offset
+// yok sayılır; builder değiştirilmez.
+//
+// Örnek:
+//
+//	total, err := qb.Table("users").Where("status", "=", "active").Count()
+func (qb *QueryBuilder) Count() (int64, error) {
+	inner := *qb
+	inner.orders = nil
+	inner.limit = 0
+	inner.offset = 0
+
+	sqlStr, args, err := inner.ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("query compilation failed: %w", err)
+	}
+
+	var total int64
+	query := fmt.Sprintf("SELECT COUNT(*) FROM (%s) AS aggregate", sqlStr)
+	if err := qb.executor.QueryRow(query, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("count query failed: %w", err)
+	}
+	return total, nil
+}
+
+// Paginate, toplam kayıt sayısını hesaplar ve istenen sayfanın kayıtlarını
+// dest'e tarar. page < 1 ise 1, perPage <= 0 ise DefaultPerPage kullanılır.
+// Builder'daki limit/offset yok sayılır; builder değiştirilmez.
+//
+// Parametreler:
+//   - page: Sayfa numarası (1'den başlar)
+//   - perPage: Sayfa boyutu
+//   - dest: Sonuçların doldurulacağı slice pointer (örn: &[]models.User)
+//
+// Döndürür:
+//   - *Paginator: Sayfalama meta bilgisi (response.Paginated ile kullanılır)
+//   - error: Count veya sorgu hatası varsa
+//
+// Örnek:
+//
+//	var users []models.User
+//	paginator, err := qb.Table("users").OrderBy("id", "ASC").Paginate(2, 20, &users)
+func (qb *QueryBuilder) Paginate(page, perPage int, dest any) (*Paginator, error) {
+	total, err := qb.Count()
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := NewPaginator(total, perPage, page)
+
+	query := *qb
+	query.limit = paginator.PerPage()
+	query.offset = paginator.Offset()
+	if err := query.Get(dest); err != nil {
+		return nil, err
+	}
+	return paginator, nil
+}
@@ -120,6 +120,12 @@ func (s *Scanner) Stop() {
 	s.wg.Wait()
 }
 
+// GetStructFieldMap, global scanner üzerinden bir struct tipinin
+// kolon -> alan haritasını döndürür. Sonuç scanner cache'ine eklenir.
+func GetStructFieldMap(structType reflect.Type) map[string]string {
+	return GetScanner().getStructFieldMap(structType)
+}
+
 // getStructFieldMap, bir struct tipini analiz eder ve cache'den döndürür.
 func (s *Scanner) getStructFieldMap(structType reflect.Type) fieldMap {
 	// Read lock ile cache'i kontrol et
@@ -0,0 +1,283 @@
+// -----------------------------------------------------------------------------
+// Schema Introspection
+// -----------------------------------------------------------------------------
+// Bağlı veritabanının şemasını information_schema üzerinden okur (MySQL).
+// `conduit db:show` ve `conduit db:table` bu API'yi kullanır; uygulama kodu
+// da tablo/kolon varlığını kontrol etmek için kullanabilir.
+//
+// Kullanım:
+//
+//	inspector := schema.NewInspector(db)
+//	tables, err := inspector.Tables()
+//	columns, err := inspector.Columns("users")
+//	indexes, err := inspector.Indexes("users")
+//
+// Not: Table.Rows InnoDB istatistiklerinden gelen bir tahmindir; kesin sayı
+// için COUNT(*) kullanılmalıdır.
+// -----------------------------------------------------------------------------
+
+package schema
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// ErrTableNotFound, tablo mevcut veritabanında yoksa döner.
+var ErrTableNotFound = errors.New("tablo bulunamadı")
+
+// Database, bağlı veritabanının özetidir.
+type Database struct {
+	Name    string
+	Version string // Sunucu sürümü (örn: 8.0.36)
+	Tables  int
+	Size    int64 // Veri + index boyutu (byte)
+}
+
+// Table, bir tablonun özetidir.
+type Table struct {
+	Name      string
+	Engine    string
+	Collation string
+	Rows      int64 // Tahmini satır sayısı
+	Size      int64 // Veri + index boyutu (byte)
+	Comment   string
+}
+
+// Column, bir tablo kolonudur.
+type Column struct {
+	Name     string
+	Type     string // Tam tip (örn: varchar(255), bigint unsigned)
+	Nullable bool
+	Default  sql.NullString
+	Key      string // PRI, UNI, MUL veya boş
+	Extra    string // auto_increment, on update CURRENT_TIMESTAMP, ...
+	Comment  string
+}
+
+// Index, bir tablo index'idir.
+type Index struct {
+	Name    string
+	Columns []string // Index sırasıyla
+	Unique  bool
+	Primary bool
+	Type    string // BTREE, FULLTEXT, ...
+}
+
+// ForeignKey, bir foreign key kısıtıdır.
+type ForeignKey struct {
+	Name              string
+	Columns           []string
+	ReferencedTable   string
+	ReferencedColumns []string
+	OnUpdate          string
+	OnDelete          string
+}
+
+// Inspector, veritabanı şemasını okur.
+type Inspector struct {
+	db *sql.DB
+}
+
+// NewInspector, yeni bir Inspector oluşturur.
+func NewInspector(db *sql.DB) *Inspector {
+	return &Inspector{db: db}
+}
+
+// Database, bağlı veritabanının adını, sürümünü, tablo sayısını ve toplam
+// boyutunu döndürür.
+func (i *Inspector) Database() (*Database, error) {
+	info := &Database{}
+	if err := i.db.QueryRow("SELECT DATABASE(), VERSION()").Scan(&info.Name, &info.Version); err != nil {
+		return nil, fmt.Errorf("veritabanı bilgisi okunamadı: %w", err)
+	}
+
+	tables, err := i.Tables()
+	if err != nil {
+		return nil, err
+	}
+	info.Tables = len(tables)
+	for _, table := range tables {
+		info.Size += table.Size
+	}
+	return info, nil
+}
+
+// Tables, mevcut veritabanındaki tabloları (view'lar hariç) ada göre sıralı
+// döndürür.
+func (i *Inspector) Tables() ([]Table, error) {
+	rows, err := i.db.Query(`
+		SELECT table_name, COALESCE(engine, ''), COALESCE(table_collation, ''),
+		       COALESCE(table_rows, 0), COALESCE(data_length, 0) + COALESCE(index_length, 0),
+		       COALESCE(table_comment, '')
+		FROM information_schema.tables
+		WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE'
+		ORDER BY table_name`)
+	if err != nil {
+		return nil, fmt.Errorf("tablolar okunamadı: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []Table
+	for rows.Next() {
+		var t Table
+		if err := rows.Scan(&t.Name, &t.Engine, &t.Collation, &t.Rows, &t.Size, &t.Comment); err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	return tables, rows.Err()
+}
+
+// Table, tek bir tablonun özetini döndürür.
+//
+// Döndürür:
+//   - *Table: Tablo özeti
+//   - error: Tablo yoksa ErrTableNotFound
+func (i *Inspector) Table(name string) (*Table, error) {
+	tables, err := i.Tables()
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		if t.Name == name {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrTableNotFound, name)
+}
+
+// HasTable, tablo mevcut veritabanında varsa true döner.
+func (i *Inspector) HasTable(name string) (bool, error) {
+	var count int
+	err := i.db.QueryRow(
+		"SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?",
+		name,
+	).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("tablo kontrol edilemedi: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Columns, tablonun kolonlarını tablo sırasıyla döndürür.
+func (i *Inspector) Columns(table string) ([]Column, error) {
+	rows, err := i.db.Query(`
+		SELECT column_name, column_type, is_nullable, column_default,
+		       column_key, extra, column_comment
+		FROM information_schema.columns
+		WHERE table_schema = DATABASE() AND table_name = ?
+		ORDER BY ordinal_position`, table)
+	if err != nil {
+		return nil, fmt.Errorf("%s kolonları okunamadı: %w", table, err)
+	}
+	defer rows.Close()
+
+	var columns []Column
+	for rows.Next() {
+		var (
+			c        Column
+			nullable string
+		)
+		if err := rows.Scan(&c.Name, &c.Type, &nullable, &c.Default, &c.Key, &c.Extra, &c.Comment); err != nil {
+			return nil, err
+		}
+		c.Nullable = nullable == "YES"
+		columns = append(columns, c)
+	}
+	return columns, rows.Err()
+}
+
+// HasColumn, tabloda kolon varsa true döner.
+func (i *Inspector) HasColumn(table, column string) (bool, error) {
+	columns, err := i.Columns(table)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range columns {
+		if c.Name == column {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Indexes, tablonun index'lerini döndürür; PRIMARY önce, diğerleri ada göre
+// sıralıdır.
+func (i *Inspector) Indexes(table string) ([]Index, error) {
+	rows, err := i.db.Query(`
+		SELECT index_name, column_name, non_unique, index_type
+		FROM information_schema.statistics
+		WHERE table_schema = DATABASE() AND table_name = ?
+		ORDER BY index_name = 'PRIMARY' DESC, index_name, seq_in_index`, table)
+	if err != nil {
+		return nil, fmt.Errorf("%s index'leri okunamadı: %w", table, err)
+	}
+	defer rows.Close()
+
+	var indexes []Index
+	for rows.Next() {
+		var (
+			name, column, indexType string
+			nonUnique               int
+		)
+		if err := rows.Scan(&name, &column, &nonUnique, &indexType); err != nil {
+			return nil, err
+		}
+
+		// Çok kolonlu index'ler ardışık satırlardır
+		if n := len(indexes); n > 0 && indexes[n-1].Name == name {
+			indexes[n-1].Columns = append(indexes[n-1].Columns, column)
+			continue
+		}
+		indexes = append(indexes, Index{
+			Name:    name,
+			Columns: []string{column},
+			Unique:  nonUnique == 0,
+			Primary: name == "PRIMARY",
+			Type:    indexType,
+		})
+	}
+	return indexes, rows.Err()
+}
+
+// ForeignKeys, tablonun foreign key'lerini ada göre sıralı döndürür.
+func (i *Inspector) ForeignKeys(table string) ([]ForeignKey, error) {
+	rows, err := i.db.Query(`
+		SELECT k.constraint_name, k.column_name, k.referenced_table_name,
+		       k.referenced_column_name, r.update_rule, r.delete_rule
+		FROM information_schema.key_column_usage k
+		JOIN information_schema.referential_constraints r
+		  ON r.constraint_schema = k.constraint_schema AND r.constraint_name = k.constraint_name
+		WHERE k.table_schema = DATABASE() AND k.table_name = ?
+		  AND k.referenced_table_name IS NOT NULL
+		ORDER BY k.constraint_name, k.ordinal_position`, table)
+	if err != nil {
+		return nil, fmt.Errorf("%s foreign key'leri okunamadı: %w", table, err)
+	}
+	defer rows.Close()
+
+	var keys []ForeignKey
+	for rows.Next() {
+		var name, column, refTable, refColumn, onUpdate, onDelete string
+		if err := rows.Scan(&name, &column, &refTable, &refColumn, &onUpdate, &onDelete); err != nil {
+			return nil, err
+		}
+
+		if n := len(keys); n > 0 && keys[n-1].Name == name {
+			keys[n-1].Columns = append(keys[n-1].Columns, column)
+			keys[n-1].ReferencedColumns = append(keys[n-1].ReferencedColumns, refColumn)
+			continue
+		}
+		keys = append(keys, ForeignKey{
+			Name:              name,
+			Columns:           []string{column},
+			ReferencedTable:   refTable,
+			ReferencedColumns: []string{refColumn},
+			OnUpdate:          onUpdate,
+			OnDelete:          onDelete,
+		})
+	}
+	return keys, rows.Err()
+}
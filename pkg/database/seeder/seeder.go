@@ -0,0 +1,131 @@
+// -----------------------------------------------------------------------------
+// Database Seeders
+// -----------------------------------------------------------------------------
+// Seeder'lar veritabanını başlangıç veya test verisiyle doldurur (Laravel
+// Seeder karşılığı). Her seeder init fonksiyonunda Register ile kaydolur;
+// `conduit db:seed` varsayılan olarak DatabaseSeeder'ı, `--class` ile
+// verilen seeder'ı çalıştırır.
+//
+// Seeder'lar DI container'ı alır; veritabanı, grammar, config ve logger
+// container'dan çözülür:
+//
+//	func init() {
+//	    seeder.Register(&UserSeeder{})
+//	}
+//
+//	type UserSeeder struct{}
+//
+//	func (s *UserSeeder) Run(c *container.Container) error {
+//	    users := models.NewUserRepository(container.GetDatabaseAndGrammar(c))
+//	    ...
+//	}
+//
+// Çalışma sırası DatabaseSeeder içinde Call ile belirlenir:
+//
+//	func (s *DatabaseSeeder) Run(c *container.Container) error {
+//	    return seeder.Call(c, &UserSeeder{}, &PostSeeder{})
+//	}
+// -----------------------------------------------------------------------------
+
+package seeder
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/console"
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+// DefaultSeeder, db:seed'in --class verilmediğinde çalıştırdığı seeder'dır.
+const DefaultSeeder = "DatabaseSeeder"
+
+// Seeder, veritabanına veri ekleyen birimdir.
+type Seeder interface {
+	// Run, seeder'ı çalıştırır. Bağımlılıklar container'dan çözülür.
+	Run(c *container.Container) error
+}
+
+// Global seeder registry
+var (
+	registry   = make(map[string]Seeder)
+	registryMu sync.RWMutex
+)
+
+// Name, seeder'ın registry adını döndürür (pointer'sız tip adı, örn:
+// UserSeeder).
+func Name(s Seeder) string {
+	t := reflect.TypeOf(s)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// Register, seeder'ı tip adıyla kaydeder. Aynı adla ikinci kayıt panic'e
+// yol açar.
+func Register(s Seeder) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	name := Name(s)
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("seeder: %s zaten kayıtlı", name))
+	}
+	registry[name] = s
+}
+
+// Get, adı verilen seeder'ı döndürür.
+func Get(name string) (Seeder, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	s, ok := registry[name]
+	return s, ok
+}
+
+// Registered, kayıtlı seeder adlarını sıralı döndürür.
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Unregister, seeder'ı registry'den siler (testler için).
+func Unregister(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(registry, name)
+}
+
+// Call, seeder'ları verilen sırayla çalıştırır; ilk hatada durur.
+//
+// Parametreler:
+//   - c: Seeder'lara verilecek container
+//   - seeders: Çalıştırılacak seeder'lar
+//
+// Döndürür:
+//   - error: Başarısız seeder'ın adıyla sarılmış hata
+func Call(c *container.Container, seeders ...Seeder) error {
+	for _, s := range seeders {
+		name := Name(s)
+		start := time.Now()
+
+		console.Line("🌱 Seeding: %s", name)
+		if err := s.Run(c); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		console.Success("Seeded:  %s (%s)", name, time.Since(start).Round(time.Millisecond))
+	}
+	return nil
+}
@@ -23,12 +23,20 @@
 //
 // Eğer işlem sırasında hata olursa:
 //   tx.Rollback()
+//
+// Commit'ten sonra çalışacak işler AfterCommit ile kaydedilir; rollback'te
+// atılırlar. tx.NewBuilder() ile yapılan yazmaların model event'leri ve
+// dispatcher.DispatchAfterCommit(tx, event) ile eklenen event'ler bu yolla
+// sadece commit başarılı olursa dispatch edilir.
 
 package database
 
 import (
 	"database/sql"
 	"log"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/events"
 )
 
 // Transaction
@@ -39,6 +47,9 @@ import (
 type Transaction struct {
 	Tx      *sql.Tx
 	grammar Grammar
+
+	mu          sync.Mutex
+	afterCommit []func() // Commit sonrası çalışacak callback'ler
 }
 
 // BeginTransaction
@@ -65,20 +76,50 @@ func BeginTransaction(db *sql.DB, grammar Grammar) (*Transaction, error) {
 }
 
 // Transaction'a bağlı yeni bir QueryBuilder oluşturur.
+// Builder'ın model event'leri commit'e ertelenir.
 func (t *Transaction) NewBuilder() *QueryBuilder {
-	return NewBuilder(t.Tx, t.grammar)
+	qb := NewBuilder(t.Tx, t.grammar)
+	qb.tx = t
+	return qb
+}
+
+// AfterCommit
+//
+// fn'i transaction başarıyla commit edildikten sonra çalıştırılmak üzere
+// kaydeder. Callback'ler eklendikleri sırayla çalışır; Rollback'te veya
+// başarısız Commit'te atılır.
+func (t *Transaction) AfterCommit(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.afterCommit = append(t.afterCommit, fn)
+}
+
+// takeCallbacks, kayıtlı callback'leri döndürür ve listeyi temizler.
+func (t *Transaction) takeCallbacks() []func() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	callbacks := t.afterCommit
+	t.afterCommit = nil
+	return callbacks
 }
 
 // Commit
 //
 // Başlatılmış olan transaction’ı başarılı şekilde sonlandırır.
-// Eğer hata oluşmazsa commit edildiğine dair log basar.
+// Eğer hata oluşmazsa commit edildiğine dair log basar ve AfterCommit
+// callback'lerini çalıştırır.
 //
 // Dönüş: error
 func (t *Transaction) Commit() error {
 	err := t.Tx.Commit()
+	callbacks := t.takeCallbacks()
 	if err == nil {
 		log.Println("✅ Transaction commit edildi.")
+		for _, fn := range callbacks {
+			fn()
+		}
 	}
 	return err
 }
@@ -90,9 +131,13 @@ func (t *Transaction) Commit() error {
 //
 // Dönüş: error
 func (t *Transaction) Rollback() error {
+	t.takeCallbacks() // Geri alınan veriye ait event'ler dispatch edilmez
 	err := t.Tx.Rollback()
 	if err == nil {
 		log.Println("❌ Transaction geri alındı.")
 	}
 	return err
 }
+
+// Transaction'ın events.TransactionScope olduğunu derleme zamanında doğrula
+var _ events.TransactionScope = (*Transaction)(nil)
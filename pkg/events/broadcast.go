@@ -0,0 +1,110 @@
+// -----------------------------------------------------------------------------
+// Event Broadcasting
+// -----------------------------------------------------------------------------
+// ShouldBroadcast event'leri, dispatch edildiğinde listener'lara ek olarak
+// isimli kanallara yayınlanır. Yayın Broadcaster üzerinden yapılır
+// (pkg/broadcast: Redis pub/sub, local hub, log); bağlı SSE/WebSocket
+// client'ları kanal mesajlarını gerçek zamanlı alır.
+//
+// Kullanım:
+//
+//	type OrderShipped struct {
+//	    *events.BaseEvent
+//	    Order *models.Order
+//	}
+//
+//	func (e *OrderShipped) BroadcastOn() []string {
+//	    return []string{fmt.Sprintf("private-orders.%d", e.Order.UserID)}
+//	}
+//
+//	// Opsiyonel: client'a giden event adı ve veri
+//	func (e *OrderShipped) BroadcastAs() string { return "order.shipped" }
+//	func (e *OrderShipped) BroadcastWith() interface{} {
+//	    return map[string]any{"id": e.Order.ID, "status": e.Order.Status}
+//	}
+//
+//	dispatcher.SetBroadcaster(broadcaster)
+//	dispatcher.Dispatch(&OrderShipped{...})
+// -----------------------------------------------------------------------------
+
+package events
+
+// ShouldBroadcast, kanallara yayınlanacak event'tir.
+type ShouldBroadcast interface {
+	Event
+
+	// BroadcastOn, event'in yayınlanacağı kanalları döndürür. "private-"
+	// ile başlayan kanallara sadece yetkili kullanıcılar abone olabilir.
+	BroadcastOn() []string
+}
+
+// BroadcastNamer, client'a gönderilen event adını değiştirir
+// (varsayılan: Event.Name()).
+type BroadcastNamer interface {
+	BroadcastAs() string
+}
+
+// BroadcastPayloader, client'a gönderilen veriyi belirler
+// (varsayılan: Event.Payload()). Hassas alanları yayından çıkarmak için
+// kullanılır.
+type BroadcastPayloader interface {
+	BroadcastWith() interface{}
+}
+
+// Broadcaster, event'leri kanallara yayınlayan driver'dır.
+type Broadcaster interface {
+	// Broadcast, veriyi event adıyla verilen kanallara yayınlar.
+	//
+	// Parametreler:
+	//   - channels: Kanal adları
+	//   - event: Client'a gönderilecek event adı
+	//   - data: JSON'a çevrilebilir veri
+	//
+	// Döndürür:
+	//   - error: Yayın başarısızsa hata
+	Broadcast(channels []string, event string, data interface{}) error
+}
+
+// SetBroadcaster, ShouldBroadcast event'lerinin yayınlanacağı driver'ı
+// ayarlar (nil: event'ler yayınlanmaz).
+//
+// Örnek:
+//
+//	dispatcher.SetBroadcaster(broadcast.NewRedisBroadcaster(client, "conduit:broadcast:", logger))
+func (d *Dispatcher) SetBroadcaster(broadcaster Broadcaster) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.broadcaster = broadcaster
+}
+
+// broadcast, event ShouldBroadcast ise kanallarına yayınlar.
+func (d *Dispatcher) broadcast(broadcaster Broadcaster, event Event) error {
+	broadcastable, ok := event.(ShouldBroadcast)
+	if !ok || broadcaster == nil || IsReplay(event) {
+		return nil
+	}
+
+	channels := broadcastable.BroadcastOn()
+	if len(channels) == 0 {
+		return nil
+	}
+
+	name := event.Name()
+	if namer, ok := event.(BroadcastNamer); ok {
+		name = namer.BroadcastAs()
+	}
+
+	data := event.Payload()
+	if payloader, ok := event.(BroadcastPayloader); ok {
+		data = payloader.BroadcastWith()
+	}
+
+	if err := broadcaster.Broadcast(channels, name, data); err != nil {
+		d.logger.Printf("❌ Broadcast error for '%s': %v", event.Name(), err)
+		return err
+	}
+
+	d.logger.Printf("📡 Broadcast event: %s (channels: %d)", name, len(channels))
+	return nil
+}
@@ -27,7 +27,9 @@ package events
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 )
@@ -37,16 +39,21 @@ import (
 // Özellikler:
 // - Thread-safe (concurrent kullanım için güvenli)
 // - Multiple listeners per event
+// - Listener priority ve propagation kontrolü (ErrStopPropagation, Halt)
 // - Wildcard listener desteği
 // - Synchronous ve asynchronous dispatch
 // - Graceful shutdown with context
 type Dispatcher struct {
-	mu        sync.RWMutex
-	listeners map[string][]Listener
-	logger    Logger
-	wg        sync.WaitGroup // Async event'leri takip etmek için
-	ctx       context.Context
-	cancel    context.CancelFunc
+	mu            sync.RWMutex
+	listeners     map[string][]registeredListener // Priority'ye göre sıralı
+	logger        Logger
+	queue         ListenerQueue  // ShouldQueue listener'ları için (nil: inline çalışır)
+	broadcaster   Broadcaster    // ShouldBroadcast event'leri için (nil: yayınlanmaz)
+	store         Store          // Event kaydı için (nil: kaydedilmez)
+	storePatterns []string       // Kaydedilecek event adları (boş: hepsi)
+	wg            sync.WaitGroup // Async event'leri takip etmek için
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 // NewDispatcher, yeni bir Dispatcher oluşturur.
@@ -68,7 +75,7 @@ type Dispatcher struct {
 func NewDispatcher(logger Logger) *Dispatcher {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Dispatcher{
-		listeners: make(map[string][]Listener),
+		listeners: make(map[string][]registeredListener),
 		logger:    logger,
 		ctx:       ctx,
 		cancel:    cancel,
@@ -96,17 +103,79 @@ func NewDispatcher(logger Logger) *Dispatcher {
 //	    return nil
 //	}))
 func (d *Dispatcher) Listen(eventName string, listener Listener) {
+	d.ListenWithPriority(eventName, listener, 0)
+}
+
+// ListenWithPriority, listener'ı belirtilen öncelikle kaydeder.
+//
+// Yüksek öncelikli listener'lar önce çalışır; aynı öncelikteki listener'lar
+// kayıt sırasıyla çalışır. Listen önceliği 0'dır. Doğrulama yapan ve
+// ErrStopPropagation/Halt ile zinciri durdurabilen listener'lar, yan etkisi
+// olan listener'lardan (email, webhook) daha yüksek öncelikle kaydedilmelidir.
+//
+// Parametreler:
+//   - eventName: Dinlenecek event adı
+//   - listener: Event gerçekleştiğinde çalışacak listener
+//   - priority: Öncelik (yüksek = önce)
+//
+// Örnek:
+//
+//	dispatcher.ListenWithPriority("order.placed", &ValidateStock{}, 100)
+//	dispatcher.Listen("order.placed", &SendOrderConfirmation{})
+func (d *Dispatcher) ListenWithPriority(eventName string, listener Listener, priority int) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	d.listeners[eventName] = append(d.listeners[eventName], listener)
-	d.logger.Printf("✅ Listener registered for event: %s", eventName)
+	// Dispatch, aldığı slice'ı kilitsiz gezer; yerinde değiştirmek yerine
+	// yeni slice oluşturulur
+	current := d.listeners[eventName]
+	position := len(current)
+	for i, registered := range current {
+		if registered.priority < priority {
+			position = i
+			break
+		}
+	}
+
+	listeners := make([]registeredListener, 0, len(current)+1)
+	listeners = append(listeners, current[:position]...)
+	listeners = append(listeners, registeredListener{listener: listener, priority: priority})
+	listeners = append(listeners, current[position:]...)
+	d.listeners[eventName] = listeners
+
+	d.logger.Printf("✅ Listener registered for event: %s (priority: %d)", eventName, priority)
+}
+
+// SetQueue, ShouldQueue listener'larının ekleneceği kuyruğu ayarlar.
+//
+// Kuyruk ayarlanmamışsa (nil) ShouldQueue listener'ları diğerleri gibi
+// inline çalıştırılır.
+//
+// Örnek:
+//
+//	dispatcher.SetQueue(queue.NewListenerQueue(q, "listeners"))
+func (d *Dispatcher) SetQueue(q ListenerQueue) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.queue = q
 }
 
 // Dispatch, bir event'i tüm kayıtlı listener'lara gönderir.
 //
 // Tüm listener'lar sırayla (synchronously) çalıştırılır.
 // Bir listener error dönerse, diğerleri yine de çalışmaya devam eder.
+// ShouldQueue listener'ları, SetQueue ile kuyruk ayarlıysa çalıştırılmak
+// yerine kuyruğa eklenir; kuyruğa ekleme hatası listener hatası gibi döner.
+//
+// Listener'lar priority sırasıyla çalışır. ErrStopPropagation dönen listener
+// sonraki listener'ları durdurur (hata sayılmaz); Halt ile sarılmış bir hata
+// zinciri durdurur ve Dispatch bu hatayı döndürür.
+//
+// ShouldBroadcast event'leri listener'lardan sonra (listener olmasa da)
+// SetBroadcaster ile ayarlanan driver'a yayınlanır; Halt ile durdurulan
+// event'ler yayınlanmaz. SetStore ile store ayarlıysa event listener'lardan
+// önce kaydedilir.
 //
 // Parametre:
 //   - event: Dispatch edilecek event
@@ -127,9 +196,17 @@ func (d *Dispatcher) Listen(eventName string, listener Listener) {
 func (d *Dispatcher) Dispatch(event Event) error {
 	d.mu.RLock()
 	listeners := d.listeners[event.Name()]
+	listenerQueue := d.queue
+	broadcaster := d.broadcaster
+	store, storePatterns := d.store, d.storePatterns
 	d.mu.RUnlock()
 
+	d.record(store, storePatterns, event)
+
 	if len(listeners) == 0 {
+		if _, ok := event.(ShouldBroadcast); ok && broadcaster != nil {
+			return d.broadcast(broadcaster, event)
+		}
 		d.logger.Printf("⚠️  No listeners for event: %s", event.Name())
 		return nil
 	}
@@ -138,16 +215,42 @@ func (d *Dispatcher) Dispatch(event Event) error {
 
 	var lastError error
 
-	for i, listener := range listeners {
+chain:
+	for i, registered := range listeners {
+		listener := registered.listener
+
+		if queued, ok := listener.(ShouldQueue); ok && listenerQueue != nil {
+			d.logger.Printf("   [%d/%d] Queueing listener for: %s", i+1, len(listeners), event.Name())
+
+			if err := listenerQueue.PushListener(queued, event); err != nil {
+				lastError = err
+				d.logger.Printf("❌ Listener queue error for '%s': %v", event.Name(), err)
+			}
+			continue
+		}
+
 		d.logger.Printf("   [%d/%d] Executing listener for: %s", i+1, len(listeners), event.Name())
 
-		if err := listener.Handle(event); err != nil {
+		err := listener.Handle(event)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrStopPropagation):
+			d.logger.Printf("⏹️  Propagation stopped for '%s' by listener %d/%d", event.Name(), i+1, len(listeners))
+			break chain
+		case IsHalted(err):
+			d.logger.Printf("⛔ Listener halted '%s': %v", event.Name(), err)
+			return err
+		default:
 			lastError = err
 			d.logger.Printf("❌ Listener error for '%s': %v", event.Name(), err)
 			// Hataya rağmen diğer listener'ları çalıştırmaya devam et
 		}
 	}
 
+	if err := d.broadcast(broadcaster, event); err != nil && lastError == nil {
+		lastError = err
+	}
+
 	return lastError
 }
 
@@ -304,7 +407,7 @@ func (d *Dispatcher) Clear() {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	d.listeners = make(map[string][]Listener)
+	d.listeners = make(map[string][]registeredListener)
 	d.logger.Println("🗑️  All event listeners cleared")
 }
 
@@ -334,9 +437,9 @@ func (d *Dispatcher) Stats() map[string]int {
 // PrintStats, dispatcher istatistiklerini konsola yazdırır.
 func (d *Dispatcher) PrintStats() {
 	stats := d.Stats()
-	d.logger.Println("\n" + "=".repeat(70))
+	d.logger.Println("\n" + strings.Repeat("=", 70))
 	d.logger.Println("📊 Event Dispatcher Stats")
-	d.logger.Println("=".repeat(70))
+	d.logger.Println(strings.Repeat("=", 70))
 
 	totalListeners := 0
 	for event, count := range stats {
@@ -346,7 +449,7 @@ func (d *Dispatcher) PrintStats() {
 
 	d.logger.Printf("\nTotal Events: %d", len(stats))
 	d.logger.Printf("Total Listeners: %d", totalListeners)
-	d.logger.Println("=".repeat(70))
+	d.logger.Println(strings.Repeat("=", 70))
 }
 
 // Shutdown, dispatcher'ı güvenli bir şekilde kapatır.
@@ -7,12 +7,15 @@
 // - Async dispatch with context cancellation
 // - Race condition testing
 // - Concurrent dispatch
+// - Listener priority & propagation control
 // -----------------------------------------------------------------------------
 
 package events
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -375,6 +378,82 @@ func TestDispatcher_ConditionalListener(t *testing.T) {
 	}
 }
 
+// TestDispatcher_Priority tests that higher priority listeners run first and
+// equal priorities keep registration order.
+func TestDispatcher_Priority(t *testing.T) {
+	dispatcher := NewDispatcher(NewMockLogger(false))
+	defer dispatcher.Shutdown()
+
+	var order []string
+	record := func(name string) Listener {
+		return ListenerFunc(func(e Event) error {
+			order = append(order, name)
+			return nil
+		})
+	}
+
+	dispatcher.Listen("test.event", record("default-1"))
+	dispatcher.ListenWithPriority("test.event", record("low"), -10)
+	dispatcher.ListenWithPriority("test.event", record("validate"), 100)
+	dispatcher.Listen("test.event", record("default-2"))
+	dispatcher.ListenWithPriority("test.event", record("authorize"), 100)
+
+	if err := dispatcher.Dispatch(NewBaseEvent("test.event", nil)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	want := "validate,authorize,default-1,default-2,low"
+	if got := strings.Join(order, ","); got != want {
+		t.Errorf("Expected order %s, got: %s", want, got)
+	}
+}
+
+// TestDispatcher_StopPropagation tests ErrStopPropagation and Halt.
+func TestDispatcher_StopPropagation(t *testing.T) {
+	t.Run("StopPropagation", func(t *testing.T) {
+		dispatcher := NewDispatcher(NewMockLogger(false))
+		defer dispatcher.Shutdown()
+
+		stopper := NewTestListener("stopper")
+		stopper.err = ErrStopPropagation
+		sideEffect := NewTestListener("side-effect")
+
+		dispatcher.Listen("test.event", sideEffect)
+		dispatcher.ListenWithPriority("test.event", stopper, 10)
+
+		if err := dispatcher.Dispatch(NewBaseEvent("test.event", nil)); err != nil {
+			t.Errorf("Stopping propagation should not be an error, got: %v", err)
+		}
+		if stopper.HandledCount() != 1 || sideEffect.HandledCount() != 0 {
+			t.Errorf("Expected only the stopper to run, got stopper=%d side-effect=%d", stopper.HandledCount(), sideEffect.HandledCount())
+		}
+	})
+
+	t.Run("Halt", func(t *testing.T) {
+		dispatcher := NewDispatcher(NewMockLogger(false))
+		defer dispatcher.Shutdown()
+
+		errInvalid := errors.New("invalid order")
+		validator := NewTestListener("validator")
+		validator.err = Halt(errInvalid)
+		sideEffect := NewTestListener("side-effect")
+
+		dispatcher.ListenWithPriority("test.event", validator, 10)
+		dispatcher.Listen("test.event", sideEffect)
+
+		err := dispatcher.Dispatch(NewBaseEvent("test.event", nil))
+		if !errors.Is(err, errInvalid) || !IsHalted(err) {
+			t.Errorf("Expected halted errInvalid, got: %v", err)
+		}
+		if sideEffect.HandledCount() != 0 {
+			t.Error("Listeners after a halt should not run")
+		}
+		if Halt(nil) != nil {
+			t.Error("Halt(nil) should be nil")
+		}
+	})
+}
+
 // Helper function to count goroutines
 func countGoroutines() int {
 	// This is an approximation for testing
@@ -21,6 +21,9 @@
 package events
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
 	"time"
 )
 
@@ -100,6 +103,65 @@ func (e *BaseEvent) SetPayload(payload interface{}) {
 	e.payload = payload
 }
 
+// DecodePayload, event payload'ını dest'e yazar.
+//
+// Payload dest'in tipindeyse (veya ona pointer'sa) doğrudan kopyalanır;
+// aksi halde JSON üzerinden çözülür. Kuyrukta çalışan listener'larda
+// payload JSON'dan (json.RawMessage) gelir; DecodePayload ile yazılan
+// listener hem inline hem kuyrukta çalışır.
+//
+// Parametreler:
+//   - event: Payload'ı okunacak event
+//   - dest: Hedef pointer (örn: &user)
+//
+// Döndürür:
+//   - error: dest pointer değilse veya payload çözülemezse
+//
+// Örnek:
+//
+//	var user models.User
+//	if err := events.DecodePayload(event, &user); err != nil {
+//	    return err
+//	}
+func DecodePayload(event Event, dest interface{}) error {
+	target := reflect.ValueOf(dest)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return fmt.Errorf("event payload: dest pointer olmalı (%T)", dest)
+	}
+	target = target.Elem()
+
+	payload := event.Payload()
+	if payload == nil {
+		return nil
+	}
+
+	if raw, ok := payload.(json.RawMessage); ok {
+		if err := json.Unmarshal(raw, dest); err != nil {
+			return fmt.Errorf("event payload çözülemedi (%s): %w", event.Name(), err)
+		}
+		return nil
+	}
+
+	value := reflect.ValueOf(payload)
+	switch {
+	case value.Type().AssignableTo(target.Type()):
+		target.Set(value)
+		return nil
+	case value.Kind() == reflect.Ptr && !value.IsNil() && value.Elem().Type().AssignableTo(target.Type()):
+		target.Set(value.Elem())
+		return nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("event payload serialize edilemedi (%s): %w", event.Name(), err)
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("event payload çözülemedi (%s): %w", event.Name(), err)
+	}
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // Common Event Types (Yaygın Event Tipleri)
 // -----------------------------------------------------------------------------
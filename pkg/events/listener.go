@@ -23,6 +23,11 @@
 
 package events
 
+import (
+	"errors"
+	"time"
+)
+
 // Listener, event'leri dinleyen ve işleyen interface.
 //
 // Her listener, Handle() metodunu implement etmelidir.
@@ -42,6 +47,64 @@ type Listener interface {
 	Handle(event Event) error
 }
 
+// registeredListener, dispatcher'a önceliğiyle kaydedilmiş listener'dır.
+type registeredListener struct {
+	listener Listener
+	priority int
+}
+
+// -----------------------------------------------------------------------------
+// Propagation Control
+// -----------------------------------------------------------------------------
+
+// ErrStopPropagation, listener'dan döndüğünde sonraki listener'lar
+// çalıştırılmaz. Dispatch bunu hata olarak döndürmez.
+//
+// Örnek:
+//
+//	func (l *SkipBots) Handle(e events.Event) error {
+//	    if isBot(e) {
+//	        return events.ErrStopPropagation
+//	    }
+//	    return nil
+//	}
+var ErrStopPropagation = errors.New("event propagation stopped")
+
+// haltError, zinciri durduran listener hatasıdır.
+type haltError struct {
+	err error
+}
+
+func (h *haltError) Error() string { return h.err.Error() }
+func (h *haltError) Unwrap() error { return h.err }
+
+// Halt, hatayı zinciri durduracak şekilde sarar: sonraki listener'lar
+// çalıştırılmaz ve Dispatch bu hatayı döndürür (normal hatalarda diğer
+// listener'lar çalışmaya devam eder).
+//
+// Örnek:
+//
+//	func (l *ValidateStock) Handle(e events.Event) error {
+//	    if !inStock(e) {
+//	        return events.Halt(ErrOutOfStock)
+//	    }
+//	    return nil
+//	}
+//
+//	err := dispatcher.Dispatch(event) // errors.Is(err, ErrOutOfStock) == true
+func Halt(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &haltError{err: err}
+}
+
+// IsHalted, hatanın Halt ile sarılıp sarılmadığını döndürür.
+func IsHalted(err error) bool {
+	var halt *haltError
+	return errors.As(err, &halt)
+}
+
 // ListenerFunc, fonksiyonları Listener interface'ine çevirir.
 //
 // Bu adapter pattern sayesinde, struct tanımlamadan
@@ -120,6 +183,57 @@ func (a *AsyncListener) Handle(event Event) error {
 	return nil
 }
 
+// -----------------------------------------------------------------------------
+// Queued Listener
+// -----------------------------------------------------------------------------
+
+// Queued, listener'a embed edildiğinde onu ShouldQueue yapar.
+//
+// Dispatcher'a SetQueue ile bir kuyruk verildiyse bu listener'lar inline
+// çalıştırılmaz; listener ve event payload'ı bir job olarak kuyruğa eklenir
+// ve worker'da çalıştırılır (bkz: queue.NewListenerQueue).
+//
+// Kullanım:
+//
+//	type SendWelcomeEmail struct {
+//	    events.Queued
+//	    Mailer mail.Mailer
+//	}
+//
+//	dispatcher.Listen("user.registered", &SendWelcomeEmail{
+//	    Queued: events.Queued{Queue: "emails", Delay: time.Minute},
+//	    Mailer: mailer,
+//	})
+//
+// Worker'da listener yeniden oluşturulur ve payload JSON'dan çözülür;
+// payload'a Payload() yerine DecodePayload ile erişilmelidir.
+type Queued struct {
+	Queue string        // Kuyruk adı (boş: ListenerQueue'nun varsayılanı)
+	Delay time.Duration // Gecikme (0: hemen)
+}
+
+// QueueOptions, listener'ın kuyruk ayarlarını döndürür.
+func (q Queued) QueueOptions() Queued {
+	return q
+}
+
+// ShouldQueue, kuyrukta çalıştırılması gereken listener'dır.
+type ShouldQueue interface {
+	Listener
+
+	// QueueOptions, listener'ın kuyruğunu ve gecikmesini döndürür.
+	QueueOptions() Queued
+}
+
+// ListenerQueue, ShouldQueue listener'larını kuyruğa ekleyen arka uçtur.
+//
+// pkg/queue bunu NewListenerQueue ile implement eder; events paketi queue'ya
+// bağımlı değildir.
+type ListenerQueue interface {
+	// PushListener, listener'ı event ile birlikte kuyruğa ekler.
+	PushListener(listener ShouldQueue, event Event) error
+}
+
 // -----------------------------------------------------------------------------
 // Conditional Listener
 // -----------------------------------------------------------------------------
@@ -0,0 +1,150 @@
+// -----------------------------------------------------------------------------
+// Event Service Provider
+// -----------------------------------------------------------------------------
+// EventServiceProvider, uygulamanın event → listener eşlemesini tek bir
+// map'te toplar (Laravel EventServiceProvider::$listen karşılığı). main
+// içinde dağınık Listen çağrıları yerine eşleme tanımlanır ve boot sırasında
+// dispatcher'a kaydedilir.
+//
+// Listener'lar iki şekilde verilebilir:
+//
+//   - Nil pointer ((*listeners.SendWelcomeEmail)(nil)): Boot'ta Resolver ile
+//     (genellikle DI container) oluşturulur; dependency'ler inject edilir.
+//   - Hazır instance (&listeners.AuditLog{}): Olduğu gibi kaydedilir.
+//
+// Kullanım:
+//
+//	provider := &events.EventServiceProvider{
+//	    Listen: map[string][]events.Listener{
+//	        events.EventUserRegistered: {
+//	            (*listeners.SendWelcomeEmail)(nil),
+//	            (*listeners.UpdateUserStats)(nil),
+//	        },
+//	    },
+//	}
+//
+//	if err := container.RegisterEventProvider(c, dispatcher, provider); err != nil {
+//	    logger.Fatalf("❌ %v", err)
+//	}
+//
+// Eşleme `conduit event:list` ile görüntülenir.
+// -----------------------------------------------------------------------------
+
+package events
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Resolver, listener tipinden instance oluşturur. container.Container.Get
+// bu imzaya uyar.
+type Resolver func(listenerType reflect.Type) (interface{}, error)
+
+// EventServiceProvider, event → listener eşlemesidir.
+type EventServiceProvider struct {
+	// Listen, event adından listener'lara eşlemedir. Listener'lar verildiği
+	// sırayla kaydedilir.
+	Listen map[string][]Listener
+}
+
+// ListenerInfo, eşlemedeki bir listener'ın açıklamasıdır.
+type ListenerInfo struct {
+	Type   string // Listener tipi (örn: *listeners.SendWelcomeEmail)
+	Queued bool   // ShouldQueue listener'ı mı?
+}
+
+// EventMapping, bir event'in listener'larıdır.
+type EventMapping struct {
+	Event     string
+	Listeners []ListenerInfo
+}
+
+// Mappings, eşlemeyi event adına göre sıralı döndürür. Listener'lar
+// çözülmez; sadece tipleri raporlanır.
+func (p *EventServiceProvider) Mappings() []EventMapping {
+	mappings := make([]EventMapping, 0, len(p.Listen))
+	for _, event := range p.events() {
+		mapping := EventMapping{Event: event}
+		for _, listener := range p.Listen[event] {
+			_, queued := listener.(ShouldQueue)
+			mapping.Listeners = append(mapping.Listeners, ListenerInfo{
+				Type:   fmt.Sprintf("%T", listener),
+				Queued: queued,
+			})
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
+
+// Boot, eşlemedeki listener'ları çözer ve dispatcher'a kaydeder.
+//
+// Tüm listener'lar kayıttan önce çözülür; biri çözülemezse hiçbiri
+// kaydedilmez.
+//
+// Parametreler:
+//   - dispatcher: Listener'ların kaydedileceği dispatcher
+//   - resolve: Nil pointer listener'ları oluşturan fonksiyon (örn: c.Get)
+//
+// Döndürür:
+//   - error: Listener çözülemezse veya çözülen servis Listener değilse
+func (p *EventServiceProvider) Boot(dispatcher *Dispatcher, resolve Resolver) error {
+	type binding struct {
+		event    string
+		listener Listener
+	}
+
+	var bindings []binding
+	for _, event := range p.events() {
+		for _, listener := range p.Listen[event] {
+			resolved, err := resolveListener(listener, resolve)
+			if err != nil {
+				return fmt.Errorf("%s listener'ı çözülemedi: %w", event, err)
+			}
+			bindings = append(bindings, binding{event: event, listener: resolved})
+		}
+	}
+
+	for _, b := range bindings {
+		dispatcher.Listen(b.event, b.listener)
+	}
+	return nil
+}
+
+// events, eşlemedeki event adlarını sıralı döndürür.
+func (p *EventServiceProvider) events() []string {
+	names := make([]string, 0, len(p.Listen))
+	for event := range p.Listen {
+		names = append(names, event)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveListener, nil pointer listener'ı resolver ile oluşturur; diğer
+// listener'ları olduğu gibi döndürür.
+func resolveListener(listener Listener, resolve Resolver) (Listener, error) {
+	if listener == nil {
+		return nil, fmt.Errorf("nil listener")
+	}
+
+	value := reflect.ValueOf(listener)
+	if value.Kind() != reflect.Ptr || !value.IsNil() {
+		return listener, nil
+	}
+	if resolve == nil {
+		return nil, fmt.Errorf("%T için resolver verilmedi", listener)
+	}
+
+	service, err := resolve(value.Type())
+	if err != nil {
+		return nil, err
+	}
+	resolved, ok := service.(Listener)
+	if !ok || resolved == nil {
+		return nil, fmt.Errorf("%T events.Listener değil", service)
+	}
+	return resolved, nil
+}
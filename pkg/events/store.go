@@ -0,0 +1,275 @@
+// -----------------------------------------------------------------------------
+// Event Store & Replay
+// -----------------------------------------------------------------------------
+// Dispatcher'a bir Store verildiğinde dispatch edilen event'ler adı, JSON
+// payload'ı ve zamanıyla kalıcı olarak kaydedilir. Kayıtlı event'ler Replay
+// ile tekrar dispatch edilebilir; projection'ları (istatistik tabloları,
+// arama index'leri) yeniden oluşturmak ve hataları incelemek için
+// kullanılır.
+//
+// Kullanım:
+//
+//	store := database.NewEventStore(db, grammar)
+//	dispatcher.SetStore(store, "user.*", "order.*") // pattern yok: tüm event'ler
+//
+//	// Dünkü sipariş event'lerini tekrar oynat
+//	result, err := dispatcher.Replay(ctx, store, events.StoreQuery{
+//	    Names: []string{"order.placed"},
+//	    Since: time.Now().Add(-24 * time.Hour),
+//	})
+//
+// Replay edilen event'lerin payload'ı json.RawMessage'dır (listener'lar
+// events.DecodePayload kullanmalıdır). Bu event'ler store'a tekrar
+// yazılmaz ve broadcast edilmez; listener'lar events.IsReplay ile yan
+// etkilerini (email, webhook) atlayabilir.
+// -----------------------------------------------------------------------------
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultReplayBatch, Replay'in store'dan tek seferde okuduğu kayıt sayısıdır.
+const DefaultReplayBatch = 500
+
+// StoredEvent, store'daki bir event kaydıdır.
+type StoredEvent struct {
+	ID         int64           `json:"id"`
+	Name       string          `json:"name"`
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// Event, kaydı tekrar dispatch edilebilecek bir event'e çevirir.
+func (s StoredEvent) Event() Event {
+	return &ReplayedEvent{stored: s}
+}
+
+// StoreQuery, store'dan okunacak kayıt aralığıdır. Sıfır değerli alanlar
+// filtre uygulamaz; kayıtlar ID sırasıyla döner.
+type StoreQuery struct {
+	FromID int64     // Bu ID ve sonrası
+	ToID   int64     // Bu ID ve öncesi
+	Since  time.Time // Bu zaman ve sonrası (OccurredAt)
+	Until  time.Time // Bu zaman ve öncesi (OccurredAt)
+	Names  []string  // Sadece bu event'ler
+	Limit  int       // En fazla kayıt sayısı
+}
+
+// Store, event'leri kalıcı olarak saklayan arayüzdür.
+type Store interface {
+	// Append, event'i kaydeder ve kayıt ID'sini döndürür.
+	Append(event Event) (int64, error)
+
+	// Range, sorguya uyan kayıtları ID sırasıyla döndürür.
+	Range(query StoreQuery) ([]StoredEvent, error)
+}
+
+// ReplayedEvent, store'dan tekrar dispatch edilen event'tir.
+type ReplayedEvent struct {
+	stored StoredEvent
+}
+
+func (e *ReplayedEvent) Name() string          { return e.stored.Name }
+func (e *ReplayedEvent) OccurredAt() time.Time { return e.stored.OccurredAt }
+func (e *ReplayedEvent) Payload() interface{}  { return e.stored.Payload }
+
+// StoredID, event'in store'daki kayıt ID'sini döndürür.
+func (e *ReplayedEvent) StoredID() int64 { return e.stored.ID }
+
+// IsReplay, event'in Replay ile tekrar dispatch edilip edilmediğini
+// döndürür.
+//
+// Örnek:
+//
+//	func (l *SendOrderConfirmation) Handle(e events.Event) error {
+//	    if events.IsReplay(e) {
+//	        return nil // Müşteriye ikinci kez email gönderme
+//	    }
+//	    ...
+//	}
+func IsReplay(event Event) bool {
+	_, ok := event.(*ReplayedEvent)
+	return ok
+}
+
+// SetStore, dispatch edilen event'lerin kaydedileceği store'u ayarlar
+// (nil: kayıt yapılmaz).
+//
+// Parametreler:
+//   - store: Event store
+//   - patterns: Kaydedilecek event adları; "user.*" gibi sonu * ile biten
+//     pattern'ler prefix olarak eşleşir (boş: tüm event'ler)
+func (d *Dispatcher) SetStore(store Store, patterns ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.store = store
+	d.storePatterns = patterns
+}
+
+// record, event'i store'a yazar. Kayıt hatası dispatch'i durdurmaz.
+func (d *Dispatcher) record(store Store, patterns []string, event Event) {
+	if store == nil || IsReplay(event) || !matchesAny(patterns, event.Name()) {
+		return
+	}
+
+	if _, err := store.Append(event); err != nil {
+		d.logger.Printf("❌ Event store error for '%s': %v", event.Name(), err)
+	}
+}
+
+// matchesAny, adın pattern'lerden birine uyup uymadığını döndürür.
+func matchesAny(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if pattern == "*" || pattern == name {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplayResult, Replay sonucudur.
+type ReplayResult struct {
+	Replayed int   `json:"replayed"` // Dispatch edilen event sayısı
+	Failed   int   `json:"failed"`   // Listener hatası dönen event sayısı
+	LastID   int64 `json:"last_id"`  // Son dispatch edilen kaydın ID'si
+}
+
+// Replay, store'daki kayıtları ID sırasıyla tekrar dispatch eder.
+//
+// Kayıtlar DefaultReplayBatch'lik gruplar halinde okunur. Listener hataları
+// loglanır ve Failed'a sayılır; replay devam eder. Kesilen bir replay
+// LastID+1'den devam ettirilebilir.
+//
+// Parametreler:
+//   - ctx: İptal edildiğinde replay durur
+//   - store: Kayıtların okunacağı store
+//   - query: Tekrar oynatılacak aralık
+//
+// Döndürür:
+//   - ReplayResult: Dispatch edilen/başarısız event sayıları
+//   - error: Store okunamazsa veya ctx iptal edilirse
+func (d *Dispatcher) Replay(ctx context.Context, store Store, query StoreQuery) (ReplayResult, error) {
+	var result ReplayResult
+	remaining := query.Limit
+
+	for {
+		batch := query
+		batch.Limit = DefaultReplayBatch
+		if remaining > 0 && remaining < batch.Limit {
+			batch.Limit = remaining
+		}
+
+		stored, err := store.Range(batch)
+		if err != nil {
+			return result, fmt.Errorf("event store okunamadı: %w", err)
+		}
+
+		for _, record := range stored {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+
+			if err := d.Dispatch(record.Event()); err != nil {
+				result.Failed++
+				d.logger.Printf("❌ Replay error for '%s' (#%d): %v", record.Name, record.ID, err)
+			}
+			result.Replayed++
+			result.LastID = record.ID
+		}
+
+		if remaining > 0 {
+			remaining -= len(stored)
+			if remaining <= 0 {
+				break
+			}
+		}
+		if len(stored) < batch.Limit {
+			break
+		}
+		query.FromID = result.LastID + 1
+	}
+
+	d.logger.Printf("🔁 Replayed %d events (failed: %d)", result.Replayed, result.Failed)
+	return result, nil
+}
+
+// -----------------------------------------------------------------------------
+// Memory Store
+// -----------------------------------------------------------------------------
+
+// MemoryStore, event'leri bellekte saklar (test ve development için).
+type MemoryStore struct {
+	mu     sync.RWMutex
+	events []StoredEvent
+}
+
+// NewMemoryStore, yeni bir MemoryStore oluşturur.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Append, event'i kaydeder.
+func (s *MemoryStore) Append(event Event) (int64, error) {
+	payload, err := json.Marshal(event.Payload())
+	if err != nil {
+		return 0, fmt.Errorf("event payload serialize edilemedi (%s): %w", event.Name(), err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := int64(len(s.events) + 1)
+	s.events = append(s.events, StoredEvent{
+		ID:         id,
+		Name:       event.Name(),
+		Payload:    payload,
+		OccurredAt: event.OccurredAt(),
+	})
+	return id, nil
+}
+
+// Range, sorguya uyan kayıtları döndürür.
+func (s *MemoryStore) Range(query StoreQuery) ([]StoredEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var names map[string]bool
+	if len(query.Names) > 0 {
+		names = make(map[string]bool, len(query.Names))
+		for _, name := range query.Names {
+			names[name] = true
+		}
+	}
+
+	var result []StoredEvent
+	for _, stored := range s.events {
+		switch {
+		case query.FromID > 0 && stored.ID < query.FromID,
+			query.ToID > 0 && stored.ID > query.ToID,
+			!query.Since.IsZero() && stored.OccurredAt.Before(query.Since),
+			!query.Until.IsZero() && stored.OccurredAt.After(query.Until),
+			names != nil && !names[stored.Name]:
+			continue
+		}
+
+		result = append(result, stored)
+		if query.Limit > 0 && len(result) == query.Limit {
+			break
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,50 @@
+// -----------------------------------------------------------------------------
+// Event Subscribers
+// -----------------------------------------------------------------------------
+// Subscriber, birbiriyle ilişkili birden fazla listener'ı tek bir struct'ta
+// toplar (Laravel event subscriber karşılığı). Örneğin login, logout ve
+// lockout event'lerini dinleyen AuthEventSubscriber tüm kayıtlarını kendi
+// Subscribe metodunda yapar.
+//
+// Kullanım:
+//
+//	type AuthEventSubscriber struct {
+//	    Logger *log.Logger
+//	}
+//
+//	func (s *AuthEventSubscriber) Subscribe(d *events.Dispatcher) {
+//	    d.Listen(events.EventUserLoggedIn, events.ListenerFunc(s.handleLogin))
+//	    d.Listen(events.EventUserLoggedOut, events.ListenerFunc(s.handleLogout))
+//	}
+//
+//	dispatcher.AddSubscriber(&AuthEventSubscriber{Logger: logger})
+//
+// Container'a kaydedilen subscriber'lar container.RegisterSubscribers ile
+// otomatik olarak eklenir.
+// -----------------------------------------------------------------------------
+
+package events
+
+// Subscriber, listener'larını kendisi kaydeden yapıdır.
+type Subscriber interface {
+	// Subscribe, listener'ları dispatcher'a kaydeder.
+	//
+	// Parametre:
+	//   - dispatcher: Listener'ların kaydedileceği dispatcher
+	Subscribe(dispatcher *Dispatcher)
+}
+
+// AddSubscriber, subscriber'ların listener'larını kaydeder.
+//
+// Parametre:
+//   - subscribers: Eklenecek subscriber'lar
+//
+// Örnek:
+//
+//	dispatcher.AddSubscriber(&AuthEventSubscriber{}, &BillingEventSubscriber{})
+func (d *Dispatcher) AddSubscriber(subscribers ...Subscriber) {
+	for _, subscriber := range subscribers {
+		subscriber.Subscribe(d)
+		d.logger.Printf("✅ Event subscriber registered: %T", subscriber)
+	}
+}
@@ -0,0 +1,65 @@
+// -----------------------------------------------------------------------------
+// Transactional Events
+// -----------------------------------------------------------------------------
+// Transaction içinde oluşan event'ler hemen dispatch edilirse listener'lar
+// henüz commit edilmemiş (ve belki geri alınacak) veriye göre hareket eder:
+// hoş geldin email'i gönderilir ama kullanıcı kaydı rollback olur.
+//
+// DispatchAfterCommit event'i transaction'a bağlar; event sadece commit
+// başarılı olursa dispatch edilir, rollback'te atılır.
+//
+// Kullanım:
+//
+//	tx, err := database.BeginTransaction(db, grammar)
+//	...
+//	result, err := tx.NewBuilder().Table("users").ExecInsert(data)
+//	if err != nil {
+//	    tx.Rollback() // Event dispatch edilmez
+//	    return err
+//	}
+//	dispatcher.DispatchAfterCommit(tx, events.NewUserRegisteredEvent(user))
+//
+//	tx.Commit() // Event şimdi dispatch edilir
+//
+// tx.NewBuilder() ile yapılan yazmaların model event'leri de (model.created
+// vb.) otomatik olarak commit'e ertelenir.
+// -----------------------------------------------------------------------------
+
+package events
+
+// TransactionScope, commit sonrasına callback bağlanabilen transaction'dır
+// (database.Transaction).
+type TransactionScope interface {
+	// AfterCommit, fn'i transaction commit edildikten sonra çalıştırılmak
+	// üzere kaydeder. Rollback'te fn çalıştırılmaz.
+	AfterCommit(fn func())
+}
+
+// DispatchAfterCommit, event'i transaction commit edildiğinde dispatch eder.
+//
+// Event'ler eklendikleri sırayla, Commit'i çağıran goroutine'de senkron
+// dispatch edilir. Commit sonrası listener hataları Commit'in sonucunu
+// etkilemez; loglanır.
+//
+// Parametreler:
+//   - tx: Event'in bağlanacağı transaction (nil: event hemen dispatch edilir)
+//   - event: Dispatch edilecek event
+//
+// Döndürür:
+//   - error: Sadece tx nil ise Dispatch'in hatası; aksi halde nil
+//
+// Örnek:
+//
+//	dispatcher.DispatchAfterCommit(tx, &OrderPlaced{Order: order})
+func (d *Dispatcher) DispatchAfterCommit(tx TransactionScope, event Event) error {
+	if tx == nil {
+		return d.Dispatch(event)
+	}
+
+	tx.AfterCommit(func() {
+		if err := d.Dispatch(event); err != nil {
+			d.logger.Printf("❌ After-commit dispatch error for '%s': %v", event.Name(), err)
+		}
+	})
+	return nil
+}
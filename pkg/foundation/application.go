@@ -0,0 +1,192 @@
+// -----------------------------------------------------------------------------
+// Application & Service Providers
+// -----------------------------------------------------------------------------
+// Uygulamanın kurulumu (wiring) service provider'lara bölünür (Laravel
+// ServiceProvider karşılığı). Her provider iki aşamada çalışır:
+//
+//   - Register: Servisleri container'a kaydeder. Başka servis çözmemelidir;
+//     diğer provider'ların kayıtları henüz yapılmamış olabilir.
+//   - Boot: Tüm kayıtlar bittikten sonra çalışır; route'lar, event
+//     listener'ları, zamanlanmış görevler ve global ayarlar burada yapılır.
+//
+// Application, provider'ları verildiği sırayla önce Register, sonra Boot
+// eder; kapanışta Terminator implement eden provider'ları ters sırayla
+// kapatır:
+//
+//	app := foundation.New(c,
+//	    &providers.AppServiceProvider{},
+//	    &providers.CacheServiceProvider{},
+//	    &providers.RouteServiceProvider{},
+//	)
+//	if err := app.Boot(); err != nil {
+//	    log.Fatalf("❌ %v", err)
+//	}
+//	defer app.Terminate(context.Background())
+// -----------------------------------------------------------------------------
+
+package foundation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+// ServiceProvider, uygulamanın bir bölümünü (cache, auth, route'lar) kuran
+// birimdir.
+type ServiceProvider interface {
+	// Register, servisleri container'a kaydeder.
+	Register(c *container.Container) error
+
+	// Boot, tüm provider'lar kaydedildikten sonra çalışır.
+	Boot(c *container.Container) error
+}
+
+// Terminator, kapanışta kaynaklarını serbest bırakan provider'ların
+// (bağlantı havuzları, arka plan goroutine'leri) opsiyonel interface'idir.
+type Terminator interface {
+	Terminate(ctx context.Context, c *container.Container) error
+}
+
+// BaseProvider, Register veya Boot aşamasından birine ihtiyaç duymayan
+// provider'lara gömülür; iki aşama da hiçbir şey yapmaz.
+//
+// Örnek:
+//
+//	type RouteServiceProvider struct {
+//	    foundation.BaseProvider
+//	}
+//
+//	func (RouteServiceProvider) Boot(c *container.Container) error { ... }
+type BaseProvider struct{}
+
+// Register, hiçbir şey kaydetmez.
+func (BaseProvider) Register(c *container.Container) error { return nil }
+
+// Boot, hiçbir şey yapmaz.
+func (BaseProvider) Boot(c *container.Container) error { return nil }
+
+// Application, service provider'ların yaşam döngüsünü yönetir.
+type Application struct {
+	container *container.Container
+
+	mu        sync.Mutex
+	providers []ServiceProvider
+	booted    bool
+}
+
+// New, container ve provider'larla yeni bir Application oluşturur.
+// Provider'lar Boot çağrılana kadar çalıştırılmaz.
+func New(c *container.Container, providers ...ServiceProvider) *Application {
+	return &Application{
+		container: c,
+		providers: providers,
+	}
+}
+
+// Container, uygulamanın DI container'ını döndürür.
+func (a *Application) Container() *container.Container {
+	return a.container
+}
+
+// Providers, kayıtlı provider'ları kayıt sırasıyla döndürür.
+func (a *Application) Providers() []ServiceProvider {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]ServiceProvider(nil), a.providers...)
+}
+
+// Register, provider ekler. Uygulama boot edilmişse provider hemen
+// Register ve Boot edilir.
+//
+// Döndürür:
+//   - error: Uygulama boot edilmişse provider'ın Register/Boot hatası
+func (a *Application) Register(provider ServiceProvider) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.providers = append(a.providers, provider)
+	if !a.booted {
+		return nil
+	}
+
+	if err := a.register(provider); err != nil {
+		return err
+	}
+	return a.boot(provider)
+}
+
+// Boot, tüm provider'ları sırayla Register, ardından sırayla Boot eder.
+// İkinci çağrı hiçbir şey yapmaz. İlk hatada durur.
+//
+// Döndürür:
+//   - error: Provider tipini içeren, sarmalanmış Register/Boot hatası
+func (a *Application) Boot() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.booted {
+		return nil
+	}
+
+	for _, provider := range a.providers {
+		if err := a.register(provider); err != nil {
+			return err
+		}
+	}
+	for _, provider := range a.providers {
+		if err := a.boot(provider); err != nil {
+			return err
+		}
+	}
+
+	a.booted = true
+	return nil
+}
+
+// Booted, Boot'un tamamlanıp tamamlanmadığını döndürür.
+func (a *Application) Booted() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.booted
+}
+
+// Terminate, Terminator implement eden provider'ları ters sırayla kapatır.
+// Bir provider'ın hatası diğerlerinin kapanmasını engellemez; tüm hatalar
+// birleştirilerek döner.
+func (a *Application) Terminate(ctx context.Context) error {
+	providers := a.Providers()
+
+	var errs []error
+	for i := len(providers) - 1; i >= 0; i-- {
+		terminator, ok := providers[i].(Terminator)
+		if !ok {
+			continue
+		}
+		if err := terminator.Terminate(ctx, a.container); err != nil {
+			errs = append(errs, fmt.Errorf("%T kapatılamadı: %w", providers[i], err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// register, provider'ın Register aşamasını çalıştırır.
+func (a *Application) register(provider ServiceProvider) error {
+	if err := provider.Register(a.container); err != nil {
+		return fmt.Errorf("%T register edilemedi: %w", provider, err)
+	}
+	return nil
+}
+
+// boot, provider'ın Boot aşamasını çalıştırır.
+func (a *Application) boot(provider ServiceProvider) error {
+	if err := provider.Boot(a.container); err != nil {
+		return fmt.Errorf("%T boot edilemedi: %w", provider, err)
+	}
+	return nil
+}
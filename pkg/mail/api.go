@@ -0,0 +1,295 @@
+// -----------------------------------------------------------------------------
+// HTTP API Mail Drivers - Ortak Altyapı
+// -----------------------------------------------------------------------------
+// SES, Mailgun ve Postmark driver'ları email'i SMTP yerine sağlayıcının HTTP
+// API'si ile gönderir. Bu dosya driver'ların ortak kullandığı gönderim akışını
+// ve HTTP çağrısını içerir.
+//
+// Davranış:
+// - Çağrılar resilience policy'si ile yapılır (varsayılan: "mail")
+// - Ağ hataları ve 5xx cevapları retry edilir; diğer 4xx cevaplar kalıcıdır
+// - 429 cevabında Retry-After header'ına uyulur (en fazla 10s beklenir);
+//   denemeler tükenirse dönen hata ErrRateLimited ile eşleşir
+// - Mesajın ID'si (Message.GetID) sağlayıcıya metadata olarak gönderilir ve
+//   sağlayıcının döndürdüğü ID Message.GetProviderID ile okunur
+// -----------------------------------------------------------------------------
+
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/resilience"
+	"github.com/google/uuid"
+)
+
+// maxRateLimitWait, 429 cevabındaki Retry-After için beklenecek en uzun süre.
+// Daha uzun bekleme istenirse retry policy backoff'u ile devam edilir.
+const maxRateLimitWait = 10 * time.Second
+
+// ErrRateLimited, sağlayıcı 429 döndürdüğünde ve denemeler tükendiğinde
+// dönen hatayla eşleşir.
+//
+// Örnek:
+//
+//	if errors.Is(err, mail.ErrRateLimited) {
+//	    // daha sonra tekrar dene
+//	}
+var ErrRateLimited = errors.New("mail provider rate limit aşıldı")
+
+// APIError, sağlayıcının başarısız HTTP cevabıdır.
+type APIError struct {
+	Provider   string        // ses, mailgun, postmark
+	StatusCode int           // HTTP durum kodu
+	Message    string        // Sağlayıcının hata mesajı
+	RetryAfter time.Duration // 429 cevabındaki Retry-After (yoksa 0)
+}
+
+// Error, hatayı okunabilir biçimde döndürür.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s api: status %d: %s", e.Provider, e.StatusCode, e.Message)
+}
+
+// Is, 429 cevaplarını ErrRateLimited ile eşleştirir.
+func (e *APIError) Is(target error) bool {
+	return target == ErrRateLimited && e.StatusCode == http.StatusTooManyRequests
+}
+
+// apiRequest, sağlayıcıya gönderilecek HTTP isteğidir. Body her denemede
+// yeniden okunabilmesi için byte olarak tutulur.
+type apiRequest struct {
+	method string
+	url    string
+	body   []byte
+	header http.Header
+
+	// sign, isteği her denemede imzalar (SES: Signature V4). Opsiyonel.
+	sign func(req *http.Request, body []byte)
+}
+
+// apiClient, API driver'larının HTTP çağrılarını policy ile yapar.
+type apiClient struct {
+	provider string
+	policy   string
+	client   *http.Client
+
+	// errorMessage, başarısız cevabın gövdesinden hata mesajını çıkarır.
+	errorMessage func(body []byte) string
+}
+
+// newAPIClient, yeni bir apiClient oluşturur.
+func newAPIClient(provider, policy string, timeout time.Duration, errorMessage func([]byte) string) *apiClient {
+	if policy == "" {
+		policy = resilience.PolicyMail
+	}
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	return &apiClient{
+		provider:     provider,
+		policy:       policy,
+		client:       &http.Client{Timeout: timeout},
+		errorMessage: errorMessage,
+	}
+}
+
+// do, isteği gönderir ve 2xx cevabı out'a decode eder (out nil olabilir).
+func (c *apiClient) do(request apiRequest, out any) error {
+	var retryAfter time.Duration
+
+	return resilience.Get(c.policy).Execute(context.Background(), func(ctx context.Context) error {
+		// Önceki deneme 429 aldıysa sağlayıcının istediği kadar bekle
+		if retryAfter > 0 {
+			timer := time.NewTimer(retryAfter)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+			retryAfter = 0
+		}
+
+		req, err := http.NewRequestWithContext(ctx, request.method, request.url, bytes.NewReader(request.body))
+		if err != nil {
+			return resilience.Permanent(err)
+		}
+		for name, values := range request.header {
+			req.Header[name] = values
+		}
+		if request.sign != nil {
+			request.sign(req, request.body)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			if out == nil || len(data) == 0 {
+				return nil
+			}
+			if err := json.Unmarshal(data, out); err != nil {
+				return resilience.Permanent(fmt.Errorf("%s api cevabı decode edilemedi: %w", c.provider, err))
+			}
+			return nil
+		}
+
+		apiErr := &APIError{
+			Provider:   c.provider,
+			StatusCode: resp.StatusCode,
+			Message:    c.errorMessage(data),
+		}
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			apiErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+			retryAfter = min(apiErr.RetryAfter, maxRateLimitWait)
+			return apiErr
+		case resp.StatusCode >= 500:
+			return apiErr
+		default:
+			// 4xx: geçersiz alıcı, doğrulanmamış domain, hatalı token...
+			return resilience.Permanent(apiErr)
+		}
+	})
+}
+
+// parseRetryAfter, Retry-After header'ını (saniye veya HTTP tarihi) süreye
+// çevirir.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+// sendAPI, API driver'larının ortak gönderim akışıdır: varsayılan From,
+// doğrulama, mesaj ID'si, loglama. deliver sağlayıcının döndürdüğü ID'yi
+// döndürür.
+func (m *BaseMailer) sendAPI(message *Message, from Address, deliver func(*Message) (string, error)) error {
+	if message.GetFrom().Email == "" {
+		message.From(from.Email, from.Name)
+	}
+
+	if err := m.ValidateMessage(message); err != nil {
+		return fmt.Errorf("message validation failed: %w", err)
+	}
+
+	// Payload'dan gelen eski mesajlarda ID olmayabilir
+	if message.id == "" {
+		message.id = uuid.NewString()
+	}
+
+	m.LogSending(message)
+
+	providerID, err := deliver(message)
+	if err != nil {
+		m.LogError(message, err)
+		return err
+	}
+	message.providerID = providerID
+
+	m.LogSuccess(message)
+	m.logger.Printf("   Message ID: %s (provider: %s)", message.id, providerID)
+
+	return nil
+}
+
+// apiAttachment, API driver'larına gönderilecek ek dosyadır.
+type apiAttachment struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+// readAttachments, mesajın eklerini okur.
+func readAttachments(message *Message) ([]apiAttachment, error) {
+	attachments := make([]apiAttachment, 0, len(message.GetAttachments()))
+	for _, filePath := range message.GetAttachments() {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach file %s: %w", filePath, err)
+		}
+
+		contentType := mime.TypeByExtension(filepath.Ext(filePath))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		attachments = append(attachments, apiAttachment{
+			name:        filepath.Base(filePath),
+			contentType: contentType,
+			data:        data,
+		})
+	}
+	return attachments, nil
+}
+
+// addressList, adresleri "Name <email>" biçiminde döndürür.
+func addressList(addresses []Address) []string {
+	list := make([]string, len(addresses))
+	for i, address := range addresses {
+		list[i] = address.String()
+	}
+	return list
+}
+
+// messageHeaders, özel header'lara öncelik header'ını ekler.
+func messageHeaders(message *Message) map[string]string {
+	headers := make(map[string]string, len(message.GetHeaders())+1)
+	for key, value := range message.GetHeaders() {
+		headers[key] = value
+	}
+	if message.GetPriority() != PriorityNormal {
+		headers["X-Priority"] = strconv.Itoa(int(message.GetPriority()))
+	}
+	return headers
+}
+
+// jsonErrorMessage, JSON hata gövdesinden verilen alanlardan ilk dolu olanı
+// döndürür; JSON değilse gövdeyi olduğu gibi döndürür.
+func jsonErrorMessage(fields ...string) func([]byte) string {
+	return func(body []byte) string {
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return strings.TrimSpace(string(body))
+		}
+		for _, field := range fields {
+			if message, ok := payload[field].(string); ok && message != "" {
+				return message
+			}
+		}
+		return strings.TrimSpace(string(body))
+	}
+}
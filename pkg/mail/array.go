@@ -0,0 +1,157 @@
+// -----------------------------------------------------------------------------
+// Array Mailer (Testing için)
+// -----------------------------------------------------------------------------
+// Email'leri göndermek yerine bellekte tutan mailer (MAIL_DRIVER=array).
+// Testler gönderilen ve kuyruğa alınan mesajları okuyup doğrulayabilir;
+// SMTP'ye veya bir API'ye hiç bağlanılmaz.
+//
+// Assertion helper'ları için mailfake paketi kullanılır:
+//
+//	fake := mailfake.New()
+//	controller.Mailer = fake
+//	...
+//	fake.AssertSentTo(t, "user@example.com")
+// -----------------------------------------------------------------------------
+
+package mail
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DefaultCaptureLimit, LogMailer.Capture için önerilen mesaj sayısıdır.
+const DefaultCaptureLimit = 50
+
+// Mailbox, gönderdiği mesajları bellekte tutan mailer'dır (ArrayMailer ve
+// Capture açık LogMailer). Development mail önizlemesi mesajları buradan
+// okur.
+type Mailbox interface {
+	// Messages, tutulan mesajları gönderim sırasıyla döndürür.
+	Messages() []*Message
+
+	// Flush, tutulan mesajları siler.
+	Flush()
+}
+
+// QueuedMessage, ArrayMailer'ın kuyruğa aldığı mesajdır.
+type QueuedMessage struct {
+	Message *Message
+	Queue   string
+}
+
+// ArrayMailer, mesajları bellekte toplayan mailer'dır.
+//
+// Queue ve SendAsync mesajları kuyruğa göndermek yerine Queued() listesine
+// ekler; SetQueue gerekmez.
+type ArrayMailer struct {
+	*BaseMailer
+	from Address
+
+	mu     sync.Mutex
+	sent   []*Message
+	queued []QueuedMessage
+}
+
+// NewArrayMailer, yeni bir ArrayMailer oluşturur.
+//
+// Örnek:
+//
+//	mailer := mail.NewArrayMailer()
+//	mailer.Send(message)
+//	sent := mailer.Messages()
+func NewArrayMailer() *ArrayMailer {
+	mailer := &ArrayMailer{
+		BaseMailer: NewBaseMailer(discardLogger{}),
+	}
+	mailer.sender = mailer
+	return mailer
+}
+
+// SetFrom, mesajda gönderici yoksa kullanılacak adresi ayarlar.
+func (m *ArrayMailer) SetFrom(email, name string) *ArrayMailer {
+	m.from = Address{Email: email, Name: name}
+	return m
+}
+
+// Send, mesajı doğrular ve gönderilenler listesine ekler.
+//
+// Geçersiz mesajlar (örn: template render hatası) diğer driver'lardaki gibi
+// hata döndürür ve listeye eklenmez.
+func (m *ArrayMailer) Send(message *Message) error {
+	if message.GetFrom().Email == "" {
+		message.From(m.from.Email, m.from.Name)
+	}
+
+	if err := m.ValidateMessage(message); err != nil {
+		return fmt.Errorf("message validation failed: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = append(m.sent, message)
+	return nil
+}
+
+// SendAsync, mesajı varsayılan kuyruğa alınmış olarak kaydeder.
+func (m *ArrayMailer) SendAsync(message *Message) error {
+	return m.Queue(message, "")
+}
+
+// Queue, mesajı kuyruğa alınanlar listesine ekler. Kuyruk adı boşsa
+// "emails" kullanılır.
+func (m *ArrayMailer) Queue(message *Message, queueName string) error {
+	if message.err != nil {
+		return fmt.Errorf("message validation failed: %w", message.err)
+	}
+	if len(message.to) == 0 {
+		return fmt.Errorf("message validation failed: at least one recipient is required")
+	}
+	if queueName == "" {
+		queueName = DefaultMailQueue
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.queued = append(m.queued, QueuedMessage{Message: message, Queue: queueName})
+	return nil
+}
+
+// Messages, gönderilen mesajları gönderim sırasıyla döndürür.
+func (m *ArrayMailer) Messages() []*Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*Message(nil), m.sent...)
+}
+
+// Queued, kuyruğa alınan mesajları döndürür.
+func (m *ArrayMailer) Queued() []QueuedMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]QueuedMessage(nil), m.queued...)
+}
+
+// Flush, toplanan tüm mesajları siler.
+func (m *ArrayMailer) Flush() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = nil
+	m.queued = nil
+}
+
+// ArrayMailer ve LogMailer'ın Mailbox olduğunu derleme zamanında doğrula
+var (
+	_ Mailbox = (*ArrayMailer)(nil)
+	_ Mailbox = (*LogMailer)(nil)
+)
+
+// discardLogger, çıktı üretmeyen Logger'dır.
+type discardLogger struct{}
+
+func (discardLogger) Printf(format string, v ...interface{}) {}
+func (discardLogger) Println(v ...interface{})               {}
@@ -0,0 +1,86 @@
+// -----------------------------------------------------------------------------
+// Mailables
+// -----------------------------------------------------------------------------
+// Mailable, bir email'in verisini ve içeriğini tek bir tipte toplar (Laravel
+// Mailable karşılığı). Controller ve job'lar mesajı satır içinde kurmak
+// yerine mailable'ı doldurur; şablon adı, konu ve şablon verisi mailable'da
+// kalır.
+//
+// Uygulama mailable'ları internal/mails altında tutulur ve
+// `conduit make:mail WelcomeMail` ile oluşturulur.
+//
+// Kullanım:
+//
+//	type WelcomeMail struct {
+//	    User *models.User
+//	}
+//
+//	func (m *WelcomeMail) Build(message *mail.Message) error {
+//	    message.
+//	        To(m.User.Email, m.User.Name).
+//	        Template("emails/welcome", map[string]any{"Name": m.User.Name})
+//	    return nil
+//	}
+//
+//	err := mail.Send(mailer, &mails.WelcomeMail{User: user})
+//	err := mail.Queue(mailer, &mails.WelcomeMail{User: user}, "")
+// -----------------------------------------------------------------------------
+
+package mail
+
+import "fmt"
+
+// Mailable, kendi mesajını oluşturan email tipidir.
+type Mailable interface {
+	// Build, mesajı doldurur (alıcılar, konu, şablon...).
+	//
+	// Parametre:
+	//   - message: NewMessage ile oluşturulmuş boş mesaj
+	//
+	// Döndürür:
+	//   - error: Mesaj oluşturulamazsa hata (Send/Queue bu hatayı döndürür)
+	Build(message *Message) error
+}
+
+// Make, mailable'dan yeni bir mesaj oluşturur.
+//
+// Build hatası Template hatası gibi mesajda saklanır ve Send/Queue
+// çağrıldığında döner; böylece Make zincir içinde kullanılabilir.
+//
+// Örnek:
+//
+//	message := mail.Make(&mails.WelcomeMail{User: user}).Cc(admin.Email, "")
+//	err := mailer.Send(message)
+func Make(mailable Mailable) *Message {
+	message := NewMessage()
+	if err := mailable.Build(message); err != nil && message.err == nil {
+		message.err = fmt.Errorf("mailable %T: %w", mailable, err)
+	}
+	return message
+}
+
+// Send, mailable'ı oluşturup mailer ile hemen gönderir.
+//
+// Parametreler:
+//   - mailer: Kullanılacak mailer
+//   - mailable: Gönderilecek email
+//
+// Döndürür:
+//   - error: Build veya gönderim hatası
+func Send(mailer Mailer, mailable Mailable) error {
+	return mailer.Send(Make(mailable))
+}
+
+// Queue, mailable'ı oluşturup kuyruğa ekler. Mesaj render edilmiş haliyle
+// serialize edilir; worker'da mailable'a ihtiyaç yoktur.
+//
+// Parametreler:
+//   - mailer: Kullanılacak mailer (SetQueue ile ayarlanmış)
+//   - mailable: Gönderilecek email
+//   - queueName: Kuyruk adı (boş: mailer'ın varsayılan kuyruğu)
+//
+// Döndürür:
+//   - error: Build veya kuyruğa ekleme hatası
+func Queue(mailer Mailer, mailable Mailable, queueName string) error {
+	return mailer.Queue(Make(mailable), queueName)
+}
@@ -9,7 +9,7 @@
 // - Fluent message builder
 // - Template support
 // - Queue integration
-// - Multiple driver support (SMTP, Mailhog, Log, vb.)
+// - Multiple driver support (SMTP, SES, Mailgun, Postmark, Log)
 //
 // Kullanım:
 //
@@ -26,6 +26,13 @@ package mail
 
 import (
 	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/queue"
 )
 
 // Mailer, email gönderim interface'i.
@@ -51,6 +58,17 @@ type Mailer interface {
 	// Döndürür:
 	//   - error: Queue'ya ekleme başarısızsa hata
 	SendAsync(message *Message) error
+
+	// Queue, email'i SendMessageJob olarak belirtilen kuyruğa ekler.
+	// BaseMailer embed eden driver'lar bunu otomatik olarak sağlar.
+	//
+	// Parametreler:
+	//   - message: Gönderilecek mesaj
+	//   - queueName: Kuyruk adı (boş: varsayılan "emails")
+	//
+	// Döndürür:
+	//   - error: Queue ayarlanmamışsa veya push başarısızsa hata
+	Queue(message *Message, queueName string) error
 }
 
 // Logger interface - dependency injection için
@@ -64,6 +82,10 @@ type Logger interface {
 // Bu yapı ortak fonksiyonları sağlar, her driver bu yapıyı embed eder.
 type BaseMailer struct {
 	logger Logger
+
+	sender    Mailer      // BaseMailer'ı embed eden driver (queue job'u bununla gönderir)
+	queue     queue.Queue // Queue/SendAsync için (bkz: queue.go)
+	queueName string
 }
 
 // NewBaseMailer, yeni bir BaseMailer oluşturur.
@@ -101,8 +123,12 @@ func (m *BaseMailer) LogError(message *Message, err error) {
 
 // LogMailer, email'leri göndermek yerine loglara yazan mailer.
 //
-// Development ve test ortamında kullanışlıdır.
-// Gerçek email gönderilmez, sadece log'a yazılır.
+// Development ortamında kullanışlıdır (MAIL_DRIVER=log). Gerçek email
+// gönderilmez; mesajın tüm header'ları, gövdeleri ve ekleri log'a yazılır.
+// Testlerde mesajları yakalamak için ArrayMailer veya mailfake kullanılır.
+//
+// Capture açıldığında son gönderilen mesajlar bellekte de tutulur; böylece
+// development mail önizlemesi (/dev/mail) log driver ile de çalışır.
 //
 // Kullanım:
 //
@@ -110,6 +136,11 @@ func (m *BaseMailer) LogError(message *Message, err error) {
 //	err := mailer.Send(message)
 type LogMailer struct {
 	*BaseMailer
+	from Address // Mesajda From yoksa kullanılır
+
+	mu       sync.Mutex
+	capture  int        // Tutulacak mesaj sayısı (0 = kapalı)
+	captured []*Message // Son gönderilen mesajlar (eskiden yeniye)
 }
 
 // NewLogMailer, yeni bir LogMailer oluşturur.
@@ -124,62 +155,151 @@ type LogMailer struct {
 //
 //	mailer := mail.NewLogMailer(log.Default())
 func NewLogMailer(logger Logger) *LogMailer {
-	return &LogMailer{
+	mailer := &LogMailer{
 		BaseMailer: NewBaseMailer(logger),
 	}
+	mailer.sender = mailer
+	return mailer
+}
+
+// SetFrom, mesajda gönderici yoksa kullanılacak adresi ayarlar
+// (diğer driver'lardaki config.From karşılığı).
+func (m *LogMailer) SetFrom(email, name string) *LogMailer {
+	m.from = Address{Email: email, Name: name}
+	return m
 }
 
 // Send, email'i loglara yazar (gerçek gönderim yapmaz).
 func (m *LogMailer) Send(message *Message) error {
+	if message.GetFrom().Email == "" {
+		message.From(m.from.Email, m.from.Name)
+	}
+
 	// Validate
 	if err := m.ValidateMessage(message); err != nil {
 		return fmt.Errorf("message validation failed: %w", err)
 	}
 
-	// Log email details
-	m.logger.Println("\n" + "=".repeat(70))
-	m.logger.Println("📧 EMAIL (LOG DRIVER - NOT ACTUALLY SENT)")
-	m.logger.Println("=".repeat(70))
-	m.logger.Printf("From: %s", message.GetFrom().String())
+	m.logger.Println("\n" + strings.Repeat("=", 70) + "\n" + renderForLog(message) + strings.Repeat("=", 70) + "\n")
 
-	for _, to := range message.GetTo() {
-		m.logger.Printf("To: %s", to.String())
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.capture > 0 {
+		m.captured = append(m.captured, message)
+		if len(m.captured) > m.capture {
+			m.captured = append([]*Message(nil), m.captured[len(m.captured)-m.capture:]...)
+		}
 	}
 
+	return nil
+}
+
+// Capture, gönderilen son limit kadar mesajın bellekte tutulmasını sağlar
+// (limit <= 0 ise kapatır ve tutulanları siler).
+//
+// Örnek:
+//
+//	mailer := mail.NewLogMailer(logger).Capture(mail.DefaultCaptureLimit)
+//	messages := mailer.Messages()
+func (m *LogMailer) Capture(limit int) *LogMailer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if limit < 0 {
+		limit = 0
+	}
+	m.capture = limit
+	if len(m.captured) > limit {
+		m.captured = append([]*Message(nil), m.captured[len(m.captured)-limit:]...)
+	}
+	return m
+}
+
+// Messages, Capture ile tutulan mesajları gönderim sırasıyla döndürür.
+func (m *LogMailer) Messages() []*Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*Message(nil), m.captured...)
+}
+
+// Flush, tutulan mesajları siler.
+func (m *LogMailer) Flush() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.captured = nil
+}
+
+// SendAsync, queue ayarlıysa kuyruğa ekler, değilse Send() ile aynıdır.
+func (m *LogMailer) SendAsync(message *Message) error {
+	return m.sendAsync(message)
+}
+
+// renderForLog, mesajı header'ları, gövdeleri ve ekleriyle okunabilir
+// biçimde döndürür.
+func renderForLog(message *Message) string {
+	var b strings.Builder
+
+	line := func(format string, args ...any) {
+		fmt.Fprintf(&b, format+"\n", args...)
+	}
+
+	line("📧 EMAIL (LOG DRIVER - NOT ACTUALLY SENT)")
+	line("%s", strings.Repeat("-", 70))
+	line("Message-ID: %s", message.GetID())
+	line("Date: %s", message.GetDate().Format(time.RFC1123Z))
+	line("From: %s", message.GetFrom().String())
+	if len(message.GetTo()) > 0 {
+		line("To: %s", strings.Join(addressList(message.GetTo()), ", "))
+	}
 	if len(message.GetCc()) > 0 {
-		for _, cc := range message.GetCc() {
-			m.logger.Printf("Cc: %s", cc.String())
-		}
+		line("Cc: %s", strings.Join(addressList(message.GetCc()), ", "))
 	}
+	if len(message.GetBcc()) > 0 {
+		line("Bcc: %s", strings.Join(addressList(message.GetBcc()), ", "))
+	}
+	if message.GetReplyTo() != nil {
+		line("Reply-To: %s", message.GetReplyTo().String())
+	}
+	line("Subject: %s", message.GetSubject())
 
-	m.logger.Printf("Subject: %s", message.GetSubject())
-	m.logger.Println("---")
+	headers := messageHeaders(message)
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		line("%s: %s", key, headers[key])
+	}
 
 	if message.GetBody() != "" {
-		m.logger.Println("Body (Plain Text):")
-		m.logger.Println(message.GetBody())
+		line("%s", strings.Repeat("-", 70))
+		line("Body (Plain Text):")
+		line("%s", message.GetBody())
 	}
 
 	if message.GetHtmlBody() != "" {
-		m.logger.Println("Body (HTML):")
-		m.logger.Println(message.GetHtmlBody())
+		line("%s", strings.Repeat("-", 70))
+		line("Body (HTML):")
+		line("%s", message.GetHtmlBody())
 	}
 
 	if len(message.GetAttachments()) > 0 {
-		m.logger.Println("Attachments:")
-		for _, att := range message.GetAttachments() {
-			m.logger.Printf("  - %s", att)
+		line("%s", strings.Repeat("-", 70))
+		line("Attachments:")
+		for _, attachment := range message.GetAttachments() {
+			if info, err := os.Stat(attachment); err == nil {
+				line("  - %s (%d bytes)", attachment, info.Size())
+			} else {
+				line("  - %s (okunamadı: %v)", attachment, err)
+			}
 		}
 	}
 
-	m.logger.Println("=".repeat(70) + "\n")
-
-	return nil
-}
-
-// SendAsync, log driver için Send() ile aynıdır.
-func (m *LogMailer) SendAsync(message *Message) error {
-	return m.Send(message)
+	return b.String()
 }
 
 // -----------------------------------------------------------------------------
@@ -0,0 +1,223 @@
+// -----------------------------------------------------------------------------
+// Mail Fake - Test Assertions
+// -----------------------------------------------------------------------------
+// Bu package, testlerde gerçek mailer yerine kullanılan sahte bir mailer ve
+// gönderim assertion'ları sağlar (Laravel Mail::fake karşılığı). Mesajlar
+// mail.ArrayMailer ile bellekte toplanır; SMTP'ye hiç bağlanılmaz.
+//
+// Kullanım:
+//
+//	func TestForgotPassword(t *testing.T) {
+//	    fake := mailfake.New()
+//	    controller.Mailer = fake
+//
+//	    // ... isteği çalıştır
+//
+//	    fake.AssertSentTo(t, "user@example.com")
+//	    fake.AssertSent(t, func(m *mail.Message) bool {
+//	        return strings.Contains(m.GetHtmlBody(), "/reset-password?token=")
+//	    })
+//	    fake.AssertNothingQueued(t)
+//	}
+// -----------------------------------------------------------------------------
+
+package mailfake
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/mail"
+)
+
+// Fake, gönderilen ve kuyruğa alınan mesajları toplayan mail.Mailer'dır.
+type Fake struct {
+	*mail.ArrayMailer
+}
+
+// New, yeni bir Fake oluşturur.
+func New() *Fake {
+	return &Fake{ArrayMailer: mail.NewArrayMailer()}
+}
+
+// --- Gönderilen mesajlar ---
+
+// Sent, filter'a uyan gönderilmiş mesajları döndürür (filter nil ise tümü).
+func (f *Fake) Sent(filter func(*mail.Message) bool) []*mail.Message {
+	return matching(f.Messages(), filter)
+}
+
+// AssertSent, filter'a uyan en az bir mesaj gönderildiğini doğrular.
+func (f *Fake) AssertSent(t testing.TB, filter func(*mail.Message) bool) {
+	t.Helper()
+	if len(f.Sent(filter)) == 0 {
+		t.Errorf("beklenen email gönderilmedi; gönderilenler:\n%s", describe(f.Messages()))
+	}
+}
+
+// AssertNotSent, filter'a uyan hiçbir mesajın gönderilmediğini doğrular.
+func (f *Fake) AssertNotSent(t testing.TB, filter func(*mail.Message) bool) {
+	t.Helper()
+	if sent := f.Sent(filter); len(sent) > 0 {
+		t.Errorf("gönderilmemesi gereken %d email gönderildi:\n%s", len(sent), describe(sent))
+	}
+}
+
+// AssertSentTo, adrese (To, Cc veya Bcc) en az bir mesaj gönderildiğini
+// doğrular.
+func (f *Fake) AssertSentTo(t testing.TB, email string) {
+	t.Helper()
+	if len(f.Sent(To(email))) == 0 {
+		t.Errorf("%s adresine email gönderilmedi; gönderilenler:\n%s", email, describe(f.Messages()))
+	}
+}
+
+// AssertNotSentTo, adrese hiç mesaj gönderilmediğini doğrular.
+func (f *Fake) AssertNotSentTo(t testing.TB, email string) {
+	t.Helper()
+	if sent := f.Sent(To(email)); len(sent) > 0 {
+		t.Errorf("%s adresine %d email gönderildi:\n%s", email, len(sent), describe(sent))
+	}
+}
+
+// AssertSentCount, gönderilen mesaj sayısını doğrular.
+func (f *Fake) AssertSentCount(t testing.TB, count int) {
+	t.Helper()
+	if sent := f.Messages(); len(sent) != count {
+		t.Errorf("%d email gönderilmesi bekleniyordu, %d gönderildi:\n%s", count, len(sent), describe(sent))
+	}
+}
+
+// AssertNothingSent, hiç mesaj gönderilmediğini doğrular.
+func (f *Fake) AssertNothingSent(t testing.TB) {
+	t.Helper()
+	f.AssertSentCount(t, 0)
+}
+
+// --- Kuyruğa alınan mesajlar ---
+
+// QueuedMessages, filter'a uyan kuyruğa alınmış mesajları döndürür.
+func (f *Fake) QueuedMessages(filter func(*mail.Message) bool) []*mail.Message {
+	queued := f.Queued()
+	messages := make([]*mail.Message, len(queued))
+	for i, q := range queued {
+		messages[i] = q.Message
+	}
+	return matching(messages, filter)
+}
+
+// AssertQueued, filter'a uyan en az bir mesajın kuyruğa alındığını doğrular.
+func (f *Fake) AssertQueued(t testing.TB, filter func(*mail.Message) bool) {
+	t.Helper()
+	if len(f.QueuedMessages(filter)) == 0 {
+		t.Errorf("beklenen email kuyruğa alınmadı; kuyruktakiler:\n%s", describe(f.QueuedMessages(nil)))
+	}
+}
+
+// AssertQueuedTo, adrese en az bir mesajın kuyruğa alındığını doğrular.
+func (f *Fake) AssertQueuedTo(t testing.TB, email string) {
+	t.Helper()
+	if len(f.QueuedMessages(To(email))) == 0 {
+		t.Errorf("%s adresine email kuyruğa alınmadı; kuyruktakiler:\n%s", email, describe(f.QueuedMessages(nil)))
+	}
+}
+
+// AssertQueuedOn, queueName kuyruğuna en az bir mesaj alındığını doğrular.
+func (f *Fake) AssertQueuedOn(t testing.TB, queueName string) {
+	t.Helper()
+	for _, q := range f.Queued() {
+		if q.Queue == queueName {
+			return
+		}
+	}
+	t.Errorf("%s kuyruğuna email alınmadı", queueName)
+}
+
+// AssertNothingQueued, hiç mesaj kuyruğa alınmadığını doğrular.
+func (f *Fake) AssertNothingQueued(t testing.TB) {
+	t.Helper()
+	if queued := f.QueuedMessages(nil); len(queued) > 0 {
+		t.Errorf("%d email kuyruğa alındı:\n%s", len(queued), describe(queued))
+	}
+}
+
+// --- Bekleme ---
+
+// WaitForSent, count kadar mesaj gönderilene kadar bekler. Controller'lar
+// email'i goroutine'de gönderdiğinde assertion'dan önce kullanılır.
+//
+// Döndürür:
+//   - bool: Süre dolmadan count'a ulaşıldıysa true
+func (f *Fake) WaitForSent(count int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if len(f.Messages()) >= count {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// --- Filtreler ---
+
+// To, mesajın alıcıları (To, Cc, Bcc) arasında email olup olmadığını
+// kontrol eden filtreyi döndürür (büyük/küçük harf duyarsız).
+func To(email string) func(*mail.Message) bool {
+	return func(m *mail.Message) bool {
+		for _, group := range [][]mail.Address{m.GetTo(), m.GetCc(), m.GetBcc()} {
+			for _, address := range group {
+				if strings.EqualFold(address.Email, email) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// Subject, konusu subject olan mesajları seçen filtreyi döndürür.
+func Subject(subject string) func(*mail.Message) bool {
+	return func(m *mail.Message) bool {
+		return m.GetSubject() == subject
+	}
+}
+
+// matching, filter'a uyan mesajları döndürür.
+func matching(messages []*mail.Message, filter func(*mail.Message) bool) []*mail.Message {
+	if filter == nil {
+		return messages
+	}
+
+	result := make([]*mail.Message, 0, len(messages))
+	for _, message := range messages {
+		if filter(message) {
+			result = append(result, message)
+		}
+	}
+	return result
+}
+
+// describe, mesajları hata çıktısı için listeler.
+func describe(messages []*mail.Message) string {
+	if len(messages) == 0 {
+		return "  (yok)"
+	}
+
+	var b strings.Builder
+	for _, message := range messages {
+		to := make([]string, len(message.GetTo()))
+		for i, address := range message.GetTo() {
+			to[i] = address.Email
+		}
+		fmt.Fprintf(&b, "  - to: %s, subject: %q\n", strings.Join(to, ", "), message.GetSubject())
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// Fake'in mail.Mailer olduğunu derleme zamanında doğrula
+var _ mail.Mailer = (*Fake)(nil)
@@ -0,0 +1,153 @@
+// -----------------------------------------------------------------------------
+// Mailgun Mail Driver
+// -----------------------------------------------------------------------------
+// Email'i Mailgun Messages API'si (multipart form) ile gönderir.
+//
+// Mesaj ID'si "v:message_id" custom variable'ı olarak gönderilir; Mailgun
+// webhook'ları bunu "user-variables" alanında döndürür.
+//
+// Kullanım:
+//
+//	mailer := mail.NewMailgunMailer(&mail.MailgunConfig{
+//	    Domain:   "mg.example.com",
+//	    APIKey:   os.Getenv("MAILGUN_SECRET"),
+//	    Endpoint: "https://api.eu.mailgun.net", // EU bölgesi
+//	    From:     mail.Address{Email: "noreply@example.com", Name: "Conduit"},
+//	}, logger)
+// -----------------------------------------------------------------------------
+
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// MailgunConfig, Mailgun driver ayarlarıdır.
+type MailgunConfig struct {
+	Domain   string        // Gönderim domain'i (örn: mg.example.com)
+	APIKey   string        // Private API key
+	Endpoint string        // API endpoint (boş = https://api.mailgun.net; EU: https://api.eu.mailgun.net)
+	From     Address       // Varsayılan gönderici
+	Timeout  time.Duration // HTTP timeout (varsayılan: 30s)
+	Policy   string        // Resilience policy adı (varsayılan: "mail")
+}
+
+// MailgunMailer, Mailgun API driver'ıdır.
+type MailgunMailer struct {
+	*BaseMailer
+	config *MailgunConfig
+	api    *apiClient
+}
+
+// NewMailgunMailer, yeni bir Mailgun mailer oluşturur.
+//
+// Parametreler:
+//   - config: Mailgun ayarları
+//   - logger: Logger instance
+//
+// Döndürür:
+//   - *MailgunMailer: Yeni Mailgun mailer
+func NewMailgunMailer(config *MailgunConfig, logger Logger) *MailgunMailer {
+	if config.Endpoint == "" {
+		config.Endpoint = "https://api.mailgun.net"
+	}
+
+	mailer := &MailgunMailer{
+		BaseMailer: NewBaseMailer(logger),
+		config:     config,
+		api:        newAPIClient("mailgun", config.Policy, config.Timeout, jsonErrorMessage("message")),
+	}
+	mailer.sender = mailer
+	return mailer
+}
+
+// Send, email'i Mailgun API'si ile gönderir.
+func (m *MailgunMailer) Send(message *Message) error {
+	return m.sendAPI(message, m.config.From, m.deliver)
+}
+
+// SendAsync, queue ayarlıysa (SetQueue) mesajı kuyruğa ekler, yoksa
+// senkron gönderir.
+func (m *MailgunMailer) SendAsync(message *Message) error {
+	return m.sendAsync(message)
+}
+
+// deliver, mesajı multipart form olarak gönderir ve Mailgun id'sini
+// döndürür.
+func (m *MailgunMailer) deliver(message *Message) (string, error) {
+	attachments, err := readAttachments(message)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+
+	field := func(name, value string) {
+		if value != "" {
+			form.WriteField(name, value)
+		}
+	}
+
+	field("from", message.GetFrom().String())
+	for _, to := range addressList(message.GetTo()) {
+		field("to", to)
+	}
+	for _, cc := range addressList(message.GetCc()) {
+		field("cc", cc)
+	}
+	for _, bcc := range addressList(message.GetBcc()) {
+		field("bcc", bcc)
+	}
+	field("subject", message.GetSubject())
+	field("text", message.GetBody())
+	field("html", message.GetHtmlBody())
+	if message.GetReplyTo() != nil {
+		field("h:Reply-To", message.GetReplyTo().String())
+	}
+	for key, value := range messageHeaders(message) {
+		field("h:"+key, value)
+	}
+	field("v:message_id", message.GetID())
+
+	for _, attachment := range attachments {
+		part, err := form.CreatePart(textproto.MIMEHeader{
+			"Content-Disposition": {fmt.Sprintf(`form-data; name="attachment"; filename="%s"`, attachment.name)},
+			"Content-Type":        {attachment.contentType},
+		})
+		if err != nil {
+			return "", err
+		}
+		part.Write(attachment.data)
+	}
+
+	if err := form.Close(); err != nil {
+		return "", err
+	}
+
+	header := http.Header{"Content-Type": {form.FormDataContentType()}}
+	request := apiRequest{
+		method: http.MethodPost,
+		url:    strings.TrimRight(m.config.Endpoint, "/") + "/v3/" + m.config.Domain + "/messages",
+		body:   buf.Bytes(),
+		header: header,
+		sign: func(req *http.Request, _ []byte) {
+			req.SetBasicAuth("api", m.config.APIKey)
+		},
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := m.api.do(request, &response); err != nil {
+		return "", fmt.Errorf("mailgun send failed: %w", err)
+	}
+
+	return response.ID, nil
+}
@@ -18,12 +18,14 @@ package mail
 import (
 	"fmt"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // Address, email adresi ve opsiyonel isim içeren yapıdır.
 type Address struct {
-	Email string // Email adresi (zorunlu)
-	Name  string // İsim (opsiyonel)
+	Email string `json:"email"`          // Email adresi (zorunlu)
+	Name  string `json:"name,omitempty"` // İsim (opsiyonel)
 }
 
 // String, Address'i "Name <email@example.com>" formatında döndürür.
@@ -55,6 +57,10 @@ type Message struct {
 	headers     map[string]string
 	priority    Priority
 	date        time.Time
+	err         error // Template render hatası, Validate'te döner
+
+	id         string // Uygulama tarafı ID (API driver'larında webhook eşleştirmesi için)
+	providerID string // API driver'ının döndürdüğü ID (SES MessageId, Mailgun id, Postmark MessageID)
 }
 
 // Priority, email öncelik seviyesi.
@@ -79,6 +85,7 @@ func NewMessage() *Message {
 		headers:  make(map[string]string),
 		priority: PriorityNormal,
 		date:     time.Now(),
+		id:       uuid.NewString(),
 	}
 }
 
@@ -187,6 +194,47 @@ func (m *Message) Html(html string) *Message {
 	return m
 }
 
+// Template, HTML ve plain text gövdeyi SetTemplates ile ayarlanan
+// şablonlardan render eder. View "subject" bloğu tanımlıyorsa ve konu henüz
+// ayarlanmadıysa konu da şablondan alınır.
+//
+// Parametreler:
+//   - name: Uzantısız view adı (örn: "emails/password-reset")
+//   - data: Şablona geçirilecek veri (map veya struct)
+//
+// Döndürür:
+//   - *Message: Zincirleme için kendi instance'ını döner
+//
+// Render hatası zinciri bozmaz; hata Validate'te (dolayısıyla Send'de)
+// döner.
+//
+// Örnek:
+//
+//	msg.Template("emails/password-reset", map[string]any{
+//	    "Name": user.Name,
+//	    "Link": link,
+//	})
+func (m *Message) Template(name string, data any) *Message {
+	templates := GetTemplates()
+	if templates == nil {
+		m.err = fmt.Errorf("mail template %q: %w", name, ErrTemplatesNotConfigured)
+		return m
+	}
+
+	rendered, err := templates.Render(name, data)
+	if err != nil {
+		m.err = fmt.Errorf("mail template %q: %w", name, err)
+		return m
+	}
+
+	m.htmlBody = rendered.HTML
+	m.body = rendered.Text
+	if m.subject == "" {
+		m.subject = rendered.Subject
+	}
+	return m
+}
+
 // Attach, dosya ekler.
 //
 // Parametre:
@@ -238,17 +286,38 @@ func (m *Message) Header(key, value string) *Message {
 	return m
 }
 
+// ID, mesajın uygulama tarafı ID'sini ayarlar. NewMessage rastgele bir
+// UUID atar; gönderimden önce veritabanına kaydedilen ID'yle eşleştirmek
+// için değiştirilebilir.
+//
+// API driver'ları (ses, mailgun, postmark) bu ID'yi sağlayıcıya metadata
+// olarak iletir; delivery/bounce webhook'larında "message_id" olarak geri
+// gelir.
+//
+// Örnek:
+//
+//	msg.ID(notification.ID)
+func (m *Message) ID(id string) *Message {
+	m.id = id
+	return m
+}
+
 // Validate, message'ın geçerli olup olmadığını kontrol eder.
 //
 // Döndürür:
 //   - error: Geçersizse hata, geçerliyse nil
 //
 // Kontroller:
+// - Template render hatası olmamalı
 // - From adresi dolu olmalı
 // - En az bir To adresi olmalı
 // - Subject dolu olmalı
 // - Body veya HtmlBody dolu olmalı
 func (m *Message) Validate() error {
+	if m.err != nil {
+		return m.err
+	}
+
 	if m.from.Email == "" {
 		return fmt.Errorf("sender address is required")
 	}
@@ -327,3 +396,14 @@ func (m *Message) GetPriority() Priority {
 func (m *Message) GetDate() time.Time {
 	return m.date
 }
+
+// GetID, mesajın uygulama tarafı ID'sini döndürür.
+func (m *Message) GetID() string {
+	return m.id
+}
+
+// GetProviderID, API driver'ının gönderim sonrası döndürdüğü ID'yi döndürür
+// (SMTP ve log driver'larında boştur).
+func (m *Message) GetProviderID() string {
+	return m.providerID
+}
@@ -0,0 +1,163 @@
+// -----------------------------------------------------------------------------
+// Postmark Mail Driver
+// -----------------------------------------------------------------------------
+// Email'i Postmark Email API'si (JSON) ile gönderir.
+//
+// Mesaj ID'si "message_id" metadata'sı olarak gönderilir; Postmark
+// webhook'ları (Delivery, Bounce, Open...) bunu "Metadata" alanında döndürür.
+//
+// Kullanım:
+//
+//	mailer := mail.NewPostmarkMailer(&mail.PostmarkConfig{
+//	    Token:         os.Getenv("POSTMARK_TOKEN"),
+//	    MessageStream: "outbound",
+//	    From:          mail.Address{Email: "noreply@example.com", Name: "Conduit"},
+//	}, logger)
+// -----------------------------------------------------------------------------
+
+package mail
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// PostmarkConfig, Postmark driver ayarlarıdır.
+type PostmarkConfig struct {
+	Token         string        // Server API token
+	MessageStream string        // Message stream ID (boş = sunucunun varsayılanı "outbound")
+	Endpoint      string        // API endpoint (boş = https://api.postmarkapp.com)
+	From          Address       // Varsayılan gönderici
+	Timeout       time.Duration // HTTP timeout (varsayılan: 30s)
+	Policy        string        // Resilience policy adı (varsayılan: "mail")
+}
+
+// PostmarkMailer, Postmark API driver'ıdır.
+type PostmarkMailer struct {
+	*BaseMailer
+	config *PostmarkConfig
+	api    *apiClient
+}
+
+// NewPostmarkMailer, yeni bir Postmark mailer oluşturur.
+//
+// Parametreler:
+//   - config: Postmark ayarları
+//   - logger: Logger instance
+//
+// Döndürür:
+//   - *PostmarkMailer: Yeni Postmark mailer
+func NewPostmarkMailer(config *PostmarkConfig, logger Logger) *PostmarkMailer {
+	if config.Endpoint == "" {
+		config.Endpoint = "https://api.postmarkapp.com"
+	}
+
+	mailer := &PostmarkMailer{
+		BaseMailer: NewBaseMailer(logger),
+		config:     config,
+		api:        newAPIClient("postmark", config.Policy, config.Timeout, jsonErrorMessage("Message")),
+	}
+	mailer.sender = mailer
+	return mailer
+}
+
+// Send, email'i Postmark API'si ile gönderir.
+func (m *PostmarkMailer) Send(message *Message) error {
+	return m.sendAPI(message, m.config.From, m.deliver)
+}
+
+// SendAsync, queue ayarlıysa (SetQueue) mesajı kuyruğa ekler, yoksa
+// senkron gönderir.
+func (m *PostmarkMailer) SendAsync(message *Message) error {
+	return m.sendAsync(message)
+}
+
+// postmarkHeader, Postmark özel header'ıdır.
+type postmarkHeader struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// postmarkAttachment, Postmark ek dosyasıdır (Content base64).
+type postmarkAttachment struct {
+	Name        string `json:"Name"`
+	Content     []byte `json:"Content"`
+	ContentType string `json:"ContentType"`
+}
+
+// postmarkEmail, /email isteğinin gövdesidir.
+type postmarkEmail struct {
+	From          string               `json:"From"`
+	To            string               `json:"To"`
+	Cc            string               `json:"Cc,omitempty"`
+	Bcc           string               `json:"Bcc,omitempty"`
+	ReplyTo       string               `json:"ReplyTo,omitempty"`
+	Subject       string               `json:"Subject"`
+	TextBody      string               `json:"TextBody,omitempty"`
+	HtmlBody      string               `json:"HtmlBody,omitempty"`
+	Headers       []postmarkHeader     `json:"Headers,omitempty"`
+	Attachments   []postmarkAttachment `json:"Attachments,omitempty"`
+	Metadata      map[string]string    `json:"Metadata"`
+	MessageStream string               `json:"MessageStream,omitempty"`
+}
+
+// deliver, mesajı /email isteği olarak gönderir ve Postmark MessageID'sini
+// döndürür.
+func (m *PostmarkMailer) deliver(message *Message) (string, error) {
+	attachments, err := readAttachments(message)
+	if err != nil {
+		return "", err
+	}
+
+	email := postmarkEmail{
+		From:          message.GetFrom().String(),
+		To:            strings.Join(addressList(message.GetTo()), ", "),
+		Cc:            strings.Join(addressList(message.GetCc()), ", "),
+		Bcc:           strings.Join(addressList(message.GetBcc()), ", "),
+		Subject:       message.GetSubject(),
+		TextBody:      message.GetBody(),
+		HtmlBody:      message.GetHtmlBody(),
+		Metadata:      map[string]string{"message_id": message.GetID()},
+		MessageStream: m.config.MessageStream,
+	}
+	if message.GetReplyTo() != nil {
+		email.ReplyTo = message.GetReplyTo().String()
+	}
+	for key, value := range messageHeaders(message) {
+		email.Headers = append(email.Headers, postmarkHeader{Name: key, Value: value})
+	}
+	for _, attachment := range attachments {
+		email.Attachments = append(email.Attachments, postmarkAttachment{
+			Name:        attachment.name,
+			Content:     attachment.data,
+			ContentType: attachment.contentType,
+		})
+	}
+
+	body, err := json.Marshal(email)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		MessageID string `json:"MessageID"`
+	}
+	err = m.api.do(apiRequest{
+		method: http.MethodPost,
+		url:    strings.TrimRight(m.config.Endpoint, "/") + "/email",
+		body:   body,
+		header: http.Header{
+			"Accept":                  {"application/json"},
+			"Content-Type":            {"application/json"},
+			"X-Postmark-Server-Token": {m.config.Token},
+		},
+	}, &response)
+	if err != nil {
+		return "", fmt.Errorf("postmark send failed: %w", err)
+	}
+
+	return response.MessageID, nil
+}
@@ -0,0 +1,231 @@
+// -----------------------------------------------------------------------------
+// Queued Mail
+// -----------------------------------------------------------------------------
+// Bu dosya, email'lerin queue üzerinden asenkron gönderilmesini sağlar.
+//
+// Mesaj (render edilmiş gövdesiyle birlikte) SendMessageJob payload'ına
+// serialize edilir; worker job'u çalıştırdığında inject edilen Mailer ile
+// gönderir. Controller'ların sadece async mail için job oluşturmasına
+// gerek kalmaz.
+//
+// Kullanım:
+//
+//	mailer.SetQueue(q, "emails")
+//	err := mailer.Queue(message, "")       // varsayılan kuyruk
+//	err := mailer.Queue(message, "bulk")   // belirli kuyruk
+//	err := mailer.SendAsync(message)       // queue varsa Queue, yoksa Send
+// -----------------------------------------------------------------------------
+
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/queue"
+)
+
+// DefaultMailQueue, kuyruk adı verilmediğinde kullanılan kuyruktur.
+const DefaultMailQueue = "emails"
+
+// ErrQueueNotConfigured, SetQueue çağrılmadan Queue kullanıldığında döner.
+var ErrQueueNotConfigured = errors.New("mail queue ayarlanmadı (SetQueue)")
+
+func init() {
+	// Worker'ın payload'ı deserialize edebilmesi için kendini kaydeder.
+	// Mailer, uygulama tarafında DI'lı factory ile yeniden kaydedilir.
+	queue.RegisterType(func() *SendMessageJob { return &SendMessageJob{} })
+}
+
+// SetQueue, Queue ve SendAsync'in kullanacağı kuyruğu ayarlar.
+//
+// Parametreler:
+//   - q: Queue driver'ı
+//   - queueName: Varsayılan kuyruk adı (boş: "emails")
+func (m *BaseMailer) SetQueue(q queue.Queue, queueName string) {
+	if queueName == "" {
+		queueName = DefaultMailQueue
+	}
+	m.queue = q
+	m.queueName = queueName
+}
+
+// Queue, mesajı SendMessageJob olarak kuyruğa ekler.
+//
+// Parametreler:
+//   - message: Gönderilecek mesaj (Template önceden render edilmiş olmalı)
+//   - queueName: Kuyruk adı (boş: SetQueue'daki varsayılan)
+//
+// Döndürür:
+//   - error: Queue ayarlanmamışsa, mesaj geçersizse veya push hatası
+//
+// Örnek:
+//
+//	message := mail.NewMessage().
+//	    To(user.Email, user.Name).
+//	    Template("emails/password-reset", data)
+//	if err := mailer.Queue(message, ""); err != nil {
+//	    return err
+//	}
+func (m *BaseMailer) Queue(message *Message, queueName string) error {
+	if m.queue == nil {
+		return ErrQueueNotConfigured
+	}
+
+	// From adresi worker'daki mailer'ın config'inden doldurulabilir; burada
+	// sadece kuyruğa almayı anlamsız kılan hatalar kontrol edilir
+	if message.err != nil {
+		return fmt.Errorf("message validation failed: %w", message.err)
+	}
+	if len(message.to) == 0 {
+		return fmt.Errorf("message validation failed: at least one recipient is required")
+	}
+
+	if queueName == "" {
+		queueName = m.queueName
+	}
+
+	job := NewSendMessageJob(message)
+	job.Mailer = m.sender // Sync queue job'u bu process'te çalıştırır
+	if err := queue.Dispatch(context.Background(), m.queue, job, queueName); err != nil {
+		return fmt.Errorf("mail queue'ya eklenemedi: %w", err)
+	}
+
+	m.logger.Printf("📬 Email queued to: %s (queue: %s)", message.to[0].Email, queueName)
+	return nil
+}
+
+// sendAsync, queue ayarlıysa mesajı kuyruğa ekler, değilse senkron gönderir.
+func (m *BaseMailer) sendAsync(message *Message) error {
+	if m.queue != nil {
+		return m.Queue(message, "")
+	}
+	return m.sender.Send(message)
+}
+
+// -----------------------------------------------------------------------------
+// Send Message Job
+// -----------------------------------------------------------------------------
+
+// SendMessageJob, kuyruğa alınmış bir email'i gönderen job'dur.
+type SendMessageJob struct {
+	queue.BaseJob
+	Message *Message `json:"message"`
+
+	// Dependency injection için (serialize edilmez)
+	Mailer Mailer `json:"-"`
+}
+
+// NewSendMessageJob, mesaj için yeni bir job oluşturur (3 deneme).
+func NewSendMessageJob(message *Message) *SendMessageJob {
+	return &SendMessageJob{
+		BaseJob: queue.BaseJob{MaxAttempts: 3},
+		Message: message,
+	}
+}
+
+// Handle, mesajı inject edilen Mailer ile gönderir.
+func (j *SendMessageJob) Handle() error {
+	if j.Mailer == nil {
+		return fmt.Errorf("mail job: mailer inject edilmemiş")
+	}
+	if j.Message == nil {
+		return fmt.Errorf("mail job: mesaj boş")
+	}
+	return j.Mailer.Send(j.Message)
+}
+
+// Failed, job tüm denemelerde başarısız olduğunda çağrılır.
+func (j *SendMessageJob) Failed(err error) error {
+	to := ""
+	if j.Message != nil && len(j.Message.to) > 0 {
+		to = j.Message.to[0].Email
+	}
+	log.Printf("❌ Queued email failed: %s (to: %s, request: %s, error: %v)", j.ID, to, j.RequestID, err)
+	return nil
+}
+
+// GetPayload, job'ı serialize eder.
+func (j *SendMessageJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j)
+}
+
+// SetPayload, job'ı deserialize eder.
+func (j *SendMessageJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, j)
+}
+
+// -----------------------------------------------------------------------------
+// Message Serialization
+// -----------------------------------------------------------------------------
+
+// messagePayload, Message'ın queue payload'ındaki JSON karşılığıdır.
+type messagePayload struct {
+	ID          string            `json:"id,omitempty"`
+	From        Address           `json:"from"`
+	To          []Address         `json:"to"`
+	Cc          []Address         `json:"cc,omitempty"`
+	Bcc         []Address         `json:"bcc,omitempty"`
+	ReplyTo     *Address          `json:"reply_to,omitempty"`
+	Subject     string            `json:"subject"`
+	Body        string            `json:"body,omitempty"`
+	HtmlBody    string            `json:"html_body,omitempty"`
+	Attachments []string          `json:"attachments,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Priority    Priority          `json:"priority"`
+	Date        time.Time         `json:"date"`
+}
+
+// MarshalJSON, mesajı queue payload'ı için JSON'a çevirir.
+func (m *Message) MarshalJSON() ([]byte, error) {
+	return json.Marshal(messagePayload{
+		ID:          m.id,
+		From:        m.from,
+		To:          m.to,
+		Cc:          m.cc,
+		Bcc:         m.bcc,
+		ReplyTo:     m.replyTo,
+		Subject:     m.subject,
+		Body:        m.body,
+		HtmlBody:    m.htmlBody,
+		Attachments: m.attachments,
+		Headers:     m.headers,
+		Priority:    m.priority,
+		Date:        m.date,
+	})
+}
+
+// UnmarshalJSON, queue payload'ından mesajı geri oluşturur.
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var payload messagePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*m = Message{
+		id:          payload.ID,
+		from:        payload.From,
+		to:          payload.To,
+		cc:          payload.Cc,
+		bcc:         payload.Bcc,
+		replyTo:     payload.ReplyTo,
+		subject:     payload.Subject,
+		body:        payload.Body,
+		htmlBody:    payload.HtmlBody,
+		attachments: payload.Attachments,
+		headers:     payload.Headers,
+		priority:    payload.Priority,
+		date:        payload.Date,
+	}
+	if m.headers == nil {
+		m.headers = make(map[string]string)
+	}
+	if m.priority == 0 {
+		m.priority = PriorityNormal
+	}
+	return nil
+}
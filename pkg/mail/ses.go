@@ -0,0 +1,154 @@
+// -----------------------------------------------------------------------------
+// Amazon SES Mail Driver
+// -----------------------------------------------------------------------------
+// Email'i SES v2 API'si (SendEmail, raw MIME içerik) ile gönderir. İstekler
+// Signature V4 ile imzalanır; AWS SDK bağımlılığı yoktur.
+//
+// Mesaj ID'si "message_id" email tag'i olarak gönderilir; SES event
+// destination'ları (SNS, EventBridge) bu tag'i bounce/delivery
+// bildirimlerinde döndürür. Tag'ler için ConfigurationSet ayarlanmalıdır.
+//
+// Kullanım:
+//
+//	mailer := mail.NewSESMailer(&mail.SESConfig{
+//	    Region:          "eu-central-1",
+//	    AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+//	    SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+//	    From:            mail.Address{Email: "noreply@conduit.com", Name: "Conduit"},
+//	}, logger)
+// -----------------------------------------------------------------------------
+
+package mail
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/awssig"
+)
+
+// SESConfig, SES driver ayarlarıdır.
+type SESConfig struct {
+	Region           string // AWS region (örn: eu-central-1)
+	AccessKeyID      string
+	SecretAccessKey  string
+	SessionToken     string        // Geçici credential'lar için (opsiyonel)
+	ConfigurationSet string        // Event destination'ları için configuration set (opsiyonel)
+	Endpoint         string        // API endpoint (boş = https://email.{region}.amazonaws.com)
+	From             Address       // Varsayılan gönderici
+	Timeout          time.Duration // HTTP timeout (varsayılan: 30s)
+	Policy           string        // Resilience policy adı (varsayılan: "mail")
+}
+
+// SESMailer, Amazon SES API driver'ıdır.
+type SESMailer struct {
+	*BaseMailer
+	config *SESConfig
+	api    *apiClient
+}
+
+// NewSESMailer, yeni bir SES mailer oluşturur.
+//
+// Parametreler:
+//   - config: SES ayarları
+//   - logger: Logger instance
+//
+// Döndürür:
+//   - *SESMailer: Yeni SES mailer
+func NewSESMailer(config *SESConfig, logger Logger) *SESMailer {
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = "https://email." + config.Region + ".amazonaws.com"
+	}
+
+	mailer := &SESMailer{
+		BaseMailer: NewBaseMailer(logger),
+		config:     config,
+		api:        newAPIClient("ses", config.Policy, config.Timeout, jsonErrorMessage("message", "Message")),
+	}
+	mailer.sender = mailer
+	return mailer
+}
+
+// Send, email'i SES API'si ile gönderir.
+func (m *SESMailer) Send(message *Message) error {
+	return m.sendAPI(message, m.config.From, m.deliver)
+}
+
+// SendAsync, queue ayarlıysa (SetQueue) mesajı kuyruğa ekler, yoksa
+// senkron gönderir.
+func (m *SESMailer) SendAsync(message *Message) error {
+	return m.sendAsync(message)
+}
+
+// sesDestination, SES v2 alıcı listesidir.
+type sesDestination struct {
+	ToAddresses  []string `json:"ToAddresses"`
+	CcAddresses  []string `json:"CcAddresses,omitempty"`
+	BccAddresses []string `json:"BccAddresses,omitempty"`
+}
+
+// sesTag, SES v2 email tag'idir.
+type sesTag struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// deliver, mesajı SendEmail isteği olarak gönderir ve SES MessageId'yi
+// döndürür.
+func (m *SESMailer) deliver(message *Message) (string, error) {
+	raw, err := buildMIME(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to build email: %w", err)
+	}
+
+	// Bcc alıcıları sadece Destination'da yer alır (MIME'da header yok)
+	request := map[string]any{
+		"FromEmailAddress": message.GetFrom().String(),
+		"Destination": sesDestination{
+			ToAddresses:  addressList(message.GetTo()),
+			CcAddresses:  addressList(message.GetCc()),
+			BccAddresses: addressList(message.GetBcc()),
+		},
+		"Content":   map[string]any{"Raw": map[string][]byte{"Data": raw}},
+		"EmailTags": []sesTag{{Name: "message_id", Value: message.GetID()}},
+	}
+	if m.config.ConfigurationSet != "" {
+		request["ConfigurationSetName"] = m.config.ConfigurationSet
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		MessageID string `json:"MessageId"`
+	}
+	err = m.api.do(apiRequest{
+		method: http.MethodPost,
+		url:    strings.TrimRight(m.config.Endpoint, "/") + "/v2/email/outbound-emails",
+		body:   body,
+		header: http.Header{"Content-Type": {"application/json"}},
+		sign:   m.sign,
+	}, &response)
+	if err != nil {
+		return "", fmt.Errorf("ses send failed: %w", err)
+	}
+
+	return response.MessageID, nil
+}
+
+// sign, isteği AWS Signature Version 4 ile imzalar.
+func (m *SESMailer) sign(req *http.Request, body []byte) {
+	awssig.Sign(req, body, awssig.Credentials{
+		AccessKeyID:     m.config.AccessKeyID,
+		SecretAccessKey: m.config.SecretAccessKey,
+		SessionToken:    m.config.SessionToken,
+	}, m.config.Region, "ses", time.Now())
+}
@@ -11,58 +11,105 @@
 // - Mailgun (smtp.mailgun.org:587)
 // - Custom SMTP servers
 //
+// Şifreleme modları:
+// - starttls: Düz bağlantı açılır, STARTTLS ile yükseltilir (587)
+// - tls:      Bağlantı baştan TLS ile açılır (implicit TLS, 465)
+// - none:     Şifreleme yapılmaz (Mailhog gibi lokal sunucular)
+// - "":       Port 465 ise tls, değilse sunucu destekliyorsa STARTTLS
+//
+// Bağlantılar havuzda tutulur: gönderim bitince bağlantı kapatılmaz, sonraki
+// gönderim RSET ile aynı oturumu kullanır (her email için TCP + TLS + AUTH
+// tekrarlanmaz).
+//
 // Kullanım:
 //
 //	config := &mail.SMTPConfig{
-//	    Host:     "smtp.gmail.com",
-//	    Port:     587,
-//	    Username: "your@gmail.com",
-//	    Password: "app-password",
-//	    From:     mail.Address{Email: "noreply@conduit.com", Name: "Conduit"},
+//	    Host:       "smtp.gmail.com",
+//	    Port:       587,
+//	    Username:   "your@gmail.com",
+//	    Password:   "app-password",
+//	    Encryption: mail.EncryptionSTARTTLS,
+//	    From:       mail.Address{Email: "noreply@conduit.com", Name: "Conduit"},
 //	}
 //	mailer := mail.NewSMTPMailer(config, logger)
+//	defer mailer.Close()
 // -----------------------------------------------------------------------------
 
 package mail
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net"
 	"net/smtp"
 	"net/textproto"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/biyonik/conduit-go/pkg/resilience"
+)
+
+// SMTP şifreleme modları.
+const (
+	EncryptionNone     = "none"
+	EncryptionSTARTTLS = "starttls"
+	EncryptionTLS      = "tls"
 )
 
 // SMTPConfig, SMTP bağlantı ayarlarını içerir.
 type SMTPConfig struct {
-	Host     string        // SMTP sunucu adresi (örn: smtp.gmail.com)
-	Port     int           // SMTP port (25, 587, 465)
-	Username string        // SMTP kullanıcı adı
-	Password string        // SMTP şifre
-	From     Address       // Varsayılan gönderici adresi
-	UseTLS   bool          // TLS kullanılsın mı (587 port için true)
-	Timeout  time.Duration // Bağlantı timeout süresi (varsayılan: 30s)
-
-	// GELECEK İYİLEŞTİRMELER (TODO Phase 5):
-	// - Connection pooling (SMTP bağlantılarını yeniden kullanma)
-	// - Keep-alive support
-	// - Retry mechanism (geçici hatalar için otomatik retry)
-	// - Rate limiting (saniyede max email sayısı)
-	//
-	// NOT: Go'nun standart smtp paketi connection pooling desteklemiyor.
-	// Custom SMTP client implementasyonu gerekli (örn: github.com/emersion/go-smtp)
+	Host       string        // SMTP sunucu adresi (örn: smtp.gmail.com)
+	Port       int           // SMTP port (25, 587, 465)
+	Username   string        // SMTP kullanıcı adı
+	Password   string        // SMTP şifre
+	From       Address       // Varsayılan gönderici adresi
+	Encryption string        // starttls, tls, none (boş: port'a göre otomatik)
+	UseTLS     bool          // Deprecated: Encryption = "starttls" ile aynı
+	Timeout    time.Duration // Bağlantı ve komut timeout süresi (varsayılan: 30s)
+	Policy     string        // Timeout/retry/circuit policy adı (varsayılan: "mail")
+
+	// LocalName, EHLO'da gönderilecek host adı (varsayılan: "localhost")
+	LocalName string
+
+	// TLSConfig, özel TLS ayarları (örn: self-signed sertifika için RootCAs).
+	// Verilmezse ServerName = Host olan varsayılan config kullanılır.
+	TLSConfig *tls.Config
+
+	// MaxIdleConns, havuzda tutulacak boşta bağlantı sayısı
+	// (varsayılan: 2, negatif: havuz kapalı, her gönderimde yeni bağlantı).
+	MaxIdleConns int
+
+	// IdleTimeout, boşta bekleyen bağlantının yeniden kullanılabileceği
+	// süre (varsayılan: 30s). Sunucular boşta bağlantıları kapattığı için
+	// bu süreyi aşan bağlantılar atılır.
+	IdleTimeout time.Duration
 }
 
 // SMTPMailer, SMTP ile email gönderen mailer.
 type SMTPMailer struct {
 	*BaseMailer
 	config *SMTPConfig
+
+	mu     sync.Mutex
+	idle   []*smtpConn
+	closed bool
+}
+
+// smtpConn, havuzdaki tek bir SMTP oturumudur.
+type smtpConn struct {
+	conn     net.Conn
+	client   *smtp.Client
+	lastUsed time.Time
 }
 
 // NewSMTPMailer, yeni bir SMTP mailer oluşturur.
@@ -77,20 +124,21 @@ type SMTPMailer struct {
 // Örnek (Gmail):
 //
 //	config := &mail.SMTPConfig{
-//	    Host:     "smtp.gmail.com",
-//	    Port:     587,
-//	    Username: "your@gmail.com",
-//	    Password: "app-password",
-//	    UseTLS:   true,
+//	    Host:       "smtp.gmail.com",
+//	    Port:       587,
+//	    Username:   "your@gmail.com",
+//	    Password:   "app-password",
+//	    Encryption: mail.EncryptionSTARTTLS,
 //	}
 //	mailer := mail.NewSMTPMailer(config, logger)
 //
 // Örnek (Mailhog - Development):
 //
 //	config := &mail.SMTPConfig{
-//	    Host:     "localhost",
-//	    Port:     1025,
-//	    From:     mail.Address{Email: "dev@conduit.local"},
+//	    Host:       "localhost",
+//	    Port:       1025,
+//	    Encryption: mail.EncryptionNone,
+//	    From:       mail.Address{Email: "dev@conduit.local"},
 //	}
 //	mailer := mail.NewSMTPMailer(config, logger)
 func NewSMTPMailer(config *SMTPConfig, logger Logger) *SMTPMailer {
@@ -98,15 +146,37 @@ func NewSMTPMailer(config *SMTPConfig, logger Logger) *SMTPMailer {
 	if config.Timeout == 0 {
 		config.Timeout = 30 * time.Second
 	}
+	if config.Policy == "" {
+		config.Policy = resilience.PolicyMail
+	}
+	if config.Encryption == "" && config.UseTLS {
+		config.Encryption = EncryptionSTARTTLS
+	}
+	if config.LocalName == "" {
+		config.LocalName = "localhost"
+	}
+	if config.MaxIdleConns == 0 {
+		config.MaxIdleConns = 2
+	}
+	if config.IdleTimeout == 0 {
+		config.IdleTimeout = 30 * time.Second
+	}
 
-	return &SMTPMailer{
+	mailer := &SMTPMailer{
 		BaseMailer: NewBaseMailer(logger),
 		config:     config,
 	}
+	mailer.sender = mailer
+	return mailer
 }
 
 // Send, email'i SMTP üzerinden gönderir.
 func (m *SMTPMailer) Send(message *Message) error {
+	// From adresi yoksa config'den al (doğrulamadan önce)
+	if message.GetFrom().Email == "" {
+		message.From(m.config.From.Email, m.config.From.Name)
+	}
+
 	// Validate message
 	if err := m.ValidateMessage(message); err != nil {
 		return fmt.Errorf("message validation failed: %w", err)
@@ -115,32 +185,27 @@ func (m *SMTPMailer) Send(message *Message) error {
 	// Log sending
 	m.LogSending(message)
 
-	// From adresi yoksa config'den al
-	if message.GetFrom().Email == "" {
-		message.From(m.config.From.Email, m.config.From.Name)
-	}
-
-	// SMTP server adresi
-	addr := fmt.Sprintf("%s:%d", m.config.Host, m.config.Port)
-
-	// Auth (eğer username/password varsa)
-	var auth smtp.Auth
-	if m.config.Username != "" && m.config.Password != "" {
-		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
-	}
-
 	// Alıcıları topla
 	recipients := m.collectRecipients(message)
 
 	// Email içeriğini oluştur
-	emailBody, err := m.buildEmail(message)
+	emailBody, err := buildMIME(message)
 	if err != nil {
 		m.LogError(message, err)
 		return fmt.Errorf("failed to build email: %w", err)
 	}
 
-	// Email gönder
-	err = smtp.SendMail(addr, auth, message.GetFrom().Email, recipients, emailBody)
+	// Email gönder (policy: timeout, retry, circuit breaker)
+	err = resilience.Get(m.config.Policy).Execute(context.Background(), func(ctx context.Context) error {
+		err := m.deliver(ctx, message.GetFrom().Email, recipients, emailBody)
+
+		// 5xx SMTP cevapları kalıcıdır (örn: geçersiz alıcı), retry edilmez
+		var protoErr *textproto.Error
+		if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+			return resilience.Permanent(err)
+		}
+		return err
+	})
 	if err != nil {
 		m.LogError(message, err)
 		return fmt.Errorf("smtp send failed: %w", err)
@@ -152,12 +217,228 @@ func (m *SMTPMailer) Send(message *Message) error {
 	return nil
 }
 
-// SendAsync, queue yoksa senkron gönderir.
-// Queue integration yapıldığında bu metod güncellenecek.
+// SendAsync, queue ayarlıysa (SetQueue) mesajı kuyruğa ekler, yoksa
+// senkron gönderir.
 func (m *SMTPMailer) SendAsync(message *Message) error {
-	// TODO (Phase 3): Queue sistemi ile entegrasyon
-	// Şimdilik senkron gönder
-	return m.Send(message)
+	return m.sendAsync(message)
+}
+
+// Close, havuzdaki boşta bağlantıları QUIT ile kapatır. Close sonrası
+// gönderimler yine çalışır ama bağlantılar havuza alınmaz.
+func (m *SMTPMailer) Close() error {
+	m.mu.Lock()
+	idle := m.idle
+	m.idle = nil
+	m.closed = true
+	m.mu.Unlock()
+
+	var errs []error
+	for _, sc := range idle {
+		if err := sc.quit(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deliver, tek bir email'i havuzdan alınan (veya yeni açılan) bağlantı
+// üzerinden gönderir. Hata olursa bağlantı havuza geri konmaz.
+func (m *SMTPMailer) deliver(ctx context.Context, from string, to []string, body []byte) error {
+	sc, err := m.acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	// net/smtp context desteklemediği için iptal, bağlantının deadline'ı
+	// geçmişe çekilerek bekleyen okuma/yazma kesilir
+	stop := context.AfterFunc(ctx, func() {
+		sc.conn.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+
+	if err := sc.send(m.deadline(ctx), from, to, body); err != nil {
+		sc.conn.Close()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return err
+	}
+
+	if !stop() {
+		// Context gönderim bitmek üzereyken iptal edildi; deadline bozuldu
+		sc.conn.Close()
+		return nil
+	}
+	m.release(sc)
+	return nil
+}
+
+// acquire, havuzdan kullanılabilir bir bağlantı alır; yoksa yenisini açar.
+// Havuzdaki bağlantı RSET ile doğrulanır, cevap vermezse atılır.
+func (m *SMTPMailer) acquire(ctx context.Context) (*smtpConn, error) {
+	for {
+		m.mu.Lock()
+		if len(m.idle) == 0 {
+			m.mu.Unlock()
+			return m.dial(ctx)
+		}
+		sc := m.idle[len(m.idle)-1]
+		m.idle = m.idle[:len(m.idle)-1]
+		m.mu.Unlock()
+
+		if time.Since(sc.lastUsed) > m.config.IdleTimeout {
+			sc.quit()
+			continue
+		}
+
+		sc.conn.SetDeadline(m.deadline(ctx))
+		if err := sc.client.Reset(); err != nil {
+			sc.conn.Close()
+			continue
+		}
+		return sc, nil
+	}
+}
+
+// release, başarılı gönderimden sonra bağlantıyı havuza geri koyar.
+func (m *SMTPMailer) release(sc *smtpConn) {
+	sc.lastUsed = time.Now()
+
+	m.mu.Lock()
+	if !m.closed && len(m.idle) < m.config.MaxIdleConns {
+		m.idle = append(m.idle, sc)
+		m.mu.Unlock()
+		return
+	}
+	m.mu.Unlock()
+
+	sc.quit()
+}
+
+// dial, yeni bir SMTP oturumu açar: bağlantı, EHLO, (STARTTLS) ve AUTH.
+func (m *SMTPMailer) dial(ctx context.Context) (*smtpConn, error) {
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	dialer := &net.Dialer{Timeout: m.config.Timeout}
+	encryption := m.encryption()
+
+	var conn net.Conn
+	var err error
+	if encryption == EncryptionTLS {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: m.tlsConfig()}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("smtp dial %s: %w", addr, err)
+	}
+	conn.SetDeadline(m.deadline(ctx))
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp handshake: %w", err)
+	}
+	sc := &smtpConn{conn: conn, client: client}
+
+	if err := client.Hello(m.config.LocalName); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp EHLO: %w", err)
+	}
+
+	// Sunucu yeteneği eksikse retry anlamsızdır (yapılandırma hatası)
+	supportsTLS, _ := client.Extension("STARTTLS")
+	switch {
+	case encryption == EncryptionSTARTTLS && !supportsTLS:
+		sc.quit()
+		return nil, resilience.Permanent(fmt.Errorf("smtp sunucusu STARTTLS desteklemiyor (%s)", addr))
+	case encryption == EncryptionSTARTTLS, encryption == "" && supportsTLS:
+		if err := client.StartTLS(m.tlsConfig()); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("smtp STARTTLS: %w", err)
+		}
+	}
+
+	if m.config.Username != "" && m.config.Password != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			sc.quit()
+			return nil, resilience.Permanent(fmt.Errorf("smtp sunucusu AUTH desteklemiyor (%s)", addr))
+		}
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("smtp AUTH: %w", err)
+		}
+	}
+
+	return sc, nil
+}
+
+// encryption, kullanılacak şifreleme modunu döndürür. Mod belirtilmemişse
+// 465 portunda implicit TLS kullanılır; diğer portlarda "" döner ve
+// STARTTLS sunucu destekliyorsa yapılır.
+func (m *SMTPMailer) encryption() string {
+	encryption := strings.ToLower(m.config.Encryption)
+	if encryption == "ssl" {
+		return EncryptionTLS
+	}
+	if encryption == "" && m.config.Port == 465 {
+		return EncryptionTLS
+	}
+	return encryption
+}
+
+// tlsConfig, TLS el sıkışması için config döndürür.
+func (m *SMTPMailer) tlsConfig() *tls.Config {
+	if m.config.TLSConfig != nil {
+		cfg := m.config.TLSConfig.Clone()
+		if cfg.ServerName == "" {
+			cfg.ServerName = m.config.Host
+		}
+		return cfg
+	}
+	return &tls.Config{ServerName: m.config.Host, MinVersion: tls.VersionTLS12}
+}
+
+// deadline, bağlantı işlemleri için son zamanı döndürür (context deadline'ı
+// daha erkense o kullanılır).
+func (m *SMTPMailer) deadline(ctx context.Context) time.Time {
+	deadline := time.Now().Add(m.config.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		return ctxDeadline
+	}
+	return deadline
+}
+
+// send, açık oturum üzerinden MAIL/RCPT/DATA komutlarını çalıştırır.
+func (sc *smtpConn) send(deadline time.Time, from string, to []string, body []byte) error {
+	sc.conn.SetDeadline(deadline)
+
+	if err := sc.client.Mail(from); err != nil {
+		return err
+	}
+	for _, rcpt := range to {
+		if err := sc.client.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+
+	w, err := sc.client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// quit, oturumu QUIT ile kapatır.
+func (sc *smtpConn) quit() error {
+	sc.conn.SetDeadline(time.Now().Add(5 * time.Second))
+	err := sc.client.Quit()
+	sc.conn.Close()
+	return err
 }
 
 // collectRecipients, tüm alıcıları (To, Cc, Bcc) toplar.
@@ -179,8 +460,9 @@ func (m *SMTPMailer) collectRecipients(message *Message) []string {
 	return recipients
 }
 
-// buildEmail, email içeriğini MIME formatında oluşturur.
-func (m *SMTPMailer) buildEmail(message *Message) ([]byte, error) {
+// buildMIME, email içeriğini MIME formatında oluşturur. SMTP ve raw MIME
+// kabul eden API driver'ları (SES) tarafından kullanılır.
+func buildMIME(message *Message) ([]byte, error) {
 	var buf bytes.Buffer
 
 	// Headers
@@ -228,14 +510,14 @@ func (m *SMTPMailer) buildEmail(message *Message) ([]byte, error) {
 
 	// Attachment varsa multipart/mixed, yoksa multipart/alternative
 	if len(message.GetAttachments()) > 0 {
-		return m.buildMultipartWithAttachments(&buf, message)
+		return buildMultipartWithAttachments(&buf, message)
 	}
 
-	return m.buildMultipartAlternative(&buf, message)
+	return buildMultipartAlternative(&buf, message)
 }
 
 // buildMultipartAlternative, plain text ve HTML içeriği olan email oluşturur.
-func (m *SMTPMailer) buildMultipartAlternative(buf *bytes.Buffer, message *Message) ([]byte, error) {
+func buildMultipartAlternative(buf *bytes.Buffer, message *Message) ([]byte, error) {
 	writer := multipart.NewWriter(buf)
 	boundary := writer.Boundary()
 
@@ -263,7 +545,7 @@ func (m *SMTPMailer) buildMultipartAlternative(buf *bytes.Buffer, message *Messa
 }
 
 // buildMultipartWithAttachments, ek dosyalı email oluşturur.
-func (m *SMTPMailer) buildMultipartWithAttachments(buf *bytes.Buffer, message *Message) ([]byte, error) {
+func buildMultipartWithAttachments(buf *bytes.Buffer, message *Message) ([]byte, error) {
 	writer := multipart.NewWriter(buf)
 	boundary := writer.Boundary()
 
@@ -300,7 +582,7 @@ func (m *SMTPMailer) buildMultipartWithAttachments(buf *bytes.Buffer, message *M
 
 	// Attachments
 	for _, filePath := range message.GetAttachments() {
-		if err := m.addAttachment(writer, filePath); err != nil {
+		if err := addAttachment(writer, filePath); err != nil {
 			return nil, fmt.Errorf("failed to attach file %s: %w", filePath, err)
 		}
 	}
@@ -311,7 +593,7 @@ func (m *SMTPMailer) buildMultipartWithAttachments(buf *bytes.Buffer, message *M
 }
 
 // addAttachment, dosyayı ek olarak ekler.
-func (m *SMTPMailer) addAttachment(writer *multipart.Writer, filePath string) error {
+func addAttachment(writer *multipart.Writer, filePath string) error {
 	// Dosyayı aç
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -0,0 +1,378 @@
+// -----------------------------------------------------------------------------
+// Mail Templates
+// -----------------------------------------------------------------------------
+// Bu dosya, email gövdelerini html/template dosyalarından üretir.
+//
+// Dizin yapısı (fs.FS köküne göre):
+//
+//	emails/layouts/default.html   # Ortak layout, {{template "content" .}} çağırır
+//	emails/partials/button.html   # Partial'lar, dosya adıyla çağrılır: {{template "button" .}}
+//	emails/password-reset.html    # View, "content" olarak layout'a yerleşir
+//	emails/password-reset.txt     # Opsiyonel plain text (yoksa HTML'den üretilir)
+//
+// View dosyası {{define "subject"}}...{{end}} ile konu da tanımlayabilir.
+//
+// Kullanım:
+//
+//	mail.SetTemplates(mail.NewTemplates(os.DirFS("resources/views")))
+//
+//	message := mail.NewMessage().
+//	    To("user@example.com", "John").
+//	    Template("emails/password-reset", map[string]any{"Name": "John", "Link": link})
+// -----------------------------------------------------------------------------
+
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+)
+
+// ErrTemplatesNotConfigured, SetTemplates çağrılmadan Template kullanıldığında döner.
+var ErrTemplatesNotConfigured = errors.New("mail şablonları ayarlanmadı (mail.SetTemplates)")
+
+// Rendered, render edilmiş email içeriğidir.
+type Rendered struct {
+	Subject string // View'daki "subject" bloğu (tanımlı değilse boş)
+	HTML    string // Layout ile sarılmış HTML gövde
+	Text    string // Plain text alternatif
+}
+
+// Templates, email şablonlarını bir fs.FS'ten yükler, derler ve cache'ler.
+type Templates struct {
+	fsys     fs.FS
+	layout   string
+	partials string
+	reload   bool
+	funcs    htmltemplate.FuncMap
+	shared   map[string]any
+
+	mu    sync.RWMutex
+	cache map[string]*compiledTemplate
+}
+
+// compiledTemplate, bir view'ın derlenmiş HTML ve (varsa) text şablonudur.
+type compiledTemplate struct {
+	html  *htmltemplate.Template
+	entry string // Çalıştırılacak şablon: layout veya "content"
+	text  *texttemplate.Template
+}
+
+// NewTemplates, verilen dosya sisteminden şablon yükleyen yeni bir
+// Templates oluşturur. Varsayılan layout "emails/layouts/default",
+// partial dizini "emails/partials"dır.
+//
+// Parametreler:
+//   - fsys: Şablonların kök dizini (os.DirFS veya embed.FS)
+//
+// Döndürür:
+//   - *Templates: Yeni Templates örneği
+//
+// Örnek:
+//
+//	templates := mail.NewTemplates(os.DirFS("resources/views")).
+//	    Share("app_name", "Conduit").
+//	    Reload(true) // Development: dosya değişiklikleri anında görünür
+func NewTemplates(fsys fs.FS) *Templates {
+	return &Templates{
+		fsys:     fsys,
+		layout:   "emails/layouts/default",
+		partials: "emails/partials",
+		funcs:    htmltemplate.FuncMap{},
+		shared:   map[string]any{},
+		cache:    map[string]*compiledTemplate{},
+	}
+}
+
+// Layout, varsayılan layout'u değiştirir ("" layout'u kapatır).
+func (t *Templates) Layout(name string) *Templates {
+	t.layout = name
+	t.flush()
+	return t
+}
+
+// Partials, partial dizinini değiştirir ("" partial yüklemeyi kapatır).
+func (t *Templates) Partials(dir string) *Templates {
+	t.partials = dir
+	t.flush()
+	return t
+}
+
+// Funcs, şablonlarda kullanılabilecek ek fonksiyonlar ekler.
+func (t *Templates) Funcs(funcs htmltemplate.FuncMap) *Templates {
+	for name, fn := range funcs {
+		t.funcs[name] = fn
+	}
+	t.flush()
+	return t
+}
+
+// Share, tüm şablonlarda {{shared "key"}} ile erişilebilecek bir değer
+// ekler (örn: uygulama adı, destek email'i).
+func (t *Templates) Share(key string, value any) *Templates {
+	t.mu.Lock()
+	t.shared[key] = value
+	t.mu.Unlock()
+	return t
+}
+
+// Reload, true ise şablonlar her render'da yeniden okunur (cache kapalı).
+func (t *Templates) Reload(enabled bool) *Templates {
+	t.reload = enabled
+	return t
+}
+
+// Render, view'ı data ile render eder.
+//
+// Parametreler:
+//   - name: Uzantısız view adı (örn: "emails/password-reset")
+//   - data: Şablona geçirilecek veri (map veya struct)
+//
+// Döndürür:
+//   - *Rendered: Konu, HTML ve plain text içerik
+//   - error: View bulunamazsa veya render hatası olursa
+func (t *Templates) Render(name string, data any) (*Rendered, error) {
+	compiled, err := t.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var subject bytes.Buffer
+	if err := compiled.html.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("subject render edilemedi: %w", err)
+	}
+
+	var body bytes.Buffer
+	if err := compiled.html.ExecuteTemplate(&body, compiled.entry, data); err != nil {
+		return nil, fmt.Errorf("html render edilemedi: %w", err)
+	}
+
+	rendered := &Rendered{
+		// Konu header'a yazılır, HTML escape'leri geri çevrilir
+		Subject: strings.TrimSpace(html.UnescapeString(subject.String())),
+		HTML:    body.String(),
+	}
+
+	if compiled.text != nil {
+		var text bytes.Buffer
+		if err := compiled.text.Execute(&text, data); err != nil {
+			return nil, fmt.Errorf("text render edilemedi: %w", err)
+		}
+		rendered.Text = strings.TrimSpace(text.String())
+	} else {
+		rendered.Text = HTMLToText(rendered.HTML)
+	}
+
+	return rendered, nil
+}
+
+// lookup, view'ı cache'ten döndürür; yoksa derleyip cache'e ekler.
+func (t *Templates) lookup(name string) (*compiledTemplate, error) {
+	if !t.reload {
+		t.mu.RLock()
+		compiled, ok := t.cache[name]
+		t.mu.RUnlock()
+		if ok {
+			return compiled, nil
+		}
+	}
+
+	compiled, err := t.compile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !t.reload {
+		t.mu.Lock()
+		t.cache[name] = compiled
+		t.mu.Unlock()
+	}
+	return compiled, nil
+}
+
+// compile, partial'ları, view'ı ve layout'u tek bir şablon setinde derler.
+func (t *Templates) compile(name string) (*compiledTemplate, error) {
+	root := htmltemplate.New(name).Funcs(t.templateFuncs())
+
+	// View subject tanımlamazsa boş konu kullanılır
+	if _, err := root.New("subject").Parse(""); err != nil {
+		return nil, err
+	}
+
+	if t.partials != "" {
+		files, err := fs.Glob(t.fsys, path.Join(t.partials, "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			partial := strings.TrimSuffix(path.Base(file), ".html")
+			if err := t.parseHTML(root, partial, file); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := t.parseHTML(root, "content", name+".html"); err != nil {
+		return nil, err
+	}
+
+	compiled := &compiledTemplate{html: root, entry: "content"}
+
+	if t.layout != "" {
+		err := t.parseHTML(root, "layout", t.layout+".html")
+		switch {
+		case err == nil:
+			compiled.entry = "layout"
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, err
+		}
+	}
+
+	source, err := fs.ReadFile(t.fsys, name+".txt")
+	switch {
+	case err == nil:
+		compiled.text, err = texttemplate.New(name + ".txt").Funcs(texttemplate.FuncMap(t.templateFuncs())).Parse(string(source))
+		if err != nil {
+			return nil, fmt.Errorf("%s.txt parse edilemedi: %w", name, err)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	return compiled, nil
+}
+
+// parseHTML, dosyayı root altında verilen isimle parse eder.
+func (t *Templates) parseHTML(root *htmltemplate.Template, name, file string) error {
+	source, err := fs.ReadFile(t.fsys, file)
+	if err != nil {
+		return fmt.Errorf("mail şablonu okunamadı: %w", err)
+	}
+	if _, err := root.New(name).Parse(string(source)); err != nil {
+		return fmt.Errorf("%s parse edilemedi: %w", file, err)
+	}
+	return nil
+}
+
+// templateFuncs, yerleşik ve kullanıcı fonksiyonlarını döndürür.
+//
+//   - shared "key": Share ile eklenen değer
+//   - dict "k1" v1 "k2" v2: Partial'lara birden fazla değer geçirmek için map
+func (t *Templates) templateFuncs() htmltemplate.FuncMap {
+	funcs := htmltemplate.FuncMap{
+		"shared": func(key string) any {
+			t.mu.RLock()
+			defer t.mu.RUnlock()
+			return t.shared[key]
+		},
+		"dict": func(pairs ...any) (map[string]any, error) {
+			if len(pairs)%2 != 0 {
+				return nil, errors.New("dict çift sayıda argüman bekler")
+			}
+			values := make(map[string]any, len(pairs)/2)
+			for i := 0; i < len(pairs); i += 2 {
+				key, ok := pairs[i].(string)
+				if !ok {
+					return nil, fmt.Errorf("dict anahtarı string olmalı: %v", pairs[i])
+				}
+				values[key] = pairs[i+1]
+			}
+			return values, nil
+		},
+	}
+	for name, fn := range t.funcs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// flush, derlenmiş şablon cache'ini temizler.
+func (t *Templates) flush() {
+	t.mu.Lock()
+	t.cache = map[string]*compiledTemplate{}
+	t.mu.Unlock()
+}
+
+// -----------------------------------------------------------------------------
+// Global Templates
+// -----------------------------------------------------------------------------
+
+var (
+	templatesMu sync.RWMutex
+	templates   *Templates
+)
+
+// SetTemplates, Message.Template'in kullanacağı şablonları ayarlar.
+// Uygulama başlangıcında bir kez çağrılır.
+func SetTemplates(t *Templates) {
+	templatesMu.Lock()
+	defer templatesMu.Unlock()
+
+	templates = t
+}
+
+// GetTemplates, aktif şablonları döndürür (ayarlanmadıysa nil).
+func GetTemplates() *Templates {
+	templatesMu.RLock()
+	defer templatesMu.RUnlock()
+
+	return templates
+}
+
+// -----------------------------------------------------------------------------
+// HTML -> Plain Text
+// -----------------------------------------------------------------------------
+
+var (
+	htmlHiddenPattern    = regexp.MustCompile(`(?is)<(head|style|script)\b.*?</(head|style|script)>`)
+	htmlLinkPattern      = regexp.MustCompile(`(?is)<a\b[^>]*\bhref\s*=\s*["']([^"']*)["'][^>]*>(.*?)</a>`)
+	htmlBreakPattern     = regexp.MustCompile(`(?i)<br\s*/?>`)
+	htmlListItemPattern  = regexp.MustCompile(`(?i)<li\b[^>]*>`)
+	htmlBlockEndPattern  = regexp.MustCompile(`(?i)</(p|div|h[1-6]|tr|table|ul|ol|li|blockquote)>`)
+	htmlTagPattern       = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlSpacePattern     = regexp.MustCompile(`[ \t\r\f\v]+`)
+	htmlBlankLinePattern = regexp.MustCompile(`\n{3,}`)
+)
+
+// HTMLToText, HTML email gövdesinden okunabilir bir plain text alternatif
+// üretir. Linkler "metin (url)" biçiminde korunur.
+//
+// Parametreler:
+//   - source: HTML içerik
+//
+// Döndürür:
+//   - string: Plain text içerik
+func HTMLToText(source string) string {
+	text := htmlHiddenPattern.ReplaceAllString(source, "")
+	text = htmlLinkPattern.ReplaceAllStringFunc(text, func(link string) string {
+		match := htmlLinkPattern.FindStringSubmatch(link)
+		href := html.UnescapeString(match[1])
+		label := strings.TrimSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(match[2], "")))
+		if label == "" || label == href {
+			return href
+		}
+		return label + " (" + href + ")"
+	})
+	text = htmlBreakPattern.ReplaceAllString(text, "\n")
+	text = htmlListItemPattern.ReplaceAllString(text, "- ")
+	text = htmlBlockEndPattern.ReplaceAllString(text, "\n\n")
+	text = htmlTagPattern.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(htmlSpacePattern.ReplaceAllString(line, " "))
+	}
+	text = strings.Join(lines, "\n")
+
+	return strings.TrimSpace(htmlBlankLinePattern.ReplaceAllString(text, "\n\n"))
+}
@@ -0,0 +1,316 @@
+// -----------------------------------------------------------------------------
+// Job Batching
+// -----------------------------------------------------------------------------
+// Birbiriyle ilişkili job'ları tek bir batch olarak dispatch eder; batch'in
+// ilerlemesi (kaç job bitti, kaçı başarısız) store'da tutulur ve batch
+// tamamlandığında veya ilk job başarısız olduğunda callback'ler çalışır.
+//
+// Kullanım:
+//
+//	// Worker ve API process'lerinde (init veya main.go):
+//	queue.RegisterBatchCallback("imports.done", func(b *queue.BatchStatus) {
+//	    log.Printf("Import tamamlandı: %s", b.ID)
+//	})
+//
+//	batch, err := queue.Batch(job1, job2, job3).
+//	    Name("user-import").
+//	    Then("imports.done").
+//	    Catch("imports.failed").
+//	    Dispatch(r.Context(), q, "imports")
+//
+//	status, _ := queue.FindBatch(batch.ID)
+//	fmt.Printf("%%%d", status.Progress())
+//
+// Callback'ler isimle kaydedilir (JobRegistry gibi): batch'i bitiren job başka
+// bir worker process'inde çalışabildiği için fonksiyonun kendisi saklanamaz.
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrBatchNotFound, istenen batch store'da yoksa döner.
+var ErrBatchNotFound = errors.New("batch bulunamadı")
+
+// BatchStatus, bir batch'in anlık durumudur.
+type BatchStatus struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	TotalJobs     int        `json:"total_jobs"`
+	PendingJobs   int        `json:"pending_jobs"` // Henüz bitmemiş (başarılı veya kalıcı başarısız olmamış) job'lar
+	FailedJobs    int        `json:"failed_jobs"`
+	FailedJobIDs  []string   `json:"failed_job_ids"`
+	ThenCallback  string     `json:"then_callback,omitempty"`
+	CatchCallback string     `json:"catch_callback,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	FinishedAt    *time.Time `json:"finished_at,omitempty"`
+}
+
+// ProcessedJobs, biten (başarılı veya başarısız) job sayısını döndürür.
+func (b *BatchStatus) ProcessedJobs() int {
+	return b.TotalJobs - b.PendingJobs
+}
+
+// Progress, tamamlanma yüzdesini (0-100) döndürür.
+func (b *BatchStatus) Progress() int {
+	if b.TotalJobs == 0 {
+		return 100
+	}
+	return b.ProcessedJobs() * 100 / b.TotalJobs
+}
+
+// Finished, tüm job'lar bittiyse true döner.
+func (b *BatchStatus) Finished() bool {
+	return b.PendingJobs == 0
+}
+
+// HasFailures, en az bir job kalıcı olarak başarısız olduysa true döner.
+func (b *BatchStatus) HasFailures() bool {
+	return b.FailedJobs > 0
+}
+
+// Batchable, bir batch'e ait olabilen job'lar için opsiyonel interface.
+// BaseJob gömen tüm job'lar bunu implement eder.
+type Batchable interface {
+	GetBatchID() string
+	SetBatchID(id string)
+}
+
+// BatchIDOf, job'ın ait olduğu batch'in ID'sini döndürür (yoksa boş string).
+func BatchIDOf(job Job) string {
+	if batchable, ok := job.(Batchable); ok {
+		return batchable.GetBatchID()
+	}
+	return ""
+}
+
+// BatchCallback, batch tamamlandığında veya başarısız olduğunda çalışır.
+type BatchCallback func(batch *BatchStatus)
+
+// Kayıtlı batch callback'leri (isim -> fonksiyon)
+var (
+	batchCallbacks   = make(map[string]BatchCallback)
+	batchCallbacksMu sync.RWMutex
+)
+
+// RegisterBatchCallback, isimlendirilmiş bir batch callback'i kaydeder.
+//
+// Callback'ler job'ları işleyen her process'te (worker, sync queue kullanan
+// API) kaydedilmiş olmalıdır.
+//
+// Örnek:
+//
+//	queue.RegisterBatchCallback("imports.done", func(b *queue.BatchStatus) {
+//	    notifyAdmin(b.Name, b.TotalJobs)
+//	})
+func RegisterBatchCallback(name string, callback BatchCallback) {
+	batchCallbacksMu.Lock()
+	defer batchCallbacksMu.Unlock()
+
+	batchCallbacks[name] = callback
+}
+
+// Global batch store (varsayılan: memory)
+var (
+	batchStore   BatchStore = NewMemoryBatchStore()
+	batchStoreMu sync.RWMutex
+)
+
+// SetBatchStore, batch durumlarının saklanacağı store'u ayarlar.
+//
+// Birden fazla process (API + worker) varsa paylaşılan bir store
+// (RedisBatchStore) kullanılmalıdır.
+func SetBatchStore(store BatchStore) {
+	batchStoreMu.Lock()
+	defer batchStoreMu.Unlock()
+
+	batchStore = store
+}
+
+// getBatchStore, aktif batch store'u döndürür.
+func getBatchStore() BatchStore {
+	batchStoreMu.RLock()
+	defer batchStoreMu.RUnlock()
+
+	return batchStore
+}
+
+// FindBatch, batch'in güncel durumunu döndürür.
+//
+// Örnek:
+//
+//	status, err := queue.FindBatch(batchID)
+//	if errors.Is(err, queue.ErrBatchNotFound) { ... }
+func FindBatch(id string) (*BatchStatus, error) {
+	return getBatchStore().Find(id)
+}
+
+// PendingBatch, dispatch edilmeyi bekleyen batch builder'ı.
+type PendingBatch struct {
+	jobs  []Job
+	name  string
+	then  string
+	catch string
+}
+
+// Batch, job'lardan yeni bir batch builder'ı oluşturur.
+func Batch(jobs ...Job) *PendingBatch {
+	return &PendingBatch{jobs: jobs}
+}
+
+// Name, batch'e okunabilir bir isim verir (loglar ve izleme için).
+func (p *PendingBatch) Name(name string) *PendingBatch {
+	p.name = name
+	return p
+}
+
+// Then, tüm job'lar başarıyla bittiğinde çalışacak callback'in adını ayarlar.
+func (p *PendingBatch) Then(callback string) *PendingBatch {
+	p.then = callback
+	return p
+}
+
+// Catch, ilk job kalıcı olarak başarısız olduğunda çalışacak callback'in
+// adını ayarlar. Kalan job'lar çalışmaya devam eder.
+func (p *PendingBatch) Catch(callback string) *PendingBatch {
+	p.catch = callback
+	return p
+}
+
+// Dispatch, batch'i store'a kaydeder ve job'ları request ID ile birlikte
+// kuyruğa ekler.
+//
+// Kuyruğa eklenemeyen job'lar başarısız sayılır; böylece batch asla
+// tamamlanmadan takılı kalmaz.
+//
+// Parametreler:
+//   - ctx: İsteğin context'i (request ID için)
+//   - q: Queue driver
+//   - queueName: Kuyruk adı
+//
+// Döndürür:
+//   - *BatchStatus: Oluşturulan batch
+//   - error: Store veya push hatası
+func (p *PendingBatch) Dispatch(ctx context.Context, q Queue, queueName string) (*BatchStatus, error) {
+	for _, job := range p.jobs {
+		if _, ok := job.(Batchable); !ok {
+			return nil, fmt.Errorf("%T batch'e eklenemez (BaseJob gömülmeli)", job)
+		}
+	}
+
+	status := &BatchStatus{
+		ID:            uuid.New().String(),
+		Name:          p.name,
+		TotalJobs:     len(p.jobs),
+		PendingJobs:   len(p.jobs),
+		ThenCallback:  p.then,
+		CatchCallback: p.catch,
+		CreatedAt:     time.Now(),
+	}
+
+	store := getBatchStore()
+	if err := store.Create(status); err != nil {
+		return nil, fmt.Errorf("batch kaydedilemedi: %w", err)
+	}
+
+	// Sync queue job'ı Push içinde çalıştırıp sonucu kendisi kaydeder;
+	// Push'un döndürdüğü hata job hatasıdır, push hatası değil.
+	_, isSync := q.(*SyncQueue)
+
+	var dispatchErr error
+	for _, job := range p.jobs {
+		job.(Batchable).SetBatchID(status.ID)
+
+		if err := Dispatch(ctx, q, job, queueName); err != nil && !isSync {
+			finishBatchJob(store, job, err, nil)
+			if dispatchErr == nil {
+				dispatchErr = fmt.Errorf("batch job'u kuyruğa eklenemedi: %w", err)
+			}
+		}
+	}
+
+	if current, err := store.Find(status.ID); err == nil {
+		status = current
+	}
+	return status, dispatchErr
+}
+
+// finishBatchJob, batch'e ait job'ın sonucunu store'a işler ve gerekiyorsa
+// callback'leri çalıştırır.
+//
+// err nil ise job başarılı, değilse kalıcı olarak başarısız kabul edilir
+// (retry edilecek job'lar için çağrılmamalıdır).
+func finishBatchJob(store BatchStore, job Job, jobErr error, logger *log.Logger) {
+	batchID := BatchIDOf(job)
+	if batchID == "" {
+		return
+	}
+
+	var (
+		status    *BatchStatus
+		firstFail bool
+		err       error
+	)
+	if jobErr == nil {
+		status, err = store.RecordSuccess(batchID, job.GetID())
+	} else {
+		status, firstFail, err = store.RecordFailure(batchID, job.GetID())
+	}
+	if err != nil {
+		logWith(logger, "⚠️  Batch güncellenemedi [%s]: %v", batchID, err)
+		return
+	}
+
+	if firstFail && status.CatchCallback != "" {
+		runBatchCallback(status.CatchCallback, status, logger)
+	}
+
+	if status.Finished() {
+		logWith(logger, "📦 Batch finished: %s (%s, total: %d, failed: %d)", status.ID, status.Name, status.TotalJobs, status.FailedJobs)
+		if !status.HasFailures() && status.ThenCallback != "" {
+			runBatchCallback(status.ThenCallback, status, logger)
+		}
+	}
+}
+
+// recordBatchResult, aktif store ile finishBatchJob'ı çağırır.
+func recordBatchResult(job Job, jobErr error, logger *log.Logger) {
+	finishBatchJob(getBatchStore(), job, jobErr, logger)
+}
+
+// runBatchCallback, kayıtlı callback'i panic'e karşı korumalı çalıştırır.
+func runBatchCallback(name string, status *BatchStatus, logger *log.Logger) {
+	batchCallbacksMu.RLock()
+	callback, ok := batchCallbacks[name]
+	batchCallbacksMu.RUnlock()
+
+	if !ok {
+		logWith(logger, "⚠️  Batch callback register edilmemiş: %s (batch: %s)", name, status.ID)
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			logWith(logger, "💥 Batch callback panic: %s (batch: %s): %v", name, status.ID, r)
+		}
+	}()
+	callback(status)
+}
+
+// logWith, logger verilmişse onu, değilse standart log'u kullanır.
+func logWith(logger *log.Logger, format string, args ...interface{}) {
+	if logger != nil {
+		logger.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
@@ -0,0 +1,259 @@
+// -----------------------------------------------------------------------------
+// Batch Stores
+// -----------------------------------------------------------------------------
+// Batch ilerlemesini saklayan store'lar.
+//
+// - MemoryBatchStore: Tek process (sync queue, testler)
+// - RedisBatchStore: API ve worker process'leri arasında paylaşılır
+//
+// Redis Data Structures:
+// - batches:{id} - Hash (total, pending, failed, name, callback'ler, zamanlar)
+// - batches:{id}:failed - Set (başarısız job ID'leri)
+//
+// Sayaçlar HINCRBY ile güncellenir; birden fazla worker aynı batch'in
+// job'larını eşzamanlı bitirse bile "son job" ve "ilk hata" tek bir
+// worker tarafından görülür, callback'ler bir kez çalışır.
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// BatchStore, batch durumlarını saklayan arayüzdür.
+type BatchStore interface {
+	// Create, yeni batch'i kaydeder.
+	Create(batch *BatchStatus) error
+
+	// Find, batch'in güncel durumunu döndürür (yoksa ErrBatchNotFound).
+	Find(id string) (*BatchStatus, error)
+
+	// RecordSuccess, başarılı job'ı işler ve güncel durumu döndürür.
+	RecordSuccess(id, jobID string) (*BatchStatus, error)
+
+	// RecordFailure, kalıcı olarak başarısız job'ı işler. İkinci dönüş
+	// değeri, bu batch'teki ilk hata ise true'dur (Catch callback'i için).
+	RecordFailure(id, jobID string) (*BatchStatus, bool, error)
+}
+
+// batchTTL, tamamlanan batch kayıtlarının saklanma süresi.
+const batchTTL = 7 * 24 * time.Hour
+
+// MemoryBatchStore, batch'leri process belleğinde saklar.
+type MemoryBatchStore struct {
+	mu      sync.Mutex
+	batches map[string]*BatchStatus
+}
+
+// NewMemoryBatchStore, yeni bir MemoryBatchStore oluşturur.
+func NewMemoryBatchStore() *MemoryBatchStore {
+	return &MemoryBatchStore{
+		batches: make(map[string]*BatchStatus),
+	}
+}
+
+// Create, yeni batch'i kaydeder.
+func (m *MemoryBatchStore) Create(batch *BatchStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := *batch
+	m.batches[batch.ID] = &stored
+	return nil
+}
+
+// Find, batch'in kopyasını döndürür.
+func (m *MemoryBatchStore) Find(id string) (*BatchStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.snapshot(id)
+}
+
+// snapshot, kilit altında batch'in kopyasını döndürür.
+func (m *MemoryBatchStore) snapshot(id string) (*BatchStatus, error) {
+	batch, ok := m.batches[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBatchNotFound, id)
+	}
+
+	copied := *batch
+	copied.FailedJobIDs = append([]string(nil), batch.FailedJobIDs...)
+	return &copied, nil
+}
+
+// finish, son job bittiyse bitiş zamanını işaretler.
+func (m *MemoryBatchStore) finish(batch *BatchStatus) {
+	if batch.PendingJobs <= 0 && batch.FinishedAt == nil {
+		now := time.Now()
+		batch.PendingJobs = 0
+		batch.FinishedAt = &now
+	}
+}
+
+// RecordSuccess, başarılı job'ı işler.
+func (m *MemoryBatchStore) RecordSuccess(id, jobID string) (*BatchStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batch, ok := m.batches[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBatchNotFound, id)
+	}
+
+	batch.PendingJobs--
+	m.finish(batch)
+	return m.snapshot(id)
+}
+
+// RecordFailure, başarısız job'ı işler.
+func (m *MemoryBatchStore) RecordFailure(id, jobID string) (*BatchStatus, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batch, ok := m.batches[id]
+	if !ok {
+		return nil, false, fmt.Errorf("%w: %s", ErrBatchNotFound, id)
+	}
+
+	batch.PendingJobs--
+	batch.FailedJobs++
+	batch.FailedJobIDs = append(batch.FailedJobIDs, jobID)
+	m.finish(batch)
+
+	status, err := m.snapshot(id)
+	return status, batch.FailedJobs == 1, err
+}
+
+// RedisBatchStore, batch'leri Redis'te saklar.
+type RedisBatchStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisBatchStore, yeni bir RedisBatchStore oluşturur.
+//
+// Örnek:
+//
+//	queue.SetBatchStore(queue.NewRedisBatchStore(redisClient, "conduit:"))
+func NewRedisBatchStore(client *redis.Client, prefix string) *RedisBatchStore {
+	return &RedisBatchStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// batchKey, batch hash'inin Redis key'i.
+func (r *RedisBatchStore) batchKey(id string) string {
+	return r.prefix + "batches:" + id
+}
+
+// failedKey, başarısız job ID'lerinin Redis key'i.
+func (r *RedisBatchStore) failedKey(id string) string {
+	return r.prefix + "batches:" + id + ":failed"
+}
+
+// Create, yeni batch'i kaydeder.
+func (r *RedisBatchStore) Create(batch *BatchStatus) error {
+	ctx := context.Background()
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, r.batchKey(batch.ID), map[string]interface{}{
+			"name":       batch.Name,
+			"total":      batch.TotalJobs,
+			"pending":    batch.PendingJobs,
+			"failed":     batch.FailedJobs,
+			"then":       batch.ThenCallback,
+			"catch":      batch.CatchCallback,
+			"created_at": batch.CreatedAt.Unix(),
+		})
+		pipe.Expire(ctx, r.batchKey(batch.ID), batchTTL)
+		return nil
+	})
+	return err
+}
+
+// Find, batch'in güncel durumunu döndürür.
+func (r *RedisBatchStore) Find(id string) (*BatchStatus, error) {
+	ctx := context.Background()
+
+	fields, err := r.client.HGetAll(ctx, r.batchKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrBatchNotFound, id)
+	}
+
+	failedIDs, err := r.client.SMembers(ctx, r.failedKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &BatchStatus{
+		ID:            id,
+		Name:          fields["name"],
+		ThenCallback:  fields["then"],
+		CatchCallback: fields["catch"],
+		FailedJobIDs:  failedIDs,
+	}
+	status.TotalJobs, _ = strconv.Atoi(fields["total"])
+	status.PendingJobs, _ = strconv.Atoi(fields["pending"])
+	status.FailedJobs, _ = strconv.Atoi(fields["failed"])
+
+	if createdAt, err := strconv.ParseInt(fields["created_at"], 10, 64); err == nil {
+		status.CreatedAt = time.Unix(createdAt, 0)
+	}
+	if finishedAt, err := strconv.ParseInt(fields["finished_at"], 10, 64); err == nil {
+		t := time.Unix(finishedAt, 0)
+		status.FinishedAt = &t
+	}
+
+	return status, nil
+}
+
+// RecordSuccess, başarılı job'ı işler.
+func (r *RedisBatchStore) RecordSuccess(id, jobID string) (*BatchStatus, error) {
+	ctx := context.Background()
+
+	pending, err := r.client.HIncrBy(ctx, r.batchKey(id), "pending", -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if pending == 0 {
+		r.client.HSet(ctx, r.batchKey(id), "finished_at", time.Now().Unix())
+	}
+	return r.Find(id)
+}
+
+// RecordFailure, başarısız job'ı işler.
+func (r *RedisBatchStore) RecordFailure(id, jobID string) (*BatchStatus, bool, error) {
+	ctx := context.Background()
+
+	var pending, failed *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pending = pipe.HIncrBy(ctx, r.batchKey(id), "pending", -1)
+		failed = pipe.HIncrBy(ctx, r.batchKey(id), "failed", 1)
+		pipe.SAdd(ctx, r.failedKey(id), jobID)
+		pipe.Expire(ctx, r.failedKey(id), batchTTL)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if pending.Val() == 0 {
+		r.client.HSet(ctx, r.batchKey(id), "finished_at", time.Now().Unix())
+	}
+
+	status, err := r.Find(id)
+	return status, failed.Val() == 1, err
+}
@@ -0,0 +1,176 @@
+// -----------------------------------------------------------------------------
+// Dead Letter Queue
+// -----------------------------------------------------------------------------
+// MaxAttempts'i tükenen job'lar failed_jobs'a ek olarak bir dead letter
+// hedefine de gönderilebilir; böylece harici sistemler (monitoring, replay
+// servisleri) "zehirli" mesajları veritabanına erişmeden inceleyebilir.
+//
+// Hedefler:
+// - RedisDeadLetterSink: Redis Stream'e XADD (dead_letter:{name})
+// - QueueDeadLetterSink: Job'ı aynı driver'daki başka bir kuyruğa ekler
+//   (conduit queue:work --queue=dead-letter ile tekrar işlenebilir)
+//
+// Kullanım:
+//
+//	worker.SetDeadLetterSink(queue.NewRedisDeadLetterSink(client, "conduit:", "dead-letter", 10000))
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DeadLetter, dead letter hedefine gönderilen kayıttır.
+type DeadLetter struct {
+	Queue     string      `json:"queue"`     // Job'ın başarısız olduğu kuyruk
+	Exception string      `json:"exception"` // Son hata mesajı
+	FailedAt  time.Time   `json:"failed_at"`
+	Job       *JobPayload `json:"job"` // Queue'da saklanan biçimiyle job
+}
+
+// NewDeadLetter, job ve hatadan bir DeadLetter oluşturur.
+func NewDeadLetter(queue string, job Job, err error) (*DeadLetter, error) {
+	payload, perr := newJobPayload(job, 0)
+	if perr != nil {
+		return nil, fmt.Errorf("dead letter payload oluşturulamadı: %w", perr)
+	}
+	payload.Queue = queue
+
+	exception := ""
+	if err != nil {
+		exception = err.Error()
+	}
+
+	return &DeadLetter{
+		Queue:     queue,
+		Exception: exception,
+		FailedAt:  time.Now(),
+		Job:       payload,
+	}, nil
+}
+
+// DeadLetterSink, kalıcı olarak başarısız job'ların gönderildiği hedeftir.
+type DeadLetterSink interface {
+	// Send, kaydı hedefe gönderir.
+	Send(letter *DeadLetter) error
+}
+
+// sendDeadLetter, sink ayarlıysa job'ı dead letter hedefine gönderir.
+//
+// Gönderim hatası job akışını etkilemez, sadece loglanır.
+func sendDeadLetter(sink DeadLetterSink, queue string, job Job, jobErr error, logger *log.Logger) {
+	if sink == nil {
+		return
+	}
+
+	letter, err := NewDeadLetter(queue, job, jobErr)
+	if err == nil {
+		err = sink.Send(letter)
+	}
+	if err != nil {
+		logWith(logger, "⚠️  Dead letter gönderilemedi: %s (%v%s)", job.GetID(), err, traceTag(job))
+		return
+	}
+
+	logWith(logger, "☠️  Job dead letter'a gönderildi: %s (queue: %s%s)", job.GetID(), queue, traceTag(job))
+}
+
+// RedisDeadLetterSink, kayıtları bir Redis Stream'e ekler.
+//
+// Stream entry alanları: id, type, queue, request_id, exception, failed_at,
+// payload (JobPayload JSON'ı). Harici bir servis payload'ı
+// queues:{queue} listesine RPUSH ederek job'ı tekrar oynatabilir.
+type RedisDeadLetterSink struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+// NewRedisDeadLetterSink, yeni bir RedisDeadLetterSink oluşturur.
+//
+// Parametreler:
+//   - client: Redis client
+//   - prefix: Key prefix (örn: "conduit:")
+//   - name: Stream adı; key {prefix}dead_letter:{name} olur
+//   - maxLen: Stream'de tutulacak yaklaşık kayıt sayısı (0 = limitsiz)
+func NewRedisDeadLetterSink(client *redis.Client, prefix, name string, maxLen int64) *RedisDeadLetterSink {
+	return &RedisDeadLetterSink{
+		client: client,
+		stream: prefix + "dead_letter:" + name,
+		maxLen: maxLen,
+	}
+}
+
+// Stream, kayıtların eklendiği stream key'ini döndürür.
+func (r *RedisDeadLetterSink) Stream() string {
+	return r.stream
+}
+
+// Send, kaydı stream'e ekler.
+func (r *RedisDeadLetterSink) Send(letter *DeadLetter) error {
+	payload, err := json.Marshal(letter.Job)
+	if err != nil {
+		return fmt.Errorf("dead letter encode hatası: %w", err)
+	}
+
+	args := &redis.XAddArgs{
+		Stream: r.stream,
+		Values: map[string]interface{}{
+			"id":         letter.Job.ID,
+			"type":       letter.Job.Type,
+			"queue":      letter.Queue,
+			"request_id": letter.Job.RequestID,
+			"exception":  letter.Exception,
+			"failed_at":  letter.FailedAt.Format(time.RFC3339),
+			"payload":    string(payload),
+		},
+	}
+	if r.maxLen > 0 {
+		args.MaxLen = r.maxLen
+		args.Approx = true
+	}
+
+	if err := r.client.XAdd(context.Background(), args).Err(); err != nil {
+		return fmt.Errorf("dead letter stream'e eklenemedi: %w", err)
+	}
+	return nil
+}
+
+// QueueDeadLetterSink, job'ı deneme sayısı sıfırlanmış olarak başka bir
+// kuyruğa ekler.
+type QueueDeadLetterSink struct {
+	queue Queue
+	name  string
+}
+
+// NewQueueDeadLetterSink, yeni bir QueueDeadLetterSink oluşturur.
+//
+// Örnek:
+//
+//	worker.SetDeadLetterSink(queue.NewQueueDeadLetterSink(redisQueue, "dead-letter"))
+func NewQueueDeadLetterSink(q Queue, name string) *QueueDeadLetterSink {
+	return &QueueDeadLetterSink{
+		queue: q,
+		name:  name,
+	}
+}
+
+// Send, job'ı dead letter kuyruğuna ekler.
+func (s *QueueDeadLetterSink) Send(letter *DeadLetter) error {
+	payload := *letter.Job
+	payload.Attempts = 0
+
+	job, err := jobFromPayload(&payload)
+	if err != nil {
+		return fmt.Errorf("dead letter job'u oluşturulamadı: %w", err)
+	}
+
+	return s.queue.Push(job, s.name)
+}
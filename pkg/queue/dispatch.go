@@ -0,0 +1,89 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/requestid"
+	"github.com/google/uuid"
+)
+
+// Dispatch, job'ı context'teki request ID ile birlikte kuyruğa ekler.
+//
+// HTTP handler'larından Push yerine bu fonksiyon kullanılmalıdır; request ID
+// job payload'ına, worker loglarına ve failed job kayıtlarına taşınır.
+// Böylece başarısız bir job, onu tetikleyen isteğe kadar izlenebilir.
+//
+// Parametreler:
+//   - ctx: İsteğin context'i (r.Context())
+//   - q: Queue driver
+//   - job: Kuyruğa eklenecek job
+//   - queueName: Kuyruk adı
+//
+// Döndürür:
+//   - error: Push hatası
+//
+// Örnek:
+//
+//	err := queue.Dispatch(r.Context(), uc.Queue, job, "emails")
+func Dispatch(ctx context.Context, q Queue, job Job, queueName string) error {
+	return DispatchLater(ctx, q, 0, job, queueName)
+}
+
+// DispatchLater, job'ı request ID ile birlikte gecikmeli olarak kuyruğa ekler.
+//
+// Job ShouldBeUnique implement ediyorsa ve aynı unique ID ile bekleyen bir
+// job varsa yeni job kuyruğa eklenmez ve nil döner (bkz: unique.go).
+// Kuyruğa eklenen job için job.queued event'i yayınlanır (bkz: events.go).
+//
+// Örnek:
+//
+//	err := queue.DispatchLater(r.Context(), q, 5*time.Minute, job, "emails")
+func DispatchLater(ctx context.Context, q Queue, delay time.Duration, job Job, queueName string) error {
+	if traceable, ok := job.(Traceable); ok && traceable.GetRequestID() == "" {
+		traceable.SetRequestID(requestid.FromContext(ctx))
+	}
+
+	acquired, err := acquireUniqueLock(job)
+	if err != nil {
+		return fmt.Errorf("unique job lock alınamadı: %w", err)
+	}
+	if !acquired {
+		log.Printf("⏭️  Duplicate job skipped: %s (%s%s)", JobTypeName(job), uniqueLockKey(job), traceTag(job))
+		return nil
+	}
+
+	// job.queued event'i ID taşısın diye ID driver'dan önce verilir
+	if job.GetID() == "" {
+		job.SetID(uuid.New().String())
+	}
+
+	queued := newJobEvent(queueName, job, 0, nil)
+	queued.Attempts = job.GetAttempts()
+	queued.Delay = delay
+
+	// Sync queue job'ı Push içinde çalıştırır; queued event'i started'dan
+	// önce gelmeli
+	_, isSync := q.(*SyncQueue)
+	if isSync {
+		fireJobEvent(EventJobQueued, queued)
+	}
+
+	if delay > 0 {
+		err = q.Later(delay, job, queueName)
+	} else {
+		err = q.Push(job, queueName)
+	}
+
+	if !isSync {
+		if err != nil {
+			// Kuyruğa eklenemediyse lock'u bırak (sync queue kendisi bırakır)
+			releaseUniqueLock(job, nil)
+		} else {
+			fireJobEvent(EventJobQueued, queued)
+		}
+	}
+	return err
+}
@@ -0,0 +1,110 @@
+// -----------------------------------------------------------------------------
+// Encrypted Jobs
+// -----------------------------------------------------------------------------
+// ShouldBeEncrypted implement eden job'ların payload'ı kuyruğa (Redis, SQS,
+// NATS), failed_jobs'a ve dead letter'a APP_KEY ile şifrelenmiş yazılır.
+// Wrapper metadata'sı (ID, tip, queue, deneme sayısı) düz kalır; worker job'ı
+// bunlarla yönlendirir.
+//
+//	type ChargeCardJob struct {
+//	    queue.BaseJob
+//	    CardToken string `json:"card_token"`
+//	}
+//
+//	func (j *ChargeCardJob) ShouldBeEncrypted() bool { return true }
+//
+// Job'ı kuyruğa ekleyen process ve worker aynı APP_KEY ile başlatılmalıdır:
+// queue.SetEncrypter(encrypter)
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/biyonik/conduit-go/pkg/crypt"
+)
+
+// ShouldBeEncrypted, payload'ı şifrelenerek saklanacak job'lar için
+// opsiyonel interface.
+type ShouldBeEncrypted interface {
+	ShouldBeEncrypted() bool
+}
+
+// ErrEncrypterNotSet, şifreli bir job için encrypter ayarlanmamışsa döner.
+var ErrEncrypterNotSet = errors.New("queue encrypter ayarlanmamış (APP_KEY / queue.SetEncrypter)")
+
+// Global payload encrypter
+var (
+	payloadEncrypter   *crypt.Encrypter
+	payloadEncrypterMu sync.RWMutex
+)
+
+// SetEncrypter, ShouldBeEncrypted job'ların payload'larını şifreleyen
+// encrypter'ı ayarlar. nil şifrelemeyi kapatır; bu durumda şifreli job'lar
+// kuyruğa eklenemez ve çözülemez.
+//
+// Örnek:
+//
+//	queue.SetEncrypter(container.MustResolve[*crypt.Encrypter](c))
+func SetEncrypter(encrypter *crypt.Encrypter) {
+	payloadEncrypterMu.Lock()
+	defer payloadEncrypterMu.Unlock()
+	payloadEncrypter = encrypter
+}
+
+// getEncrypter, ayarlı encrypter'ı döndürür (yoksa nil).
+func getEncrypter() *crypt.Encrypter {
+	payloadEncrypterMu.RLock()
+	defer payloadEncrypterMu.RUnlock()
+	return payloadEncrypter
+}
+
+// shouldEncrypt, job'ın payload'ının şifrelenip şifrelenmeyeceğini döndürür.
+func shouldEncrypt(job Job) bool {
+	encrypted, ok := job.(ShouldBeEncrypted)
+	return ok && encrypted.ShouldBeEncrypted()
+}
+
+// sealPayload, job verisini şifreler ve JSON string olarak döndürür. Job tipi
+// ek veri (AAD) olarak kullanılır; şifreli payload başka bir job tipine
+// taşınamaz.
+func sealPayload(jobType string, data []byte) (json.RawMessage, error) {
+	encrypter := getEncrypter()
+	if encrypter == nil {
+		return nil, fmt.Errorf("%s şifrelenemedi: %w", jobType, ErrEncrypterNotSet)
+	}
+
+	sealed, err := encrypter.Encrypt(data, []byte(jobType))
+	if err != nil {
+		return nil, fmt.Errorf("%s şifrelenemedi: %w", jobType, err)
+	}
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(sealed))
+}
+
+// openPayload, sealPayload ile şifrelenmiş job verisini çözer.
+func openPayload(jobType string, data json.RawMessage) ([]byte, error) {
+	encrypter := getEncrypter()
+	if encrypter == nil {
+		return nil, fmt.Errorf("%s çözülemedi: %w", jobType, ErrEncrypterNotSet)
+	}
+
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("%s çözülemedi: %w", jobType, crypt.ErrInvalidPayload)
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s çözülemedi: %w", jobType, crypt.ErrInvalidPayload)
+	}
+
+	plaintext, err := encrypter.Decrypt(sealed, []byte(jobType))
+	if err != nil {
+		return nil, fmt.Errorf("%s çözülemedi: %w", jobType, err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,95 @@
+// -----------------------------------------------------------------------------
+// Job Lifecycle Events
+// -----------------------------------------------------------------------------
+// Queue, job yaşam döngüsündeki adımları pkg/events üzerinden yayınlar;
+// metrik ve alarm listener'ları job'lara dokunmadan kuyruğu izleyebilir:
+//
+//	queue.SetEventDispatcher(dispatcher)
+//
+//	dispatcher.Listen(queue.EventJobFailed, events.ListenerFunc(func(e events.Event) error {
+//	    job := e.Payload().(*queue.JobEvent)
+//	    alert("%s failed on %s: %v", job.Type, job.Queue, job.Error)
+//	    return nil
+//	}))
+//
+// Event'ler job'ı işleyen goroutine'de senkron dispatch edilir; yavaş
+// listener'lar worker'ı yavaşlatır (gerekirse events.NewAsyncListener).
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// Job event adları
+const (
+	EventJobQueued    = "job.queued"    // Kuyruğa eklendi (Dispatch/DispatchLater)
+	EventJobStarted   = "job.started"   // İşlenmeye başladı
+	EventJobSucceeded = "job.succeeded" // Başarıyla bitti
+	EventJobFailed    = "job.failed"    // Kalıcı olarak başarısız (MaxAttempts tükendi)
+	EventJobRetrying  = "job.retrying"  // Başarısız oldu, tekrar denenecek
+)
+
+// JobEvent, job event'lerinin payload'ıdır.
+type JobEvent struct {
+	JobID     string
+	Type      string // Job tipi (örn: *jobs.SendEmailJob)
+	Queue     string
+	Attempts  int           // Kaçıncı deneme (1'den başlar; queued: önceki deneme sayısı)
+	Duration  time.Duration // Çalışma süresi (succeeded/failed/retrying)
+	Delay     time.Duration // Gecikme (queued) veya retry gecikmesi (retrying)
+	Error     error         // Hata (failed/retrying)
+	RequestID string
+	BatchID   string
+}
+
+// Global event dispatcher
+var (
+	eventDispatcher   *events.Dispatcher
+	eventDispatcherMu sync.RWMutex
+)
+
+// SetEventDispatcher, job event'lerinin yayınlanacağı dispatcher'ı ayarlar
+// (nil = event yayınlanmaz).
+func SetEventDispatcher(dispatcher *events.Dispatcher) {
+	eventDispatcherMu.Lock()
+	defer eventDispatcherMu.Unlock()
+
+	eventDispatcher = dispatcher
+}
+
+// fireJobEvent, dispatcher ayarlıysa ve event'i dinleyen varsa yayınlar.
+//
+// Listener hataları dispatcher tarafından loglanır; job akışını etkilemez.
+func fireJobEvent(name string, event *JobEvent) {
+	eventDispatcherMu.RLock()
+	dispatcher := eventDispatcher
+	eventDispatcherMu.RUnlock()
+
+	if dispatcher == nil || !dispatcher.HasListeners(name) {
+		return
+	}
+
+	dispatcher.Dispatch(events.NewBaseEvent(name, event))
+}
+
+// newJobEvent, işlenen job'dan event payload'ı oluşturur.
+//
+// Driver'lar deneme sayısını Release sırasında artırdığı için işlenen
+// denemenin numarası GetAttempts()+1'dir (worker log'larıyla aynı).
+func newJobEvent(queue string, job Job, duration time.Duration, err error) *JobEvent {
+	return &JobEvent{
+		JobID:     job.GetID(),
+		Type:      JobTypeName(job),
+		Queue:     queue,
+		Attempts:  job.GetAttempts() + 1,
+		Duration:  duration,
+		Error:     err,
+		RequestID: RequestIDOf(job),
+		BatchID:   BatchIDOf(job),
+	}
+}
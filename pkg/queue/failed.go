@@ -0,0 +1,306 @@
+// -----------------------------------------------------------------------------
+// Failed Jobs
+// -----------------------------------------------------------------------------
+// MaxAttempts'i tükenen job'lar failed_jobs tablosuna kaydedilir; böylece
+// hata sebebi incelenip job tekrar kuyruğa alınabilir:
+//
+//	conduit queue:failed           -> kayıtları listeler
+//	conduit queue:retry 5          -> #5'i tekrar kuyruğa alır
+//	conduit queue:retry all        -> hepsini tekrar kuyruğa alır
+//	conduit queue:forget 5         -> #5'i siler
+//
+// Job, queue'da saklanan JobPayload JSON'ı olarak kaydedilir; retry sırasında
+// job tipi registry'den oluşturulur ve deneme sayısı sıfırlanır.
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/database"
+)
+
+// FailedJobsTable, failed job kayıtlarının tutulduğu tablo.
+const FailedJobsTable = "failed_jobs"
+
+// ErrFailedJobNotFound, istenen failed job kaydı yoksa döner.
+var ErrFailedJobNotFound = errors.New("failed job bulunamadı")
+
+// FailedJob, failed_jobs tablosundaki bir kayıttır.
+type FailedJob struct {
+	ID        int64     `json:"id" db:"id"`
+	UUID      string    `json:"uuid" db:"uuid"`           // Job ID'si
+	Queue     string    `json:"queue" db:"queue"`         // Kuyruk adı
+	Payload   string    `json:"payload" db:"payload"`     // JobPayload JSON'ı
+	Exception string    `json:"exception" db:"exception"` // Son hata mesajı
+	FailedAt  time.Time `json:"failed_at" db:"failed_at"`
+}
+
+// JobPayload, kayıtlı payload'ı decode eder.
+func (f *FailedJob) JobPayload() (*JobPayload, error) {
+	var payload JobPayload
+	if err := json.Unmarshal([]byte(f.Payload), &payload); err != nil {
+		return nil, fmt.Errorf("failed job payload decode hatası: %w", err)
+	}
+	return &payload, nil
+}
+
+// FailedJobProvider, failed job kayıtlarını saklayan arayüzdür.
+type FailedJobProvider interface {
+	// Log, başarısız job'ı kaydeder ve kayıt ID'sini döndürür.
+	Log(queue string, job Job, err error) (int64, error)
+
+	// All, kayıtları en yeniden eskiye doğru döndürür.
+	All() ([]FailedJob, error)
+
+	// Find, ID ile kayıt döndürür (yoksa ErrFailedJobNotFound).
+	Find(id int64) (*FailedJob, error)
+
+	// Forget, kaydı siler; kayıt yoksa false döner.
+	Forget(id int64) (bool, error)
+
+	// Flush, tüm kayıtları siler ve silinen sayıyı döndürür.
+	Flush() (int64, error)
+}
+
+// newFailedJob, job ve hatadan kayıt oluşturur.
+func newFailedJob(queue string, job Job, err error) (*FailedJob, error) {
+	payload, perr := newJobPayload(job, 0)
+	if perr != nil {
+		return nil, fmt.Errorf("failed job payload oluşturulamadı: %w", perr)
+	}
+	payload.Queue = queue
+
+	data, perr := json.Marshal(payload)
+	if perr != nil {
+		return nil, fmt.Errorf("failed job payload encode hatası: %w", perr)
+	}
+
+	exception := ""
+	if err != nil {
+		exception = err.Error()
+	}
+
+	return &FailedJob{
+		UUID:      job.GetID(),
+		Queue:     queue,
+		Payload:   string(data),
+		Exception: exception,
+		FailedAt:  time.Now(),
+	}, nil
+}
+
+// RetryFailed, failed job'ı deneme sayısı sıfırlanmış olarak kendi kuyruğuna
+// tekrar ekler.
+//
+// Kayıt silinmez; başarılı push sonrası Forget çağrılmalıdır.
+//
+// Örnek:
+//
+//	if err := queue.RetryFailed(q, failed); err == nil {
+//	    provider.Forget(failed.ID)
+//	}
+func RetryFailed(q Queue, failed *FailedJob) error {
+	payload, err := failed.JobPayload()
+	if err != nil {
+		return err
+	}
+	payload.Attempts = 0
+
+	job, err := jobFromPayload(payload)
+	if err != nil {
+		return fmt.Errorf("failed job #%d oluşturulamadı: %w", failed.ID, err)
+	}
+
+	return q.Push(job, failed.Queue)
+}
+
+// DatabaseFailedJobProvider, failed job'ları veritabanında saklar.
+type DatabaseFailedJobProvider struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+// NewDatabaseFailedJobProvider, yeni bir DatabaseFailedJobProvider oluşturur.
+//
+// Örnek:
+//
+//	provider := queue.NewDatabaseFailedJobProvider(db, grammar)
+//	worker.SetFailedJobProvider(provider)
+func NewDatabaseFailedJobProvider(db *sql.DB, grammar database.Grammar) *DatabaseFailedJobProvider {
+	return &DatabaseFailedJobProvider{
+		db:      db,
+		grammar: grammar,
+	}
+}
+
+// newBuilder, provider için yeni bir QueryBuilder oluşturur.
+func (p *DatabaseFailedJobProvider) newBuilder() *database.QueryBuilder {
+	return database.NewBuilder(p.db, p.grammar).Table(FailedJobsTable)
+}
+
+// CreateTable, failed_jobs tablosunu yoksa oluşturur.
+func (p *DatabaseFailedJobProvider) CreateTable() error {
+	_, err := p.db.Exec(`
+		CREATE TABLE IF NOT EXISTS failed_jobs (
+			id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+			uuid VARCHAR(64) NOT NULL,
+			queue VARCHAR(255) NOT NULL,
+			payload LONGTEXT NOT NULL,
+			exception LONGTEXT NOT NULL,
+			failed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX failed_jobs_uuid_index (uuid)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`)
+	if err != nil {
+		return fmt.Errorf("failed_jobs tablosu oluşturulamadı: %w", err)
+	}
+	return nil
+}
+
+// Log, başarısız job'ı kaydeder.
+func (p *DatabaseFailedJobProvider) Log(queue string, job Job, err error) (int64, error) {
+	failed, ferr := newFailedJob(queue, job, err)
+	if ferr != nil {
+		return 0, ferr
+	}
+
+	result, ferr := p.newBuilder().ExecInsert(map[string]interface{}{
+		"uuid":      failed.UUID,
+		"queue":     failed.Queue,
+		"payload":   failed.Payload,
+		"exception": failed.Exception,
+		"failed_at": failed.FailedAt,
+	})
+	if ferr != nil {
+		return 0, fmt.Errorf("failed job kaydedilemedi: %w", ferr)
+	}
+
+	return result.LastInsertId()
+}
+
+// All, kayıtları en yeniden eskiye doğru döndürür.
+func (p *DatabaseFailedJobProvider) All() ([]FailedJob, error) {
+	var failed []FailedJob
+	if err := p.newBuilder().OrderBy("id", "DESC").Get(&failed); err != nil {
+		return nil, fmt.Errorf("failed job'lar okunamadı: %w", err)
+	}
+	return failed, nil
+}
+
+// Find, ID ile kayıt döndürür.
+func (p *DatabaseFailedJobProvider) Find(id int64) (*FailedJob, error) {
+	var failed FailedJob
+	err := p.newBuilder().Where("id", "=", id).First(&failed)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: #%d", ErrFailedJobNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &failed, nil
+}
+
+// Forget, kaydı siler.
+func (p *DatabaseFailedJobProvider) Forget(id int64) (bool, error) {
+	result, err := p.newBuilder().Where("id", "=", id).ExecDelete()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// Flush, tüm kayıtları siler.
+func (p *DatabaseFailedJobProvider) Flush() (int64, error) {
+	result, err := p.newBuilder().ExecDelete()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// MemoryFailedJobProvider, failed job'ları bellekte saklar (test/sync için).
+type MemoryFailedJobProvider struct {
+	mu     sync.RWMutex
+	nextID int64
+	jobs   map[int64]FailedJob
+}
+
+// NewMemoryFailedJobProvider, yeni bir MemoryFailedJobProvider oluşturur.
+func NewMemoryFailedJobProvider() *MemoryFailedJobProvider {
+	return &MemoryFailedJobProvider{
+		jobs: make(map[int64]FailedJob),
+	}
+}
+
+// Log, başarısız job'ı kaydeder.
+func (p *MemoryFailedJobProvider) Log(queue string, job Job, err error) (int64, error) {
+	failed, ferr := newFailedJob(queue, job, err)
+	if ferr != nil {
+		return 0, ferr
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.nextID++
+	failed.ID = p.nextID
+	p.jobs[failed.ID] = *failed
+	return failed.ID, nil
+}
+
+// All, kayıtları en yeniden eskiye doğru döndürür.
+func (p *MemoryFailedJobProvider) All() ([]FailedJob, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	failed := make([]FailedJob, 0, len(p.jobs))
+	for _, job := range p.jobs {
+		failed = append(failed, job)
+	}
+	sort.Slice(failed, func(i, j int) bool { return failed[i].ID > failed[j].ID })
+	return failed, nil
+}
+
+// Find, ID ile kayıt döndürür.
+func (p *MemoryFailedJobProvider) Find(id int64) (*FailedJob, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	failed, ok := p.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: #%d", ErrFailedJobNotFound, id)
+	}
+	return &failed, nil
+}
+
+// Forget, kaydı siler.
+func (p *MemoryFailedJobProvider) Forget(id int64) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.jobs[id]; !ok {
+		return false, nil
+	}
+	delete(p.jobs, id)
+	return true, nil
+}
+
+// Flush, tüm kayıtları siler.
+func (p *MemoryFailedJobProvider) Flush() (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	count := int64(len(p.jobs))
+	p.jobs = make(map[int64]FailedJob)
+	return count, nil
+}
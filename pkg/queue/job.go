@@ -7,13 +7,15 @@
 // - Handle(): İşi yapar
 // - Failed(): Başarısız olursa çağrılır
 // - GetPayload/SetPayload: Serialization
-// - Metadata: ID, attempts, queue name
+// - Metadata: ID, attempts, queue name, timeout
 // -----------------------------------------------------------------------------
 
 package queue
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"time"
 )
 
@@ -92,6 +94,11 @@ type BaseJob struct {
 	Queue       string `json:"queue"`
 	Attempts    int    `json:"attempts"`
 	MaxAttempts int    `json:"max_attempts"`
+	RequestID   string `json:"request_id,omitempty"` // Job'ı dispatch eden HTTP isteğinin ID'si
+	BatchID     string `json:"batch_id,omitempty"`   // Job'ın ait olduğu batch (bkz: batch.go)
+
+	// Timeout, job'ın en fazla ne kadar çalışabileceği (0 = worker varsayılanı).
+	Timeout time.Duration `json:"timeout,omitempty"`
 }
 
 // GetID, job ID'sini döndürür.
@@ -132,16 +139,114 @@ func (b *BaseJob) GetMaxAttempts() int {
 	return b.MaxAttempts
 }
 
+// GetRequestID, job'ı dispatch eden isteğin ID'sini döndürür.
+func (b *BaseJob) GetRequestID() string {
+	return b.RequestID
+}
+
+// SetRequestID, job'ı dispatch eden isteğin ID'sini set eder.
+func (b *BaseJob) SetRequestID(id string) {
+	b.RequestID = id
+}
+
+// GetBatchID, job'ın ait olduğu batch'in ID'sini döndürür.
+func (b *BaseJob) GetBatchID() string {
+	return b.BatchID
+}
+
+// SetBatchID, job'ın ait olduğu batch'in ID'sini set eder.
+func (b *BaseJob) SetBatchID(id string) {
+	b.BatchID = id
+}
+
+// GetTimeout, job'ın çalışma süresi limitini döndürür.
+func (b *BaseJob) GetTimeout() time.Duration {
+	return b.Timeout
+}
+
+// SetTimeout, job'ın çalışma süresi limitini set eder.
+func (b *BaseJob) SetTimeout(timeout time.Duration) {
+	b.Timeout = timeout
+}
+
+// ErrJobTimeout, job Timeout süresi içinde bitmezse döner.
+//
+// Timeout bir deneme sayılır. ContextJob'lar MaxAttempts'e kadar tekrar
+// denenir; iptal sinyali alamayan düz Job'lar sonuna kadar çalıştığı için
+// tekrar denenmez, doğrudan failed_jobs'a kaydedilir.
+var ErrJobTimeout = errors.New("job timeout")
+
+// Timeoutable, kendi çalışma süresi limitini taşıyan job'lar için opsiyonel
+// interface. BaseJob gömen tüm job'lar bunu implement eder.
+type Timeoutable interface {
+	GetTimeout() time.Duration
+	SetTimeout(timeout time.Duration)
+}
+
+// TimeoutOf, job'ın kendi timeout'unu döndürür (yoksa 0).
+func TimeoutOf(job Job) time.Duration {
+	if timeoutable, ok := job.(Timeoutable); ok {
+		return timeoutable.GetTimeout()
+	}
+	return 0
+}
+
+// ContextJob, timeout/iptal sinyalini dinleyebilen job'lar için opsiyonel
+// interface.
+//
+// Worker bu interface'i implement eden job'larda Handle yerine HandleContext'i
+// çağırır. Go'da goroutine dışarıdan öldürülemediği için worker timeout'u aşan
+// bir Handle'ın bitmesini bekler ve job'ı tekrar denemeden başarısız sayar;
+// timeout'ta tekrar denenmesi gereken uzun job'lar ctx.Done()'ı dinleyip
+// erken dönmelidir.
+//
+// Örnek:
+//
+//	func (j *ExportJob) HandleContext(ctx context.Context) error {
+//	    rows, err := db.QueryContext(ctx, "SELECT ...")
+//	    ...
+//	}
+type ContextJob interface {
+	HandleContext(ctx context.Context) error
+}
+
+// Traceable, dispatch edildiği isteğin ID'sini taşıyabilen job'lar için
+// opsiyonel interface. BaseJob gömen tüm job'lar bunu implement eder.
+type Traceable interface {
+	GetRequestID() string
+	SetRequestID(id string)
+}
+
+// RequestIDOf, job'ın taşıdığı request ID'yi döndürür (yoksa boş string).
+func RequestIDOf(job Job) string {
+	if traceable, ok := job.(Traceable); ok {
+		return traceable.GetRequestID()
+	}
+	return ""
+}
+
+// traceTag, log satırlarına eklenecek ", request: <id>" etiketini döndürür.
+func traceTag(job Job) string {
+	if id := RequestIDOf(job); id != "" {
+		return ", request: " + id
+	}
+	return ""
+}
+
 // JobPayload, queue'da saklanan job wrapper'ı.
 //
 // Bu struct job'ı metadata ile birlikte saklar.
 type JobPayload struct {
 	ID          string          `json:"id"`
-	Type        string          `json:"type"`         // Job tipi (SendEmailJob, ProcessUploadJob, vb.)
-	Queue       string          `json:"queue"`        // Kuyruk adı
-	Payload     json.RawMessage `json:"payload"`      // Gerçek job data
-	Attempts    int             `json:"attempts"`     // Deneme sayısı
-	MaxAttempts int             `json:"max_attempts"` // Maksimum deneme
-	CreatedAt   time.Time       `json:"created_at"`   // Oluşturulma zamanı
-	AvailableAt time.Time       `json:"available_at"` // İşlenebilir olacağı zaman (delayed jobs için)
+	Type        string          `json:"type"`                 // Job tipi (SendEmailJob, ProcessUploadJob, vb.)
+	Queue       string          `json:"queue"`                // Kuyruk adı
+	Payload     json.RawMessage `json:"payload"`              // Gerçek job data
+	Encrypted   bool            `json:"encrypted,omitempty"`  // Payload şifreli mi (ShouldBeEncrypted)
+	Attempts    int             `json:"attempts"`             // Deneme sayısı
+	MaxAttempts int             `json:"max_attempts"`         // Maksimum deneme
+	CreatedAt   time.Time       `json:"created_at"`           // Oluşturulma zamanı
+	AvailableAt time.Time       `json:"available_at"`         // İşlenebilir olacağı zaman (delayed jobs için)
+	RequestID   string          `json:"request_id,omitempty"` // Dispatch eden isteğin ID'si (failed job izleme)
+	BatchID     string          `json:"batch_id,omitempty"`   // Ait olduğu batch
+	Timeout     time.Duration   `json:"timeout,omitempty"`    // Çalışma süresi limiti
 }
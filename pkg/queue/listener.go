@@ -0,0 +1,230 @@
+// -----------------------------------------------------------------------------
+// Queued Event Listeners
+// -----------------------------------------------------------------------------
+// events.ShouldQueue listener'larını kuyrukta çalıştırır. Dispatcher'a
+// NewListenerQueue verildiğinde bu listener'lar inline çalışmaz; listener tipi
+// ve event payload'ı CallQueuedListenerJob olarak kuyruğa eklenir.
+//
+// Worker listener'ı RegisterListener ile kaydedilmiş factory'den yeniden
+// oluşturur (dependency'ler serialize edilmez). Payload JSON olarak taşınır;
+// listener'lar payload'a events.DecodePayload ile erişmelidir.
+//
+// Kullanım:
+//
+//	// API ve worker: listener'ı DI'lı factory ile kaydet
+//	queue.RegisterListener(func() *listeners.SendWelcomeEmail {
+//	    return &listeners.SendWelcomeEmail{Mailer: mailer}
+//	})
+//
+//	// API: ShouldQueue listener'ları kuyruğa gitsin
+//	dispatcher.SetQueue(queue.NewListenerQueue(q, "listeners"))
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// DefaultListenerQueue, listener kuyruk adı verilmediğinde kullanılır.
+const DefaultListenerQueue = "listeners"
+
+func init() {
+	RegisterType(func() *CallQueuedListenerJob { return &CallQueuedListenerJob{} })
+}
+
+// Listener factory registry
+var (
+	listenerFactories   = make(map[string]func() events.Listener)
+	listenerFactoriesMu sync.RWMutex
+)
+
+// RegisterListener, kuyrukta çalışacak listener tipini factory ile kaydeder.
+//
+// Type string'i L'den türetilir (örn: "*listeners.SendWelcomeEmail").
+// Worker'da kayıtlı olmayan bir listener'ın job'u başarısız olur.
+//
+// Parametreler:
+//   - factory: Dependency'leri inject edilmiş listener oluşturan fonksiyon
+//
+// Döndürür:
+//   - string: Kaydedilen type string'i
+func RegisterListener[L events.Listener](factory func() L) string {
+	listenerType := reflect.TypeOf((*L)(nil)).Elem().String()
+
+	listenerFactoriesMu.Lock()
+	defer listenerFactoriesMu.Unlock()
+
+	listenerFactories[listenerType] = func() events.Listener {
+		return factory()
+	}
+	return listenerType
+}
+
+// RegisteredListeners, kayıtlı listener tiplerini alfabetik sırayla döndürür.
+func RegisteredListeners() []string {
+	listenerFactoriesMu.RLock()
+	defer listenerFactoriesMu.RUnlock()
+
+	types := make([]string, 0, len(listenerFactories))
+	for listenerType := range listenerFactories {
+		types = append(types, listenerType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// createListener, kayıtlı factory ile listener oluşturur.
+func createListener(listenerType string) (events.Listener, error) {
+	listenerFactoriesMu.RLock()
+	factory, exists := listenerFactories[listenerType]
+	listenerFactoriesMu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("listener tipi register edilmemiş: %s (queue.RegisterListener ile kaydedin)", listenerType)
+	}
+	return factory(), nil
+}
+
+// -----------------------------------------------------------------------------
+// Listener Queue
+// -----------------------------------------------------------------------------
+
+// ListenerQueue, events.ListenerQueue'nun queue driver'ı üzerindeki
+// implementasyonudur.
+type ListenerQueue struct {
+	queue     Queue
+	queueName string
+}
+
+// NewListenerQueue, yeni bir ListenerQueue oluşturur.
+//
+// Parametreler:
+//   - q: Queue driver
+//   - queueName: Varsayılan kuyruk (boş: "listeners"); listener'ın
+//     events.Queued.Queue değeri bunu ezer
+//
+// Örnek:
+//
+//	dispatcher.SetQueue(queue.NewListenerQueue(q, ""))
+func NewListenerQueue(q Queue, queueName string) *ListenerQueue {
+	if queueName == "" {
+		queueName = DefaultListenerQueue
+	}
+	return &ListenerQueue{queue: q, queueName: queueName}
+}
+
+// PushListener, listener'ı event ile birlikte CallQueuedListenerJob olarak
+// kuyruğa ekler.
+func (l *ListenerQueue) PushListener(listener events.ShouldQueue, event events.Event) error {
+	job, err := NewCallQueuedListenerJob(listener, event)
+	if err != nil {
+		return err
+	}
+
+	options := listener.QueueOptions()
+	queueName := options.Queue
+	if queueName == "" {
+		queueName = l.queueName
+	}
+
+	if err := DispatchLater(context.Background(), l.queue, options.Delay, job, queueName); err != nil {
+		return fmt.Errorf("listener kuyruğa eklenemedi (%s): %w", job.Listener, err)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Call Queued Listener Job
+// -----------------------------------------------------------------------------
+
+// CallQueuedListenerJob, kuyruğa alınmış bir listener'ı çalıştıran job'dur.
+type CallQueuedListenerJob struct {
+	BaseJob
+	Listener   string          `json:"listener"`          // Listener tipi (örn: *listeners.SendWelcomeEmail)
+	Event      string          `json:"event"`             // Event adı
+	OccurredAt time.Time       `json:"occurred_at"`       // Event zamanı
+	Payload    json.RawMessage `json:"payload,omitempty"` // Event payload'ı (JSON)
+
+	// Sync queue job'u aynı process'te çalıştırır; bu durumda orijinal
+	// listener ve event kullanılır (serialize edilmez)
+	listener events.Listener
+	event    events.Event
+}
+
+// NewCallQueuedListenerJob, listener ve event için yeni bir job oluşturur
+// (3 deneme).
+//
+// Döndürür:
+//   - error: Event payload'ı JSON'a çevrilemezse
+func NewCallQueuedListenerJob(listener events.ShouldQueue, event events.Event) (*CallQueuedListenerJob, error) {
+	payload, err := json.Marshal(event.Payload())
+	if err != nil {
+		return nil, fmt.Errorf("event payload serialize edilemedi (%s): %w", event.Name(), err)
+	}
+
+	return &CallQueuedListenerJob{
+		BaseJob:    BaseJob{MaxAttempts: 3},
+		Listener:   fmt.Sprintf("%T", listener),
+		Event:      event.Name(),
+		OccurredAt: event.OccurredAt(),
+		Payload:    payload,
+		listener:   listener,
+		event:      event,
+	}, nil
+}
+
+// Handle, listener'ı event ile çalıştırır.
+//
+// Worker'da listener RegisterListener factory'sinden oluşturulur; event'in
+// payload'ı json.RawMessage'dır (events.DecodePayload ile çözülür).
+func (j *CallQueuedListenerJob) Handle() error {
+	listener, event := j.listener, j.event
+	if listener == nil {
+		var err error
+		if listener, err = createListener(j.Listener); err != nil {
+			return err
+		}
+	}
+	if event == nil {
+		event = &queuedEvent{name: j.Event, occurredAt: j.OccurredAt, payload: j.Payload}
+	}
+
+	return listener.Handle(event)
+}
+
+// Failed, job tüm denemelerde başarısız olduğunda çağrılır.
+func (j *CallQueuedListenerJob) Failed(err error) error {
+	log.Printf("❌ Queued listener failed: %s (event: %s, listener: %s, error: %v)", j.ID, j.Event, j.Listener, err)
+	return nil
+}
+
+// GetPayload, job'ı serialize eder.
+func (j *CallQueuedListenerJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j)
+}
+
+// SetPayload, job'ı deserialize eder.
+func (j *CallQueuedListenerJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, j)
+}
+
+// queuedEvent, worker'da payload'dan yeniden oluşturulan event'tir.
+type queuedEvent struct {
+	name       string
+	occurredAt time.Time
+	payload    json.RawMessage
+}
+
+func (e *queuedEvent) Name() string          { return e.name }
+func (e *queuedEvent) OccurredAt() time.Time { return e.occurredAt }
+func (e *queuedEvent) Payload() interface{}  { return e.payload }
@@ -0,0 +1,311 @@
+// -----------------------------------------------------------------------------
+// Queue Metrics Stores
+// -----------------------------------------------------------------------------
+// Worker'ların işlediği job sayılarını ve aktif worker'ları saklayan store'lar
+// (bkz: stats.go).
+//
+// - MemoryMetricsStore: Tek process (sync queue, testler)
+// - RedisMetricsStore: Worker, API ve conduit queue:monitor arasında paylaşılır
+//
+// Redis Data Structures:
+// - queue_metrics:{queue} - Hash (processed, failed toplamları)
+// - queue_metrics:{queue}:{dakika} - Hash (dakikalık sayaçlar, TTL'li)
+// - queue_workers:{queue} - ZSET (worker ID -> son heartbeat zamanı)
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// metricsWindow, processed/failed oranlarının hesaplandığı süre.
+	metricsWindow = 5 * time.Minute
+
+	// workerHeartbeat, worker'ların kendini bildirme aralığı.
+	workerHeartbeat = 10 * time.Second
+
+	// workerTTL, bu süre heartbeat göndermeyen worker ölü sayılır.
+	workerTTL = 3 * workerHeartbeat
+)
+
+// QueueMetrics, bir queue'nun worker sayaçlarıdır.
+type QueueMetrics struct {
+	Processed       int64 // Başarıyla biten job sayısı (toplam)
+	Failed          int64 // Kalıcı olarak başarısız job sayısı (toplam)
+	RecentProcessed int64 // Son metricsWindow içinde başarıyla biten
+	RecentFailed    int64 // Son metricsWindow içinde kalıcı başarısız
+	Workers         int   // Heartbeat'i güncel worker process sayısı
+}
+
+// MetricsStore, queue metriklerini saklayan arayüzdür.
+type MetricsStore interface {
+	// RecordJob, biten bir job'ı sayar (failed: kalıcı olarak başarısız).
+	RecordJob(queue string, failed bool, at time.Time) error
+
+	// Heartbeat, worker'ın queue'ları dinlediğini bildirir.
+	Heartbeat(workerID string, queues []string, at time.Time) error
+
+	// ForgetWorker, duran worker'ın kaydını siler.
+	ForgetWorker(workerID string, queues []string) error
+
+	// Metrics, queue'nun sayaçlarını döndürür.
+	Metrics(queue string, now time.Time) (*QueueMetrics, error)
+}
+
+// metricsMinute, dakikalık sayaç bucket'ının anahtarı.
+func metricsMinute(t time.Time) int64 {
+	return t.Unix() / 60
+}
+
+// metricsMinutes, now dahil metricsWindow'a giren dakikaları döndürür.
+func metricsMinutes(now time.Time) []int64 {
+	count := int(metricsWindow / time.Minute)
+	current := metricsMinute(now)
+
+	minutes := make([]int64, count)
+	for i := range minutes {
+		minutes[i] = current - int64(i)
+	}
+	return minutes
+}
+
+// memoryQueueMetrics, tek queue'nun bellek içi sayaçları.
+type memoryQueueMetrics struct {
+	processed int64
+	failed    int64
+	minutes   map[int64][2]int64 // dakika -> [processed, failed]
+	workers   map[string]time.Time
+}
+
+// MemoryMetricsStore, metrikleri process belleğinde saklar.
+type MemoryMetricsStore struct {
+	mu     sync.Mutex
+	queues map[string]*memoryQueueMetrics
+}
+
+// NewMemoryMetricsStore, yeni bir MemoryMetricsStore oluşturur.
+func NewMemoryMetricsStore() *MemoryMetricsStore {
+	return &MemoryMetricsStore{
+		queues: make(map[string]*memoryQueueMetrics),
+	}
+}
+
+// get, kilit altında queue'nun sayaçlarını döndürür (yoksa oluşturur).
+func (m *MemoryMetricsStore) get(queue string) *memoryQueueMetrics {
+	metrics, ok := m.queues[queue]
+	if !ok {
+		metrics = &memoryQueueMetrics{
+			minutes: make(map[int64][2]int64),
+			workers: make(map[string]time.Time),
+		}
+		m.queues[queue] = metrics
+	}
+	return metrics
+}
+
+// RecordJob, biten job'ı sayar.
+func (m *MemoryMetricsStore) RecordJob(queue string, failed bool, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics := m.get(queue)
+	minute := metricsMinute(at)
+	bucket := metrics.minutes[minute]
+
+	if failed {
+		metrics.failed++
+		bucket[1]++
+	} else {
+		metrics.processed++
+		bucket[0]++
+	}
+	metrics.minutes[minute] = bucket
+
+	// Pencere dışına çıkan bucket'ları temizle
+	oldest := minute - int64(metricsWindow/time.Minute)
+	for key := range metrics.minutes {
+		if key <= oldest {
+			delete(metrics.minutes, key)
+		}
+	}
+	return nil
+}
+
+// Heartbeat, worker'ın son görülme zamanını günceller.
+func (m *MemoryMetricsStore) Heartbeat(workerID string, queues []string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, queue := range queues {
+		m.get(queue).workers[workerID] = at
+	}
+	return nil
+}
+
+// ForgetWorker, worker'ı siler.
+func (m *MemoryMetricsStore) ForgetWorker(workerID string, queues []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, queue := range queues {
+		delete(m.get(queue).workers, workerID)
+	}
+	return nil
+}
+
+// Metrics, queue'nun sayaçlarını döndürür.
+func (m *MemoryMetricsStore) Metrics(queue string, now time.Time) (*QueueMetrics, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics, ok := m.queues[queue]
+	if !ok {
+		return &QueueMetrics{}, nil
+	}
+
+	result := &QueueMetrics{
+		Processed: metrics.processed,
+		Failed:    metrics.failed,
+	}
+	for _, minute := range metricsMinutes(now) {
+		bucket := metrics.minutes[minute]
+		result.RecentProcessed += bucket[0]
+		result.RecentFailed += bucket[1]
+	}
+	for _, seen := range metrics.workers {
+		if now.Sub(seen) < workerTTL {
+			result.Workers++
+		}
+	}
+	return result, nil
+}
+
+// RedisMetricsStore, metrikleri Redis'te saklar.
+type RedisMetricsStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisMetricsStore, yeni bir RedisMetricsStore oluşturur.
+//
+// Örnek:
+//
+//	queue.SetMetricsStore(queue.NewRedisMetricsStore(redisClient, "conduit:"))
+func NewRedisMetricsStore(client *redis.Client, prefix string) *RedisMetricsStore {
+	return &RedisMetricsStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// totalsKey, toplam sayaçların Redis key'i.
+func (r *RedisMetricsStore) totalsKey(queue string) string {
+	return r.prefix + "queue_metrics:" + queue
+}
+
+// minuteKey, dakikalık sayaçların Redis key'i.
+func (r *RedisMetricsStore) minuteKey(queue string, minute int64) string {
+	return r.totalsKey(queue) + ":" + strconv.FormatInt(minute, 10)
+}
+
+// workersKey, aktif worker ZSET'inin Redis key'i.
+func (r *RedisMetricsStore) workersKey(queue string) string {
+	return r.prefix + "queue_workers:" + queue
+}
+
+// RecordJob, biten job'ı sayar.
+func (r *RedisMetricsStore) RecordJob(queue string, failed bool, at time.Time) error {
+	ctx := context.Background()
+
+	field := "processed"
+	if failed {
+		field = "failed"
+	}
+	minuteKey := r.minuteKey(queue, metricsMinute(at))
+
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, r.totalsKey(queue), field, 1)
+		pipe.HIncrBy(ctx, minuteKey, field, 1)
+		pipe.Expire(ctx, minuteKey, metricsWindow+time.Minute)
+		return nil
+	})
+	return err
+}
+
+// Heartbeat, worker'ın son görülme zamanını günceller.
+func (r *RedisMetricsStore) Heartbeat(workerID string, queues []string, at time.Time) error {
+	ctx := context.Background()
+	expired := strconv.FormatInt(at.Add(-workerTTL).Unix(), 10)
+
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, queue := range queues {
+			pipe.ZAdd(ctx, r.workersKey(queue), redis.Z{Score: float64(at.Unix()), Member: workerID})
+			pipe.ZRemRangeByScore(ctx, r.workersKey(queue), "-inf", "("+expired)
+		}
+		return nil
+	})
+	return err
+}
+
+// ForgetWorker, worker'ı siler.
+func (r *RedisMetricsStore) ForgetWorker(workerID string, queues []string) error {
+	ctx := context.Background()
+
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, queue := range queues {
+			pipe.ZRem(ctx, r.workersKey(queue), workerID)
+		}
+		return nil
+	})
+	return err
+}
+
+// Metrics, queue'nun sayaçlarını döndürür.
+func (r *RedisMetricsStore) Metrics(queue string, now time.Time) (*QueueMetrics, error) {
+	ctx := context.Background()
+	minutes := metricsMinutes(now)
+	alive := strconv.FormatInt(now.Add(-workerTTL).Unix(), 10)
+
+	var totals *redis.SliceCmd
+	recent := make([]*redis.SliceCmd, len(minutes))
+	var workers *redis.IntCmd
+
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		totals = pipe.HMGet(ctx, r.totalsKey(queue), "processed", "failed")
+		for i, minute := range minutes {
+			recent[i] = pipe.HMGet(ctx, r.minuteKey(queue, minute), "processed", "failed")
+		}
+		workers = pipe.ZCount(ctx, r.workersKey(queue), alive, "+inf")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &QueueMetrics{Workers: int(workers.Val())}
+	result.Processed, result.Failed = redisCounters(totals.Val())
+	for _, cmd := range recent {
+		processed, failed := redisCounters(cmd.Val())
+		result.RecentProcessed += processed
+		result.RecentFailed += failed
+	}
+	return result, nil
+}
+
+// redisCounters, HMGET processed failed cevabını sayılara çevirir.
+func redisCounters(values []interface{}) (int64, int64) {
+	counters := [2]int64{}
+	for i, value := range values {
+		if s, ok := value.(string); ok && i < len(counters) {
+			counters[i], _ = strconv.ParseInt(s, 10, 64)
+		}
+	}
+	return counters[0], counters[1]
+}
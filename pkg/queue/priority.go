@@ -0,0 +1,168 @@
+// -----------------------------------------------------------------------------
+// Queue Priorities
+// -----------------------------------------------------------------------------
+// Worker birden fazla queue dinlediğinde hangi queue'dan job çekileceğini
+// belirleyen stratejiler:
+//
+// - priority (varsayılan): Queue'lar verilen sırayla denenir; öndeki queue'da
+//   job varsa her zaman önce o işlenir.
+//     conduit queue:work --queue=critical,default,low
+//
+// - weighted: Her turda ilk denenecek queue ağırlığına göre rastgele seçilir;
+//   yoğun bir düşük öncelikli queue yüksek olanı aç bırakmaz, yüksek
+//   öncelikli bir queue da diğerlerini tamamen durdurmaz.
+//     conduit queue:work --queue=critical:5,default:3,low:1
+//
+// - round-robin: Queue'lar sırayla denenir (eşit paylaşım).
+//     conduit queue:work --queue=high,low --strategy=round-robin
+//
+// Her strateji sadece deneme sırasını belirler; seçilen queue boşsa sıradaki
+// denenir. RedisQueue tüm queue'ları tek bir BLPOP ile beklediği için boş bir
+// yüksek öncelikli queue alttakileri bekletmez.
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// QueueStrategy, çoklu queue tüketim stratejisidir.
+type QueueStrategy string
+
+const (
+	// StrategyPriority, queue'ları verilen sırayla (öncelik) dener.
+	StrategyPriority QueueStrategy = "priority"
+
+	// StrategyWeighted, ilk denenecek queue'yu ağırlığına göre seçer.
+	StrategyWeighted QueueStrategy = "weighted"
+
+	// StrategyRoundRobin, queue'ları sırayla eşit paylaştırır.
+	StrategyRoundRobin QueueStrategy = "round-robin"
+)
+
+// ParseQueueStrategy, CLI/config değerini QueueStrategy'ye çevirir.
+func ParseQueueStrategy(value string) (QueueStrategy, error) {
+	switch strategy := QueueStrategy(strings.ToLower(strings.TrimSpace(value))); strategy {
+	case StrategyPriority, StrategyWeighted, StrategyRoundRobin:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("geçersiz queue stratejisi: %q (priority, weighted, round-robin)", value)
+	}
+}
+
+// QueueWeight, ağırlığı ile birlikte bir queue adıdır.
+type QueueWeight struct {
+	Name   string
+	Weight int
+}
+
+// ParseQueues, "critical:5,default:3,low" biçimindeki queue listesini ayrıştırır.
+//
+// Ağırlık verilmeyen queue'ların ağırlığı 1'dir.
+//
+// Döndürür:
+//   - []QueueWeight: Verilen sırayla queue'lar
+//   - bool: En az bir queue'ya açıkça ağırlık verildiyse true
+//   - error: Geçersiz ağırlık
+func ParseQueues(specs ...string) ([]QueueWeight, bool, error) {
+	var (
+		queues   []QueueWeight
+		weighted bool
+	)
+
+	for _, spec := range specs {
+		for _, part := range strings.Split(spec, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			name, weight := part, 1
+			if i := strings.LastIndex(part, ":"); i != -1 {
+				n, err := strconv.Atoi(part[i+1:])
+				if err != nil || n < 1 {
+					return nil, false, fmt.Errorf("geçersiz queue ağırlığı: %q", part)
+				}
+				name, weight = part[:i], n
+				weighted = true
+			}
+
+			queues = append(queues, QueueWeight{Name: name, Weight: weight})
+		}
+	}
+
+	return queues, weighted, nil
+}
+
+// multiQueuePopper, birden fazla queue'yu tek seferde bekleyebilen driver'lar
+// için opsiyonel interface (bkz: RedisQueue.PopFirst).
+type multiQueuePopper interface {
+	PopFirst(queues ...string) (Job, string, error)
+}
+
+// queueOrder, stratejiye göre bu turda queue'ların deneneceği sırayı döndürür.
+//
+// tick, goroutine'in tur sayacıdır (round-robin için); rnd weighted seçim
+// için goroutine'e ait random kaynağıdır.
+func queueOrder(strategy QueueStrategy, queues []QueueWeight, tick int, rnd *rand.Rand) []string {
+	order := make([]string, 0, len(queues))
+
+	switch strategy {
+	case StrategyRoundRobin:
+		for i := range queues {
+			order = append(order, queues[(tick+i)%len(queues)].Name)
+		}
+
+	case StrategyWeighted:
+		total := 0
+		for _, q := range queues {
+			total += q.Weight
+		}
+
+		// İlk queue ağırlığa göre seçilir, kalanlar öncelik sırasıyla denenir
+		first, pick := 0, rnd.Intn(total)
+		for i, q := range queues {
+			if pick < q.Weight {
+				first = i
+				break
+			}
+			pick -= q.Weight
+		}
+
+		order = append(order, queues[first].Name)
+		for i, q := range queues {
+			if i != first {
+				order = append(order, q.Name)
+			}
+		}
+
+	default:
+		for _, q := range queues {
+			order = append(order, q.Name)
+		}
+	}
+
+	return order
+}
+
+// popFirst, queue'ları verilen sırayla dener ve ilk bulunan job'ı döndürür.
+func popFirst(q Queue, order []string) (Job, string, error) {
+	if popper, ok := q.(multiQueuePopper); ok {
+		return popper.PopFirst(order...)
+	}
+
+	for _, name := range order {
+		job, err := q.Pop(name)
+		if err != nil {
+			return nil, name, err
+		}
+		if job != nil {
+			return job, name, nil
+		}
+	}
+	return nil, "", nil
+}
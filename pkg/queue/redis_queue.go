@@ -5,15 +5,15 @@
 //
 // Özellikler:
 // - Atomic operations (RPUSH, BLPOP)
-// - Delayed jobs (sorted sets)
+// - Delayed jobs (sorted sets, restart'larda kaybolmaz)
 // - Failed job tracking
 // - Multiple queue support
 //
 // Redis Data Structures:
 // - queues:{name} - List (FIFO)
-// - queues:{name}:delayed - Sorted Set (timestamp score)
+// - queues:{name}:delayed - Sorted Set (score: hazır olacağı zaman, unix saniye)
 // - queues:{name}:reserved - Set (processing jobs)
-// - queues:failed - List (failed jobs)
+// - queues:failed - List (failed jobs, request_id ile birlikte)
 // -----------------------------------------------------------------------------
 
 package queue
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -109,9 +110,8 @@ func (r *RedisQueue) Later(delay time.Duration, job Job, queue string) error {
 
 	// Delayed job ise sorted set'e ekle
 	if delay > 0 {
-		availableAt := time.Now().Add(delay).Unix()
 		err = r.client.ZAdd(ctx, r.delayedKey(queue), redis.Z{
-			Score:  float64(availableAt),
+			Score:  delayedScore(time.Now().Add(delay)),
 			Member: data,
 		}).Err()
 
@@ -120,7 +120,7 @@ func (r *RedisQueue) Later(delay time.Duration, job Job, queue string) error {
 			return fmt.Errorf("delayed job push hatası: %w", err)
 		}
 
-		r.logger.Printf("✅ Delayed job pushed: %s (queue: %s, delay: %v)", job.GetID(), queue, delay)
+		r.logger.Printf("✅ Delayed job pushed: %s (queue: %s, delay: %v%s)", job.GetID(), queue, delay, traceTag(job))
 		return nil
 	}
 
@@ -131,50 +131,78 @@ func (r *RedisQueue) Later(delay time.Duration, job Job, queue string) error {
 		return fmt.Errorf("job push hatası: %w", err)
 	}
 
-	r.logger.Printf("✅ Job pushed: %s (queue: %s)", job.GetID(), queue)
+	r.logger.Printf("✅ Job pushed: %s (queue: %s%s)", job.GetID(), queue, traceTag(job))
 	return nil
 }
 
+// Client, kullanılan Redis client'ını döndürür.
+func (r *RedisQueue) Client() *redis.Client {
+	return r.client
+}
+
 // Pop, kuyruktan bir job çeker.
 func (r *RedisQueue) Pop(queue string) (Job, error) {
+	job, _, err := r.PopFirst(queue)
+	return job, err
+}
+
+// PopFirst, verilen kuyruklardan sıradaki ilk dolu olandan bir job çeker.
+//
+// Tek bir BLPOP ile tüm kuyruklar beklenir; Redis key'leri verilen sırayla
+// kontrol ettiği için öndeki kuyruk her zaman önceliklidir ve boş bir
+// yüksek öncelikli kuyruk, alttakilerdeki job'ları bekletmez.
+//
+// Döndürür:
+//   - Job: Çekilen job (hepsi boşsa nil)
+//   - string: Job'ın çekildiği kuyruk
+//   - error: Pop hatası
+func (r *RedisQueue) PopFirst(queues ...string) (Job, string, error) {
 	ctx := context.Background()
 
-	// Önce delayed jobs'ları kontrol et ve taşı
-	r.migrateDelayedJobs(queue)
+	keys := make([]string, len(queues))
+	names := make(map[string]string, len(queues))
+	for i, queue := range queues {
+		// Önce delayed jobs'ları kontrol et ve taşı
+		r.migrateDelayedJobs(queue)
+
+		keys[i] = r.queueKey(queue)
+		names[keys[i]] = queue
+	}
 
 	// BLPOP ile job çek (5 saniye timeout)
-	result, err := r.client.BLPop(ctx, 5*time.Second, r.queueKey(queue)).Result()
+	result, err := r.client.BLPop(ctx, 5*time.Second, keys...).Result()
 	if err != nil {
 		if err == redis.Nil {
-			// Queue boş
-			return nil, nil
+			// Queue'lar boş
+			return nil, "", nil
 		}
-		r.logger.Printf("❌ Job pop hatası [%s]: %v", queue, err)
-		return nil, fmt.Errorf("job pop hatası: %w", err)
+		r.logger.Printf("❌ Job pop hatası %v: %v", queues, err)
+		return nil, "", fmt.Errorf("job pop hatası: %w", err)
 	}
 
 	// result[0] = key, result[1] = value
+	queue := names[result[0]]
 	data := result[1]
 
 	// Deserialize et
 	var payload JobPayload
 	if err := json.Unmarshal([]byte(data), &payload); err != nil {
 		r.logger.Printf("❌ JSON decode hatası: %v", err)
-		return nil, fmt.Errorf("json decode hatası: %w", err)
+		return nil, queue, fmt.Errorf("json decode hatası: %w", err)
 	}
 
 	// Job instance oluştur (tip registry'den)
 	job, err := r.createJobInstance(&payload)
 	if err != nil {
 		r.logger.Printf("❌ Job instance oluşturma hatası: %v", err)
-		return nil, fmt.Errorf("job instance oluşturulamadı: %w", err)
+		return nil, queue, fmt.Errorf("job instance oluşturulamadı: %w", err)
 	}
 
 	// Job'ı reserved set'e ekle
 	r.client.SAdd(ctx, r.reservedKey(queue), data)
 
 	r.logger.Printf("🔄 Job popped: %s (queue: %s, attempts: %d)", job.GetID(), queue, job.GetAttempts())
-	return job, nil
+	return job, queue, nil
 }
 
 // Delete, job'ı kuyruktan siler.
@@ -229,7 +257,7 @@ func (r *RedisQueue) Release(queue string, job Job, delay time.Duration) error {
 	if job.GetAttempts() >= job.GetMaxAttempts() {
 		// Failed jobs'a ekle
 		r.client.RPush(ctx, r.failedKey(), data)
-		r.logger.Printf("⚠️  Job failed (max attempts): %s (queue: %s, attempts: %d)", job.GetID(), queue, job.GetAttempts())
+		r.logger.Printf("⚠️  Job failed (max attempts): %s (queue: %s, attempts: %d%s)", job.GetID(), queue, job.GetAttempts(), traceTag(job))
 		return nil
 	}
 
@@ -256,34 +284,125 @@ func (r *RedisQueue) Size(queue string) (int64, error) {
 	return normalSize + delayedSize, nil
 }
 
-// migrateDelayedJobs, delayed jobs'ları kontrol eder ve zamanı gelenleri taşır.
-func (r *RedisQueue) migrateDelayedJobs(queue string) {
+// OldestJobAge, en eski hazır job'ın ne kadar süredir beklediğini döndürür.
+//
+// Ana listenin başındaki job ile zamanı gelmiş ama henüz taşınmamış delayed
+// job'lardan eskisi dikkate alınır.
+func (r *RedisQueue) OldestJobAge(queue string) (time.Duration, error) {
 	ctx := context.Background()
-	now := float64(time.Now().Unix())
+	now := time.Now()
+	var oldest time.Duration
+
+	head, err := r.client.LIndex(ctx, r.queueKey(queue), 0).Result()
+	if err != nil && err != redis.Nil {
+		return 0, err
+	}
+	if head != "" {
+		var payload JobPayload
+		if err := json.Unmarshal([]byte(head), &payload); err == nil && !payload.AvailableAt.IsZero() {
+			oldest = now.Sub(payload.AvailableAt)
+		}
+	}
 
-	// Zamanı gelen job'ları bul
-	jobs, err := r.client.ZRangeByScore(ctx, r.delayedKey(queue), &redis.ZRangeBy{
-		Min: "-inf",
-		Max: fmt.Sprintf("%f", now),
+	due, err := r.client.ZRangeByScoreWithScores(ctx, r.delayedKey(queue), &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatFloat(delayedScore(now), 'f', 3, 64),
+		Count: 1,
 	}).Result()
+	if err != nil {
+		return 0, err
+	}
+	if len(due) > 0 {
+		availableAt := time.UnixMilli(int64(due[0].Score * 1000))
+		if age := now.Sub(availableAt); age > oldest {
+			oldest = age
+		}
+	}
 
-	if err != nil || len(jobs) == 0 {
-		return
+	if oldest < 0 {
+		oldest = 0
 	}
+	return oldest, nil
+}
+
+// delayedMoveBatch, tek seferde taşınacak en fazla delayed job sayısı.
+const delayedMoveBatch = 100
+
+// moveDueJobsScript, zamanı gelen job'ları delayed ZSET'ten ana listeye
+// atomic olarak taşır.
+//
+// ZRANGEBYSCORE + ZREM + RPUSH tek script'te çalıştığı için birden fazla
+// worker aynı anda taşıma yapsa bile bir job iki kez kuyruğa girmez.
+//
+// KEYS[1] = delayed ZSET, KEYS[2] = ana liste
+// ARGV[1] = şimdiki zaman (score), ARGV[2] = limit
+var moveDueJobsScript = redis.NewScript(`
+local jobs = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+if #jobs > 0 then
+	redis.call('ZREM', KEYS[1], unpack(jobs))
+	redis.call('RPUSH', KEYS[2], unpack(jobs))
+end
+return #jobs
+`)
+
+// delayedScore, ZSET score'u olarak kullanılan zaman değeri (saniye,
+// milisaniye hassasiyetli). Tam saniye score'lu eski kayıtlarla uyumludur.
+func delayedScore(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+// MigrateDueJobs, zamanı gelen delayed job'ları ana kuyruğa taşır.
+//
+// Pop her çağrıda bunu yapar; delayed job'lar Redis'te kalıcı olduğu için
+// worker yeniden başlatılsa bile kaybolmaz ve zamanı geldiğinde işlenir.
+//
+// Döndürür:
+//   - int: Taşınan job sayısı
+//   - error: Redis hatası
+func (r *RedisQueue) MigrateDueJobs(queue string) (int, error) {
+	ctx := context.Background()
+	keys := []string{r.delayedKey(queue), r.queueKey(queue)}
+	now := strconv.FormatFloat(delayedScore(time.Now()), 'f', 3, 64)
 
-	// Her job'ı normal queue'ya taşı
-	for _, jobData := range jobs {
-		// Normal queue'ya ekle
-		r.client.RPush(ctx, r.queueKey(queue), jobData)
-		// Delayed queue'dan sil
-		r.client.ZRem(ctx, r.delayedKey(queue), jobData)
+	total := 0
+	for {
+		moved, err := moveDueJobsScript.Run(ctx, r.client, keys, now, delayedMoveBatch).Int()
+		if err != nil {
+			return total, err
+		}
+
+		total += moved
+		if moved < delayedMoveBatch {
+			return total, nil
+		}
 	}
+}
 
-	r.logger.Printf("🔄 Migrated %d delayed jobs (queue: %s)", len(jobs), queue)
+// migrateDelayedJobs, delayed jobs'ları kontrol eder ve zamanı gelenleri taşır.
+func (r *RedisQueue) migrateDelayedJobs(queue string) {
+	moved, err := r.MigrateDueJobs(queue)
+	if err != nil {
+		r.logger.Printf("❌ Delayed job taşıma hatası [%s]: %v", queue, err)
+		return
+	}
+
+	if moved > 0 {
+		r.logger.Printf("🔄 Migrated %d delayed jobs (queue: %s)", moved, queue)
+	}
 }
 
 // createPayload, job'dan JobPayload oluşturur.
 func (r *RedisQueue) createPayload(job Job, delay time.Duration) (*JobPayload, error) {
+	return newJobPayload(job, delay)
+}
+
+// createJobInstance, JobPayload'dan Job instance oluşturur.
+func (r *RedisQueue) createJobInstance(payload *JobPayload) (Job, error) {
+	return jobFromPayload(payload)
+}
+
+// newJobPayload, job'dan JobPayload oluşturur.
+func newJobPayload(job Job, delay time.Duration) (*JobPayload, error) {
 	// Job'ı serialize et
 	jobData, err := job.GetPayload()
 	if err != nil {
@@ -293,6 +412,14 @@ func (r *RedisQueue) createPayload(job Job, delay time.Duration) (*JobPayload, e
 	// Job type belirle (reflection ile)
 	jobType := fmt.Sprintf("%T", job)
 
+	// ShouldBeEncrypted job'ların verisi APP_KEY ile şifrelenir
+	encrypted := shouldEncrypt(job)
+	if encrypted {
+		if jobData, err = sealPayload(jobType, jobData); err != nil {
+			return nil, err
+		}
+	}
+
 	// Payload oluştur
 	availableAt := time.Now()
 	if delay > 0 {
@@ -304,34 +431,54 @@ func (r *RedisQueue) createPayload(job Job, delay time.Duration) (*JobPayload, e
 		Type:        jobType,
 		Queue:       job.GetQueue(),
 		Payload:     jobData,
+		Encrypted:   encrypted,
 		Attempts:    job.GetAttempts(),
 		MaxAttempts: job.GetMaxAttempts(),
 		CreatedAt:   time.Now(),
 		AvailableAt: availableAt,
+		RequestID:   RequestIDOf(job),
+		BatchID:     BatchIDOf(job),
+		Timeout:     TimeoutOf(job),
 	}
 
 	return payload, nil
 }
 
-// createJobInstance, JobPayload'dan Job instance oluşturur.
+// jobFromPayload, JobPayload'dan Job instance oluşturur.
 //
 // NOT: Bu fonksiyon job type registry kullanır.
 // Her job tipi register edilmelidir.
-func (r *RedisQueue) createJobInstance(payload *JobPayload) (Job, error) {
+func jobFromPayload(payload *JobPayload) (Job, error) {
 	// Job type registry'den instance oluştur
 	job, err := JobRegistry.Create(payload.Type)
 	if err != nil {
 		return nil, err
 	}
 
-	// Metadata set et
+	// Payload set et
+	data := []byte(payload.Payload)
+	if payload.Encrypted {
+		if data, err = openPayload(payload.Type, payload.Payload); err != nil {
+			return nil, err
+		}
+	}
+	if err := job.SetPayload(data); err != nil {
+		return nil, err
+	}
+
+	// Metadata set et (job'ın kendi JSON'ındaki BaseJob alanlarını ezer;
+	// attempts/queue gibi değerler için wrapper esas alınır)
 	job.SetID(payload.ID)
 	job.SetQueue(payload.Queue)
 	job.SetAttempts(payload.Attempts)
-
-	// Payload set et
-	if err := job.SetPayload(payload.Payload); err != nil {
-		return nil, err
+	if traceable, ok := job.(Traceable); ok && payload.RequestID != "" {
+		traceable.SetRequestID(payload.RequestID)
+	}
+	if batchable, ok := job.(Batchable); ok && payload.BatchID != "" {
+		batchable.SetBatchID(payload.BatchID)
+	}
+	if timeoutable, ok := job.(Timeoutable); ok && payload.Timeout > 0 {
+		timeoutable.SetTimeout(payload.Timeout)
 	}
 
 	return job, nil
@@ -5,16 +5,23 @@
 //
 // Her job tipi register edilmeli ki worker deserialize edebilsin.
 //
-// Kullanım:
-//   queue.RegisterJob("*jobs.SendEmailJob", func() queue.Job {
-//       return &jobs.SendEmailJob{}
-//   })
+// Kullanım (önerilen - job dosyasında init ile kendini kaydeder):
+//
+//	func init() {
+//	    queue.RegisterType(func() *SendEmailJob { return &SendEmailJob{} })
+//	}
+//
+// Type string'i otomatik hesaplandığı için ("*jobs.SendEmailJob") main.go'da
+// elle kayıt yapmaya ve yazım hatası sonucu "job tipi register edilmemiş"
+// hatalarına gerek kalmaz. Kayıtlı tipler `conduit queue:jobs` ile listelenir.
 // -----------------------------------------------------------------------------
 
 package queue
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"sync"
 )
 
@@ -64,12 +71,43 @@ func (r *Registry) Create(jobType string) (Job, error) {
 
 	factory, exists := r.factories[jobType]
 	if !exists {
-		return nil, fmt.Errorf("job tipi register edilmemiş: %s", jobType)
+		// make:job ile üretilen job'lar init'te kendini kaydeder; bu hata
+		// genellikle paketin worker binary'sine import edilmediğini gösterir
+		return nil, fmt.Errorf("job tipi register edilmemiş: %s (job'un init fonksiyonunda queue.RegisterType çağrıldığından ve paketinin import edildiğinden emin olun)", jobType)
 	}
 
 	return factory(), nil
 }
 
+// Has, job tipinin register edilip edilmediğini döndürür.
+func (r *Registry) Has(jobType string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.factories[jobType]
+	return exists
+}
+
+// Types, register edilmiş job tiplerini alfabetik sırayla döndürür.
+func (r *Registry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]string, 0, len(r.factories))
+	for jobType := range r.factories {
+		types = append(types, jobType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// JobTypeName, job'un queue'da kullanılan type string'ini döndürür.
+//
+// Push sırasında payload'a yazılan değerle (fmt.Sprintf("%T", job)) aynıdır.
+func JobTypeName(job Job) string {
+	return fmt.Sprintf("%T", job)
+}
+
 // RegisterJob, global registry'ye job register eder.
 //
 // Parametreler:
@@ -84,3 +122,29 @@ func (r *Registry) Create(jobType string) (Job, error) {
 func RegisterJob(jobType string, factory JobFactory) {
 	JobRegistry.Register(jobType, factory)
 }
+
+// RegisterType, job tipini type string'i otomatik hesaplayarak global
+// registry'ye kaydeder.
+//
+// Type string'i T'den türetilir (örn: *jobs.SendEmailJob -> "*jobs.SendEmailJob");
+// böylece Push ile payload'a yazılan değerle her zaman eşleşir. Job
+// dosyalarındaki init fonksiyonlarında kullanılmak üzere tasarlanmıştır.
+//
+// Parametreler:
+//   - factory: Job instance oluşturan fonksiyon
+//
+// Döndürür:
+//   - string: Kaydedilen type string'i
+//
+// Örnek:
+//
+//	func init() {
+//	    queue.RegisterType(func() *SendEmailJob { return &SendEmailJob{} })
+//	}
+func RegisterType[T Job](factory func() T) string {
+	jobType := reflect.TypeOf((*T)(nil)).Elem().String()
+	JobRegistry.Register(jobType, func() Job {
+		return factory()
+	})
+	return jobType
+}
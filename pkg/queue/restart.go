@@ -0,0 +1,109 @@
+// -----------------------------------------------------------------------------
+// Worker Restart Signal
+// -----------------------------------------------------------------------------
+// Deploy sonrası uzun süre çalışan worker'ların yeni kodu yüklemesi için
+// cache üzerinden restart sinyali (Laravel queue:restart gibi):
+//
+//  1. conduit queue:restart, cache'e o anın zaman damgasını yazar
+//  2. Worker'lar başlarken damgayı okur ve periyodik olarak kontrol eder
+//  3. Damga başlangıçtakinden yeniyse mevcut job'larını bitirip çıkarlar
+//  4. Supervisor (systemd, supervisord, Kubernetes) process'i yeni kodla
+//     tekrar başlatır
+//
+// Worker'lar ve CLI aynı cache'i (ör. Redis) kullanmalıdır.
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/cache"
+)
+
+// RestartCacheKey, restart zaman damgasının saklandığı cache key'i.
+const RestartCacheKey = "queue:restart"
+
+// restartPollInterval, worker'ların restart sinyalini kontrol etme aralığı.
+const restartPollInterval = 3 * time.Second
+
+// SignalRestart, tüm worker'lara mevcut job'larını bitirip çıkmalarını
+// bildirir.
+//
+// Örnek:
+//
+//	if err := queue.SignalRestart(cacheDriver); err != nil {
+//	    return err
+//	}
+func SignalRestart(store cache.Cache) error {
+	// String olarak saklanır; JSON decode sırasında float'a dönüşüp
+	// hassasiyet kaybetmez
+	stamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := store.Forever(RestartCacheKey, stamp); err != nil {
+		return fmt.Errorf("queue restart sinyali yazılamadı: %w", err)
+	}
+	return nil
+}
+
+// lastRestart, son restart sinyalinin zaman damgasını döndürür (yoksa 0).
+func lastRestart(store cache.Cache) (int64, error) {
+	value, err := store.Get(RestartCacheKey)
+	if err != nil || value == nil {
+		return 0, err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("geçersiz queue restart değeri: %v", value)
+	}
+}
+
+// watchRestart, restart sinyali gelene veya worker durana kadar cache'i
+// kontrol eder; sinyal gelince worker'ı durdurur.
+//
+// Sadece başlangıçtakinden yeni bir damga sinyal sayılır. Key'in silinmesi
+// (cache:clear, LRU eviction) restart tetiklemez. Başlangıç damgası
+// okunamazsa 0 varsayılmaz; mevcut bir damga sinyal sanılmasın diye okuma
+// sonraki kontrollerde tekrar denenir.
+func (w *Worker) watchRestart(store cache.Cache) {
+	defer w.wg.Done()
+
+	started, err := lastRestart(store)
+	known := err == nil
+	if err != nil {
+		w.logger.Printf("⚠️  Queue restart sinyali okunamadı: %v", err)
+	}
+
+	ticker := time.NewTicker(w.restartPoll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			current, err := lastRestart(store)
+			if err != nil {
+				w.logger.Printf("⚠️  Queue restart sinyali okunamadı: %v", err)
+				continue
+			}
+			if !known {
+				started, known = current, true
+				continue
+			}
+			if current > started {
+				w.logger.Println("🔁 Queue restart sinyali alındı, mevcut job'lar bitince çıkılıyor...")
+				w.Stop()
+				return
+			}
+		case <-w.stopChan:
+			return
+		}
+	}
+}
@@ -0,0 +1,395 @@
+// -----------------------------------------------------------------------------
+// Amazon SQS Queue Driver
+// -----------------------------------------------------------------------------
+// Redis olmadan AWS üzerinde çalışan ekipler için SQS implementation.
+//
+// SQS JSON API'si (AmazonSQS.*) doğrudan HTTP ile çağrılır ve istekler
+// Signature V4 ile imzalanır; AWS SDK bağımlılığı yoktur.
+//
+// Davranış:
+// - Kuyruk adı URL'e çevrilir: {Prefix}/{queue}
+// - Later: DelaySeconds (SQS en fazla 15 dakika destekler; daha uzun
+//   gecikmeler available_at ile takip edilir, mesaj zamanı gelene kadar
+//   görünmez tutulur)
+// - Pop: Long polling (WaitTimeSeconds), job Timeout'u varsa visibility
+//   timeout job süresine göre uzatılır (job bitmeden başka worker'a düşmez)
+// - Release: Mesaj silinir ve artan attempts ile gecikmeli tekrar gönderilir
+//
+// Batch ilerlemesi paylaşılan bir store gerektirir; SQS ile kullanılırken
+// queue.SetBatchStore(queue.NewRedisBatchStore(...)) ayarlanmazsa batch'ler
+// sadece tek process'te doğru izlenir.
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/awssig"
+	"github.com/google/uuid"
+)
+
+// SQS limitleri
+const (
+	sqsMaxDelay      = 15 * time.Minute // DelaySeconds üst sınırı
+	sqsMaxVisibility = 12 * time.Hour   // VisibilityTimeout üst sınırı
+	sqsMaxWait       = 20 * time.Second // WaitTimeSeconds üst sınırı
+)
+
+// SQSConfig, SQS driver ayarlarıdır.
+type SQSConfig struct {
+	Region          string // AWS region (örn: eu-central-1)
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string        // Geçici credential'lar için (opsiyonel)
+	Prefix          string        // Kuyruk URL prefix'i: https://sqs.{region}.amazonaws.com/{account-id}
+	Endpoint        string        // API endpoint (boş = https://sqs.{region}.amazonaws.com, LocalStack için override)
+	Visibility      time.Duration // Varsayılan visibility timeout
+	WaitTime        time.Duration // Long polling süresi (en fazla 20s)
+}
+
+// SQSQueue, Amazon SQS-based queue implementation.
+type SQSQueue struct {
+	config SQSConfig
+	client *http.Client
+	logger *log.Logger
+
+	// Pop edilen job'ların receipt handle'ları (job ID -> handle);
+	// Delete/Release mesajı bu handle ile siler.
+	receipts   map[string]string
+	receiptsMu sync.Mutex
+}
+
+// NewSQSQueue, yeni bir SQS queue instance oluşturur.
+//
+// Parametreler:
+//   - config: SQS ayarları
+//   - logger: Log instance
+//
+// Döndürür:
+//   - *SQSQueue: Queue instance
+//
+// Örnek:
+//
+//	q := queue.NewSQSQueue(queue.SQSConfig{
+//	    Region:          "eu-central-1",
+//	    AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+//	    SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+//	    Prefix:          "https://sqs.eu-central-1.amazonaws.com/123456789012",
+//	}, logger)
+//	q.Push(emailJob, "emails")
+func NewSQSQueue(config SQSConfig, logger *log.Logger) *SQSQueue {
+	if config.Endpoint == "" {
+		config.Endpoint = "https://sqs." + config.Region + ".amazonaws.com"
+	}
+	if config.Visibility <= 0 {
+		config.Visibility = 60 * time.Second
+	}
+	if config.WaitTime < 0 {
+		config.WaitTime = 0
+	}
+	if config.WaitTime > sqsMaxWait {
+		config.WaitTime = sqsMaxWait
+	}
+
+	return &SQSQueue{
+		config: config,
+		// Long polling isteği WaitTime kadar açık kalır
+		client:   &http.Client{Timeout: config.WaitTime + 30*time.Second},
+		logger:   logger,
+		receipts: make(map[string]string),
+	}
+}
+
+// queueURL, kuyruk adının SQS URL'ini döndürür.
+func (s *SQSQueue) queueURL(queue string) string {
+	return strings.TrimRight(s.config.Prefix, "/") + "/" + queue
+}
+
+// Push, job'ı hemen kuyruğa ekler.
+func (s *SQSQueue) Push(job Job, queue string) error {
+	return s.Later(0, job, queue)
+}
+
+// Later, job'ı belirli bir gecikme ile kuyruğa ekler.
+//
+// 15 dakikadan uzun gecikmelerde mesaj 15 dakika sonra görünür olur; Pop
+// available_at gelmeden çekilen mesajı tekrar gizler.
+func (s *SQSQueue) Later(delay time.Duration, job Job, queue string) error {
+	if job.GetID() == "" {
+		job.SetID(uuid.New().String())
+	}
+	job.SetQueue(queue)
+
+	payload, err := newJobPayload(job, delay)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("json encode hatası: %w", err)
+	}
+
+	if delay > sqsMaxDelay {
+		delay = sqsMaxDelay
+	}
+
+	request := map[string]interface{}{
+		"QueueUrl":    s.queueURL(queue),
+		"MessageBody": string(body),
+	}
+	if delay > 0 {
+		request["DelaySeconds"] = int(delay / time.Second)
+	}
+
+	if err := s.call("SendMessage", request, nil); err != nil {
+		s.logger.Printf("❌ Job push hatası [%s]: %v", queue, err)
+		return fmt.Errorf("job push hatası: %w", err)
+	}
+
+	if delay > 0 {
+		s.logger.Printf("⏱️  Job scheduled: %s (queue: %s, delay: %v%s)", job.GetID(), queue, delay, traceTag(job))
+	} else {
+		s.logger.Printf("✅ Job pushed: %s (queue: %s%s)", job.GetID(), queue, traceTag(job))
+	}
+	return nil
+}
+
+// sqsMessage, ReceiveMessage cevabındaki mesajdır.
+type sqsMessage struct {
+	MessageID     string `json:"MessageId"`
+	ReceiptHandle string `json:"ReceiptHandle"`
+	Body          string `json:"Body"`
+}
+
+// Pop, kuyruktan bir job çeker (long polling ile).
+func (s *SQSQueue) Pop(queue string) (Job, error) {
+	return s.receive(queue, s.config.WaitTime)
+}
+
+// PopFirst, verilen kuyruklardan sıradaki ilk dolu olandan bir job çeker.
+//
+// SQS tek istekte birden fazla kuyruğu bekleyemez; kuyruklar önce beklemeden
+// sırayla denenir, hepsi boşsa en öncelikli kuyrukta kısa bir long poll
+// yapılır. Böylece boş bir yüksek öncelikli kuyruk alttakileri WaitTime
+// kadar bekletmez.
+func (s *SQSQueue) PopFirst(queues ...string) (Job, string, error) {
+	if len(queues) == 1 {
+		job, err := s.Pop(queues[0])
+		return job, queues[0], err
+	}
+
+	for _, queue := range queues {
+		job, err := s.receive(queue, 0)
+		if err != nil || job != nil {
+			return job, queue, err
+		}
+	}
+
+	wait := time.Second
+	if s.config.WaitTime < wait {
+		wait = s.config.WaitTime
+	}
+	job, err := s.receive(queues[0], wait)
+	return job, queues[0], err
+}
+
+// receive, tek bir mesaj çeker ve job'a çevirir.
+func (s *SQSQueue) receive(queue string, wait time.Duration) (Job, error) {
+	var response struct {
+		Messages []sqsMessage `json:"Messages"`
+	}
+
+	err := s.call("ReceiveMessage", map[string]interface{}{
+		"QueueUrl":            s.queueURL(queue),
+		"MaxNumberOfMessages": 1,
+		"WaitTimeSeconds":     int(wait / time.Second),
+		"VisibilityTimeout":   int(s.config.Visibility / time.Second),
+	}, &response)
+	if err != nil {
+		s.logger.Printf("❌ Job pop hatası [%s]: %v", queue, err)
+		return nil, fmt.Errorf("job pop hatası: %w", err)
+	}
+
+	if len(response.Messages) == 0 {
+		return nil, nil
+	}
+	message := response.Messages[0]
+
+	var payload JobPayload
+	if err := json.Unmarshal([]byte(message.Body), &payload); err != nil {
+		s.logger.Printf("❌ JSON decode hatası: %v", err)
+		return nil, fmt.Errorf("json decode hatası: %w", err)
+	}
+
+	// 15 dakikadan uzun gecikme: zamanı gelene kadar tekrar gizle
+	if wait := time.Until(payload.AvailableAt); wait > time.Second {
+		s.changeVisibility(queue, message.ReceiptHandle, wait)
+		return nil, nil
+	}
+
+	job, err := jobFromPayload(&payload)
+	if err != nil {
+		s.logger.Printf("❌ Job instance oluşturma hatası: %v", err)
+		return nil, fmt.Errorf("job instance oluşturulamadı: %w", err)
+	}
+
+	// Visibility timeout job süresini kapsamalı; yoksa job bitmeden mesaj
+	// başka bir worker'a teslim edilir
+	if timeout := TimeoutOf(job); timeout > 0 && timeout+30*time.Second > s.config.Visibility {
+		s.changeVisibility(queue, message.ReceiptHandle, timeout+30*time.Second)
+	}
+
+	s.receiptsMu.Lock()
+	s.receipts[job.GetID()] = message.ReceiptHandle
+	s.receiptsMu.Unlock()
+
+	s.logger.Printf("🔄 Job popped: %s (queue: %s, attempts: %d)", job.GetID(), queue, job.GetAttempts())
+	return job, nil
+}
+
+// changeVisibility, mesajın görünmez kalma süresini değiştirir.
+func (s *SQSQueue) changeVisibility(queue, receipt string, timeout time.Duration) {
+	if timeout > sqsMaxVisibility {
+		timeout = sqsMaxVisibility
+	}
+
+	err := s.call("ChangeMessageVisibility", map[string]interface{}{
+		"QueueUrl":          s.queueURL(queue),
+		"ReceiptHandle":     receipt,
+		"VisibilityTimeout": int(timeout / time.Second),
+	}, nil)
+	if err != nil {
+		s.logger.Printf("⚠️  Visibility timeout değiştirilemedi [%s]: %v", queue, err)
+	}
+}
+
+// takeReceipt, job'ın receipt handle'ını döndürür ve kaydı siler.
+func (s *SQSQueue) takeReceipt(job Job) (string, bool) {
+	s.receiptsMu.Lock()
+	defer s.receiptsMu.Unlock()
+
+	receipt, ok := s.receipts[job.GetID()]
+	delete(s.receipts, job.GetID())
+	return receipt, ok
+}
+
+// Delete, job'ın mesajını kuyruktan siler.
+func (s *SQSQueue) Delete(queue string, job Job) error {
+	receipt, ok := s.takeReceipt(job)
+	if !ok {
+		return nil
+	}
+
+	err := s.call("DeleteMessage", map[string]interface{}{
+		"QueueUrl":      s.queueURL(queue),
+		"ReceiptHandle": receipt,
+	}, nil)
+	if err != nil {
+		s.logger.Printf("❌ Job delete hatası [%s]: %v", queue, err)
+		return fmt.Errorf("job delete hatası: %w", err)
+	}
+	return nil
+}
+
+// Release, job'ı attempts artırılmış olarak gecikme ile tekrar kuyruğa ekler.
+//
+// SQS mesajları değiştirilemediği için eski mesaj silinir ve yenisi
+// gönderilir; max attempts aşıldıysa sadece silinir.
+func (s *SQSQueue) Release(queue string, job Job, delay time.Duration) error {
+	job.SetAttempts(job.GetAttempts() + 1)
+
+	if err := s.Delete(queue, job); err != nil {
+		return err
+	}
+
+	if job.GetAttempts() >= job.GetMaxAttempts() {
+		s.logger.Printf("⚠️  Job failed (max attempts): %s (queue: %s, attempts: %d%s)", job.GetID(), queue, job.GetAttempts(), traceTag(job))
+		return nil
+	}
+
+	return s.Later(delay, job, queue)
+}
+
+// Size, kuyruktaki (görünür + gecikmeli) yaklaşık mesaj sayısını döndürür.
+func (s *SQSQueue) Size(queue string) (int64, error) {
+	var response struct {
+		Attributes map[string]string `json:"Attributes"`
+	}
+
+	err := s.call("GetQueueAttributes", map[string]interface{}{
+		"QueueUrl":       s.queueURL(queue),
+		"AttributeNames": []string{"ApproximateNumberOfMessages", "ApproximateNumberOfMessagesDelayed"},
+	}, &response)
+	if err != nil {
+		return 0, fmt.Errorf("queue size alınamadı: %w", err)
+	}
+
+	visible, _ := strconv.ParseInt(response.Attributes["ApproximateNumberOfMessages"], 10, 64)
+	delayed, _ := strconv.ParseInt(response.Attributes["ApproximateNumberOfMessagesDelayed"], 10, 64)
+	return visible + delayed, nil
+}
+
+// call, SQS JSON API'sine imzalı istek gönderir.
+//
+// Parametreler:
+//   - action: API action'ı (örn: "SendMessage")
+//   - request: İstek gövdesi
+//   - response: Cevabın decode edileceği değer (nil = yok say)
+func (s *SQSQueue) call(action string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.config.Endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS."+action)
+
+	awssig.Sign(req, body, awssig.Credentials{
+		AccessKeyID:     s.config.AccessKeyID,
+		SecretAccessKey: s.config.SecretAccessKey,
+		SessionToken:    s.config.SessionToken,
+	}, s.config.Region, "sqs", time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		json.Unmarshal(data, &apiErr)
+		return fmt.Errorf("sqs %s: status %d: %s %s", action, resp.StatusCode, apiErr.Type, apiErr.Message)
+	}
+
+	if response != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, response); err != nil {
+			return fmt.Errorf("sqs %s cevabı decode edilemedi: %w", action, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// -----------------------------------------------------------------------------
+// Queue Stats
+// -----------------------------------------------------------------------------
+// Kuyruk derinliği, en eski job'ın bekleme süresi, processed/failed oranları
+// ve aktif worker sayısını tek bir API'de toplar. conduit queue:monitor,
+// health endpoint'i ve Prometheus exporter'ları aynı veriyi kullanır:
+//
+//	stats, err := queue.Stats(q, "critical", "default")
+//
+//	// /metrics
+//	queue.WritePrometheus(w, stats)
+//
+// Sayaçlar worker'lar tarafından metrics store'a yazılır; API ve CLI
+// process'leri worker'ları görebilsin diye RedisMetricsStore kullanılmalıdır
+// (bkz: metrics.go).
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+)
+
+// QueueStats, bir queue'nun anlık durumudur.
+type QueueStats struct {
+	Queue              string        `json:"queue"`
+	Size               int64         `json:"size"`           // Bekleyen job sayısı (delayed dahil)
+	OldestJobAge       time.Duration `json:"oldest_job_age"` // En eski hazır job'ın bekleme süresi (0: boş veya driver desteklemiyor)
+	Processed          int64         `json:"processed"`      // Başarıyla biten job sayısı (toplam)
+	Failed             int64         `json:"failed"`         // Kalıcı olarak başarısız job sayısı (toplam)
+	ProcessedPerMinute float64       `json:"processed_per_minute"`
+	FailedPerMinute    float64       `json:"failed_per_minute"`
+	Workers            int           `json:"workers"` // Queue'yu dinleyen aktif worker process sayısı
+}
+
+// oldestJobAger, en eski job'ın yaşını raporlayabilen driver'lar için
+// opsiyonel arayüz.
+type oldestJobAger interface {
+	OldestJobAge(queue string) (time.Duration, error)
+}
+
+// Global metrics store (varsayılan: memory)
+var (
+	metricsStore   MetricsStore = NewMemoryMetricsStore()
+	metricsStoreMu sync.RWMutex
+)
+
+// SetMetricsStore, worker metriklerinin saklanacağı store'u ayarlar.
+//
+// Worker'lar ayrı process'lerde çalışıyorsa paylaşılan bir store
+// (RedisMetricsStore) kullanılmalıdır.
+func SetMetricsStore(store MetricsStore) {
+	metricsStoreMu.Lock()
+	defer metricsStoreMu.Unlock()
+
+	metricsStore = store
+}
+
+// getMetricsStore, aktif metrics store'u döndürür.
+func getMetricsStore() MetricsStore {
+	metricsStoreMu.RLock()
+	defer metricsStoreMu.RUnlock()
+
+	return metricsStore
+}
+
+// recordJobMetric, biten job'ı metrics store'a yazar.
+//
+// Store hatası job akışını etkilemez, sadece loglanır.
+func recordJobMetric(queue string, failed bool, logger *log.Logger) {
+	store := getMetricsStore()
+	if store == nil {
+		return
+	}
+
+	if err := store.RecordJob(queue, failed, time.Now()); err != nil {
+		logWith(logger, "⚠️  Queue metric yazılamadı (queue: %s): %v", queue, err)
+	}
+}
+
+// Stats, queue'ların anlık durumunu döndürür.
+//
+// Parametreler:
+//   - q: Queue driver (Size ve varsa en eski job yaşı için)
+//   - queues: Queue adları
+//
+// Döndürür:
+//   - []QueueStats: Verilen sırayla queue durumları
+//   - error: Driver veya metrics store hatası
+//
+// Örnek:
+//
+//	stats, err := queue.Stats(q, "default", "emails")
+//	for _, s := range stats {
+//	    fmt.Printf("%s: %d bekliyor, %d worker\n", s.Queue, s.Size, s.Workers)
+//	}
+func Stats(q Queue, queues ...string) ([]QueueStats, error) {
+	now := time.Now()
+	store := getMetricsStore()
+	window := metricsWindow.Minutes()
+
+	result := make([]QueueStats, 0, len(queues))
+	for _, name := range queues {
+		stats := QueueStats{Queue: name}
+
+		size, err := q.Size(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s queue boyutu alınamadı: %w", name, err)
+		}
+		stats.Size = size
+
+		if ager, ok := q.(oldestJobAger); ok && size > 0 {
+			if stats.OldestJobAge, err = ager.OldestJobAge(name); err != nil {
+				return nil, fmt.Errorf("%s queue en eski job okunamadı: %w", name, err)
+			}
+		}
+
+		if store != nil {
+			metrics, err := store.Metrics(name, now)
+			if err != nil {
+				return nil, fmt.Errorf("%s queue metrikleri alınamadı: %w", name, err)
+			}
+			stats.Processed = metrics.Processed
+			stats.Failed = metrics.Failed
+			stats.ProcessedPerMinute = float64(metrics.RecentProcessed) / window
+			stats.FailedPerMinute = float64(metrics.RecentFailed) / window
+			stats.Workers = metrics.Workers
+		}
+
+		result = append(result, stats)
+	}
+
+	return result, nil
+}
+
+// WritePrometheus, queue durumlarını Prometheus text formatında yazar.
+//
+// Örnek:
+//
+//	r.GET("/metrics", func(w http.ResponseWriter, r *request.Request) {
+//	    stats, _ := queue.Stats(q, "default")
+//	    w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+//	    queue.WritePrometheus(w, stats)
+//	})
+func WritePrometheus(w io.Writer, stats []QueueStats) error {
+	metrics := []struct {
+		name, kind, help string
+		value            func(QueueStats) float64
+	}{
+		{"conduit_queue_size", "gauge", "Jobs waiting in the queue (including delayed).",
+			func(s QueueStats) float64 { return float64(s.Size) }},
+		{"conduit_queue_oldest_job_age_seconds", "gauge", "Age of the oldest ready job.",
+			func(s QueueStats) float64 { return s.OldestJobAge.Seconds() }},
+		{"conduit_queue_jobs_processed_total", "counter", "Jobs completed successfully.",
+			func(s QueueStats) float64 { return float64(s.Processed) }},
+		{"conduit_queue_jobs_failed_total", "counter", "Jobs failed permanently.",
+			func(s QueueStats) float64 { return float64(s.Failed) }},
+		{"conduit_queue_workers", "gauge", "Active worker processes listening on the queue.",
+			func(s QueueStats) float64 { return float64(s.Workers) }},
+	}
+
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind); err != nil {
+			return err
+		}
+		for _, s := range stats {
+			// %q Prometheus label escape kurallarıyla uyumludur (\", \\, \n)
+			if _, err := fmt.Fprintf(w, "%s{queue=%q} %g\n", metric.name, s.Queue, metric.value(s)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
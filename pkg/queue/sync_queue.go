@@ -16,7 +16,9 @@ import (
 
 // SyncQueue, synchronous queue implementation.
 type SyncQueue struct {
-	logger *log.Logger
+	logger     *log.Logger
+	failed     FailedJobProvider
+	deadLetter DeadLetterSink
 }
 
 // NewSyncQueue, yeni bir Sync queue instance oluşturur.
@@ -26,28 +28,71 @@ func NewSyncQueue(logger *log.Logger) *SyncQueue {
 	}
 }
 
+// SetFailedJobProvider, başarısız job'ların kaydedileceği provider'ı ayarlar.
+//
+// Sync queue'da retry yoktur; hata alan job doğrudan kaydedilir.
+func (s *SyncQueue) SetFailedJobProvider(provider FailedJobProvider) *SyncQueue {
+	s.failed = provider
+	return s
+}
+
+// SetDeadLetterSink, başarısız job'ların gönderileceği dead letter hedefini
+// ayarlar (bkz: dead_letter.go).
+func (s *SyncQueue) SetDeadLetterSink(sink DeadLetterSink) *SyncQueue {
+	s.deadLetter = sink
+	return s
+}
+
 // Push, job'ı hemen çalıştırır.
 func (s *SyncQueue) Push(job Job, queue string) error {
-	s.logger.Printf("⚡ Sync executing job: %s (queue: %s)", job.GetID(), queue)
+	s.logger.Printf("⚡ Sync executing job: %s (queue: %s%s)", job.GetID(), queue, traceTag(job))
 
+	fireJobEvent(EventJobStarted, newJobEvent(queue, job, 0, nil))
+
+	startTime := time.Now()
 	err := job.Handle()
+	elapsed := time.Since(startTime)
+
 	if err != nil {
-		s.logger.Printf("❌ Job failed: %s (error: %v)", job.GetID(), err)
+		s.logger.Printf("❌ Job failed: %s (error: %v%s)", job.GetID(), err, traceTag(job))
 		job.Failed(err)
+		if s.failed != nil {
+			if _, logErr := s.failed.Log(queue, job, err); logErr != nil {
+				s.logger.Printf("⚠️  Failed job kaydedilemedi: %v", logErr)
+			}
+		}
+		sendDeadLetter(s.deadLetter, queue, job, err, s.logger)
+		recordBatchResult(job, err, s.logger)
+		releaseUniqueLock(job, s.logger)
+		recordJobMetric(queue, true, s.logger)
+		fireJobEvent(EventJobFailed, newJobEvent(queue, job, elapsed, err))
 		return err
 	}
 
 	s.logger.Printf("✅ Job completed: %s", job.GetID())
+	recordBatchResult(job, nil, s.logger)
+	releaseUniqueLock(job, s.logger)
+	recordJobMetric(queue, false, s.logger)
+	fireJobEvent(EventJobSucceeded, newJobEvent(queue, job, elapsed, nil))
 	return nil
 }
 
-// Later, job'ı gecikme ile çalıştırır.
+// Later, job'ı gecikme dolduğunda arka planda çalıştırır.
+//
+// Çağıran goroutine beklemez; handler'dan Later(2*time.Hour, ...) isteği
+// bloklamaz. Job'ın hatası Push'taki gibi loglanır ve failed job/dead
+// letter'a yazılır. Zamanlayıcı process belleğindedir; process kapanırsa
+// bekleyen job'lar kaybolur.
 func (s *SyncQueue) Later(delay time.Duration, job Job, queue string) error {
-	if delay > 0 {
-		s.logger.Printf("⏱️  Waiting %v before executing job: %s", delay, job.GetID())
-		time.Sleep(delay)
+	if delay <= 0 {
+		return s.Push(job, queue)
 	}
-	return s.Push(job, queue)
+
+	s.logger.Printf("⏱️  Job scheduled in %v: %s", delay, job.GetID())
+	time.AfterFunc(delay, func() {
+		s.Push(job, queue)
+	})
+	return nil
 }
 
 // Pop, sync queue'da kullanılmaz.
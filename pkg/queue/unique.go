@@ -0,0 +1,137 @@
+// -----------------------------------------------------------------------------
+// Unique Jobs
+// -----------------------------------------------------------------------------
+// ShouldBeUnique implement eden job'lar için aynı unique ID'ye sahip ikinci
+// bir dispatch, ilk job bitene kadar (veya lock süresi dolana kadar) kuyruğa
+// eklenmez:
+//
+//	type RecalculateStatsJob struct {
+//	    queue.BaseJob
+//	    UserID int64 `json:"user_id"`
+//	}
+//
+//	func (j *RecalculateStatsJob) UniqueID() string { return strconv.FormatInt(j.UserID, 10) }
+//	func (j *RecalculateStatsJob) UniqueFor() time.Duration { return 10 * time.Minute }
+//
+// Lock, cache.Add (Redis: SET NX) ile alınır ve job başarıyla bittiğinde veya
+// kalıcı olarak başarısız olduğunda bırakılır. Worker çökerse lock UniqueFor
+// süresi sonunda kendiliğinden düşer.
+//
+// Birden fazla process varsa lock store'u paylaşılan bir cache (Redis)
+// olmalıdır: queue.SetUniqueLockStore(cacheDriver)
+// -----------------------------------------------------------------------------
+
+package queue
+
+import (
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/google/uuid"
+)
+
+// DefaultUniqueFor, UniqueFor sıfır döndüğünde kullanılan lock süresi.
+const DefaultUniqueFor = time.Hour
+
+// ShouldBeUnique, aynı anda kuyrukta tek kopyası olması gereken job'lar için
+// opsiyonel interface.
+type ShouldBeUnique interface {
+	// UniqueID, job'ı aynı tipteki diğer job'lardan ayıran anahtar
+	// (örn: kullanıcı ID'si).
+	UniqueID() string
+
+	// UniqueFor, lock'un en fazla ne kadar tutulacağı (0 = DefaultUniqueFor).
+	UniqueFor() time.Duration
+}
+
+// Global unique lock store
+var (
+	uniqueLocks   cache.Cache
+	uniqueLocksMu sync.RWMutex
+)
+
+// SetUniqueLockStore, unique job lock'larının tutulacağı cache'i ayarlar.
+//
+// Ayarlanmazsa process içi bir memory cache kullanılır (sadece tek process
+// için doğru sonuç verir).
+func SetUniqueLockStore(store cache.Cache) {
+	uniqueLocksMu.Lock()
+	defer uniqueLocksMu.Unlock()
+
+	uniqueLocks = store
+}
+
+// getUniqueLockStore, aktif lock store'unu döndürür (gerekirse oluşturur).
+func getUniqueLockStore() cache.Cache {
+	uniqueLocksMu.RLock()
+	store := uniqueLocks
+	uniqueLocksMu.RUnlock()
+
+	if store != nil {
+		return store
+	}
+
+	uniqueLocksMu.Lock()
+	defer uniqueLocksMu.Unlock()
+
+	if uniqueLocks == nil {
+		uniqueLocks = cache.NewMemoryCache(log.New(io.Discard, "", 0))
+	}
+	return uniqueLocks
+}
+
+// uniqueLockKey, job'ın lock key'ini döndürür (unique değilse boş string).
+func uniqueLockKey(job Job) string {
+	unique, ok := job.(ShouldBeUnique)
+	if !ok {
+		return ""
+	}
+	return "unique_job:" + JobTypeName(job) + ":" + unique.UniqueID()
+}
+
+// acquireUniqueLock, unique job için lock almaya çalışır.
+//
+// Job unique değilse veya lock alındıysa true döner. Aynı anahtarla bekleyen
+// bir job varsa false döner ve job dispatch edilmemelidir.
+func acquireUniqueLock(job Job) (bool, error) {
+	key := uniqueLockKey(job)
+	if key == "" {
+		return true, nil
+	}
+
+	// Lock sahibi job ID'si ile işaretlenir; ID henüz yoksa şimdi verilir
+	if job.GetID() == "" {
+		job.SetID(uuid.New().String())
+	}
+
+	ttl := job.(ShouldBeUnique).UniqueFor()
+	if ttl <= 0 {
+		ttl = DefaultUniqueFor
+	}
+
+	return getUniqueLockStore().Add(key, job.GetID(), ttl)
+}
+
+// releaseUniqueLock, job bittiğinde lock'u bırakır.
+//
+// Lock sadece bu job'a aitse silinir; lock süresi dolup başka bir job
+// tarafından alınmışsa dokunulmaz.
+func releaseUniqueLock(job Job, logger *log.Logger) {
+	key := uniqueLockKey(job)
+	if key == "" {
+		return
+	}
+
+	store := getUniqueLockStore()
+	owner, err := store.Get(key)
+	if err != nil || owner != job.GetID() {
+		return
+	}
+
+	if err := store.Delete(key); err != nil {
+		logWith(logger, "⚠️  Unique job lock bırakılamadı [%s]: %v", key, err)
+	}
+}
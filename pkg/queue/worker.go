@@ -8,23 +8,40 @@
 // - Graceful shutdown
 // - Failed job handling
 // - Retry mechanism
-// - Concurrency control
+// - Job timeouts (context deadline, timeout bir deneme sayılır)
+// - Concurrency control (N goroutine)
+// - Queue priorities / weights (bkz: priority.go)
+// - Panic isolation (panic eden job başarısız sayılır, worker çalışmaya devam eder)
+// - Lifecycle events (job.started, job.succeeded, job.failed, job.retrying)
+// - Metrics ve heartbeat (queue.Stats / conduit queue:monitor, bkz: stats.go)
+// - Restart sinyali (conduit queue:restart, bkz: restart.go)
 //
 // Kullanım:
 //   worker := NewWorker(queue, logger)
-//   worker.Work("emails", "notifications")
+//   worker.SetConcurrency(8)
+//   worker.Work("critical", "default", "low")    // öncelik sırası
+//   worker.Work("critical:5", "default:3", "low") // ağırlıklı
 // -----------------------------------------------------------------------------
 
 package queue
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
+	"math/rand"
 	"os"
 	"os/signal"
+	"runtime/debug"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
+
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/google/uuid"
 )
 
 // Worker, queue job'larını işleyen yapı.
@@ -32,9 +49,21 @@ type Worker struct {
 	queue      Queue
 	logger     *log.Logger
 	stopChan   chan struct{}
+	stopOnce   sync.Once
 	wg         sync.WaitGroup
 	maxRetries int
 	retryDelay time.Duration
+	timeout    time.Duration // Kendi Timeout'u olmayan job'lar için limit (0 = limitsiz)
+	failed     FailedJobProvider
+	deadLetter DeadLetterSink
+
+	restart     cache.Cache   // queue:restart sinyalinin okunduğu cache (nil = kapalı)
+	restartPoll time.Duration // Restart sinyali kontrol aralığı
+
+	concurrency int           // Eşzamanlı goroutine sayısı
+	strategy    QueueStrategy // Çoklu queue stratejisi (boş = otomatik)
+	maxJobs     int64         // Bu kadar job işlenince dur (0 = limitsiz)
+	processed   int64         // İşlenen job sayısı (atomic)
 }
 
 // NewWorker, yeni bir Worker instance oluşturur.
@@ -57,6 +86,9 @@ func NewWorker(queue Queue, logger *log.Logger) *Worker {
 		stopChan:   make(chan struct{}),
 		maxRetries: 3,
 		retryDelay: 90 * time.Second,
+
+		restartPoll: restartPollInterval,
+		concurrency: 1,
 	}
 }
 
@@ -72,16 +104,98 @@ func (w *Worker) SetRetryDelay(delay time.Duration) *Worker {
 	return w
 }
 
+// SetTimeout, kendi Timeout'u olmayan job'lar için varsayılan çalışma süresi
+// limitini ayarlar (0 = limitsiz).
+//
+// Süreyi aşan job ErrJobTimeout ile başarısız sayılır. ContextJob'ların
+// context'i iptal edilir ve retry/failed akışına girer; düz Job'ların
+// bitmesi beklenir ve tekrar denenmeden failed_jobs'a kaydedilir.
+//
+// Örnek:
+//
+//	worker.SetTimeout(60 * time.Second)
+func (w *Worker) SetTimeout(timeout time.Duration) *Worker {
+	w.timeout = timeout
+	return w
+}
+
+// SetConcurrency, aynı process içinde çalışacak goroutine sayısını ayarlar.
+//
+// Her goroutine tüm queue'ları stratejiye göre dener (bkz: SetStrategy).
+// 1'den küçük değerler 1 kabul edilir.
+//
+// Örnek:
+//
+//	worker.SetConcurrency(8).Work("high", "default")
+func (w *Worker) SetConcurrency(n int) *Worker {
+	if n < 1 {
+		n = 1
+	}
+	w.concurrency = n
+	return w
+}
+
+// SetStrategy, birden fazla queue dinlenirken kullanılacak stratejiyi ayarlar.
+//
+// Ayarlanmazsa queue'lardan birine ağırlık verildiyse (örn: "critical:5")
+// StrategyWeighted, verilmediyse StrategyPriority kullanılır.
+//
+// Örnek:
+//
+//	worker.SetStrategy(queue.StrategyRoundRobin).Work("high", "low")
+func (w *Worker) SetStrategy(strategy QueueStrategy) *Worker {
+	w.strategy = strategy
+	return w
+}
+
+// SetMaxJobs, worker'ın toplam kaç job işledikten sonra duracağını ayarlar
+// (0 = limitsiz). Memory leak'lere karşı process'i periyodik yenilemek için
+// kullanılır.
+func (w *Worker) SetMaxJobs(n int) *Worker {
+	w.maxJobs = int64(n)
+	return w
+}
+
+// SetFailedJobProvider, MaxAttempts'i tükenen job'ların kaydedileceği
+// provider'ı ayarlar (bkz: failed.go).
+func (w *Worker) SetFailedJobProvider(provider FailedJobProvider) *Worker {
+	w.failed = provider
+	return w
+}
+
+// SetDeadLetterSink, kalıcı olarak başarısız job'ların failed_jobs'a ek
+// olarak gönderileceği dead letter hedefini ayarlar (bkz: dead_letter.go).
+func (w *Worker) SetDeadLetterSink(sink DeadLetterSink) *Worker {
+	w.deadLetter = sink
+	return w
+}
+
+// SetRestartSignal, conduit queue:restart sinyalinin okunacağı cache'i ayarlar
+// (bkz: restart.go).
+//
+// Sinyal gelince worker mevcut job'larını bitirip durur; yeni kodla tekrar
+// başlatmak supervisor'ın işidir.
+//
+// Örnek:
+//
+//	worker.SetRestartSignal(cacheDriver).Work("default")
+func (w *Worker) SetRestartSignal(store cache.Cache) *Worker {
+	w.restart = store
+	return w
+}
+
 // Work, belirtilen queue'ları dinlemeye başlar.
 //
 // Bu fonksiyon blocking'dir, goroutine'de çalıştırılmalı.
 //
 // Parametreler:
-//   - queues: Dinlenecek queue adları (variadic)
+//   - queues: Dinlenecek queue adları, öncelik sırasıyla (variadic). Her biri
+//     "ad:ağırlık" biçiminde veya virgülle ayrılmış liste olabilir.
 //
 // Örnek:
 //
 //	go worker.Work("emails", "notifications", "default")
+//	go worker.Work("critical:5,default:3,low:1")
 //
 // Graceful Shutdown:
 //
@@ -91,22 +205,48 @@ func (w *Worker) SetRetryDelay(delay time.Duration) *Worker {
 //	<-quit
 //	worker.Stop()
 func (w *Worker) Work(queues ...string) {
-	if len(queues) == 0 {
-		queues = []string{"default"}
+	parsed, weighted, err := ParseQueues(queues...)
+	if err != nil {
+		w.logger.Printf("❌ %v", err)
+		return
+	}
+	if len(parsed) == 0 {
+		parsed = []QueueWeight{{Name: "default", Weight: 1}}
+	}
+
+	strategy := w.strategy
+	if strategy == "" {
+		strategy = StrategyPriority
+		if weighted {
+			strategy = StrategyWeighted
+		}
 	}
 
 	w.logger.Println("\n" + strings.Repeat("=", 70))
 	w.logger.Println("🚀 Queue Worker Started")
 	w.logger.Println(strings.Repeat("=", 70))
-	w.logger.Printf("📋 Queues: %v", queues)
+	w.logger.Printf("📋 Queues: %s", formatQueues(parsed, strategy))
+	w.logger.Printf("🎯 Strategy: %s", strategy)
+	w.logger.Printf("🧵 Concurrency: %d", w.concurrency)
 	w.logger.Printf("🔄 Max Retries: %d", w.maxRetries)
 	w.logger.Printf("⏱️  Retry Delay: %v", w.retryDelay)
+	w.logger.Printf("⏰ Job Timeout: %v", w.timeout)
 	w.logger.Println(strings.Repeat("=", 70))
 
-	// Her queue için bir worker goroutine başlat
-	for _, queueName := range queues {
+	// queue:monitor worker'ı görebilsin diye periyodik heartbeat
+	w.wg.Add(1)
+	go w.heartbeat(parsed)
+
+	// queue:restart sinyali
+	if w.restart != nil {
+		w.wg.Add(1)
+		go w.watchRestart(w.restart)
+	}
+
+	// N goroutine başlat
+	for i := 0; i < w.concurrency; i++ {
 		w.wg.Add(1)
-		go w.processQueue(queueName)
+		go w.run(i, parsed, strategy)
 	}
 
 	// Graceful shutdown signal handler
@@ -117,86 +257,238 @@ func (w *Worker) Work(queues ...string) {
 	w.logger.Println("✅ Queue Worker Stopped")
 }
 
-// processQueue, tek bir queue'yu işler.
-func (w *Worker) processQueue(queueName string) {
+// heartbeat, worker duruncaya kadar metrics store'a kendini bildirir;
+// dururken kaydını siler.
+func (w *Worker) heartbeat(queues []QueueWeight) {
 	defer w.wg.Done()
 
-	w.logger.Printf("✅ Worker started for queue: %s", queueName)
+	names := make([]string, len(queues))
+	for i, q := range queues {
+		names[i] = q.Name
+	}
+
+	hostname, _ := os.Hostname()
+	workerID := fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), uuid.New().String()[:8])
+
+	beat := func() {
+		if store := getMetricsStore(); store != nil {
+			if err := store.Heartbeat(workerID, names, time.Now()); err != nil {
+				w.logger.Printf("⚠️  Worker heartbeat yazılamadı: %v", err)
+			}
+		}
+	}
+	beat()
+
+	ticker := time.NewTicker(workerHeartbeat)
+	defer ticker.Stop()
 
 	for {
+		select {
+		case <-ticker.C:
+			beat()
+		case <-w.stopChan:
+			if store := getMetricsStore(); store != nil {
+				store.ForgetWorker(workerID, names)
+			}
+			return
+		}
+	}
+}
+
+// run, tek bir worker goroutine'idir.
+//
+// Her turda queue'lar stratejinin belirlediği sırayla denenir; round-robin'de
+// goroutine'ler farklı offset'lerden başladığı için queue'lar eşit paylaşılır.
+func (w *Worker) run(id int, queues []QueueWeight, strategy QueueStrategy) {
+	defer w.wg.Done()
+
+	w.logger.Printf("✅ Worker #%d started", id+1)
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano() + int64(id)))
+
+	for tick := id; ; tick++ {
 		select {
 		case <-w.stopChan:
-			w.logger.Printf("🛑 Worker stopping for queue: %s", queueName)
+			w.logger.Printf("🛑 Worker #%d stopping", id+1)
 			return
 		default:
-			// Job çek
-			job, err := w.queue.Pop(queueName)
-			if err != nil {
-				w.logger.Printf("❌ Job pop hatası [%s]: %v", queueName, err)
-				time.Sleep(1 * time.Second)
-				continue
-			}
+		}
 
-			// Queue boş
-			if job == nil {
-				continue
-			}
+		order := queueOrder(strategy, queues, tick, rnd)
+
+		// Job çek
+		job, queueName, err := popFirst(w.queue, order)
+		if err != nil {
+			w.logger.Printf("❌ Job pop hatası %v: %v", order, err)
+			time.Sleep(1 * time.Second)
+			continue
+		}
+
+		// Queue boş
+		if job == nil {
+			continue
+		}
+
+		// Job'ı işle
+		w.processJob(queueName, job)
+
+		if w.maxJobs > 0 && atomic.AddInt64(&w.processed, 1) >= w.maxJobs {
+			w.logger.Printf("✅ Max jobs reached (%d), stopping...", w.maxJobs)
+			w.Stop()
+		}
+	}
+}
+
+// handle, job'ı timeout ile çalıştırır.
+//
+// Job'ın kendi Timeout'u, yoksa worker'ın varsayılanı uygulanır. Süre dolarsa
+// ErrJobTimeout döner; ContextJob implement eden job'ların context'i iptal
+// edilir. Go'da goroutine dışarıdan durdurulamadığı için job'ın dönmesi yine
+// de beklenir: slot (concurrency) erken boşalmaz ve aynı job retry ile iki
+// kez eşzamanlı çalışmaz.
+func (w *Worker) handle(job Job) error {
+	timeout := TimeoutOf(job)
+	if timeout <= 0 {
+		timeout = w.timeout
+	}
+	if timeout <= 0 {
+		return w.call(context.Background(), job)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- w.call(ctx, job)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	if _, ok := job.(ContextJob); ok {
+		w.logger.Printf("⏰ Job timed out: %s (after %v%s), waiting for cancellation", job.GetID(), timeout, traceTag(job))
+	} else {
+		w.logger.Printf("⏰ Job timed out: %s (after %v%s), job does not implement ContextJob; waiting for it to finish", job.GetID(), timeout, traceTag(job))
+	}
+	<-done
+
+	return fmt.Errorf("%w: %v", ErrJobTimeout, timeout)
+}
 
-			// Job'ı işle
-			w.processJob(queueName, job)
+// retryable, başarısız job'ın tekrar denenip denenemeyeceğini döndürür.
+//
+// Timeout'u aşan düz Job iptal sinyali alamadığı için sonuna kadar çalışmıştır;
+// tekrar denemek yan etkilerini (email, ödeme) tekrarlar. Bu job'lar doğrudan
+// failed akışına girer.
+func retryable(job Job, err error) bool {
+	if job.GetAttempts()+1 >= job.GetMaxAttempts() {
+		return false
+	}
+	if _, ok := job.(ContextJob); !ok && errors.Is(err, ErrJobTimeout) {
+		return false
+	}
+	return true
+}
+
+// call, job'ı çalıştırır; panic'i hataya çevirir.
+//
+// Panic eden job diğer goroutine'leri ve process'i düşürmez; normal hata
+// gibi retry/failed akışına girer.
+func (w *Worker) call(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.logger.Printf("💥 Job panic: %s (%v%s)\n%s", job.GetID(), r, traceTag(job), debug.Stack())
+			err = fmt.Errorf("job panic: %v", r)
 		}
+	}()
+
+	if contextJob, ok := job.(ContextJob); ok {
+		return contextJob.HandleContext(ctx)
 	}
+	return job.Handle()
 }
 
 // processJob, tek bir job'ı işler.
 func (w *Worker) processJob(queueName string, job Job) {
 	startTime := time.Now()
 
-	w.logger.Printf("🔄 Processing job: %s (queue: %s, attempt: %d/%d)",
-		job.GetID(), queueName, job.GetAttempts()+1, job.GetMaxAttempts())
+	w.logger.Printf("🔄 Processing job: %s (queue: %s, attempt: %d/%d%s)",
+		job.GetID(), queueName, job.GetAttempts()+1, job.GetMaxAttempts(), traceTag(job))
+
+	fireJobEvent(EventJobStarted, newJobEvent(queueName, job, 0, nil))
 
-	// Job'ı çalıştır
-	err := job.Handle()
+	// Job'ı çalıştır (panic izole edilir)
+	err := w.handle(job)
+	elapsed := time.Since(startTime)
 
 	// Başarılı
 	if err == nil {
-		elapsed := time.Since(startTime)
-		w.logger.Printf("✅ Job completed: %s (queue: %s, duration: %v)",
-			job.GetID(), queueName, elapsed)
+		w.logger.Printf("✅ Job completed: %s (queue: %s, duration: %v%s)",
+			job.GetID(), queueName, elapsed, traceTag(job))
 
 		// Queue'dan sil
 		if delErr := w.queue.Delete(queueName, job); delErr != nil {
 			w.logger.Printf("⚠️  Job delete hatası: %v", delErr)
 		}
+
+		recordBatchResult(job, nil, w.logger)
+		releaseUniqueLock(job, w.logger)
+		recordJobMetric(queueName, false, w.logger)
+		fireJobEvent(EventJobSucceeded, newJobEvent(queueName, job, elapsed, nil))
 		return
 	}
 
 	// Başarısız
-	w.logger.Printf("❌ Job failed: %s (queue: %s, error: %v)",
-		job.GetID(), queueName, err)
+	w.logger.Printf("❌ Job failed: %s (queue: %s, error: %v%s)",
+		job.GetID(), queueName, err, traceTag(job))
 
-	// Max attempts kontrolü
-	if job.GetAttempts()+1 >= job.GetMaxAttempts() {
-		w.logger.Printf("⚠️  Job max attempts reached: %s (queue: %s)",
-			job.GetID(), queueName)
+	// Max attempts (veya tekrar denenemeyen timeout) kontrolü
+	if !retryable(job, err) {
+		w.logger.Printf("⚠️  Job will not be retried: %s (queue: %s, attempt: %d/%d%s)",
+			job.GetID(), queueName, job.GetAttempts()+1, job.GetMaxAttempts(), traceTag(job))
 
 		// Failed handler çağır
 		if failErr := job.Failed(err); failErr != nil {
 			w.logger.Printf("⚠️  Job failed handler hatası: %v", failErr)
 		}
 
+		// failed_jobs'a kaydet (queue:retry ile tekrar denenebilir)
+		if w.failed != nil {
+			if id, logErr := w.failed.Log(queueName, job, err); logErr != nil {
+				w.logger.Printf("⚠️  Failed job kaydedilemedi: %v", logErr)
+			} else {
+				w.logger.Printf("📥 Failed job kaydedildi: #%d (%s%s)", id, job.GetID(), traceTag(job))
+			}
+		}
+
+		sendDeadLetter(w.deadLetter, queueName, job, err, w.logger)
+
 		// Queue'dan sil (failed queue'ya taşınacak)
 		w.queue.Release(queueName, job, 0)
+
+		recordBatchResult(job, err, w.logger)
+		releaseUniqueLock(job, w.logger)
+		recordJobMetric(queueName, true, w.logger)
+		fireJobEvent(EventJobFailed, newJobEvent(queueName, job, elapsed, err))
 		return
 	}
 
 	// Retry için tekrar kuyruğa ekle
-	w.logger.Printf("🔄 Job retrying: %s (queue: %s, next attempt: %d/%d)",
-		job.GetID(), queueName, job.GetAttempts()+2, job.GetMaxAttempts())
+	w.logger.Printf("🔄 Job retrying: %s (queue: %s, next attempt: %d/%d%s)",
+		job.GetID(), queueName, job.GetAttempts()+2, job.GetMaxAttempts(), traceTag(job))
+
+	retrying := newJobEvent(queueName, job, elapsed, err)
+	retrying.Delay = w.retryDelay
 
 	if relErr := w.queue.Release(queueName, job, w.retryDelay); relErr != nil {
 		w.logger.Printf("❌ Job release hatası: %v", relErr)
 	}
+
+	fireJobEvent(EventJobRetrying, retrying)
 }
 
 // Stop, worker'ı gracefully durdurur.
@@ -207,8 +499,10 @@ func (w *Worker) processJob(queueName string, job Job) {
 //
 //	worker.Stop()
 func (w *Worker) Stop() {
-	w.logger.Println("🛑 Stopping queue worker...")
-	close(w.stopChan)
+	w.stopOnce.Do(func() {
+		w.logger.Println("🛑 Stopping queue worker...")
+		close(w.stopChan)
+	})
 }
 
 // handleShutdown, SIGTERM/SIGINT sinyallerini dinler.
@@ -242,3 +536,15 @@ func (w *Worker) Stats(queues ...string) map[string]interface{} {
 
 	return stats
 }
+
+// formatQueues, queue listesini log için biçimlendirir.
+func formatQueues(queues []QueueWeight, strategy QueueStrategy) string {
+	names := make([]string, len(queues))
+	for i, q := range queues {
+		names[i] = q.Name
+		if strategy == StrategyWeighted {
+			names[i] = fmt.Sprintf("%s:%d", q.Name, q.Weight)
+		}
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,77 @@
+// -----------------------------------------------------------------------------
+// Request ID Propagation
+// -----------------------------------------------------------------------------
+// Bu paket, bir HTTP isteğine atanan request ID'yi context üzerinden taşır.
+// ID, middleware tarafından atanır; queue'ya dispatch edilen job'lara ve
+// worker loglarına aktarılır. Böylece başarısız bir email job'u, onu
+// tetikleyen kayıt isteğine kadar geriye doğru izlenebilir.
+//
+// Kullanım:
+//
+//	ctx := requestid.WithID(r.Context(), requestid.New())
+//	id := requestid.FromContext(ctx)
+//
+// Paket internal'a bağımlı değildir; pkg/queue gibi framework paketleri de
+// kullanabilir.
+// -----------------------------------------------------------------------------
+
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// HeaderName, request ID'yi taşıyan HTTP header'ı.
+const HeaderName = "X-Request-ID"
+
+// maxLength, dışarıdan kabul edilen request ID'nin maksimum uzunluğu.
+const maxLength = 128
+
+// contextKey, context içinde request ID için kullanılan özel anahtar tipi.
+type contextKey struct{}
+
+// New, yeni bir request ID üretir (UUID v4).
+func New() string {
+	return uuid.New().String()
+}
+
+// WithID, request ID'yi context'e ekler.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext, context'teki request ID'yi döndürür (yoksa boş string).
+//
+// Örnek:
+//
+//	logger.Printf("[%s] Kullanıcı oluşturuldu", requestid.FromContext(r.Context()))
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Valid, dışarıdan gelen (örn: load balancer'ın eklediği) request ID'nin
+// kabul edilebilir olup olmadığını kontrol eder.
+//
+// Log injection'ı önlemek için sadece harf, rakam ve "-_.:" karakterleri
+// kabul edilir.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+
+	for _, ch := range id {
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9':
+		case ch == '-' || ch == '_' || ch == '.' || ch == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
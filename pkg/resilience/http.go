@@ -0,0 +1,85 @@
+package resilience
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPClient, isteklerini isimlendirilmiş bir policy ile gönderen HTTP client'tır.
+//
+// Ağ hataları, 5xx ve 429 cevapları retry edilir; diğer 4xx cevaplar
+// retry edilmeden döner.
+//
+// Örnek:
+//
+//	client := resilience.NewHTTPClient(resilience.PolicyHTTP)
+//	req, _ := http.NewRequest("POST", url, bytes.NewReader(payload))
+//	resp, err := client.Do(ctx, req)
+type HTTPClient struct {
+	policy string
+	client *http.Client
+}
+
+// NewHTTPClient, verilen policy ile yeni bir HTTP client oluşturur.
+//
+// Policy her istekte registry'den okunur; config ile yeniden kaydedilen
+// policy'ler mevcut client'lara da uygulanır.
+func NewHTTPClient(policy string) *HTTPClient {
+	return &HTTPClient{
+		policy: policy,
+		client: &http.Client{}, // Timeout policy context'i ile uygulanır
+	}
+}
+
+// Do, isteği policy kurallarıyla gönderir.
+//
+// Request body retry'larda yeniden gönderilebilmesi için belleğe alınır.
+// Başarılı cevabın body'sini kapatmak çağıranın sorumluluğundadır.
+func (c *HTTPClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("request body okunamadı: %w", err)
+		}
+	}
+
+	var resp *http.Response
+	err := Get(c.policy).Execute(ctx, func(ctx context.Context) error {
+		attempt := req.Clone(ctx)
+		if body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		r, err := c.client.Do(attempt)
+		if err != nil {
+			return err
+		}
+
+		if r.StatusCode >= 500 || r.StatusCode == http.StatusTooManyRequests {
+			r.Body.Close()
+			return fmt.Errorf("%s %s: status %d", req.Method, req.URL, r.StatusCode)
+		}
+
+		// Body, attempt context'i iptal edilmeden önce okunmalı
+		data, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(data))
+
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
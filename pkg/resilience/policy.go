@@ -0,0 +1,310 @@
+// -----------------------------------------------------------------------------
+// Outbound Call Policies (Timeout / Retry / Circuit Breaker)
+// -----------------------------------------------------------------------------
+// Bu paket, dış servislere yapılan çağrılar (HTTP API'ler, SMTP, webhook'lar)
+// için isimlendirilmiş dayanıklılık policy'leri sağlar. Timeout, retry ve
+// circuit breaker eşikleri config'den okunur; operasyonel ayar değişikliği
+// için kod değiştirmek gerekmez.
+//
+// Kullanım:
+//
+//	resilience.Register("mail", resilience.Options{
+//	    Timeout:          10 * time.Second,
+//	    MaxRetries:       2,
+//	    CircuitThreshold: 5,
+//	})
+//
+//	err := resilience.Get("mail").Execute(ctx, func(ctx context.Context) error {
+//	    return sendMail(ctx)
+//	})
+// -----------------------------------------------------------------------------
+
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Varsayılan policy isimleri.
+const (
+	PolicyDefault = "default"
+	PolicyMail    = "mail"
+	PolicyHTTP    = "http"
+	PolicyWebhook = "webhook"
+)
+
+// ErrCircuitOpen, circuit açıkken yapılan çağrılarda döner.
+//
+// Bu durumda dış servis çağrılmaz; hata hızlıca döner (fail fast).
+var ErrCircuitOpen = errors.New("circuit breaker açık: çağrı reddedildi")
+
+// Options, bir policy'nin ayarlarını tutar.
+type Options struct {
+	Timeout          time.Duration // Deneme başına timeout (0 = sınırsız)
+	MaxRetries       int           // İlk denemeden sonraki ek deneme sayısı
+	Backoff          time.Duration // İlk retry öncesi bekleme (her denemede 2x)
+	MaxBackoff       time.Duration // Bekleme üst sınırı
+	CircuitThreshold int           // Art arda kaç hatada circuit açılır (0 = kapalı)
+	CircuitCooldown  time.Duration // Circuit açık kalma süresi
+}
+
+// DefaultOptions, config'de tanımlanmayan policy'ler için varsayılanları döndürür.
+func DefaultOptions() Options {
+	return Options{
+		Timeout:          10 * time.Second,
+		MaxRetries:       2,
+		Backoff:          200 * time.Millisecond,
+		MaxBackoff:       5 * time.Second,
+		CircuitThreshold: 5,
+		CircuitCooldown:  30 * time.Second,
+	}
+}
+
+// Policy, isimlendirilmiş bir dayanıklılık policy'sidir.
+//
+// Circuit breaker durumu policy başına tutulur; aynı policy'yi kullanan tüm
+// çağrılar (örn: tüm SMTP gönderimleri) aynı circuit'i paylaşır.
+type Policy struct {
+	Name    string
+	Options Options
+
+	mu          sync.Mutex
+	failures    int       // Art arda hata sayısı
+	openedAt    time.Time // Circuit'in açıldığı zaman (zero = kapalı)
+	halfOpenRun bool      // Cooldown sonrası deneme çağrısı sürüyor mu
+}
+
+// NewPolicy, yeni bir policy oluşturur.
+func NewPolicy(name string, opts Options) *Policy {
+	return &Policy{Name: name, Options: opts}
+}
+
+// permanentError, retry edilmemesi gereken hataları işaretler.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent, hatayı retry edilemez olarak işaretler.
+//
+// Örneğin 4xx HTTP cevapları veya geçersiz alıcı adresi gibi tekrar
+// denemenin sonucu değiştirmeyeceği durumlarda kullanılır.
+//
+// Örnek:
+//
+//	if resp.StatusCode == 400 {
+//	    return resilience.Permanent(fmt.Errorf("bad request"))
+//	}
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Execute, fn'i policy kurallarıyla çalıştırır.
+//
+// Her deneme policy timeout'u ile sınırlı bir context alır. Hata durumunda
+// exponential backoff ile MaxRetries kadar tekrar denenir. Permanent ile
+// işaretlenen hatalar ve ctx iptali retry edilmez.
+//
+// Permanent hatalar (4xx, geçersiz alıcı) ve çağıranın ctx iptali circuit
+// breaker'a hata olarak sayılmaz: servis cevap vermiştir veya çağrı servis
+// yüzünden yarıda kalmamıştır. Aksi halde kullanıcı kaynaklı birkaç hatalı
+// istek circuit'i açıp herkesin çağrılarını engellerdi.
+//
+// Parametreler:
+//   - ctx: Üst context (iptal edilirse retry'lar durur)
+//   - fn: Dış servis çağrısı; verilen context'e uymalıdır
+//
+// Döndürür:
+//   - error: Son denemenin hatası veya ErrCircuitOpen
+func (p *Policy) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !p.allow() {
+		return fmt.Errorf("%s: %w", p.Name, ErrCircuitOpen)
+	}
+
+	backoff := p.Options.Backoff
+	var err error
+
+	for attempt := 0; attempt <= p.Options.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				p.release()
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if p.Options.MaxBackoff > 0 && backoff > p.Options.MaxBackoff {
+				backoff = p.Options.MaxBackoff
+			}
+		}
+
+		err = p.attempt(ctx, fn)
+		if err == nil {
+			break
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			// Servis cevap verdi; circuit açısından başarılı çağrı
+			p.record(nil)
+			return permanent.err
+		}
+		if ctx.Err() != nil {
+			p.release()
+			return err
+		}
+	}
+
+	p.record(err)
+	return err
+}
+
+// attempt, tek bir denemeyi timeout ile çalıştırır.
+func (p *Policy) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if p.Options.Timeout <= 0 {
+		return fn(ctx)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, p.Options.Timeout)
+	defer cancel()
+
+	return fn(attemptCtx)
+}
+
+// allow, circuit durumuna göre çağrıya izin verilip verilmediğini döndürür.
+//
+// Cooldown dolduğunda tek bir deneme çağrısına (half-open) izin verilir;
+// başarılı olursa circuit kapanır, başarısız olursa cooldown yeniden başlar.
+func (p *Policy) allow() bool {
+	if p.Options.CircuitThreshold <= 0 {
+		return true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.openedAt.IsZero() {
+		return true
+	}
+	if time.Since(p.openedAt) < p.Options.CircuitCooldown || p.halfOpenRun {
+		return false
+	}
+
+	p.halfOpenRun = true
+	return true
+}
+
+// record, çağrı sonucunu circuit durumuna işler.
+func (p *Policy) record(err error) {
+	if p.Options.CircuitThreshold <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.halfOpenRun = false
+
+	if err == nil {
+		p.failures = 0
+		p.openedAt = time.Time{}
+		return
+	}
+
+	p.failures++
+	if p.failures >= p.Options.CircuitThreshold {
+		p.openedAt = time.Now()
+	}
+}
+
+// release, sonucu circuit'e işlemeden half-open deneme hakkını bırakır.
+//
+// Çağıran ctx'i iptal ettiğinde kullanılır; servis sağlığı hakkında bilgi
+// vermeyen sonuçlar hata sayacını değiştirmez.
+func (p *Policy) release() {
+	if p.Options.CircuitThreshold <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.halfOpenRun = false
+}
+
+// State, circuit durumunu döndürür: "closed", "open" veya "half-open".
+//
+// Monitoring ve health endpoint'leri için kullanılır.
+func (p *Policy) State() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch {
+	case p.openedAt.IsZero():
+		return "closed"
+	case time.Since(p.openedAt) < p.Options.CircuitCooldown:
+		return "open"
+	default:
+		return "half-open"
+	}
+}
+
+// Global policy registry (RegisterCORSPolicy ile aynı pattern)
+var (
+	policies   = make(map[string]*Policy)
+	policiesMu sync.RWMutex
+)
+
+// Register, isimlendirilmiş bir policy kaydeder (varsa üzerine yazar).
+//
+// Uygulama başlatılırken (main.go) config yüklendikten sonra çağrılmalı.
+func Register(name string, opts Options) *Policy {
+	policy := NewPolicy(name, opts)
+
+	policiesMu.Lock()
+	defer policiesMu.Unlock()
+	policies[name] = policy
+
+	return policy
+}
+
+// Get, isimlendirilmiş policy'yi döndürür.
+//
+// Policy kayıtlı değilse "default" policy, o da yoksa DefaultOptions ile
+// oluşturulan policy kaydedilip döndürülür; böylece çağıranlar nil kontrolü
+// yapmak zorunda kalmaz.
+func Get(name string) *Policy {
+	policiesMu.RLock()
+	policy, ok := policies[name]
+	if !ok {
+		policy, ok = policies[PolicyDefault]
+	}
+	policiesMu.RUnlock()
+
+	if ok {
+		return policy
+	}
+	return Register(PolicyDefault, DefaultOptions())
+}
+
+// Policies, kayıtlı tüm policy'leri döndürür.
+func Policies() map[string]*Policy {
+	policiesMu.RLock()
+	defer policiesMu.RUnlock()
+
+	result := make(map[string]*Policy, len(policies))
+	for name, policy := range policies {
+		result[name] = policy
+	}
+	return result
+}
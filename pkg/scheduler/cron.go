@@ -0,0 +1,192 @@
+// -----------------------------------------------------------------------------
+// Cron Expressions
+// -----------------------------------------------------------------------------
+// Standart 5 alanlı cron ifadeleri:
+//
+//	┌───────────── dakika (0-59)
+//	│ ┌─────────── saat (0-23)
+//	│ │ ┌───────── ayın günü (1-31)
+//	│ │ │ ┌─────── ay (1-12)
+//	│ │ │ │ ┌───── haftanın günü (0-6, 0 ve 7 = Pazar)
+//	* * * * *
+//
+// Her alan `*`, sayı, aralık (`1-5`), adım (`*/15`, `0-30/10`) veya virgülle
+// ayrılmış liste (`0,30`) olabilir. Kısaltmalar: @yearly, @annually,
+// @monthly, @weekly, @daily, @midnight, @hourly.
+//
+// Ayın günü ve haftanın günü birlikte kısıtlanırsa (ikisi de `*` değilse)
+// cron'daki gibi herhangi biri eşleştiğinde ifade eşleşir.
+// -----------------------------------------------------------------------------
+
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronMacros, kısaltmaların karşılığıdır.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Cron, ayrıştırılmış bir cron ifadesidir.
+type Cron struct {
+	expression string
+
+	minute, hour, dom, month, dow uint64 // Eşleşen değerlerin bit kümeleri
+	domStar, dowStar              bool   // Gün alanları `*` mı
+}
+
+// ParseCron, cron ifadesini ayrıştırır.
+//
+// Örnek:
+//
+//	cron, err := scheduler.ParseCron("*/15 9-17 * * 1-5")
+//	next := cron.Next(time.Now())
+func ParseCron(expression string) (*Cron, error) {
+	fields := strings.Fields(expression)
+	if len(fields) == 1 {
+		macro, ok := cronMacros[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("cron: bilinmeyen kısaltma: %s", fields[0])
+		}
+		fields = strings.Fields(macro)
+	}
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron: 5 alan bekleniyor, %d alan verildi: %q", len(fields), expression)
+	}
+
+	c := &Cron{expression: expression}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("cron: dakika: %w", err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("cron: saat: %w", err)
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("cron: ayın günü: %w", err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("cron: ay: %w", err)
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("cron: haftanın günü: %w", err)
+	}
+	// 7 de Pazar'dır
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domStar = fields[2] == "*"
+	c.dowStar = fields[4] == "*"
+
+	return c, nil
+}
+
+// String, ifadenin orijinal halini döndürür.
+func (c *Cron) String() string {
+	return c.expression
+}
+
+// Matches, t'nin dakikası ifadeyle eşleşiyorsa true döner.
+func (c *Cron) Matches(t time.Time) bool {
+	return c.minute&(1<<uint(t.Minute())) != 0 &&
+		c.hour&(1<<uint(t.Hour())) != 0 &&
+		c.month&(1<<uint(t.Month())) != 0 &&
+		c.dayMatches(t)
+}
+
+// Next, after'dan sonraki ilk eşleşen dakikayı döndürür (after'ın
+// location'ında). 5 yıl içinde eşleşme yoksa (örn: 30 Şubat) sıfır zaman
+// döner.
+func (c *Cron) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	loc := t.Location()
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches, gün alanlarını cron kurallarına göre değerlendirir.
+func (c *Cron) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseCronField, tek bir alanı bit kümesine çevirir.
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, fmt.Errorf("geçersiz adım: %s", part)
+			}
+		}
+
+		start, end := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err1, err2 error
+			start, err1 = strconv.Atoi(from)
+			end, err2 = strconv.Atoi(to)
+			if err1 != nil || err2 != nil || start > end {
+				return 0, fmt.Errorf("geçersiz aralık: %s", part)
+			}
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("geçersiz değer: %s", part)
+			}
+			start = value
+			if !hasStep {
+				end = value
+			}
+		}
+
+		if start < min || end > max {
+			return 0, fmt.Errorf("%s %d-%d aralığı dışında", part, min, max)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
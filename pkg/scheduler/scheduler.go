@@ -0,0 +1,366 @@
+// -----------------------------------------------------------------------------
+// Task Scheduler
+// -----------------------------------------------------------------------------
+// Tekrarlanan görevleri (rapor üretimi, süresi dolmuş kayıtların temizliği,
+// cache prune) kod içinde tanımlar (Laravel Task Scheduling karşılığı).
+// Sunucuda tek bir cron girdisi yeterlidir:
+//
+//	* * * * * cd /app && conduit schedule:run >> /dev/null 2>&1
+//
+// veya cron olmayan ortamlarda (container) `conduit schedule:work` çalışır.
+//
+// Görevler internal/providers/schedule.go içinde tanımlanır:
+//
+//	s.Call("reports:daily", func(ctx context.Context) error {
+//	    return reports.SendDaily(ctx)
+//	}).DailyAt("08:00")
+//
+//	s.Call("tokens:prune", pruneTokens).Hourly().WithoutOverlapping()
+//
+// Aynı dakikada due olan görevler paralel çalışır; bir görevin hatası veya
+// panic'i diğerlerini etkilemez.
+// -----------------------------------------------------------------------------
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/cache"
+)
+
+// DefaultOverlapExpiry, WithoutOverlapping lock'unun en fazla tutulacağı
+// süredir. Process çökerse lock bu süre sonunda düşer.
+const DefaultOverlapExpiry = 24 * time.Hour
+
+// TaskFunc, zamanlanmış görevin çalıştırdığı fonksiyondur.
+type TaskFunc func(ctx context.Context) error
+
+// Task, zamanlanmış bir görevdir. Sıklık fluent metodlarla ayarlanır;
+// varsayılan her dakikadır.
+type Task struct {
+	name string
+	fn   TaskFunc
+	cron *Cron
+
+	description    string
+	withoutOverlap bool
+	overlapExpiry  time.Duration
+}
+
+// Result, bir görev çalıştırmasının sonucudur.
+type Result struct {
+	Task     string
+	Duration time.Duration
+	Err      error
+	Skipped  bool // Önceki çalıştırma sürdüğü için atlandı (WithoutOverlapping)
+}
+
+// Scheduler, görevleri tutar ve due olanları çalıştırır.
+type Scheduler struct {
+	mu       sync.RWMutex
+	tasks    []*Task
+	location *time.Location
+	locks    cache.Cache
+	logger   *log.Logger
+}
+
+// New, yeni bir Scheduler oluşturur.
+//
+// Zaman dilimi varsayılan olarak time.Local'dir. WithoutOverlapping lock'ları
+// varsayılan olarak process içi memory cache'te tutulur; schedule:run cron'dan
+// her dakika ayrı process olarak çalıştığı için SetLockStore ile paylaşılan
+// bir cache (Redis, file) verilmelidir.
+func New(logger *log.Logger) *Scheduler {
+	if logger == nil {
+		logger = log.New(os.Stdout, "", log.LstdFlags)
+	}
+	return &Scheduler{
+		location: time.Local,
+		locks:    cache.NewMemoryCache(log.New(io.Discard, "", 0)),
+		logger:   logger,
+	}
+}
+
+// SetLocation, cron ifadelerinin değerlendirileceği zaman dilimini ayarlar.
+func (s *Scheduler) SetLocation(loc *time.Location) *Scheduler {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.location = loc
+	return s
+}
+
+// SetLockStore, WithoutOverlapping lock'larının tutulacağı cache'i ayarlar.
+func (s *Scheduler) SetLockStore(store cache.Cache) *Scheduler {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locks = store
+	return s
+}
+
+// Call, fn'i name adıyla zamanlar. Name, listede ve lock key'lerinde
+// kullanılır; benzersiz olmalıdır.
+//
+// Parametreler:
+//   - name: Görev adı (örn: "tokens:prune")
+//   - fn: Çalıştırılacak fonksiyon
+//
+// Döndürür:
+//   - *Task: Sıklığı ayarlanacak görev (varsayılan: her dakika)
+//
+// Örnek:
+//
+//	s.Call("cache:prune", pruneCache).EveryFifteenMinutes()
+func (s *Scheduler) Call(name string, fn TaskFunc) *Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, task := range s.tasks {
+		if task.name == name {
+			panic(fmt.Sprintf("scheduler: %s zaten tanımlı", name))
+		}
+	}
+
+	task := &Task{name: name, fn: fn}
+	task.Cron("* * * * *")
+	s.tasks = append(s.tasks, task)
+	return task
+}
+
+// Tasks, tanımlı görevleri tanım sırasıyla döndürür.
+func (s *Scheduler) Tasks() []*Task {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]*Task(nil), s.tasks...)
+}
+
+// Location, scheduler'ın zaman dilimini döndürür.
+func (s *Scheduler) Location() *time.Location {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.location
+}
+
+// DueTasks, now dakikasında çalışması gereken görevleri döndürür.
+func (s *Scheduler) DueTasks(now time.Time) []*Task {
+	now = now.In(s.Location())
+
+	var due []*Task
+	for _, task := range s.Tasks() {
+		if task.cron.Matches(now) {
+			due = append(due, task)
+		}
+	}
+	return due
+}
+
+// RunDue, now dakikasında due olan görevleri paralel çalıştırır ve hepsi
+// bitince sonuçları tanım sırasıyla döndürür.
+func (s *Scheduler) RunDue(ctx context.Context, now time.Time) []Result {
+	due := s.DueTasks(now)
+	results := make([]Result, len(due))
+
+	var wg sync.WaitGroup
+	for i, task := range due {
+		wg.Add(1)
+		go func(i int, task *Task) {
+			defer wg.Done()
+			results[i] = s.run(ctx, task)
+		}(i, task)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// Work, ctx iptal edilene kadar her dakika başında due görevleri çalıştırır
+// (schedule:work). İptalden sonra çalışan görevlerin bitmesini bekler.
+func (s *Scheduler) Work(ctx context.Context) {
+	var running sync.WaitGroup
+	defer running.Wait()
+
+	for {
+		now := time.Now()
+		next := now.Truncate(time.Minute).Add(time.Minute)
+
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case tick := <-timer.C:
+			// Görevler bir sonraki dakikaya taşsa da takvim kaçırılmaz
+			running.Add(1)
+			go func(minute time.Time) {
+				defer running.Done()
+				s.RunDue(ctx, minute)
+			}(tick.Truncate(time.Minute))
+		}
+	}
+}
+
+// run, görevi lock ve panic korumasıyla çalıştırır.
+func (s *Scheduler) run(ctx context.Context, task *Task) (result Result) {
+	result.Task = task.name
+
+	if task.withoutOverlap {
+		key := task.lockKey()
+		acquired, err := s.lockStore().Add(key, true, task.overlapExpiry)
+		if err != nil {
+			result.Err = fmt.Errorf("lock alınamadı: %w", err)
+			s.logger.Printf("❌ Scheduled task failed: %s: %v", task.name, result.Err)
+			return result
+		}
+		if !acquired {
+			result.Skipped = true
+			s.logger.Printf("⏭️  Scheduled task skipped (still running): %s", task.name)
+			return result
+		}
+		defer s.lockStore().Delete(key)
+	}
+
+	start := time.Now()
+	s.logger.Printf("🔄 Running scheduled task: %s", task.name)
+
+	defer func() {
+		if r := recover(); r != nil {
+			result.Err = fmt.Errorf("panic: %v", r)
+		}
+		result.Duration = time.Since(start)
+
+		if result.Err != nil {
+			s.logger.Printf("❌ Scheduled task failed: %s (%s): %v", task.name, result.Duration.Round(time.Millisecond), result.Err)
+		} else {
+			s.logger.Printf("✅ Scheduled task done: %s (%s)", task.name, result.Duration.Round(time.Millisecond))
+		}
+	}()
+
+	result.Err = task.fn(ctx)
+	return result
+}
+
+// lockStore, aktif lock store'unu döndürür.
+func (s *Scheduler) lockStore() cache.Cache {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.locks
+}
+
+// Name, görevin adını döndürür.
+func (t *Task) Name() string {
+	return t.name
+}
+
+// Description, görevin açıklamasını döndürür.
+func (t *Task) Description() string {
+	return t.description
+}
+
+// Expression, görevin cron ifadesini döndürür.
+func (t *Task) Expression() string {
+	return t.cron.String()
+}
+
+// NextRun, after'dan sonraki çalışma zamanını döndürür.
+func (t *Task) NextRun(after time.Time) time.Time {
+	return t.cron.Next(after)
+}
+
+// Describe, schedule:list'te gösterilecek açıklamayı ayarlar.
+func (t *Task) Describe(description string) *Task {
+	t.description = description
+	return t
+}
+
+// Cron, görevin sıklığını cron ifadesiyle ayarlar. Geçersiz ifade
+// programlama hatasıdır ve panic'e yol açar.
+func (t *Task) Cron(expression string) *Task {
+	cron, err := ParseCron(expression)
+	if err != nil {
+		panic(fmt.Sprintf("scheduler: %s: %v", t.name, err))
+	}
+	t.cron = cron
+	return t
+}
+
+// EveryMinute, görevi her dakika çalıştırır.
+func (t *Task) EveryMinute() *Task { return t.Cron("* * * * *") }
+
+// EveryFiveMinutes, görevi 5 dakikada bir çalıştırır.
+func (t *Task) EveryFiveMinutes() *Task { return t.Cron("*/5 * * * *") }
+
+// EveryTenMinutes, görevi 10 dakikada bir çalıştırır.
+func (t *Task) EveryTenMinutes() *Task { return t.Cron("*/10 * * * *") }
+
+// EveryFifteenMinutes, görevi 15 dakikada bir çalıştırır.
+func (t *Task) EveryFifteenMinutes() *Task { return t.Cron("*/15 * * * *") }
+
+// EveryThirtyMinutes, görevi 30 dakikada bir çalıştırır.
+func (t *Task) EveryThirtyMinutes() *Task { return t.Cron("0,30 * * * *") }
+
+// Hourly, görevi her saat başı çalıştırır.
+func (t *Task) Hourly() *Task { return t.Cron("0 * * * *") }
+
+// HourlyAt, görevi her saatin verilen dakikasında çalıştırır.
+func (t *Task) HourlyAt(minute int) *Task { return t.Cron(fmt.Sprintf("%d * * * *", minute)) }
+
+// Daily, görevi her gün gece yarısı çalıştırır.
+func (t *Task) Daily() *Task { return t.Cron("0 0 * * *") }
+
+// DailyAt, görevi her gün verilen saatte ("13:00") çalıştırır.
+func (t *Task) DailyAt(clock string) *Task {
+	at, err := time.Parse("15:04", clock)
+	if err != nil {
+		panic(fmt.Sprintf("scheduler: %s: geçersiz saat: %s", t.name, clock))
+	}
+	return t.Cron(fmt.Sprintf("%d %d * * *", at.Minute(), at.Hour()))
+}
+
+// Weekly, görevi her Pazar gece yarısı çalıştırır.
+func (t *Task) Weekly() *Task { return t.Cron("0 0 * * 0") }
+
+// Monthly, görevi her ayın ilk günü gece yarısı çalıştırır.
+func (t *Task) Monthly() *Task { return t.Cron("0 0 1 * *") }
+
+// Weekdays, mevcut saat/dakikayı koruyarak görevi hafta içi günlerle
+// sınırlar.
+func (t *Task) Weekdays() *Task {
+	expression := t.cron.String()
+	if macro, ok := cronMacros[expression]; ok {
+		expression = macro
+	}
+	fields := strings.Fields(expression)
+	return t.Cron(fmt.Sprintf("%s %s %s %s 1-5", fields[0], fields[1], fields[2], fields[3]))
+}
+
+// WithoutOverlapping, önceki çalıştırma sürerken görevin tekrar
+// başlamasını engeller.
+//
+// Parametreler:
+//   - expiry: Lock'un en fazla tutulacağı süre (verilmezse
+//     DefaultOverlapExpiry)
+func (t *Task) WithoutOverlapping(expiry ...time.Duration) *Task {
+	t.withoutOverlap = true
+	t.overlapExpiry = DefaultOverlapExpiry
+	if len(expiry) > 0 && expiry[0] > 0 {
+		t.overlapExpiry = expiry[0]
+	}
+	return t
+}
+
+// lockKey, görevin overlap lock key'idir.
+func (t *Task) lockKey() string {
+	return "schedule:lock:" + t.name
+}
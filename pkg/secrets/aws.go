@@ -0,0 +1,129 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/awssig"
+)
+
+// AWSConfig, AWS Secrets Manager bağlantı ayarlarıdır.
+type AWSConfig struct {
+	Region          string // AWS region (örn: eu-central-1)
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // Geçici credential'lar için (opsiyonel)
+	Endpoint        string // API endpoint (boş = https://secretsmanager.{region}.amazonaws.com, LocalStack için override)
+}
+
+// AWSSecretsManager, AWS Secrets Manager'dan secret okuyan Resolver'dır.
+//
+// GetSecretValue API'si doğrudan HTTP ile çağrılır ve istekler Signature V4
+// ile imzalanır; AWS SDK bağımlılığı yoktur. Aynı secret'ın birden fazla
+// alanı okunduğunda (DB_DSN, DB_PASSWORD) API bir kez çağrılır.
+type AWSSecretsManager struct {
+	config AWSConfig
+	client *http.Client
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// NewAWSSecretsManager, yeni bir AWS Secrets Manager resolver'ı oluşturur.
+//
+// Örnek:
+//
+//	secrets.Register("aws-sm", secrets.NewAWSSecretsManager(secrets.AWSConfig{
+//	    Region:          "eu-central-1",
+//	    AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+//	    SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+//	}))
+func NewAWSSecretsManager(config AWSConfig) *AWSSecretsManager {
+	if config.Endpoint == "" {
+		config.Endpoint = "https://secretsmanager." + config.Region + ".amazonaws.com"
+	}
+	return &AWSSecretsManager{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+		cache:  make(map[string]string),
+	}
+}
+
+// Resolve, secret'ı (SecretString) okur; key verilirse JSON alanını döndürür.
+func (m *AWSSecretsManager) Resolve(ctx context.Context, path, key string) (string, error) {
+	secret, err := m.secretString(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	return Field(secret, key)
+}
+
+// secretString, GetSecretValue ile secret'ı okur ve cache'ler.
+func (m *AWSSecretsManager) secretString(ctx context.Context, name string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if secret, ok := m.cache[name]; ok {
+		return secret, nil
+	}
+
+	if m.config.AccessKeyID == "" || m.config.SecretAccessKey == "" {
+		return "", fmt.Errorf("aws-sm için AWS_ACCESS_KEY_ID ve AWS_SECRET_ACCESS_KEY gerekli")
+	}
+
+	body, _ := json.Marshal(map[string]string{"SecretId": name})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.config.Endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	awssig.Sign(req, body, awssig.Credentials{
+		AccessKeyID:     m.config.AccessKeyID,
+		SecretAccessKey: m.config.SecretAccessKey,
+		SessionToken:    m.config.SessionToken,
+	}, m.config.Region, "secretsmanager", time.Now())
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		json.Unmarshal(data, &apiErr)
+		if strings.HasSuffix(apiErr.Type, "ResourceNotFoundException") {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return "", fmt.Errorf("aws-sm GetSecretValue: status %d: %s %s", resp.StatusCode, apiErr.Type, apiErr.Message)
+	}
+
+	var result struct {
+		SecretString *string `json:"SecretString"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return "", fmt.Errorf("aws-sm cevabı decode edilemedi: %w", err)
+	}
+	if result.SecretString == nil {
+		return "", fmt.Errorf("aws-sm %s: binary secret'lar desteklenmiyor", name)
+	}
+
+	m.cache[name] = *result.SecretString
+	return *result.SecretString, nil
+}
@@ -0,0 +1,186 @@
+// -----------------------------------------------------------------------------
+// Secrets Package
+// -----------------------------------------------------------------------------
+// DB şifresi, JWT secret'ı gibi değerlerin env dosyalarında düz metin
+// tutulması yerine uygulama başlarken bir secret manager'dan okunmasını
+// sağlar. Config değeri bir referans olarak yazılır:
+//
+//	DB_DSN=secret://aws-sm/prod/app#db_dsn     # AWS Secrets Manager
+//	JWT_SECRET=vault://secret/app#jwt_secret   # Vault KV
+//
+// Biçim: secret://<provider>/<path>[#key] veya kısaca <provider>://<path>[#key].
+// #key verilirse secret JSON bir nesne olarak okunup alanı döndürülür.
+//
+// Yerleşik provider'lar: aws-sm (AWSSecretsManager) ve vault (Vault).
+// Kendi provider'ınızı Register ile ekleyebilirsiniz:
+//
+//	secrets.Register("gcp-sm", myResolver)
+//	value, err := secrets.Resolve(ctx, "gcp-sm://projects/app/db#password")
+// -----------------------------------------------------------------------------
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrNotFound, secret veya istenen alan bulunamadığında döner.
+var ErrNotFound = errors.New("secret bulunamadı")
+
+// Resolver, bir provider'daki secret'ı okur.
+type Resolver interface {
+	// Resolve, path'teki secret'ı döndürür. key boş değilse secret JSON
+	// nesnesinin key alanı döndürülmelidir (bkz: Field).
+	Resolve(ctx context.Context, path, key string) (string, error)
+}
+
+// ResolverFunc, fonksiyonları Resolver olarak kullanmayı sağlar.
+type ResolverFunc func(ctx context.Context, path, key string) (string, error)
+
+// Resolve, Resolver interface'ini implement eder.
+func (f ResolverFunc) Resolve(ctx context.Context, path, key string) (string, error) {
+	return f(ctx, path, key)
+}
+
+// Reference, parse edilmiş bir secret referansıdır.
+type Reference struct {
+	Provider string // aws-sm, vault, ...
+	Path     string // Provider'daki secret adı/yolu
+	Key      string // JSON alanı (opsiyonel)
+}
+
+// String, referansı secret://provider/path#key biçiminde döndürür.
+func (r Reference) String() string {
+	s := "secret://" + r.Provider + "/" + r.Path
+	if r.Key != "" {
+		s += "#" + r.Key
+	}
+	return s
+}
+
+var (
+	mu        sync.RWMutex
+	resolvers = map[string]Resolver{}
+)
+
+// Register, provider adı için bir Resolver kaydeder (aynı adla tekrar
+// çağrılırsa öncekini ezer).
+func Register(provider string, r Resolver) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	resolvers[provider] = r
+}
+
+// Get, provider için kayıtlı Resolver'ı döndürür.
+func Get(provider string) (Resolver, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	r, ok := resolvers[provider]
+	return r, ok
+}
+
+// Parse, value bir secret referansıysa parse eder.
+//
+// secret:// her zaman referanstır; <provider>:// kısa biçimi sadece o
+// adla bir Resolver kayıtlıysa referans sayılır (böylece https://,
+// redis:// gibi normal URL'ler etkilenmez).
+//
+// Döndürür:
+//   - Reference: Parse edilen referans
+//   - bool: value bir referans mı
+//   - error: Referans ama biçimi hatalıysa
+func Parse(value string) (Reference, bool, error) {
+	scheme, rest, found := strings.Cut(value, "://")
+	if !found || scheme == "" {
+		return Reference{}, false, nil
+	}
+
+	var ref Reference
+	if scheme == "secret" {
+		provider, path, _ := strings.Cut(rest, "/")
+		ref = Reference{Provider: provider, Path: path}
+	} else {
+		if _, ok := Get(scheme); !ok {
+			return Reference{}, false, nil
+		}
+		ref = Reference{Provider: scheme, Path: rest}
+	}
+
+	if path, key, hasKey := strings.Cut(ref.Path, "#"); hasKey {
+		ref.Path, ref.Key = path, key
+	}
+	if ref.Provider == "" || ref.Path == "" {
+		return Reference{}, true, fmt.Errorf("geçersiz secret referansı: %s (secret://<provider>/<path>#key)", value)
+	}
+	return ref, true, nil
+}
+
+// Resolve, value bir secret referansıysa provider'dan okur; değilse
+// value'yu olduğu gibi döndürür.
+//
+// Parametreler:
+//   - ctx: Timeout/iptal için context
+//   - value: Config değeri (örn: "vault://secret/app#jwt_secret")
+//
+// Döndürür:
+//   - string: Secret veya değişmemiş value
+//   - error: Provider kayıtlı değilse veya secret okunamazsa
+//
+// Örnek:
+//
+//	dsn, err := secrets.Resolve(ctx, os.Getenv("DB_DSN"))
+func Resolve(ctx context.Context, value string) (string, error) {
+	ref, ok, err := Parse(value)
+	if err != nil || !ok {
+		return value, err
+	}
+
+	resolver, registered := Get(ref.Provider)
+	if !registered {
+		return "", fmt.Errorf("secret provider kayıtlı değil: %s (%s)", ref.Provider, ref)
+	}
+
+	secret, err := resolver.Resolve(ctx, ref.Path, ref.Key)
+	if err != nil {
+		return "", fmt.Errorf("%s okunamadı: %w", ref, err)
+	}
+	return secret, nil
+}
+
+// Field, JSON nesnesi olarak saklanan secret'ın key alanını döndürür.
+// key boşsa secret olduğu gibi döner. String olmayan alanlar JSON
+// gösterimleriyle döner (örn: 5432).
+func Field(secret, key string) (string, error) {
+	if key == "" {
+		return secret, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", fmt.Errorf("#%s için secret JSON nesnesi olmalı: %w", key, err)
+	}
+	return fieldValue(fields, key)
+}
+
+// fieldValue, map'teki key alanını string'e çevirir.
+func fieldValue(fields map[string]any, key string) (string, error) {
+	value, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("%w: #%s alanı yok", ErrNotFound, key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,141 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// VaultConfig, HashiCorp Vault bağlantı ayarlarıdır.
+type VaultConfig struct {
+	Address   string // Vault adresi (örn: https://vault.example.com:8200)
+	Token     string // X-Vault-Token
+	Namespace string // Vault Enterprise namespace'i (opsiyonel)
+	KVVersion int    // KV secrets engine sürümü: 2 (varsayılan) veya 1
+}
+
+// Vault, Vault KV secrets engine'inden secret okuyan Resolver'dır.
+//
+// Path'in ilk parçası mount'tur: "secret/app" KV v2'de
+// /v1/secret/data/app, KV v1'de /v1/secret/app adresinden okunur. Bir
+// secret birden fazla alan içerdiğinden #key zorunludur:
+//
+//	JWT_SECRET=vault://secret/app#jwt_secret
+type Vault struct {
+	config VaultConfig
+	client *http.Client
+
+	mu    sync.Mutex
+	cache map[string]map[string]any
+}
+
+// NewVault, yeni bir Vault resolver'ı oluşturur.
+//
+// Örnek:
+//
+//	secrets.Register("vault", secrets.NewVault(secrets.VaultConfig{
+//	    Address: os.Getenv("VAULT_ADDR"),
+//	    Token:   os.Getenv("VAULT_TOKEN"),
+//	}))
+func NewVault(config VaultConfig) *Vault {
+	if config.KVVersion == 0 {
+		config.KVVersion = 2
+	}
+	config.Address = strings.TrimRight(config.Address, "/")
+	return &Vault{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+		cache:  make(map[string]map[string]any),
+	}
+}
+
+// Resolve, path'teki secret'ın key alanını döndürür.
+func (v *Vault) Resolve(ctx context.Context, path, key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("vault referansı alan içermeli: vault://%s#<key>", path)
+	}
+
+	fields, err := v.read(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	return fieldValue(fields, key)
+}
+
+// read, secret'ın alanlarını okur ve cache'ler.
+func (v *Vault) read(ctx context.Context, path string) (map[string]any, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if fields, ok := v.cache[path]; ok {
+		return fields, nil
+	}
+
+	if v.config.Address == "" || v.config.Token == "" {
+		return nil, fmt.Errorf("vault için VAULT_ADDR ve VAULT_TOKEN gerekli")
+	}
+
+	apiPath := strings.Trim(path, "/")
+	if v.config.KVVersion == 2 {
+		mount, rest, found := strings.Cut(apiPath, "/")
+		if !found {
+			return nil, fmt.Errorf("vault path'i <mount>/<secret> biçiminde olmalı: %s", path)
+		}
+		apiPath = mount + "/data/" + rest
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.config.Address+"/v1/"+apiPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", v.config.Token)
+	if v.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.config.Namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Errors []string `json:"errors"`
+		}
+		json.Unmarshal(data, &apiErr)
+		return nil, fmt.Errorf("vault: status %d: %s", resp.StatusCode, strings.Join(apiErr.Errors, "; "))
+	}
+
+	var result struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("vault cevabı decode edilemedi: %w", err)
+	}
+
+	fields := result.Data
+	if v.config.KVVersion == 2 {
+		inner, _ := fields["data"].(map[string]any)
+		fields = inner
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+	}
+
+	v.cache[path] = fields
+	return fields, nil
+}
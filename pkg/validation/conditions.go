@@ -0,0 +1,52 @@
+// Package validation, koşullu (kardeş alanlara bağlı) doğrulama desteğini
+// içerir. RequiredIf, RequiredWith ve ExcludedUnless gibi kurallar alanın
+// kendi değerine ek olarak aynı seviyedeki diğer alanlara da bakar.
+package validation
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// ConditionalType, kardeş alanlara bağlı kuralları olan tiplerin
+// implement ettiği opsiyonel arayüzdür.
+//
+// Şema, Validate'ten önce ValidateConditions'ı çağırır:
+//   - true dönerse alan hariç tutulur (doğrulanmaz, validData'da yer almaz)
+//   - Alana hata eklendiyse (örn: koşullu zorunluluk) Validate çağrılmaz
+type ConditionalType interface {
+	Type
+
+	// ValidateConditions, koşulları aynı seviyedeki veri üzerinde
+	// değerlendirir.
+	//
+	// Parametreler:
+	//   - field: Alan yolu (hata anahtarı, örn: "items.0.qty")
+	//   - value: Alanın (dönüştürülmüş) değeri
+	//   - siblings: Aynı seviyedeki tüm alanlar
+	//   - result: Hataların ekleneceği ValidationResult
+	//
+	// Döndürür:
+	//   - bool: Alan hariç tutulacaksa true
+	ValidateConditions(field string, value any, siblings map[string]any, result *ValidationResult) bool
+}
+
+// ValidateField, alanı (varsa) koşullarıyla birlikte doğrular. Şema ve
+// iç içe nesne tipleri alanlarını bu fonksiyonla doğrular.
+//
+// Döndürür:
+//   - bool: Alan hariç tutulduysa false (çağıran validData'dan çıkarmalıdır)
+func ValidateField(field string, typ Type, value any, siblings map[string]any, result *ValidationResult) bool {
+	if conditional, ok := typ.(ConditionalType); ok {
+		if conditional.ValidateConditions(field, value, siblings, result) {
+			return false
+		}
+		if len(result.Errors()[field]) > 0 {
+			return true
+		}
+	}
+
+	typ.Validate(field, value, result)
+	bail(field, typ, result)
+	return true
+}
@@ -103,6 +103,21 @@ func PasswordMatchValidatorEN(passwordField, confirmField string) func(map[strin
 	}
 }
 
+// emailSchema and strongPasswordSchema are provided by the types package
+// (see SetSchemaHelpers). The validation package cannot import its own type
+// implementations without an import cycle.
+var (
+	emailSchema          func() Type
+	strongPasswordSchema func() Type
+)
+
+// SetSchemaHelpers registers the constructors behind EmailSchema and
+// StrongPasswordSchema. It is called by the types package in init.
+func SetSchemaHelpers(email, strongPassword func() Type) {
+	emailSchema = email
+	strongPasswordSchema = strongPassword
+}
+
 // EmailSchema creates a common email validation schema.
 //
 // This is a shortcut for creating a basic email field validation.
@@ -117,11 +132,10 @@ func PasswordMatchValidatorEN(passwordField, confirmField string) func(map[strin
 //
 //	types.String().Required().Email().Max(255).Trim()
 func EmailSchema() Type {
-	return NewStringType().
-		Required().
-		Email().
-		Max(255).
-		Trim()
+	if emailSchema == nil {
+		panic("validation: EmailSchema requires github.com/biyonik/conduit-go/pkg/validation/types to be imported")
+	}
+	return emailSchema()
 }
 
 // StrongPasswordSchema creates a strong password validation schema.
@@ -139,10 +153,8 @@ func EmailSchema() Type {
 //	    "password": validation.StrongPasswordSchema(),
 //	})
 func StrongPasswordSchema() Type {
-	return NewStringType().
-		Required().
-		Min(8).
-		Max(255)
-	// Note: Full password validation would require types.Password() with options
-	// This is a placeholder showing the pattern
+	if strongPasswordSchema == nil {
+		panic("validation: StrongPasswordSchema requires github.com/biyonik/conduit-go/pkg/validation/types to be imported")
+	}
+	return strongPasswordSchema()
 }
@@ -0,0 +1,135 @@
+// Package validation, doğrulama şemalarını JSON Schema (draft 2020-12)
+// formatında dışa aktarabilir. Böylece OpenAPI generator'ı ve frontend form
+// builder'ları sunucudaki kuralların birebir aynısını kullanır; istemci ve
+// sunucu doğrulaması elle senkronize edilmek zorunda kalmaz.
+package validation
+
+import (
+	"sort"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// JSONSchemaDraft, üretilen şemaların uyduğu JSON Schema sürümü.
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchemaDescriber, kendini JSON Schema olarak tanımlayabilen tipler
+// tarafından implement edilir. Tüm yerleşik tipler (types paketi) bu
+// arayüzü sağlar.
+type JSONSchemaDescriber interface {
+	// JSONSchema, tipin kurallarını JSON Schema property'si olarak döndürür.
+	JSONSchema() map[string]any
+
+	// IsRequired, alanın zorunlu olup olmadığını döndürür.
+	IsRequired() bool
+}
+
+// TypeJSONSchema, tek bir tipin JSON Schema karşılığını döndürür.
+//
+// Tip JSONSchemaDescriber değilse (örn: özel bir Type implementasyonu)
+// her değeri kabul eden boş şema ({}) döner.
+//
+// Döndürür:
+//   - map[string]any: Tipin JSON Schema'sı
+//   - bool: Alan zorunlu mu
+func TypeJSONSchema(typ Type) (map[string]any, bool) {
+	describer, ok := typ.(JSONSchemaDescriber)
+	if !ok {
+		return map[string]any{}, false
+	}
+	return describer.JSONSchema(), describer.IsRequired()
+}
+
+// ShapeJSONSchema, alan -> tip eşlemesinden bir "object" şeması üretir.
+//
+// ObjectType gibi iç içe yapılar da aynı fonksiyonu kullanır.
+//
+// Örnek çıktı:
+//
+//	{"type": "object", "properties": {"email": {...}}, "required": ["email"]}
+func ShapeJSONSchema(shape map[string]Type) map[string]any {
+	properties := make(map[string]any, len(shape))
+	required := make([]string, 0)
+
+	for field, typ := range shape {
+		property, isRequired := TypeJSONSchema(typ)
+		properties[field] = property
+		if isRequired {
+			required = append(required, field)
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+
+	if len(required) > 0 {
+		sort.Strings(required) // Deterministik çıktı (snapshot/diff dostu)
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// JSONSchema, şemayı JSON Schema (draft 2020-12) dokümanı olarak döndürür.
+//
+// When() ile tanımlanan koşullu kurallar "allOf" içinde "if/then" olarak
+// aktarılır. CrossValidate fonksiyonları keyfi Go kodu olduğu için JSON
+// Schema'ya çevrilemez; bunlar sadece sunucuda çalışır.
+//
+// Örnek:
+//
+//	schema := validation.Make().Shape(map[string]validation.Type{
+//	    "email": types.String().Required().Email().Max(255),
+//	    "age":   types.Number().Integer().Min(18),
+//	})
+//	doc, _ := json.MarshalIndent(schema.JSONSchema(), "", "  ")
+//
+// Çıktı:
+//
+//	{
+//	  "$schema": "https://json-schema.org/draft/2020-12/schema",
+//	  "type": "object",
+//	  "properties": {
+//	    "age": {"type": "integer", "minimum": 18},
+//	    "email": {"type": "string", "format": "email", "maxLength": 255}
+//	  },
+//	  "required": ["email"]
+//	}
+func (vs *ValidationSchema) JSONSchema() map[string]any {
+	schema := vs.objectJSONSchema()
+	schema["$schema"] = JSONSchemaDraft
+	return schema
+}
+
+// objectJSONSchema, "$schema" anahtarı olmadan şemayı üretir (alt şemalar için).
+func (vs *ValidationSchema) objectJSONSchema() map[string]any {
+	schema := ShapeJSONSchema(vs.shape)
+
+	if len(vs.conditionalRules) == 0 {
+		return schema
+	}
+
+	conditions := make([]any, 0, len(vs.conditionalRules))
+	for _, rule := range vs.conditionalRules {
+		then := rule.callback().JSONSchema()
+		delete(then, "$schema") // Alt şemada sadece kök dokümanda olmalı
+
+		conditions = append(conditions, map[string]any{
+			"if": map[string]any{
+				"properties": map[string]any{
+					rule.field: map[string]any{"const": rule.expectedValue},
+				},
+				"required": []string{rule.field},
+			},
+			"then": then,
+		})
+	}
+	schema["allOf"] = conditions
+
+	return schema
+}
@@ -0,0 +1,57 @@
+// Package validation, alanın nasıl doğrulanacağını değiştiren Nullable,
+// Sometimes ve Bail belirteçlerinin şema tarafındaki desteğini içerir.
+package validation
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// ModifiedType, Nullable/Sometimes/Bail belirteçlerini destekleyen tiplerin
+// implement ettiği opsiyonel arayüzdür.
+//
+//   - Nullable: Açıkça null gönderilen alan doğrulanmaz (Required olsa bile)
+//     ve validData'da nil olarak yer alır; varsayılan değer uygulanmaz.
+//   - Sometimes: Anahtar veride yoksa alan doğrulanmaz ve validData'da yer
+//     almaz (PATCH endpoint'leri için).
+//   - Bail: Alan için ilk hatadan sonraki hatalar raporlanmaz.
+type ModifiedType interface {
+	Type
+
+	IsNullable() bool
+	IsSometimes() bool
+	IsBail() bool
+}
+
+// SkipField, alanın Nullable/Sometimes nedeniyle dönüştürülmeden ve
+// doğrulanmadan geçilip geçilmeyeceğini döndürür. Şema ve iç içe nesne
+// tipleri alanlarını işlemeden önce bunu çağırır.
+//
+// Parametreler:
+//   - typ: Alanın tipi
+//   - value: Alanın ham değeri
+//   - present: Anahtar veride var mı
+//
+// Döndürür:
+//   - bool: Alan atlanacaksa true (present ise validData'ya nil yazılır)
+func SkipField(typ Type, value any, present bool) bool {
+	modified, ok := typ.(ModifiedType)
+	if !ok {
+		return false
+	}
+	if !present {
+		return modified.IsSometimes()
+	}
+	return value == nil && modified.IsNullable()
+}
+
+// bail, Bail belirteci olan alan için ilk hata dışındaki hataları siler.
+func bail(field string, typ Type, result *ValidationResult) {
+	modified, ok := typ.(ModifiedType)
+	if !ok || !modified.IsBail() {
+		return
+	}
+	if messages := result.errors[field]; len(messages) > 1 {
+		result.errors[field] = messages[:1]
+	}
+}
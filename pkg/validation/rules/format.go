@@ -0,0 +1,49 @@
+// Package rules, format doğrulama kurallarını içerir.
+// Bu dosya URL ve JSON string'lerinin doğrulamasını barındırır.
+package rules
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// IsValidURL, verilen string'in mutlak bir URL olup olmadığını kontrol eder.
+//
+// Parametreler:
+//   - value: Doğrulanacak URL
+//   - schemes: İzin verilen şemalar (boşsa http ve https)
+//
+// Dönüş:
+//   - bool: URL şema ve host içeriyorsa ve şema izinliyse true
+func IsValidURL(value string, schemes ...string) bool {
+	if strings.ContainsAny(value, " \t\r\n") {
+		return false
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.Hostname() == "" {
+		return false
+	}
+
+	if len(schemes) == 0 {
+		schemes = []string{"http", "https"}
+	}
+	for _, scheme := range schemes {
+		if strings.EqualFold(parsed.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidJSON, verilen string'in geçerli bir JSON dokümanı olup olmadığını
+// kontrol eder.
+func IsValidJSON(value string) bool {
+	return strings.TrimSpace(value) != "" && json.Valid([]byte(value))
+}
@@ -1,7 +1,10 @@
 // pkg/validation/rules/uuid.go
 package rules
 
-import "regexp"
+import (
+	"regexp"
+	"strings"
+)
 
 // PHP'deki UuidValidationTrait'ten port edilmiştir.
 // Regex'leri global değişken olarak derlemek, her çağrıda derlemekten çok daha
@@ -18,11 +21,9 @@ var (
 // IsValidUUID, bir string'in geçerli bir UUID olup olmadığını kontrol eder.
 // PHP'deki isValidUuid() metoduna karşılık gelir.
 func IsValidUUID(uuid string, version int) bool {
-	// Not: Go'da 'i' (case-insensitive) flag'i regex'e eklemek yerine
-	// string'i küçük harfe çevirmek daha yaygın (ve bazen daha hızlı) bir pratiktir.
-	// Ancak UUID regex'lerimiz zaten 'a-f' içerdiği için buna gerek yok.
-	// PHP'deki 'i' flag'ini karşılamak için regex'lere 'i' flag'i eklenebilir: `(?i)^...`
-	// Şimdilik küçük harf varsayıyoruz.
+	// UUID'ler büyük/küçük harf duyarsızdır (RFC 4122); regex'ler küçük harf
+	// olduğu için değer normalize edilir.
+	uuid = strings.ToLower(uuid)
 
 	switch version {
 	case 1:
@@ -99,13 +99,60 @@ func (vs *ValidationSchema) When(field string, expectedValue any, callback func(
 // Döndürür:
 //   - *ValidationResult: Doğrulama sonucu (hatalar ve temiz veri)
 func (vs *ValidationSchema) Validate(data map[string]any) *ValidationResult {
+	return vs.validate(data, vs.shape)
+}
+
+// ValidatePartial, PATCH semantiği için sadece payload'da BULUNAN alanları
+// doğrular.
+//
+// Alanın gönderilmemesi ile sıfır değerle gönderilmesi ayrı tutulur:
+//   - Gönderilmeyen alan: Atlanır (Required olsa bile), validData'da yer almaz
+//   - null/"" ile gönderilen alan: Normal kurallarla doğrulanır (Required ise hata)
+//
+// Böylece validData sadece güncellenmesi istenen alanları içerir ve doğrudan
+// kısmi update için kullanılabilir. When() kuralları koşul alanı payload'da
+// varsa uygulanır; CrossValidate fonksiyonları eksik alanları tolere etmelidir.
+//
+// Parametre:
+//   - data: PATCH payload'u (örn: r.ParseJSONMap() sonucu)
+//
+// Döndürür:
+//   - *ValidationResult: Doğrulama sonucu (validData sadece gönderilen alanlar)
+//
+// Örnek:
+//
+//	payload, _ := r.ParseJSONMap()
+//	result := schema.ValidatePartial(payload)
+//	if result.HasErrors() { ... }
+//	userRepo.UpdateFields(id, result.ValidData())
+func (vs *ValidationSchema) ValidatePartial(data map[string]any) *ValidationResult {
+	present := make(map[string]Type, len(data))
+	for field, typ := range vs.shape {
+		if _, ok := data[field]; ok {
+			present[field] = typ
+		}
+	}
+	return vs.validate(data, present)
+}
+
+// validate, verilen shape üzerinde doğrulama pipeline'ını çalıştırır.
+func (vs *ValidationSchema) validate(data map[string]any, shape map[string]Type) *ValidationResult {
 	result := NewResult()
 	transformedData := make(map[string]any)
 
 	// 1. AŞAMA: DÖNÜŞTÜRME (TRANSFORM)
-	// (Değişiklik yok: Veriyi temizler ve 'transformedData' haritasını doldurur)
-	for field, typ := range vs.shape {
-		value := data[field]
+	// Veriyi temizler ve 'transformedData' haritasını doldurur. Sometimes
+	// (gönderilmemiş) ve Nullable (null gönderilmiş) alanlar atlanır.
+	skipped := make(map[string]bool)
+	for field, typ := range shape {
+		value, present := data[field]
+		if SkipField(typ, value, present) {
+			skipped[field] = true
+			if present {
+				transformedData[field] = nil
+			}
+			continue
+		}
 
 		transformedValue, err := typ.Transform(value)
 		if err != nil {
@@ -123,9 +170,28 @@ func (vs *ValidationSchema) Validate(data map[string]any) *ValidationResult {
 	}
 
 	// 2. AŞAMA: TEMEL DOĞRULAMA (VALIDATE)
-	// (Değişiklik yok: 'transformedData'yı temel 'shape'e göre doğrular)
-	for field, typ := range vs.shape {
-		typ.Validate(field, transformedData[field], result)
+	// Koşullu kurallar (RequiredIf, ExcludedUnless, ...) kardeş alanlara
+	// bakar; şemada olmayan alanlar için ham veri kullanılır.
+	siblings := make(map[string]any, len(data)+len(transformedData))
+	for field, value := range data {
+		siblings[field] = value
+	}
+	for field, value := range transformedData {
+		siblings[field] = value
+	}
+
+	var excluded []string
+	for field, typ := range shape {
+		if skipped[field] {
+			continue
+		}
+		if !ValidateField(field, typ, transformedData[field], siblings, result) {
+			excluded = append(excluded, field)
+		}
+	}
+	// Hariç tutulan alanlar diğer koşullar değerlendirildikten sonra çıkarılır
+	for _, field := range excluded {
+		delete(transformedData, field)
 	}
 
 	// 3. AŞAMA (YENİ): KOŞULLU DOĞRULAMA (WHEN)
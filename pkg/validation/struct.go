@@ -0,0 +1,391 @@
+// -----------------------------------------------------------------------------
+// Struct Tag Validation
+// -----------------------------------------------------------------------------
+// Basit request struct'larını Shape() yazmadan, struct tag'leri ile doğrular:
+//
+//	type LoginRequest struct {
+//	    Email    string `json:"email" validate:"required,email,max=255,trim,label=Email"`
+//	    Password string `json:"password" validate:"required,label=Şifre"`
+//	}
+//
+//	result := validation.ValidateStruct(&req)
+//
+// Alan adları (ve hata anahtarları) json tag'inden alınır. Şema her struct
+// tipi için bir kez oluşturulur ve cache'lenir.
+//
+// Tag'ler tip bağımsız bir FieldSpec'e çevrilir; Type'lara dönüşüm types
+// paketinde yapılır (validation paketi import cycle olmadan types'ı import
+// edemez). types paketi builder'ı init'te kaydeder.
+// -----------------------------------------------------------------------------
+
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// FieldKind, struct alanının doğrulama tipi karşılığıdır.
+type FieldKind int
+
+const (
+	FieldString FieldKind = iota
+	FieldInt
+	FieldFloat
+	FieldBool
+	FieldArray
+	FieldObject
+)
+
+// TagRule, validate tag'indeki tek bir kuraldır (örn: "max=255" ->
+// Name: "max", Param: "255").
+type TagRule struct {
+	Name  string
+	Param string
+}
+
+// FieldSpec, bir struct alanının tip ve kurallarını tanımlar.
+type FieldSpec struct {
+	Name   string      // Alan adı (json tag'i)
+	Kind   FieldKind   // Alanın tipi
+	Rules  []TagRule   // validate tag'indeki kurallar
+	Elem   *FieldSpec  // FieldArray için eleman tipi
+	Fields []FieldSpec // FieldObject için iç alanlar
+}
+
+// StructTypeBuilder, FieldSpec'ten Type oluşturan fonksiyondur.
+type StructTypeBuilder func(spec FieldSpec) (Type, error)
+
+var (
+	structBuilder   StructTypeBuilder
+	structBuilderMu sync.RWMutex
+
+	// reflect.Type -> map[string]Type
+	structShapes sync.Map
+)
+
+// RegisterStructTypeBuilder, struct tag'lerinden Type oluşturacak builder'ı
+// kaydeder. types paketi bunu init'te çağırır.
+func RegisterStructTypeBuilder(builder StructTypeBuilder) {
+	structBuilderMu.Lock()
+	defer structBuilderMu.Unlock()
+
+	structBuilder = builder
+}
+
+// ValidateStruct, struct'ı validate tag'lerine göre doğrular.
+//
+// Desteklenen kurallar:
+//   - Tüm tipler: required, label=Ad, nullable, sometimes, bail,
+//     required_if=alan değer1 değer2, required_with=alan1 alan2,
+//     excluded_unless=alan değer
+//   - string: email, url, uuid, ip, ipv4, ipv6, json, trim, min=N, max=N,
+//     oneof=a b c (veya in=a b c), regex=desen (virgül içeremez)
+//   - int: oneof=1 2 3 (veya in=1 2 3)
+//   - int/float: min=N, max=N, between=N M, positive
+//   - slice: min=N, max=N (eleman sayısı); struct elemanları kendi
+//     tag'leriyle doğrulanır
+//   - İç içe struct'lar kendi tag'leriyle doğrulanır (hata: address.city)
+//
+// Sıfır değerli alanlar (0, "", false, nil) gönderilmemiş sayılır; 0 veya
+// false'un geçerli bir değer olduğu alanlar için pointer (*int, *bool)
+// kullanın.
+//
+// Parametreler:
+//   - v: Struct veya struct pointer'ı
+//
+// Döndürür:
+//   - *ValidationResult: Doğrulama sonucu (hata anahtarları json adlarıdır)
+//
+// Geçersiz bir tag (bilinmeyen kural, hatalı parametre) programlama hatası
+// olduğundan panic'e neden olur.
+//
+// Örnek:
+//
+//	var req LoginRequest
+//	r.ParseJSON(&req)
+//	result := validation.ValidateStruct(&req)
+//	if result.HasErrors() {
+//	    conduitRes.Error(w, 422, result.Errors())
+//	    return
+//	}
+func ValidateStruct(v any) *ValidationResult {
+	schema, err := StructSchema(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return schema.Validate(structToMap(reflect.Indirect(reflect.ValueOf(v))))
+}
+
+// StructSchema, struct'ın validate tag'lerinden oluşturulan şemayı
+// döndürür (örn: JSON Schema export'u veya CrossValidate eklemek için).
+//
+// Döndürür:
+//   - Schema: Struct'ın şeması
+//   - error: v struct değilse, builder kayıtlı değilse veya tag geçersizse
+func StructSchema(v any) (Schema, error) {
+	rt := reflect.TypeOf(v)
+	for rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validation: struct bekleniyordu, gelen %T", v)
+	}
+
+	shape, err := structShape(rt)
+	if err != nil {
+		return nil, err
+	}
+	return Make().Shape(shape), nil
+}
+
+// structShape, struct tipinin şemasını (cache'ten veya oluşturarak) döndürür.
+func structShape(rt reflect.Type) (map[string]Type, error) {
+	if cached, ok := structShapes.Load(rt); ok {
+		return cached.(map[string]Type), nil
+	}
+
+	structBuilderMu.RLock()
+	builder := structBuilder
+	structBuilderMu.RUnlock()
+	if builder == nil {
+		return nil, fmt.Errorf("validation: struct builder kayıtlı değil (github.com/biyonik/conduit-go/pkg/validation/types import edilmeli)")
+	}
+
+	specs, err := structSpecs(rt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shape := make(map[string]Type, len(specs))
+	for _, spec := range specs {
+		typ, err := builder(spec)
+		if err != nil {
+			return nil, fmt.Errorf("validation: %s.%s: %w", rt.Name(), spec.Name, err)
+		}
+		shape[spec.Name] = typ
+	}
+
+	structShapes.Store(rt, shape)
+	return shape, nil
+}
+
+// structSpecs, struct alanlarını FieldSpec'lere çevirir. Sadece validate
+// tag'i olan alanlar ve iç içe struct'lar şemaya dahil edilir.
+func structSpecs(rt reflect.Type, seen []reflect.Type) ([]FieldSpec, error) {
+	for _, s := range seen {
+		if s == rt {
+			return nil, fmt.Errorf("validation: %s kendine referans veriyor", rt.Name())
+		}
+	}
+	seen = append(seen, rt)
+
+	var specs []FieldSpec
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, hasTag := field.Tag.Lookup("validate")
+		if tag == "-" {
+			continue
+		}
+
+		// Gömülü struct'ların alanları (encoding/json gibi) üst seviyeye alınır
+		if embedded := embeddedStruct(field); embedded != nil {
+			fields, err := structSpecs(embedded, seen)
+			if err != nil {
+				return nil, err
+			}
+			specs = append(specs, fields...)
+			continue
+		}
+
+		name := fieldName(field)
+		if name == "" {
+			continue
+		}
+
+		spec, err := fieldSpec(name, field.Type, tag, seen)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Name, err)
+		}
+		// Tag'siz alanlardan sadece doğrulanacak alanı olan struct'lar alınır
+		if spec == nil || (!hasTag && (spec.Kind != FieldObject || len(spec.Fields) == 0)) {
+			continue
+		}
+		specs = append(specs, *spec)
+	}
+	return specs, nil
+}
+
+// embeddedStruct, json adı verilmemiş gömülü bir struct alanıysa struct
+// tipini döndürür.
+func embeddedStruct(field reflect.StructField) reflect.Type {
+	if !field.Anonymous || field.Tag.Get("json") != "" {
+		return nil
+	}
+	ft := field.Type
+	if ft.Kind() == reflect.Ptr {
+		ft = ft.Elem()
+	}
+	if ft.Kind() != reflect.Struct {
+		return nil
+	}
+	return ft
+}
+
+// fieldSpec, tek bir alanın FieldSpec'ini oluşturur. Desteklenmeyen
+// tipler (tag'siz) için nil döner.
+func fieldSpec(name string, ft reflect.Type, tag string, seen []reflect.Type) (*FieldSpec, error) {
+	for ft.Kind() == reflect.Ptr {
+		ft = ft.Elem()
+	}
+
+	spec := &FieldSpec{Name: name, Rules: parseTag(tag)}
+
+	switch ft.Kind() {
+	case reflect.String:
+		spec.Kind = FieldString
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		spec.Kind = FieldInt
+	case reflect.Float32, reflect.Float64:
+		spec.Kind = FieldFloat
+	case reflect.Bool:
+		spec.Kind = FieldBool
+	case reflect.Slice, reflect.Array:
+		spec.Kind = FieldArray
+		elem, err := fieldSpec(name, ft.Elem(), "", seen)
+		if err != nil {
+			return nil, err
+		}
+		if elem != nil && elem.Kind == FieldObject {
+			spec.Elem = elem
+		}
+	case reflect.Struct:
+		spec.Kind = FieldObject
+		fields, err := structSpecs(ft, seen)
+		if err != nil {
+			return nil, err
+		}
+		spec.Fields = fields
+	default:
+		if tag != "" {
+			return nil, fmt.Errorf("desteklenmeyen tip: %s", ft)
+		}
+		return nil, nil
+	}
+
+	return spec, nil
+}
+
+// fieldName, alanın json adını döndürür (json:"-" ise boş).
+func fieldName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// parseTag, "required,max=255,oneof=a b" tag'ini kurallara ayırır.
+func parseTag(tag string) []TagRule {
+	var rules []TagRule
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(part, "=")
+		rules = append(rules, TagRule{Name: strings.TrimSpace(name), Param: strings.TrimSpace(param)})
+	}
+	return rules
+}
+
+// structToMap, struct değerini json adlarıyla map'e çevirir. Sıfır değerler
+// nil olur; nil pointer'lar map'e eklenmez (gönderilmemiş sayılır, böylece
+// sometimes kuralı pointer alanlarda çalışır).
+func structToMap(rv reflect.Value) map[string]any {
+	data := make(map[string]any)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if embeddedStruct(field) != nil {
+			fv := reflect.Indirect(rv.Field(i))
+			if fv.IsValid() {
+				for key, value := range structToMap(fv) {
+					data[key] = value
+				}
+			}
+			continue
+		}
+		name := fieldName(field)
+		if name == "" {
+			continue
+		}
+		if fv := rv.Field(i); fv.Kind() != reflect.Ptr || !fv.IsNil() {
+			data[name] = structValue(fv)
+		}
+	}
+	return data
+}
+
+// structValue, alan değerini doğrulama tiplerinin beklediği forma çevirir.
+func structValue(fv reflect.Value) any {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return nil
+		}
+		// Pointer alanlarda sıfır değer de geçerli bir değerdir
+		return plainValue(fv.Elem())
+	}
+	if fv.IsZero() {
+		return nil
+	}
+	return plainValue(fv)
+}
+
+// plainValue, değeri string/int64/float64/bool/[]any/map[string]any'ye çevirir.
+func plainValue(fv reflect.Value) any {
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return fv.Float()
+	case reflect.Bool:
+		return fv.Bool()
+	case reflect.Slice, reflect.Array:
+		if fv.Kind() == reflect.Slice && fv.IsNil() {
+			return nil
+		}
+		// Dizi elemanlarında sıfır değer geçerli bir değerdir
+		items := make([]any, fv.Len())
+		for i := range items {
+			items[i] = plainValue(fv.Index(i))
+		}
+		return items
+	case reflect.Struct:
+		return structToMap(fv)
+	case reflect.Ptr:
+		if fv.IsNil() {
+			return nil
+		}
+		return plainValue(fv.Elem())
+	}
+	return fv.Interface()
+}
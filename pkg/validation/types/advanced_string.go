@@ -131,11 +131,21 @@ func (as *AdvancedStringType) URL() *AdvancedStringType {
 	return as
 }
 
+func (as *AdvancedStringType) Regex(pattern string) *AdvancedStringType {
+	as.StringType.Regex(pattern)
+	return as
+}
+
 func (as *AdvancedStringType) OneOf(values []string) *AdvancedStringType {
 	as.StringType.OneOf(values)
 	return as
 }
 
+func (as *AdvancedStringType) In(values ...string) *AdvancedStringType {
+	as.StringType.In(values...)
+	return as
+}
+
 func (as *AdvancedStringType) Password(options ...PasswordOption) *AdvancedStringType {
 	as.StringType.Password(options...)
 	return as
@@ -162,7 +172,7 @@ func (as *AdvancedStringType) Phone(countryCode string) *AdvancedStringType {
 func (as *AdvancedStringType) Validate(field string, value any, result *validation.ValidationResult) {
 	// 1. Önce temel StringType doğrulamalarını çalıştır (Min, Max, Email, IP, Phone, Password vb.)
 	as.StringType.Validate(field, value, result)
-	if result.HasErrors() || value == nil {
+	if hasFieldErrors(result, field) || value == nil {
 		return
 	}
 
@@ -177,21 +187,21 @@ func (as *AdvancedStringType) Validate(field string, value any, result *validati
 	if as.turkishChars != nil {
 		hasTurkish := rules.HasTurkishChars(str)
 		if *as.turkishChars && !hasTurkish {
-			result.AddError(field, fmt.Sprintf("%s alanında Türkçe karakter bulunmalıdır", fieldName))
+			as.addError(result, field, "turkish", fmt.Sprintf("%s alanında Türkçe karakter bulunmalıdır", fieldName))
 		} else if !*as.turkishChars && hasTurkish {
-			result.AddError(field, fmt.Sprintf("%s alanında Türkçe karakter bulunmamalıdır", fieldName))
+			as.addError(result, field, "turkish", fmt.Sprintf("%s alanında Türkçe karakter bulunmamalıdır", fieldName))
 		}
 	}
 
 	if as.domainCheck != nil {
 		if !rules.IsValidDomain(str, *as.domainCheck) {
-			result.AddError(field, fmt.Sprintf("%s alanı geçerli bir alan adı olmalıdır", fieldName))
+			as.addError(result, field, "domain", fmt.Sprintf("%s alanı geçerli bir alan adı olmalıdır", fieldName))
 		}
 	}
 
 	if as.charSet != nil {
 		if !rules.ValidateCharSet(str, *as.charSet) {
-			result.AddError(field, fmt.Sprintf("%s alanı '%s' karakter setine uymalıdır", fieldName, *as.charSet))
+			as.addError(result, field, "charset", fmt.Sprintf("%s alanı '%s' karakter setine uymalıdır", fieldName, *as.charSet))
 		}
 	}
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/biyonik/conduit-go/pkg/validation"
 )
@@ -74,9 +75,10 @@ func (a *ArrayType) Transform(value any) (any, error) {
 		return nil, nil
 	}
 
-	slice, ok := value.([]any)
+	// Dizi olmayan değerler olduğu gibi bırakılır; Validate hata ekler
+	slice, ok := toSlice(value)
 	if !ok {
-		return nil, fmt.Errorf("dizi (array) tipinde olmalıdır")
+		return value, nil
 	}
 
 	if a.elementSchema != nil {
@@ -84,7 +86,9 @@ func (a *ArrayType) Transform(value any) (any, error) {
 		for i, item := range slice {
 			transformedItem, err := a.elementSchema.Transform(item)
 			if err != nil {
-				return nil, fmt.Errorf("dizi index %d: %w", i, err)
+				// Dönüştürülemeyen eleman ham haliyle doğrulanır, böylece
+				// hata "items.0" gibi eleman yoluyla raporlanır
+				transformedItem = item
 			}
 			transformedSlice[i] = transformedItem
 		}
@@ -103,37 +107,61 @@ func (a *ArrayType) Transform(value any) (any, error) {
 func (a *ArrayType) Validate(field string, value any, result *validation.ValidationResult) {
 	// Temel doğrulama
 	a.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 	if value == nil {
 		return
 	}
 
-	slice, ok := value.([]any)
-	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı dizi (array) tipinde olmalıdır", a.label))
-		return
-	}
-
 	fieldName := a.label
 	if fieldName == "" {
 		fieldName = field
 	}
 
+	slice, ok := toSlice(value)
+	if !ok {
+		a.addError(result, field, "type", fmt.Sprintf("%s alanı dizi (array) tipinde olmalıdır", fieldName))
+		return
+	}
+
 	// Minimum ve maksimum uzunluk kontrolü
 	if a.minLength != nil && len(slice) < *a.minLength {
-		result.AddError(field, fmt.Sprintf("%s alanında en az %d eleman olmalıdır", fieldName, *a.minLength))
+		a.addError(result, field, "min", fmt.Sprintf("%s alanında en az %d eleman olmalıdır", fieldName, *a.minLength))
 	}
 	if a.maxLength != nil && len(slice) > *a.maxLength {
-		result.AddError(field, fmt.Sprintf("%s alanında en fazla %d eleman olmalıdır", fieldName, *a.maxLength))
+		a.addError(result, field, "max", fmt.Sprintf("%s alanında en fazla %d eleman olmalıdır", fieldName, *a.maxLength))
 	}
 
-	// Eleman şeması varsa, her elemanı doğrula
+	// Eleman şeması varsa, her elemanı doğrula (hata anahtarı: items.0.qty)
 	if a.elementSchema != nil {
 		for i, item := range slice {
-			elementFieldPath := fmt.Sprintf("%s[%d]", field, i)
+			elementFieldPath := fmt.Sprintf("%s.%d", field, i)
 			a.elementSchema.Validate(elementFieldPath, item, result)
 		}
 	}
 }
+
+// toSlice, herhangi bir slice/array değerini []any'ye çevirir. JSON'dan
+// gelen []any doğrudan döner; []string, []int gibi Go slice'ları da
+// kabul edilir.
+func toSlice(value any) ([]any, bool) {
+	if slice, ok := value.([]any); ok {
+		return slice, true
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	// []byte bir dizi değil, binary veridir
+	if rv.Type().Elem().Kind() == reflect.Uint8 {
+		return nil, false
+	}
+
+	slice := make([]any, rv.Len())
+	for i := range slice {
+		slice[i] = rv.Index(i).Interface()
+	}
+	return slice, true
+}
@@ -19,11 +19,19 @@ import (
 // label: alanın insan okunabilir adı
 // defaultValue: dönüşüm sırasında uygulanacak varsayılan değer
 // transformations: değere uygulanacak dönüşüm fonksiyonları (trim, strip tags vb.)
+// conditions: RequiredIf, RequiredWith, ExcludedUnless kuralları
+// nullable, sometimes, bail: doğrulama belirteçleri (modifiers.go)
+// messages: kural adı -> özel hata mesajı (messages.go)
 type BaseType struct {
 	isRequired      bool
 	label           string
 	defaultValue    any
 	transformations []func(any) (any, error)
+	conditions      []condition // Kardeş alanlara bağlı kurallar (conditional.go)
+	nullable        bool
+	sometimes       bool
+	bail            bool
+	messages        map[string]string
 }
 
 // --- Akıcı (Fluent) Metotlar ---
@@ -97,13 +105,21 @@ func (b *BaseType) Validate(field string, value any, result *validation.Validati
 	if b.isRequired {
 		// Nil değer kontrolü
 		if value == nil {
-			result.AddError(field, fmt.Sprintf("%s alanı zorunludur", fieldName))
+			b.addError(result, field, "required", fmt.Sprintf("%s alanı zorunludur", fieldName))
 			return
 		}
 		// String ise boş string kontrolü
 		if str, ok := value.(string); ok && str == "" {
-			result.AddError(field, fmt.Sprintf("%s alanı zorunludur", fieldName))
+			b.addError(result, field, "required", fmt.Sprintf("%s alanı zorunludur", fieldName))
 			return
 		}
 	}
 }
+
+// hasFieldErrors, alan için daha önce hata eklenip eklenmediğini döndürür.
+// Tipler zorunluluk hatasından sonra kendi kurallarını atlamak için bunu
+// kullanır; result.HasErrors() diğer alanların (ve dizi elemanlarının)
+// hatalarını da sayacağından bu alanın doğrulanmasını engellerdi.
+func hasFieldErrors(result *validation.ValidationResult, field string) bool {
+	return len(result.Errors()[field]) > 0
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/biyonik/conduit-go/pkg/validation"
 )
@@ -17,7 +19,8 @@ import (
 // BooleanType, boolean değerlerinin doğrulamasını ve dönüşümünü yönetir.
 // BaseType'ı gömerek ortak doğrulama ve transform fonksiyonlarını kullanır.
 type BooleanType struct {
-	BaseType // Ortak doğrulama ve transform fonksiyonları
+	BaseType      // Ortak doğrulama ve transform fonksiyonları
+	coerce   bool // "true", "1", "on" gibi değerler bool'a çevrilsin mi (Bool())
 }
 
 // --- Akıcı (Fluent) Metotlar ---
@@ -57,6 +60,21 @@ func (b *BooleanType) Default(value bool) *BooleanType {
 
 // --- Arayüz (Interface) Implementasyonu ---
 
+// Transform, varsayılan değeri ve dönüşümleri uygular. Bool() ile
+// oluşturulan tiplerde değer ayrıca bool'a çevrilir:
+//   - "true", "1", "yes", "on" -> true
+//   - "false", "0", "no", "off" -> false
+//   - 1 / 0 (JSON sayısı) -> true / false
+//
+// Çevrilemeyen değerler olduğu gibi bırakılır; hata Validate'te raporlanır.
+func (b *BooleanType) Transform(value any) (any, error) {
+	value, err := b.BaseType.Transform(value)
+	if err != nil || value == nil || !b.coerce {
+		return value, err
+	}
+	return coerceBool(value), nil
+}
+
 // Validate, verilen boolean değeri doğrular ve hataları ValidationResult'a ekler.
 //
 // Parametreler:
@@ -66,10 +84,14 @@ func (b *BooleanType) Default(value bool) *BooleanType {
 func (b *BooleanType) Validate(field string, value any, result *validation.ValidationResult) {
 	// Temel doğrulama: zorunlu alan kontrolü
 	b.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 
+	if b.coerce {
+		value = coerceBool(value)
+	}
+
 	// Nil değer kontrolü (zorunlu değilse dur)
 	if value == nil {
 		return
@@ -82,6 +104,31 @@ func (b *BooleanType) Validate(field string, value any, result *validation.Valid
 		if fieldName == "" {
 			fieldName = field
 		}
-		result.AddError(field, fmt.Sprintf("%s alanı boolean tipinde olmalıdır", fieldName))
+		b.addError(result, field, "type", fmt.Sprintf("%s alanı boolean tipinde olmalıdır", fieldName))
+	}
+}
+
+// coerceBool, form/query/JSON'dan gelen yaygın boolean gösterimlerini
+// bool'a çevirir.
+func coerceBool(value any) any {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "":
+			return nil
+		case "true", "1", "yes", "on":
+			return true
+		case "false", "0", "no", "off":
+			return false
+		}
+	case json.Number:
+		return coerceBool(string(v))
+	default:
+		if num, ok := coerceInt(value).(int64); ok && (num == 0 || num == 1) {
+			return num == 1
+		}
 	}
+	return value
 }
@@ -0,0 +1,358 @@
+// Package types, tip bazlı doğrulama nesnelerini ve kurallarını yönetir.
+// Bu dosya, kardeş alanlara bağlı koşullu kuralları (RequiredIf,
+// RequiredWith, ExcludedUnless) ve her tip için akıcı metotlarını içerir
+// (validation.ConditionalType).
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/validation"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// conditionKind, koşullu kuralın türüdür.
+type conditionKind int
+
+const (
+	conditionRequiredIf conditionKind = iota
+	conditionRequiredWith
+	conditionExcludedUnless
+)
+
+// condition, kardeş alanlara bağlı tek bir kuraldır.
+type condition struct {
+	kind   conditionKind
+	field  string   // RequiredIf / ExcludedUnless: bakılan alan
+	fields []string // RequiredWith: bakılan alanlar
+	values []any    // RequiredIf / ExcludedUnless: beklenen değerler
+}
+
+// AddRequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (b *BaseType) AddRequiredIf(other string, values ...any) {
+	b.conditions = append(b.conditions, condition{kind: conditionRequiredIf, field: other, values: values})
+}
+
+// AddRequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (b *BaseType) AddRequiredWith(others ...string) {
+	b.conditions = append(b.conditions, condition{kind: conditionRequiredWith, fields: others})
+}
+
+// AddExcludedUnless, other alanı values'tan birine eşit değilse alanı
+// doğrulamadan ve validData'dan hariç tutar.
+func (b *BaseType) AddExcludedUnless(other string, values ...any) {
+	b.conditions = append(b.conditions, condition{kind: conditionExcludedUnless, field: other, values: values})
+}
+
+// ValidateConditions, koşullu kuralları kardeş alanlar üzerinde
+// değerlendirir (validation.ConditionalType).
+//
+// Parametreler:
+//   - field: Alan yolu
+//   - value: Alanın değeri
+//   - siblings: Aynı seviyedeki alanlar
+//   - result: Hataların ekleneceği ValidationResult
+//
+// Döndürür:
+//   - bool: Alan hariç tutulacaksa true
+func (b *BaseType) ValidateConditions(field string, value any, siblings map[string]any, result *validation.ValidationResult) bool {
+	// Hariç tutma diğer tüm kurallardan önce gelir
+	for _, c := range b.conditions {
+		if c.kind == conditionExcludedUnless && !matchesAny(siblings[c.field], c.values) {
+			return true
+		}
+	}
+
+	if !isEmptyValue(value) {
+		return false
+	}
+
+	fieldName := b.label
+	if fieldName == "" {
+		fieldName = field
+	}
+
+	for _, c := range b.conditions {
+		switch c.kind {
+		case conditionRequiredIf:
+			if matchesAny(siblings[c.field], c.values) {
+				b.addError(result, field, "required_if", fmt.Sprintf("%s alanı, %s alanı %v olduğunda zorunludur", fieldName, c.field, siblings[c.field]))
+				return false
+			}
+
+		case conditionRequiredWith:
+			for _, other := range c.fields {
+				if !isEmptyValue(siblings[other]) {
+					b.addError(result, field, "required_with", fmt.Sprintf("%s alanı, %s alanı mevcut olduğunda zorunludur", fieldName, strings.Join(c.fields, " / ")))
+					return false
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesAny, değerin beklenen değerlerden birine eşit olup olmadığını
+// döndürür. Karşılaştırma metin gösterimi üzerinden yapılır; böylece JSON'dan
+// gelen float64(1), int64(1) ve "1" aynı kabul edilir.
+func matchesAny(value any, expected []any) bool {
+	if value == nil {
+		for _, e := range expected {
+			if e == nil {
+				return true
+			}
+		}
+		return false
+	}
+
+	actual := fmt.Sprint(value)
+	for _, e := range expected {
+		if e != nil && fmt.Sprint(e) == actual {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyValue, değerin gönderilmemiş sayılıp sayılmayacağını döndürür
+// (nil, boş string, boş dizi veya nesne).
+func isEmptyValue(value any) bool {
+	if value == nil {
+		return true
+	}
+	if str, ok := value.(string); ok {
+		return strings.TrimSpace(str) == ""
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return rv.Len() == 0
+	}
+	return false
+}
+
+// --- Akıcı (Fluent) Metotlar ---
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (as *AdvancedStringType) RequiredIf(other string, values ...any) *AdvancedStringType {
+	as.AddRequiredIf(other, values...)
+	return as
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (as *AdvancedStringType) RequiredWith(others ...string) *AdvancedStringType {
+	as.AddRequiredWith(others...)
+	return as
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (as *AdvancedStringType) ExcludedUnless(other string, values ...any) *AdvancedStringType {
+	as.AddExcludedUnless(other, values...)
+	return as
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (a *ArrayType) RequiredIf(other string, values ...any) *ArrayType {
+	a.AddRequiredIf(other, values...)
+	return a
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (a *ArrayType) RequiredWith(others ...string) *ArrayType {
+	a.AddRequiredWith(others...)
+	return a
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (a *ArrayType) ExcludedUnless(other string, values ...any) *ArrayType {
+	a.AddExcludedUnless(other, values...)
+	return a
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (b *BooleanType) RequiredIf(other string, values ...any) *BooleanType {
+	b.AddRequiredIf(other, values...)
+	return b
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (b *BooleanType) RequiredWith(others ...string) *BooleanType {
+	b.AddRequiredWith(others...)
+	return b
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (b *BooleanType) ExcludedUnless(other string, values ...any) *BooleanType {
+	b.AddExcludedUnless(other, values...)
+	return b
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (c *CreditCardType) RequiredIf(other string, values ...any) *CreditCardType {
+	c.AddRequiredIf(other, values...)
+	return c
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (c *CreditCardType) RequiredWith(others ...string) *CreditCardType {
+	c.AddRequiredWith(others...)
+	return c
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (c *CreditCardType) ExcludedUnless(other string, values ...any) *CreditCardType {
+	c.AddExcludedUnless(other, values...)
+	return c
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (d *DateType) RequiredIf(other string, values ...any) *DateType {
+	d.AddRequiredIf(other, values...)
+	return d
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (d *DateType) RequiredWith(others ...string) *DateType {
+	d.AddRequiredWith(others...)
+	return d
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (d *DateType) ExcludedUnless(other string, values ...any) *DateType {
+	d.AddExcludedUnless(other, values...)
+	return d
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (f *FloatType) RequiredIf(other string, values ...any) *FloatType {
+	f.AddRequiredIf(other, values...)
+	return f
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (f *FloatType) RequiredWith(others ...string) *FloatType {
+	f.AddRequiredWith(others...)
+	return f
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (f *FloatType) ExcludedUnless(other string, values ...any) *FloatType {
+	f.AddExcludedUnless(other, values...)
+	return f
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (i *IbanType) RequiredIf(other string, values ...any) *IbanType {
+	i.AddRequiredIf(other, values...)
+	return i
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (i *IbanType) RequiredWith(others ...string) *IbanType {
+	i.AddRequiredWith(others...)
+	return i
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (i *IbanType) ExcludedUnless(other string, values ...any) *IbanType {
+	i.AddExcludedUnless(other, values...)
+	return i
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (i *IntType) RequiredIf(other string, values ...any) *IntType {
+	i.AddRequiredIf(other, values...)
+	return i
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (i *IntType) RequiredWith(others ...string) *IntType {
+	i.AddRequiredWith(others...)
+	return i
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (i *IntType) ExcludedUnless(other string, values ...any) *IntType {
+	i.AddExcludedUnless(other, values...)
+	return i
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (n *NumberType) RequiredIf(other string, values ...any) *NumberType {
+	n.AddRequiredIf(other, values...)
+	return n
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (n *NumberType) RequiredWith(others ...string) *NumberType {
+	n.AddRequiredWith(others...)
+	return n
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (n *NumberType) ExcludedUnless(other string, values ...any) *NumberType {
+	n.AddExcludedUnless(other, values...)
+	return n
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (o *ObjectType) RequiredIf(other string, values ...any) *ObjectType {
+	o.AddRequiredIf(other, values...)
+	return o
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (o *ObjectType) RequiredWith(others ...string) *ObjectType {
+	o.AddRequiredWith(others...)
+	return o
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (o *ObjectType) ExcludedUnless(other string, values ...any) *ObjectType {
+	o.AddExcludedUnless(other, values...)
+	return o
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (s *StringType) RequiredIf(other string, values ...any) *StringType {
+	s.AddRequiredIf(other, values...)
+	return s
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (s *StringType) RequiredWith(others ...string) *StringType {
+	s.AddRequiredWith(others...)
+	return s
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (s *StringType) ExcludedUnless(other string, values ...any) *StringType {
+	s.AddExcludedUnless(other, values...)
+	return s
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (u *UuidType) RequiredIf(other string, values ...any) *UuidType {
+	u.AddRequiredIf(other, values...)
+	return u
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (u *UuidType) RequiredWith(others ...string) *UuidType {
+	u.AddRequiredWith(others...)
+	return u
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (u *UuidType) ExcludedUnless(other string, values ...any) *UuidType {
+	u.AddExcludedUnless(other, values...)
+	return u
+}
@@ -1,11 +1,30 @@
 package types
 
-// Array, yeni bir ArrayType nesnesi oluşturur.
+import "github.com/biyonik/conduit-go/pkg/validation"
+
+// Array, yeni bir ArrayType nesnesi oluşturur. Eleman şeması opsiyonel
+// olarak doğrudan verilebilir (Elements() ile aynı).
+//
+// Parametreler:
+//   - of: Her elemanın uyması gereken şema (opsiyonel)
 //
 // Döndürür:
 //   - *ArrayType: Yeni ArrayType örneği
-func Array() *ArrayType {
-	return &ArrayType{}
+//
+// Örnek:
+//
+//	"items": types.Array(types.Object(map[string]validation.Type{
+//	    "sku": types.String().Required(),
+//	    "qty": types.Int().Required().Positive(),
+//	})).Required().Min(1),
+//
+// Eleman hataları "items.0.qty" gibi yol anahtarlarıyla raporlanır.
+func Array(of ...validation.Type) *ArrayType {
+	array := &ArrayType{}
+	if len(of) > 0 {
+		array.elementSchema = of[0]
+	}
+	return array
 }
 
 // Boolean, yeni bir BooleanType nesnesi oluşturur.
@@ -16,6 +35,15 @@ func Boolean() *BooleanType {
 	return &BooleanType{}
 }
 
+// Bool, string ve sayı gösterimlerini ("true", "1", "on", 0/1) bool'a
+// çeviren bir BooleanType oluşturur. Boolean() sadece JSON boolean kabul eder.
+//
+// Döndürür:
+//   - *BooleanType: Yeni BooleanType örneği
+func Bool() *BooleanType {
+	return &BooleanType{coerce: true}
+}
+
 // CreditCard, yeni bir CreditCardType nesnesi oluşturur.
 func CreditCard() *CreditCardType {
 	return &CreditCardType{}
@@ -31,14 +59,48 @@ func Date() *DateType {
 	}
 }
 
+// Float, sayısal string'leri ve JSON sayılarını float64'e çeviren yeni bir
+// FloatType oluşturur.
+//
+// Döndürür:
+//   - *FloatType: Yeni FloatType örneği
+func Float() *FloatType {
+	return &FloatType{}
+}
+
+// Int, sayısal string'leri ve tam JSON sayılarını int64'e çeviren yeni bir
+// IntType oluşturur.
+//
+// Döndürür:
+//   - *IntType: Yeni IntType örneği
+//
+// Örnek:
+//
+//	"age":  types.Int().Required().Between(18, 120).Label("Yaş"),
+//	"page": types.Int().Positive().Default(1),
+func Int() *IntType {
+	return &IntType{}
+}
+
 // Number, yeni bir NumberType nesnesi oluşturur.
 func Number() *NumberType {
 	return &NumberType{}
 }
 
-// Object, yeni bir ObjectType nesnesi oluşturur.
-func Object() *ObjectType {
-	return &ObjectType{}
+// Object, yeni bir ObjectType nesnesi oluşturur. İç şema opsiyonel olarak
+// doğrudan verilebilir (Shape() ile aynı).
+//
+// Parametreler:
+//   - shape: İç nesnenin alan şemaları (opsiyonel)
+//
+// Döndürür:
+//   - *ObjectType: Yeni ObjectType örneği
+func Object(shape ...map[string]validation.Type) *ObjectType {
+	object := &ObjectType{}
+	if len(shape) > 0 {
+		object.shape = shape[0]
+	}
+	return object
 }
 
 // String, yeni bir StringType nesnesi oluşturur.
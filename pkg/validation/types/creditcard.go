@@ -54,7 +54,7 @@ func (c *CreditCardType) Type(typ string) *CreditCardType {
 func (c *CreditCardType) Validate(field string, value any, result *validation.ValidationResult) {
 	// Temel zorunluluk kontrolünü uygula
 	c.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 
@@ -64,7 +64,7 @@ func (c *CreditCardType) Validate(field string, value any, result *validation.Va
 
 	str, ok := value.(string)
 	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı metin tipinde olmalıdır", c.label))
+		c.addError(result, field, "type", fmt.Sprintf("%s alanı metin tipinde olmalıdır", c.label))
 		return
 	}
 
@@ -80,6 +80,6 @@ func (c *CreditCardType) Validate(field string, value any, result *validation.Va
 			typeText = fmt.Sprintf(" (%s)", c.cardType)
 		}
 
-		result.AddError(field, fmt.Sprintf("%s alanı geçerli bir kredi kartı numarası%s olmalıdır", fieldName, typeText))
+		c.addError(result, field, "card", fmt.Sprintf("%s alanı geçerli bir kredi kartı numarası%s olmalıdır", fieldName, typeText))
 	}
 }
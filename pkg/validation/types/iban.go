@@ -36,13 +36,13 @@ func (i *IbanType) Country(code string) *IbanType {
 
 func (i *IbanType) Validate(field string, value any, result *validation.ValidationResult) {
 	i.BaseType.Validate(field, value, result)
-	if result.HasErrors() || value == nil {
+	if hasFieldErrors(result, field) || value == nil {
 		return
 	}
 
 	str, ok := value.(string)
 	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı metin tipinde olmalıdır", i.label))
+		i.addError(result, field, "type", fmt.Sprintf("%s alanı metin tipinde olmalıdır", i.label))
 		return
 	}
 
@@ -56,6 +56,6 @@ func (i *IbanType) Validate(field string, value any, result *validation.Validati
 		if i.countryCode != "" {
 			countryText = fmt.Sprintf(" (%s)", i.countryCode)
 		}
-		result.AddError(field, fmt.Sprintf("%s alanı geçerli bir IBAN%s olmalıdır", fieldName, countryText))
+		i.addError(result, field, "iban", fmt.Sprintf("%s alanı geçerli bir IBAN%s olmalıdır", fieldName, countryText))
 	}
 }
@@ -0,0 +1,279 @@
+// Package types, tip bazlı doğrulama nesnelerini ve kurallarını yönetir.
+// Bu dosya, her tipin kurallarını JSON Schema property'si olarak dışa aktaran
+// JSONSchema() metotlarını içerir (validation.JSONSchemaDescriber).
+package types
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/validation"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// IsRequired, alanın zorunlu olup olmadığını döndürür.
+// Sometimes ile işaretlenen alanlar gönderilmeyebileceğinden zorunlu
+// sayılmaz.
+func (b *BaseType) IsRequired() bool {
+	return b.isRequired && !b.sometimes
+}
+
+// baseJSONSchema, tüm tiplerde ortak olan "type", "title" ve "default"
+// anahtarlarını içeren şemayı oluşturur.
+func (b *BaseType) baseJSONSchema(jsonType string) map[string]any {
+	schema := map[string]any{"type": jsonType}
+	if b.nullable {
+		schema["type"] = []string{jsonType, "null"}
+	}
+	if b.label != "" {
+		schema["title"] = b.label
+	}
+	if b.defaultValue != nil {
+		schema["default"] = b.defaultValue
+	}
+	return schema
+}
+
+// JSONSchema, StringType kurallarını JSON Schema olarak döndürür.
+//
+// Standart karşılığı olmayan kurallar (telefon, parola politikası) "x-"
+// önekli extension anahtarlarıyla aktarılır; form builder'lar bunları
+// isteğe bağlı olarak kullanabilir.
+func (s *StringType) JSONSchema() map[string]any {
+	schema := s.baseJSONSchema("string")
+
+	if s.minLength != nil {
+		schema["minLength"] = *s.minLength
+	}
+	if s.maxLength != nil {
+		schema["maxLength"] = *s.maxLength
+	}
+	if s.emailRegex != nil {
+		schema["format"] = "email"
+	}
+	if s.urlSchemes != nil {
+		schema["format"] = "uri"
+	}
+	if s.uuidVersion != nil {
+		schema["format"] = "uuid"
+		if *s.uuidVersion > 0 {
+			schema["x-uuid-version"] = *s.uuidVersion
+		}
+	}
+	if s.isJSON {
+		schema["contentMediaType"] = "application/json"
+	}
+	if len(s.patterns) > 0 {
+		schema["pattern"] = s.patterns[0].String()
+	}
+	if len(s.allowedValues) > 0 {
+		schema["enum"] = s.allowedValues
+	}
+
+	if s.ipVersion != nil {
+		switch *s.ipVersion {
+		case 4:
+			schema["format"] = "ipv4"
+		case 6:
+			schema["format"] = "ipv6"
+		default:
+			schema["anyOf"] = []any{
+				map[string]any{"format": "ipv4"},
+				map[string]any{"format": "ipv6"},
+			}
+		}
+	}
+
+	if s.phoneCountry != nil {
+		schema["x-phone-country"] = *s.phoneCountry
+	}
+
+	if s.passwordRules != nil {
+		rules := s.passwordRules
+		schema["format"] = "password"
+		// Parola kuralları uzunluk kısıtlarını belirler (Min/Max'tan sıkıysa)
+		if current, ok := schema["minLength"].(int); !ok || rules.MinLength > current {
+			schema["minLength"] = rules.MinLength
+		}
+		if current, ok := schema["maxLength"].(int); rules.MaxLength > 0 && (!ok || rules.MaxLength < current) {
+			schema["maxLength"] = rules.MaxLength
+		}
+		schema["x-password-rules"] = map[string]any{
+			"requireUppercase": rules.RequireUppercase,
+			"requireLowercase": rules.RequireLowercase,
+			"requireNumeric":   rules.RequireNumeric,
+			"requireSpecial":   rules.RequireSpecial,
+			"specialChars":     rules.SpecialChars,
+			"minUniqueChars":   rules.MinUniqueChars,
+		}
+	}
+
+	return schema
+}
+
+// JSONSchema, AdvancedStringType kurallarını JSON Schema olarak döndürür.
+func (as *AdvancedStringType) JSONSchema() map[string]any {
+	schema := as.StringType.JSONSchema()
+
+	if as.domainCheck != nil {
+		schema["format"] = "hostname"
+	}
+	if as.charSet != nil {
+		schema["x-charset"] = *as.charSet
+	}
+	if as.turkishChars != nil {
+		schema["x-turkish-chars"] = *as.turkishChars
+	}
+
+	return schema
+}
+
+// JSONSchema, NumberType kurallarını JSON Schema olarak döndürür.
+func (n *NumberType) JSONSchema() map[string]any {
+	jsonType := "number"
+	if n.isInteger {
+		jsonType = "integer"
+	}
+
+	schema := n.baseJSONSchema(jsonType)
+	if n.min != nil {
+		schema["minimum"] = *n.min
+	}
+	if n.max != nil {
+		schema["maximum"] = *n.max
+	}
+	return schema
+}
+
+// JSONSchema, IntType kurallarını JSON Schema olarak döndürür.
+func (i *IntType) JSONSchema() map[string]any {
+	schema := i.baseJSONSchema("integer")
+	if i.min != nil {
+		schema["minimum"] = *i.min
+	}
+	if i.max != nil {
+		schema["maximum"] = *i.max
+	}
+	if len(i.allowedValues) > 0 {
+		schema["enum"] = i.allowedValues
+	}
+	return schema
+}
+
+// JSONSchema, EnumType'ın izin verilen değerlerini JSON Schema olarak
+// döndürür.
+func (e *EnumType[T]) JSONSchema() map[string]any {
+	jsonType := "integer"
+	if reflect.TypeFor[T]().Kind() == reflect.String {
+		jsonType = "string"
+	}
+	schema := e.baseJSONSchema(jsonType)
+	schema["enum"] = e.values
+	return schema
+}
+
+// JSONSchema, FloatType kurallarını JSON Schema olarak döndürür.
+func (f *FloatType) JSONSchema() map[string]any {
+	schema := f.baseJSONSchema("number")
+	if f.min != nil {
+		if f.exclusiveMin {
+			schema["exclusiveMinimum"] = *f.min
+		} else {
+			schema["minimum"] = *f.min
+		}
+	}
+	if f.max != nil {
+		schema["maximum"] = *f.max
+	}
+	return schema
+}
+
+// JSONSchema, BooleanType kurallarını JSON Schema olarak döndürür.
+func (b *BooleanType) JSONSchema() map[string]any {
+	return b.baseJSONSchema("boolean")
+}
+
+// JSONSchema, DateType kurallarını JSON Schema olarak döndürür.
+//
+// Go layout'u standart bir formata karşılık geliyorsa "date" veya
+// "date-time" kullanılır; diğer layout'lar "x-go-layout" ile aktarılır.
+func (d *DateType) JSONSchema() map[string]any {
+	schema := d.baseJSONSchema("string")
+
+	switch d.format {
+	case "2006-01-02":
+		schema["format"] = "date"
+	case time.RFC3339, time.RFC3339Nano:
+		schema["format"] = "date-time"
+	default:
+		schema["x-go-layout"] = d.format
+	}
+
+	if d.minDateStr != nil {
+		schema["formatMinimum"] = *d.minDateStr
+	}
+	if d.maxDateStr != nil {
+		schema["formatMaximum"] = *d.maxDateStr
+	}
+	return schema
+}
+
+// JSONSchema, UuidType kurallarını JSON Schema olarak döndürür.
+func (u *UuidType) JSONSchema() map[string]any {
+	schema := u.baseJSONSchema("string")
+	schema["format"] = "uuid"
+	if u.version > 0 {
+		schema["x-uuid-version"] = u.version
+	}
+	return schema
+}
+
+// JSONSchema, CreditCardType kurallarını JSON Schema olarak döndürür.
+func (c *CreditCardType) JSONSchema() map[string]any {
+	schema := c.baseJSONSchema("string")
+	schema["x-format"] = "credit-card"
+	if c.cardType != "" {
+		schema["x-card-type"] = c.cardType
+	}
+	return schema
+}
+
+// JSONSchema, IbanType kurallarını JSON Schema olarak döndürür.
+func (i *IbanType) JSONSchema() map[string]any {
+	schema := i.baseJSONSchema("string")
+	schema["x-format"] = "iban"
+	if i.countryCode != "" {
+		schema["x-iban-country"] = i.countryCode
+	}
+	return schema
+}
+
+// JSONSchema, ArrayType kurallarını JSON Schema olarak döndürür.
+func (a *ArrayType) JSONSchema() map[string]any {
+	schema := a.baseJSONSchema("array")
+
+	if a.minLength != nil {
+		schema["minItems"] = *a.minLength
+	}
+	if a.maxLength != nil {
+		schema["maxItems"] = *a.maxLength
+	}
+	if a.elementSchema != nil {
+		items, _ := validation.TypeJSONSchema(a.elementSchema)
+		schema["items"] = items
+	}
+	return schema
+}
+
+// JSONSchema, ObjectType kurallarını (iç şema dahil) JSON Schema olarak döndürür.
+func (o *ObjectType) JSONSchema() map[string]any {
+	schema := validation.ShapeJSONSchema(o.shape)
+	for key, value := range o.baseJSONSchema("object") {
+		schema[key] = value
+	}
+	return schema
+}
@@ -0,0 +1,123 @@
+// Package types, tip bazlı doğrulama nesnelerini ve kurallarını yönetir.
+// Bu dosya, kural bazlı özel hata mesajlarını (Message) içerir.
+//
+// Kural adları: required, required_if, required_with, type, min, max, in,
+// email, url, uuid, json, ip, phone, regex, password, integer, card, iban,
+// turkish, domain, charset. Mesajdaki {field} alanın etiketiyle (Label)
+// veya adıyla değiştirilir.
+package types
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/validation"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// SetMessage, kural için varsayılan hata mesajı yerine kullanılacak mesajı
+// belirler.
+func (b *BaseType) SetMessage(rule, message string) {
+	if b.messages == nil {
+		b.messages = make(map[string]string)
+	}
+	b.messages[rule] = message
+}
+
+// addError, kural için (varsa) özel mesajı, yoksa varsayılan mesajı ekler.
+// Bir kural birden fazla hata üretse de (örn: password) özel mesaj bir kez
+// eklenir.
+func (b *BaseType) addError(result *validation.ValidationResult, field, rule, message string) {
+	custom, ok := b.messages[rule]
+	if !ok {
+		result.AddError(field, message)
+		return
+	}
+
+	fieldName := b.label
+	if fieldName == "" {
+		fieldName = field
+	}
+	custom = strings.ReplaceAll(custom, "{field}", fieldName)
+	if !slices.Contains(result.Errors()[field], custom) {
+		result.AddError(field, custom)
+	}
+}
+
+// --- Akıcı (Fluent) Metotlar ---
+
+// Message, kural için özel hata mesajı belirler.
+func (as *AdvancedStringType) Message(rule, message string) *AdvancedStringType {
+	as.SetMessage(rule, message)
+	return as
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (a *ArrayType) Message(rule, message string) *ArrayType {
+	a.SetMessage(rule, message)
+	return a
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (b *BooleanType) Message(rule, message string) *BooleanType {
+	b.SetMessage(rule, message)
+	return b
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (c *CreditCardType) Message(rule, message string) *CreditCardType {
+	c.SetMessage(rule, message)
+	return c
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (d *DateType) Message(rule, message string) *DateType {
+	d.SetMessage(rule, message)
+	return d
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (f *FloatType) Message(rule, message string) *FloatType {
+	f.SetMessage(rule, message)
+	return f
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (i *IbanType) Message(rule, message string) *IbanType {
+	i.SetMessage(rule, message)
+	return i
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (i *IntType) Message(rule, message string) *IntType {
+	i.SetMessage(rule, message)
+	return i
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (n *NumberType) Message(rule, message string) *NumberType {
+	n.SetMessage(rule, message)
+	return n
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (o *ObjectType) Message(rule, message string) *ObjectType {
+	o.SetMessage(rule, message)
+	return o
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (s *StringType) Message(rule, message string) *StringType {
+	s.SetMessage(rule, message)
+	return s
+}
+
+// Message, kural için özel hata mesajı belirler.
+func (u *UuidType) Message(rule, message string) *UuidType {
+	u.SetMessage(rule, message)
+	return u
+}
@@ -0,0 +1,258 @@
+// Package types, tip bazlı doğrulama nesnelerini ve kurallarını yönetir.
+// Bu dosya, Nullable, Sometimes ve Bail belirteçlerini ve her tip için
+// akıcı metotlarını içerir (validation.ModifiedType).
+package types
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// SetNullable, açıkça null gönderilen değerin kabul edilmesini sağlar.
+func (b *BaseType) SetNullable() {
+	b.nullable = true
+}
+
+// SetSometimes, alanın sadece veride bulunduğunda doğrulanmasını sağlar.
+func (b *BaseType) SetSometimes() {
+	b.sometimes = true
+}
+
+// SetBail, alan için ilk hatadan sonra doğrulamanın durmasını sağlar.
+func (b *BaseType) SetBail() {
+	b.bail = true
+}
+
+// IsNullable, alanın null kabul edip etmediğini döndürür.
+func (b *BaseType) IsNullable() bool {
+	return b.nullable
+}
+
+// IsSometimes, alanın sadece gönderildiğinde doğrulanıp doğrulanmadığını
+// döndürür.
+func (b *BaseType) IsSometimes() bool {
+	return b.sometimes
+}
+
+// IsBail, alanın ilk hatada durup durmadığını döndürür.
+func (b *BaseType) IsBail() bool {
+	return b.bail
+}
+
+// --- Akıcı (Fluent) Metotlar ---
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (as *AdvancedStringType) Nullable() *AdvancedStringType {
+	as.SetNullable()
+	return as
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (as *AdvancedStringType) Sometimes() *AdvancedStringType {
+	as.SetSometimes()
+	return as
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (as *AdvancedStringType) Bail() *AdvancedStringType {
+	as.SetBail()
+	return as
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (a *ArrayType) Nullable() *ArrayType {
+	a.SetNullable()
+	return a
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (a *ArrayType) Sometimes() *ArrayType {
+	a.SetSometimes()
+	return a
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (a *ArrayType) Bail() *ArrayType {
+	a.SetBail()
+	return a
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (b *BooleanType) Nullable() *BooleanType {
+	b.SetNullable()
+	return b
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (b *BooleanType) Sometimes() *BooleanType {
+	b.SetSometimes()
+	return b
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (b *BooleanType) Bail() *BooleanType {
+	b.SetBail()
+	return b
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (c *CreditCardType) Nullable() *CreditCardType {
+	c.SetNullable()
+	return c
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (c *CreditCardType) Sometimes() *CreditCardType {
+	c.SetSometimes()
+	return c
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (c *CreditCardType) Bail() *CreditCardType {
+	c.SetBail()
+	return c
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (d *DateType) Nullable() *DateType {
+	d.SetNullable()
+	return d
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (d *DateType) Sometimes() *DateType {
+	d.SetSometimes()
+	return d
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (d *DateType) Bail() *DateType {
+	d.SetBail()
+	return d
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (f *FloatType) Nullable() *FloatType {
+	f.SetNullable()
+	return f
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (f *FloatType) Sometimes() *FloatType {
+	f.SetSometimes()
+	return f
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (f *FloatType) Bail() *FloatType {
+	f.SetBail()
+	return f
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (i *IbanType) Nullable() *IbanType {
+	i.SetNullable()
+	return i
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (i *IbanType) Sometimes() *IbanType {
+	i.SetSometimes()
+	return i
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (i *IbanType) Bail() *IbanType {
+	i.SetBail()
+	return i
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (i *IntType) Nullable() *IntType {
+	i.SetNullable()
+	return i
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (i *IntType) Sometimes() *IntType {
+	i.SetSometimes()
+	return i
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (i *IntType) Bail() *IntType {
+	i.SetBail()
+	return i
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (n *NumberType) Nullable() *NumberType {
+	n.SetNullable()
+	return n
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (n *NumberType) Sometimes() *NumberType {
+	n.SetSometimes()
+	return n
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (n *NumberType) Bail() *NumberType {
+	n.SetBail()
+	return n
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (o *ObjectType) Nullable() *ObjectType {
+	o.SetNullable()
+	return o
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (o *ObjectType) Sometimes() *ObjectType {
+	o.SetSometimes()
+	return o
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (o *ObjectType) Bail() *ObjectType {
+	o.SetBail()
+	return o
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (s *StringType) Nullable() *StringType {
+	s.SetNullable()
+	return s
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (s *StringType) Sometimes() *StringType {
+	s.SetSometimes()
+	return s
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (s *StringType) Bail() *StringType {
+	s.SetBail()
+	return s
+}
+
+// Nullable, açıkça null gönderilen değeri kabul eder ve nil olarak bırakır.
+func (u *UuidType) Nullable() *UuidType {
+	u.SetNullable()
+	return u
+}
+
+// Sometimes, alanı sadece veride bulunduğunda doğrular.
+func (u *UuidType) Sometimes() *UuidType {
+	u.SetSometimes()
+	return u
+}
+
+// Bail, alan için sadece ilk hatayı raporlar.
+func (u *UuidType) Bail() *UuidType {
+	u.SetBail()
+	return u
+}
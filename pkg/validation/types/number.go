@@ -81,7 +81,7 @@ func (n *NumberType) Integer() *NumberType {
 func (n *NumberType) Validate(field string, value any, result *validation.ValidationResult) {
 	// Temel zorunluluk kontrolünü uygula
 	n.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 
@@ -114,22 +114,22 @@ func (n *NumberType) Validate(field string, value any, result *validation.Valida
 	}
 
 	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı sayısal bir değer olmalıdır", fieldName))
+		n.addError(result, field, "type", fmt.Sprintf("%s alanı sayısal bir değer olmalıdır", fieldName))
 		return
 	}
 
 	// Tamsayı kontrolü
 	if n.isInteger && num != float64(int64(num)) {
-		result.AddError(field, fmt.Sprintf("%s alanı tamsayı olmalıdır", fieldName))
+		n.addError(result, field, "integer", fmt.Sprintf("%s alanı tamsayı olmalıdır", fieldName))
 	}
 
 	// Minimum değer kontrolü
 	if n.min != nil && num < *n.min {
-		result.AddError(field, fmt.Sprintf("%s alanı %v değerinden küçük olamaz", fieldName, *n.min))
+		n.addError(result, field, "min", fmt.Sprintf("%s alanı %v değerinden küçük olamaz", fieldName, *n.min))
 	}
 
 	// Maksimum değer kontrolü
 	if n.max != nil && num > *n.max {
-		result.AddError(field, fmt.Sprintf("%s alanı %v değerinden büyük olamaz", fieldName, *n.max))
+		n.addError(result, field, "max", fmt.Sprintf("%s alanı %v değerinden büyük olamaz", fieldName, *n.max))
 	}
 }
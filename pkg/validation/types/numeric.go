@@ -0,0 +1,353 @@
+// Package types, tip bazlı doğrulama nesnelerini ve kurallarını yönetir.
+// Bu dosya, tamsayı (Int) ve ondalıklı sayı (Float) tiplerini içerir.
+//
+// Number()'dan farkı: değerler doğrulamadan önce hedef tipe çevrilir
+// (coercion). JSON sayıları (float64), json.Number, Go sayı tipleri ve
+// sayısal string'ler ("42", " 3.14 ") kabul edilir; validData'da değer
+// Int için int64, Float için float64 olarak yer alır. Böylece query string
+// ve form alanları da string olarak değil sayı olarak doğrulanır.
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/validation"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+// IntType, tamsayı alanlarının doğrulamasını ve dönüşümünü yönetir.
+type IntType struct {
+	BaseType
+	min           *int64  // Minimum değer (opsiyonel)
+	max           *int64  // Maksimum değer (opsiyonel)
+	allowedValues []int64 // İzin verilen değerler (opsiyonel)
+}
+
+// --- Akıcı (Fluent) Metotlar ---
+
+// Required, alanı zorunlu olarak işaretler.
+func (i *IntType) Required() *IntType {
+	i.SetRequired()
+	return i
+}
+
+// Label, alan için insan okunabilir bir isim atar.
+func (i *IntType) Label(label string) *IntType {
+	i.SetLabel(label)
+	return i
+}
+
+// Default, alan için varsayılan değer atar.
+func (i *IntType) Default(value int64) *IntType {
+	i.SetDefault(value)
+	return i
+}
+
+// Min, alan için minimum değeri belirler.
+func (i *IntType) Min(val int64) *IntType {
+	i.min = &val
+	return i
+}
+
+// Max, alan için maksimum değeri belirler.
+func (i *IntType) Max(val int64) *IntType {
+	i.max = &val
+	return i
+}
+
+// Between, değerin [min, max] aralığında olmasını zorunlu kılar.
+//
+// Örnek:
+//
+//	types.Int().Between(1, 100) // Min(1).Max(100) ile aynı
+func (i *IntType) Between(min, max int64) *IntType {
+	return i.Min(min).Max(max)
+}
+
+// Positive, değerin sıfırdan büyük olmasını zorunlu kılar.
+func (i *IntType) Positive() *IntType {
+	return i.Min(1)
+}
+
+// In, değerin belirli değerlerden biri olmasını zorunlu kılar.
+//
+// Örnek:
+//
+//	"priority": types.Int().In(1, 2, 3),
+func (i *IntType) In(values ...int64) *IntType {
+	i.allowedValues = values
+	return i
+}
+
+// --- Arayüz (Interface) Implementasyonu ---
+
+// Transform, varsayılan değeri ve dönüşümleri uygular, ardından değeri
+// int64'e çevirir. Çevrilemeyen değerler olduğu gibi bırakılır; hata
+// Validate'te alan mesajı olarak raporlanır.
+func (i *IntType) Transform(value any) (any, error) {
+	value, err := i.BaseType.Transform(value)
+	if err != nil || value == nil {
+		return value, err
+	}
+	return coerceInt(value), nil
+}
+
+// Validate, tamsayı alanının doğrulama mantığını çalıştırır.
+//
+// Parametreler:
+//   - field: Alan adı
+//   - value: Doğrulanacak değer (Transform sonrası)
+//   - result: ValidationResult, hatalar buraya eklenir
+func (i *IntType) Validate(field string, value any, result *validation.ValidationResult) {
+	i.BaseType.Validate(field, value, result)
+	if hasFieldErrors(result, field) {
+		return
+	}
+
+	value = coerceInt(value)
+	if value == nil {
+		return
+	}
+
+	fieldName := i.label
+	if fieldName == "" {
+		fieldName = field
+	}
+
+	num, ok := value.(int64)
+	if !ok {
+		i.addError(result, field, "type", fmt.Sprintf("%s alanı tamsayı olmalıdır", fieldName))
+		return
+	}
+
+	if i.min != nil && num < *i.min {
+		i.addError(result, field, "min", fmt.Sprintf("%s alanı %d değerinden küçük olamaz", fieldName, *i.min))
+	}
+	if i.max != nil && num > *i.max {
+		i.addError(result, field, "max", fmt.Sprintf("%s alanı %d değerinden büyük olamaz", fieldName, *i.max))
+	}
+	if len(i.allowedValues) > 0 && !slices.Contains(i.allowedValues, num) {
+		allowed := make([]string, len(i.allowedValues))
+		for idx, v := range i.allowedValues {
+			allowed[idx] = strconv.FormatInt(v, 10)
+		}
+		i.addError(result, field, "in", fmt.Sprintf("%s alanı şu değerlerden biri olmalıdır: %s", fieldName, strings.Join(allowed, ", ")))
+	}
+}
+
+// FloatType, ondalıklı sayı alanlarının doğrulamasını ve dönüşümünü yönetir.
+type FloatType struct {
+	BaseType
+	min          *float64 // Minimum değer (opsiyonel)
+	max          *float64 // Maksimum değer (opsiyonel)
+	exclusiveMin bool     // min dahil değil (Positive için)
+}
+
+// --- Akıcı (Fluent) Metotlar ---
+
+// Required, alanı zorunlu olarak işaretler.
+func (f *FloatType) Required() *FloatType {
+	f.SetRequired()
+	return f
+}
+
+// Label, alan için insan okunabilir bir isim atar.
+func (f *FloatType) Label(label string) *FloatType {
+	f.SetLabel(label)
+	return f
+}
+
+// Default, alan için varsayılan değer atar.
+func (f *FloatType) Default(value float64) *FloatType {
+	f.SetDefault(value)
+	return f
+}
+
+// Min, alan için minimum değeri belirler (dahil).
+func (f *FloatType) Min(val float64) *FloatType {
+	f.min = &val
+	f.exclusiveMin = false
+	return f
+}
+
+// Max, alan için maksimum değeri belirler (dahil).
+func (f *FloatType) Max(val float64) *FloatType {
+	f.max = &val
+	return f
+}
+
+// Between, değerin [min, max] aralığında olmasını zorunlu kılar.
+func (f *FloatType) Between(min, max float64) *FloatType {
+	return f.Min(min).Max(max)
+}
+
+// Positive, değerin sıfırdan büyük olmasını zorunlu kılar (0 kabul edilmez).
+func (f *FloatType) Positive() *FloatType {
+	zero := 0.0
+	f.min = &zero
+	f.exclusiveMin = true
+	return f
+}
+
+// --- Arayüz (Interface) Implementasyonu ---
+
+// Transform, varsayılan değeri ve dönüşümleri uygular, ardından değeri
+// float64'e çevirir. Çevrilemeyen değerler olduğu gibi bırakılır.
+func (f *FloatType) Transform(value any) (any, error) {
+	value, err := f.BaseType.Transform(value)
+	if err != nil || value == nil {
+		return value, err
+	}
+	return coerceFloat(value), nil
+}
+
+// Validate, ondalıklı sayı alanının doğrulama mantığını çalıştırır.
+//
+// Parametreler:
+//   - field: Alan adı
+//   - value: Doğrulanacak değer (Transform sonrası)
+//   - result: ValidationResult, hatalar buraya eklenir
+func (f *FloatType) Validate(field string, value any, result *validation.ValidationResult) {
+	f.BaseType.Validate(field, value, result)
+	if hasFieldErrors(result, field) {
+		return
+	}
+
+	value = coerceFloat(value)
+	if value == nil {
+		return
+	}
+
+	fieldName := f.label
+	if fieldName == "" {
+		fieldName = field
+	}
+
+	num, ok := value.(float64)
+	if !ok {
+		f.addError(result, field, "type", fmt.Sprintf("%s alanı sayısal bir değer olmalıdır", fieldName))
+		return
+	}
+
+	if f.min != nil {
+		if f.exclusiveMin && num <= *f.min {
+			f.addError(result, field, "min", fmt.Sprintf("%s alanı %v değerinden büyük olmalıdır", fieldName, *f.min))
+		} else if !f.exclusiveMin && num < *f.min {
+			f.addError(result, field, "min", fmt.Sprintf("%s alanı %v değerinden küçük olamaz", fieldName, *f.min))
+		}
+	}
+	if f.max != nil && num > *f.max {
+		f.addError(result, field, "max", fmt.Sprintf("%s alanı %v değerinden büyük olamaz", fieldName, *f.max))
+	}
+}
+
+// --- Coercion Yardımcıları ---
+
+// coerceInt, değeri int64'e çevirir. Kesirli, aralık dışı veya sayısal
+// olmayan değerler olduğu gibi döner.
+func coerceInt(value any) any {
+	switch v := value.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint:
+		if uint64(v) <= math.MaxInt64 {
+			return int64(v)
+		}
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v)
+		}
+	case float32:
+		return coerceInt(float64(v))
+	case float64:
+		// JSON sayıları float64 olarak gelir; sadece tam değerler kabul edilir
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return int64(v)
+		}
+	case json.Number:
+		return coerceInt(string(v))
+	case string:
+		s := strings.TrimSpace(v)
+		if s == "" {
+			return nil
+		}
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n
+		}
+	}
+	return value
+}
+
+// coerceFloat, değeri float64'e çevirir. Sayısal olmayan değerler (ve
+// NaN/Inf) olduğu gibi döner.
+func coerceFloat(value any) any {
+	var num float64
+	switch v := value.(type) {
+	case float64:
+		num = v
+	case float32:
+		num = float64(v)
+	case int:
+		num = float64(v)
+	case int8:
+		num = float64(v)
+	case int16:
+		num = float64(v)
+	case int32:
+		num = float64(v)
+	case int64:
+		num = float64(v)
+	case uint:
+		num = float64(v)
+	case uint8:
+		num = float64(v)
+	case uint16:
+		num = float64(v)
+	case uint32:
+		num = float64(v)
+	case uint64:
+		num = float64(v)
+	case json.Number:
+		return coerceFloat(string(v))
+	case string:
+		s := strings.TrimSpace(v)
+		if s == "" {
+			return nil
+		}
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return value
+		}
+		num = n
+	default:
+		return value
+	}
+
+	if math.IsNaN(num) || math.IsInf(num, 0) {
+		return value
+	}
+	return num
+}
@@ -60,18 +60,27 @@ func (o *ObjectType) Transform(value any) (any, error) {
 		return nil, nil
 	}
 
+	// Nesne olmayan değerler olduğu gibi bırakılır; Validate hata ekler
 	data, ok := value.(map[string]any)
 	if !ok {
-		return nil, fmt.Errorf("nesne (object) tipinde olmalıdır")
+		return value, nil
 	}
 
 	transformedData := make(map[string]any)
 	for field, typ := range o.shape {
-		subValue := data[field]
+		subValue, present := data[field]
+		if validation.SkipField(typ, subValue, present) {
+			if present {
+				transformedData[field] = nil
+			}
+			continue
+		}
 
 		transformedSubValue, err := typ.Transform(subValue)
 		if err != nil {
-			return nil, fmt.Errorf("alan '%s': %w", field, err)
+			// Dönüştürülemeyen alan ham haliyle doğrulanır, böylece hata
+			// "address.zip" gibi alan yoluyla raporlanır
+			transformedSubValue = subValue
 		}
 		transformedData[field] = transformedSubValue
 	}
@@ -94,7 +103,7 @@ func (o *ObjectType) Transform(value any) (any, error) {
 //   - result: ValidationResult, hatalar buraya eklenir
 func (o *ObjectType) Validate(field string, value any, result *validation.ValidationResult) {
 	o.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 	if value == nil {
@@ -103,13 +112,28 @@ func (o *ObjectType) Validate(field string, value any, result *validation.Valida
 
 	data, ok := value.(map[string]any)
 	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı nesne (object) tipinde olmalıdır", o.label))
+		fieldName := o.label
+		if fieldName == "" {
+			fieldName = field
+		}
+		o.addError(result, field, "type", fmt.Sprintf("%s alanı nesne (object) tipinde olmalıdır", fieldName))
 		return
 	}
 
+	// Hata anahtarı: address.zip, items.0.qty
+	// Koşullu kurallar nesnenin kendi alanlarına bakar
+	var excluded []string
 	for subField, subSchema := range o.shape {
-		subValue := data[subField]
+		subValue, present := data[subField]
+		if validation.SkipField(subSchema, subValue, present) {
+			continue
+		}
 		fullFieldPath := fmt.Sprintf("%s.%s", field, subField)
-		subSchema.Validate(fullFieldPath, subValue, result)
+		if !validation.ValidateField(fullFieldPath, subSchema, subValue, data, result) {
+			excluded = append(excluded, subField)
+		}
+	}
+	for _, subField := range excluded {
+		delete(data, subField)
 	}
 }
@@ -24,11 +24,14 @@ type StringType struct {
 	minLength     *int // Minimum uzunluk kısıtı
 	maxLength     *int // Maksimum uzunluk kısıtı
 	emailRegex    *regexp.Regexp
-	urlRegex      *regexp.Regexp
+	urlSchemes    []string // URL() ile ayarlanır (nil: URL kontrolü yok)
 	allowedValues []string
 	passwordRules *rules.PasswordRules
 	ipVersion     *int
 	phoneCountry  *string
+	uuidVersion   *int // UUID() ile ayarlanır (0: tüm versiyonlar)
+	isJSON        bool
+	patterns      []*regexp.Regexp // Regex() ile eklenen desenler
 }
 
 // --- Akıcı (Fluent) Metotlar ---
@@ -70,8 +73,36 @@ func (s *StringType) Email() *StringType {
 	return s
 }
 
-func (s *StringType) URL() *StringType {
-	s.urlRegex = regexp.MustCompile(`^(https?:\/\/)?([\da-z\.-]+)\.([a-z\.]{2,6})([\/\w \.-]*)*\/?$`)
+// URL, alanın şema ve host içeren mutlak bir URL olmasını zorunlu kılar.
+// Şema verilmezse http ve https kabul edilir.
+//
+// Örnek:
+//
+//	types.String().URL()                  // https://example.com/path
+//	types.String().URL("https")           // sadece https
+//	types.String().URL("postgres", "redis")
+func (s *StringType) URL(schemes ...string) *StringType {
+	if len(schemes) == 0 {
+		schemes = []string{"http", "https"}
+	}
+	s.urlSchemes = schemes
+	return s
+}
+
+// UUID, alanın UUID formatında olmasını zorunlu kılar (büyük/küçük harf
+// duyarsız). Versiyon verilirse (1, 3, 4, 5) sadece o versiyon kabul edilir.
+func (s *StringType) UUID(version ...int) *StringType {
+	v := 0
+	if len(version) > 0 {
+		v = version[0]
+	}
+	s.uuidVersion = &v
+	return s
+}
+
+// JSON, alanın geçerli bir JSON dokümanı içeren string olmasını zorunlu kılar.
+func (s *StringType) JSON() *StringType {
+	s.isJSON = true
 	return s
 }
 
@@ -81,6 +112,29 @@ func (s *StringType) OneOf(values []string) *StringType {
 	return s
 }
 
+// Regex, alanın verilen düzenli ifadeye uymasını zorunlu kılar. Birden fazla
+// çağrıda tüm desenler sağlanmalıdır. Geçersiz desen panic'e neden olur.
+//
+// Örnek:
+//
+//	"username": types.String().Required().
+//	    Regex(`^[a-z0-9_]{3,20}$`).
+//	    Message("regex", "{field} 3-20 karakter olmalı; küçük harf, rakam ve _ içerebilir"),
+func (s *StringType) Regex(pattern string) *StringType {
+	s.patterns = append(s.patterns, regexp.MustCompile(pattern))
+	return s
+}
+
+// In, OneOf'un variadic karşılığıdır. Hata mesajı izin verilen değerleri
+// listeler.
+//
+// Örnek:
+//
+//	"status": types.String().Required().In("active", "inactive", "banned"),
+func (s *StringType) In(values ...string) *StringType {
+	return s.OneOf(values)
+}
+
 // IP, alanın bir IP adresi olmasını gerektirir.
 // Parametre yoksa (IP()), hem v4 hem v6 kabul edilir.
 // IP(4) -> sadece IPv4
@@ -94,6 +148,16 @@ func (s *StringType) IP(version ...int) *StringType {
 	return s
 }
 
+// IPv4, alanın bir IPv4 adresi olmasını gerektirir (IP(4) ile aynı).
+func (s *StringType) IPv4() *StringType {
+	return s.IP(4)
+}
+
+// IPv6, alanın bir IPv6 adresi olmasını gerektirir (IP(6) ile aynı).
+func (s *StringType) IPv6() *StringType {
+	return s.IP(6)
+}
+
 // Phone, alanın bir telefon numarası olmasını gerektirir.
 func (s *StringType) Phone(countryCode string) *StringType {
 	s.phoneCountry = &countryCode
@@ -160,7 +224,7 @@ func (s *StringType) Password(options ...PasswordOption) *StringType {
 func (s *StringType) Validate(field string, value any, result *validation.ValidationResult) {
 	// Temel doğrulama
 	s.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 
@@ -168,39 +232,71 @@ func (s *StringType) Validate(field string, value any, result *validation.Valida
 		return
 	}
 
-	str, ok := value.(string)
-	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı metin tipinde olmalıdır", s.label))
-		return
-	}
-
 	fieldName := s.label
 	if fieldName == "" {
 		fieldName = field
 	}
 
+	str, ok := value.(string)
+	if !ok {
+		s.addError(result, field, "type", fmt.Sprintf("%s alanı metin tipinde olmalıdır", fieldName))
+		return
+	}
+
 	// Minimum ve maksimum uzunluk
 	if s.minLength != nil && len(str) < *s.minLength {
-		result.AddError(field, fmt.Sprintf("%s alanı en az %d karakter olmalıdır", fieldName, *s.minLength))
+		s.addError(result, field, "min", fmt.Sprintf("%s alanı en az %d karakter olmalıdır", fieldName, *s.minLength))
 	}
 	if s.maxLength != nil && len(str) > *s.maxLength {
-		result.AddError(field, fmt.Sprintf("%s alanı en fazla %d karakter olmalıdır", fieldName, *s.maxLength))
+		s.addError(result, field, "max", fmt.Sprintf("%s alanı en fazla %d karakter olmalıdır", fieldName, *s.maxLength))
 	}
 
 	// E-posta kontrolü
 	if s.emailRegex != nil && !s.emailRegex.MatchString(str) {
-		result.AddError(field, fmt.Sprintf("%s alanı geçerli bir e-posta formatında değil", fieldName))
+		s.addError(result, field, "email", fmt.Sprintf("%s alanı geçerli bir e-posta formatında değil", fieldName))
+	}
+
+	// URL kontrolü
+	if s.urlSchemes != nil && str != "" && !rules.IsValidURL(str, s.urlSchemes...) {
+		s.addError(result, field, "url", fmt.Sprintf("%s alanı geçerli bir URL olmalıdır (%s)", fieldName, strings.Join(s.urlSchemes, ", ")))
+	}
+
+	// UUID kontrolü
+	if s.uuidVersion != nil && str != "" && !rules.IsValidUUID(str, *s.uuidVersion) {
+		versionText := ""
+		if *s.uuidVersion > 0 {
+			versionText = fmt.Sprintf(" (v%d)", *s.uuidVersion)
+		}
+		s.addError(result, field, "uuid", fmt.Sprintf("%s alanı geçerli bir UUID%s olmalıdır", fieldName, versionText))
+	}
+
+	// JSON kontrolü
+	if s.isJSON && str != "" && !rules.IsValidJSON(str) {
+		s.addError(result, field, "json", fmt.Sprintf("%s alanı geçerli bir JSON metni olmalıdır", fieldName))
+	}
+
+	// Düzenli ifade kontrolü
+	for _, pattern := range s.patterns {
+		if str != "" && !pattern.MatchString(str) {
+			s.addError(result, field, "regex", fmt.Sprintf("%s alanı geçerli formatta değil", fieldName))
+			break
+		}
+	}
+
+	// İzin verilen değerler (OneOf)
+	if len(s.allowedValues) > 0 && !containsString(s.allowedValues, str) {
+		s.addError(result, field, "in", fmt.Sprintf("%s alanı şu değerlerden biri olmalıdır: %s", fieldName, strings.Join(s.allowedValues, ", ")))
 	}
 
 	// Parola kuralları
 	if s.passwordRules != nil && str != "" {
 		passwordErrors := rules.ValidatePassword(str, s.passwordRules)
 		for _, err := range passwordErrors {
-			result.AddError(field, fmt.Sprintf("%s %s", fieldName, err))
+			s.addError(result, field, "password", fmt.Sprintf("%s %s", fieldName, err))
 		}
 	}
 
-	if s.ipVersion != nil {
+	if s.ipVersion != nil && str != "" {
 		if !rules.IsValidIP(str, *s.ipVersion) {
 			versionText := ""
 			if *s.ipVersion == 4 {
@@ -209,13 +305,23 @@ func (s *StringType) Validate(field string, value any, result *validation.Valida
 			if *s.ipVersion == 6 {
 				versionText = " (IPv6)"
 			}
-			result.AddError(field, fmt.Sprintf("%s alanı geçerli bir IP%s adresi olmalıdır", fieldName, versionText))
+			s.addError(result, field, "ip", fmt.Sprintf("%s alanı geçerli bir IP%s adresi olmalıdır", fieldName, versionText))
 		}
 	}
 
 	if s.phoneCountry != nil {
 		if !rules.IsValidPhoneNumber(str, *s.phoneCountry) {
-			result.AddError(field, fmt.Sprintf("%s alanı geçerli bir %s telefon numarası olmalıdır", fieldName, *s.phoneCountry))
+			s.addError(result, field, "phone", fmt.Sprintf("%s alanı geçerli bir %s telefon numarası olmalıdır", fieldName, *s.phoneCountry))
+		}
+	}
+}
+
+// containsString, değerin listede olup olmadığını kontrol eder.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
 		}
 	}
+	return false
 }
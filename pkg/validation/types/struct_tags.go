@@ -0,0 +1,297 @@
+// Package types, tip bazlı doğrulama nesnelerini ve kurallarını yönetir.
+// Bu dosya, validation.ValidateStruct için struct tag'lerinden
+// (validate:"required,email,max=255") Type oluşturan builder'ı içerir.
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/biyonik/conduit-go/pkg/validation"
+)
+
+// @author    Ahmet Altun
+// @email     ahmet.altun60@gmail.com
+// @github    github.com/biyonik
+// @linkedin  linkedin.com/in/biyonik
+
+func init() {
+	validation.RegisterStructTypeBuilder(BuildFromSpec)
+}
+
+// BuildFromSpec, struct alanı tanımından (FieldSpec) Type oluşturur.
+//
+// Parametreler:
+//   - spec: Alanın tipi ve validate tag'indeki kurallar
+//
+// Döndürür:
+//   - validation.Type: Kuralları uygulanmış tip
+//   - error: Bilinmeyen kural veya geçersiz parametre
+func BuildFromSpec(spec validation.FieldSpec) (validation.Type, error) {
+	var (
+		typ   validation.Type
+		base  *BaseType
+		apply func(rule validation.TagRule) (bool, error)
+	)
+
+	switch spec.Kind {
+	case validation.FieldString:
+		s := String()
+		typ, base, apply = s, &s.BaseType, stringRule(s)
+	case validation.FieldInt:
+		i := Int()
+		typ, base, apply = i, &i.BaseType, intRule(i)
+	case validation.FieldFloat:
+		f := Float()
+		typ, base, apply = f, &f.BaseType, floatRule(f)
+	case validation.FieldBool:
+		b := Bool()
+		typ, base, apply = b, &b.BaseType, noRule
+	case validation.FieldArray:
+		a := Array()
+		if spec.Elem != nil {
+			elem, err := BuildFromSpec(*spec.Elem)
+			if err != nil {
+				return nil, err
+			}
+			a.Elements(elem)
+		}
+		typ, base, apply = a, &a.BaseType, arrayRule(a)
+	case validation.FieldObject:
+		shape := make(map[string]validation.Type, len(spec.Fields))
+		for _, field := range spec.Fields {
+			fieldType, err := BuildFromSpec(field)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field.Name, err)
+			}
+			shape[field.Name] = fieldType
+		}
+		o := Object(shape)
+		typ, base, apply = o, &o.BaseType, noRule
+	default:
+		return nil, fmt.Errorf("desteklenmeyen alan tipi: %d", spec.Kind)
+	}
+
+	for _, rule := range spec.Rules {
+		handled, err := commonRule(base, rule)
+		if err != nil {
+			return nil, err
+		}
+		if !handled {
+			if handled, err = apply(rule); err != nil {
+				return nil, err
+			}
+		}
+		if !handled {
+			return nil, fmt.Errorf("bilinmeyen kural: %s", rule.Name)
+		}
+	}
+
+	return typ, nil
+}
+
+// commonRule, tüm tiplerde geçerli kuralları uygular.
+func commonRule(base *BaseType, rule validation.TagRule) (bool, error) {
+	switch rule.Name {
+	case "required":
+		base.SetRequired()
+	case "label":
+		base.SetLabel(rule.Param)
+	case "nullable":
+		base.SetNullable()
+	case "sometimes":
+		base.SetSometimes()
+	case "bail":
+		base.SetBail()
+	case "required_if", "excluded_unless":
+		fields := strings.Fields(rule.Param)
+		if len(fields) < 2 {
+			return false, fmt.Errorf("%s alan ve en az bir değer gerektirir", rule.Name)
+		}
+		values := make([]any, len(fields)-1)
+		for i, value := range fields[1:] {
+			values[i] = value
+		}
+		if rule.Name == "required_if" {
+			base.AddRequiredIf(fields[0], values...)
+		} else {
+			base.AddExcludedUnless(fields[0], values...)
+		}
+	case "required_with":
+		fields := strings.Fields(rule.Param)
+		if len(fields) == 0 {
+			return false, fmt.Errorf("required_with en az bir alan gerektirir")
+		}
+		base.AddRequiredWith(fields...)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// stringRule, string'e özel kuralları uygular.
+func stringRule(s *StringType) func(validation.TagRule) (bool, error) {
+	return func(rule validation.TagRule) (bool, error) {
+		switch rule.Name {
+		case "email":
+			s.Email()
+		case "url":
+			s.URL(strings.Fields(rule.Param)...)
+		case "uuid":
+			if rule.Param == "" {
+				s.UUID()
+				break
+			}
+			version, err := strconv.Atoi(rule.Param)
+			if err != nil {
+				return false, fmt.Errorf("uuid versiyonu sayı olmalı: %s", rule.Param)
+			}
+			s.UUID(version)
+		case "ip":
+			s.IP()
+		case "ipv4":
+			s.IPv4()
+		case "ipv6":
+			s.IPv6()
+		case "json":
+			s.JSON()
+		case "regex":
+			pattern, err := regexp.Compile(rule.Param)
+			if err != nil {
+				return false, fmt.Errorf("regex deseni geçersiz: %w", err)
+			}
+			s.Regex(pattern.String())
+		case "trim":
+			s.Trim()
+		case "oneof", "in":
+			s.In(strings.Fields(rule.Param)...)
+		case "min", "max":
+			length, err := strconv.Atoi(rule.Param)
+			if err != nil {
+				return false, fmt.Errorf("%s uzunluğu sayı olmalı: %s", rule.Name, rule.Param)
+			}
+			if rule.Name == "min" {
+				s.Min(length)
+			} else {
+				s.Max(length)
+			}
+		default:
+			return false, nil
+		}
+		return true, nil
+	}
+}
+
+// intRule, tamsayıya özel kuralları uygular.
+func intRule(i *IntType) func(validation.TagRule) (bool, error) {
+	return func(rule validation.TagRule) (bool, error) {
+		switch rule.Name {
+		case "positive":
+			i.Positive()
+		case "oneof", "in":
+			values := strings.Fields(rule.Param)
+			allowed := make([]int64, len(values))
+			for idx, value := range values {
+				num, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return false, fmt.Errorf("%s değeri tamsayı olmalı: %s", rule.Name, value)
+				}
+				allowed[idx] = num
+			}
+			i.In(allowed...)
+		case "min", "max", "between":
+			bounds, err := parseBounds[int64](rule, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return false, err
+			}
+			switch rule.Name {
+			case "min":
+				i.Min(bounds[0])
+			case "max":
+				i.Max(bounds[0])
+			default:
+				i.Between(bounds[0], bounds[1])
+			}
+		default:
+			return false, nil
+		}
+		return true, nil
+	}
+}
+
+// floatRule, ondalıklı sayıya özel kuralları uygular.
+func floatRule(f *FloatType) func(validation.TagRule) (bool, error) {
+	return func(rule validation.TagRule) (bool, error) {
+		switch rule.Name {
+		case "positive":
+			f.Positive()
+		case "min", "max", "between":
+			bounds, err := parseBounds[float64](rule, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return false, err
+			}
+			switch rule.Name {
+			case "min":
+				f.Min(bounds[0])
+			case "max":
+				f.Max(bounds[0])
+			default:
+				f.Between(bounds[0], bounds[1])
+			}
+		default:
+			return false, nil
+		}
+		return true, nil
+	}
+}
+
+// arrayRule, diziye özel kuralları (eleman sayısı) uygular.
+func arrayRule(a *ArrayType) func(validation.TagRule) (bool, error) {
+	return func(rule validation.TagRule) (bool, error) {
+		switch rule.Name {
+		case "min", "max":
+			length, err := strconv.Atoi(rule.Param)
+			if err != nil {
+				return false, fmt.Errorf("%s eleman sayısı sayı olmalı: %s", rule.Name, rule.Param)
+			}
+			if rule.Name == "min" {
+				a.Min(length)
+			} else {
+				a.Max(length)
+			}
+		default:
+			return false, nil
+		}
+		return true, nil
+	}
+}
+
+// noRule, tipe özel kuralı olmayan tipler içindir.
+func noRule(validation.TagRule) (bool, error) {
+	return false, nil
+}
+
+// parseBounds, "min=1", "max=10" veya "between=1 10" parametrelerini
+// ayrıştırır.
+func parseBounds[T int64 | float64](rule validation.TagRule, parse func(string) (T, error)) ([]T, error) {
+	fields := strings.Fields(rule.Param)
+	expected := 1
+	if rule.Name == "between" {
+		expected = 2
+	}
+	if len(fields) != expected {
+		return nil, fmt.Errorf("%s %d parametre gerektirir: %q", rule.Name, expected, rule.Param)
+	}
+
+	bounds := make([]T, expected)
+	for i, field := range fields {
+		value, err := parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("%s parametresi geçersiz: %s", rule.Name, field)
+		}
+		bounds[i] = value
+	}
+	return bounds, nil
+}
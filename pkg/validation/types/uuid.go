@@ -63,7 +63,7 @@ func (u *UuidType) Version(v int) *UuidType {
 //  5. Hata varsa ValidationResult nesnesine eklenir.
 func (u *UuidType) Validate(field string, value any, result *validation.ValidationResult) {
 	u.BaseType.Validate(field, value, result)
-	if result.HasErrors() {
+	if hasFieldErrors(result, field) {
 		return
 	}
 	if value == nil {
@@ -72,7 +72,7 @@ func (u *UuidType) Validate(field string, value any, result *validation.Validati
 
 	str, ok := value.(string)
 	if !ok {
-		result.AddError(field, fmt.Sprintf("%s alanı metin tipinde olmalıdır", u.label))
+		u.addError(result, field, "type", fmt.Sprintf("%s alanı metin tipinde olmalıdır", u.label))
 		return
 	}
 
@@ -87,6 +87,6 @@ func (u *UuidType) Validate(field string, value any, result *validation.Validati
 	}
 
 	if !rules.IsValidUUID(str, u.version) {
-		result.AddError(field, fmt.Sprintf("%s alanı geçerli bir UUID%s olmalıdır", fieldName, versionText))
+		u.addError(result, field, "uuid", fmt.Sprintf("%s alanı geçerli bir UUID%s olmalıdır", fieldName, versionText))
 	}
 }
@@ -109,6 +109,10 @@ type Schema interface {
 	//   - *ValidationResult: Doğrulama sonucu
 	Validate(data map[string]any) *ValidationResult
 
+	// ValidatePartial, sadece veri haritasında bulunan alanları doğrular
+	// (PATCH / kısmi güncelleme için).
+	ValidatePartial(data map[string]any) *ValidationResult
+
 	// Shape, şemada alan tiplerini tanımlar.
 	// Parametre:
 	//   - shape: Alan adı -> Type eşlemesi
@@ -126,4 +130,8 @@ type Schema interface {
 	// Bir alanın değeri beklenen değerle eşleşirse,
 	// callback'den dönen alt şemayı (sub-schema) da doğrulamaya dahil eder.
 	When(field string, expectedValue any, callback func() Schema) Schema
+
+	// JSONSchema, şemayı JSON Schema (draft 2020-12) dokümanı olarak döndürür.
+	// OpenAPI ve frontend form builder'ları için sunucu kurallarını dışa aktarır.
+	JSONSchema() map[string]any
 }
@@ -0,0 +1,65 @@
+// -----------------------------------------------------------------------------
+// Version & Build Info
+// -----------------------------------------------------------------------------
+// Bu paket, framework/uygulama sürümünü ve build bilgilerini tek bir yerde
+// tutar. Değerler build sırasında ldflags ile enjekte edilir; böylece handler'lara
+// "1.0.0-phase3" gibi sabit string'ler yazmak gerekmez.
+//
+// Build:
+//
+//	go build -ldflags "\
+//	  -X github.com/biyonik/conduit-go/pkg/version.Version=1.4.0 \
+//	  -X github.com/biyonik/conduit-go/pkg/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X github.com/biyonik/conduit-go/pkg/version.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)" \
+//	  ./cmd/api
+//
+// Makefile'daki build hedefi bu flag'leri otomatik ekler.
+// -----------------------------------------------------------------------------
+
+package version
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Build sırasında ldflags (-X) ile doldurulan değerler.
+// String olmaları zorunludur; -X sadece string değişkenleri set edebilir.
+var (
+	Version   = "dev"     // Semantik sürüm (örn: 1.4.0)
+	Commit    = "unknown" // Git commit hash'i (kısa)
+	BuildDate = "unknown" // Build zamanı (RFC3339, UTC)
+)
+
+// Info, sürüm ve build bilgilerini taşır.
+//
+// JSON tag'leri sayesinde /health gibi endpoint'lerde doğrudan kullanılabilir.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get, aktif build bilgilerini döndürür.
+//
+// Örnek:
+//
+//	info := version.Get()
+//	log.Printf("Conduit %s (%s)", info.Version, info.Commit)
+func Get() Info {
+	return Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+	}
+}
+
+// String, insan tarafından okunabilir tek satırlık sürüm bilgisini döndürür.
+//
+// Örnek çıktı: "1.4.0 (commit: a1b2c3d, built: 2024-05-01T10:00:00Z, go1.22.2)"
+func String() string {
+	info := Get()
+	return fmt.Sprintf("%s (commit: %s, built: %s, %s)", info.Version, info.Commit, info.BuildDate, info.GoVersion)
+}
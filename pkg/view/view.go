@@ -0,0 +1,391 @@
+// -----------------------------------------------------------------------------
+// View Engine
+// -----------------------------------------------------------------------------
+// Bu paket, sunucu tarafında render edilen HTML sayfaları için html/template
+// üzerine layout, partial, şablon cache'i ve paylaşılan veri ekler.
+//
+// Dizin yapısı (fs.FS köküne göre):
+//
+//	layouts/app.html      # Ortak layout, {{template "content" .}} çağırır
+//	partials/nav.html     # Partial'lar, dosya adıyla çağrılır: {{template "nav" .}}
+//	users/index.html      # View, "content" olarak layout'a yerleşir
+//
+// Layout {{block "title" .}}Varsayılan{{end}} gibi bloklar tanımlayabilir;
+// view aynı isimle {{define "title"}}...{{end}} yazarak bunları ezer.
+//
+// View'a verilen data map[string]any ise Share ile eklenen değerler ve
+// Composer'ların istek başına ürettiği değerler (auth_user, csrf_token...)
+// ile birleştirilir; handler'ın verdiği anahtarlar önceliklidir.
+//
+// Kullanım:
+//
+//	engine := view.New(os.DirFS("resources/views")).
+//	    Share("app_name", "Conduit").
+//	    BaseURL("https://example.com")
+//	view.SetEngine(engine)
+//
+//	response.View(w, r.Request, "users/index", map[string]any{"users": users})
+// -----------------------------------------------------------------------------
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// ErrNotConfigured, SetEngine çağrılmadan view render edilmek istendiğinde döner.
+var ErrNotConfigured = errors.New("view engine ayarlanmadı (view.SetEngine)")
+
+// Composer, her render'da isteğe özel paylaşılan veri üretir (örn: giriş
+// yapmış kullanıcı, CSRF token'ı). r nil ise composer'lar çağrılmaz.
+type Composer func(r *http.Request) map[string]any
+
+// Engine, view şablonlarını bir fs.FS'ten yükler, derler ve cache'ler.
+type Engine struct {
+	fsys     fs.FS
+	layout   string
+	partials string
+	reload   bool
+	baseURL  string
+	funcs    template.FuncMap
+
+	mu        sync.RWMutex
+	shared    map[string]any
+	composers []Composer
+	cache     map[string]*compiledView
+}
+
+// compiledView, bir view'ın layout ve partial'larla derlenmiş şablonudur.
+type compiledView struct {
+	tmpl  *template.Template
+	entry string // Çalıştırılacak şablon: layout veya "content"
+}
+
+// New, verilen dosya sisteminden view yükleyen yeni bir Engine oluşturur.
+// Varsayılan layout "layouts/app", partial dizini "partials"tır.
+//
+// Parametreler:
+//   - fsys: View'ların kök dizini (os.DirFS veya embed.FS)
+//
+// Döndürür:
+//   - *Engine: Yeni Engine örneği
+//
+// Örnek:
+//
+//	engine := view.New(os.DirFS("resources/views")).
+//	    Share("app_name", "Conduit").
+//	    Reload(true) // Development: dosya değişiklikleri anında görünür
+func New(fsys fs.FS) *Engine {
+	return &Engine{
+		fsys:     fsys,
+		layout:   "layouts/app",
+		partials: "partials",
+		funcs:    template.FuncMap{},
+		shared:   map[string]any{},
+		cache:    map[string]*compiledView{},
+	}
+}
+
+// Layout, varsayılan layout'u değiştirir ("" layout'u kapatır).
+func (e *Engine) Layout(name string) *Engine {
+	e.layout = name
+	e.flush()
+	return e
+}
+
+// Partials, partial dizinini değiştirir ("" partial yüklemeyi kapatır).
+func (e *Engine) Partials(dir string) *Engine {
+	e.partials = dir
+	e.flush()
+	return e
+}
+
+// Funcs, şablonlarda kullanılabilecek ek fonksiyonlar ekler.
+func (e *Engine) Funcs(funcs template.FuncMap) *Engine {
+	for name, fn := range funcs {
+		e.funcs[name] = fn
+	}
+	e.flush()
+	return e
+}
+
+// BaseURL, url fonksiyonunun mutlak link üretirken kullandığı adresi
+// ayarlar (genellikle APP_URL). Boşsa url göreli path döndürür.
+func (e *Engine) BaseURL(baseURL string) *Engine {
+	e.baseURL = strings.TrimRight(baseURL, "/")
+	return e
+}
+
+// Share, tüm view'larda kullanılabilecek bir değer ekler. Map data ile
+// render edilen view'larda {{.key}}, her view'da {{shared "key"}} ile
+// erişilir.
+func (e *Engine) Share(key string, value any) *Engine {
+	e.mu.Lock()
+	e.shared[key] = value
+	e.mu.Unlock()
+	return e
+}
+
+// Composer, her render'da isteğe özel paylaşılan veri üreten bir
+// fonksiyon ekler. Composer'lar eklenme sırasıyla çalışır.
+//
+// Örnek:
+//
+//	engine.Composer(func(r *http.Request) map[string]any {
+//	    return map[string]any{"locale": r.Header.Get("Accept-Language")}
+//	})
+func (e *Engine) Composer(fn Composer) *Engine {
+	e.mu.Lock()
+	e.composers = append(e.composers, fn)
+	e.mu.Unlock()
+	return e
+}
+
+// Reload, true ise view'lar her render'da yeniden okunur (cache kapalı).
+func (e *Engine) Reload(enabled bool) *Engine {
+	e.reload = enabled
+	return e
+}
+
+// Exists, view dosyasının var olup olmadığını döndürür.
+func (e *Engine) Exists(name string) bool {
+	_, err := fs.Stat(e.fsys, name+".html")
+	return err == nil
+}
+
+// Render, view'ı data ile w'ye render eder. Render hatasında w'ye kısmi
+// çıktı yazılmış olabilir; HTTP yanıtları için response.View kullanılır
+// (önce buffer'a render eder).
+//
+// Parametreler:
+//   - w: Çıktının yazılacağı writer
+//   - r: İstek (composer'lar için; nil olabilir)
+//   - name: Uzantısız view adı (örn: "users/index")
+//   - data: Şablona geçirilecek veri (map ise paylaşılan veriyle birleştirilir)
+//
+// Döndürür:
+//   - error: View bulunamazsa veya render hatası olursa
+func (e *Engine) Render(w io.Writer, r *http.Request, name string, data any) error {
+	compiled, err := e.lookup(name)
+	if err != nil {
+		return err
+	}
+
+	if err := compiled.tmpl.ExecuteTemplate(w, compiled.entry, e.viewData(r, data)); err != nil {
+		return fmt.Errorf("%s render edilemedi: %w", name, err)
+	}
+	return nil
+}
+
+// viewData, map data'yı paylaşılan ve composer verisiyle birleştirir.
+// Map olmayan data olduğu gibi döndürülür.
+func (e *Engine) viewData(r *http.Request, data any) any {
+	values, ok := data.(map[string]any)
+	if data != nil && !ok {
+		return data
+	}
+
+	e.mu.RLock()
+	merged := make(map[string]any, len(e.shared)+len(values))
+	for key, value := range e.shared {
+		merged[key] = value
+	}
+	composers := e.composers
+	e.mu.RUnlock()
+
+	if r != nil {
+		for _, compose := range composers {
+			for key, value := range compose(r) {
+				merged[key] = value
+			}
+		}
+	}
+	for key, value := range values {
+		merged[key] = value
+	}
+	return merged
+}
+
+// lookup, view'ı cache'ten döndürür; yoksa derleyip cache'e ekler.
+func (e *Engine) lookup(name string) (*compiledView, error) {
+	if !e.reload {
+		e.mu.RLock()
+		compiled, ok := e.cache[name]
+		e.mu.RUnlock()
+		if ok {
+			return compiled, nil
+		}
+	}
+
+	compiled, err := e.compile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !e.reload {
+		e.mu.Lock()
+		e.cache[name] = compiled
+		e.mu.Unlock()
+	}
+	return compiled, nil
+}
+
+// compile, layout'u, partial'ları ve view'ı tek bir şablon setinde derler.
+// View en son parse edilir; böylece layout'taki block'ları ezebilir.
+func (e *Engine) compile(name string) (*compiledView, error) {
+	root := template.New(name).Funcs(e.templateFuncs())
+	compiled := &compiledView{tmpl: root, entry: "content"}
+
+	if e.layout != "" {
+		err := e.parse(root, "layout", e.layout+".html")
+		switch {
+		case err == nil:
+			compiled.entry = "layout"
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, err
+		}
+	}
+
+	if e.partials != "" {
+		files, err := fs.Glob(e.fsys, path.Join(e.partials, "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			partial := strings.TrimSuffix(path.Base(file), ".html")
+			if err := e.parse(root, partial, file); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := e.parse(root, "content", name+".html"); err != nil {
+		return nil, err
+	}
+
+	return compiled, nil
+}
+
+// parse, dosyayı root altında verilen isimle parse eder.
+func (e *Engine) parse(root *template.Template, name, file string) error {
+	source, err := fs.ReadFile(e.fsys, file)
+	if err != nil {
+		return fmt.Errorf("view okunamadı: %w", err)
+	}
+	if _, err := root.New(name).Parse(string(source)); err != nil {
+		return fmt.Errorf("%s parse edilemedi: %w", file, err)
+	}
+	return nil
+}
+
+// templateFuncs, yerleşik ve kullanıcı fonksiyonlarını döndürür.
+//
+//   - shared "key": Share ile eklenen değer
+//   - url "/path" "key" value...: BaseURL'e göre link (query parametreleriyle)
+//   - dict "k1" v1 "k2" v2: Partial'lara birden fazla değer geçirmek için map
+func (e *Engine) templateFuncs() template.FuncMap {
+	funcs := template.FuncMap{
+		"shared": func(key string) any {
+			e.mu.RLock()
+			defer e.mu.RUnlock()
+			return e.shared[key]
+		},
+		"url": e.URL,
+		"dict": func(pairs ...any) (map[string]any, error) {
+			if len(pairs)%2 != 0 {
+				return nil, errors.New("dict çift sayıda argüman bekler")
+			}
+			values := make(map[string]any, len(pairs)/2)
+			for i := 0; i < len(pairs); i += 2 {
+				key, ok := pairs[i].(string)
+				if !ok {
+					return nil, fmt.Errorf("dict anahtarı string olmalı: %v", pairs[i])
+				}
+				values[key] = pairs[i+1]
+			}
+			return values, nil
+		},
+	}
+	for name, fn := range e.funcs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// URL, path'i BaseURL'e göre mutlak bir linke çevirir; çift sayıda
+// verilen pairs query parametresi olarak eklenir. Şablonlarda url
+// fonksiyonu olarak kullanılır.
+//
+// Örnek:
+//
+//	engine.URL("/users", "page", 2) // https://example.com/users?page=2
+//	{{url "/users" "page" 2}}
+func (e *Engine) URL(p string, pairs ...any) (string, error) {
+	if len(pairs)%2 != 0 {
+		return "", errors.New("url query parametreleri çift sayıda olmalı")
+	}
+
+	link := p
+	if !strings.Contains(p, "://") {
+		link = e.baseURL + "/" + strings.TrimLeft(p, "/")
+	}
+
+	if len(pairs) > 0 {
+		query := url.Values{}
+		for i := 0; i < len(pairs); i += 2 {
+			key, ok := pairs[i].(string)
+			if !ok {
+				return "", fmt.Errorf("url query anahtarı string olmalı: %v", pairs[i])
+			}
+			query.Add(key, fmt.Sprint(pairs[i+1]))
+		}
+		separator := "?"
+		if strings.Contains(link, "?") {
+			separator = "&"
+		}
+		link += separator + query.Encode()
+	}
+	return link, nil
+}
+
+// flush, derlenmiş view cache'ini temizler.
+func (e *Engine) flush() {
+	e.mu.Lock()
+	e.cache = map[string]*compiledView{}
+	e.mu.Unlock()
+}
+
+// -----------------------------------------------------------------------------
+// Global Engine
+// -----------------------------------------------------------------------------
+
+var (
+	engineMu sync.RWMutex
+	engine   *Engine
+)
+
+// SetEngine, response.View'in kullanacağı engine'i ayarlar.
+// Uygulama başlangıcında bir kez çağrılır.
+func SetEngine(e *Engine) {
+	engineMu.Lock()
+	defer engineMu.Unlock()
+
+	engine = e
+}
+
+// GetEngine, aktif engine'i döndürür (ayarlanmadıysa nil).
+func GetEngine() *Engine {
+	engineMu.RLock()
+	defer engineMu.RUnlock()
+
+	return engine
+}
@@ -0,0 +1,491 @@
+package ws
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// acceptGUID, Sec-WebSocket-Accept hesaplamasında kullanılan sabittir
+// (RFC 6455, 1.3).
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// DefaultReadLimit, client'tan kabul edilen varsayılan maksimum mesaj
+// boyutudur. Aşan mesajlarda bağlantı 1009 ile kapatılır.
+const DefaultReadLimit = 64 << 10
+
+// MessageType, WebSocket veri mesajının tipidir.
+type MessageType int
+
+// Mesaj ve frame tipleri (opcode).
+const (
+	TextMessage   MessageType = 1
+	BinaryMessage MessageType = 2
+
+	opContinuation = 0
+	opClose        = 8
+	opPing         = 9
+	opPong         = 10
+)
+
+// Close kodları (RFC 6455, 7.4.1).
+const (
+	CloseNormal          = 1000
+	CloseGoingAway       = 1001
+	CloseProtocolError   = 1002
+	CloseUnsupportedData = 1003
+	CloseNoStatus        = 1005
+	CloseInvalidPayload  = 1007
+	ClosePolicyViolation = 1008
+	CloseMessageTooBig   = 1009
+	CloseInternalError   = 1011
+)
+
+// ErrClosed, kapatılmış bağlantıya yazılmak istendiğinde döner.
+var ErrClosed = errors.New("websocket bağlantısı kapalı")
+
+// CloseError, karşı tarafın gönderdiği veya protokol hatası nedeniyle
+// gönderilen close frame'idir.
+type CloseError struct {
+	Code   int
+	Reason string
+}
+
+// Error, error interface'ini implement eder.
+func (e *CloseError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("websocket kapandı: %d", e.Code)
+	}
+	return fmt.Sprintf("websocket kapandı: %d %s", e.Code, e.Reason)
+}
+
+// UpgradeOptions, Upgrade'in handshake ayarlarıdır.
+type UpgradeOptions struct {
+	// CheckOrigin, Origin header'ını kontrol eder. nil ise Origin yoksa
+	// veya host'u isteğin Host'u ile aynıysa kabul edilir (cross-site
+	// WebSocket hijacking'e karşı).
+	CheckOrigin func(r *http.Request) bool
+}
+
+// Conn, RFC 6455 WebSocket bağlantısıdır (sunucu tarafı).
+//
+// ReadMessage tek bir goroutine'den çağrılmalıdır; yazma metodları
+// birden fazla goroutine'den güvenle çağrılabilir.
+type Conn struct {
+	conn net.Conn
+	br   *bufio.Reader
+
+	readLimit   int64
+	readTimeout time.Duration
+	onPong      func()
+
+	writeMu      sync.Mutex
+	writeTimeout time.Duration
+	closeSent    bool
+}
+
+// Upgrade, HTTP isteğini WebSocket bağlantısına yükseltir. Handshake
+// geçersizse uygun HTTP hatası yazılır ve hata döndürülür.
+//
+// Bağlantı hijack edildiği için sunucunun Read/WriteTimeout'ları artık
+// uygulanmaz; zaman aşımları SetReadTimeout ve SetWriteTimeout ile
+// ayarlanır.
+//
+// Parametreler:
+//   - w: Yanıt yazıcısı (http.Hijacker desteklemeli)
+//   - r: Upgrade isteği
+//   - opts: Handshake ayarları (nil: varsayılanlar)
+//
+// Döndürür:
+//   - *Conn: WebSocket bağlantısı
+//   - error: Handshake veya hijack hatası
+//
+// Örnek:
+//
+//	conn, err := ws.Upgrade(w, r, nil)
+//	if err != nil {
+//	    return // Hata yanıtı yazıldı
+//	}
+//	defer conn.Close(ws.CloseNormal, "")
+func Upgrade(w http.ResponseWriter, r *http.Request, opts *UpgradeOptions) (*Conn, error) {
+	if opts == nil {
+		opts = &UpgradeOptions{}
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "WebSocket handshake GET ile yapılmalıdır", http.StatusMethodNotAllowed)
+		return nil, errors.New("websocket: method GET değil")
+	}
+	if !headerContainsToken(r.Header, "Connection", "upgrade") || !headerContainsToken(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "WebSocket upgrade isteği bekleniyor", http.StatusBadRequest)
+		return nil, errors.New("websocket: upgrade header'ları eksik")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "Desteklenmeyen WebSocket versiyonu", http.StatusUpgradeRequired)
+		return nil, errors.New("websocket: desteklenmeyen versiyon")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		http.Error(w, "Geçersiz Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, errors.New("websocket: geçersiz Sec-WebSocket-Key")
+	}
+
+	checkOrigin := opts.CheckOrigin
+	if checkOrigin == nil {
+		checkOrigin = SameOrigin
+	}
+	if !checkOrigin(r) {
+		http.Error(w, "Origin kabul edilmedi", http.StatusForbidden)
+		return nil, fmt.Errorf("websocket: origin kabul edilmedi: %s", r.Header.Get("Origin"))
+	}
+
+	netConn, brw, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		http.Error(w, "WebSocket desteklenmiyor", http.StatusInternalServerError)
+		return nil, fmt.Errorf("websocket: hijack başarısız: %w", err)
+	}
+
+	// Sunucunun istek için koyduğu deadline'ları kaldır
+	if err := netConn.SetDeadline(time.Time{}); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	handshake := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+	if _, err := netConn.Write([]byte(handshake)); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	return &Conn{
+		conn:      netConn,
+		br:        brw.Reader,
+		readLimit: DefaultReadLimit,
+	}, nil
+}
+
+// SameOrigin, Origin header'ı yoksa (tarayıcı dışı client) veya host'u
+// isteğin Host'u ile aynıysa true döner. UpgradeOptions.CheckOrigin'in
+// varsayılanıdır.
+func SameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// AllowOrigins, Origin'i verilen listeyle karşılaştıran bir CheckOrigin
+// döndürür ("*": hepsi). Aynı origin'den gelen istekler her zaman kabul
+// edilir.
+//
+// Örnek:
+//
+//	opts := &ws.UpgradeOptions{
+//	    CheckOrigin: ws.AllowOrigins([]string{"https://app.example.com"}),
+//	}
+func AllowOrigins(origins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		if SameOrigin(r) {
+			return true
+		}
+		origin := r.Header.Get("Origin")
+		for _, allowed := range origins {
+			if allowed == "*" || strings.EqualFold(allowed, origin) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// acceptKey, Sec-WebSocket-Accept değerini hesaplar.
+func acceptKey(key string) string {
+	sum := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// headerContainsToken, virgülle ayrılmış header değerlerinde token'ı
+// (büyük/küçük harf duyarsız) arar.
+func headerContainsToken(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetReadLimit, kabul edilen maksimum mesaj boyutunu ayarlar.
+func (c *Conn) SetReadLimit(limit int64) {
+	c.readLimit = limit
+}
+
+// SetReadTimeout, her frame için beklenecek maksimum süreyi ayarlar
+// (0: süresiz). Ping gönderen sunucularda ping aralığından uzun olmalıdır;
+// pong'lar da frame sayılır.
+func (c *Conn) SetReadTimeout(timeout time.Duration) {
+	c.readTimeout = timeout
+}
+
+// SetWriteTimeout, her yazım için maksimum süreyi ayarlar (0: süresiz).
+func (c *Conn) SetWriteTimeout(timeout time.Duration) {
+	c.writeTimeout = timeout
+}
+
+// SetPongHandler, client'tan pong geldiğinde çağrılacak fonksiyonu ayarlar.
+func (c *Conn) SetPongHandler(fn func()) {
+	c.onPong = fn
+}
+
+// RemoteAddr, client'ın adresini döndürür.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// ReadMessage, sıradaki veri mesajını okur. Parçalı (fragmented) mesajlar
+// birleştirilir; ping'lere otomatik pong gönderilir.
+//
+// Döndürür:
+//   - MessageType: TextMessage veya BinaryMessage
+//   - []byte: Mesaj içeriği
+//   - error: Client close gönderdiyse *CloseError; protokol ihlallerinde
+//     bağlantı uygun kodla kapatılır ve *CloseError döner
+func (c *Conn) ReadMessage() (MessageType, []byte, error) {
+	var (
+		messageType MessageType
+		message     []byte
+	)
+
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		switch opcode {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case opPong:
+			if c.onPong != nil {
+				c.onPong()
+			}
+			continue
+		case opClose:
+			return 0, nil, c.handleClose(payload)
+		case opContinuation:
+			if messageType == 0 {
+				return 0, nil, c.fail(CloseProtocolError, "beklenmeyen continuation frame")
+			}
+		case int(TextMessage), int(BinaryMessage):
+			if messageType != 0 {
+				return 0, nil, c.fail(CloseProtocolError, "parçalı mesaj tamamlanmadı")
+			}
+			messageType = MessageType(opcode)
+		default:
+			return 0, nil, c.fail(CloseProtocolError, "bilinmeyen opcode")
+		}
+
+		if int64(len(message)+len(payload)) > c.readLimit {
+			return 0, nil, c.fail(CloseMessageTooBig, "mesaj çok büyük")
+		}
+		message = append(message, payload...)
+
+		if fin {
+			if messageType == TextMessage && !utf8.Valid(message) {
+				return 0, nil, c.fail(CloseInvalidPayload, "geçersiz UTF-8")
+			}
+			return messageType, message, nil
+		}
+	}
+}
+
+// readFrame, tek bir frame okur ve maskesini çözer.
+func (c *Conn) readFrame() (fin bool, opcode int, payload []byte, err error) {
+	if c.readTimeout > 0 {
+		if err := c.conn.SetReadDeadline(time.Now().Add(c.readTimeout)); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	var header [2]byte
+	if _, err := io.ReadFull(c.br, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	fin = header[0]&0x80 != 0
+	opcode = int(header[0] & 0x0f)
+	masked := header[1]&0x80 != 0
+	length := int64(header[1] & 0x7f)
+
+	if header[0]&0x70 != 0 {
+		return false, 0, nil, c.fail(CloseProtocolError, "RSV bitleri desteklenmiyor")
+	}
+	if !masked {
+		return false, 0, nil, c.fail(CloseProtocolError, "client frame'leri maskelenmelidir")
+	}
+	if opcode >= opClose && (!fin || length > 125) {
+		return false, 0, nil, c.fail(CloseProtocolError, "geçersiz control frame")
+	}
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = int64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = int64(binary.BigEndian.Uint64(ext[:]))
+	}
+	if length < 0 || length > c.readLimit {
+		return false, 0, nil, c.fail(CloseMessageTooBig, "mesaj çok büyük")
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return fin, opcode, payload, nil
+}
+
+// handleClose, client'ın close frame'ini yanıtlar ve bağlantıyı kapatır.
+func (c *Conn) handleClose(payload []byte) error {
+	closeErr := &CloseError{Code: CloseNoStatus}
+	if len(payload) >= 2 {
+		closeErr.Code = int(binary.BigEndian.Uint16(payload))
+		closeErr.Reason = string(payload[2:])
+	}
+
+	code := closeErr.Code
+	if code == CloseNoStatus {
+		code = CloseNormal
+	}
+	c.Close(code, "")
+	return closeErr
+}
+
+// fail, protokol hatasında bağlantıyı kapatır ve hatayı döndürür.
+func (c *Conn) fail(code int, reason string) error {
+	c.Close(code, reason)
+	return &CloseError{Code: code, Reason: reason}
+}
+
+// WriteMessage, tek frame'lik bir veri mesajı gönderir.
+func (c *Conn) WriteMessage(messageType MessageType, data []byte) error {
+	return c.writeFrame(int(messageType), data)
+}
+
+// WriteText, metin mesajı gönderir.
+func (c *Conn) WriteText(data []byte) error {
+	return c.writeFrame(int(TextMessage), data)
+}
+
+// Ping, client'a ping gönderir; client pong ile yanıtlar.
+func (c *Conn) Ping() error {
+	return c.writeFrame(opPing, nil)
+}
+
+// writeFrame, maskesiz (sunucu) frame yazar.
+func (c *Conn) writeFrame(opcode int, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.closeSent {
+		return ErrClosed
+	}
+	return c.writeFrameLocked(opcode, payload)
+}
+
+// writeFrameLocked, writeMu tutulurken frame yazar.
+func (c *Conn) writeFrameLocked(opcode int, payload []byte) error {
+	header := make([]byte, 2, 10)
+	header[0] = 0x80 | byte(opcode) // FIN
+	switch length := len(payload); {
+	case length <= 125:
+		header[1] = byte(length)
+	case length <= 0xffff:
+		header[1] = 126
+		header = binary.BigEndian.AppendUint16(header, uint16(length))
+	default:
+		header[1] = 127
+		header = binary.BigEndian.AppendUint64(header, uint64(length))
+	}
+
+	if c.writeTimeout > 0 {
+		if err := c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout)); err != nil {
+			return err
+		}
+	}
+
+	buffers := net.Buffers{header, payload}
+	_, err := buffers.WriteTo(c.conn)
+	return err
+}
+
+// Close, client'a close frame'i gönderir ve bağlantıyı kapatır. Birden
+// fazla çağrılabilir.
+//
+// Parametreler:
+//   - code: Close kodu (örn: CloseNormal, CloseGoingAway)
+//   - reason: Client'a iletilecek açıklama (en fazla 123 byte)
+func (c *Conn) Close(code int, reason string) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.closeSent {
+		return nil
+	}
+	c.closeSent = true
+
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	payload := binary.BigEndian.AppendUint16(nil, uint16(code))
+	payload = append(payload, reason...)
+
+	// Close frame'i en fazla 1 saniye beklenir; yavaş client Close'u bloklamaz
+	c.conn.SetWriteDeadline(time.Now().Add(time.Second))
+	writeErr := c.writeFrameLocked(opClose, payload)
+	closeErr := c.conn.Close()
+	if writeErr != nil {
+		return writeErr
+	}
+	return closeErr
+}
@@ -0,0 +1,483 @@
+// -----------------------------------------------------------------------------
+// WebSocket
+// -----------------------------------------------------------------------------
+// Bu package, broadcast.Hub kanallarını WebSocket üzerinden sunar. SSE
+// endpoint'inden farklı olarak client bağlantı açıkken kanallara abone
+// olup ayrılabilir ve token'ını bağlantı içinde gönderebilir (tarayıcılar
+// WebSocket isteğine Authorization header'ı ekleyemez).
+//
+// Oda (room) yönetimi, private kanal yetkilendirmesi ve Redis pub/sub ile
+// instance'lar arası dağıtım Hub'dadır; ws sadece transport'tur. Redis
+// driver'ında RedisBroadcaster.Listen mesajları her instance'ın Hub'ına
+// aktardığı için, hangi instance yayınlarsa yayınlasın tüm WebSocket
+// client'ları mesajı alır.
+//
+// Protokol (JSON metin mesajları):
+//
+//	→ {"action": "auth", "token": "eyJhbGc..."}
+//	← {"event": "ws.authenticated"}
+//	→ {"action": "subscribe", "channel": "private-users.42"}
+//	← {"event": "ws.subscribed", "channel": "private-users.42"}
+//	← {"event": "order.shipped", "channel": "private-users.42", "data": {...}}
+//	→ {"action": "unsubscribe", "channel": "private-users.42"}
+//	← {"event": "ws.unsubscribed", "channel": "private-users.42"}
+//	→ {"action": "ping"}
+//	← {"event": "ws.pong"}
+//	← {"event": "ws.error", "channel": "...", "data": {"message": "..."}}
+//
+// Kullanım:
+//
+//	server := ws.NewServer(hub, logger)
+//	server.Authenticate = func(token string) (auth.User, error) {
+//	    return middleware.AuthenticateToken(token, nil)
+//	}
+//	r.GET("/broadcasting/ws", server.ServeHTTP).Middleware(middleware.OptionalAuth())
+//	srv.RegisterOnShutdown(server.Close)
+//
+//	const socket = new WebSocket("wss://api.example.com/broadcasting/ws?channels=orders")
+//	socket.onopen = () => socket.send(JSON.stringify({ action: "auth", token }))
+// -----------------------------------------------------------------------------
+
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/broadcast"
+)
+
+// Varsayılan bağlantı ayarları.
+const (
+	DefaultMaxChannels    = 20
+	DefaultMaxMessageSize = 4 << 10 // Client komutları küçük JSON'lardır
+	DefaultPingInterval   = 25 * time.Second
+	DefaultWriteTimeout   = 10 * time.Second
+)
+
+// Server'ın client'a gönderdiği kontrol event'leri.
+const (
+	EventAuthenticated = "ws.authenticated"
+	EventSubscribed    = "ws.subscribed"
+	EventUnsubscribed  = "ws.unsubscribed"
+	EventPong          = "ws.pong"
+	EventError         = "ws.error"
+)
+
+// Server, WebSocket bağlantılarını broadcast.Hub kanallarına bağlayan
+// http.Handler'dır. Alanlar ilk bağlantıdan önce ayarlanmalıdır.
+type Server struct {
+	Hub    *broadcast.Hub
+	Logger *log.Logger
+
+	// Authenticate, client'ın {"action": "auth"} ile gönderdiği token'ı
+	// doğrular. nil ise auth mesajları reddedilir; kullanıcı sadece
+	// istek context'inden (OptionalAuth) alınır.
+	Authenticate func(token string) (auth.User, error)
+
+	// CheckOrigin, Origin kontrolüdür (nil: SameOrigin).
+	CheckOrigin func(r *http.Request) bool
+
+	MaxChannels  int           // Bağlantı başına abone olunabilecek kanal sayısı
+	ReadLimit    int64         // Client mesajlarının maksimum boyutu
+	PingInterval time.Duration // Ping aralığı; pong gelmezse bağlantı kapanır (0: ping yok)
+	WriteTimeout time.Duration // Tek bir yazımın maksimum süresi
+
+	mu      sync.Mutex
+	clients map[*client]struct{}
+	closed  bool
+}
+
+// NewServer, varsayılan ayarlarla yeni bir Server oluşturur.
+//
+// Parametreler:
+//   - hub: Kanal aboneliklerinin yapıldığı Hub
+//   - logger: Bağlantı hatalarının loglanacağı logger
+//
+// Döndürür:
+//   - *Server: http.Handler olarak kullanılabilir Server
+func NewServer(hub *broadcast.Hub, logger *log.Logger) *Server {
+	return &Server{
+		Hub:          hub,
+		Logger:       logger,
+		MaxChannels:  DefaultMaxChannels,
+		ReadLimit:    DefaultMaxMessageSize,
+		PingInterval: DefaultPingInterval,
+		WriteTimeout: DefaultWriteTimeout,
+		clients:      make(map[*client]struct{}),
+	}
+}
+
+// ServeHTTP, isteği WebSocket'e yükseltir ve bağlantı kapanana kadar
+// client mesajlarını işler.
+//
+// ?channels=a,b parametresindeki kanallara handshake'ten önce abone
+// olunur; yetkisiz bir kanal varsa upgrade yapılmaz ve SSE endpoint'i gibi
+// 401/403 döner. Kullanıcı istek context'inden ("user", OptionalAuth/Auth
+// middleware'i) alınır.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value("user").(auth.User)
+
+	channels := parseChannels(r.URL.Query().Get("channels"))
+	if len(channels) > s.MaxChannels {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("En fazla %d kanala abone olunabilir", s.MaxChannels))
+		return
+	}
+	for _, channel := range channels {
+		if err := s.Hub.Authorize(user, channel); err != nil {
+			if user == nil {
+				writeError(w, http.StatusUnauthorized, "Private kanallar için giriş yapılmalıdır")
+			} else {
+				writeError(w, http.StatusForbidden, "Bu kanala abone olma yetkiniz yok: "+channel)
+			}
+			return
+		}
+	}
+
+	conn, err := Upgrade(w, r, &UpgradeOptions{CheckOrigin: s.CheckOrigin})
+	if err != nil {
+		return // Hata yanıtı Upgrade tarafından yazıldı
+	}
+	conn.SetReadLimit(s.ReadLimit)
+	conn.SetWriteTimeout(s.WriteTimeout)
+	if s.PingInterval > 0 {
+		conn.SetReadTimeout(2 * s.PingInterval)
+	}
+
+	c := &client{
+		server: s,
+		conn:   conn,
+		user:   user,
+		subs:   make(map[string]*broadcast.Subscription),
+		done:   make(chan struct{}),
+	}
+	if !s.add(c) {
+		conn.Close(CloseGoingAway, "sunucu kapanıyor")
+		return
+	}
+	defer s.remove(c)
+
+	for _, channel := range channels {
+		c.subscribe(channel)
+	}
+	c.run()
+}
+
+// Connections, açık WebSocket bağlantılarının sayısını döndürür.
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.clients)
+}
+
+// Close, tüm bağlantıları 1001 (going away) ile kapatır ve yeni
+// bağlantıları reddeder. Hijack edilmiş bağlantılar http.Server.Shutdown
+// tarafından beklenmediği için RegisterOnShutdown ile çağrılmalıdır.
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	clients := make([]*client, 0, len(s.clients))
+	for c := range s.clients {
+		clients = append(clients, c)
+	}
+	s.mu.Unlock()
+
+	for _, c := range clients {
+		c.close(CloseGoingAway, "sunucu kapanıyor")
+	}
+}
+
+// add, client'ı kaydeder; Server kapatıldıysa false döner.
+func (s *Server) add(c *client) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	if s.clients == nil {
+		s.clients = make(map[*client]struct{})
+	}
+	s.clients[c] = struct{}{}
+	return true
+}
+
+// remove, client'ın kaydını siler.
+func (s *Server) remove(c *client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, c)
+}
+
+// clientMessage, client'tan gelen komuttur.
+type clientMessage struct {
+	Action  string `json:"action"`
+	Channel string `json:"channel"`
+	Token   string `json:"token"`
+}
+
+// serverMessage, client'a gönderilen mesajdır.
+type serverMessage struct {
+	Event   string      `json:"event"`
+	Channel string      `json:"channel,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// client, tek bir WebSocket bağlantısıdır.
+type client struct {
+	server *Server
+	conn   *Conn
+
+	mu   sync.Mutex
+	user auth.User
+	subs map[string]*broadcast.Subscription
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// run, client mesajlarını bağlantı kapanana kadar okur.
+func (c *client) run() {
+	defer c.close(CloseNormal, "")
+
+	if c.server.PingInterval > 0 {
+		go c.pingLoop()
+	}
+
+	for {
+		messageType, data, err := c.conn.ReadMessage()
+		if err != nil {
+			var closeErr *CloseError
+			if !errors.As(err, &closeErr) && !isClosedErr(err) {
+				c.server.Logger.Printf("⚠️  WebSocket read error (%s): %v", c.conn.RemoteAddr(), err)
+			}
+			return
+		}
+		if messageType != TextMessage {
+			c.close(CloseUnsupportedData, "sadece JSON metin mesajları desteklenir")
+			return
+		}
+
+		var msg clientMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			c.send(serverMessage{Event: EventError, Data: errorData("Geçersiz JSON mesajı")})
+			continue
+		}
+		c.handle(msg)
+	}
+}
+
+// handle, tek bir client komutunu işler.
+func (c *client) handle(msg clientMessage) {
+	switch msg.Action {
+	case "subscribe":
+		c.subscribe(strings.TrimSpace(msg.Channel))
+	case "unsubscribe":
+		c.unsubscribe(strings.TrimSpace(msg.Channel))
+	case "auth":
+		c.authenticate(msg.Token)
+	case "ping":
+		c.send(serverMessage{Event: EventPong})
+	default:
+		c.send(serverMessage{Event: EventError, Data: errorData("Bilinmeyen action: " + msg.Action)})
+	}
+}
+
+// authenticate, bağlantının kullanıcısını token ile belirler. Mevcut
+// abonelikler korunur.
+func (c *client) authenticate(token string) {
+	if c.server.Authenticate == nil {
+		c.send(serverMessage{Event: EventError, Data: errorData("Token ile kimlik doğrulama desteklenmiyor")})
+		return
+	}
+
+	user, err := c.server.Authenticate(token)
+	if err != nil || user == nil {
+		c.send(serverMessage{Event: EventError, Data: errorData("Geçersiz veya süresi dolmuş token")})
+		return
+	}
+
+	c.mu.Lock()
+	c.user = user
+	c.mu.Unlock()
+	c.send(serverMessage{Event: EventAuthenticated})
+}
+
+// subscribe, kanal yetkisini kontrol eder ve Hub'a abone olur.
+func (c *client) subscribe(channel string) {
+	if channel == "" {
+		c.send(serverMessage{Event: EventError, Data: errorData("Kanal adı boş olamaz")})
+		return
+	}
+
+	c.mu.Lock()
+	if _, ok := c.subs[channel]; ok {
+		c.mu.Unlock()
+		c.send(serverMessage{Event: EventSubscribed, Channel: channel})
+		return
+	}
+	if len(c.subs) >= c.server.MaxChannels {
+		c.mu.Unlock()
+		c.send(serverMessage{Event: EventError, Channel: channel, Data: errorData(fmt.Sprintf("En fazla %d kanala abone olunabilir", c.server.MaxChannels))})
+		return
+	}
+	user := c.user
+	c.mu.Unlock()
+
+	if err := c.server.Hub.Authorize(user, channel); err != nil {
+		message := "Bu kanala abone olma yetkiniz yok"
+		if user == nil {
+			message = "Private kanallar için giriş yapılmalıdır"
+		}
+		c.send(serverMessage{Event: EventError, Channel: channel, Data: errorData(message)})
+		return
+	}
+
+	sub := c.server.Hub.Subscribe(channel)
+	c.mu.Lock()
+	if _, ok := c.subs[channel]; ok {
+		// Eşzamanlı iki subscribe (?channels ve mesaj aynı anda): fazlasını bırak
+		c.mu.Unlock()
+		sub.Close()
+		return
+	}
+	c.subs[channel] = sub
+	c.mu.Unlock()
+
+	go c.forward(channel, sub)
+	c.send(serverMessage{Event: EventSubscribed, Channel: channel})
+}
+
+// unsubscribe, kanal aboneliğini kapatır.
+func (c *client) unsubscribe(channel string) {
+	c.mu.Lock()
+	sub, ok := c.subs[channel]
+	delete(c.subs, channel)
+	c.mu.Unlock()
+
+	if ok {
+		sub.Close()
+	}
+	c.send(serverMessage{Event: EventUnsubscribed, Channel: channel})
+}
+
+// forward, aboneliğe gelen mesajları client'a yazar. Abonelik client
+// ayrılmadan kapandıysa (Hub.Close) bağlantı 1001 ile kapatılır.
+func (c *client) forward(channel string, sub *broadcast.Subscription) {
+	for {
+		select {
+		case <-c.done:
+			return
+		case message, ok := <-sub.Messages():
+			if !ok {
+				c.mu.Lock()
+				hubClosed := c.subs[channel] == sub
+				c.mu.Unlock()
+				if hubClosed {
+					c.close(CloseGoingAway, "sunucu kapanıyor")
+				}
+				return
+			}
+			c.send(serverMessage{Event: message.Event, Channel: message.Channel, Data: message.Data})
+		}
+	}
+}
+
+// pingLoop, PingInterval'da bir ping gönderir. Client'ın pong'u (veya
+// herhangi bir frame'i) okuma zaman aşımını uzatır.
+func (c *client) pingLoop() {
+	ticker := time.NewTicker(c.server.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.conn.Ping(); err != nil {
+				c.close(CloseGoingAway, "")
+				return
+			}
+		}
+	}
+}
+
+// send, mesajı JSON olarak client'a yazar. Yazılamayan client'ın
+// bağlantısı kapatılır.
+func (c *client) send(msg serverMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		c.server.Logger.Printf("⚠️  WebSocket message encode error (%s): %v", msg.Event, err)
+		return
+	}
+	if err := c.conn.WriteText(data); err != nil && !errors.Is(err, ErrClosed) {
+		c.close(CloseGoingAway, "")
+	}
+}
+
+// close, bağlantıyı kapatır ve tüm abonelikleri bırakır. Birden fazla
+// çağrılabilir.
+func (c *client) close(code int, reason string) {
+	c.closeOnce.Do(func() {
+		close(c.done)
+
+		c.mu.Lock()
+		subs := c.subs
+		c.subs = make(map[string]*broadcast.Subscription)
+		c.mu.Unlock()
+
+		for _, sub := range subs {
+			sub.Close()
+		}
+		c.conn.Close(code, reason)
+	})
+}
+
+// isClosedErr, bağlantı kapandığı veya client yanıt vermediği (ping
+// zaman aşımı) için oluşan okuma hatalarını ayırt eder.
+func isClosedErr(err error) bool {
+	return errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, os.ErrDeadlineExceeded)
+}
+
+// errorData, ws.error event'inin gövdesidir.
+func errorData(message string) map[string]string {
+	return map[string]string{"message": message}
+}
+
+// parseChannels, virgülle ayrılmış kanal listesini tekilleştirerek döndürür.
+func parseChannels(value string) []string {
+	seen := make(map[string]bool)
+	var channels []string
+	for _, channel := range strings.Split(value, ",") {
+		channel = strings.TrimSpace(channel)
+		if channel == "" || seen[channel] {
+			continue
+		}
+		seen[channel] = true
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// writeError, upgrade'den önceki hataları JSON olarak yazar.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": false,
+		"error":   message,
+	})
+}
@@ -0,0 +1,14 @@
+// Package views, uygulamanın şablon dosyalarını binary'ye gömer.
+//
+// Şablonlar diskten düzenlenmek istendiğinde MAIL_TEMPLATE_PATH (email) veya
+// VIEW_PATH (HTML sayfalar) ile bu dizin (resources/views) gösterilir; aksi
+// halde gömülü kopya kullanılır. Yeni bir sayfa dizini (örn: users/) gömülü
+// kopyada da olması için aşağıdaki go:embed satırına eklenmelidir.
+package views
+
+import "embed"
+
+// FS, resources/views altındaki şablonlardır (kök: "emails/...", "layouts/...").
+//
+//go:embed emails layouts partials welcome.html
+var FS embed.FS
@@ -8,22 +8,36 @@ package tests
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"database/sql"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/biyonik/conduit-go/internal/config"
 	"github.com/biyonik/conduit-go/internal/controllers"
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
 	"github.com/biyonik/conduit-go/internal/middleware"
 	"github.com/biyonik/conduit-go/internal/models"
 	"github.com/biyonik/conduit-go/internal/router"
 	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/cache"
 	"github.com/biyonik/conduit-go/pkg/container"
 	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/golang-jwt/jwt/v5"
 )
 
 // setupTestRouter, test için router ve controller'ları hazırlar.
@@ -366,7 +380,7 @@ func TestPasswordHash(t *testing.T) {
 func TestRoleMiddleware(t *testing.T) {
 	r := router.New()
 
-	testHandler := func(w http.ResponseWriter, r *http.Request) {
+	testHandler := func(w http.ResponseWriter, r *conduitReq.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	}
@@ -397,3 +411,756 @@ func TestRoleMiddleware(t *testing.T) {
 		t.Errorf("Non-admin user should be forbidden, got %d", w2.Code)
 	}
 }
+
+// TestTokenRevocation, logout ve RevokeAllForUser ile iptal edilen token'ların
+// Auth middleware tarafından reddedildiğini test eder.
+func TestTokenRevocation(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	auth.SetTokenDenylist(cache.NewMemoryCache(logger))
+	defer auth.SetTokenDenylist(nil)
+
+	authController := &controllers.AuthController{
+		Logger:        logger,
+		JWTConfig:     auth.DefaultJWTConfig(),
+		RefreshTokens: auth.NewMemoryRefreshTokenStore(),
+	}
+
+	r := router.New()
+	r.GET("/me", func(w http.ResponseWriter, r *conduitReq.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Middleware(middleware.Auth())
+	r.POST("/logout", authController.Logout).Middleware(middleware.Auth())
+
+	call := func(method, path, token, body string) int {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	first, _ := auth.GenerateToken(42, "revoke@example.com", "user", nil)
+	second, _ := auth.GenerateToken(42, "revoke@example.com", "user", nil)
+	refresh, _ := auth.GenerateRefreshToken(42, "revoke@example.com", nil)
+
+	if code := call("GET", "/me", first, ""); code != http.StatusOK {
+		t.Fatalf("Geçerli token kabul edilmeli, got %d", code)
+	}
+
+	if code := call("POST", "/logout", first, `{"refresh_token":"`+refresh+`"}`); code != http.StatusOK {
+		t.Fatalf("Logout başarılı olmalı, got %d", code)
+	}
+	if code := call("GET", "/me", first, ""); code != http.StatusUnauthorized {
+		t.Errorf("Logout sonrası token reddedilmeli, got %d", code)
+	}
+	if code := call("GET", "/me", second, ""); code != http.StatusOK {
+		t.Errorf("Aynı kullanıcının diğer token'ı etkilenmemeli, got %d", code)
+	}
+
+	refreshClaims, _ := auth.ParseToken(refresh, nil)
+	if revoked, _ := auth.IsRevoked(refreshClaims); !revoked {
+		t.Error("Logout'ta verilen refresh token da iptal edilmeli")
+	}
+
+	if err := auth.RevokeAllForUser(42, nil); err != nil {
+		t.Fatalf("RevokeAllForUser hatası: %v", err)
+	}
+	if code := call("GET", "/me", second, ""); code != http.StatusUnauthorized {
+		t.Errorf("RevokeAllForUser sonrası eski token'lar reddedilmeli, got %d", code)
+	}
+
+	other, _ := auth.GenerateToken(7, "other@example.com", "user", nil)
+	if code := call("GET", "/me", other, ""); code != http.StatusOK {
+		t.Errorf("Başka kullanıcının token'ı etkilenmemeli, got %d", code)
+	}
+
+	// jti'siz token tek başına iptal edilemez
+	if err := auth.RevokeToken(&auth.JWTClaims{}); err == nil {
+		t.Error("jti'siz token için hata dönmeli")
+	}
+}
+
+// TestRefreshTokenReuse, refresh token rotation ve reuse detection'ı test eder.
+func TestRefreshTokenReuse(t *testing.T) {
+	store := auth.NewMemoryRefreshTokenStore()
+	device := auth.RefreshDevice{UserAgent: "test-agent", IPAddress: "10.0.0.1"}
+
+	original, err := auth.IssueRefreshToken(store, 42, "reuse@example.com", device, nil)
+	if err != nil {
+		t.Fatalf("IssueRefreshToken hatası: %v", err)
+	}
+
+	record, err := store.FindByHash(auth.HashRefreshToken(original))
+	if err != nil {
+		t.Fatalf("Refresh token hash'i ile kaydedilmeli: %v", err)
+	}
+	if record.TokenHash == original || record.UserAgent != "test-agent" || record.IPAddress != "10.0.0.1" {
+		t.Errorf("Kayıt hash ve cihaz bilgisi içermeli: %+v", record)
+	}
+
+	claims, rotated, err := auth.RotateRefreshToken(store, original, device, nil)
+	if err != nil {
+		t.Fatalf("Rotation başarılı olmalı: %v", err)
+	}
+	if claims.UserID != 42 || rotated == "" || rotated == original {
+		t.Fatalf("Rotation yeni bir refresh token vermeli (user: %d)", claims.UserID)
+	}
+
+	next, _ := store.FindByHash(auth.HashRefreshToken(rotated))
+	if next == nil || next.FamilyID != record.FamilyID {
+		t.Error("Yeni token aynı aileye ait olmalı")
+	}
+
+	// Kullanılmış token tekrar gelirse tüm aile iptal edilir
+	if _, _, err := auth.RotateRefreshToken(store, original, device, nil); !errors.Is(err, auth.ErrRefreshTokenReused) {
+		t.Fatalf("Tekrar kullanım ErrRefreshTokenReused dönmeli, got %v", err)
+	}
+	if _, _, err := auth.RotateRefreshToken(store, rotated, device, nil); !errors.Is(err, auth.ErrRefreshTokenRevoked) {
+		t.Errorf("Reuse sonrası ailenin yeni token'ı da reddedilmeli, got %v", err)
+	}
+
+	// Başka bir login (aile) etkilenmez; logout ile iptal edilir
+	other, _ := auth.IssueRefreshToken(store, 42, "reuse@example.com", device, nil)
+	if err := auth.RevokeRefreshToken(store, other); err != nil {
+		t.Fatalf("RevokeRefreshToken hatası: %v", err)
+	}
+	if _, _, err := auth.RotateRefreshToken(store, other, device, nil); !errors.Is(err, auth.ErrRefreshTokenRevoked) {
+		t.Errorf("Logout sonrası refresh token reddedilmeli, got %v", err)
+	}
+
+	// Store'da olmayan (ör. başka sistemde üretilmiş) token kabul edilmez
+	unknown, _ := auth.GenerateRefreshToken(42, "reuse@example.com", nil)
+	if _, _, err := auth.RotateRefreshToken(store, unknown, device, nil); !errors.Is(err, auth.ErrRefreshTokenNotFound) {
+		t.Errorf("Kayıtsız token ErrRefreshTokenNotFound dönmeli, got %v", err)
+	}
+}
+
+// TestPersonalAccessTokens, personal access token doğrulamasını, yetkileri
+// ve iptali test eder.
+func TestPersonalAccessTokens(t *testing.T) {
+	auth.SetPersonalAccessTokenStore(auth.NewMemoryPersonalAccessTokenStore())
+	defer auth.SetPersonalAccessTokenStore(nil)
+
+	tokenController := &controllers.TokenController{Logger: log.New(io.Discard, "", 0)}
+
+	r := router.New()
+	r.GET("/servers", func(w http.ResponseWriter, r *conduitReq.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Middleware(middleware.AuthToken())
+	r.POST("/deploy", func(w http.ResponseWriter, r *conduitReq.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Middleware(middleware.AuthToken()).Middleware(middleware.TokenAbilities("servers:deploy"))
+	r.DELETE("/tokens/{id}", tokenController.Destroy).Middleware(middleware.Auth())
+
+	call := func(method, path, token string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	readOnly, err := auth.CreatePersonalAccessToken(42, "ci", []string{"servers:read"}, nil)
+	if err != nil {
+		t.Fatalf("CreatePersonalAccessToken hatası: %v", err)
+	}
+	if !auth.IsPersonalAccessToken(readOnly.PlainTextToken) {
+		t.Fatalf("Düz token {id}|{secret} formatında olmalı: %s", readOnly.PlainTextToken)
+	}
+	full, _ := auth.CreatePersonalAccessToken(42, "deploy-cli", nil, nil)
+
+	if code := call("GET", "/servers", readOnly.PlainTextToken); code != http.StatusOK {
+		t.Errorf("Geçerli personal access token kabul edilmeli, got %d", code)
+	}
+	if code := call("POST", "/deploy", readOnly.PlainTextToken); code != http.StatusForbidden {
+		t.Errorf("Yetkisi olmayan token 403 almalı, got %d", code)
+	}
+	if code := call("POST", "/deploy", full.PlainTextToken); code != http.StatusOK {
+		t.Errorf("\"*\" yetkili token kabul edilmeli, got %d", code)
+	}
+
+	// JWT ile gelen istek tüm yetkilere sahip sayılır
+	jwtToken, _ := auth.GenerateToken(42, "pat@example.com", "user", nil)
+	if code := call("POST", "/deploy", jwtToken); code != http.StatusOK {
+		t.Errorf("AuthToken JWT'yi de kabul etmeli, got %d", code)
+	}
+
+	// Secret'ı yanlış token reddedilir
+	forged := strconv.FormatInt(full.AccessToken.ID, 10) + "|" + strings.Repeat("0", 40)
+	if code := call("GET", "/servers", forged); code != http.StatusUnauthorized {
+		t.Errorf("Hatalı secret 401 almalı, got %d", code)
+	}
+
+	// Süresi dolmuş token reddedilir
+	past := time.Now().Add(-time.Minute)
+	expired, _ := auth.CreatePersonalAccessToken(42, "old", nil, &past)
+	if code := call("GET", "/servers", expired.PlainTextToken); code != http.StatusUnauthorized {
+		t.Errorf("Süresi dolmuş token 401 almalı, got %d", code)
+	}
+
+	// Son kullanım zamanı güncellenir
+	tokens, _ := auth.PersonalAccessTokens(42)
+	for _, token := range tokens {
+		if token.ID == readOnly.AccessToken.ID && token.LastUsedAt == nil {
+			t.Error("Kullanılan token'ın last_used_at alanı güncellenmeli")
+		}
+	}
+
+	// Başka kullanıcı token'ı silemez; sahibi silebilir
+	otherJWT, _ := auth.GenerateToken(7, "other@example.com", "user", nil)
+	path := "/tokens/" + strconv.FormatInt(readOnly.AccessToken.ID, 10)
+	if code := call("DELETE", path, otherJWT); code != http.StatusNotFound {
+		t.Errorf("Başka kullanıcının token'ı 404 dönmeli, got %d", code)
+	}
+	if code := call("DELETE", path, jwtToken); code != http.StatusOK {
+		t.Fatalf("Token sahibi token'ı silebilmeli, got %d", code)
+	}
+	if code := call("GET", "/servers", readOnly.PlainTextToken); code != http.StatusUnauthorized {
+		t.Errorf("İptal edilen token 401 almalı, got %d", code)
+	}
+}
+
+// TestJWTConfigFromEnv, JWT ayarlarının environment'tan okunmasını,
+// issuer/audience doğrulamasını ve zayıf secret kontrolünü test eder.
+func TestJWTConfigFromEnv(t *testing.T) {
+	secret := strings.Repeat("s", 48)
+	t.Setenv("JWT_SECRET", secret)
+	t.Setenv("JWT_TTL", "15m")
+	t.Setenv("JWT_REFRESH_EXPIRATION", "86400") // Eski değişken (saniye)
+	t.Setenv("JWT_ISSUER", "billing")
+	t.Setenv("JWT_AUDIENCE", "billing-api")
+
+	jwtConfig := config.Load().JWT.Auth()
+	if jwtConfig.Secret != secret || jwtConfig.ExpirationTime != 15*time.Minute || jwtConfig.RefreshExpiresIn != 24*time.Hour {
+		t.Fatalf("JWT config environment'tan okunmalı: %+v", jwtConfig)
+	}
+	if err := jwtConfig.Validate(); err != nil {
+		t.Errorf("Güçlü secret geçerli olmalı: %v", err)
+	}
+
+	token, _ := auth.GenerateToken(1, "jwt@example.com", "user", jwtConfig)
+	if _, err := auth.ParseToken(token, jwtConfig); err != nil {
+		t.Fatalf("Aynı config ile token doğrulanmalı: %v", err)
+	}
+
+	otherAudience := *jwtConfig
+	otherAudience.Audience = "admin-api"
+	if _, err := auth.ParseToken(token, &otherAudience); err == nil {
+		t.Error("Farklı audience için verilmiş token reddedilmeli")
+	}
+	otherIssuer := *jwtConfig
+	otherIssuer.Issuer = "crm"
+	if _, err := auth.ParseToken(token, &otherIssuer); err == nil {
+		t.Error("Farklı issuer'ın token'ı reddedilmeli")
+	}
+
+	// Config verilmeyen çağrılar (middleware.Auth) varsayılan config'i kullanır
+	auth.SetDefaultJWTConfig(jwtConfig)
+	defer auth.SetDefaultJWTConfig(nil)
+	if _, err := auth.ParseToken(token, nil); err != nil {
+		t.Errorf("SetDefaultJWTConfig sonrası nil config ile doğrulanmalı: %v", err)
+	}
+
+	weak := []*auth.JWTConfig{
+		{Secret: "", ExpirationTime: time.Hour, RefreshExpiresIn: time.Hour},
+		{Secret: "short", ExpirationTime: time.Hour, RefreshExpiresIn: time.Hour},
+		{Secret: "your-super-secret-jwt-key-change-this-in-production", ExpirationTime: time.Hour, RefreshExpiresIn: time.Hour},
+	}
+	for _, cfg := range weak {
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("Zayıf secret reddedilmeli: %q", cfg.Secret)
+		}
+	}
+
+	// Production'da zayıf secret config validation'dan geçmez
+	t.Setenv("APP_ENV", "production")
+	t.Setenv("JWT_SECRET", "short")
+	if err := config.Load().Validate(); err == nil {
+		t.Error("Production'da zayıf JWT_SECRET hata vermeli")
+	}
+}
+
+// TestImpersonation, impersonation token'ını, context'teki durumu, hassas
+// işlem kısıtını, audit log'u ve bitirme endpoint'ini test eder.
+func TestImpersonation(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	auth.SetTokenDenylist(cache.NewMemoryCache(logger))
+	defer auth.SetTokenDenylist(nil)
+
+	var events []auth.ImpersonationEvent
+	auth.SetImpersonationAuditor(func(e auth.ImpersonationEvent) { events = append(events, e) })
+	defer auth.SetImpersonationAuditor(nil)
+
+	impersonationController := &controllers.ImpersonationController{Logger: logger, JWTConfig: auth.DefaultJWTConfig()}
+
+	var seenImpersonator int64
+	r := router.New()
+	r.GET("/me", func(w http.ResponseWriter, r *conduitReq.Request) {
+		seenImpersonator = middleware.GetImpersonatorID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}).Middleware(middleware.Auth())
+	r.PUT("/password", func(w http.ResponseWriter, r *conduitReq.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Middleware(middleware.Auth()).Middleware(middleware.NotImpersonating())
+	r.DELETE("/impersonate", impersonationController.Stop).Middleware(middleware.Auth())
+
+	call := func(method, path, token string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	target := &auth.AuthenticatedUser{ID: 42, Email: "customer@example.com", Role: "user"}
+	if _, _, err := auth.GenerateImpersonationToken(target, 42, nil); err == nil {
+		t.Error("Kullanıcı kendini impersonate edememeli")
+	}
+
+	token, claims, err := auth.GenerateImpersonationToken(target, 1, nil)
+	if err != nil {
+		t.Fatalf("GenerateImpersonationToken hatası: %v", err)
+	}
+	if !claims.IsImpersonated() || claims.ExpiresAt.Sub(claims.IssuedAt.Time) > auth.ImpersonationTTL {
+		t.Errorf("Impersonation token'ı işaretli ve kısa ömürlü olmalı: %+v", claims)
+	}
+
+	if code := call("GET", "/me", token); code != http.StatusOK || seenImpersonator != 1 {
+		t.Errorf("Impersonation durumu context'te olmalı (code: %d, impersonator: %d)", code, seenImpersonator)
+	}
+	if code := call("PUT", "/password", token); code != http.StatusForbidden {
+		t.Errorf("Hassas işlem impersonation sırasında reddedilmeli, got %d", code)
+	}
+
+	normal, _ := auth.GenerateToken(42, "customer@example.com", "user", nil)
+	if code := call("PUT", "/password", normal); code != http.StatusOK {
+		t.Errorf("Normal oturum hassas işlemi yapabilmeli, got %d", code)
+	}
+	if code := call("DELETE", "/impersonate", normal); code != http.StatusBadRequest {
+		t.Errorf("Impersonation olmadan bitirme 400 dönmeli, got %d", code)
+	}
+
+	if code := call("DELETE", "/impersonate", token); code != http.StatusOK {
+		t.Fatalf("Impersonation bitirilebilmeli, got %d", code)
+	}
+	if code := call("GET", "/me", token); code != http.StatusUnauthorized {
+		t.Errorf("Bitirilen impersonation token'ı reddedilmeli, got %d", code)
+	}
+
+	// Her istek ve bitiş audit log'a yazılır
+	actions := map[string]int{}
+	for _, e := range events {
+		if e.ImpersonatorID != 1 || e.UserID != 42 || e.TokenID != claims.ID {
+			t.Errorf("Audit olayı impersonator/kullanıcı/token bilgisi içermeli: %+v", e)
+		}
+		actions[e.Action]++
+	}
+	if actions[auth.ImpersonationRequest] != 3 || actions[auth.ImpersonationStopped] != 1 {
+		t.Errorf("Beklenmeyen audit olayları: %v", actions)
+	}
+}
+
+// TestMagicLink, magic link token'larının tek kullanımlık, süreli ve email
+// başına sınırlı olduğunu test eder.
+func TestMagicLink(t *testing.T) {
+	store := auth.NewMemoryMagicLinkStore()
+	config := &auth.MagicLinkConfig{TTL: 15 * time.Minute, MaxPerWindow: 3, Window: 15 * time.Minute}
+
+	token, err := auth.CreateMagicLink(store, 7, "Magic@Example.com", "10.0.0.1", config)
+	if err != nil {
+		t.Fatalf("CreateMagicLink hatası: %v", err)
+	}
+	if len(token) != 64 {
+		t.Errorf("Token 256 bit (64 hex) olmalı, got %d", len(token))
+	}
+
+	// Magic link'ler refresh token'larla aynı SHA-256 hash'i ile saklanır
+	record, err := store.FindByHash(auth.HashRefreshToken(token))
+	if err != nil {
+		t.Fatalf("Token hash'i ile kaydedilmeli: %v", err)
+	}
+	if record.TokenHash == token || record.Email != "magic@example.com" {
+		t.Errorf("Kayıt hash ve normalize email içermeli: %+v", record)
+	}
+
+	link, err := auth.ConsumeMagicLink(store, token)
+	if err != nil {
+		t.Fatalf("İlk kullanım başarılı olmalı: %v", err)
+	}
+	if link.UserID != 7 {
+		t.Errorf("Expected user 7, got %d", link.UserID)
+	}
+
+	// Tek kullanımlık
+	if _, err := auth.ConsumeMagicLink(store, token); !errors.Is(err, auth.ErrMagicLinkUsed) {
+		t.Errorf("İkinci kullanım ErrMagicLinkUsed dönmeli, got %v", err)
+	}
+	if _, err := auth.ConsumeMagicLink(store, strings.Repeat("a", 64)); !errors.Is(err, auth.ErrMagicLinkInvalid) {
+		t.Errorf("Bilinmeyen token ErrMagicLinkInvalid dönmeli, got %v", err)
+	}
+
+	// Yeni link öncekini geçersiz kılar; email başına pencerede en fazla 3 link
+	first, _ := auth.CreateMagicLink(store, 7, "magic@example.com", "10.0.0.1", config)
+	last, err := auth.CreateMagicLink(store, 7, "magic@example.com", "10.0.0.1", config)
+	if err != nil {
+		t.Fatalf("3. link üretilebilmeli: %v", err)
+	}
+	if _, err := auth.CreateMagicLink(store, 7, "magic@example.com", "10.0.0.1", config); !errors.Is(err, auth.ErrMagicLinkThrottled) {
+		t.Errorf("Pencere içinde 4. link ErrMagicLinkThrottled dönmeli, got %v", err)
+	}
+	if _, err := auth.CreateMagicLink(store, 8, "other@example.com", "10.0.0.1", config); err != nil {
+		t.Errorf("Limit email başına olmalı: %v", err)
+	}
+	if _, err := auth.ConsumeMagicLink(store, first); !errors.Is(err, auth.ErrMagicLinkUsed) {
+		t.Errorf("Yeni link istenince eski link geçersiz olmalı, got %v", err)
+	}
+	if _, err := auth.ConsumeMagicLink(store, last); err != nil {
+		t.Errorf("Son link kullanılabilmeli: %v", err)
+	}
+
+	// Süresi dolmuş link
+	expired, _ := auth.CreateMagicLink(store, 9, "expired@example.com", "", &auth.MagicLinkConfig{TTL: -time.Second})
+	if _, err := auth.ConsumeMagicLink(store, expired); !errors.Is(err, auth.ErrMagicLinkExpired) {
+		t.Errorf("Süresi dolmuş link ErrMagicLinkExpired dönmeli, got %v", err)
+	}
+}
+
+// staticUserProvider, test için kullanıcıları bellekte tutan provider'dır
+// (LDAP / harici API provider'larının yerine).
+type staticUserProvider struct {
+	users     map[int64]*auth.AuthenticatedUser
+	passwords map[string]string
+}
+
+func (p *staticUserProvider) RetrieveByID(id int64) (auth.User, error) {
+	if user, ok := p.users[id]; ok {
+		return user, nil
+	}
+	return nil, auth.ErrUserNotFound
+}
+
+func (p *staticUserProvider) RetrieveByCredentials(credentials auth.Credentials) (auth.User, error) {
+	for _, user := range p.users {
+		if user.Email == credentials["email"] && p.passwords[user.Email] == credentials["password"] {
+			return user, nil
+		}
+	}
+	return nil, auth.ErrInvalidCredentials
+}
+
+// TestUserProvider, login ve auth middleware'inin kullanıcıları ayarlanan
+// provider'dan yüklediğini test eder.
+func TestUserProvider(t *testing.T) {
+	provider := &staticUserProvider{
+		users: map[int64]*auth.AuthenticatedUser{
+			5: {ID: 5, Email: "ldap@example.com", Role: "admin"},
+		},
+		passwords: map[string]string{"ldap@example.com": "Secret123!"},
+	}
+	auth.SetUserProvider(provider)
+	defer auth.SetUserProvider(nil)
+
+	authController := &controllers.AuthController{
+		Logger:        log.New(io.Discard, "", 0),
+		JWTConfig:     auth.DefaultJWTConfig(),
+		RefreshTokens: auth.NewMemoryRefreshTokenStore(),
+		Users:         provider,
+	}
+
+	var contextUser auth.User
+	r := router.New()
+	r.POST("/login", authController.Login)
+	r.GET("/me", func(w http.ResponseWriter, r *conduitReq.Request) {
+		contextUser = middleware.GetAuthUser(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}).Middleware(middleware.Auth())
+
+	login := func(password string) *httptest.ResponseRecorder {
+		body := `{"email":"ldap@example.com","password":"` + password + `"}`
+		req := httptest.NewRequest("POST", "/login", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := login("wrong"); w.Code != http.StatusUnauthorized {
+		t.Errorf("Yanlış şifre 401 dönmeli, got %d", w.Code)
+	}
+
+	w := login("Secret123!")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Provider ile login başarılı olmalı, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var response struct {
+		Data struct {
+			AccessToken string                 `json:"access_token"`
+			User        map[string]interface{} `json:"user"`
+		} `json:"data"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if response.Data.User["role"] != "admin" {
+		t.Errorf("Rol provider'dan gelmeli, got %v", response.Data.User["role"])
+	}
+
+	call := func(token string) int {
+		req := httptest.NewRequest("GET", "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := call(response.Data.AccessToken); code != http.StatusOK {
+		t.Fatalf("Token kabul edilmeli, got %d", code)
+	}
+	if contextUser != provider.users[5] {
+		t.Errorf("Context'teki user provider'dan yüklenmeli, got %#v", contextUser)
+	}
+
+	// Provider'da artık olmayan kullanıcının token'ı reddedilir
+	delete(provider.users, 5)
+	if code := call(response.Data.AccessToken); code != http.StatusUnauthorized {
+		t.Errorf("Silinmiş kullanıcının token'ı reddedilmeli, got %d", code)
+	}
+}
+
+// TestPasswordResetLinks, şifre sıfırlama / davet linklerinin token'ı escape
+// ettiğini ve davet token'larının kendi süresini kullandığını test eder.
+func TestPasswordResetLinks(t *testing.T) {
+	link := auth.TokenLink("https://app.example.com/reset-password?lang=tr", "a+b/c=")
+	if link != "https://app.example.com/reset-password?lang=tr&token=a%2Bb%2Fc%3D" {
+		t.Errorf("TokenLink = %s", link)
+	}
+
+	created := time.Now().Add(-2 * time.Hour)
+	if !(controllers.PasswordResetToken{CreatedAt: created}).Expired() {
+		t.Error("expires_at olmayan token 1 saat sonra geçersiz olmalı")
+	}
+
+	expiresAt := created.Add(72 * time.Hour)
+	if (controllers.PasswordResetToken{CreatedAt: created, ExpiresAt: &expiresAt}).Expired() {
+		t.Error("Davet token'ı expires_at'e kadar geçerli olmalı")
+	}
+}
+
+// emailUserProvider, email ile aramayı da destekleyen staticUserProvider.
+type emailUserProvider struct{ *staticUserProvider }
+
+func (p emailUserProvider) RetrieveByEmail(email string) (auth.User, error) {
+	for _, user := range p.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, auth.ErrUserNotFound
+}
+
+// TestMagicLinkUserProvider, magic link girişinin kullanıcıyı users tablosu
+// yerine ayarlanan provider'dan yüklediğini test eder.
+func TestMagicLinkUserProvider(t *testing.T) {
+	static := &staticUserProvider{users: map[int64]*auth.AuthenticatedUser{
+		7: {ID: 7, Email: "ldap@example.com", Role: "user"},
+	}}
+	links := auth.NewMemoryMagicLinkStore()
+	controller := &controllers.MagicLinkController{
+		Logger:          log.New(io.Discard, "", 0),
+		Users:           emailUserProvider{static},
+		Mailer:          mail.NewArrayMailer(),
+		MagicLinks:      links,
+		RefreshTokens:   auth.NewMemoryRefreshTokenStore(),
+		JWTConfig:       auth.DefaultJWTConfig(),
+		MagicLinkConfig: &auth.MagicLinkConfig{TTL: time.Minute},
+		LinkURL:         "https://app.example.com/login/magic",
+	}
+
+	r := router.New()
+	r.POST("/magic-link", controller.Send)
+	r.POST("/magic-link/consume", controller.Consume)
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := post("/magic-link", `{"email":"ldap@example.com"}`); w.Code != http.StatusOK {
+		t.Fatalf("Provider'daki kullanıcı için link istenebilmeli, got %d: %s", w.Code, w.Body.String())
+	}
+
+	token, _ := auth.CreateMagicLink(links, 7, "ldap@example.com", "", &auth.MagicLinkConfig{TTL: time.Minute})
+	w := post("/magic-link/consume", `{"token":"`+token+`"}`)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"email":"ldap@example.com"`) {
+		t.Fatalf("Provider'daki kullanıcı giriş yapabilmeli, got %d: %s", w.Code, w.Body.String())
+	}
+
+	// Provider'da olmayan kullanıcının linki geçersizdir
+	token, _ = auth.CreateMagicLink(links, 99, "gone@example.com", "", &auth.MagicLinkConfig{TTL: time.Minute})
+	if w := post("/magic-link/consume", `{"token":"`+token+`"}`); w.Code != http.StatusUnauthorized {
+		t.Errorf("Bilinmeyen kullanıcı 401 dönmeli, got %d", w.Code)
+	}
+
+	// Email ile arama desteklemeyen provider
+	if _, err := auth.RetrieveByEmail(static, "ldap@example.com"); !errors.Is(err, auth.ErrEmailLookupUnsupported) {
+		t.Errorf("RetrieveByEmail ErrEmailLookupUnsupported dönmeli, got %v", err)
+	}
+}
+
+// fakeOIDCIssuer, discovery, JWKS ve token endpoint'i sunan test sağlayıcısı.
+type fakeOIDCIssuer struct {
+	server  *httptest.Server
+	key     *rsa.PrivateKey
+	idToken string // Token endpoint'inin döndüreceği ID token
+}
+
+func newFakeOIDCIssuer(t *testing.T) *fakeOIDCIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("RSA key hatası: %v", err)
+	}
+
+	issuer := &fakeOIDCIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.server.URL,
+			"authorization_endpoint": issuer.server.URL + "/authorize",
+			"token_endpoint":         issuer.server.URL + "/token",
+			"jwks_uri":               issuer.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test-key",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, _ := r.BasicAuth(); id != "client-1" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.FormValue("code") != "good-code" || r.FormValue("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": issuer.idToken, "token_type": "Bearer"})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+func (f *fakeOIDCIssuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	signed, err := token.SignedString(f.key)
+	if err != nil {
+		t.Fatalf("ID token imzalanamadı: %v", err)
+	}
+	return signed
+}
+
+func TestOIDC(t *testing.T) {
+	issuer := newFakeOIDCIssuer(t)
+	provider := auth.NewOIDCProvider(&auth.OIDCConfig{
+		Issuer:               issuer.server.URL,
+		ClientID:             "client-1",
+		ClientSecret:         "secret",
+		RedirectURL:          "http://app.test/api/auth/oidc/callback",
+		RequireVerifiedEmail: true,
+		AllowedDomains:       []string{"example.com"},
+	})
+	ctx := context.Background()
+
+	authReq, err := auth.NewOIDCAuthRequest()
+	if err != nil {
+		t.Fatalf("NewOIDCAuthRequest hatası: %v", err)
+	}
+
+	loginURL, err := provider.AuthCodeURL(ctx, authReq)
+	if err != nil {
+		t.Fatalf("AuthCodeURL hatası: %v", err)
+	}
+	for _, part := range []string{issuer.server.URL + "/authorize?", "state=" + authReq.State, "nonce=" + authReq.Nonce, "code_challenge_method=S256", "scope=openid+email+profile"} {
+		if !strings.Contains(loginURL, part) {
+			t.Errorf("Login URL %q içermeli: %s", part, loginURL)
+		}
+	}
+
+	claims := func(overrides jwt.MapClaims) jwt.MapClaims {
+		base := jwt.MapClaims{
+			"iss":            issuer.server.URL,
+			"sub":            "okta|123",
+			"aud":            "client-1",
+			"exp":            time.Now().Add(5 * time.Minute).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          authReq.Nonce,
+			"email":          "Jane@Example.com",
+			"email_verified": true,
+			"name":           "Jane Doe",
+		}
+		for k, v := range overrides {
+			base[k] = v
+		}
+		return base
+	}
+
+	// Başarılı akış: code -> ID token -> kimlik
+	issuer.idToken = issuer.sign(t, claims(nil))
+	tokens, err := provider.Exchange(ctx, "good-code", authReq.CodeVerifier)
+	if err != nil {
+		t.Fatalf("Exchange hatası: %v", err)
+	}
+	verified, err := provider.VerifyIDToken(ctx, tokens.IDToken, authReq.Nonce)
+	if err != nil {
+		t.Fatalf("VerifyIDToken hatası: %v", err)
+	}
+	identity, err := provider.Identity(verified)
+	if err != nil {
+		t.Fatalf("Identity hatası: %v", err)
+	}
+	if identity.Email != "jane@example.com" || identity.Name != "Jane Doe" || identity.Subject != "okta|123" || !identity.EmailVerified {
+		t.Errorf("Beklenmeyen kimlik: %+v", identity)
+	}
+
+	if _, err := provider.Exchange(ctx, "bad-code", authReq.CodeVerifier); err == nil {
+		t.Error("Geçersiz code reddedilmeli")
+	}
+
+	// Doğrulama hataları
+	invalid := map[string]string{
+		"nonce":    issuer.sign(t, claims(jwt.MapClaims{"nonce": "other"})),
+		"audience": issuer.sign(t, claims(jwt.MapClaims{"aud": "other-client"})),
+		"issuer":   issuer.sign(t, claims(jwt.MapClaims{"iss": "https://evil.test"})),
+		"expired":  issuer.sign(t, claims(jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()})),
+		"azp":      issuer.sign(t, claims(jwt.MapClaims{"aud": []string{"client-1", "other"}, "azp": "other"})),
+	}
+	hmacToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(nil)).SignedString([]byte("secret"))
+	invalid["hs256"] = hmacToken
+
+	for name, raw := range invalid {
+		if _, err := provider.VerifyIDToken(ctx, raw, authReq.Nonce); !errors.Is(err, auth.ErrOIDCInvalidToken) {
+			t.Errorf("%s: ErrOIDCInvalidToken bekleniyordu, got %v", name, err)
+		}
+	}
+
+	// Email politikaları
+	if _, err := provider.Identity(claims(jwt.MapClaims{"email_verified": false})); !errors.Is(err, auth.ErrOIDCEmailNotVerified) {
+		t.Errorf("Doğrulanmamış email reddedilmeli, got %v", err)
+	}
+	if _, err := provider.Identity(claims(jwt.MapClaims{"email": "jane@other.com"})); !errors.Is(err, auth.ErrOIDCDomainNotAllowed) {
+		t.Errorf("İzin verilmeyen domain reddedilmeli, got %v", err)
+	}
+	if _, err := provider.Identity(claims(jwt.MapClaims{"email": ""})); !errors.Is(err, auth.ErrOIDCEmailMissing) {
+		t.Errorf("Email'siz kimlik reddedilmeli, got %v", err)
+	}
+}
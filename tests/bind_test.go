@@ -0,0 +1,143 @@
+// -----------------------------------------------------------------------------
+// Bind Tests
+// -----------------------------------------------------------------------------
+// Request.BindQuery ve BindForm ile query/form verisinin struct'lara tip
+// dönüşümü ve varsayılan değerlerle doldurulmasını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+)
+
+type pagination struct {
+	Page    int `query:"page" default:"1"`
+	PerPage int `query:"per_page" default:"20"`
+}
+
+type userFilter struct {
+	pagination
+
+	Search   string        `query:"q"`
+	Active   *bool         `query:"active"`
+	Verified *bool         `query:"verified"`
+	Roles    []string      `query:"role"`
+	IDs      []int64       `query:"id"`
+	MinScore float64       `query:"min_score"`
+	Since    time.Time     `query:"since" time_format:"2006-01-02"`
+	Until    time.Time     `query:"until"`
+	Timeout  time.Duration `query:"timeout" default:"30s"`
+	Sort     string        `json:"sort" default:"created_at"`
+	Internal string        `query:"-"`
+	Ignored  string
+}
+
+func TestBindQuery(t *testing.T) {
+	r := conduitReq.New(httptest.NewRequest("GET",
+		"/users?q=ada&active=yes&role=admin&role=editor&id=1&id=2&min_score=4.5&since=2026-01-31&until=2026-02-01T10:00:00Z&per_page=&Ignored=x&Internal=x", nil))
+
+	filter := userFilter{Internal: "keep"}
+	if err := r.BindQuery(&filter); err != nil {
+		t.Fatal(err)
+	}
+
+	if filter.Search != "ada" || filter.Active == nil || !*filter.Active || filter.Verified != nil {
+		t.Errorf("search/active/verified = %q, %v, %v", filter.Search, filter.Active, filter.Verified)
+	}
+	if !reflect.DeepEqual(filter.Roles, []string{"admin", "editor"}) || !reflect.DeepEqual(filter.IDs, []int64{1, 2}) {
+		t.Errorf("roles/ids = %v, %v", filter.Roles, filter.IDs)
+	}
+	if filter.MinScore != 4.5 {
+		t.Errorf("min_score = %v", filter.MinScore)
+	}
+	if !filter.Since.Equal(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)) || !filter.Until.Equal(time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("since/until = %v, %v", filter.Since, filter.Until)
+	}
+
+	// Gönderilmeyen ve boş gönderilen alanlara default uygulanır
+	if filter.Page != 1 || filter.PerPage != 20 || filter.Timeout != 30*time.Second || filter.Sort != "created_at" {
+		t.Errorf("defaults = page %d, per_page %d, timeout %v, sort %q", filter.Page, filter.PerPage, filter.Timeout, filter.Sort)
+	}
+	if filter.Internal != "keep" || filter.Ignored != "" {
+		t.Error("fields without a tag or with \"-\" should not be bound")
+	}
+}
+
+func TestBindQueryErrors(t *testing.T) {
+	r := conduitReq.New(httptest.NewRequest("GET", "/users?page=two&active=maybe&since=31.01.2026&id=1&id=x", nil))
+
+	var filter userFilter
+	err := r.BindQuery(&filter)
+
+	var bindErr *conduitReq.BindError
+	if !errors.As(err, &bindErr) {
+		t.Fatalf("error = %v, want *BindError", err)
+	}
+	for _, field := range []string{"page", "active", "since", "id"} {
+		if len(bindErr.Fields[field]) == 0 {
+			t.Errorf("expected an error for %s, got %v", field, bindErr.Fields)
+		}
+	}
+	if !strings.Contains(bindErr.Fields["page"][0], "tam sayı") {
+		t.Errorf("page error = %q", bindErr.Fields["page"][0])
+	}
+
+	if err := r.BindQuery(filter); err == nil {
+		t.Error("non-pointer destination should fail")
+	}
+}
+
+type contactForm struct {
+	Name      string `form:"name"`
+	Age       uint8  `form:"age"`
+	Subscribe bool   `form:"subscribe"`
+	Topics    []string
+	Source    string `form:"source" default:"web"`
+}
+
+func TestBindForm(t *testing.T) {
+	req := httptest.NewRequest("POST", "/contact?source=query", strings.NewReader("name=Ada&age=36&subscribe=on"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var form contactForm
+	if err := conduitReq.New(req).BindForm(&form); err != nil {
+		t.Fatal(err)
+	}
+	// Query string form'a dahil edilmez
+	want := contactForm{Name: "Ada", Age: 36, Subscribe: true, Source: "web"}
+	if !reflect.DeepEqual(form, want) {
+		t.Errorf("form = %+v, want %+v", form, want)
+	}
+}
+
+func TestBindFormMultipart(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("name", "Grace")
+	writer.WriteField("age", "300")
+	writer.Close()
+
+	req := httptest.NewRequest("POST", "/contact", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var form contactForm
+	err := conduitReq.New(req).BindForm(&form)
+
+	var bindErr *conduitReq.BindError
+	if !errors.As(err, &bindErr) || len(bindErr.Fields["age"]) == 0 {
+		t.Fatalf("expected an overflow error for age, got %v", err)
+	}
+	if form.Name != "Grace" {
+		t.Errorf("name = %q", form.Name)
+	}
+}
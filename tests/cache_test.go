@@ -15,8 +15,14 @@
 package tests
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -64,7 +70,7 @@ func setupRedisCache(t *testing.T) cache.Cache {
 	}
 
 	// Test DB'yi temizle
-	redisClient.Client().FlushDB(ctx)
+	redisClient.Client().FlushDB(context.Background())
 
 	return cache.NewRedisCache(redisClient.Client(), logger, "test:")
 }
@@ -287,6 +293,90 @@ func TestCacheBulkOperations(t *testing.T) {
 	}
 }
 
+// TestCachePullAddForever, Pull, Add ve Forever primitive'lerini test eder.
+func TestCachePullAddForever(t *testing.T) {
+	drivers := []struct {
+		name  string
+		cache cache.Cache
+	}{
+		{"Memory", setupMemoryCache()},
+		{"File", setupFileCache(t)},
+	}
+
+	for _, driver := range drivers {
+		t.Run(driver.name, func(t *testing.T) {
+			c := driver.cache
+
+			// ADD: key yokken yazmalı
+			added, err := c.Add("lock:job", "worker-1", 10*time.Second)
+			if err != nil || !added {
+				t.Fatalf("Add ilk çağrıda yazmalıydı: added=%v err=%v", added, err)
+			}
+
+			// ADD: key varken yazmamalı
+			added, err = c.Add("lock:job", "worker-2", 10*time.Second)
+			if err != nil || added {
+				t.Errorf("Add mevcut key'i ezmemeliydi: added=%v err=%v", added, err)
+			}
+
+			// PULL: değeri döndürüp silmeli
+			val, err := c.Pull("lock:job")
+			if err != nil || val != "worker-1" {
+				t.Errorf("Pull beklenen değeri döndürmedi: %v (err: %v)", val, err)
+			}
+			if exists, _ := c.Has("lock:job"); exists {
+				t.Error("Pull sonrası key silinmiş olmalıydı")
+			}
+
+			// PULL: olmayan key nil dönmeli
+			val, err = c.Pull("lock:job")
+			if err != nil || val != nil {
+				t.Errorf("Olmayan key için Pull nil dönmeli: %v (err: %v)", val, err)
+			}
+
+			// ADD: expire olmuş key yokmuş gibi davranmalı
+			c.Set("otp:expired", "old", 1*time.Second)
+			time.Sleep(2 * time.Second)
+			added, err = c.Add("otp:expired", "new", 10*time.Second)
+			if err != nil || !added {
+				t.Errorf("Expire olmuş key için Add yazmalıydı: added=%v err=%v", added, err)
+			}
+
+			// FOREVER: süresiz yazmalı
+			if err := c.Forever("settings:site", "conduit"); err != nil {
+				t.Fatalf("Forever hatası: %v", err)
+			}
+			if val, _ := c.Get("settings:site"); val != "conduit" {
+				t.Errorf("Forever ile yazılan değer okunamadı: %v", val)
+			}
+		})
+	}
+}
+
+// TestCachePullConcurrent, eşzamanlı Pull'larda değerin tek bir çağrıya
+// teslim edildiğini test eder.
+func TestCachePullConcurrent(t *testing.T) {
+	c := setupMemoryCache()
+	c.Set("token:once", "secret", time.Minute)
+
+	var wg sync.WaitGroup
+	var winners int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if val, _ := c.Pull("token:once"); val != nil {
+				atomic.AddInt32(&winners, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if winners != 1 {
+		t.Errorf("Değer tam olarak bir kez alınmalıydı, alınan: %d", winners)
+	}
+}
+
 // TestCacheFlush, Flush operasyonunu test eder.
 func TestCacheFlush(t *testing.T) {
 	c := setupMemoryCache()
@@ -309,6 +399,132 @@ func TestCacheFlush(t *testing.T) {
 	}
 }
 
+// TestCacheTags, tag'li cache ve tag bazlı flush operasyonunu test eder.
+func TestCacheTags(t *testing.T) {
+	drivers := []struct {
+		name  string
+		cache cache.Cache
+	}{
+		{"Memory", setupMemoryCache()},
+		{"File", setupFileCache(t)},
+	}
+
+	for _, driver := range drivers {
+		t.Run(driver.name, func(t *testing.T) {
+			c := driver.cache
+
+			// Tag'li key'ler
+			if err := c.Tags("users", "profiles").Set("user:1", "Ahmet", 10*time.Second); err != nil {
+				t.Fatalf("Tag'li Set hatası: %v", err)
+			}
+			c.Tags("users").Set("user:2", "Mehmet", 10*time.Second)
+			c.Tags("posts").Set("post:1", "Hello", 10*time.Second)
+
+			// Tag'siz key
+			c.Set("settings", "dark", 10*time.Second)
+
+			// Tag'li Get
+			val, err := c.Tags("users").Get("user:1")
+			if err != nil {
+				t.Fatalf("Tag'li Get hatası: %v", err)
+			}
+			if val != "Ahmet" {
+				t.Errorf("Beklenen: Ahmet, Alınan: %v", val)
+			}
+
+			// "users" tag'ini flush et
+			if err := c.Tags("users").Flush(); err != nil {
+				t.Fatalf("Tag flush hatası: %v", err)
+			}
+
+			for _, key := range []string{"user:1", "user:2"} {
+				if exists, _ := c.Has(key); exists {
+					t.Errorf("%s silinmeliydi", key)
+				}
+			}
+
+			for _, key := range []string{"post:1", "settings"} {
+				if exists, _ := c.Has(key); !exists {
+					t.Errorf("%s silinmemeliydi", key)
+				}
+			}
+		})
+	}
+}
+
+// TestCacheRememberStampede, eşzamanlı miss'lerde callback'in tek kez
+// çalıştığını doğrular.
+func TestCacheRememberStampede(t *testing.T) {
+	drivers := []struct {
+		name  string
+		cache cache.Cache
+	}{
+		{"Memory", setupMemoryCache()},
+		{"File", setupFileCache(t)},
+	}
+
+	for _, driver := range drivers {
+		t.Run(driver.name, func(t *testing.T) {
+			var calls int32
+			var wg sync.WaitGroup
+
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					val, err := driver.cache.Remember("stampede:key", time.Minute, func() (interface{}, error) {
+						atomic.AddInt32(&calls, 1)
+						time.Sleep(50 * time.Millisecond) // Pahalı işlem simülasyonu
+						return "computed", nil
+					})
+					if err != nil || val != "computed" {
+						t.Errorf("Beklenmeyen sonuç: %v (err: %v)", val, err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if calls != 1 {
+				t.Errorf("Callback 1 kez çalışmalıydı, %d kez çalıştı", calls)
+			}
+		})
+	}
+}
+
+// TestRedisRememberDistributedLock, iki ayrı process'i simüle eden iki Redis
+// cache instance'ında lock sayesinde tek hesaplama yapıldığını doğrular.
+func TestRedisRememberDistributedLock(t *testing.T) {
+	first := setupRedisCache(t).(*cache.RedisCache)
+	second := setupRedisCache(t).(*cache.RedisCache)
+
+	opts := cache.StampedeOptions{Lock: true, LockTTL: 5 * time.Second, WaitTimeout: 2 * time.Second}
+	first.SetStampedeOptions(opts)
+	second.SetStampedeOptions(opts)
+
+	var calls int32
+	var wg sync.WaitGroup
+	callback := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(100 * time.Millisecond)
+		return "computed", nil
+	}
+
+	for _, c := range []*cache.RedisCache{first, second, first, second} {
+		wg.Add(1)
+		go func(c *cache.RedisCache) {
+			defer wg.Done()
+			if _, err := c.Remember("stampede:distributed", time.Minute, callback); err != nil {
+				t.Errorf("Remember hatası: %v", err)
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Callback 1 kez çalışmalıydı, %d kez çalıştı", calls)
+	}
+}
+
 // BenchmarkCacheSet, Set operasyonunun performansını ölçer.
 func BenchmarkCacheSet(b *testing.B) {
 	logger := log.New(os.Stdout, "[BENCH] ", log.LstdFlags)
@@ -358,3 +574,374 @@ func BenchmarkCacheRemember(b *testing.B) {
 		c.Remember("bench:user", 10*time.Minute, callback)
 	}
 }
+
+// TestCacheSerializers, serializer'ların tip korumasını ve format önekleri
+// sayesinde serializer değiştirilse bile eski girdilerin okunabildiğini test eder.
+func TestCacheSerializers(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	createdAt := time.Date(2024, 5, 1, 10, 30, 0, 123, time.UTC)
+
+	for _, serializer := range []cache.Serializer{cache.GobSerializer{}, cache.MsgpackSerializer{}} {
+		t.Run(serializer.Name(), func(t *testing.T) {
+			fc, err := cache.NewFileCache(t.TempDir(), logger)
+			if err != nil {
+				t.Fatalf("File cache oluşturulamadı: %v", err)
+			}
+			defer fc.Stop()
+
+			// JSON ile yazılmış eski girdi
+			fc.Set("legacy", "json-value", time.Minute)
+
+			fc.SetSerializer(serializer)
+			fc.Set("count", int64(42), time.Minute)
+			fc.Set("created_at", createdAt, time.Minute)
+			fc.Set("raw", []byte{0x00, 0xff}, time.Minute)
+
+			if val, _ := fc.Get("count"); val != int64(42) {
+				t.Errorf("int64 korunmalı: %#v", val)
+			}
+			if val, _ := fc.Get("created_at"); !createdAt.Equal(val.(time.Time)) {
+				t.Errorf("time.Time korunmalı: %#v", val)
+			}
+			if val, _ := fc.Get("raw"); string(val.([]byte)) != "\x00\xff" {
+				t.Errorf("[]byte korunmalı: %#v", val)
+			}
+			if val, _ := fc.Get("legacy"); val != "json-value" {
+				t.Errorf("JSON ile yazılmış girdi okunabilmeli: %#v", val)
+			}
+
+			if n, err := fc.Increment("count", 8); err != nil || n != 50 {
+				t.Errorf("Increment = %d (err: %v), beklenen 50", n, err)
+			}
+		})
+	}
+}
+
+// TestMsgpackSerializerRoundTrip, msgpack codec'inin desteklenen tipleri
+// doğru encode/decode ettiğini test eder.
+func TestMsgpackSerializerRoundTrip(t *testing.T) {
+	s := cache.MsgpackSerializer{}
+
+	input := map[string]interface{}{
+		"nil":      nil,
+		"bool":     true,
+		"small":    7,
+		"negative": -300,
+		"big":      int64(1) << 40,
+		"unsigned": uint16(65000),
+		"float":    3.25,
+		"string":   "merhaba dünya",
+		"long":     string(make([]byte, 300)),
+		"list":     []string{"a", "b"},
+		"nested":   map[string]int{"x": 1},
+		"struct": struct {
+			Name string `json:"name"`
+		}{Name: "Jane"},
+	}
+
+	data, err := s.Marshal(input)
+	if err != nil {
+		t.Fatalf("Marshal hatası: %v", err)
+	}
+	decoded, err := s.Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal hatası: %v", err)
+	}
+
+	got := decoded.(map[string]interface{})
+	expected := map[string]interface{}{
+		"nil":      nil,
+		"bool":     true,
+		"small":    int64(7),
+		"negative": int64(-300),
+		"big":      int64(1) << 40,
+		"unsigned": uint64(65000),
+		"float":    3.25,
+		"string":   "merhaba dünya",
+		"long":     string(make([]byte, 300)),
+	}
+	for key, want := range expected {
+		if got[key] != want {
+			t.Errorf("%s = %#v, beklenen %#v", key, got[key], want)
+		}
+	}
+
+	if list := got["list"].([]interface{}); len(list) != 2 || list[1] != "b" {
+		t.Errorf("list hatalı: %#v", got["list"])
+	}
+	if nested := got["nested"].(map[string]interface{}); nested["x"] != int64(1) {
+		t.Errorf("nested hatalı: %#v", got["nested"])
+	}
+	if st := got["struct"].(map[string]interface{}); st["name"] != "Jane" {
+		t.Errorf("struct json tag'leriyle map'e çevrilmeli: %#v", got["struct"])
+	}
+
+	if _, err := s.Unmarshal(data[:len(data)-1]); err == nil {
+		t.Error("Eksik veri hata vermeli")
+	}
+
+	// Hash'lenemeyen key'ler (bin, array, map) panic değil hata vermeli
+	for name, raw := range map[string][]byte{
+		"bin":   {0x81, 0xc4, 0x01, 'k', 0xc0},
+		"array": {0x81, 0x90, 0xc0},
+		"map":   {0x81, 0x80, 0xc0},
+	} {
+		if _, err := s.Unmarshal(raw); err == nil {
+			t.Errorf("%s key'i hata vermeli", name)
+		}
+	}
+	if decoded, err := s.Unmarshal([]byte{0x81, 0x01, 0xc0}); err != nil {
+		t.Errorf("Sayı key'i desteklenmeli: %v", err)
+	} else if _, ok := decoded.(map[interface{}]interface{})[int64(1)]; !ok {
+		t.Errorf("Sayı key'i korunmalı: %#v", decoded)
+	}
+}
+
+// TestEncryptedCache, şifreli cache wrapper'ının değerleri inner cache'te
+// düz metin bırakmadığını ve doğru çözdüğünü test eder.
+func TestEncryptedCache(t *testing.T) {
+	inner := setupFileCache(t)
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	if _, err := cache.NewEncrypted(inner, []byte("short")); err == nil {
+		t.Error("Geçersiz anahtar uzunluğu reddedilmeli")
+	}
+
+	secure, err := cache.NewEncrypted(inner, key)
+	if err != nil {
+		t.Fatalf("Encrypted cache oluşturulamadı: %v", err)
+	}
+
+	secure.Set("user:email", "jane@example.com", time.Minute)
+
+	raw, _ := inner.Get("user:email")
+	if s, ok := raw.(string); !ok || strings.Contains(s, "jane@example.com") {
+		t.Errorf("Inner cache'te değer şifreli olmalı: %#v", raw)
+	}
+
+	if val, err := secure.Get("user:email"); err != nil || val != "jane@example.com" {
+		t.Errorf("Get = %#v (err: %v)", val, err)
+	}
+
+	// Şifreli değer başka key'e kopyalanırsa çözülememeli (key AAD olarak bağlı)
+	inner.Set("user:copied", raw, time.Minute)
+	if _, err := secure.Get("user:copied"); err == nil {
+		t.Error("Başka key'e kopyalanan değer çözülmemeli")
+	}
+
+	// Dışarıdan yazılmış düz değer reddedilmeli
+	inner.Set("plain", "value", time.Minute)
+	if _, err := secure.Get("plain"); !errors.Is(err, cache.ErrNotEncrypted) {
+		t.Errorf("Düz değer ErrNotEncrypted dönmeli: %v", err)
+	}
+
+	// Farklı anahtarla çözülememeli
+	other, _ := cache.NewEncrypted(inner, []byte("fedcba9876543210fedcba9876543210"))
+	if _, err := other.Get("user:email"); err == nil {
+		t.Error("Yanlış anahtarla çözülmemeli")
+	}
+
+	// Remember: hesaplanan değer orijinal döner, sonraki okuma çözülür
+	calls := 0
+	for i := 0; i < 2; i++ {
+		val, err := secure.Remember("session:abc", time.Minute, func() (interface{}, error) {
+			calls++
+			return "session-data", nil
+		})
+		if err != nil || val != "session-data" {
+			t.Errorf("Remember = %#v (err: %v)", val, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Remember callback 1 kez çalışmalı, %d kez çalıştı", calls)
+	}
+
+	if val, _ := secure.Pull("user:email"); val != "jane@example.com" {
+		t.Errorf("Pull = %#v", val)
+	}
+	if exists, _ := secure.Has("user:email"); exists {
+		t.Error("Pull sonrası key silinmeli")
+	}
+}
+
+// TestCachePrune, expired girdilerin Prune ile temizlendiğini test eder.
+func TestCachePrune(t *testing.T) {
+	drivers := map[string]cache.Cache{
+		"memory": setupMemoryCache(),
+		"file":   setupFileCache(t),
+	}
+
+	for name, c := range drivers {
+		t.Run(name, func(t *testing.T) {
+			c.Set("short", "value", 1*time.Second)
+			c.Set("long", "value", time.Hour)
+
+			time.Sleep(2100 * time.Millisecond)
+
+			removed, err := c.(cache.Pruner).Prune()
+			if err != nil {
+				t.Fatalf("Prune hatası: %v", err)
+			}
+			if removed != 1 {
+				t.Errorf("Prune 1 girdi silmeli, %d sildi", removed)
+			}
+			if val, _ := c.Get("long"); val != "value" {
+				t.Errorf("Süresi dolmamış girdi korunmalı: %#v", val)
+			}
+		})
+	}
+}
+
+// TestCacheGetWithTTLAndTouch, kalan süre okumayı ve TTL uzatmayı test eder.
+func TestCacheGetWithTTLAndTouch(t *testing.T) {
+	drivers := map[string]cache.Cache{
+		"memory": setupMemoryCache(),
+		"file":   setupFileCache(t),
+	}
+
+	for name, c := range drivers {
+		t.Run(name, func(t *testing.T) {
+			c.Set("session", "data", 10*time.Second)
+			c.Forever("settings", "site")
+
+			val, remaining, err := c.GetWithTTL("session")
+			if err != nil || val != "data" {
+				t.Fatalf("GetWithTTL = %#v (err: %v)", val, err)
+			}
+			if remaining <= 0 || remaining > 10*time.Second {
+				t.Errorf("Kalan süre 0-10s arasında olmalı: %v", remaining)
+			}
+
+			if _, remaining, _ := c.GetWithTTL("settings"); remaining != cache.NoExpiry {
+				t.Errorf("Süresiz key NoExpiry dönmeli: %v", remaining)
+			}
+			if val, remaining, _ := c.GetWithTTL("missing"); val != nil || remaining != 0 {
+				t.Errorf("Olmayan key nil/0 dönmeli: %#v, %v", val, remaining)
+			}
+
+			// Touch: değer korunur, süre uzar
+			if ok, err := c.Touch("session", time.Hour); !ok || err != nil {
+				t.Fatalf("Touch = %v (err: %v)", ok, err)
+			}
+			val, remaining, _ = c.GetWithTTL("session")
+			if val != "data" || remaining < 50*time.Minute {
+				t.Errorf("Touch sonrası: %#v, %v", val, remaining)
+			}
+
+			// TTL = 0 key'i süresiz yapar
+			c.Touch("session", 0)
+			if _, remaining, _ := c.GetWithTTL("session"); remaining != cache.NoExpiry {
+				t.Errorf("Touch(0) sonrası süresiz olmalı: %v", remaining)
+			}
+
+			if ok, _ := c.Touch("missing", time.Minute); ok {
+				t.Error("Olmayan key için Touch false dönmeli")
+			}
+		})
+	}
+}
+
+// TestMemoryCacheLRU, boyut limitleri aşıldığında en eski erişilen
+// entry'lerin silindiğini test eder.
+func TestMemoryCacheLRU(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	mc := cache.NewMemoryCache(logger)
+	mc.SetLimits(cache.MemoryLimits{MaxEntries: 3})
+
+	mc.Set("a", 1, time.Minute)
+	mc.Set("b", 2, time.Minute)
+	mc.Set("c", 3, time.Minute)
+
+	// "a" erişildi -> en yeni; eviction adayı "b"
+	mc.Get("a")
+	mc.Set("d", 4, time.Minute)
+
+	if val, _ := mc.Get("b"); val != nil {
+		t.Errorf("LRU entry 'b' silinmeliydi: %#v", val)
+	}
+	for _, key := range []string{"a", "c", "d"} {
+		if val, _ := mc.Get(key); val == nil {
+			t.Errorf("'%s' korunmalıydı", key)
+		}
+	}
+
+	stats := mc.Stats()
+	if stats["evictions"] != uint64(1) || stats["total_keys"] != 3 {
+		t.Errorf("Stats: %+v", stats)
+	}
+
+	// Byte limiti: büyük değerler eski entry'leri iter, limitten büyük değer reddedilir
+	mc.SetLimits(cache.MemoryLimits{MaxBytes: 1024})
+	if err := mc.Set("huge", strings.Repeat("x", 2048), time.Minute); err == nil {
+		t.Error("Limitten büyük değer reddedilmeli")
+	}
+
+	for i := 0; i < 10; i++ {
+		mc.Set(fmt.Sprintf("blob:%d", i), strings.Repeat("x", 200), time.Minute)
+	}
+	if bytes := mc.Stats()["bytes"].(int64); bytes > 1024 {
+		t.Errorf("Toplam boyut limiti aşmamalı: %d", bytes)
+	}
+	if val, _ := mc.Get("blob:9"); val == nil {
+		t.Error("En son yazılan entry korunmalı")
+	}
+	if val, _ := mc.Get("blob:0"); val != nil {
+		t.Error("En eski entry silinmeli")
+	}
+}
+
+func TestCacheManagerNamedStores(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	manager := cache.NewManager(setupMemoryCache())
+
+	sessions, err := cache.NewStore(cache.StoreConfig{
+		Driver:  "file",
+		FileDir: t.TempDir(),
+		TTL:     time.Minute,
+	}, nil, logger)
+	if err != nil {
+		t.Fatalf("File store oluşturulamadı: %v", err)
+	}
+	manager.Register("sessions", sessions)
+
+	// Store'lar birbirinden bağımsız
+	manager.Store("sessions").Set("user", "ahmet", 0)
+	if val, _ := manager.Store(cache.DefaultStore).Get("user"); val != nil {
+		t.Errorf("Varsayılan store sessions key'ini görmemeli: %#v", val)
+	}
+
+	// ttl = 0 -> store'un varsayılan TTL'i, Forever -> süresiz
+	if _, remaining, _ := sessions.GetWithTTL("user"); remaining <= 0 || remaining > time.Minute {
+		t.Errorf("Varsayılan TTL uygulanmalı: %v", remaining)
+	}
+	sessions.Forever("settings", "site")
+	if _, remaining, _ := sessions.GetWithTTL("settings"); remaining != cache.NoExpiry {
+		t.Errorf("Forever süresiz olmalı: %v", remaining)
+	}
+	sessions.Tags("users").Set("tagged", "x", 0)
+	if _, remaining, _ := sessions.GetWithTTL("tagged"); remaining <= 0 || remaining > time.Minute {
+		t.Errorf("Tag'li yazmada varsayılan TTL uygulanmalı: %v", remaining)
+	}
+
+	// Tanımsız store varsayılana düşer, Lookup false döner
+	if manager.Store("responses") != manager.Store(cache.DefaultStore) {
+		t.Error("Tanımsız store varsayılan store'a düşmeli")
+	}
+	if _, ok := manager.Lookup("responses"); ok {
+		t.Error("Lookup tanımsız store için false dönmeli")
+	}
+	if names := manager.Names(); fmt.Sprint(names) != "[default sessions]" {
+		t.Errorf("Names = %v", names)
+	}
+
+	// Global erişim
+	cache.SetManager(manager)
+	defer cache.SetManager(nil)
+	if val, _ := cache.Store("sessions").Get("user"); val != "ahmet" {
+		t.Errorf("cache.Store(\"sessions\") = %#v", val)
+	}
+
+	if _, err := cache.NewStore(cache.StoreConfig{Driver: "redis"}, nil, logger); err == nil {
+		t.Error("Redis store client olmadan oluşturulamamalı")
+	}
+}
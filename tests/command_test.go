@@ -0,0 +1,179 @@
+// -----------------------------------------------------------------------------
+// Console Command Tests
+// -----------------------------------------------------------------------------
+// Uygulama komutlarının kaydını, flag ayrıştırmasını ve kernel'in komut
+// bulma ve help çıktısını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/pkg/console/command"
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+// syncRatesCommand, testlerde kullanılan örnek komut.
+type syncRatesCommand struct {
+	base  string
+	force bool
+
+	args []string
+	err  error
+}
+
+func (cmd *syncRatesCommand) Name() string        { return "rates:sync" }
+func (cmd *syncRatesCommand) Description() string { return "Sync exchange rates" }
+func (cmd *syncRatesCommand) Usage() string       { return "[currency...]" }
+
+func (cmd *syncRatesCommand) Configure(fs *flag.FlagSet) {
+	fs.StringVar(&cmd.base, "base", "EUR", "Base currency")
+	fs.BoolVar(&cmd.force, "force", false, "Skip confirmation")
+}
+
+func (cmd *syncRatesCommand) Handle(c *container.Container, args []string) error {
+	cmd.args = args
+	return cmd.err
+}
+
+func TestCommandRegistry(t *testing.T) {
+	cmd := &syncRatesCommand{}
+	command.Register(cmd)
+	defer command.Unregister(cmd.Name())
+
+	got, ok := command.Get("rates:sync")
+	if !ok || got != cmd {
+		t.Fatal("registered command not found")
+	}
+
+	found := false
+	for _, registered := range command.Registered() {
+		found = found || registered.Name() == "rates:sync"
+	}
+	if !found {
+		t.Error("Registered() does not include rates:sync")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("duplicate Register should panic")
+		}
+	}()
+	command.Register(&syncRatesCommand{})
+}
+
+func TestCommandExecuteParsesInterleavedFlags(t *testing.T) {
+	tests := []struct {
+		args      []string
+		wantArgs  []string
+		wantBase  string
+		wantForce bool
+	}{
+		{nil, nil, "EUR", false},
+		{[]string{"--base=USD", "TRY"}, []string{"TRY"}, "USD", false},
+		{[]string{"TRY", "--force", "GBP", "--base", "USD"}, []string{"TRY", "GBP"}, "USD", true},
+		{[]string{"TRY", "--", "--force"}, []string{"TRY", "--force"}, "EUR", false},
+	}
+
+	for _, tt := range tests {
+		cmd := &syncRatesCommand{}
+		if err := command.Execute(cmd, container.New(), tt.args); err != nil {
+			t.Fatalf("Execute(%v): %v", tt.args, err)
+		}
+		if !reflect.DeepEqual(cmd.args, tt.wantArgs) || cmd.base != tt.wantBase || cmd.force != tt.wantForce {
+			t.Errorf("Execute(%v): args=%v base=%s force=%v, want args=%v base=%s force=%v",
+				tt.args, cmd.args, cmd.base, cmd.force, tt.wantArgs, tt.wantBase, tt.wantForce)
+		}
+	}
+}
+
+func TestCommandExecuteErrors(t *testing.T) {
+	handleErr := errors.New("api down")
+	cmd := &syncRatesCommand{err: handleErr}
+	if err := command.Execute(cmd, container.New(), nil); !errors.Is(err, handleErr) {
+		t.Errorf("Execute error = %v, want %v", err, handleErr)
+	}
+
+	// Tanımsız flag Handle çağrılmadan hata döner
+	cmd = &syncRatesCommand{}
+	if err := command.Execute(cmd, container.New(), []string{"--unknown"}); err == nil {
+		t.Error("expected an error for an undefined flag")
+	}
+	if cmd.args != nil {
+		t.Error("Handle should not run when flag parsing fails")
+	}
+}
+
+func TestCommandFlagSetUsage(t *testing.T) {
+	var out bytes.Buffer
+	fs := command.NewFlagSet(&syncRatesCommand{}, &out)
+	fs.Usage()
+
+	for _, want := range []string{"Sync exchange rates", "conduit rates:sync [currency...] [flags]", "-base", "Base currency"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("usage missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestKernelFindAndRun(t *testing.T) {
+	var ran []string
+	k := command.NewKernel().
+		Add(command.Definition{Name: "migrate", Group: "MIGRATION COMMANDS", Run: func(args []string) {
+			ran = append(ran, "migrate "+strings.Join(args, " "))
+		}}).
+		Add(command.Definition{Name: "help", Aliases: []string{"-h"}, Group: "OTHER COMMANDS", Run: func([]string) {
+			ran = append(ran, "help")
+		}})
+
+	if !k.Run("migrate", []string{"--step=1"}) || !k.Run("-h", nil) {
+		t.Fatal("known commands should run")
+	}
+	if k.Run("unknown", nil) {
+		t.Error("unknown command should not run")
+	}
+	if want := []string{"migrate --step=1", "help"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("ran = %v, want %v", ran, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("adding a duplicate alias should panic")
+		}
+	}()
+	k.Add(command.Definition{Name: "-h"})
+}
+
+func TestKernelHelp(t *testing.T) {
+	noop := func([]string) {}
+	boot := func() (*container.Container, func(), error) { return container.New(), func() {}, nil }
+
+	k := command.NewKernel().
+		Add(command.Definition{Name: "make:model", Usage: "<name>", Description: "Create a new model", Group: "MAKE COMMANDS", Run: noop}).
+		Add(command.Definition{Name: "serve", Description: "Start development server", Group: "OTHER COMMANDS", Run: noop}).
+		Add(command.Definition{Name: "make:job", Usage: "<name>", Description: "Create a new job", Group: "MAKE COMMANDS", Run: noop}).
+		AddCommand(&syncRatesCommand{}, boot)
+
+	var out bytes.Buffer
+	k.Help(&out)
+
+	want := "" +
+		"MAKE COMMANDS:\n" +
+		"  make:model <name>          Create a new model\n" +
+		"  make:job <name>            Create a new job\n" +
+		"\n" +
+		"OTHER COMMANDS:\n" +
+		"  serve                      Start development server\n" +
+		"\n" +
+		"APPLICATION COMMANDS:\n" +
+		"  rates:sync [currency...]   Sync exchange rates\n"
+	if out.String() != want {
+		t.Errorf("help =\n%s\nwant\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,373 @@
+// -----------------------------------------------------------------------------
+// Config Tests
+// -----------------------------------------------------------------------------
+// Config dump'ının (config:show) secret maskelemesini, config cache'inin
+// (config:cache) yazılıp okunmasını ve fail-fast doğrulamayı test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	"github.com/biyonik/conduit-go/pkg/crypt"
+)
+
+func TestConfigEntries(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.App.Name = "Conduit"
+	cfg.DB.DSN = "user:pass@tcp(localhost)/app"
+	cfg.DB.ConnMaxLifetime = 5 * time.Minute
+	cfg.JWT.Secret = "super-secret"
+	cfg.Security.CORSAllowedOrigins = []string{"https://a.com", "https://b.com"}
+	cfg.Policies = map[string]config.PolicyConfig{"http": {MaxRetries: 2}}
+
+	values := make(map[string]config.Entry)
+	for _, entry := range cfg.Entries() {
+		values[entry.Key] = entry
+	}
+
+	tests := []struct {
+		key   string
+		value string
+	}{
+		{"app.name", "Conduit"},
+		{"db.dsn", config.RedactedValue},
+		{"db.conn_max_lifetime", "5m0s"},
+		{"jwt.secret", config.RedactedValue},
+		{"app.key", ""}, // Boş secret maskelenmez
+		{"mail.ses.secret_access_key", ""},
+		{"security.cors_allowed_origins", "https://a.com,https://b.com"},
+		{"policies.http.max_retries", "2"},
+	}
+	for _, tt := range tests {
+		entry, ok := values[tt.key]
+		if !ok {
+			t.Errorf("%s entry missing", tt.key)
+			continue
+		}
+		if entry.Value != tt.value {
+			t.Errorf("%s = %q, want %q", tt.key, entry.Value, tt.value)
+		}
+	}
+
+	if !values["app.key"].Secret || values["app.name"].Secret {
+		t.Error("only secret fields should be marked as secret")
+	}
+}
+
+func TestConfigCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "config.json")
+
+	if _, err := config.ReadCache(path); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("missing cache should return fs.ErrNotExist: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.App.Name = "Cached"
+	cfg.Queue.Driver = "redis"
+	cfg.JWT.Expiration = time.Hour
+	if err := config.WriteCache(cfg, path); err != nil {
+		t.Fatalf("WriteCache error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("cache file missing: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("cache file should be 0600, got %v", info.Mode().Perm())
+	}
+
+	cached, err := config.ReadCache(path)
+	if err != nil {
+		t.Fatalf("ReadCache error: %v", err)
+	}
+	if cached.App.Name != "Cached" || cached.Queue.Driver != "redis" || cached.JWT.Expiration != time.Hour {
+		t.Errorf("cached config mismatch: %+v", cached.App)
+	}
+
+	t.Setenv("CONFIG_CACHE_PATH", path)
+	t.Setenv("APP_NAME", "FromEnv")
+	if loaded := config.Load(); loaded.App.Name != "Cached" {
+		t.Errorf("Load should prefer the cache, got %s", loaded.App.Name)
+	}
+
+	removed, err := config.ClearCache(path)
+	if err != nil || !removed {
+		t.Fatalf("ClearCache = %v (err: %v)", removed, err)
+	}
+	if loaded := config.Load(); loaded.App.Name != "FromEnv" {
+		t.Errorf("Load should read env after clear, got %s", loaded.App.Name)
+	}
+	if removed, _ := config.ClearCache(path); removed {
+		t.Error("second ClearCache should report nothing removed")
+	}
+}
+
+func TestLoadConfigAggregatesErrors(t *testing.T) {
+	t.Setenv("CONFIG_CACHE_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("APP_ENV", "production")
+	t.Setenv("APP_URL", "")
+	t.Setenv("DB_DSN", "")
+	t.Setenv("JWT_SECRET", strings.Repeat("s", 40))
+	t.Setenv("PORT", "http")
+	t.Setenv("REDIS_PORT", "six")
+	t.Setenv("MAIL_TIMEOUT", "30")
+	t.Setenv("OIDC_AUTO_PROVISION", "evet")
+
+	_, err := config.LoadConfig()
+	var verr *config.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("LoadConfig error = %v, want *ValidationError", err)
+	}
+
+	for _, want := range []string{"APP_URL", "DB_DSN", "PORT", "REDIS_PORT", "MAIL_TIMEOUT", "OIDC_AUTO_PROVISION"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error should mention %s:\n%v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "JWT_SECRET") {
+		t.Errorf("valid JWT_SECRET reported:\n%v", err)
+	}
+}
+
+func TestRequireEnv(t *testing.T) {
+	t.Setenv("CONFIG_CACHE_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("APP_ENV", "staging-required-test")
+	t.Setenv("STAGING_ONLY_TOKEN", "")
+
+	if _, err := config.LoadConfig(); err != nil {
+		t.Fatalf("staging without requirements should be valid: %v", err)
+	}
+
+	config.RequireEnv("staging-required-test", "STAGING_ONLY_TOKEN")
+	if _, err := config.LoadConfig(); err == nil || !strings.Contains(err.Error(), "STAGING_ONLY_TOKEN") {
+		t.Errorf("missing required key should fail, got %v", err)
+	}
+
+	t.Setenv("STAGING_ONLY_TOKEN", "set")
+	if _, err := config.LoadConfig(); err != nil {
+		t.Errorf("required key set, got %v", err)
+	}
+}
+
+func TestLoadFilesFlattensAndOverrides(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("app.yaml", `# Uygulama
+app:
+  name: "Conduit # YAML"
+  env: staging
+cors:
+  allowed_origins:
+    - https://a.com
+    - 'https://b.com'
+`)
+	write("cache.yaml", `cache:
+  driver: redis
+  stores: [sessions, "responses"]
+  store:
+    sessions:
+      ttl: 2h   # oturumlar
+`)
+	write("cache.staging.yaml", "cache:\n  driver: memory\n")
+	write("cache.production.yaml", "cache:\n  driver: file\n")
+	write("notes.txt", "ignored: true\n")
+
+	values, err := config.LoadFiles(dir, "")
+	if err != nil {
+		t.Fatalf("LoadFiles error: %v", err)
+	}
+
+	want := map[string]string{
+		"APP_NAME":                 "Conduit # YAML",
+		"APP_ENV":                  "staging",
+		"CORS_ALLOWED_ORIGINS":     "https://a.com,https://b.com",
+		"CACHE_DRIVER":             "memory", // cache.staging.yaml (app.env)
+		"CACHE_STORES":             "sessions,responses",
+		"CACHE_STORE_SESSIONS_TTL": "2h",
+	}
+	for key, value := range want {
+		if values[key] != value {
+			t.Errorf("%s = %q, want %q", key, values[key], value)
+		}
+	}
+
+	if values, _ := config.LoadFiles(dir, "production"); values["CACHE_DRIVER"] != "file" {
+		t.Errorf("production override CACHE_DRIVER = %q, want file", values["CACHE_DRIVER"])
+	}
+	if values, err := config.LoadFiles(filepath.Join(dir, "missing"), ""); err != nil || len(values) != 0 {
+		t.Errorf("missing dir = %v, %v; want empty", values, err)
+	}
+}
+
+func TestLoadFilesReportsSyntaxErrors(t *testing.T) {
+	tests := map[string]string{
+		"indent": "cache:\n  driver: redis\n    prefix: x\n",
+		"flow":   "cache: {driver: redis}\n",
+		"list":   "cache:\n  stores:\n    - name: sessions\n",
+		"tab":    "cache:\n\tdriver: redis\n",
+	}
+	for name, content := range tests {
+		dir := t.TempDir()
+		os.WriteFile(filepath.Join(dir, "cache.yaml"), []byte(content), 0o644)
+		if _, err := config.LoadFiles(dir, ""); err == nil || !strings.Contains(err.Error(), "cache.yaml") {
+			t.Errorf("%s: error = %v, want error naming the file", name, err)
+		}
+	}
+}
+
+func TestLoadFromEnvUsesConfigFiles(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "app.yaml"), []byte(`app:
+  name: FromFile
+cache:
+  driver: file
+  serializer: gob
+db:
+  max_open_conns: 7
+`), 0o644)
+
+	t.Setenv("CONFIG_CACHE_PATH", filepath.Join(dir, "config.json"))
+	t.Setenv("CONFIG_PATH", dir)
+	t.Setenv("APP_ENV", "development")
+	t.Setenv("APP_NAME", "")
+	t.Setenv("CACHE_DRIVER", "memory")
+	t.Setenv("CACHE_SERIALIZER", "")
+	t.Setenv("DB_MAX_OPEN_CONNS", "")
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if cfg.App.Name != "FromFile" || cfg.Cache.Serializer != "gob" || cfg.DB.MaxOpenConns != 7 {
+		t.Errorf("file values not applied: name=%q serializer=%q max_open=%d", cfg.App.Name, cfg.Cache.Serializer, cfg.DB.MaxOpenConns)
+	}
+	if cfg.Cache.Driver != "memory" {
+		t.Errorf("env should override file, CACHE_DRIVER = %q", cfg.Cache.Driver)
+	}
+
+	os.WriteFile(filepath.Join(dir, "broken.yaml"), []byte("cache: {}\n"), 0o644)
+	if _, err := config.LoadConfig(); err == nil || !strings.Contains(err.Error(), "broken.yaml") {
+		t.Errorf("broken file should fail validation, got %v", err)
+	}
+}
+
+func TestConfigGet(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.App.Name = "Conduit"
+	cfg.DB.MaxOpenConns = 25
+	cfg.DB.ConnMaxLifetime = 5 * time.Minute
+	cfg.RateLimit.Enabled = true
+	cfg.Security.CORSAllowedOrigins = []string{"https://a.com", "https://b.com"}
+	cfg.CacheStores = map[string]config.CacheStoreConfig{"sessions": {TTL: 2 * time.Hour}}
+	cfg.Values = map[string]string{"SERVICES_STRIPE_TIMEOUT": "30", "FEATURES_BETA": "yes"}
+
+	t.Setenv("SERVICES_STRIPE_KEY", "sk_test")
+
+	if got := cfg.Get("app.name", "x"); got != "Conduit" {
+		t.Errorf("app.name = %q", got)
+	}
+	if got := cfg.GetInt("db.max_open_conns", 0); got != 25 {
+		t.Errorf("db.max_open_conns = %d", got)
+	}
+	if got := cfg.GetDuration("db.conn_max_lifetime", 0); got != 5*time.Minute {
+		t.Errorf("db.conn_max_lifetime = %v", got)
+	}
+	if !cfg.GetBool("rate_limit.enabled", false) {
+		t.Error("rate_limit.enabled should be true")
+	}
+	if got := cfg.GetStrings("security.cors_allowed_origins", nil); len(got) != 2 || got[1] != "https://b.com" {
+		t.Errorf("security.cors_allowed_origins = %v", got)
+	}
+
+	// Alan adındaki alt çizgi yerine nokta ve map key'leri
+	for _, key := range []string{"cache.stores.sessions.ttl", "cache_stores.sessions.ttl"} {
+		if got := cfg.GetDuration(key, time.Minute); got != 2*time.Hour {
+			t.Errorf("%s = %v, want 2h", key, got)
+		}
+	}
+
+	// Struct'ta olmayan key'ler: önce ortam, sonra config dosyaları
+	if got := cfg.Get("services.stripe.key", ""); got != "sk_test" {
+		t.Errorf("services.stripe.key = %q", got)
+	}
+	if got := cfg.GetDuration("services.stripe.timeout", 0); got != 30*time.Second {
+		t.Errorf("services.stripe.timeout = %v", got)
+	}
+
+	// Eksik veya geçersiz değerlerde varsayılan
+	if got := cfg.GetInt("services.missing.retries", 3); got != 3 {
+		t.Errorf("missing key = %d, want default", got)
+	}
+	if cfg.GetBool("features.beta", false) {
+		t.Error("invalid bool should fall back to default")
+	}
+	if cfg.Has("app") || cfg.Has("cache.stores.sessions") || !cfg.Has("app.name") {
+		t.Error("only leaf values should be reported by Has")
+	}
+
+	config.SetDefault(cfg)
+	defer config.SetDefault(nil)
+	if got := config.GetInt("db.max_open_conns", 0); got != 25 {
+		t.Errorf("config.GetInt = %d", got)
+	}
+}
+
+func TestEncryptedConfigValues(t *testing.T) {
+	appKey, _ := crypt.GenerateKey()
+	otherKey, _ := crypt.GenerateKey()
+
+	token, err := config.EncryptValue(appKey, "p@ss word")
+	if err != nil {
+		t.Fatalf("EncryptValue error: %v", err)
+	}
+	if !config.IsEncrypted(token) || config.IsEncrypted("ENC()") || config.IsEncrypted("plain") {
+		t.Errorf("IsEncrypted mismatch for %q", token)
+	}
+	if plain, err := config.DecryptValue(appKey, token); err != nil || plain != "p@ss word" {
+		t.Errorf("DecryptValue = %q, %v", plain, err)
+	}
+	if _, err := config.DecryptValue(otherKey, token); err == nil {
+		t.Error("decrypting with another APP_KEY should fail")
+	}
+
+	fileToken, _ := config.EncryptValue(appKey, "sk_test")
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "services.yaml"), []byte("services:\n  stripe:\n    key: "+fileToken+"\n"), 0o644)
+
+	t.Setenv("CONFIG_CACHE_PATH", filepath.Join(dir, "config.json"))
+	t.Setenv("CONFIG_PATH", dir)
+	t.Setenv("APP_ENV", "development")
+	t.Setenv("APP_KEY", appKey)
+	t.Setenv("MAIL_PASSWORD", token)
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	defer config.SetDefault(nil)
+	if cfg.Mail.Password != "p@ss word" {
+		t.Errorf("MAIL_PASSWORD = %q, want decrypted value", cfg.Mail.Password)
+	}
+	if got := cfg.Get("services.stripe.key", ""); got != "sk_test" {
+		t.Errorf("services.stripe.key = %q, want decrypted value", got)
+	}
+
+	t.Setenv("APP_KEY", otherKey)
+	if _, err := config.LoadConfig(); err == nil || !strings.Contains(err.Error(), "MAIL_PASSWORD") {
+		t.Errorf("undecryptable value should fail validation, got %v", err)
+	}
+}
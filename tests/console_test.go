@@ -0,0 +1,169 @@
+// -----------------------------------------------------------------------------
+// Console Tests
+// -----------------------------------------------------------------------------
+// CLI çıktı katmanının mesajlarını, tablo hizalamasını, prompt cevaplarını ve
+// terminal dışı progress bar çıktısını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/pkg/console"
+)
+
+func TestConsoleMessages(t *testing.T) {
+	var out, errOut bytes.Buffer
+	c := console.New(strings.NewReader(""), &out, &errOut)
+
+	c.Success("%d migration(s) migrated", 3)
+	c.Warn("careful")
+	c.Error("boom")
+
+	if got := out.String(); got != "✅ 3 migration(s) migrated\n⚠️  careful\n" {
+		t.Errorf("out = %q", got)
+	}
+	if got := errOut.String(); got != "❌ boom\n" {
+		t.Errorf("errOut = %q", got)
+	}
+}
+
+func TestConsoleColor(t *testing.T) {
+	t.Setenv("FORCE_COLOR", "")
+
+	var out bytes.Buffer
+	c := console.New(strings.NewReader(""), &out, &out)
+
+	// Terminal olmayan çıktıda renk kodu yazılmaz
+	c.Success("plain")
+	if strings.Contains(out.String(), "\033[") {
+		t.Errorf("color codes written to a non-terminal: %q", out.String())
+	}
+
+	c.SetColor(true).Success("green")
+	if !strings.Contains(out.String(), string(console.Green)+"green"+string(console.Reset)) {
+		t.Errorf("expected green output, got %q", out.String())
+	}
+}
+
+func TestConsoleTable(t *testing.T) {
+	var out bytes.Buffer
+	c := console.New(strings.NewReader(""), &out, &out).SetColor(true)
+
+	c.Table(
+		[]string{"ID", "Status"},
+		[][]string{
+			{"1", c.Colorize(console.Green, "Ran")},
+			{"10", "Pending"},
+			{"100"}, // Eksik hücre boş yazılır
+		},
+	)
+
+	plain := strings.NewReplacer(
+		string(console.Green), "", string(console.Bold), "", string(console.Reset), "",
+	).Replace(out.String())
+
+	want := "" +
+		"+-----+---------+\n" +
+		"| ID  | Status  |\n" +
+		"+-----+---------+\n" +
+		"| 1   | Ran     |\n" +
+		"| 10  | Pending |\n" +
+		"| 100 |         |\n" +
+		"+-----+---------+\n"
+	if plain != want {
+		t.Errorf("table =\n%s\nwant\n%s", plain, want)
+	}
+}
+
+func TestConsoleTableWideCharacters(t *testing.T) {
+	var out bytes.Buffer
+	c := console.New(strings.NewReader(""), &out, &out)
+
+	c.Table([]string{"Status"}, [][]string{{"✅ Ran"}, {"Pending"}})
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if lines[3] != "| ✅ Ran  |" || lines[4] != "| Pending |" {
+		t.Errorf("emoji cell misaligned:\n%s", out.String())
+	}
+}
+
+func TestConsoleConfirm(t *testing.T) {
+	tests := []struct {
+		input      string
+		defaultYes bool
+		want       bool
+	}{
+		{"yes\n", false, true},
+		{"Y\n", false, true},
+		{"evet\n", false, true},
+		{"no\n", true, false},
+		{"\n", true, true},
+		{"\n", false, false},
+		{"", true, true},            // EOF: varsayılan
+		{"maybe\ny\n", false, true}, // Geçersiz cevapta tekrar sorulur
+		{"maybe\n", true, true},     // Geçersiz cevaptan sonra EOF
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		c := console.New(strings.NewReader(tt.input), &out, &out)
+
+		if got := c.Confirm("Continue?", tt.defaultYes); got != tt.want {
+			t.Errorf("Confirm(%q, %v) = %v, want %v", tt.input, tt.defaultYes, got, tt.want)
+		}
+	}
+}
+
+func TestConsoleAskAndSecret(t *testing.T) {
+	var out bytes.Buffer
+	c := console.New(strings.NewReader("\n  alice \ns3cret\n"), &out, &out)
+
+	if got := c.Ask("Name", "bob"); got != "bob" {
+		t.Errorf("Ask default = %q, want bob", got)
+	}
+	if got := c.Ask("Name", ""); got != "alice" {
+		t.Errorf("Ask = %q, want alice", got)
+	}
+
+	secret, err := c.Secret("Password")
+	if err != nil {
+		t.Fatalf("Secret: %v", err)
+	}
+	if secret != "s3cret" {
+		t.Errorf("Secret = %q, want s3cret", secret)
+	}
+	if !strings.Contains(out.String(), "Name [bob]: ") {
+		t.Errorf("prompt missing default hint: %q", out.String())
+	}
+}
+
+func TestConsoleProgressBarNonInteractive(t *testing.T) {
+	var out bytes.Buffer
+	c := console.New(strings.NewReader(""), &out, &out)
+
+	bar := c.NewProgressBar(4)
+	bar.Advance()
+	c.Warn("job #2 failed")
+	bar.Advance(5) // Total'ı aşmaz
+	if bar.Current() != 4 {
+		t.Errorf("Current = %d, want 4", bar.Current())
+	}
+	bar.Finish()
+	bar.Finish()
+
+	// Terminal değilken ara adımlar çizilmez; mesajlar ve tek bitiş satırı
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", out.String())
+	}
+	if lines[0] != "⚠️  job #2 failed" {
+		t.Errorf("line 0 = %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "4/4") || !strings.Contains(lines[1], "100%") {
+		t.Errorf("finish line = %q", lines[1])
+	}
+}
@@ -0,0 +1,227 @@
+// -----------------------------------------------------------------------------
+// Container Tests
+// -----------------------------------------------------------------------------
+// Generic kayıt ve çözme fonksiyonlarını (Register[T], Resolve[T],
+// MustResolve[T]), isimli ve bağlamsal kayıtları test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/container"
+)
+
+type greeter interface {
+	Greet(name string) string
+}
+
+type englishGreeter struct{ calls int }
+
+func (g *englishGreeter) Greet(name string) string {
+	g.calls++
+	return "Hello, " + name
+}
+
+func TestContainerResolveGeneric(t *testing.T) {
+	c := container.New()
+
+	container.Register(c, func(c *container.Container) (*log.Logger, error) {
+		return log.Default(), nil
+	})
+	container.Register(c, func(c *container.Container) (greeter, error) {
+		return &englishGreeter{}, nil
+	})
+
+	if logger := container.MustResolve[*log.Logger](c); logger != log.Default() {
+		t.Error("MustResolve should return the registered logger")
+	}
+
+	// Interface anahtarı: reflect.TypeOf(...).Elem() gerekmez
+	g, err := container.Resolve[greeter](c)
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if got := g.Greet("Ada"); got != "Hello, Ada" {
+		t.Errorf("Greet = %q", got)
+	}
+	if container.MustResolve[greeter](c) != g {
+		t.Error("resolved services should be singletons")
+	}
+
+	// Generic kayıtlar MustGet ile de çözülür
+	if c.MustGet(container.TypeOf[greeter]()) != g {
+		t.Error("MustGet should return the same instance")
+	}
+}
+
+func TestContainerResolveErrors(t *testing.T) {
+	c := container.New()
+
+	if _, err := container.Resolve[greeter](c); err == nil || !strings.Contains(err.Error(), "kaydı bulunamadı") {
+		t.Errorf("unregistered service should fail, got %v", err)
+	}
+
+	boom := errors.New("boom")
+	container.Register(c, func(c *container.Container) (*englishGreeter, error) {
+		return nil, boom
+	})
+	if _, err := container.Resolve[*englishGreeter](c); !errors.Is(err, boom) {
+		t.Errorf("factory error should be wrapped, got %v", err)
+	}
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "greeter") {
+			t.Errorf("MustResolve should panic for missing services, got %v", r)
+		}
+	}()
+	container.MustResolve[greeter](c)
+}
+
+func TestContainerConcurrentResolveBuildsOnce(t *testing.T) {
+	c := container.New()
+
+	var builds int32
+	release := make(chan struct{})
+	container.Register(c, func(c *container.Container) (greeter, error) {
+		atomic.AddInt32(&builds, 1)
+		<-release // Diğer goroutine'ler fabrika çalışırken çözmeye çalışsın
+		return &englishGreeter{}, nil
+	})
+
+	const n = 16
+	results := make([]greeter, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = container.MustResolve[greeter](c)
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&builds); got != 1 {
+		t.Errorf("factory should run once, ran %d times", got)
+	}
+	for i, g := range results {
+		if g != results[0] {
+			t.Fatalf("goroutine %d got a different instance", i)
+		}
+	}
+}
+
+func TestContainerCircularDependency(t *testing.T) {
+	c := container.New()
+
+	container.Register(c, func(c *container.Container) (*authService, error) {
+		_, err := container.Resolve[*reportService](c)
+		return &authService{}, err
+	})
+	container.Register(c, func(c *container.Container) (*reportService, error) {
+		_, err := container.Resolve[*authService](c)
+		return &reportService{}, err
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := container.Resolve[*authService](c)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "döngüsel") {
+			t.Errorf("circular dependency should be reported, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("circular dependency should not deadlock")
+	}
+}
+
+type authService struct{ cache greeter }
+type reportService struct{ cache greeter }
+
+type turkishGreeter struct{}
+
+func (turkishGreeter) Greet(name string) string { return "Merhaba, " + name }
+
+func TestContainerNamedBindings(t *testing.T) {
+	c := container.New()
+
+	container.Register(c, func(c *container.Container) (greeter, error) {
+		return &englishGreeter{}, nil
+	})
+	container.RegisterNamed(c, "tr", func(c *container.Container) (greeter, error) {
+		return turkishGreeter{}, nil
+	})
+
+	if got := container.MustResolve[greeter](c).Greet("Ada"); got != "Hello, Ada" {
+		t.Errorf("default binding = %q", got)
+	}
+	tr := container.MustResolveNamed[greeter](c, "tr")
+	if got := tr.Greet("Ada"); got != "Merhaba, Ada" {
+		t.Errorf("named binding = %q", got)
+	}
+	if container.MustResolveNamed[greeter](c, "tr") != tr {
+		t.Error("named services should be singletons")
+	}
+	if _, err := container.ResolveNamed[greeter](c, "de"); err == nil || !strings.Contains(err.Error(), `"de"`) {
+		t.Errorf("missing named binding should fail, got %v", err)
+	}
+
+	// Implementing sadece isimsiz kayıtları döndürür
+	services, err := c.Implementing(container.TypeOf[greeter]())
+	if err != nil || len(services) != 1 {
+		t.Errorf("Implementing = %d services, %v", len(services), err)
+	}
+}
+
+func TestContainerContextualBindings(t *testing.T) {
+	c := container.New()
+
+	container.Register(c, func(c *container.Container) (greeter, error) {
+		return &englishGreeter{}, nil
+	})
+	container.RegisterNamed(c, "tr", func(c *container.Container) (greeter, error) {
+		return turkishGreeter{}, nil
+	})
+	container.Register(c, func(c *container.Container) (*authService, error) {
+		return &authService{cache: container.MustResolve[greeter](c)}, nil
+	})
+	container.Register(c, func(c *container.Container) (*reportService, error) {
+		return &reportService{cache: container.MustResolve[greeter](c)}, nil
+	})
+
+	container.WhenNeeds[*authService, greeter](c, "tr")
+
+	if got := container.MustResolve[*authService](c).cache.Greet("Ada"); got != "Merhaba, Ada" {
+		t.Errorf("authService should get the contextual binding, got %q", got)
+	}
+	if got := container.MustResolve[*reportService](c).cache.Greet("Ada"); got != "Hello, Ada" {
+		t.Errorf("reportService should get the default binding, got %q", got)
+	}
+	if got := container.MustResolve[greeter](c).Greet("Ada"); got != "Hello, Ada" {
+		t.Errorf("application code should get the default binding, got %q", got)
+	}
+
+	// Eksik isimli kayıt tüketicinin hatası olarak raporlanır
+	c.When(container.TypeOf[*reportService]()).Needs(container.TypeOf[greeter]()).Give("de")
+	container.Register(c, func(c *container.Container) (*reportService, error) {
+		g, err := container.Resolve[greeter](c)
+		return &reportService{cache: g}, err
+	})
+	if _, err := container.Resolve[*reportService](c); err == nil || !strings.Contains(err.Error(), `"de"`) {
+		t.Errorf("missing contextual binding should fail, got %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+// -----------------------------------------------------------------------------
+// Cookie Tests
+// -----------------------------------------------------------------------------
+// response.Cookie varsayılanlarını ve EncryptCookies middleware'inin
+// şifreleme, çözme ve tamper tespitini test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/pkg/crypt"
+)
+
+func TestCookieDefaults(t *testing.T) {
+	defer middleware.SetSecurityProfile(middleware.DevelopmentSecurityProfile())
+
+	w := httptest.NewRecorder()
+	response.Cookie(w, "locale", "tr", time.Hour)
+	cookie := w.Result().Cookies()[0]
+	if cookie.Path != "/" || !cookie.HttpOnly || cookie.Secure || cookie.MaxAge != 3600 {
+		t.Errorf("development cookie = %+v", cookie)
+	}
+
+	// Production profili Secure ve SameSite'ı cookie helper'larına da uygular
+	profile := middleware.DevelopmentSecurityProfile()
+	profile.CookieSecure = true
+	profile.CookieSameSite = http.SameSiteStrictMode
+	middleware.SetSecurityProfile(profile)
+
+	cookie = response.NewCookie("locale", "tr", 0)
+	if !cookie.Secure || cookie.SameSite != http.SameSiteStrictMode || cookie.MaxAge != 0 {
+		t.Errorf("production cookie = %+v", cookie)
+	}
+
+	w = httptest.NewRecorder()
+	response.ForgetCookie(w, "locale")
+	if forgotten := w.Result().Cookies()[0]; forgotten.MaxAge != -1 || forgotten.Value != "" {
+		t.Errorf("ForgetCookie = %+v", forgotten)
+	}
+}
+
+func newCookieEncrypter(t *testing.T) *crypt.Encrypter {
+	t.Helper()
+	key, err := crypt.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypter, err := crypt.NewFromAppKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encrypter
+}
+
+func TestEncryptCookies(t *testing.T) {
+	encrypter := newCookieEncrypter(t)
+
+	var seen map[string]string
+	handler := middleware.EncryptCookies(encrypter, "theme")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request.New(r)
+		seen = map[string]string{
+			"cart":  req.CookieValue("cart", "-"),
+			"theme": req.CookieValue("theme", "-"),
+			"other": req.CookieValue("other", "-"),
+		}
+		response.Cookie(w, "cart", "item-1,item-2", time.Hour)
+		response.Cookie(w, "theme", "dark", time.Hour)
+		response.Cookie(w, middleware.CSRFCookieName, "token", time.Hour)
+		response.ForgetCookie(w, "old")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+
+	cookies := make(map[string]*http.Cookie)
+	for _, cookie := range w.Result().Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	if cart := cookies["cart"]; cart == nil || cart.Value == "item-1,item-2" || cart.MaxAge != 3600 || !cart.HttpOnly {
+		t.Fatalf("cart cookie should be encrypted keeping attributes, got %+v", cart)
+	}
+	if cookies["theme"].Value != "dark" || cookies[middleware.CSRFCookieName].Value != "token" {
+		t.Error("excepted and CSRF cookies should not be encrypted")
+	}
+	if cookies["old"].Value != "" {
+		t.Error("deletion cookie should stay empty")
+	}
+
+	// Şifreli değer geri gönderildiğinde handler düz değeri görür
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "cart", Value: cookies["cart"].Value})
+	req.AddCookie(&http.Cookie{Name: "theme", Value: "dark"})
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if seen["cart"] != "item-1,item-2" || seen["theme"] != "dark" {
+		t.Errorf("decrypted cookies = %v", seen)
+	}
+
+	// Değiştirilmiş, başka cookie'den kopyalanmış veya şifresiz değerler atılır
+	encrypted := cookies["cart"].Value
+	tampered := encrypted[:len(encrypted)-2] + "AA"
+	if tampered == encrypted {
+		tampered = encrypted[:len(encrypted)-2] + "BB"
+	}
+	req = httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "cart", Value: tampered})
+	req.AddCookie(&http.Cookie{Name: "other", Value: encrypted})
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if seen["cart"] != "-" || seen["other"] != "-" {
+		t.Errorf("tampered cookies should be dropped, got %v", seen)
+	}
+}
+
+func TestEncryptCookiesFlush(t *testing.T) {
+	handler := middleware.EncryptCookies(newCookieEncrypter(t))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response.Cookie(w, "stream", "secret", 0)
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Fatal(err)
+		}
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	if header := w.Header().Get("Set-Cookie"); strings.Contains(header, "secret") || !w.Flushed {
+		t.Errorf("cookie should be encrypted before flush, Set-Cookie: %q", header)
+	}
+}
@@ -0,0 +1,104 @@
+// -----------------------------------------------------------------------------
+// Crypt Tests
+// -----------------------------------------------------------------------------
+// APP_KEY ayrıştırmasını ve AES-GCM şifreleme/çözme davranışını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/pkg/crypt"
+)
+
+func TestCryptKeys(t *testing.T) {
+	key, err := crypt.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey error: %v", err)
+	}
+	if !strings.HasPrefix(key, crypt.KeyPrefix) {
+		t.Errorf("key should have %s prefix: %s", crypt.KeyPrefix, key)
+	}
+
+	raw, err := crypt.ParseKey(key)
+	if err != nil || len(raw) != crypt.KeySize {
+		t.Errorf("ParseKey = %d bytes (err: %v)", len(raw), err)
+	}
+
+	if other, _ := crypt.GenerateKey(); other == key {
+		t.Error("generated keys should be random")
+	}
+
+	if _, err := crypt.ParseKey(""); !errors.Is(err, crypt.ErrMissingKey) {
+		t.Errorf("empty key should return ErrMissingKey: %v", err)
+	}
+	if _, err := crypt.ParseKey("base64:not-base64!"); err == nil {
+		t.Error("invalid base64 should be rejected")
+	}
+	if _, err := crypt.ParseKey("short"); err == nil {
+		t.Error("invalid key length should be rejected")
+	}
+	if _, err := crypt.ParseKey("0123456789abcdef0123456789abcdef"); err != nil {
+		t.Errorf("raw 32-byte key should be accepted: %v", err)
+	}
+}
+
+func TestCryptEncrypter(t *testing.T) {
+	key, _ := crypt.GenerateKey()
+	encrypter, err := crypt.NewFromAppKey(key)
+	if err != nil {
+		t.Fatalf("NewFromAppKey error: %v", err)
+	}
+
+	t.Run("string round trip", func(t *testing.T) {
+		token, err := encrypter.EncryptString("secret value")
+		if err != nil {
+			t.Fatalf("EncryptString error: %v", err)
+		}
+		if strings.Contains(token, "secret") {
+			t.Errorf("token should not contain plaintext: %s", token)
+		}
+
+		again, _ := encrypter.EncryptString("secret value")
+		if again == token {
+			t.Error("each encryption should use a fresh nonce")
+		}
+
+		plain, err := encrypter.DecryptString(token)
+		if err != nil || plain != "secret value" {
+			t.Errorf("DecryptString = %q (err: %v)", plain, err)
+		}
+	})
+
+	t.Run("additional data is bound", func(t *testing.T) {
+		sealed, _ := encrypter.Encrypt([]byte("value"), []byte("cookie:a"))
+		if _, err := encrypter.Decrypt(sealed, []byte("cookie:b")); !errors.Is(err, crypt.ErrInvalidPayload) {
+			t.Errorf("different additional data should fail: %v", err)
+		}
+		if plain, err := encrypter.Decrypt(sealed, []byte("cookie:a")); err != nil || string(plain) != "value" {
+			t.Errorf("Decrypt = %q (err: %v)", plain, err)
+		}
+	})
+
+	t.Run("rejects tampered and foreign values", func(t *testing.T) {
+		token, _ := encrypter.EncryptString("value")
+
+		otherKey, _ := crypt.GenerateKey()
+		other, _ := crypt.NewFromAppKey(otherKey)
+		if _, err := other.DecryptString(token); !errors.Is(err, crypt.ErrInvalidPayload) {
+			t.Errorf("wrong key should fail: %v", err)
+		}
+
+		tampered := []byte(token)
+		tampered[len(tampered)-1] ^= 1
+		if _, err := encrypter.DecryptString(string(tampered)); err == nil {
+			t.Error("tampered token should fail")
+		}
+		if _, err := encrypter.DecryptString("x"); !errors.Is(err, crypt.ErrInvalidPayload) {
+			t.Errorf("short token should fail: %v", err)
+		}
+	})
+}
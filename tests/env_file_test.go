@@ -0,0 +1,172 @@
+// -----------------------------------------------------------------------------
+// Env File Tests
+// -----------------------------------------------------------------------------
+// .env okuma/güncellemenin yorumları, sırayı ve dosya izinlerini korumasını,
+// tırnaklı değerleri ve yedek oluşturmayı test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/config"
+)
+
+const sampleEnv = `# Application
+APP_NAME="Conduit App"
+APP_ENV=local # local, staging, production
+export CACHE_DRIVER=file
+
+DB_PASSWORD='p@ss$word'
+MAIL_FROM_NAME="Say \"hi\"" # quoted
+EMPTY=
+`
+
+func writeEnvFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(content), 0640); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestEnvFileGet(t *testing.T) {
+	env, err := config.ReadEnvFile(writeEnvFile(t, sampleEnv))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"APP_NAME":       "Conduit App",
+		"APP_ENV":        "local",
+		"CACHE_DRIVER":   "file",
+		"DB_PASSWORD":    "p@ss$word",
+		"MAIL_FROM_NAME": `Say "hi"`,
+		"EMPTY":          "",
+	}
+	for key, want := range tests {
+		got, found := env.Get(key)
+		if !found || got != want {
+			t.Errorf("Get(%s) = %q, %v; want %q", key, got, found, want)
+		}
+	}
+
+	if _, found := env.Get("MISSING"); found {
+		t.Error("Get(MISSING) should not be found")
+	}
+
+	wantKeys := []string{"APP_NAME", "APP_ENV", "CACHE_DRIVER", "DB_PASSWORD", "MAIL_FROM_NAME", "EMPTY"}
+	if keys := env.Keys(); !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("Keys() = %v, want %v", keys, wantKeys)
+	}
+}
+
+func TestEnvFileSetPreservesLayout(t *testing.T) {
+	path := writeEnvFile(t, sampleEnv)
+	env, err := config.ReadEnvFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	must(env.Set("APP_ENV", "production"))
+	must(env.Set("CACHE_DRIVER", "redis"))
+	must(env.Set("MAIL_FROM_NAME", "Conduit Team"))
+	must(env.Set("JWT_SECRET", "a$b"))
+	must(env.Set("MOTD", "it's\nfine"))
+	must(env.Save(true))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `# Application
+APP_NAME="Conduit App"
+APP_ENV=production # local, staging, production
+export CACHE_DRIVER=redis
+
+DB_PASSWORD='p@ss$word'
+MAIL_FROM_NAME='Conduit Team' # quoted
+EMPTY=
+JWT_SECRET='a$b'
+MOTD="it's\nfine"
+`
+	if string(data) != want {
+		t.Errorf("saved file =\n%s\nwant\n%s", data, want)
+	}
+
+	// Yazılan değerler aynen geri okunur
+	reread, err := config.ReadEnvFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range map[string]string{"JWT_SECRET": "a$b", "MOTD": "it's\nfine", "MAIL_FROM_NAME": "Conduit Team"} {
+		if got, _ := reread.Get(key); got != value {
+			t.Errorf("round trip %s = %q, want %q", key, got, value)
+		}
+	}
+}
+
+func TestEnvFileSaveBackupAndPermissions(t *testing.T) {
+	path := writeEnvFile(t, "APP_ENV=local\n")
+	env, err := config.ReadEnvFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	env.Set("APP_ENV", "production")
+	if err := env.Save(true); err != nil {
+		t.Fatal(err)
+	}
+
+	backup, err := os.ReadFile(path + config.EnvBackupSuffix)
+	if err != nil {
+		t.Fatalf("backup not created: %v", err)
+	}
+	if string(backup) != "APP_ENV=local\n" {
+		t.Errorf("backup = %q", backup)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0640 {
+		t.Errorf("mode = %v, want 0640", info.Mode().Perm())
+	}
+
+	// Geçici dosya kalmaz
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 2 {
+		t.Errorf("expected .env and .env.backup only, got %d entries", len(entries))
+	}
+}
+
+func TestEnvFileErrors(t *testing.T) {
+	_, err := config.ReadEnvFile(filepath.Join(t.TempDir(), ".env"))
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadEnvFile(missing) error = %v, want fs.ErrNotExist", err)
+	}
+
+	env, err := config.ReadEnvFile(writeEnvFile(t, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"", "1ABC", "APP-ENV", "APP ENV"} {
+		if err := env.Set(key, "x"); err == nil {
+			t.Errorf("Set(%q) should fail", key)
+		}
+	}
+}
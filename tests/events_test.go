@@ -0,0 +1,747 @@
+// -----------------------------------------------------------------------------
+// Event Tests
+// -----------------------------------------------------------------------------
+// Event subscriber'larını, container üzerinden otomatik kaydını,
+// EventServiceProvider eşlemesini, QueryBuilder'ın model event'lerini,
+// event broadcasting'i, event store/replay'i ve commit sonrası dispatch'i
+// test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/controllers"
+	"github.com/biyonik/conduit-go/internal/models"
+	"github.com/biyonik/conduit-go/internal/router"
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/broadcast"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/events"
+)
+
+// authEventSubscriber, login/logout event'lerini tek yerde dinler.
+type authEventSubscriber struct {
+	handled []string
+}
+
+func (s *authEventSubscriber) Subscribe(d *events.Dispatcher) {
+	d.Listen(events.EventUserLoggedIn, events.ListenerFunc(s.record))
+	d.Listen(events.EventUserLoggedOut, events.ListenerFunc(s.record))
+}
+
+func (s *authEventSubscriber) record(e events.Event) error {
+	s.handled = append(s.handled, e.Name())
+	return nil
+}
+
+// auditSubscriber, ikinci bir subscriber'dır.
+type auditSubscriber struct {
+	handled int
+}
+
+func (s *auditSubscriber) Subscribe(d *events.Dispatcher) {
+	d.Listen(events.EventUserLoggedIn, events.ListenerFunc(func(e events.Event) error {
+		s.handled++
+		return nil
+	}))
+}
+
+func TestEventSubscribers(t *testing.T) {
+	dispatcher := events.NewDispatcher(log.New(io.Discard, "", 0))
+	defer dispatcher.Shutdown()
+
+	c := container.New()
+	c.Register(func(c *container.Container) (*authEventSubscriber, error) {
+		return &authEventSubscriber{}, nil
+	})
+	c.Register(func(c *container.Container) (*log.Logger, error) {
+		return log.New(io.Discard, "", 0), nil
+	})
+	c.Register(func(c *container.Container) (*auditSubscriber, error) {
+		return &auditSubscriber{}, nil
+	})
+
+	subscribers, err := c.Implementing(reflect.TypeOf((*events.Subscriber)(nil)).Elem())
+	if err != nil {
+		t.Fatalf("Implementing hatası: %v", err)
+	}
+	if len(subscribers) != 2 {
+		t.Fatalf("Sadece subscriber'lar çözülmeli, %d servis döndü", len(subscribers))
+	}
+	if _, ok := subscribers[0].(*authEventSubscriber); !ok {
+		t.Errorf("Servisler kayıt sırasıyla dönmeli, ilk: %T", subscribers[0])
+	}
+
+	if err := container.RegisterSubscribers(c, dispatcher); err != nil {
+		t.Fatalf("RegisterSubscribers hatası: %v", err)
+	}
+
+	dispatcher.Dispatch(events.NewUserLoggedInEvent(nil))
+	dispatcher.Dispatch(events.NewBaseEvent(events.EventUserLoggedOut, nil))
+
+	auth := c.MustGet(reflect.TypeOf((*authEventSubscriber)(nil))).(*authEventSubscriber)
+	audit := c.MustGet(reflect.TypeOf((*auditSubscriber)(nil))).(*auditSubscriber)
+	if strings.Join(auth.handled, ",") != "user.logged.in,user.logged.out" {
+		t.Errorf("Subscriber'ın tüm listener'ları kaydedilmeli: %v", auth.handled)
+	}
+	if audit.handled != 1 {
+		t.Errorf("İkinci subscriber da kaydedilmeli, %d çağrı", audit.handled)
+	}
+	if dispatcher.GetListeners(events.EventUserLoggedIn) != 2 {
+		t.Errorf("Login event'inde 2 listener olmalı, %d var", dispatcher.GetListeners(events.EventUserLoggedIn))
+	}
+
+	if _, err := c.Implementing(reflect.TypeOf((*authEventSubscriber)(nil))); err == nil {
+		t.Error("Interface olmayan tip için hata dönmeli")
+	}
+}
+
+// fakeExecResult, sabit değerler döndüren sql.Result'tır.
+type fakeExecResult struct {
+	lastID   int64
+	affected int64
+}
+
+func (r fakeExecResult) LastInsertId() (int64, error) { return r.lastID, nil }
+func (r fakeExecResult) RowsAffected() (int64, error) { return r.affected, nil }
+
+// fakeExecutor, Exec çağrılarını DB'ye gitmeden yanıtlayan QueryExecutor'dır.
+type fakeExecutor struct {
+	result sql.Result
+	err    error
+}
+
+func (f *fakeExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return f.result, f.err
+}
+
+func (f *fakeExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func (f *fakeExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+// recordingListener, container'dan çözülen ve çağrıları kaydeden listener'dır.
+type recordingListener struct {
+	handled *[]string
+}
+
+func (l *recordingListener) Handle(e events.Event) error {
+	*l.handled = append(*l.handled, "recording:"+e.Name())
+	return nil
+}
+
+// queuedAuditListener, eşlemede queued olarak raporlanan listener'dır.
+type queuedAuditListener struct {
+	events.Queued
+}
+
+func (l *queuedAuditListener) Handle(e events.Event) error { return nil }
+
+func TestEventServiceProvider(t *testing.T) {
+	var handled []string
+	c := container.New()
+	c.Register(func(c *container.Container) (*recordingListener, error) {
+		return &recordingListener{handled: &handled}, nil
+	})
+
+	provider := &events.EventServiceProvider{
+		Listen: map[string][]events.Listener{
+			events.EventUserRegistered: {
+				(*recordingListener)(nil),
+				events.ListenerFunc(func(e events.Event) error {
+					handled = append(handled, "func:"+e.Name())
+					return nil
+				}),
+			},
+			events.EventUserLoggedIn: {
+				(*queuedAuditListener)(nil),
+			},
+		},
+	}
+
+	mappings := provider.Mappings()
+	if len(mappings) != 2 || mappings[0].Event != events.EventUserLoggedIn || mappings[1].Event != events.EventUserRegistered {
+		t.Fatalf("Eşleme event adına göre sıralı olmalı: %+v", mappings)
+	}
+	if info := mappings[0].Listeners[0]; info.Type != "*tests.queuedAuditListener" || !info.Queued {
+		t.Errorf("Queued listener tipiyle raporlanmalı: %+v", info)
+	}
+	if info := mappings[1].Listeners[0]; info.Type != "*tests.recordingListener" || info.Queued {
+		t.Errorf("Listener tipi yanlış raporlandı: %+v", info)
+	}
+
+	// queuedAuditListener container'da yok: hiçbir listener kaydedilmemeli
+	dispatcher := events.NewDispatcher(log.New(io.Discard, "", 0))
+	err := container.RegisterEventProvider(c, dispatcher, provider)
+	if err == nil || !strings.Contains(err.Error(), "queuedAuditListener") {
+		t.Fatalf("Çözülemeyen listener hata vermeli: %v", err)
+	}
+	if dispatcher.HasListeners(events.EventUserRegistered) {
+		t.Error("Hata durumunda listener kaydedilmemeli")
+	}
+
+	c.Register(func(c *container.Container) (*queuedAuditListener, error) {
+		return &queuedAuditListener{}, nil
+	})
+	if err := container.RegisterEventProvider(c, dispatcher, provider); err != nil {
+		t.Fatalf("Provider boot edilemedi: %v", err)
+	}
+
+	dispatcher.Dispatch(events.NewUserRegisteredEvent(nil))
+	if strings.Join(handled, ",") != "recording:user.registered,func:user.registered" {
+		t.Errorf("Listener'lar eşlemedeki sırayla çalışmalı: %v", handled)
+	}
+	if dispatcher.GetListeners(events.EventUserLoggedIn) != 1 {
+		t.Error("Login listener'ı kaydedilmeli")
+	}
+}
+
+func TestModelEvents(t *testing.T) {
+	dispatcher := events.NewDispatcher(log.New(io.Discard, "", 0))
+	defer dispatcher.Shutdown()
+
+	var fired []string
+	var payloads []*database.ModelEvent
+	for _, name := range []string{database.EventModelCreated, database.EventModelUpdated, database.EventModelDeleted} {
+		dispatcher.Listen(name, events.ListenerFunc(func(e events.Event) error {
+			fired = append(fired, e.Name())
+			payloads = append(payloads, e.Payload().(*database.ModelEvent))
+			return nil
+		}))
+	}
+
+	database.SetEventDispatcher(dispatcher)
+	defer database.SetEventDispatcher(nil)
+
+	executor := &fakeExecutor{result: fakeExecResult{lastID: 42, affected: 1}}
+	builder := func() *database.QueryBuilder {
+		return database.NewBuilder(executor, database.NewMySQLGrammar()).Table("users")
+	}
+
+	if _, err := builder().ExecInsert(map[string]interface{}{"email": "a@example.com"}); err != nil {
+		t.Fatalf("Insert hatası: %v", err)
+	}
+	if _, err := builder().Where("users.id", "=", int64(7)).ExecUpdate(map[string]interface{}{"name": "Ali"}); err != nil {
+		t.Fatalf("Update hatası: %v", err)
+	}
+	if _, err := builder().Where("id", "=", int64(7)).ExecDelete(); err != nil {
+		t.Fatalf("Delete hatası: %v", err)
+	}
+	if _, err := builder().Where("id", "=", int64(1)).OrWhere("id", "=", int64(2)).ExecDelete(); err != nil {
+		t.Fatalf("Delete hatası: %v", err)
+	}
+
+	want := "model.created,model.updated,model.deleted,model.deleted"
+	if strings.Join(fired, ",") != want {
+		t.Fatalf("Event'ler %s olmalı, %v yayınlandı", want, fired)
+	}
+
+	created, updated, deleted, bulk := payloads[0], payloads[1], payloads[2], payloads[3]
+	if created.Table != "users" || created.Key != int64(42) || created.Data["email"] != "a@example.com" {
+		t.Errorf("model.created tablo, LastInsertId ve veri taşımalı: %+v", created)
+	}
+	if updated.Key != int64(7) || updated.Data["name"] != "Ali" || updated.RowsAffected != 1 {
+		t.Errorf("model.updated id koşulundan key almalı: %+v", updated)
+	}
+	if deleted.Key != int64(7) || deleted.Data != nil {
+		t.Errorf("model.deleted id koşulundan key almalı: %+v", deleted)
+	}
+	if bulk.Key != nil {
+		t.Errorf("OR içeren toplu silmede key nil olmalı: %+v", bulk)
+	}
+
+	// Başarısız sorgu event yayınlamaz
+	fired = nil
+	executor.err = errors.New("db down")
+	builder().ExecInsert(map[string]interface{}{"email": "b@example.com"})
+	if len(fired) != 0 {
+		t.Errorf("Başarısız yazma event yayınlamamalı: %v", fired)
+	}
+}
+
+// orderShippedEvent, kullanıcının private kanalına yayınlanan event'tir.
+type orderShippedEvent struct {
+	*events.BaseEvent
+	userID  int64
+	orderID int
+}
+
+func newOrderShippedEvent(userID int64, orderID int) *orderShippedEvent {
+	return &orderShippedEvent{
+		BaseEvent: events.NewBaseEvent("order.shipped", nil),
+		userID:    userID,
+		orderID:   orderID,
+	}
+}
+
+func (e *orderShippedEvent) BroadcastOn() []string {
+	return []string{fmt.Sprintf("private-users.%d", e.userID), "orders"}
+}
+
+func (e *orderShippedEvent) BroadcastAs() string { return "OrderShipped" }
+
+func (e *orderShippedEvent) BroadcastWith() interface{} {
+	return map[string]int{"order_id": e.orderID}
+}
+
+func TestBroadcasting(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	owner, other := &models.User{}, &models.User{}
+	owner.ID, other.ID = 42, 7
+
+	newHub := func() *broadcast.Hub {
+		hub := broadcast.NewHub(logger)
+		hub.Channel("private-users.{id}", func(user auth.User, params map[string]string) bool {
+			return strconv.FormatInt(user.GetID(), 10) == params["id"]
+		})
+		return hub
+	}
+
+	t.Run("private kanal yetkilendirmesi", func(t *testing.T) {
+		hub := newHub()
+
+		if err := hub.Authorize(nil, "orders"); err != nil {
+			t.Errorf("Public kanal herkese açık olmalı: %v", err)
+		}
+		if err := hub.Authorize(owner, "private-users.42"); err != nil {
+			t.Errorf("Kanal sahibi abone olabilmeli: %v", err)
+		}
+		for name, user := range map[string]auth.User{"guest": nil, "başka kullanıcı": other} {
+			if err := hub.Authorize(user, "private-users.42"); !errors.Is(err, broadcast.ErrUnauthorized) {
+				t.Errorf("%s private kanala abone olamamalı: %v", name, err)
+			}
+		}
+		if err := hub.Authorize(owner, "private-admin"); !errors.Is(err, broadcast.ErrUnauthorized) {
+			t.Errorf("Tanımsız private kanal reddedilmeli: %v", err)
+		}
+	})
+
+	t.Run("dispatcher yayını abonelere iletir", func(t *testing.T) {
+		hub := newHub()
+		dispatcher := events.NewDispatcher(logger)
+		dispatcher.SetBroadcaster(hub)
+
+		sub := hub.Subscribe("private-users.42")
+		defer sub.Close()
+
+		// Listener olmasa da yayınlanır
+		if err := dispatcher.Dispatch(newOrderShippedEvent(42, 1001)); err != nil {
+			t.Fatalf("Dispatch hatası: %v", err)
+		}
+
+		select {
+		case message := <-sub.Messages():
+			data, _ := message.Data.(map[string]int)
+			if message.Channel != "private-users.42" || message.Event != "OrderShipped" || data["order_id"] != 1001 {
+				t.Errorf("Beklenmeyen mesaj: %+v", message)
+			}
+		default:
+			t.Fatal("Abone mesaj almadı")
+		}
+
+		// Halt edilen event yayınlanmaz
+		dispatcher.Listen("order.shipped", events.ListenerFunc(func(events.Event) error {
+			return events.Halt(errors.New("iptal"))
+		}))
+		dispatcher.Dispatch(newOrderShippedEvent(42, 1002))
+		select {
+		case message := <-sub.Messages():
+			t.Errorf("Halt edilen event yayınlanmamalı: %+v", message)
+		default:
+		}
+
+		sub.Close()
+		if hub.Subscribers("private-users.42") != 0 {
+			t.Error("Kapatılan abonelik hub'dan silinmeli")
+		}
+	})
+
+	t.Run("yavaş abone yayını bloklamaz", func(t *testing.T) {
+		hub := newHub().SetBuffer(1)
+		sub := hub.Subscribe("orders")
+		defer sub.Close()
+
+		if delivered := hub.Publish(broadcast.Message{Channel: "orders", Event: "a"}); delivered != 1 {
+			t.Errorf("İlk mesaj iletilmeli, iletilen: %d", delivered)
+		}
+		if delivered := hub.Publish(broadcast.Message{Channel: "orders", Event: "b"}); delivered != 0 {
+			t.Errorf("Buffer dolunca mesaj düşürülmeli, iletilen: %d", delivered)
+		}
+	})
+
+	t.Run("SSE endpoint", func(t *testing.T) {
+		hub := newHub()
+		controller := &controllers.BroadcastController{Logger: logger, Hub: hub}
+
+		r := router.New()
+		r.GET("/broadcasting/events", controller.Events)
+
+		call := func(path string, user auth.User) int {
+			req := httptest.NewRequest("GET", path, nil)
+			if user != nil {
+				req = req.WithContext(context.WithValue(req.Context(), "user", user))
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w.Code
+		}
+
+		if code := call("/broadcasting/events", nil); code != http.StatusBadRequest {
+			t.Errorf("Kanal olmadan 400 beklenirdi, alınan: %d", code)
+		}
+		if code := call("/broadcasting/events?channels=private-users.42", nil); code != http.StatusUnauthorized {
+			t.Errorf("Guest private kanal için 401 beklenirdi, alınan: %d", code)
+		}
+		if code := call("/broadcasting/events?channels=orders,private-users.42", other); code != http.StatusForbidden {
+			t.Errorf("Yetkisiz kullanıcı için 403 beklenirdi, alınan: %d", code)
+		}
+
+		server := httptest.NewServer(r)
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/broadcasting/events?channels=orders", nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("SSE bağlantısı kurulamadı: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			t.Fatalf("Content-Type text/event-stream olmalı: %q", ct)
+		}
+
+		reader := bufio.NewReader(resp.Body)
+		if line, _ := reader.ReadString('\n'); !strings.HasPrefix(line, ": subscribed orders") {
+			t.Fatalf("Abonelik yorumu beklenirdi: %q", line)
+		}
+		reader.ReadString('\n')
+
+		hub.Broadcast([]string{"orders"}, "OrderShipped", map[string]int{"order_id": 7})
+
+		event, _ := reader.ReadString('\n')
+		data, _ := reader.ReadString('\n')
+		if event != "event: OrderShipped\n" {
+			t.Errorf("Event satırı yanlış: %q", event)
+		}
+
+		var body struct {
+			Channel string         `json:"channel"`
+			Data    map[string]int `json:"data"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &body); err != nil {
+			t.Fatalf("Data satırı parse edilemedi: %v (%q)", err, data)
+		}
+		if body.Channel != "orders" || body.Data["order_id"] != 7 {
+			t.Errorf("Beklenmeyen mesaj gövdesi: %+v", body)
+		}
+
+		// Hub kapanınca stream sonlanır
+		hub.Close()
+		if _, err := io.ReadAll(reader); err != nil {
+			t.Errorf("Stream temiz kapanmalı: %v", err)
+		}
+	})
+}
+
+func TestEventStore(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+
+	type orderPayload struct {
+		OrderID int `json:"order_id"`
+	}
+
+	t.Run("pattern'e uyan event'ler kaydedilir", func(t *testing.T) {
+		store := events.NewMemoryStore()
+		dispatcher := events.NewDispatcher(logger)
+		dispatcher.SetStore(store, "order.*")
+
+		dispatcher.Dispatch(events.NewBaseEvent("order.placed", orderPayload{OrderID: 1}))
+		dispatcher.Dispatch(events.NewBaseEvent("user.registered", nil))
+		dispatcher.Dispatch(events.NewBaseEvent("order.cancelled", orderPayload{OrderID: 1}))
+
+		stored, err := store.Range(events.StoreQuery{})
+		if err != nil {
+			t.Fatalf("Range hatası: %v", err)
+		}
+		if len(stored) != 2 || stored[0].Name != "order.placed" || stored[1].Name != "order.cancelled" {
+			t.Fatalf("Sadece order.* event'leri kaydedilmeli: %+v", stored)
+		}
+		if string(stored[0].Payload) != `{"order_id":1}` || stored[0].OccurredAt.IsZero() {
+			t.Errorf("Payload ve zaman kaydedilmeli: %+v", stored[0])
+		}
+	})
+
+	t.Run("replay aralığı tekrar dispatch eder", func(t *testing.T) {
+		store := events.NewMemoryStore()
+		hub := broadcast.NewHub(logger)
+		dispatcher := events.NewDispatcher(logger)
+		dispatcher.SetStore(store)
+		dispatcher.SetBroadcaster(hub)
+
+		for i := 1; i <= 5; i++ {
+			dispatcher.Dispatch(events.NewBaseEvent("order.placed", orderPayload{OrderID: i}))
+		}
+		dispatcher.Dispatch(newOrderShippedEvent(42, 5))
+
+		var replayed []int
+		dispatcher.Listen("order.placed", events.ListenerFunc(func(e events.Event) error {
+			if !events.IsReplay(e) {
+				t.Error("Replay edilen event IsReplay olmalı")
+			}
+			var payload orderPayload
+			if err := events.DecodePayload(e, &payload); err != nil {
+				return err
+			}
+			replayed = append(replayed, payload.OrderID)
+			if payload.OrderID == 3 {
+				return errors.New("projection hatası")
+			}
+			return nil
+		}))
+
+		sub := hub.Subscribe("orders")
+		defer sub.Close()
+
+		result, err := dispatcher.Replay(context.Background(), store, events.StoreQuery{FromID: 2})
+		if err != nil {
+			t.Fatalf("Replay hatası: %v", err)
+		}
+		if fmt.Sprint(replayed) != "[2 3 4 5]" {
+			t.Errorf("Beklenen sıra [2 3 4 5], alınan: %v", replayed)
+		}
+		if result.Replayed != 5 || result.Failed != 1 || result.LastID != 6 {
+			t.Errorf("Beklenmeyen sonuç: %+v", result)
+		}
+
+		// Replay edilen event'ler tekrar kaydedilmez ve yayınlanmaz
+		if stored, _ := store.Range(events.StoreQuery{}); len(stored) != 6 {
+			t.Errorf("Replay store'a yazmamalı, kayıt sayısı: %d", len(stored))
+		}
+		select {
+		case message := <-sub.Messages():
+			t.Errorf("Replay edilen event yayınlanmamalı: %+v", message)
+		default:
+		}
+	})
+
+	t.Run("limit ve batch", func(t *testing.T) {
+		store := events.NewMemoryStore()
+		for i := 0; i < events.DefaultReplayBatch+10; i++ {
+			store.Append(events.NewBaseEvent("metric.recorded", nil))
+		}
+
+		dispatcher := events.NewDispatcher(logger)
+		result, err := dispatcher.Replay(context.Background(), store, events.StoreQuery{})
+		if err != nil || result.Replayed != events.DefaultReplayBatch+10 {
+			t.Errorf("Tüm batch'ler oynatılmalı: %+v, %v", result, err)
+		}
+
+		result, err = dispatcher.Replay(context.Background(), store, events.StoreQuery{FromID: 10, Limit: 3})
+		if err != nil || result.Replayed != 3 || result.LastID != 12 {
+			t.Errorf("Limit uygulanmalı: %+v, %v", result, err)
+		}
+	})
+
+	t.Run("iptal edilen context replay'i durdurur", func(t *testing.T) {
+		store := events.NewMemoryStore()
+		for i := 0; i < 3; i++ {
+			store.Append(events.NewBaseEvent("order.placed", nil))
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		dispatcher := events.NewDispatcher(logger)
+		dispatcher.Listen("order.placed", events.ListenerFunc(func(events.Event) error {
+			cancel()
+			return nil
+		}))
+
+		result, err := dispatcher.Replay(ctx, store, events.StoreQuery{})
+		if !errors.Is(err, context.Canceled) || result.Replayed != 1 {
+			t.Errorf("İlk event'ten sonra durmalı: %+v, %v", result, err)
+		}
+	})
+
+	t.Run("admin endpoint'leri", func(t *testing.T) {
+		store := events.NewMemoryStore()
+		dispatcher := events.NewDispatcher(logger)
+		dispatcher.SetStore(store)
+		for i := 1; i <= 3; i++ {
+			dispatcher.Dispatch(events.NewBaseEvent("order.placed", orderPayload{OrderID: i}))
+		}
+
+		controller := &controllers.EventStoreController{Logger: logger, Store: store, Dispatcher: dispatcher}
+		r := router.New()
+		r.GET("/api/admin/events", controller.Index)
+		r.POST("/api/admin/events/replay", controller.Replay)
+
+		call := func(method, path, body string) (int, map[string]interface{}) {
+			req := httptest.NewRequest(method, path, strings.NewReader(body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			var response map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &response)
+			return w.Code, response
+		}
+
+		code, response := call("GET", "/api/admin/events?event=order.placed&limit=2", "")
+		data, _ := response["data"].([]interface{})
+		meta, _ := response["meta"].(map[string]interface{})
+		if code != http.StatusOK || len(data) != 2 || meta["next_from_id"] != float64(3) {
+			t.Errorf("Liste sayfalanmalı: %d %v", code, response)
+		}
+		if code, _ := call("GET", "/api/admin/events?since=dün", ""); code != http.StatusBadRequest {
+			t.Errorf("Geçersiz zaman için 400 beklenirdi, alınan: %d", code)
+		}
+
+		if code, _ := call("POST", "/api/admin/events/replay", `{}`); code != http.StatusBadRequest {
+			t.Errorf("Filtresiz replay için 400 beklenirdi, alınan: %d", code)
+		}
+
+		var replayed int
+		dispatcher.Listen("order.placed", events.ListenerFunc(func(events.Event) error {
+			replayed++
+			return nil
+		}))
+
+		if code, _ := call("POST", "/api/admin/events/replay", `{"from_id": 2, "dry_run": true}`); code != http.StatusOK || replayed != 0 {
+			t.Errorf("Dry run dispatch etmemeli: %d, %d", code, replayed)
+		}
+
+		code, response = call("POST", "/api/admin/events/replay", `{"from_id": 2}`)
+		result, _ := response["data"].(map[string]interface{})
+		if code != http.StatusOK || replayed != 2 || result["last_id"] != float64(3) {
+			t.Errorf("Replay from_id'den itibaren oynatmalı: %d, %d, %v", code, replayed, response)
+		}
+	})
+}
+
+// txTestDriver, gerçek veritabanı olmadan transaction açan sql driver'ıdır.
+// Her Exec bir satır etkiler; commit ve rollback her zaman başarılıdır.
+type txTestDriver struct{}
+
+func (txTestDriver) Open(string) (driver.Conn, error) { return txTestConn{}, nil }
+
+type txTestConn struct{}
+
+func (txTestConn) Prepare(string) (driver.Stmt, error) { return txTestStmt{}, nil }
+func (txTestConn) Close() error                        { return nil }
+func (txTestConn) Begin() (driver.Tx, error)           { return txTestTx{}, nil }
+
+type txTestStmt struct{}
+
+func (txTestStmt) Close() error                               { return nil }
+func (txTestStmt) NumInput() int                              { return -1 }
+func (txTestStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(1), nil }
+func (txTestStmt) Query([]driver.Value) (driver.Rows, error)  { return nil, driver.ErrSkip }
+
+type txTestTx struct{}
+
+func (txTestTx) Commit() error   { return nil }
+func (txTestTx) Rollback() error { return nil }
+
+var registerTxTestDriver sync.Once
+
+func TestDispatchAfterCommit(t *testing.T) {
+	registerTxTestDriver.Do(func() { sql.Register("conduit-tx-test", txTestDriver{}) })
+	db, err := sql.Open("conduit-tx-test", "")
+	if err != nil {
+		t.Fatalf("sql.Open hatası: %v", err)
+	}
+	defer db.Close()
+	grammar := database.NewMySQLGrammar()
+
+	dispatcher := events.NewDispatcher(log.New(io.Discard, "", 0))
+	var fired []string
+	record := events.ListenerFunc(func(e events.Event) error {
+		fired = append(fired, e.Name())
+		return nil
+	})
+	dispatcher.Listen("user.registered", record)
+	dispatcher.Listen(database.EventModelCreated, record)
+
+	database.SetEventDispatcher(dispatcher)
+	defer database.SetEventDispatcher(nil)
+
+	insert := func(tx *database.Transaction) {
+		if _, err := tx.NewBuilder().Table("users").ExecInsert(map[string]interface{}{"email": "a@example.com"}); err != nil {
+			t.Fatalf("Insert hatası: %v", err)
+		}
+		dispatcher.DispatchAfterCommit(tx, events.NewBaseEvent("user.registered", nil))
+	}
+
+	t.Run("commit sonrası dispatch edilir", func(t *testing.T) {
+		fired = nil
+		tx, err := database.BeginTransaction(db, grammar)
+		if err != nil {
+			t.Fatalf("Transaction başlatılamadı: %v", err)
+		}
+
+		insert(tx)
+		if len(fired) != 0 {
+			t.Fatalf("Commit'ten önce event dispatch edilmemeli: %v", fired)
+		}
+
+		if err := tx.Commit(); err != nil {
+			t.Fatalf("Commit hatası: %v", err)
+		}
+		if strings.Join(fired, ",") != "model.created,user.registered" {
+			t.Errorf("Event'ler eklenme sırasıyla dispatch edilmeli: %v", fired)
+		}
+	})
+
+	t.Run("rollback'te atılır", func(t *testing.T) {
+		fired = nil
+		tx, err := database.BeginTransaction(db, grammar)
+		if err != nil {
+			t.Fatalf("Transaction başlatılamadı: %v", err)
+		}
+
+		insert(tx)
+		if err := tx.Rollback(); err != nil {
+			t.Fatalf("Rollback hatası: %v", err)
+		}
+		if len(fired) != 0 {
+			t.Errorf("Geri alınan transaction'ın event'leri dispatch edilmemeli: %v", fired)
+		}
+
+		// Sonlanmış transaction'a tekrar commit callback'leri çalıştırmaz
+		tx.Commit()
+		if len(fired) != 0 {
+			t.Errorf("Atılan event'ler sonradan dispatch edilmemeli: %v", fired)
+		}
+	})
+
+	t.Run("transaction yoksa hemen dispatch edilir", func(t *testing.T) {
+		fired = nil
+		if err := dispatcher.DispatchAfterCommit(nil, events.NewBaseEvent("user.registered", nil)); err != nil {
+			t.Fatalf("Dispatch hatası: %v", err)
+		}
+		if len(fired) != 1 {
+			t.Errorf("Event hemen dispatch edilmeli: %v", fired)
+		}
+	})
+}
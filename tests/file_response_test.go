@@ -0,0 +1,104 @@
+// -----------------------------------------------------------------------------
+// File Response Tests
+// -----------------------------------------------------------------------------
+// response.Download, File ve DownloadReader'ın Content-Disposition, MIME
+// tespiti, Range desteği ve hata yanıtlarını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/http/response"
+)
+
+func TestContentDisposition(t *testing.T) {
+	tests := map[string]string{
+		"report.csv": `attachment; filename="report.csv"`,
+		`a"b.txt`:    `attachment; filename="a_b.txt"; filename*=UTF-8''a%22b.txt`,
+		"özet 1.csv": `attachment; filename="_zet 1.csv"; filename*=UTF-8''%C3%B6zet%201.csv`,
+	}
+	for filename, want := range tests {
+		if got := response.ContentDisposition("attachment", filename); got != want {
+			t.Errorf("ContentDisposition(%q) = %q, want %q", filename, got, want)
+		}
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.csv")
+	if err := os.WriteFile(path, []byte("id,name\n1,Ada\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	if err := response.Download(w, httptest.NewRequest("GET", "/export", nil), path, "users.csv"); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusOK || w.Body.String() != "id,name\n1,Ada\n" {
+		t.Errorf("download = %d %q", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="users.csv"` {
+		t.Errorf("Content-Disposition = %q", got)
+	}
+	if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/csv") {
+		t.Errorf("Content-Type = %q", got)
+	}
+
+	// Range: indirme kaldığı yerden devam eder
+	req := httptest.NewRequest("GET", "/export", nil)
+	req.Header.Set("Range", "bytes=8-")
+	w = httptest.NewRecorder()
+	response.File(w, req, path)
+	if w.Code != http.StatusPartialContent || w.Body.String() != "1,Ada\n" {
+		t.Errorf("range = %d %q", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Disposition"); got != `inline; filename="export.csv"` {
+		t.Errorf("inline Content-Disposition = %q", got)
+	}
+}
+
+func TestDownloadMissingFile(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := response.Download(w, httptest.NewRequest("GET", "/", nil), filepath.Join(t.TempDir(), "missing.pdf"), "")
+	if err == nil || w.Code != http.StatusNotFound || w.Header().Get("Content-Disposition") != "" {
+		t.Errorf("missing file = %v, %d, %q", err, w.Code, w.Header().Get("Content-Disposition"))
+	}
+
+	w = httptest.NewRecorder()
+	if err := response.File(w, httptest.NewRequest("GET", "/", nil), t.TempDir()); err == nil || w.Code != http.StatusNotFound {
+		t.Errorf("directory = %v, %d", err, w.Code)
+	}
+}
+
+func TestDownloadReader(t *testing.T) {
+	// ReadSeeker: Range desteklenir
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Range", "bytes=0-4")
+	w := httptest.NewRecorder()
+	response.DownloadReader(w, req, bytes.NewReader([]byte("hello world")), "greeting.txt")
+	if w.Code != http.StatusPartialContent || w.Body.String() != "hello" {
+		t.Errorf("seeker range = %d %q", w.Code, w.Body)
+	}
+
+	// Düz reader: stream edilir, tip içerikten tespit edilir
+	w = httptest.NewRecorder()
+	pdf := io.MultiReader(strings.NewReader("%PDF-1.4\n"), strings.NewReader("..."))
+	if err := response.DownloadReader(w, req, pdf, "rapor"); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusOK || w.Body.String() != "%PDF-1.4\n..." {
+		t.Errorf("stream = %d %q", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/pdf" {
+		t.Errorf("sniffed Content-Type = %q", got)
+	}
+}
@@ -0,0 +1,195 @@
+// -----------------------------------------------------------------------------
+// Foundation Tests
+// -----------------------------------------------------------------------------
+// Service provider yaşam döngüsünü (Register → Boot → Terminate) test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/foundation"
+)
+
+// recordingProvider, aşamaları ortak bir log'a yazan test provider'ıdır.
+type recordingProvider struct {
+	name         string
+	log          *[]string
+	registerErr  error
+	bootErr      error
+	terminateErr error
+}
+
+func (p *recordingProvider) Register(c *container.Container) error {
+	*p.log = append(*p.log, p.name+".register")
+	return p.registerErr
+}
+
+func (p *recordingProvider) Boot(c *container.Container) error {
+	*p.log = append(*p.log, p.name+".boot")
+	return p.bootErr
+}
+
+func (p *recordingProvider) Terminate(ctx context.Context, c *container.Container) error {
+	*p.log = append(*p.log, p.name+".terminate")
+	return p.terminateErr
+}
+
+// greeterProvider, greeter'ı kaydeder; BaseProvider'ın Boot'unu kullanır.
+type greeterProvider struct {
+	foundation.BaseProvider
+}
+
+func (greeterProvider) Register(c *container.Container) error {
+	container.Register(c, func(c *container.Container) (greeter, error) {
+		return &englishGreeter{}, nil
+	})
+	return nil
+}
+
+// greetingProvider, başka bir provider'ın kaydettiği servisi boot'ta kullanır.
+type greetingProvider struct {
+	foundation.BaseProvider
+	greeting string
+}
+
+func (p *greetingProvider) Boot(c *container.Container) error {
+	p.greeting = container.MustResolve[greeter](c).Greet("Ada")
+	return nil
+}
+
+func TestApplicationBootOrder(t *testing.T) {
+	var calls []string
+	app := foundation.New(container.New(),
+		&recordingProvider{name: "a", log: &calls},
+		&recordingProvider{name: "b", log: &calls},
+	)
+
+	if app.Booted() {
+		t.Fatal("app should not be booted before Boot")
+	}
+	if err := app.Boot(); err != nil {
+		t.Fatalf("Boot error: %v", err)
+	}
+	if !app.Booted() {
+		t.Error("app should be booted after Boot")
+	}
+
+	// Tüm Register'lar Boot'lardan önce çalışır
+	want := "a.register b.register a.boot b.boot"
+	if got := strings.Join(calls, " "); got != want {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+
+	// İkinci Boot hiçbir şey yapmaz
+	if err := app.Boot(); err != nil {
+		t.Fatalf("second Boot error: %v", err)
+	}
+	if len(calls) != 4 {
+		t.Errorf("second Boot should be a no-op, calls = %v", calls)
+	}
+}
+
+func TestApplicationBootResolvesAcrossProviders(t *testing.T) {
+	greeting := &greetingProvider{}
+
+	// greetingProvider önce listelense de greeter Boot'tan önce kaydedilir
+	app := foundation.New(container.New(), greeting, greeterProvider{})
+	if err := app.Boot(); err != nil {
+		t.Fatalf("Boot error: %v", err)
+	}
+	if greeting.greeting != "Hello, Ada" {
+		t.Errorf("greeting = %q", greeting.greeting)
+	}
+	if app.Container() == nil || len(app.Providers()) != 2 {
+		t.Error("Container and Providers should expose the app state")
+	}
+}
+
+func TestApplicationBootErrors(t *testing.T) {
+	registerErr := errors.New("register failed")
+
+	var calls []string
+	app := foundation.New(container.New(),
+		&recordingProvider{name: "a", log: &calls, registerErr: registerErr},
+		&recordingProvider{name: "b", log: &calls},
+	)
+
+	err := app.Boot()
+	if !errors.Is(err, registerErr) {
+		t.Fatalf("Boot error = %v, want %v", err, registerErr)
+	}
+	if !strings.Contains(err.Error(), "recordingProvider") {
+		t.Errorf("error should name the provider type: %v", err)
+	}
+	if app.Booted() {
+		t.Error("failed Boot should not mark the app as booted")
+	}
+	if strings.Join(calls, " ") != "a.register" {
+		t.Errorf("Boot should stop at the first error, calls = %v", calls)
+	}
+
+	bootErr := errors.New("boot failed")
+	app = foundation.New(container.New(), &recordingProvider{name: "c", log: &calls, bootErr: bootErr})
+	if err := app.Boot(); !errors.Is(err, bootErr) {
+		t.Errorf("Boot error = %v, want %v", err, bootErr)
+	}
+}
+
+func TestApplicationRegisterAfterBoot(t *testing.T) {
+	var calls []string
+	app := foundation.New(container.New())
+
+	// Boot'tan önce eklenen provider Boot'u bekler
+	if err := app.Register(&recordingProvider{name: "a", log: &calls}); err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("provider should not run before Boot, calls = %v", calls)
+	}
+	if err := app.Boot(); err != nil {
+		t.Fatalf("Boot error: %v", err)
+	}
+
+	// Boot'tan sonra eklenen provider hemen çalışır
+	if err := app.Register(&recordingProvider{name: "b", log: &calls}); err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	want := "a.register a.boot b.register b.boot"
+	if got := strings.Join(calls, " "); got != want {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
+
+func TestApplicationTerminate(t *testing.T) {
+	errA := errors.New("a failed")
+	errC := errors.New("c failed")
+
+	var calls []string
+	app := foundation.New(container.New(),
+		&recordingProvider{name: "a", log: &calls, terminateErr: errA},
+		greeterProvider{}, // Terminator değil, atlanır
+		&recordingProvider{name: "b", log: &calls},
+		&recordingProvider{name: "c", log: &calls, terminateErr: errC},
+	)
+	if err := app.Boot(); err != nil {
+		t.Fatalf("Boot error: %v", err)
+	}
+	calls = nil
+
+	err := app.Terminate(context.Background())
+
+	// Ters sırayla; bir hata diğerlerinin kapanmasını engellemez
+	want := "c.terminate b.terminate a.terminate"
+	if got := strings.Join(calls, " "); got != want {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+	if !errors.Is(err, errA) || !errors.Is(err, errC) {
+		t.Errorf("Terminate should join all errors, got %v", err)
+	}
+}
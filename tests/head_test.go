@@ -0,0 +1,126 @@
+// -----------------------------------------------------------------------------
+// Automatic HEAD Tests
+// -----------------------------------------------------------------------------
+// GET route'larının HEAD isteklerine gövdesiz ve doğru Content-Length ile
+// yanıt verdiğini test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/router"
+)
+
+func TestHeadRunsGetHandler(t *testing.T) {
+	r := router.New()
+	calls := 0
+	r.GET("/users/{id}", func(w http.ResponseWriter, req *conduitReq.Request) {
+		calls++
+		w.Header().Set("X-User", req.RouteParam("id"))
+		response.Success(w, http.StatusOK, map[string]string{"name": "Ada"}, "")
+	})
+
+	get := httptest.NewRecorder()
+	r.ServeHTTP(get, httptest.NewRequest("GET", "/users/7", nil))
+
+	head := httptest.NewRecorder()
+	r.ServeHTTP(head, httptest.NewRequest("HEAD", "/users/7", nil))
+
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+	if head.Code != http.StatusOK {
+		t.Fatalf("HEAD status = %d, want 200", head.Code)
+	}
+	if head.Body.Len() != 0 {
+		t.Errorf("HEAD body = %q, want empty", head.Body.String())
+	}
+	if got := head.Header().Get("X-User"); got != "7" {
+		t.Errorf("X-User = %q, want 7", got)
+	}
+	if got, want := head.Header().Get("Content-Length"), strconv.Itoa(get.Body.Len()); got != want {
+		t.Errorf("Content-Length = %q, want %q", got, want)
+	}
+	if head.Header().Get("Content-Type") != get.Header().Get("Content-Type") {
+		t.Errorf("Content-Type = %q, want %q", head.Header().Get("Content-Type"), get.Header().Get("Content-Type"))
+	}
+}
+
+func TestHeadKeepsStatusAndExplicitLength(t *testing.T) {
+	r := router.New()
+	r.GET("/empty", func(w http.ResponseWriter, req *conduitReq.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	r.GET("/sized", func(w http.ResponseWriter, req *conduitReq.Request) {
+		w.Header().Set("Content-Length", "5")
+		w.Write([]byte("hello"))
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("HEAD", "/empty", nil))
+	if w.Code != http.StatusNoContent || w.Header().Get("Content-Length") != "" {
+		t.Errorf("204 HEAD = %d, Content-Length %q", w.Code, w.Header().Get("Content-Length"))
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("HEAD", "/sized", nil))
+	if w.Header().Get("Content-Length") != "5" || w.Body.Len() != 0 {
+		t.Errorf("sized HEAD = Content-Length %q, body %q", w.Header().Get("Content-Length"), w.Body.String())
+	}
+}
+
+func TestHeadFileDownload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.txt")
+	if err := os.WriteFile(path, []byte("quarterly numbers"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := router.New()
+	r.GET("/report", func(w http.ResponseWriter, req *conduitReq.Request) {
+		response.Download(w, req.Request, path, "")
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	res, err := http.Head(srv.URL + "/report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+
+	if res.StatusCode != http.StatusOK || len(body) != 0 {
+		t.Fatalf("HEAD = %d, body %q", res.StatusCode, body)
+	}
+	if res.ContentLength != int64(len("quarterly numbers")) {
+		t.Errorf("ContentLength = %d, want %d", res.ContentLength, len("quarterly numbers"))
+	}
+}
+
+func TestHeadUnknownRouteAndAllow(t *testing.T) {
+	r := router.New()
+	r.POST("/orders", func(w http.ResponseWriter, req *conduitReq.Request) {})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("HEAD", "/missing", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("HEAD /missing = %d, want 404", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("HEAD", "/orders", nil))
+	if w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != "POST" {
+		t.Errorf("HEAD /orders = %d, Allow %q", w.Code, w.Header().Get("Allow"))
+	}
+}
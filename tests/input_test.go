@@ -0,0 +1,101 @@
+// -----------------------------------------------------------------------------
+// Request Input Tests
+// -----------------------------------------------------------------------------
+// r.All, r.Input, r.Only ve r.Except'in route parametreleri, query, form ve
+// JSON gövdeyi birleştirmesini test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/router"
+)
+
+func TestRequestInputJSON(t *testing.T) {
+	var all, only, except map[string]any
+	var city, sku, missing any
+	var parsed struct {
+		Name string `json:"name"`
+	}
+
+	r := router.New()
+	r.PUT("/users/{id}", func(w http.ResponseWriter, req *conduitReq.Request) {
+		all = req.All()
+		city = req.Input("address.city", "-")
+		sku = req.Input("items.1.sku", "-")
+		missing = req.Input("address.zip", "00000")
+		only = req.Only("name", "role")
+		except = req.Except("id", "address", "items", "notify")
+
+		// Gövde cache'lendikten sonra da okunabilir
+		if err := req.ParseJSON(&parsed); err != nil {
+			t.Errorf("ParseJSON after All: %v", err)
+		}
+	})
+
+	body := `{"id": 99, "name": "Ada", "address": {"city": "İzmir"}, "items": [{"sku": "A"}, {"sku": "B"}]}`
+	req := httptest.NewRequest("PUT", "/users/42?notify=1&tag=a&tag=b", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Route parametresi gövdedeki id'yi ezer
+	if all["id"] != "42" || all["notify"] != "1" || all["name"] != "Ada" {
+		t.Errorf("All = %v", all)
+	}
+	if !reflect.DeepEqual(all["tag"], []any{"a", "b"}) {
+		t.Errorf("multi-value query = %#v", all["tag"])
+	}
+	if city != "İzmir" || sku != "B" || missing != "00000" {
+		t.Errorf("dot input = %v, %v, %v", city, sku, missing)
+	}
+	if !reflect.DeepEqual(only, map[string]any{"name": "Ada"}) {
+		t.Errorf("Only = %v", only)
+	}
+	if !reflect.DeepEqual(except, map[string]any{"name": "Ada", "tag": []any{"a", "b"}}) {
+		t.Errorf("Except = %v", except)
+	}
+	if parsed.Name != "Ada" {
+		t.Errorf("ParseJSON name = %q", parsed.Name)
+	}
+}
+
+func TestRequestInputForm(t *testing.T) {
+	req := httptest.NewRequest("POST", "/contact?source=footer&name=query", strings.NewReader("name=Ada&topics=a&topics=b"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r := conduitReq.New(req)
+
+	// Form gövdesi query'yi ezer
+	if r.Input("name", "") != "Ada" || r.Input("source", "") != "footer" {
+		t.Errorf("form input = %v", r.All())
+	}
+	if !reflect.DeepEqual(r.Input("topics", nil), []any{"a", "b"}) {
+		t.Errorf("topics = %#v", r.Input("topics", nil))
+	}
+
+	// Dönen map kopyadır
+	all := r.All()
+	all["name"] = "changed"
+	if r.Input("name", "") != "Ada" {
+		t.Error("All should return a copy")
+	}
+}
+
+func TestRequestInputInvalidJSON(t *testing.T) {
+	req := httptest.NewRequest("POST", "/?page=2", strings.NewReader(`[1, 2`))
+	req.Header.Set("Content-Type", "application/json")
+	r := conduitReq.New(req)
+
+	if got := r.All(); !reflect.DeepEqual(got, map[string]any{"page": "2"}) {
+		t.Errorf("invalid JSON body should be ignored, got %v", got)
+	}
+	if _, err := r.ParseJSONMap(); err == nil {
+		t.Error("ParseJSONMap should still report the invalid body")
+	}
+}
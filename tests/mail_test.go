@@ -0,0 +1,884 @@
+// -----------------------------------------------------------------------------
+// Mail Tests
+// -----------------------------------------------------------------------------
+// Sahte bir SMTP sunucusu üzerinden gönderim, bağlantı havuzu, AUTH ve
+// şifreleme modlarını; email şablonlarını, kuyruk üzerinden gönderimi ve
+// HTTP API driver'larını (SES, Mailgun, Postmark), log/array driver'larını,
+// mailfake assertion'larını, mailable'ları ve development mail önizlemesini
+// test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/biyonik/conduit-go/internal/controllers"
+	"github.com/biyonik/conduit-go/internal/mails"
+	"github.com/biyonik/conduit-go/internal/router"
+	"github.com/biyonik/conduit-go/pkg/mail"
+	"github.com/biyonik/conduit-go/pkg/mail/mailfake"
+	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/resilience"
+	"github.com/biyonik/conduit-go/resources/views"
+)
+
+// fakeSMTPServer, testler için minimal bir SMTP sunucusudur.
+type fakeSMTPServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	startTLS  bool // EHLO'da STARTTLS duyurulsun mu
+
+	mu          sync.Mutex
+	connections int
+	resets      int
+	auth        string
+	messages    []string
+}
+
+func newFakeSMTPServer(t *testing.T, implicitTLS, startTLS bool) (*fakeSMTPServer, *x509.CertPool) {
+	t.Helper()
+
+	// httptest'in self-signed sertifikası 127.0.0.1 için geçerlidir
+	certServer := httptest.NewTLSServer(nil)
+	t.Cleanup(certServer.Close)
+	pool := x509.NewCertPool()
+	pool.AddCert(certServer.Certificate())
+	tlsConfig := &tls.Config{Certificates: certServer.TLS.Certificates}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listener açılamadı: %v", err)
+	}
+	if implicitTLS {
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+
+	s := &fakeSMTPServer{listener: ln, tlsConfig: tlsConfig, startTLS: startTLS}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.connections++
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+
+	return s, pool
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+	reply("220 fake ESMTP")
+
+	upgraded := false
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch verb {
+		case "EHLO":
+			reply("250-fake")
+			if s.startTLS && !upgraded {
+				reply("250-STARTTLS")
+			}
+			reply("250 AUTH PLAIN")
+		case "STARTTLS":
+			reply("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, reader, upgraded = tlsConn, bufio.NewReader(tlsConn), true
+		case "AUTH":
+			decoded, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH PLAIN "))
+			s.mu.Lock()
+			s.auth = string(decoded)
+			s.mu.Unlock()
+			reply("235 Authentication successful")
+		case "RSET":
+			s.mu.Lock()
+			s.resets++
+			s.mu.Unlock()
+			reply("250 OK")
+		case "MAIL", "NOOP":
+			reply("250 OK")
+		case "RCPT":
+			if strings.Contains(line, "rejected@") {
+				reply("550 No such user")
+				continue
+			}
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, data.String())
+			s.mu.Unlock()
+			reply("250 Queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) stats() (connections, resets int, messages []string, auth string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connections, s.resets, append([]string(nil), s.messages...), s.auth
+}
+
+func newTestSMTPMailer(config *mail.SMTPConfig) *mail.SMTPMailer {
+	// Testlerde retry/backoff beklenmesin
+	resilience.Register("smtp-test", resilience.Options{Timeout: 5 * time.Second})
+	config.Policy = "smtp-test"
+	config.Timeout = 5 * time.Second
+	return mail.NewSMTPMailer(config, log.New(io.Discard, "", 0))
+}
+
+func testMailMessage(to string) *mail.Message {
+	return mail.NewMessage().
+		To(to, "Test User").
+		Subject("Şifre Sıfırlama").
+		Body("Merhaba")
+}
+
+func TestSMTPMailerReusesConnection(t *testing.T) {
+	server, _ := newFakeSMTPServer(t, false, false)
+
+	mailer := newTestSMTPMailer(&mail.SMTPConfig{
+		Host:       "127.0.0.1",
+		Port:       server.port(),
+		Encryption: mail.EncryptionNone,
+		From:       mail.Address{Email: "noreply@conduit.test", Name: "Conduit"},
+	})
+	defer mailer.Close()
+
+	for i := 0; i < 3; i++ {
+		if err := mailer.Send(testMailMessage("user" + strconv.Itoa(i) + "@example.com")); err != nil {
+			t.Fatalf("Gönderim %d başarısız: %v", i, err)
+		}
+	}
+
+	connections, resets, messages, _ := server.stats()
+	if connections != 1 {
+		t.Errorf("Bağlantı sayısı = %d, beklenen 1 (havuz)", connections)
+	}
+	if resets != 2 {
+		t.Errorf("RSET sayısı = %d, beklenen 2", resets)
+	}
+	if len(messages) != 3 {
+		t.Fatalf("Mesaj sayısı = %d, beklenen 3", len(messages))
+	}
+	if !strings.Contains(messages[0], "From: Conduit <noreply@conduit.test>") {
+		t.Errorf("Varsayılan gönderici eklenmedi:\n%s", messages[0])
+	}
+	if !strings.Contains(messages[2], "user2@example.com") {
+		t.Errorf("Mesaj içeriği yanlış:\n%s", messages[2])
+	}
+}
+
+func TestSMTPMailerPermanentErrorDropsConnection(t *testing.T) {
+	server, _ := newFakeSMTPServer(t, false, false)
+
+	mailer := newTestSMTPMailer(&mail.SMTPConfig{
+		Host:       "127.0.0.1",
+		Port:       server.port(),
+		Encryption: mail.EncryptionNone,
+		From:       mail.Address{Email: "noreply@conduit.test"},
+	})
+	defer mailer.Close()
+
+	if err := mailer.Send(testMailMessage("rejected@example.com")); err == nil {
+		t.Fatal("550 cevabı hata dönmeliydi")
+	}
+	if err := mailer.Send(testMailMessage("user@example.com")); err != nil {
+		t.Fatalf("Hatadan sonra yeni bağlantıyla gönderim başarılı olmalıydı: %v", err)
+	}
+
+	connections, _, messages, _ := server.stats()
+	if connections != 2 {
+		t.Errorf("Bağlantı sayısı = %d, beklenen 2 (hatalı bağlantı havuza dönmez)", connections)
+	}
+	if len(messages) != 1 {
+		t.Errorf("Mesaj sayısı = %d, beklenen 1", len(messages))
+	}
+}
+
+func TestSMTPMailerSTARTTLSAndAuth(t *testing.T) {
+	server, pool := newFakeSMTPServer(t, false, true)
+
+	mailer := newTestSMTPMailer(&mail.SMTPConfig{
+		Host:       "127.0.0.1",
+		Port:       server.port(),
+		Username:   "apikey",
+		Password:   "secret",
+		Encryption: mail.EncryptionSTARTTLS,
+		TLSConfig:  &tls.Config{RootCAs: pool},
+		From:       mail.Address{Email: "noreply@conduit.test"},
+	})
+	defer mailer.Close()
+
+	if err := mailer.Send(testMailMessage("user@example.com")); err != nil {
+		t.Fatalf("STARTTLS ile gönderim başarısız: %v", err)
+	}
+
+	_, _, messages, auth := server.stats()
+	if len(messages) != 1 {
+		t.Errorf("Mesaj sayısı = %d, beklenen 1", len(messages))
+	}
+	if auth != "\x00apikey\x00secret" {
+		t.Errorf("AUTH PLAIN bilgisi = %q", auth)
+	}
+}
+
+func TestSMTPMailerRequiresSTARTTLSSupport(t *testing.T) {
+	server, _ := newFakeSMTPServer(t, false, false)
+
+	mailer := newTestSMTPMailer(&mail.SMTPConfig{
+		Host:       "127.0.0.1",
+		Port:       server.port(),
+		Encryption: mail.EncryptionSTARTTLS,
+		From:       mail.Address{Email: "noreply@conduit.test"},
+	})
+	defer mailer.Close()
+
+	err := mailer.Send(testMailMessage("user@example.com"))
+	if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("STARTTLS desteklemeyen sunucu reddedilmeliydi: %v", err)
+	}
+}
+
+func TestSMTPMailerImplicitTLS(t *testing.T) {
+	server, pool := newFakeSMTPServer(t, true, false)
+
+	mailer := newTestSMTPMailer(&mail.SMTPConfig{
+		Host:       "127.0.0.1",
+		Port:       server.port(),
+		Encryption: mail.EncryptionTLS,
+		TLSConfig:  &tls.Config{RootCAs: pool},
+		From:       mail.Address{Email: "noreply@conduit.test"},
+	})
+	defer mailer.Close()
+
+	if err := mailer.Send(testMailMessage("user@example.com")); err != nil {
+		t.Fatalf("Implicit TLS ile gönderim başarısız: %v", err)
+	}
+	if _, _, messages, _ := server.stats(); len(messages) != 1 {
+		t.Errorf("Mesaj sayısı = %d, beklenen 1", len(messages))
+	}
+}
+
+func TestMailTemplateWithLayoutAndPartial(t *testing.T) {
+	previous := mail.GetTemplates()
+	defer mail.SetTemplates(previous)
+
+	mail.SetTemplates(mail.NewTemplates(views.FS).Share("app_name", "Conduit"))
+
+	message := mail.NewMessage().
+		From("noreply@conduit.test", "").
+		To("user@example.com", "John").
+		Template("emails/password-reset", map[string]any{
+			"Name":    "<John & Jane>",
+			"Link":    "https://app.test/reset-password?token=abc",
+			"Minutes": 60,
+		})
+
+	if err := message.Validate(); err != nil {
+		t.Fatalf("Şablon render edilemedi: %v", err)
+	}
+	if message.GetSubject() != "Şifre Sıfırlama" {
+		t.Errorf("Konu şablondan alınmadı: %q", message.GetSubject())
+	}
+
+	htmlBody := message.GetHtmlBody()
+	for _, want := range []string{"<title>Şifre Sıfırlama</title>", "Conduit", "&lt;John &amp; Jane&gt;", `href="https://app.test/reset-password?token=abc"`} {
+		if !strings.Contains(htmlBody, want) {
+			t.Errorf("HTML %q içermeli", want)
+		}
+	}
+
+	text := message.GetBody()
+	if !strings.Contains(text, "Merhaba <John & Jane>,") {
+		t.Errorf("Plain text HTML'den üretilmedi:\n%s", text)
+	}
+	if !strings.Contains(text, "Şifremi sıfırla (https://app.test/reset-password?token=abc)") {
+		t.Errorf("Plain text link korunmadı:\n%s", text)
+	}
+	if strings.Contains(text, "<p>") || strings.Contains(text, "<table") || strings.Contains(text, "<title") {
+		t.Errorf("Plain text HTML tag içermemeli:\n%s", text)
+	}
+}
+
+func TestMailTemplateTextOverrideAndErrors(t *testing.T) {
+	previous := mail.GetTemplates()
+	defer mail.SetTemplates(previous)
+
+	fsys := fstest.MapFS{
+		"emails/welcome.html": {Data: []byte(`{{define "subject"}}Hoş geldin {{.Name}}{{end}}<h1>Hoş geldin {{.Name}}</h1>`)},
+		"emails/welcome.txt":  {Data: []byte(`Selam {{.Name}}!`)},
+	}
+	mail.SetTemplates(mail.NewTemplates(fsys))
+
+	// Layout ve partial dizini yoksa view tek başına render edilir
+	message := mail.NewMessage().
+		Subject("Açık konu").
+		Template("emails/welcome", map[string]string{"Name": "O'Brien"})
+	if message.GetSubject() != "Açık konu" {
+		t.Errorf("Önceden ayarlanan konu korunmalı: %q", message.GetSubject())
+	}
+	if message.GetHtmlBody() != "<h1>Hoş geldin O&#39;Brien</h1>" {
+		t.Errorf("HTML = %q", message.GetHtmlBody())
+	}
+	if message.GetBody() != "Selam O'Brien!" {
+		t.Errorf("Text şablonu kullanılmadı: %q", message.GetBody())
+	}
+
+	rendered, err := mail.GetTemplates().Render("emails/welcome", map[string]string{"Name": "O'Brien"})
+	if err != nil || rendered.Subject != "Hoş geldin O'Brien" {
+		t.Errorf("Konu unescape edilmeli: %q (%v)", rendered.Subject, err)
+	}
+
+	missing := mail.NewMessage().
+		From("noreply@conduit.test", "").
+		To("user@example.com", "").
+		Template("emails/missing", nil)
+	if err := missing.Validate(); err == nil || !strings.Contains(err.Error(), "emails/missing") {
+		t.Errorf("Eksik şablon Validate'te hata vermeli: %v", err)
+	}
+
+	mail.SetTemplates(nil)
+	unconfigured := mail.NewMessage().Template("emails/welcome", nil)
+	if err := unconfigured.Validate(); !errors.Is(err, mail.ErrTemplatesNotConfigured) {
+		t.Errorf("Şablonlar ayarlanmadan hata dönmeli: %v", err)
+	}
+}
+
+func TestMailerQueue(t *testing.T) {
+	var logs strings.Builder
+	logMailer := mail.NewLogMailer(log.New(&logs, "", 0))
+
+	message := mail.NewMessage().
+		From("noreply@conduit.test", "Conduit").
+		To("user@example.com", "John").
+		Cc("cc@example.com", "").
+		Subject("Kuyruk testi").
+		Body("Merhaba").
+		Html("<p>Merhaba</p>").
+		Header("X-Campaign", "welcome").
+		Priority(mail.PriorityHigh)
+
+	if err := logMailer.Queue(message, ""); !errors.Is(err, mail.ErrQueueNotConfigured) {
+		t.Fatalf("Queue ayarlanmadan hata dönmeli: %v", err)
+	}
+
+	// Queue yokken SendAsync senkron gönderir
+	if err := logMailer.SendAsync(message); err != nil || !strings.Contains(logs.String(), "Kuyruk testi") {
+		t.Fatalf("SendAsync senkron göndermeli: %v", err)
+	}
+
+	q := newMemoryTestQueue()
+	logMailer.SetQueue(q, "")
+	if err := logMailer.Queue(message, "bulk"); err != nil {
+		t.Fatalf("Queue başarısız: %v", err)
+	}
+	if err := logMailer.SendAsync(message); err != nil {
+		t.Fatalf("SendAsync başarısız: %v", err)
+	}
+	if size, _ := q.Size("bulk"); size != 1 {
+		t.Errorf("bulk kuyruğu = %d job, beklenen 1", size)
+	}
+	if size, _ := q.Size(mail.DefaultMailQueue); size != 1 {
+		t.Errorf("SendAsync varsayılan kuyruğa eklemeli, boyut = %d", size)
+	}
+
+	// Worker tarafı: payload'dan yeniden oluşturulan job inject edilen
+	// mailer ile gönderir
+	queued, _ := q.Pop("bulk")
+	payload, err := queued.GetPayload()
+	if err != nil {
+		t.Fatalf("Payload alınamadı: %v", err)
+	}
+	if strings.Contains(string(payload), "Mailer") {
+		t.Errorf("Mailer serialize edilmemeli: %s", payload)
+	}
+
+	job, err := queue.JobRegistry.Create(queue.JobTypeName(queued))
+	if err != nil {
+		t.Fatalf("SendMessageJob kayıtlı olmalı: %v", err)
+	}
+	if err := job.SetPayload(payload); err != nil {
+		t.Fatalf("Payload çözülemedi: %v", err)
+	}
+
+	if err := job.Handle(); err == nil {
+		t.Error("Mailer inject edilmeden Handle hata dönmeli")
+	}
+
+	server, _ := newFakeSMTPServer(t, false, false)
+	smtpMailer := newTestSMTPMailer(&mail.SMTPConfig{Host: "127.0.0.1", Port: server.port(), Encryption: mail.EncryptionNone})
+	defer smtpMailer.Close()
+
+	job.(*mail.SendMessageJob).Mailer = smtpMailer
+	if err := job.Handle(); err != nil {
+		t.Fatalf("Kuyruktaki email gönderilemedi: %v", err)
+	}
+
+	_, _, messages, _ := server.stats()
+	if len(messages) != 1 {
+		t.Fatalf("Mesaj sayısı = %d, beklenen 1", len(messages))
+	}
+	for _, want := range []string{"From: Conduit <noreply@conduit.test>", "Cc: cc@example.com", "Subject: Kuyruk testi", "X-Campaign: welcome", "X-Priority: 5", "<p>Merhaba</p>"} {
+		if !strings.Contains(messages[0], want) {
+			t.Errorf("Gönderilen mesaj %q içermeli", want)
+		}
+	}
+}
+
+// apiTestPolicy, API driver testlerinde beklemeden retry yapan policy'dir.
+func apiTestPolicy() string {
+	resilience.Register("mail-api-test", resilience.Options{Timeout: 5 * time.Second, MaxRetries: 2, Backoff: time.Millisecond})
+	return "mail-api-test"
+}
+
+func TestMailAPIDriversSendProviderRequests(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests = map[string]*http.Request{}
+		bodies   = map[string][]byte{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests[r.URL.Path] = r
+		bodies[r.URL.Path] = body
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/v2/email/outbound-emails":
+			io.WriteString(w, `{"MessageId":"ses-123"}`)
+		case "/v3/mg.example.com/messages":
+			io.WriteString(w, `{"id":"<mg-123@mg.example.com>","message":"Queued. Thank you."}`)
+		case "/email":
+			io.WriteString(w, `{"MessageID":"pm-123","ErrorCode":0,"Message":"OK"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	from := mail.Address{Email: "noreply@conduit.test", Name: "Conduit"}
+	discard := log.New(io.Discard, "", 0)
+	mailers := map[string]mail.Mailer{
+		"/v2/email/outbound-emails":   mail.NewSESMailer(&mail.SESConfig{Region: "eu-central-1", AccessKeyID: "AKIATEST", SecretAccessKey: "secret", ConfigurationSet: "tracking", Endpoint: server.URL, From: from, Policy: apiTestPolicy()}, discard),
+		"/v3/mg.example.com/messages": mail.NewMailgunMailer(&mail.MailgunConfig{Domain: "mg.example.com", APIKey: "key-test", Endpoint: server.URL, From: from, Policy: apiTestPolicy()}, discard),
+		"/email":                      mail.NewPostmarkMailer(&mail.PostmarkConfig{Token: "pm-token", MessageStream: "outbound", Endpoint: server.URL, From: from, Policy: apiTestPolicy()}, discard),
+	}
+	providerIDs := map[string]string{
+		"/v2/email/outbound-emails":   "ses-123",
+		"/v3/mg.example.com/messages": "<mg-123@mg.example.com>",
+		"/email":                      "pm-123",
+	}
+
+	for path, mailer := range mailers {
+		message := mail.NewMessage().
+			ID("notification-42").
+			To("user@example.com", "John").
+			Bcc("audit@example.com", "").
+			Subject("Hoş geldiniz").
+			Body("Merhaba").
+			Html("<p>Merhaba</p>")
+
+		if err := mailer.Send(message); err != nil {
+			t.Fatalf("%s: gönderim başarısız: %v", path, err)
+		}
+		if message.GetProviderID() != providerIDs[path] {
+			t.Errorf("%s: provider ID = %q, beklenen %q", path, message.GetProviderID(), providerIDs[path])
+		}
+	}
+
+	// SES: SigV4 imzası, raw MIME ve message_id tag'i
+	ses := requests["/v2/email/outbound-emails"]
+	if auth := ses.Header.Get("Authorization"); !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIATEST/") || !strings.Contains(auth, "/eu-central-1/ses/aws4_request") {
+		t.Errorf("SES isteği imzalanmamış: %q", auth)
+	}
+	var sesBody struct {
+		Destination struct{ BccAddresses []string }
+		Content     struct{ Raw struct{ Data []byte } }
+		EmailTags   []struct{ Name, Value string }
+
+		ConfigurationSetName string
+	}
+	if err := json.Unmarshal(bodies["/v2/email/outbound-emails"], &sesBody); err != nil {
+		t.Fatalf("SES gövdesi çözülemedi: %v", err)
+	}
+	if raw := string(sesBody.Content.Raw.Data); !strings.Contains(raw, "Subject: Hoş geldiniz") || strings.Contains(raw, "audit@example.com") {
+		t.Errorf("SES raw MIME hatalı (Bcc header'da olmamalı): %s", raw)
+	}
+	if len(sesBody.Destination.BccAddresses) != 1 || sesBody.ConfigurationSetName != "tracking" {
+		t.Errorf("SES destination/configuration set hatalı: %+v", sesBody)
+	}
+	if len(sesBody.EmailTags) != 1 || sesBody.EmailTags[0].Value != "notification-42" {
+		t.Errorf("SES message_id tag'i eksik: %+v", sesBody.EmailTags)
+	}
+
+	// Mailgun: basic auth ve form alanları
+	mg := requests["/v3/mg.example.com/messages"]
+	if user, pass, ok := mg.BasicAuth(); !ok || user != "api" || pass != "key-test" {
+		t.Errorf("Mailgun basic auth hatalı: %s %s", user, pass)
+	}
+	mg.Body = io.NopCloser(strings.NewReader(string(bodies["/v3/mg.example.com/messages"])))
+	if err := mg.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatalf("Mailgun formu çözülemedi: %v", err)
+	}
+	for field, want := range map[string]string{"to": "John <user@example.com>", "bcc": "audit@example.com", "html": "<p>Merhaba</p>", "v:message_id": "notification-42"} {
+		if got := mg.FormValue(field); got != want {
+			t.Errorf("Mailgun %s = %q, beklenen %q", field, got, want)
+		}
+	}
+
+	// Postmark: token header'ı, stream ve metadata
+	pm := requests["/email"]
+	if pm.Header.Get("X-Postmark-Server-Token") != "pm-token" {
+		t.Error("Postmark token header'ı eksik")
+	}
+	var pmBody struct {
+		To, Bcc, MessageStream string
+		Metadata               map[string]string
+	}
+	json.Unmarshal(bodies["/email"], &pmBody)
+	if pmBody.To != "John <user@example.com>" || pmBody.Bcc != "audit@example.com" || pmBody.MessageStream != "outbound" || pmBody.Metadata["message_id"] != "notification-42" {
+		t.Errorf("Postmark gövdesi hatalı: %+v", pmBody)
+	}
+}
+
+func TestMailAPIDriverRetriesAndRateLimits(t *testing.T) {
+	var calls int
+	status := http.StatusTooManyRequests
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch {
+		case status == http.StatusTooManyRequests:
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(status)
+			io.WriteString(w, `{"ErrorCode":429,"Message":"Rate limit exceeded"}`)
+		case status == http.StatusUnprocessableEntity:
+			w.WriteHeader(status)
+			io.WriteString(w, `{"ErrorCode":300,"Message":"Invalid 'To' address"}`)
+		case calls == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			io.WriteString(w, `{"MessageID":"pm-456"}`)
+		}
+	}))
+	defer server.Close()
+
+	mailer := mail.NewPostmarkMailer(&mail.PostmarkConfig{
+		Token:    "pm-token",
+		Endpoint: server.URL,
+		From:     mail.Address{Email: "noreply@conduit.test"},
+		Policy:   apiTestPolicy(),
+	}, log.New(io.Discard, "", 0))
+
+	// 429: Retry-After beklenir, denemeler tükenince ErrRateLimited
+	started := time.Now()
+	err := mailer.Send(testMailMessage("user@example.com"))
+	if !errors.Is(err, mail.ErrRateLimited) || calls != 3 {
+		t.Fatalf("429 sonrası ErrRateLimited beklenirdi (deneme: %d): %v", calls, err)
+	}
+	if elapsed := time.Since(started); elapsed < 2*time.Second {
+		t.Errorf("Retry-After'a uyulmadı (süre: %s)", elapsed)
+	}
+	var apiErr *mail.APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != time.Second || apiErr.Message != "Rate limit exceeded" {
+		t.Errorf("APIError hatalı: %+v", apiErr)
+	}
+
+	// 4xx: retry edilmez
+	calls, status = 0, http.StatusUnprocessableEntity
+	if err := mailer.Send(testMailMessage("invalid")); err == nil || calls != 1 || !strings.Contains(err.Error(), "Invalid 'To' address") {
+		t.Errorf("422 retry edilmemeli (deneme: %d): %v", calls, err)
+	}
+
+	// 5xx: retry edilir
+	calls, status = 0, http.StatusOK
+	message := testMailMessage("user@example.com")
+	if err := mailer.Send(message); err != nil || calls != 2 || message.GetProviderID() != "pm-456" {
+		t.Errorf("503 sonrası retry ile gönderilmeliydi (deneme: %d): %v", calls, err)
+	}
+}
+
+// recordingTB, assertion'ların hata üretip üretmediğini yakalar.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestLogMailerRendersFullMessage(t *testing.T) {
+	var logs strings.Builder
+	mailer := mail.NewLogMailer(log.New(&logs, "", 0)).SetFrom("noreply@conduit.test", "Conduit")
+
+	message := mail.NewMessage().
+		ID("msg-1").
+		To("user@example.com", "John").
+		Bcc("audit@example.com", "").
+		ReplyTo("support@conduit.test", "").
+		Subject("Fatura").
+		Body("Merhaba").
+		Html("<p>Merhaba</p>").
+		Header("X-Campaign", "invoice")
+
+	if err := mailer.Send(message); err != nil {
+		t.Fatalf("Log mailer başarısız: %v", err)
+	}
+	for _, want := range []string{"Message-ID: msg-1", "From: Conduit <noreply@conduit.test>", "To: John <user@example.com>", "Bcc: audit@example.com", "Reply-To: support@conduit.test", "Subject: Fatura", "X-Campaign: invoice", "Merhaba", "<p>Merhaba</p>"} {
+		if !strings.Contains(logs.String(), want) {
+			t.Errorf("Log çıktısı %q içermeli:\n%s", want, logs.String())
+		}
+	}
+}
+
+func TestMailFakeAssertions(t *testing.T) {
+	fake := mailfake.New()
+	fake.SetFrom("noreply@conduit.test", "Conduit")
+
+	var mailer mail.Mailer = fake
+	if err := mailer.Send(testMailMessage("User@Example.com")); err != nil {
+		t.Fatalf("Fake gönderim başarısız: %v", err)
+	}
+	if err := mailer.Queue(testMailMessage("queued@example.com").Subject("Bülten"), "bulk"); err != nil {
+		t.Fatalf("Fake kuyruğa alma başarısız: %v", err)
+	}
+
+	// Geçersiz mesaj diğer driver'lar gibi reddedilir
+	if err := mailer.Send(mail.NewMessage().To("x@example.com", "")); err == nil {
+		t.Error("Konusuz mesaj reddedilmeli")
+	}
+
+	fake.AssertSentTo(t, "user@example.com")
+	fake.AssertSentCount(t, 1)
+	fake.AssertSent(t, mailfake.Subject("Şifre Sıfırlama"))
+	fake.AssertNotSentTo(t, "queued@example.com")
+	fake.AssertQueuedTo(t, "queued@example.com")
+	fake.AssertQueuedOn(t, "bulk")
+	fake.AssertQueued(t, mailfake.Subject("Bülten"))
+
+	if from := fake.Messages()[0].GetFrom(); from.Email != "noreply@conduit.test" {
+		t.Errorf("Varsayılan From uygulanmadı: %+v", from)
+	}
+
+	// Başarısız assertion'lar test hatası üretir
+	rec := &recordingTB{TB: t}
+	fake.AssertSentTo(rec, "other@example.com")
+	fake.AssertNothingSent(rec)
+	fake.AssertNothingQueued(rec)
+	fake.AssertNotSent(rec, mailfake.To("user@example.com"))
+	if len(rec.errors) != 4 {
+		t.Errorf("4 assertion hatası bekleniyordu, %d: %v", len(rec.errors), rec.errors)
+	}
+	if !strings.Contains(rec.errors[0], `subject: "Şifre Sıfırlama"`) {
+		t.Errorf("Hata mesajı gönderilenleri listelemeli: %s", rec.errors[0])
+	}
+
+	fake.Flush()
+	fake.AssertNothingSent(t)
+	fake.AssertNothingQueued(t)
+}
+
+// failingMailable, Build hatasını test eder.
+type failingMailable struct{}
+
+func (failingMailable) Build(message *mail.Message) error {
+	return errors.New("kullanıcı bulunamadı")
+}
+
+func TestMailables(t *testing.T) {
+	previous := mail.GetTemplates()
+	defer mail.SetTemplates(previous)
+	mail.SetTemplates(mail.NewTemplates(views.FS).Share("app_name", "Conduit").Share("app_url", "https://conduit.test"))
+
+	fake := mailfake.New()
+	fake.SetFrom("noreply@conduit.test", "Conduit")
+
+	if err := mail.Send(fake, &mails.WelcomeMail{Email: "user@example.com", Name: "Ayşe"}); err != nil {
+		t.Fatalf("Mailable gönderilemedi: %v", err)
+	}
+	fake.AssertSentTo(t, "user@example.com")
+	fake.AssertSent(t, func(m *mail.Message) bool {
+		return m.GetSubject() == "Conduit uygulamasına hoş geldiniz" &&
+			strings.Contains(m.GetHtmlBody(), "Merhaba Ayşe") &&
+			strings.Contains(m.GetHtmlBody(), `href="https://conduit.test"`)
+	})
+
+	reset := mail.Make(&mails.PasswordResetMail{Email: "user@example.com", Name: "Ayşe", Link: "https://conduit.test/reset-password?token=abc", TTL: time.Hour})
+	if err := mail.Queue(fake, &mails.MagicLinkMail{Email: "user@example.com", Link: "https://conduit.test/magic?token=x", TTL: 15 * time.Minute}, "auth"); err != nil {
+		t.Fatalf("Mailable kuyruğa alınamadı: %v", err)
+	}
+	fake.AssertQueuedOn(t, "auth")
+	if !strings.Contains(reset.GetBody(), "60") || !strings.Contains(reset.GetHtmlBody(), "token=abc") {
+		t.Errorf("Şifre sıfırlama mailable'ı şablon verisini aktarmadı: %s", reset.GetBody())
+	}
+
+	invite := mail.Make(&mails.AccountInvitationMail{Email: "user@example.com", Name: "Ayşe", Link: "https://conduit.test/reset-password?token=abc", TTL: 72 * time.Hour})
+	if !strings.Contains(invite.GetHtmlBody(), "72 saat") {
+		t.Errorf("Davet mailable'ı geçerlilik süresini göstermeli: %s", invite.GetHtmlBody())
+	}
+
+	// Build hatası Send/Queue'dan döner, mesaj gönderilmez
+	if err := mail.Send(fake, failingMailable{}); err == nil || !strings.Contains(err.Error(), "kullanıcı bulunamadı") {
+		t.Errorf("Build hatası dönmeliydi: %v", err)
+	}
+	if err := mail.Queue(fake, failingMailable{}, ""); err == nil {
+		t.Error("Build hatası kuyruğa almayı engellemeli")
+	}
+	fake.AssertSentCount(t, 1)
+}
+
+func TestLogMailerCaptureKeepsLatestMessages(t *testing.T) {
+	mailer := mail.NewLogMailer(log.New(io.Discard, "", 0)).SetFrom("noreply@conduit.test", "")
+
+	if err := mailer.Send(testMailMessage("first@example.com")); err != nil {
+		t.Fatalf("Gönderim başarısız: %v", err)
+	}
+	if got := len(mailer.Messages()); got != 0 {
+		t.Fatalf("Capture kapalıyken mesaj tutulmamalı, %d tutuldu", got)
+	}
+
+	mailer.Capture(2)
+	for _, to := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+		if err := mailer.Send(testMailMessage(to)); err != nil {
+			t.Fatalf("Gönderim başarısız: %v", err)
+		}
+	}
+
+	messages := mailer.Messages()
+	if len(messages) != 2 || messages[0].GetTo()[0].Email != "b@example.com" || messages[1].GetTo()[0].Email != "c@example.com" {
+		t.Fatalf("Son 2 mesaj tutulmalı, %d mesaj var", len(messages))
+	}
+
+	mailer.Flush()
+	if len(mailer.Messages()) != 0 {
+		t.Error("Flush sonrası mesaj kalmamalı")
+	}
+}
+
+func TestDevMailPreview(t *testing.T) {
+	mailer := mail.NewArrayMailer().SetFrom("noreply@conduit.test", "Conduit")
+	controller := &controllers.DevMailController{Logger: log.New(io.Discard, "", 0), Mailbox: mailer}
+
+	r := router.New()
+	r.GET("/dev/mail", controller.Index)
+	r.DELETE("/dev/mail", controller.Clear)
+	r.GET("/dev/mail/{id}/html", controller.HTML)
+	r.GET("/dev/mail/{id}/text", controller.Text)
+
+	call := func(method, path, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	sent := testMailMessage("user@example.com").ID("msg-sent").Subject("Hoş geldiniz").Html("<h1>Merhaba <script>alert(1)</script></h1>")
+	if err := mailer.Send(sent); err != nil {
+		t.Fatalf("Gönderim başarısız: %v", err)
+	}
+	if err := mailer.Queue(testMailMessage("queued@example.com").ID("msg-queued"), "emails"); err != nil {
+		t.Fatalf("Kuyruğa alma başarısız: %v", err)
+	}
+
+	var list struct {
+		Data []struct {
+			ID    string   `json:"id"`
+			To    []string `json:"to"`
+			Queue string   `json:"queue"`
+		} `json:"data"`
+	}
+	w := call("GET", "/dev/mail", "application/json")
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+		t.Fatalf("JSON liste parse edilemedi: %v (%s)", err, w.Body.String())
+	}
+	if len(list.Data) != 2 || list.Data[0].ID != "msg-queued" || list.Data[0].Queue != "emails" || list.Data[1].ID != "msg-sent" {
+		t.Fatalf("Liste en yeni mesaj üstte olacak şekilde gönderilen ve kuyruktaki mesajları içermeli: %s", w.Body.String())
+	}
+
+	w = call("GET", "/dev/mail?id=msg-sent", "text/html,*/*")
+	if w.Code != 200 || !strings.Contains(w.Body.String(), `src="/dev/mail/msg-sent/html"`) || !strings.Contains(w.Body.String(), "Hoş geldiniz") {
+		t.Fatalf("HTML sayfa seçili mesajın önizlemesini içermeli: %d\n%s", w.Code, w.Body.String())
+	}
+	if strings.Contains(w.Body.String(), "<script>alert") {
+		t.Error("Mesaj HTML'i liste sayfasına gömülmemeli")
+	}
+
+	w = call("GET", "/dev/mail/msg-sent/html", "text/html")
+	if w.Body.String() != sent.GetHtmlBody() || w.Header().Get("Content-Security-Policy") != "sandbox" {
+		t.Errorf("HTML önizleme sandbox ile mesaj gövdesini döndürmeli: %q %q", w.Header().Get("Content-Security-Policy"), w.Body.String())
+	}
+	if w = call("GET", "/dev/mail/msg-sent/text", "text/plain"); w.Body.String() != sent.GetBody() {
+		t.Errorf("Text önizleme düz metin gövdeyi döndürmeli: %q", w.Body.String())
+	}
+	if w = call("GET", "/dev/mail/missing/html", "text/html"); w.Code != 404 {
+		t.Errorf("Olmayan mesaj 404 döndürmeli, %d döndü", w.Code)
+	}
+
+	if w = call("DELETE", "/dev/mail", ""); w.Code != http.StatusNoContent {
+		t.Fatalf("Temizleme 204 döndürmeli, %d döndü", w.Code)
+	}
+	if len(mailer.Messages()) != 0 || len(mailer.Queued()) != 0 {
+		t.Error("Temizleme sonrası mesaj kalmamalı")
+	}
+}
@@ -0,0 +1,80 @@
+// -----------------------------------------------------------------------------
+// Migration Tests
+// -----------------------------------------------------------------------------
+// Migration registry'sini, Blueprint → MySQL SQL üretimini ve uygulama
+// migration'larının kaydını test eder. Migration'ların veritabanında
+// çalıştırılması `conduit migrate` ile yapılır.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	_ "github.com/biyonik/conduit-go/database/migrations"
+	"github.com/biyonik/conduit-go/pkg/database/migration"
+)
+
+type noopMigration struct{}
+
+func (noopMigration) Up(*migration.Migrator) error   { return nil }
+func (noopMigration) Down(*migration.Migrator) error { return nil }
+
+func TestMigrationRegistry(t *testing.T) {
+	names := []string{"2099_01_02_000000_second", "2099_01_01_000000_first"}
+	for _, name := range names {
+		migration.Register(name, noopMigration{})
+		defer migration.Unregister(name)
+	}
+
+	registered := migration.Registered()
+	if !strings.HasPrefix(registered[0], "2024_01_01_000000_create_users_table") {
+		t.Errorf("Uygulama migration'ları init ile kaydedilmeli: %v", registered)
+	}
+	last := registered[len(registered)-2:]
+	if last[0] != "2099_01_01_000000_first" || last[1] != "2099_01_02_000000_second" {
+		t.Errorf("Migration'lar ada (timestamp) göre sıralı olmalı: %v", registered)
+	}
+
+	if _, ok := migration.Get("2099_01_01_000000_first"); !ok {
+		t.Error("Kayıtlı migration Get ile bulunmalı")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Aynı adla ikinci kayıt panic etmeli")
+		}
+	}()
+	migration.Register("2099_01_01_000000_first", noopMigration{})
+}
+
+func TestMigrationGrammar(t *testing.T) {
+	blueprint := migration.NewBlueprint("posts")
+	blueprint.ID()
+	blueprint.String("title", 200)
+	blueprint.String("slug", 200).Unique()
+	blueprint.String("status", 20).Default("draft")
+	blueprint.Boolean("featured").Default(false)
+	blueprint.BigInteger("user_id").Unsigned()
+	blueprint.Timestamps()
+	blueprint.Index("user_id")
+
+	sql := migration.NewMySQLGrammar().CompileCreateTable("posts", blueprint.Columns(), blueprint.Indexes())
+
+	for _, want := range []string{
+		"CREATE TABLE `posts`",
+		"`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY",
+		"`title` VARCHAR(200) NOT NULL",
+		"`slug` VARCHAR(200) NOT NULL UNIQUE",
+		"`status` VARCHAR(20) NOT NULL DEFAULT 'draft'",
+		"`featured` TINYINT(1) NOT NULL DEFAULT 0",
+		"`user_id` BIGINT UNSIGNED NOT NULL",
+		"`created_at` TIMESTAMP NULL",
+		"INDEX `posts_user_id_index` (`user_id`)",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("SQL %q içermeli:\n%s", want, sql)
+		}
+	}
+}
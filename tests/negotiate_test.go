@@ -0,0 +1,140 @@
+// -----------------------------------------------------------------------------
+// Content Negotiation Tests
+// -----------------------------------------------------------------------------
+// Accept header'ının ayrıştırılmasını, response.Negotiate ile JSON/XML
+// seçimini ve encoder registry'sini test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+)
+
+func TestParseAccept(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{"", []string{}},
+		{"application/json", []string{"application/json"}},
+		{"text/html;q=0.8, application/xml, */*;q=0.1", []string{"application/xml", "text/html", "*/*"}},
+		{"*/*, application/*, application/json", []string{"application/json", "application/*", "*/*"}},
+		{"application/json;q=0, text/csv;q=abc, TEXT/XML", []string{"text/xml"}},
+	}
+
+	for _, tt := range tests {
+		if got := response.ParseAccept(tt.header); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseAccept(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestRequestWantsJSON(t *testing.T) {
+	tests := map[string]bool{
+		"":                                  false,
+		"application/json":                  true,
+		"application/vnd.api+json":          true,
+		"text/html, application/json;q=0.9": false,
+		"text/html;q=0.5, application/json": true,
+		"*/*":                               false,
+	}
+
+	for accept, want := range tests {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("Accept", accept)
+		if got := conduitReq.New(req).WantsJSON(); got != want {
+			t.Errorf("WantsJSON() with Accept %q = %v, want %v", accept, got, want)
+		}
+	}
+}
+
+type negotiatedUser struct {
+	ID    int      `json:"id"`
+	Name  string   `json:"name"`
+	Email *string  `json:"email"`
+	Roles []string `json:"roles"`
+}
+
+func negotiate(t *testing.T, accept string, data any) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest("GET", "/users/1", nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	if err := response.Negotiate(rec, req, data); err != nil {
+		t.Fatal(err)
+	}
+	return rec
+}
+
+func TestNegotiateJSON(t *testing.T) {
+	user := negotiatedUser{ID: 1, Name: "Ada", Roles: []string{"admin"}}
+
+	for _, accept := range []string{"", "application/json", "*/*", "text/csv", "application/vnd.api+json"} {
+		rec := negotiate(t, accept, user)
+		if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Accept %q: Content-Type = %q, want application/json", accept, ct)
+		}
+		if want := `{"success":true,"data":{"id":1,"name":"Ada","email":null,"roles":["admin"]}}`; strings.TrimSpace(rec.Body.String()) != want {
+			t.Errorf("Accept %q: body = %s", accept, rec.Body.String())
+		}
+		if rec.Header().Get("Vary") != "Accept" {
+			t.Error("Vary: Accept header missing")
+		}
+	}
+}
+
+func TestNegotiateXML(t *testing.T) {
+	user := negotiatedUser{ID: 1, Name: "Ada & Co", Roles: []string{"admin", "editor"}}
+
+	rec := negotiate(t, "text/html;q=0.9, application/xml", user)
+	if ct := rec.Header().Get("Content-Type"); ct != "application/xml" {
+		t.Errorf("Content-Type = %q, want application/xml", ct)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<response><success>true</success><data><id>1</id><name>Ada &amp; Co</name><email></email>` +
+		`<roles><item>admin</item><item>editor</item></roles></data></response>` + "\n"
+	if rec.Body.String() != want {
+		t.Errorf("body =\n%s\nwant\n%s", rec.Body.String(), want)
+	}
+
+	// Element adı olamayan key'ler entry olarak yazılır
+	rec = negotiate(t, "text/xml", map[string]int{"address.zip": 1, "1st": 2})
+	if ct := rec.Header().Get("Content-Type"); ct != "text/xml" {
+		t.Errorf("Content-Type = %q, want text/xml", ct)
+	}
+	if !strings.Contains(rec.Body.String(), `<data><entry key="1st">2</entry><address.zip>1</address.zip></data>`) {
+		t.Errorf("body = %s", rec.Body.String())
+	}
+}
+
+func TestNegotiateCustomEncoder(t *testing.T) {
+	response.RegisterEncoder("text/plain", func(w io.Writer, v any) error {
+		_, err := fmt.Fprintf(w, "%v", v.(response.JSONResponse).Data)
+		return err
+	})
+	defer response.UnregisterEncoder("text/plain")
+
+	rec := negotiate(t, "text/plain", "hello")
+	if rec.Header().Get("Content-Type") != "text/plain" || rec.Body.String() != "hello" {
+		t.Errorf("custom encoder: %q %q", rec.Header().Get("Content-Type"), rec.Body.String())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("duplicate RegisterEncoder should panic")
+		}
+	}()
+	response.RegisterEncoder("application/json", response.EncodeJSON)
+}
@@ -0,0 +1,88 @@
+// -----------------------------------------------------------------------------
+// Pagination Tests
+// -----------------------------------------------------------------------------
+// database.Paginator hesaplamalarını ve response.Paginated'ın ürettiği
+// data, meta ve links alanlarını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/pkg/database"
+)
+
+func TestPaginator(t *testing.T) {
+	p := database.NewPaginator(42, 10, 2)
+	if p.LastPage() != 5 || p.Offset() != 10 || !p.HasMorePages() {
+		t.Errorf("paginator = last %d, offset %d, more %v", p.LastPage(), p.Offset(), p.HasMorePages())
+	}
+
+	p = database.NewPaginator(0, 0, 0)
+	if p.PerPage() != database.DefaultPerPage || p.CurrentPage() != 1 || p.LastPage() != 1 || p.HasMorePages() {
+		t.Errorf("empty paginator = %+v", p)
+	}
+}
+
+// paginatedBody, Paginated yanıtını decode eder.
+type paginatedBody struct {
+	Success bool                    `json:"success"`
+	Data    []string                `json:"data"`
+	Meta    response.PaginationMeta `json:"meta"`
+	Links   map[string]*string      `json:"links"`
+}
+
+func TestPaginatedResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/api/users?status=active&page=2", nil)
+	response.Paginated(w, []string{"d", "e", "f"}, database.NewPaginator(7, 3, 2), r)
+
+	var body paginatedBody
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if !body.Success || len(body.Data) != 3 {
+		t.Errorf("body = %+v", body)
+	}
+	want := response.PaginationMeta{Total: 7, PerPage: 3, CurrentPage: 2, LastPage: 3}
+	if body.Meta != want {
+		t.Errorf("meta = %+v, want %+v", body.Meta, want)
+	}
+
+	// Diğer query parametreleri korunur, yalnızca page değişir
+	links := map[string]string{
+		"first": "/api/users?page=1&status=active",
+		"last":  "/api/users?page=3&status=active",
+		"prev":  "/api/users?page=1&status=active",
+		"next":  "/api/users?page=3&status=active",
+	}
+	for name, link := range links {
+		if got := body.Links[name]; got == nil || *got != link {
+			t.Errorf("links.%s = %v, want %s", name, got, link)
+		}
+	}
+}
+
+func TestPaginatedEmptyPage(t *testing.T) {
+	w := httptest.NewRecorder()
+	var items []string
+	response.Paginated(w, items, database.NewPaginator(0, 15, 1), httptest.NewRequest("GET", "/api/users", nil))
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(w.Body.Bytes(), &raw); err != nil {
+		t.Fatal(err)
+	}
+	if string(raw["data"]) != "[]" {
+		t.Errorf("data = %s, want []", raw["data"])
+	}
+
+	var links map[string]*string
+	json.Unmarshal(raw["links"], &links)
+	if links["prev"] != nil || links["next"] != nil || *links["last"] != "/api/users?page=1" {
+		t.Errorf("links = %s", raw["links"])
+	}
+}
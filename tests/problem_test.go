@@ -0,0 +1,168 @@
+// -----------------------------------------------------------------------------
+// Problem Details Tests
+// -----------------------------------------------------------------------------
+// RFC 7807 application/problem+json builder'ını ve panic, 404, 405,
+// response.Error ile doğrulama hatalarının bu zarfı kullandığını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/internal/router"
+)
+
+// decodeProblem, yanıtın problem+json olduğunu doğrular ve gövdeyi döndürür.
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder, status int) map[string]any {
+	t.Helper()
+
+	if w.Code != status {
+		t.Errorf("status = %d, want %d", w.Code, status)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != response.ProblemContentType {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid JSON: %v (%s)", err, w.Body)
+	}
+	if body["status"] != float64(status) {
+		t.Errorf("body status = %v", body["status"])
+	}
+	return body
+}
+
+func TestProblemBuilder(t *testing.T) {
+	handler := middleware.RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response.NewProblem(http.StatusConflict).
+			WithType("https://example.com/problems/out-of-stock").
+			WithDetail("Ürün stokta yok").
+			With("product_id", 42).
+			With("status", "ignored"). // Standart alanlar ezilemez
+			Send(w, r)
+	}))
+
+	req := httptest.NewRequest("POST", "/orders", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	body := decodeProblem(t, w, http.StatusConflict)
+	want := map[string]any{
+		"type":       "https://example.com/problems/out-of-stock",
+		"title":      "Conflict",
+		"detail":     "Ürün stokta yok",
+		"instance":   "/orders",
+		"request_id": "req-123",
+		"product_id": float64(42),
+	}
+	for key, value := range want {
+		if body[key] != value {
+			t.Errorf("%s = %v, want %v", key, body[key], value)
+		}
+	}
+}
+
+func TestPanicRecoveryProblem(t *testing.T) {
+	handler := middleware.PanicRecovery(log.New(io.Discard, "", 0))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/crash", nil))
+
+	body := decodeProblem(t, w, http.StatusInternalServerError)
+	if body["title"] != "Internal Server Error" || body["instance"] != "/crash" {
+		t.Errorf("panic problem = %v", body)
+	}
+}
+
+func TestRouterNotFoundAndMethodNotAllowed(t *testing.T) {
+	r := router.New()
+	ok := func(w http.ResponseWriter, r *conduitReq.Request) { w.WriteHeader(http.StatusOK) }
+	r.GET("/orders/{id}", ok)
+	r.DELETE("/orders/{id}", ok)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/missing", nil))
+	if body := decodeProblem(t, w, http.StatusNotFound); body["instance"] != "/missing" {
+		t.Errorf("404 problem = %v", body)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/orders/42", nil))
+	decodeProblem(t, w, http.StatusMethodNotAllowed)
+	if allow := w.Header().Get("Allow"); allow != "GET, HEAD, DELETE" {
+		t.Errorf("Allow = %q, want %q", allow, "GET, HEAD, DELETE")
+	}
+}
+
+func TestValidationProblemIncludesRequestID(t *testing.T) {
+	// Varsayılan doğrulama formatı problem+json
+	response.SetValidationFormatter(nil)
+
+	handler := middleware.RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response.ValidationError(w, map[string][]string{"email": {"Email alanı zorunludur"}})
+	}))
+
+	req := httptest.NewRequest("POST", "/register", nil)
+	req.Header.Set("X-Request-ID", "req-456")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	body := decodeProblem(t, w, http.StatusUnprocessableEntity)
+	if body["request_id"] != "req-456" || body["invalid-params"] == nil {
+		t.Errorf("validation problem = %v", body)
+	}
+}
+
+func TestErrorUsesProblemEnvelope(t *testing.T) {
+	// Auth middleware'i 401'i response.Error ile yazar
+	handler := middleware.RequestID()(middleware.Auth()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler should not run without a token")
+	})))
+
+	req := httptest.NewRequest("GET", "/api/profile", nil)
+	req.Header.Set("X-Request-ID", "req-789")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	body := decodeProblem(t, w, http.StatusUnauthorized)
+	if body["title"] != "Unauthorized" || body["detail"] != "Authorization header gerekli" || body["request_id"] != "req-789" {
+		t.Errorf("401 problem = %v", body)
+	}
+	if _, ok := body["success"]; ok {
+		t.Errorf("problem body should not contain the legacy envelope: %v", body)
+	}
+}
+
+func TestErrorFormatLegacy(t *testing.T) {
+	if err := response.SetErrorFormat("legacy"); err != nil {
+		t.Fatal(err)
+	}
+	defer response.SetErrorFormat(response.ErrorFormatProblem)
+
+	w := httptest.NewRecorder()
+	response.Error(w, http.StatusTooManyRequests, "Çok fazla istek")
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if want := `{"success":false,"error":"Çok fazla istek"}`; strings.TrimSpace(w.Body.String()) != want {
+		t.Errorf("legacy body = %s, want %s", w.Body, want)
+	}
+
+	if err := response.SetErrorFormat("xml"); err == nil {
+		t.Error("unknown error format should be rejected")
+	}
+}
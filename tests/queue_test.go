@@ -7,13 +7,33 @@
 package tests
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/biyonik/conduit-go/internal/jobs"
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/pkg/cache"
+	"github.com/biyonik/conduit-go/pkg/crypt"
+	"github.com/biyonik/conduit-go/pkg/database"
+	"github.com/biyonik/conduit-go/pkg/events"
+	"github.com/biyonik/conduit-go/pkg/mail"
 	"github.com/biyonik/conduit-go/pkg/queue"
+	"github.com/biyonik/conduit-go/pkg/requestid"
 )
 
 func TestSyncQueue(t *testing.T) {
@@ -27,6 +47,7 @@ func TestSyncQueue(t *testing.T) {
 		"test@example.com",
 		"Test Email",
 		"This is a test email from queue system",
+		nil,
 	)
 
 	// Job'ı register et
@@ -40,31 +61,41 @@ func TestSyncQueue(t *testing.T) {
 		t.Errorf("Push hatası: %v", err)
 	}
 
-	// Later testi (5 saniye gecikme)
-	t.Log("Testing Later() with 2 second delay...")
-	uploadJob := jobs.NewProcessUploadJob(
-		"/tmp/test.jpg",
-		123,
-		"image",
-	)
+	t.Log("✅ Sync queue tests passed")
+}
 
-	queue.RegisterJob("*jobs.ProcessUploadJob", func() queue.Job {
-		return &jobs.ProcessUploadJob{}
-	})
+// TestSyncQueueLaterDoesNotBlock, Later'ın çağıranı bekletmeden job'ı
+// gecikme dolunca çalıştırdığını test eder.
+func TestSyncQueueLaterDoesNotBlock(t *testing.T) {
+	syncQueue := queue.NewSyncQueue(log.New(io.Discard, "", 0))
 
-	startTime := time.Now()
-	err = syncQueue.Later(2*time.Second, uploadJob, "uploads")
-	elapsed := time.Since(startTime)
+	var running, peak int32
+	var handled sync.Map
+	job := &countingJob{running: &running, peak: &peak, handled: &handled}
+	job.SetID("delayed-job")
 
-	if err != nil {
-		t.Errorf("Later hatası: %v", err)
+	startTime := time.Now()
+	if err := syncQueue.Later(100*time.Millisecond, job, "uploads"); err != nil {
+		t.Fatalf("Later hatası: %v", err)
 	}
-
-	if elapsed < 2*time.Second {
-		t.Errorf("Later gecikme çalışmadı: %v", elapsed)
+	if elapsed := time.Since(startTime); elapsed > 50*time.Millisecond {
+		t.Errorf("Later çağıranı bekletmemeli: %v", elapsed)
+	}
+	if _, ok := handled.Load("delayed-job"); ok {
+		t.Fatal("Job gecikme dolmadan çalışmamalı")
 	}
 
-	t.Log("✅ Sync queue tests passed")
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, ok := handled.Load("delayed-job"); ok {
+			if time.Since(startTime) < 100*time.Millisecond {
+				t.Error("Job gecikmeden önce çalıştı")
+			}
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Job gecikme sonrası çalışmadı")
 }
 
 func TestJobSerialization(t *testing.T) {
@@ -73,6 +104,7 @@ func TestJobSerialization(t *testing.T) {
 		"user@example.com",
 		"Welcome",
 		"Welcome to Conduit-Go!",
+		nil,
 	)
 
 	// Serialize
@@ -102,6 +134,500 @@ func TestJobSerialization(t *testing.T) {
 	t.Log("✅ Job serialization tests passed")
 }
 
+// TestJobAutoRegistration, job'ların init ile kendini kaydettiğini ve
+// RegisterType'ın Push ile aynı type string'ini ürettiğini test eder.
+func TestJobAutoRegistration(t *testing.T) {
+	for _, job := range []queue.Job{&jobs.SendEmailJob{}, &jobs.ProcessUploadJob{}, &jobs.ImportUsersJob{}} {
+		jobType := queue.JobTypeName(job)
+		if !queue.JobRegistry.Has(jobType) {
+			t.Errorf("%s init ile register edilmemiş", jobType)
+		}
+	}
+
+	jobType := queue.RegisterType(func() *jobs.SendEmailJob { return &jobs.SendEmailJob{} })
+	if jobType != "*jobs.SendEmailJob" {
+		t.Errorf("RegisterType type string: %s, beklenen *jobs.SendEmailJob", jobType)
+	}
+
+	created, err := queue.JobRegistry.Create(jobType)
+	if err != nil {
+		t.Fatalf("Create hatası: %v", err)
+	}
+	if _, ok := created.(*jobs.SendEmailJob); !ok {
+		t.Errorf("Create yanlış tip döndürdü: %T", created)
+	}
+
+	if _, err := queue.JobRegistry.Create("*jobs.MissingJob"); err == nil || !strings.Contains(err.Error(), "RegisterType") {
+		t.Errorf("Kayıtsız tip hatası nasıl kaydedileceğini söylemeli: %v", err)
+	}
+
+	types := queue.JobRegistry.Types()
+	for i := 1; i < len(types); i++ {
+		if types[i-1] > types[i] {
+			t.Errorf("Types sıralı değil: %v", types)
+			break
+		}
+	}
+}
+
+// TestJobRequestIDPropagation, request ID'nin middleware'den dispatch edilen
+// job'a ve serialize edilen payload'a taşındığını test eder.
+func TestJobRequestIDPropagation(t *testing.T) {
+	logger := log.New(os.Stdout, "[QueueTest] ", log.Ldate|log.Ltime)
+	syncQueue := queue.NewSyncQueue(logger)
+
+	var job *jobs.SendEmailJob
+	handler := middleware.RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		job = jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", nil)
+		queue.Dispatch(r.Context(), syncQueue, job, "emails")
+	}))
+
+	// Dışarıdan gelen geçerli ID korunmalı
+	req := httptest.NewRequest("POST", "/api/auth/register", nil)
+	req.Header.Set(requestid.HeaderName, "lb-1234")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Header().Get(requestid.HeaderName) != "lb-1234" {
+		t.Errorf("Response header: %q", rec.Header().Get(requestid.HeaderName))
+	}
+	if queue.RequestIDOf(job) != "lb-1234" {
+		t.Errorf("Job request ID: %q, beklenen lb-1234", queue.RequestIDOf(job))
+	}
+
+	// Payload round-trip sonrası ID korunmalı (worker logları ve failed kayıtlar)
+	data, _ := job.GetPayload()
+	restored := &jobs.SendEmailJob{}
+	restored.SetPayload(data)
+	if restored.RequestID != "lb-1234" {
+		t.Errorf("Deserialize sonrası request ID kayboldu: %q", restored.RequestID)
+	}
+
+	// Geçersiz (log injection) ID yerine yeni ID üretilmeli
+	req = httptest.NewRequest("POST", "/api/auth/register", nil)
+	req.Header.Set(requestid.HeaderName, "bad\nid")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	generated := rec.Header().Get(requestid.HeaderName)
+	if generated == "" || generated == "bad\nid" {
+		t.Errorf("Geçersiz ID reddedilmeli: %q", generated)
+	}
+	if queue.RequestIDOf(job) != generated {
+		t.Errorf("Job üretilen ID'yi taşımalı: %q != %q", queue.RequestIDOf(job), generated)
+	}
+}
+
+// failingMailer, her gönderimde hata döner (failed job testleri için).
+type failingMailer struct{}
+
+func (failingMailer) Send(message *mail.Message) error {
+	return errors.New("smtp: connection refused")
+}
+
+func (failingMailer) SendAsync(message *mail.Message) error {
+	return errors.New("smtp: connection refused")
+}
+
+func (failingMailer) Queue(message *mail.Message, queueName string) error {
+	return errors.New("smtp: connection refused")
+}
+
+// TestFailedJobs, başarısız job'ın kaydedilip tekrar kuyruğa alınabildiğini
+// test eder.
+func TestFailedJobs(t *testing.T) {
+	logger := log.New(os.Stdout, "[QueueTest] ", log.Ldate|log.Ltime)
+	provider := queue.NewMemoryFailedJobProvider()
+	syncQueue := queue.NewSyncQueue(logger).SetFailedJobProvider(provider)
+
+	job := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", failingMailer{})
+	job.SetRequestID("req-42")
+	if err := syncQueue.Push(job, "emails"); err == nil {
+		t.Fatal("Push hata dönmeli")
+	}
+
+	failed, _ := provider.All()
+	if len(failed) != 1 {
+		t.Fatalf("1 failed job beklenirken %d", len(failed))
+	}
+	record := failed[0]
+	if record.Queue != "emails" || record.UUID != job.GetID() || record.Exception == "" {
+		t.Errorf("Kayıt eksik: %+v", record)
+	}
+
+	payload, err := record.JobPayload()
+	if err != nil || payload.Type != "*jobs.SendEmailJob" || payload.RequestID != "req-42" {
+		t.Errorf("Payload: %+v (err: %v)", payload, err)
+	}
+
+	// Retry: job registry'den yeniden oluşturulur (mailer yok -> başarılı simülasyon)
+	if err := queue.RetryFailed(queue.NewSyncQueue(logger), &record); err != nil {
+		t.Errorf("RetryFailed hatası: %v", err)
+	}
+
+	if ok, _ := provider.Forget(record.ID); !ok {
+		t.Error("Forget kaydı silmeli")
+	}
+	if _, err := provider.Find(record.ID); !errors.Is(err, queue.ErrFailedJobNotFound) {
+		t.Errorf("Silinen kayıt ErrFailedJobNotFound dönmeli: %v", err)
+	}
+}
+
+// TestDeadLetterQueue, kalıcı olarak başarısız job'ın dead letter hedefine
+// gönderildiğini test eder.
+func TestDeadLetterQueue(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+
+	t.Run("Queue", func(t *testing.T) {
+		dead := newMemoryTestQueue()
+		syncQueue := queue.NewSyncQueue(logger).
+			SetFailedJobProvider(queue.NewMemoryFailedJobProvider()).
+			SetDeadLetterSink(queue.NewQueueDeadLetterSink(dead, "dead-letter"))
+
+		job := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", failingMailer{})
+		job.SetID("dlq-1")
+		job.SetRequestID("req-dlq")
+		job.SetAttempts(2)
+		syncQueue.Push(job, "emails")
+
+		letter, _ := dead.Pop("dead-letter")
+		if letter == nil {
+			t.Fatal("Job dead letter kuyruğuna eklenmeli")
+		}
+		if letter.GetID() != job.GetID() || letter.GetAttempts() != 0 || queue.RequestIDOf(letter) != "req-dlq" {
+			t.Errorf("Dead letter job'u eksik: id=%s attempts=%d request=%s", letter.GetID(), letter.GetAttempts(), queue.RequestIDOf(letter))
+		}
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		redisClient, err := database.NewRedisClient(database.DefaultRedisConfig(), logger)
+		if err != nil {
+			t.Skip("Redis bağlantısı yok, test skip edildi")
+		}
+		defer redisClient.Close()
+
+		prefix := "test:dlq:" + time.Now().Format("150405.000") + ":"
+		sink := queue.NewRedisDeadLetterSink(redisClient.Client(), prefix, "dead-letter", 100)
+		defer redisClient.Client().Del(context.Background(), sink.Stream())
+
+		job := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", nil)
+		letter, err := queue.NewDeadLetter("emails", job, errors.New("smtp down"))
+		if err != nil {
+			t.Fatalf("NewDeadLetter hatası: %v", err)
+		}
+		if err := sink.Send(letter); err != nil {
+			t.Fatalf("Send hatası: %v", err)
+		}
+
+		entries, err := redisClient.Client().XRange(context.Background(), sink.Stream(), "-", "+").Result()
+		if err != nil || len(entries) != 1 {
+			t.Fatalf("Stream'de 1 kayıt beklenirken: %v (err: %v)", entries, err)
+		}
+		if entries[0].Values["exception"] != "smtp down" || entries[0].Values["queue"] != "emails" {
+			t.Errorf("Stream kaydı eksik: %v", entries[0].Values)
+		}
+	})
+}
+
+// fakeSQS, SQS JSON API'sinin test için yeterli bir taklidi.
+type fakeSQS struct {
+	mu         sync.Mutex
+	messages   map[string][]map[string]interface{} // QueueUrl -> mesajlar
+	inFlight   map[string]map[string]interface{}   // ReceiptHandle -> mesaj
+	visibility []float64
+	unsigned   int
+	nextID     int
+}
+
+func (f *fakeSQS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDTEST/") {
+		f.unsigned++
+	}
+
+	var req map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&req)
+	url, _ := req["QueueUrl"].(string)
+
+	resp := map[string]interface{}{}
+	switch strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "AmazonSQS.") {
+	case "SendMessage":
+		f.messages[url] = append(f.messages[url], req)
+	case "ReceiveMessage":
+		if len(f.messages[url]) > 0 {
+			msg := f.messages[url][0]
+			f.messages[url] = f.messages[url][1:]
+			f.nextID++
+			receipt := fmt.Sprintf("receipt-%d", f.nextID)
+			f.inFlight[receipt] = msg
+			resp["Messages"] = []map[string]interface{}{{"MessageId": receipt, "ReceiptHandle": receipt, "Body": msg["MessageBody"]}}
+		}
+	case "DeleteMessage":
+		delete(f.inFlight, req["ReceiptHandle"].(string))
+	case "ChangeMessageVisibility":
+		f.visibility = append(f.visibility, req["VisibilityTimeout"].(float64))
+	case "GetQueueAttributes":
+		resp["Attributes"] = map[string]string{
+			"ApproximateNumberOfMessages":        strconv.Itoa(len(f.messages[url])),
+			"ApproximateNumberOfMessagesDelayed": "0",
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"__type": "InvalidAction", "message": "unknown"})
+		return
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// TestSQSQueue, SQS driver'ının imzalı istekler ve doğru API çağrıları
+// yaptığını test eder.
+func TestSQSQueue(t *testing.T) {
+	fake := &fakeSQS{messages: map[string][]map[string]interface{}{}, inFlight: map[string]map[string]interface{}{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	q := queue.NewSQSQueue(queue.SQSConfig{
+		Region:          "eu-central-1",
+		AccessKeyID:     "AKIDTEST",
+		SecretAccessKey: "secret",
+		Prefix:          server.URL + "/123456789012",
+		Endpoint:        server.URL,
+	}, log.New(io.Discard, "", 0))
+
+	emailsURL := server.URL + "/123456789012/emails"
+
+	job := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", nil)
+	job.Timeout = 5 * time.Minute
+	if err := q.Push(job, "emails"); err != nil {
+		t.Fatalf("Push hatası: %v", err)
+	}
+	if size, _ := q.Size("emails"); size != 1 {
+		t.Errorf("Size 1 olmalı: %d", size)
+	}
+
+	popped, err := q.Pop("emails")
+	if err != nil || popped == nil {
+		t.Fatalf("Pop job döndürmeli: %v, %v", popped, err)
+	}
+	if popped.GetID() != job.GetID() {
+		t.Errorf("Yanlış job: %s", popped.GetID())
+	}
+	if len(fake.visibility) != 1 || fake.visibility[0] != 330 {
+		t.Errorf("Visibility timeout job timeout'una göre uzatılmalı: %v", fake.visibility)
+	}
+
+	// Release: eski mesaj silinir, attempts artmış mesaj tekrar gönderilir
+	if err := q.Release("emails", popped, 0); err != nil {
+		t.Fatalf("Release hatası: %v", err)
+	}
+	if len(fake.inFlight) != 0 || len(fake.messages[emailsURL]) != 1 {
+		t.Fatalf("Release mesajı silip tekrar göndermeli (in-flight: %d, queued: %d)", len(fake.inFlight), len(fake.messages[emailsURL]))
+	}
+
+	retried, _ := q.Pop("emails")
+	if retried == nil || retried.GetAttempts() != 1 {
+		t.Fatalf("Tekrar gönderilen job attempts=1 taşımalı: %+v", retried)
+	}
+	if err := q.Delete("emails", retried); err != nil || len(fake.inFlight) != 0 {
+		t.Errorf("Delete mesajı silmeli: %v", err)
+	}
+
+	// Later: DelaySeconds; zamanı gelmeden çekilen mesaj tekrar gizlenir
+	if err := q.Later(30*time.Second, jobs.NewSendEmailJob("b@example.com", "Hi", "Hello", nil), "emails"); err != nil {
+		t.Fatalf("Later hatası: %v", err)
+	}
+	if delay := fake.messages[emailsURL][0]["DelaySeconds"]; delay != float64(30) {
+		t.Errorf("DelaySeconds 30 olmalı: %v", delay)
+	}
+	if early, _ := q.Pop("emails"); early != nil {
+		t.Error("Zamanı gelmemiş job döndürülmemeli")
+	}
+
+	if fake.unsigned != 0 {
+		t.Errorf("Tüm istekler SigV4 ile imzalanmalı (imzasız: %d)", fake.unsigned)
+	}
+}
+
+// fakeJetStream, NATS protokolünü ve JetStream API'sinin test için yeterli
+// bir kısmını taklit eden TCP sunucusu.
+type fakeJetStream struct {
+	listener net.Listener
+	mu       sync.Mutex
+	messages map[string][][]byte // subject -> mesajlar
+	filters  map[string]string   // durable -> filter subject
+	inFlight map[string][]byte   // ack subject -> mesaj
+	acks     []string
+	nextAck  int
+}
+
+func newFakeJetStream(t *testing.T) *fakeJetStream {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("TCP listener açılamadı: %v", err)
+	}
+
+	f := &fakeJetStream{
+		listener: listener,
+		messages: map[string][][]byte{},
+		filters:  map[string]string{},
+		inFlight: map[string][]byte{},
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return f
+}
+
+func (f *fakeJetStream) URL() string {
+	return "nats://" + f.listener.Addr().String()
+}
+
+func (f *fakeJetStream) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"headers\":true,\"jetstream\":true}\r\n")
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		case "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			data := make([]byte, size+2)
+			io.ReadFull(reader, data)
+			reply := ""
+			if len(fields) == 4 {
+				reply = fields[2]
+			}
+			f.handle(conn, fields[1], reply, data[:size])
+		}
+	}
+}
+
+func (f *fakeJetStream) handle(conn net.Conn, subject, reply string, data []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	respond := func(body string) {
+		fmt.Fprintf(conn, "MSG %s 1 %d\r\n%s\r\n", reply, len(body), body)
+	}
+
+	switch {
+	case strings.HasPrefix(subject, "$JS.API.STREAM.CREATE."):
+		respond(`{"type":"io.nats.jetstream.api.v1.stream_create_response"}`)
+
+	case strings.HasPrefix(subject, "$JS.API.CONSUMER.DURABLE.CREATE."):
+		var req struct {
+			Config struct {
+				Durable string `json:"durable_name"`
+				Filter  string `json:"filter_subject"`
+			} `json:"config"`
+		}
+		json.Unmarshal(data, &req)
+		f.filters[req.Config.Durable] = req.Config.Filter
+		respond(`{}`)
+
+	case strings.HasPrefix(subject, "$JS.API.CONSUMER.INFO."):
+		durable := subject[strings.LastIndex(subject, ".")+1:]
+		respond(fmt.Sprintf(`{"num_pending":%d}`, len(f.messages[f.filters[durable]])))
+
+	case strings.HasPrefix(subject, "$JS.API.CONSUMER.MSG.NEXT."):
+		durable := subject[strings.LastIndex(subject, ".")+1:]
+		filter := f.filters[durable]
+		if len(f.messages[filter]) == 0 {
+			header := "NATS/1.0 404 No Messages\r\n\r\n"
+			fmt.Fprintf(conn, "HMSG %s 1 %d %d\r\n%s\r\n", reply, len(header), len(header), header)
+			return
+		}
+		msg := f.messages[filter][0]
+		f.messages[filter] = f.messages[filter][1:]
+		f.nextAck++
+		ack := fmt.Sprintf("$JS.ACK.CONDUIT.%s.1.%d.%d.0.0", durable, f.nextAck, f.nextAck)
+		f.inFlight[ack] = msg
+		fmt.Fprintf(conn, "MSG %s 1 %s %d\r\n%s\r\n", reply, ack, len(msg), msg)
+
+	case strings.HasPrefix(subject, "$JS.ACK."):
+		f.acks = append(f.acks, string(data))
+		delete(f.inFlight, subject)
+
+	case strings.HasPrefix(subject, "conduit.jobs."):
+		f.messages[subject] = append(f.messages[subject], data)
+		respond(fmt.Sprintf(`{"stream":"CONDUIT","seq":%d}`, len(f.messages[subject])))
+	}
+}
+
+// TestNATSQueue, JetStream driver'ının protokol ve ack akışını test eder.
+func TestNATSQueue(t *testing.T) {
+	server := newFakeJetStream(t)
+	defer server.listener.Close()
+
+	q := queue.NewNATSQueue(queue.NATSConfig{URL: server.URL(), WaitTime: 100 * time.Millisecond}, log.New(io.Discard, "", 0))
+	defer q.Close()
+
+	job := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", nil)
+	if err := q.Push(job, "emails"); err != nil {
+		t.Fatalf("Push hatası: %v", err)
+	}
+	if size, err := q.Size("emails"); err != nil || size != 1 {
+		t.Errorf("Size 1 olmalı: %d (err: %v)", size, err)
+	}
+
+	popped, err := q.Pop("emails")
+	if err != nil || popped == nil || popped.GetID() != job.GetID() {
+		t.Fatalf("Pop job döndürmeli: %v, %v", popped, err)
+	}
+
+	// Release: yeni mesaj yayınlanır, eski mesaj ack edilir
+	if err := q.Release("emails", popped, 0); err != nil {
+		t.Fatalf("Release hatası: %v", err)
+	}
+
+	retried, err := q.Pop("emails")
+	if err != nil || retried == nil || retried.GetAttempts() != 1 {
+		t.Fatalf("Tekrar yayınlanan job attempts=1 taşımalı: %+v (err: %v)", retried, err)
+	}
+	q.Delete("emails", retried)
+
+	// Boş kuyruk: 404 status'ü nil job olarak döner
+	if empty, err := q.Pop("emails"); err != nil || empty != nil {
+		t.Errorf("Boş kuyrukta nil dönmeli: %v, %v", empty, err)
+	}
+
+	// Zamanı gelmemiş job gecikmeli NAK edilir
+	q.Later(time.Minute, jobs.NewSendEmailJob("b@example.com", "Hi", "Hello", nil), "emails")
+	if early, _ := q.Pop("emails"); early != nil {
+		t.Error("Zamanı gelmemiş job döndürülmemeli")
+	}
+
+	time.Sleep(50 * time.Millisecond) // ack'ler asenkron yayınlanır
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if len(server.acks) != 3 || server.acks[0] != "+ACK" || server.acks[1] != "+ACK" || !strings.HasPrefix(server.acks[2], "-NAK {\"delay\":") {
+		t.Errorf("Beklenmeyen ack'ler: %v", server.acks)
+	}
+	if len(server.inFlight) != 0 {
+		t.Errorf("Tüm mesajlar onaylanmalı: %d bekliyor", len(server.inFlight))
+	}
+}
+
 // Benchmark testi
 func BenchmarkSyncQueue(b *testing.B) {
 	logger := log.New(os.Stdout, "[Benchmark] ", log.Ldate|log.Ltime)
@@ -117,7 +643,1107 @@ func BenchmarkSyncQueue(b *testing.B) {
 			"bench@example.com",
 			"Benchmark",
 			"Benchmark test",
+			nil,
 		)
 		syncQueue.Push(job, "emails")
 	}
 }
+
+// memoryTestQueue, worker testleri için basit bir in-memory queue.
+type memoryTestQueue struct {
+	mu       sync.Mutex
+	jobs     map[string][]queue.Job
+	released int
+}
+
+func newMemoryTestQueue() *memoryTestQueue {
+	return &memoryTestQueue{jobs: make(map[string][]queue.Job)}
+}
+
+func (m *memoryTestQueue) Push(job queue.Job, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs[name] = append(m.jobs[name], job)
+	return nil
+}
+
+func (m *memoryTestQueue) Later(delay time.Duration, job queue.Job, name string) error {
+	return m.Push(job, name)
+}
+
+func (m *memoryTestQueue) Pop(name string) (queue.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.jobs[name]) == 0 {
+		return nil, nil
+	}
+	job := m.jobs[name][0]
+	m.jobs[name] = m.jobs[name][1:]
+	return job, nil
+}
+
+func (m *memoryTestQueue) Delete(name string, job queue.Job) error { return nil }
+
+func (m *memoryTestQueue) Release(name string, job queue.Job, delay time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.released++
+	return nil
+}
+
+func (m *memoryTestQueue) Size(name string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return int64(len(m.jobs[name])), nil
+}
+
+// countingJob, çalıştığı goroutine sayısını ve queue'yu kaydeden test job'ı.
+type countingJob struct {
+	queue.BaseJob
+	panics  bool
+	running *int32
+	peak    *int32
+	handled *sync.Map
+}
+
+func (j *countingJob) Handle() error {
+	if j.panics {
+		panic("boom")
+	}
+
+	current := atomic.AddInt32(j.running, 1)
+	for {
+		peak := atomic.LoadInt32(j.peak)
+		if current <= peak || atomic.CompareAndSwapInt32(j.peak, peak, current) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	atomic.AddInt32(j.running, -1)
+
+	j.handled.Store(j.GetID(), j.GetQueue())
+	return nil
+}
+
+func (j *countingJob) Failed(err error) error       { return nil }
+func (j *countingJob) GetPayload() ([]byte, error)  { return nil, nil }
+func (j *countingJob) SetPayload(data []byte) error { return nil }
+
+// TestWorkerConcurrency, worker pool'un job'ları eşzamanlı, queue'lar arasında
+// adil işlediğini ve panic eden job'ı izole ettiğini test eder.
+func TestWorkerConcurrency(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	q := newMemoryTestQueue()
+
+	var running, peak int32
+	handled := &sync.Map{}
+	for i := 0; i < 10; i++ {
+		for _, name := range []string{"high", "low"} {
+			job := &countingJob{running: &running, peak: &peak, handled: handled}
+			job.SetID(fmt.Sprintf("%s-%d", name, i))
+			job.SetQueue(name)
+			q.Push(job, name)
+		}
+	}
+
+	panicking := &countingJob{panics: true}
+	panicking.SetID("panic")
+	panicking.MaxAttempts = 1
+	q.Push(panicking, "high")
+
+	worker := queue.NewWorker(q, logger).SetConcurrency(4).SetMaxJobs(21)
+
+	done := make(chan struct{})
+	go func() {
+		worker.Work("high", "low")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		worker.Stop()
+		t.Fatal("Worker max jobs sonrası durmadı")
+	}
+
+	count := 0
+	handled.Range(func(key, value interface{}) bool {
+		count++
+		return true
+	})
+	if count != 20 {
+		t.Errorf("20 job işlenmeliydi, işlenen: %d", count)
+	}
+	if atomic.LoadInt32(&peak) < 2 {
+		t.Errorf("Job'lar eşzamanlı işlenmeli (peak: %d)", peak)
+	}
+	if q.released != 1 {
+		t.Errorf("Panic eden job failed akışına girmeli (release: %d)", q.released)
+	}
+}
+
+// TestJobBatch, batch ilerlemesinin izlendiğini ve Then/Catch callback'lerinin
+// çalıştığını test eder.
+func TestJobBatch(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	syncQueue := queue.NewSyncQueue(logger)
+	queue.SetBatchStore(queue.NewMemoryBatchStore())
+
+	var done, failed []string
+	queue.RegisterBatchCallback("test.batch.done", func(b *queue.BatchStatus) { done = append(done, b.ID) })
+	queue.RegisterBatchCallback("test.batch.failed", func(b *queue.BatchStatus) { failed = append(failed, b.ID) })
+
+	// Tüm job'lar başarılı -> Then
+	batch, err := queue.Batch(
+		jobs.NewSendEmailJob("a@example.com", "Hi", "Hello", nil),
+		jobs.NewSendEmailJob("b@example.com", "Hi", "Hello", nil),
+	).Name("welcome").Then("test.batch.done").Catch("test.batch.failed").Dispatch(context.Background(), syncQueue, "emails")
+	if err != nil {
+		t.Fatalf("Dispatch hatası: %v", err)
+	}
+	if !batch.Finished() || batch.Progress() != 100 || batch.HasFailures() {
+		t.Errorf("Batch tamamlanmış olmalı: %+v", batch)
+	}
+	if len(done) != 1 || done[0] != batch.ID || len(failed) != 0 {
+		t.Errorf("Then bir kez çalışmalı: done=%v failed=%v", done, failed)
+	}
+
+	// Bir job başarısız -> Catch (bir kez), Then çalışmaz
+	failing := jobs.NewSendEmailJob("c@example.com", "Hi", "Hello", failingMailer{})
+	batch, _ = queue.Batch(
+		jobs.NewSendEmailJob("d@example.com", "Hi", "Hello", nil),
+		failing,
+		jobs.NewSendEmailJob("e@example.com", "Hi", "Hello", failingMailer{}),
+	).Then("test.batch.done").Catch("test.batch.failed").Dispatch(context.Background(), syncQueue, "emails")
+
+	status, err := queue.FindBatch(batch.ID)
+	if err != nil {
+		t.Fatalf("FindBatch hatası: %v", err)
+	}
+	if status.FailedJobs != 2 || !status.Finished() || status.FailedJobIDs[0] != failing.GetID() {
+		t.Errorf("Başarısız job'lar izlenmeli: %+v", status)
+	}
+	if len(failed) != 1 || len(done) != 1 {
+		t.Errorf("Catch bir kez, Then hiç çalışmamalı: done=%v failed=%v", done, failed)
+	}
+
+	if _, err := queue.FindBatch("missing"); !errors.Is(err, queue.ErrBatchNotFound) {
+		t.Errorf("Olmayan batch ErrBatchNotFound dönmeli: %v", err)
+	}
+}
+
+// uniqueStatsJob, kullanıcı başına tek kopyası olması gereken test job'ı.
+type uniqueStatsJob struct {
+	queue.BaseJob
+	UserID string
+}
+
+func (j *uniqueStatsJob) Handle() error                { return nil }
+func (j *uniqueStatsJob) Failed(err error) error       { return nil }
+func (j *uniqueStatsJob) GetPayload() ([]byte, error)  { return nil, nil }
+func (j *uniqueStatsJob) SetPayload(data []byte) error { return nil }
+func (j *uniqueStatsJob) UniqueID() string             { return j.UserID }
+func (j *uniqueStatsJob) UniqueFor() time.Duration     { return time.Minute }
+
+// TestUniqueJobs, aynı unique ID'li job'ların ilk job bitene kadar tekrar
+// kuyruğa eklenmediğini test eder.
+func TestUniqueJobs(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	queue.SetUniqueLockStore(setupMemoryCache())
+	q := newMemoryTestQueue()
+	ctx := context.Background()
+
+	queue.Dispatch(ctx, q, &uniqueStatsJob{UserID: "1"}, "stats")
+	queue.Dispatch(ctx, q, &uniqueStatsJob{UserID: "1"}, "stats")
+	queue.Dispatch(ctx, q, &uniqueStatsJob{UserID: "2"}, "stats")
+
+	if size, _ := q.Size("stats"); size != 2 {
+		t.Fatalf("Duplicate job birleştirilmeli: kuyrukta %d job", size)
+	}
+
+	// İşlendikten sonra lock bırakılır
+	queue.NewWorker(q, logger).SetMaxJobs(2).Work("stats")
+
+	queue.Dispatch(ctx, q, &uniqueStatsJob{UserID: "1"}, "stats")
+	if size, _ := q.Size("stats"); size != 1 {
+		t.Errorf("Job bittikten sonra tekrar dispatch edilebilmeli: kuyrukta %d job", size)
+	}
+
+	// Sync queue'da job hemen çalışır ve lock bırakılır
+	syncQueue := queue.NewSyncQueue(logger)
+	for i := 0; i < 2; i++ {
+		if err := queue.Dispatch(ctx, syncQueue, &uniqueStatsJob{UserID: "3"}, "stats"); err != nil {
+			t.Errorf("Sync dispatch hatası: %v", err)
+		}
+	}
+}
+
+// TestRedisQueueDelayedJobs, delayed job'ların ZSET'te beklediğini ve zamanı
+// gelince ana kuyruğa taşındığını test eder.
+func TestRedisQueueDelayedJobs(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+
+	config := database.DefaultRedisConfig()
+	redisClient, err := database.NewRedisClient(config, logger)
+	if err != nil {
+		t.Skip("Redis bağlantısı yok, test skip edildi")
+	}
+	defer redisClient.Close()
+
+	prefix := "test:delayed:" + time.Now().Format("150405.000") + ":"
+	q := queue.NewRedisQueue(redisClient.Client(), logger, prefix)
+
+	if err := q.Later(300*time.Millisecond, jobs.NewSendEmailJob("a@example.com", "Hi", "Hello", nil), "emails"); err != nil {
+		t.Fatalf("Later hatası: %v", err)
+	}
+
+	if moved, _ := q.MigrateDueJobs("emails"); moved != 0 {
+		t.Errorf("Zamanı gelmemiş job taşınmamalı: %d", moved)
+	}
+	if size, _ := q.Size("emails"); size != 1 {
+		t.Errorf("Delayed job Size'a dahil olmalı: %d", size)
+	}
+
+	time.Sleep(400 * time.Millisecond)
+
+	job, err := q.Pop("emails")
+	if err != nil || job == nil {
+		t.Fatalf("Zamanı gelen job pop edilmeli: %v, %v", job, err)
+	}
+	q.Delete("emails", job)
+}
+
+// orderJob, işlendiği queue'yu sırayla kaydeden test job'ı.
+type orderJob struct {
+	queue.BaseJob
+	mu    *sync.Mutex
+	order *[]string
+}
+
+func (j *orderJob) Handle() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	*j.order = append(*j.order, j.GetQueue())
+	return nil
+}
+
+func (j *orderJob) Failed(err error) error {
+	return nil
+}
+
+func (j *orderJob) GetPayload() ([]byte, error) {
+	return nil, nil
+}
+
+func (j *orderJob) SetPayload(data []byte) error {
+	return nil
+}
+
+// runOrderedWorker, queue'lara job'ları ekler, worker'ı maxJobs kadar
+// çalıştırır ve işlenme sırasını döndürür.
+func runOrderedWorker(t *testing.T, counts map[string]int, maxJobs int, spec string) []string {
+	t.Helper()
+
+	q := newMemoryTestQueue()
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	for name, count := range counts {
+		for i := 0; i < count; i++ {
+			job := &orderJob{mu: &mu, order: &order}
+			job.SetID(fmt.Sprintf("%s-%d", name, i))
+			job.SetQueue(name)
+			q.Push(job, name)
+		}
+	}
+
+	worker := queue.NewWorker(q, log.New(io.Discard, "", 0)).SetMaxJobs(maxJobs)
+
+	done := make(chan struct{})
+	go func() {
+		worker.Work(spec)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		worker.Stop()
+		t.Fatal("Worker max jobs sonrası durmadı")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]string(nil), order...)
+}
+
+// TestQueuePriorities, çoklu queue stratejilerini test eder.
+func TestQueuePriorities(t *testing.T) {
+	t.Run("ParseQueues", func(t *testing.T) {
+		queues, weighted, err := queue.ParseQueues("critical:5, default:3,low")
+		if err != nil {
+			t.Fatalf("ParseQueues hatası: %v", err)
+		}
+		if !weighted || len(queues) != 3 {
+			t.Fatalf("3 ağırlıklı queue beklendi: %+v", queues)
+		}
+		if queues[0].Name != "critical" || queues[0].Weight != 5 || queues[2].Weight != 1 {
+			t.Errorf("Yanlış ayrıştırma: %+v", queues)
+		}
+
+		if _, _, err := queue.ParseQueues("critical:0"); err == nil {
+			t.Error("Geçersiz ağırlık hata vermeli")
+		}
+		if _, err := queue.ParseQueueStrategy("fastest"); err == nil {
+			t.Error("Geçersiz strateji hata vermeli")
+		}
+	})
+
+	t.Run("Priority", func(t *testing.T) {
+		order := runOrderedWorker(t, map[string]int{"low": 5, "critical": 5}, 10, "critical,low")
+
+		if len(order) != 10 {
+			t.Fatalf("10 job işlenmeliydi, işlenen: %d", len(order))
+		}
+		for i, name := range order[:5] {
+			if name != "critical" {
+				t.Fatalf("Critical job'lar önce işlenmeli, #%d: %s (%v)", i, name, order)
+			}
+		}
+	})
+
+	t.Run("Weighted", func(t *testing.T) {
+		order := runOrderedWorker(t, map[string]int{"critical": 40, "low": 40}, 40, "critical:9,low:1")
+
+		counts := map[string]int{}
+		for _, name := range order {
+			counts[name]++
+		}
+		if counts["critical"] <= counts["low"] {
+			t.Errorf("Ağırlıklar uygulanmalı: %v", counts)
+		}
+	})
+}
+
+// slowJob, verilen süre kadar çalışan (veya context iptalini bekleyen) test job'ı.
+type slowJob struct {
+	queue.BaseJob
+	duration  time.Duration
+	useCtx    bool
+	cancelled chan struct{}
+}
+
+func (j *slowJob) Handle() error {
+	time.Sleep(j.duration)
+	return nil
+}
+
+func (j *slowJob) HandleContext(ctx context.Context) error {
+	if !j.useCtx {
+		return j.Handle()
+	}
+	select {
+	case <-ctx.Done():
+		close(j.cancelled)
+		return ctx.Err()
+	case <-time.After(j.duration):
+		return nil
+	}
+}
+
+func (j *slowJob) Failed(err error) error {
+	return nil
+}
+
+func (j *slowJob) GetPayload() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (j *slowJob) SetPayload(data []byte) error {
+	return nil
+}
+
+// plainSlowJob, ContextJob implement etmeyen ve eşzamanlı çalışmalarını
+// sayan yavaş test job'ı.
+type plainSlowJob struct {
+	queue.BaseJob
+	duration time.Duration
+	running  *int32
+	peak     *int32
+	runs     *int32
+}
+
+func (j *plainSlowJob) Handle() error {
+	atomic.AddInt32(j.runs, 1)
+	n := atomic.AddInt32(j.running, 1)
+	defer atomic.AddInt32(j.running, -1)
+	for {
+		p := atomic.LoadInt32(j.peak)
+		if n <= p || atomic.CompareAndSwapInt32(j.peak, p, n) {
+			break
+		}
+	}
+	time.Sleep(j.duration)
+	return nil
+}
+
+func (j *plainSlowJob) Failed(err error) error {
+	return nil
+}
+
+func (j *plainSlowJob) GetPayload() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (j *plainSlowJob) SetPayload(data []byte) error {
+	return nil
+}
+
+// TestJobTimeout, job timeout'larının context deadline ile uygulandığını ve
+// bir deneme olarak sayıldığını test eder.
+func TestJobTimeout(t *testing.T) {
+	runJob := func(t *testing.T, job *slowJob, workerTimeout time.Duration) (*memoryTestQueue, *queue.MemoryFailedJobProvider, time.Duration) {
+		t.Helper()
+
+		q := newMemoryTestQueue()
+		q.Push(job, "default")
+
+		failed := queue.NewMemoryFailedJobProvider()
+		worker := queue.NewWorker(q, log.New(io.Discard, "", 0)).
+			SetTimeout(workerTimeout).
+			SetMaxJobs(1).
+			SetFailedJobProvider(failed)
+
+		start := time.Now()
+		done := make(chan struct{})
+		go func() {
+			worker.Work("default")
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			worker.Stop()
+			t.Fatal("Worker durmadı")
+		}
+		return q, failed, time.Since(start)
+	}
+
+	t.Run("JobTimeoutCancelsContext", func(t *testing.T) {
+		job := &slowJob{duration: 2 * time.Second, useCtx: true, cancelled: make(chan struct{})}
+		job.SetID("slow-ctx")
+		job.MaxAttempts = 1
+		job.Timeout = 50 * time.Millisecond
+
+		q, failed, elapsed := runJob(t, job, 0)
+
+		if elapsed > time.Second {
+			t.Errorf("Job timeout'ta kesilmeli (süre: %v)", elapsed)
+		}
+		select {
+		case <-job.cancelled:
+		case <-time.After(time.Second):
+			t.Error("ContextJob'un context'i iptal edilmeli")
+		}
+		if q.released != 1 {
+			t.Errorf("Timeout bir deneme sayılmalı (release: %d)", q.released)
+		}
+
+		records, _ := failed.All()
+		if len(records) != 1 || !strings.Contains(records[0].Exception, queue.ErrJobTimeout.Error()) {
+			t.Errorf("Timeout failed_jobs'a kaydedilmeli: %+v", records)
+		}
+	})
+
+	t.Run("WorkerDefaultTimeout", func(t *testing.T) {
+		job := &slowJob{duration: 300 * time.Millisecond}
+		job.SetID("slow-plain")
+		job.MaxAttempts = 1
+
+		q, failed, _ := runJob(t, job, 50*time.Millisecond)
+
+		if q.released != 1 {
+			t.Errorf("Timeout bir deneme sayılmalı (release: %d)", q.released)
+		}
+		records, _ := failed.All()
+		if len(records) != 1 || !strings.Contains(records[0].Exception, queue.ErrJobTimeout.Error()) {
+			t.Errorf("Worker timeout'u uygulanmalı: %+v", records)
+		}
+	})
+
+	t.Run("PlainJobOutlivesTimeout", func(t *testing.T) {
+		// ContextJob olmayan job iptal edilemez: slot job bitene kadar tutulur
+		// ve kalan denemelere rağmen tekrar denenmez (eşzamanlı ikinci çalışma yok)
+		var running, peak, runs int32
+		job := &plainSlowJob{duration: 300 * time.Millisecond, running: &running, peak: &peak, runs: &runs}
+		job.SetID("plain-outlives")
+		job.MaxAttempts = 3
+		job.Timeout = 50 * time.Millisecond
+
+		q := newMemoryTestQueue()
+		q.Push(job, "default")
+
+		failed := queue.NewMemoryFailedJobProvider()
+		worker := queue.NewWorker(q, log.New(io.Discard, "", 0)).
+			SetConcurrency(2).
+			SetMaxJobs(1).
+			SetFailedJobProvider(failed)
+
+		start := time.Now()
+		done := make(chan struct{})
+		go func() {
+			worker.Work("default")
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			worker.Stop()
+			t.Fatal("Worker durmadı")
+		}
+
+		if elapsed := time.Since(start); elapsed < job.duration {
+			t.Errorf("Slot job bitmeden boşalmamalı (süre: %v)", elapsed)
+		}
+		if atomic.LoadInt32(&running) != 0 {
+			t.Error("Worker durduğunda job hâlâ çalışıyor")
+		}
+		if r, p := atomic.LoadInt32(&runs), atomic.LoadInt32(&peak); r != 1 || p != 1 {
+			t.Errorf("Job bir kez çalışmalı (runs: %d, peak: %d)", r, p)
+		}
+		records, _ := failed.All()
+		if len(records) != 1 || !strings.Contains(records[0].Exception, queue.ErrJobTimeout.Error()) {
+			t.Errorf("Timeout'u aşan düz job tekrar denenmeden kaydedilmeli: %+v", records)
+		}
+	})
+
+	t.Run("FastJobUnaffected", func(t *testing.T) {
+		job := &slowJob{duration: 10 * time.Millisecond}
+		job.SetID("fast")
+
+		q, _, _ := runJob(t, job, time.Second)
+
+		if q.released != 0 {
+			t.Errorf("Süresinde biten job başarısız sayılmamalı (release: %d)", q.released)
+		}
+	})
+}
+
+// TestJobEvents, job yaşam döngüsü event'lerinin doğru sırayla ve job
+// bilgileriyle yayınlandığını test eder.
+func TestJobEvents(t *testing.T) {
+	dispatcher := events.NewDispatcher(log.New(io.Discard, "", 0))
+	defer dispatcher.Shutdown()
+
+	var mu sync.Mutex
+	var fired []string
+	var payloads []*queue.JobEvent
+	for _, name := range []string{
+		queue.EventJobQueued, queue.EventJobStarted, queue.EventJobSucceeded,
+		queue.EventJobFailed, queue.EventJobRetrying,
+	} {
+		dispatcher.Listen(name, events.ListenerFunc(func(e events.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			fired = append(fired, e.Name())
+			payloads = append(payloads, e.Payload().(*queue.JobEvent))
+			return nil
+		}))
+	}
+
+	queue.SetEventDispatcher(dispatcher)
+	defer queue.SetEventDispatcher(nil)
+
+	reset := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		fired, payloads = nil, nil
+	}
+
+	t.Run("Sync", func(t *testing.T) {
+		reset()
+		syncQueue := queue.NewSyncQueue(log.New(io.Discard, "", 0))
+
+		job := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", nil)
+		if err := queue.Dispatch(context.Background(), syncQueue, job, "emails"); err != nil {
+			t.Fatalf("Dispatch hatası: %v", err)
+		}
+
+		want := []string{queue.EventJobQueued, queue.EventJobStarted, queue.EventJobSucceeded}
+		if strings.Join(fired, ",") != strings.Join(want, ",") {
+			t.Fatalf("Event sırası yanlış: %v", fired)
+		}
+		for _, p := range payloads {
+			if p.JobID == "" || p.JobID != job.GetID() {
+				t.Errorf("%s event'inde job ID yok: %q", p.Type, p.JobID)
+			}
+			if p.Queue != "emails" || p.Type != "*jobs.SendEmailJob" {
+				t.Errorf("Event job bilgisi yanlış: %+v", p)
+			}
+		}
+
+		reset()
+		failing := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", failingMailer{})
+		syncQueue.Push(failing, "emails")
+
+		if len(fired) != 2 || fired[1] != queue.EventJobFailed || payloads[1].Error == nil {
+			t.Errorf("Başarısız sync job failed event'i yayınlamalı: %v", fired)
+		}
+	})
+
+	t.Run("WorkerRetryAndFail", func(t *testing.T) {
+		reset()
+		q := newMemoryTestQueue()
+
+		job := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", failingMailer{})
+		job.SetID("events-job")
+		job.MaxAttempts = 2
+		if err := queue.DispatchLater(context.Background(), q, time.Second, job, "emails"); err != nil {
+			t.Fatalf("DispatchLater hatası: %v", err)
+		}
+
+		// memoryTestQueue Release'de job'ı geri eklemez; son deneme ayrı eklenir
+		last := jobs.NewSendEmailJob("test@example.com", "Welcome", "Hello", failingMailer{})
+		last.SetID("events-job")
+		last.Attempts = 1
+		last.MaxAttempts = 2
+		q.Push(last, "emails")
+
+		worker := queue.NewWorker(q, log.New(io.Discard, "", 0)).
+			SetMaxJobs(2).
+			SetRetryDelay(5 * time.Second)
+
+		done := make(chan struct{})
+		go func() {
+			worker.Work("emails")
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			worker.Stop()
+			t.Fatal("Worker max jobs sonrası durmadı")
+		}
+
+		want := []string{
+			queue.EventJobQueued,
+			queue.EventJobStarted, queue.EventJobRetrying,
+			queue.EventJobStarted, queue.EventJobFailed,
+		}
+		if strings.Join(fired, ",") != strings.Join(want, ",") {
+			t.Fatalf("Event sırası yanlış: %v", fired)
+		}
+		if payloads[0].Delay != time.Second {
+			t.Errorf("Queued event gecikmeyi taşımalı: %v", payloads[0].Delay)
+		}
+		if payloads[2].Error == nil || payloads[2].Delay != 5*time.Second || payloads[2].Attempts != 1 || payloads[0].Attempts != 0 {
+			t.Errorf("Retrying event bilgisi yanlış: %+v", payloads[2])
+		}
+		if payloads[4].Error == nil || payloads[4].Attempts != 2 || payloads[4].JobID != "events-job" {
+			t.Errorf("Failed event bilgisi yanlış: %+v", payloads[4])
+		}
+	})
+}
+
+// TestQueueStats, worker metriklerinin ve heartbeat'lerin queue.Stats ile
+// raporlandığını test eder.
+func TestQueueStats(t *testing.T) {
+	queue.SetMetricsStore(queue.NewMemoryMetricsStore())
+	defer queue.SetMetricsStore(queue.NewMemoryMetricsStore())
+
+	q := newMemoryTestQueue()
+	for i := 0; i < 3; i++ {
+		q.Push(jobs.NewSendEmailJob("a@example.com", "Hi", "Hello", nil), "emails")
+	}
+	failing := jobs.NewSendEmailJob("b@example.com", "Hi", "Hello", failingMailer{})
+	failing.MaxAttempts = 1
+	q.Push(failing, "emails")
+	q.Push(jobs.NewSendEmailJob("c@example.com", "Hi", "Hello", nil), "reports")
+
+	worker := queue.NewWorker(q, log.New(io.Discard, "", 0))
+	done := make(chan struct{})
+	go func() {
+		worker.Work("emails")
+		close(done)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	var stats []queue.QueueStats
+	for {
+		var err error
+		if stats, err = queue.Stats(q, "emails", "reports"); err != nil {
+			t.Fatalf("Stats hatası: %v", err)
+		}
+		if stats[0].Processed+stats[0].Failed == 4 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	emails, reports := stats[0], stats[1]
+	if emails.Queue != "emails" || emails.Size != 0 || emails.Processed != 3 || emails.Failed != 1 {
+		t.Errorf("emails stats yanlış: %+v", emails)
+	}
+	if emails.Workers != 1 {
+		t.Errorf("Çalışan worker raporlanmalı: %d", emails.Workers)
+	}
+	if emails.ProcessedPerMinute <= 0 || emails.FailedPerMinute <= 0 {
+		t.Errorf("Son dakikalardaki oranlar hesaplanmalı: %+v", emails)
+	}
+	if reports.Size != 1 || reports.Workers != 0 || reports.Processed != 0 {
+		t.Errorf("reports stats yanlış: %+v", reports)
+	}
+
+	worker.Stop()
+	<-done
+
+	stats, _ = queue.Stats(q, "emails")
+	if stats[0].Workers != 0 {
+		t.Errorf("Duran worker kaydını silmeli: %d", stats[0].Workers)
+	}
+
+	var out strings.Builder
+	if err := queue.WritePrometheus(&out, stats); err != nil {
+		t.Fatalf("WritePrometheus hatası: %v", err)
+	}
+	for _, line := range []string{
+		"# TYPE conduit_queue_jobs_processed_total counter",
+		`conduit_queue_jobs_processed_total{queue="emails"} 3`,
+		`conduit_queue_jobs_failed_total{queue="emails"} 1`,
+		`conduit_queue_size{queue="emails"} 0`,
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("Prometheus çıktısında %q yok:\n%s", line, out.String())
+		}
+	}
+}
+
+// TestRedisQueueStats, Redis metrics store'unun ve en eski job yaşının
+// process'ler arasında okunabildiğini test eder.
+func TestRedisQueueStats(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+
+	redisClient, err := database.NewRedisClient(database.DefaultRedisConfig(), logger)
+	if err != nil {
+		t.Skip("Redis bağlantısı yok, test skip edildi")
+	}
+	defer redisClient.Close()
+
+	prefix := "test:stats:" + time.Now().Format("150405.000") + ":"
+	q := queue.NewRedisQueue(redisClient.Client(), logger, prefix)
+	store := queue.NewRedisMetricsStore(redisClient.Client(), prefix)
+
+	queue.SetMetricsStore(store)
+	defer queue.SetMetricsStore(queue.NewMemoryMetricsStore())
+
+	q.Push(jobs.NewSendEmailJob("a@example.com", "Hi", "Hello", nil), "emails")
+	store.RecordJob("emails", false, time.Now())
+	store.RecordJob("emails", true, time.Now())
+	store.Heartbeat("worker-1", []string{"emails"}, time.Now())
+	store.Heartbeat("worker-2", []string{"emails"}, time.Now().Add(-time.Hour))
+
+	time.Sleep(1100 * time.Millisecond)
+
+	stats, err := queue.Stats(q, "emails")
+	if err != nil {
+		t.Fatalf("Stats hatası: %v", err)
+	}
+	s := stats[0]
+	if s.Size != 1 || s.Processed != 1 || s.Failed != 1 || s.Workers != 1 {
+		t.Errorf("Redis stats yanlış: %+v", s)
+	}
+	if s.OldestJobAge < time.Second {
+		t.Errorf("En eski job yaşı raporlanmalı: %v", s.OldestJobAge)
+	}
+}
+
+// TestQueueRestart, queue:restart sinyalinin çalışan worker'ı mevcut job
+// bittikten sonra durdurduğunu test eder.
+func TestQueueRestart(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	store := cache.NewMemoryCache(logger)
+
+	// Worker başlamadan önceki sinyal yok sayılmalı
+	if err := queue.SignalRestart(store); err != nil {
+		t.Fatalf("SignalRestart hatası: %v", err)
+	}
+
+	// Job, restart kontrolünden (3s) uzun sürer
+	q := newMemoryTestQueue()
+	job := &slowJob{duration: 3500 * time.Millisecond}
+	job.SetID("restart-job")
+	q.Push(job, "default")
+
+	worker := queue.NewWorker(q, logger).SetRestartSignal(store)
+	started := time.Now()
+	done := make(chan struct{})
+	go func() {
+		worker.Work("default")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Worker eski restart sinyaliyle durmamalı")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := queue.SignalRestart(store); err != nil {
+		t.Fatalf("SignalRestart hatası: %v", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		worker.Stop()
+		t.Fatal("Worker restart sinyaliyle durmadı")
+	}
+
+	if elapsed := time.Since(started); elapsed < job.duration {
+		t.Errorf("Worker mevcut job'ı bitirmeden durmamalı (%v)", elapsed)
+	}
+	if q.released != 0 {
+		t.Errorf("Job başarıyla bitmeli, release edilmemeli: %d", q.released)
+	}
+}
+
+// flakyRestartCache, ilk Get çağrılarında hata döndüren cache'dir.
+type flakyRestartCache struct {
+	cache.Cache
+	failures int32
+}
+
+func (c *flakyRestartCache) Get(key string) (interface{}, error) {
+	if atomic.AddInt32(&c.failures, -1) >= 0 {
+		return nil, errors.New("cache geçici olarak erişilemez")
+	}
+	return c.Cache.Get(key)
+}
+
+// TestQueueRestartIgnoresMissingStamp, ilk okuması başarısız olan veya
+// damgası silinen (cache:clear, eviction) worker'ların sinyal olmadan
+// durmadığını test eder.
+func TestQueueRestartIgnoresMissingStamp(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+
+	// Worker başlamadan önceki sinyal
+	flaky := &flakyRestartCache{Cache: cache.NewMemoryCache(logger), failures: 1}
+	evicted := cache.NewMemoryCache(logger)
+	for _, store := range []cache.Cache{flaky.Cache, evicted} {
+		if err := queue.SignalRestart(store); err != nil {
+			t.Fatalf("SignalRestart hatası: %v", err)
+		}
+	}
+
+	start := func(store cache.Cache) (*queue.Worker, chan struct{}) {
+		worker := queue.NewWorker(newMemoryTestQueue(), logger).SetRestartSignal(store)
+		done := make(chan struct{})
+		go func() {
+			worker.Work("default")
+			close(done)
+		}()
+		return worker, done
+	}
+
+	flakyWorker, flakyDone := start(flaky)
+	evictedWorker, evictedDone := start(evicted)
+	defer flakyWorker.Stop()
+	defer evictedWorker.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+	evicted.Delete(queue.RestartCacheKey)
+
+	// İlk restart kontrolü (3s) geçene kadar ikisi de çalışmalı
+	select {
+	case <-flakyDone:
+		t.Fatal("İlk okuması başarısız olan worker eski damgayla durmamalı")
+	case <-evictedDone:
+		t.Fatal("Damgası silinen worker durmamalı")
+	case <-time.After(3500 * time.Millisecond):
+	}
+}
+
+// queuedWelcomeListener, kuyrukta çalışan test listener'ıdır.
+type queuedWelcomeListener struct {
+	events.Queued
+	handled chan string
+}
+
+func (l *queuedWelcomeListener) Handle(e events.Event) error {
+	var user struct {
+		Email string `json:"email"`
+	}
+	if err := events.DecodePayload(e, &user); err != nil {
+		return err
+	}
+	l.handled <- user.Email
+	return nil
+}
+
+// delayRecordingQueue, Later'a verilen gecikmeyi kaydeder.
+type delayRecordingQueue struct {
+	*memoryTestQueue
+	delays map[string]time.Duration
+}
+
+func (d *delayRecordingQueue) Later(delay time.Duration, job queue.Job, name string) error {
+	d.delays[name] = delay
+	return d.Push(job, name)
+}
+
+// TestQueuedListeners, ShouldQueue listener'larının inline çalışmak yerine
+// kuyruğa eklendiğini ve worker'da factory'den oluşturulup çalıştığını test
+// eder.
+func TestQueuedListeners(t *testing.T) {
+	type registeredUser struct {
+		Email string `json:"email"`
+	}
+
+	workerHandled := make(chan string, 1)
+	queue.RegisterListener(func() *queuedWelcomeListener {
+		return &queuedWelcomeListener{handled: workerHandled}
+	})
+
+	dispatcher := events.NewDispatcher(log.New(io.Discard, "", 0))
+	defer dispatcher.Shutdown()
+
+	inline := make(chan string, 1)
+	dispatcher.Listen(events.EventUserRegistered, &queuedWelcomeListener{
+		Queued:  events.Queued{Queue: "emails", Delay: time.Minute},
+		handled: inline,
+	})
+
+	t.Run("InlineWithoutQueue", func(t *testing.T) {
+		if err := dispatcher.Dispatch(events.NewUserRegisteredEvent(&registeredUser{Email: "inline@example.com"})); err != nil {
+			t.Fatalf("Dispatch hatası: %v", err)
+		}
+		if got := <-inline; got != "inline@example.com" {
+			t.Errorf("Queue yokken listener inline çalışmalı, payload: %q", got)
+		}
+	})
+
+	t.Run("QueuedWithOptions", func(t *testing.T) {
+		q := &delayRecordingQueue{memoryTestQueue: newMemoryTestQueue(), delays: make(map[string]time.Duration)}
+		dispatcher.SetQueue(queue.NewListenerQueue(q, ""))
+		defer dispatcher.SetQueue(nil)
+
+		if err := dispatcher.Dispatch(events.NewUserRegisteredEvent(&registeredUser{Email: "queued@example.com"})); err != nil {
+			t.Fatalf("Dispatch hatası: %v", err)
+		}
+		if len(inline) != 0 {
+			t.Fatal("ShouldQueue listener inline çalışmamalı")
+		}
+		if q.delays["emails"] != time.Minute {
+			t.Errorf("Listener'ın kuyruk/gecikme ayarı kullanılmalı: %v", q.delays)
+		}
+
+		pushed, _ := q.Pop("emails")
+		if pushed == nil {
+			t.Fatal("Listener emails kuyruğuna eklenmeli")
+		}
+
+		// Worker tarafı: payload'dan yeni job oluştur
+		data, err := pushed.GetPayload()
+		if err != nil {
+			t.Fatalf("Payload hatası: %v", err)
+		}
+		job, err := queue.JobRegistry.Create(queue.JobTypeName(pushed))
+		if err != nil {
+			t.Fatalf("Job tipi kayıtlı olmalı: %v", err)
+		}
+		if err := job.SetPayload(data); err != nil {
+			t.Fatalf("SetPayload hatası: %v", err)
+		}
+		if err := job.Handle(); err != nil {
+			t.Fatalf("Queued listener hatası: %v", err)
+		}
+		if got := <-workerHandled; got != "queued@example.com" {
+			t.Errorf("Worker'daki listener payload'ı çözmeli, %q geldi", got)
+		}
+	})
+
+	t.Run("UnregisteredListener", func(t *testing.T) {
+		job := &queue.CallQueuedListenerJob{Listener: "*tests.unknownListener", Event: "user.registered"}
+		if err := job.Handle(); err == nil || !strings.Contains(err.Error(), "register edilmemiş") {
+			t.Errorf("Kayıtsız listener hata döndürmeli: %v", err)
+		}
+	})
+}
+
+// secretJob, payload'ı şifrelenerek saklanan test job'ı.
+type secretJob struct {
+	queue.BaseJob
+	CardToken string `json:"card_token"`
+}
+
+func (j *secretJob) ShouldBeEncrypted() bool { return true }
+
+func (j *secretJob) Handle() error { return nil }
+
+func (j *secretJob) Failed(err error) error { return nil }
+
+func (j *secretJob) GetPayload() ([]byte, error) { return json.Marshal(j) }
+
+func (j *secretJob) SetPayload(data []byte) error { return json.Unmarshal(data, j) }
+
+// TestEncryptedJobPayload, ShouldBeEncrypted job'ların payload'ının saklandığı
+// yerde düz metin olmadığını ve aynı APP_KEY ile çözüldüğünü test eder.
+func TestEncryptedJobPayload(t *testing.T) {
+	queue.RegisterType(func() *secretJob { return &secretJob{} })
+
+	key, _ := crypt.GenerateKey()
+	encrypter, err := crypt.NewFromAppKey(key)
+	if err != nil {
+		t.Fatalf("NewFromAppKey error: %v", err)
+	}
+	defer queue.SetEncrypter(nil)
+
+	job := &secretJob{CardToken: "tok_4242424242"}
+	job.SetID("secret-1")
+	job.MaxAttempts = 1
+
+	// Encrypter yoksa düz metne düşülmez
+	queue.SetEncrypter(nil)
+	failed := queue.NewMemoryFailedJobProvider()
+	if _, err := failed.Log("payments", job, errors.New("boom")); !errors.Is(err, queue.ErrEncrypterNotSet) {
+		t.Fatalf("Encrypter olmadan şifreli job saklanmamalı: %v", err)
+	}
+
+	queue.SetEncrypter(encrypter)
+	id, err := failed.Log("payments", job, errors.New("boom"))
+	if err != nil {
+		t.Fatalf("Log hatası: %v", err)
+	}
+	record, _ := failed.Find(id)
+	if strings.Contains(record.Payload, "tok_4242424242") {
+		t.Errorf("Payload düz metin içermemeli: %s", record.Payload)
+	}
+	if payload, err := record.JobPayload(); err != nil || !payload.Encrypted || payload.Type != "*tests.secretJob" {
+		t.Errorf("Wrapper metadata'sı düz kalmalı: %+v (%v)", payload, err)
+	}
+
+	// Retry aynı anahtarla çözer
+	q := newMemoryTestQueue()
+	if err := queue.RetryFailed(q, record); err != nil {
+		t.Fatalf("RetryFailed hatası: %v", err)
+	}
+	retried, _ := q.Pop("payments")
+	if got := retried.(*secretJob).CardToken; got != "tok_4242424242" {
+		t.Errorf("CardToken = %q", got)
+	}
+
+	// Farklı anahtar veya encrypter olmadan çözülemez
+	otherKey, _ := crypt.GenerateKey()
+	other, _ := crypt.NewFromAppKey(otherKey)
+	queue.SetEncrypter(other)
+	if err := queue.RetryFailed(q, record); !errors.Is(err, crypt.ErrInvalidPayload) {
+		t.Errorf("Farklı APP_KEY ile çözülmemeli: %v", err)
+	}
+	queue.SetEncrypter(nil)
+	if err := queue.RetryFailed(q, record); !errors.Is(err, queue.ErrEncrypterNotSet) {
+		t.Errorf("Encrypter olmadan çözülmemeli: %v", err)
+	}
+}
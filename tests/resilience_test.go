@@ -0,0 +1,168 @@
+// -----------------------------------------------------------------------------
+// Resilience Policy Tests
+// -----------------------------------------------------------------------------
+// Timeout, retry ve circuit breaker davranışlarını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/resilience"
+)
+
+func TestPolicyRetriesUntilSuccess(t *testing.T) {
+	policy := resilience.NewPolicy("test", resilience.Options{MaxRetries: 3, Backoff: time.Millisecond})
+
+	calls := 0
+	err := policy.Execute(context.Background(), func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("geçici hata")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Üçüncü denemede başarılı olmalıydı: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Deneme sayısı = %d, beklenen 3", calls)
+	}
+}
+
+func TestPolicyPermanentErrorIsNotRetried(t *testing.T) {
+	policy := resilience.NewPolicy("test", resilience.Options{MaxRetries: 3, Backoff: time.Millisecond})
+	cause := errors.New("geçersiz alıcı")
+
+	calls := 0
+	err := policy.Execute(context.Background(), func(ctx context.Context) error {
+		calls++
+		return resilience.Permanent(cause)
+	})
+
+	if !errors.Is(err, cause) {
+		t.Errorf("Orijinal hata dönmeliydi: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Permanent hata retry edilmemeli, deneme sayısı: %d", calls)
+	}
+}
+
+func TestPolicyTimeout(t *testing.T) {
+	policy := resilience.NewPolicy("test", resilience.Options{Timeout: 20 * time.Millisecond})
+
+	err := policy.Execute(context.Background(), func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Timeout hatası bekleniyordu: %v", err)
+	}
+}
+
+func TestPolicyCircuitBreaker(t *testing.T) {
+	policy := resilience.NewPolicy("test", resilience.Options{
+		CircuitThreshold: 2,
+		CircuitCooldown:  50 * time.Millisecond,
+	})
+	failing := func(ctx context.Context) error { return errors.New("servis kapalı") }
+
+	policy.Execute(context.Background(), failing)
+	policy.Execute(context.Background(), failing)
+
+	if policy.State() != "open" {
+		t.Fatalf("İki hatadan sonra circuit açık olmalı, durum: %s", policy.State())
+	}
+
+	calls := 0
+	err := policy.Execute(context.Background(), func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+	if !errors.Is(err, resilience.ErrCircuitOpen) || calls != 0 {
+		t.Errorf("Circuit açıkken çağrı yapılmamalı: err=%v calls=%d", err, calls)
+	}
+
+	// Cooldown sonrası başarılı deneme circuit'i kapatmalı
+	time.Sleep(60 * time.Millisecond)
+	if err := policy.Execute(context.Background(), func(ctx context.Context) error { return nil }); err != nil {
+		t.Fatalf("Half-open denemesine izin verilmeli: %v", err)
+	}
+	if policy.State() != "closed" {
+		t.Errorf("Başarılı denemeden sonra circuit kapanmalı, durum: %s", policy.State())
+	}
+}
+
+func TestPolicyPermanentErrorsDoNotOpenCircuit(t *testing.T) {
+	policy := resilience.NewPolicy("test", resilience.Options{
+		CircuitThreshold: 2,
+		CircuitCooldown:  time.Minute,
+	})
+
+	// Çağıran kaynaklı hatalar (4xx, geçersiz alıcı) servis arızası değildir
+	for i := 0; i < 5; i++ {
+		policy.Execute(context.Background(), func(ctx context.Context) error {
+			return resilience.Permanent(errors.New("geçersiz alıcı"))
+		})
+	}
+	if policy.State() != "closed" {
+		t.Fatalf("Permanent hatalar circuit'i açmamalı, durum: %s", policy.State())
+	}
+
+	// Çağıranın iptal ettiği istekler de sayılmaz
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		policy.Execute(ctx, func(ctx context.Context) error { return ctx.Err() })
+	}
+	if policy.State() != "closed" {
+		t.Fatalf("Çağıranın iptali circuit'i açmamalı, durum: %s", policy.State())
+	}
+
+	if err := policy.Execute(context.Background(), func(ctx context.Context) error { return nil }); err != nil {
+		t.Errorf("Sağlıklı çağrı geçmeli: %v", err)
+	}
+}
+
+func TestPolicyRegistryFallsBackToDefault(t *testing.T) {
+	resilience.Register(resilience.PolicyDefault, resilience.Options{MaxRetries: 7})
+
+	if got := resilience.Get("tanimsiz-policy").Options.MaxRetries; got != 7 {
+		t.Errorf("Tanımsız policy default'a düşmeli, MaxRetries = %d", got)
+	}
+}
+
+func TestHTTPClientRetriesServerErrors(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	resilience.Register("test-http", resilience.Options{Timeout: time.Second, MaxRetries: 2, Backoff: time.Millisecond})
+	client := resilience.NewHTTPClient("test-http")
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("İkinci denemede başarılı olmalıydı: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || atomic.LoadInt32(&hits) != 2 {
+		t.Errorf("Beklenmeyen sonuç: status=%d hits=%d", resp.StatusCode, hits)
+	}
+}
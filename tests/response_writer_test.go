@@ -0,0 +1,123 @@
+// -----------------------------------------------------------------------------
+// Response Writer Tests
+// -----------------------------------------------------------------------------
+// response.Writer'ın status/boyut yakalamasını, hook'larını ve router
+// entegrasyonunu test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/router"
+)
+
+func TestResponseWriterCapture(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := response.NewWriter(rec)
+
+	calls := 0
+	w.BeforeWriteHeader(func(w *response.Writer) {
+		calls++
+		w.Header().Set("X-Hook", "before")
+	})
+
+	if w.Written() || w.Status() != 0 {
+		t.Fatal("writer should start unwritten")
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(http.StatusInternalServerError) // Yok sayılır
+	w.Write([]byte("hello"))
+	w.Write([]byte(" world"))
+
+	if w.Status() != http.StatusCreated || w.Size() != 11 || !w.Written() {
+		t.Errorf("status = %d, size = %d", w.Status(), w.Size())
+	}
+	if calls != 1 || rec.Header().Get("X-Hook") != "before" || rec.Code != http.StatusCreated {
+		t.Errorf("before hook calls = %d, header = %q, code = %d", calls, rec.Header().Get("X-Hook"), rec.Code)
+	}
+	if response.WrapWriter(w) != w {
+		t.Error("WrapWriter should reuse an existing Writer")
+	}
+}
+
+func TestResponseWriterInformational(t *testing.T) {
+	var writtenAfterHints bool
+	server := httptest.NewServer(http.HandlerFunc(func(rec http.ResponseWriter, r *http.Request) {
+		w := response.NewWriter(rec)
+		w.Header().Set("Link", "</app.css>; rel=preload")
+		w.WriteHeader(http.StatusEarlyHints) // 1xx header'ları göndermiş sayılmaz
+		writtenAfterHints = w.Written()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if writtenAfterHints || res.StatusCode != http.StatusCreated {
+		t.Errorf("written after 103 = %v, final status = %d", writtenAfterHints, res.StatusCode)
+	}
+}
+
+func TestResponseWriterFlushSendsHeaders(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := response.NewWriter(rec)
+	w.BeforeWriteHeader(func(w *response.Writer) { w.Header().Set("X-Hook", "flush") })
+
+	if err := http.NewResponseController(w).Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if !rec.Flushed || w.Status() != http.StatusOK || rec.Header().Get("X-Hook") != "flush" {
+		t.Errorf("flushed = %v, status = %d", rec.Flushed, w.Status())
+	}
+}
+
+func TestRouterResponseWriterHooks(t *testing.T) {
+	r := router.New()
+
+	var status int
+	var size int64
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			rw, ok := w.(*response.Writer)
+			if !ok {
+				t.Fatalf("router should pass a *response.Writer, got %T", w)
+			}
+			rw.AfterWrite(func(rw *response.Writer) {
+				status, size = rw.Status(), rw.Size()
+			})
+			next.ServeHTTP(w, req)
+		})
+	})
+	r.GET("/ok", func(w http.ResponseWriter, req *conduitReq.Request) {
+		response.Success(w, http.StatusAccepted, "tamam", nil)
+	})
+	r.GET("/empty", func(w http.ResponseWriter, req *conduitReq.Request) {})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/ok", nil))
+	if status != http.StatusAccepted || size != int64(w.Body.Len()) {
+		t.Errorf("after hook saw status %d size %d, body %d bytes", status, size, w.Body.Len())
+	}
+
+	// Hiçbir şey yazmayan handler: 200
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/empty", nil))
+	if status != http.StatusOK || size != 0 {
+		t.Errorf("empty handler: status %d size %d", status, size)
+	}
+
+	// 404 de aynı Writer'dan geçer
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/missing", nil))
+	if status != http.StatusNotFound {
+		t.Errorf("not found status = %d", status)
+	}
+}
@@ -0,0 +1,48 @@
+// -----------------------------------------------------------------------------
+// Route Table Tests
+// -----------------------------------------------------------------------------
+// route:list ve route cache'inin kullandığı Router.Routes çıktısını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/internal/router"
+)
+
+type routeTableController struct{}
+
+func (routeTableController) Show(w http.ResponseWriter, r *conduitReq.Request) {}
+
+func TestRouterRoutes(t *testing.T) {
+	r := router.New()
+	r.CORS("public")
+	r.GET("/health", func(w http.ResponseWriter, r *conduitReq.Request) {})
+
+	admin := r.Group("/api/admin")
+	admin.CORS("admin")
+	admin.Use(middleware.Auth())
+	admin.GET("/users/{id}", routeTableController{}.Show).Middleware(middleware.NotImpersonating())
+
+	routes := r.Routes()
+	if len(routes) != 2 {
+		t.Fatalf("Routes = %d, want 2", len(routes))
+	}
+
+	if got := routes[0]; got.Method != "GET" || got.Path != "/health" || got.Middleware != 0 || got.CORSPolicy != "public" {
+		t.Errorf("health route = %+v", got)
+	}
+
+	got := routes[1]
+	if got.Path != "/api/admin/users/{id}" || got.Middleware != 2 || got.CORSPolicy != "admin" {
+		t.Errorf("admin route = %+v", got)
+	}
+	if got.Handler != "tests.routeTableController.Show" {
+		t.Errorf("Handler = %q", got.Handler)
+	}
+}
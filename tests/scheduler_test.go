@@ -0,0 +1,143 @@
+// -----------------------------------------------------------------------------
+// Scheduler Tests
+// -----------------------------------------------------------------------------
+// Cron ifadelerinin ayrıştırılmasını, sonraki çalışma zamanı hesabını ve
+// due görevlerin çalıştırılmasını (hata, panic, overlap) test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/scheduler"
+)
+
+func TestCronNext(t *testing.T) {
+	// 2024-01-15 bir Pazartesi
+	from := time.Date(2024, 1, 15, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		want       time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 15, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{"0 * * * *", time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"30 8 * * *", time.Date(2024, 1, 16, 8, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 9 * * 6,7", time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		// Ayın günü ve haftanın günü birlikte: herhangi biri
+		{"0 0 20 * 3", time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		cron, err := scheduler.ParseCron(tt.expression)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.expression, err)
+			continue
+		}
+		if got := cron.Next(from); !got.Equal(tt.want) {
+			t.Errorf("%s: Next = %s, want %s", tt.expression, got, tt.want)
+		}
+	}
+
+	never, _ := scheduler.ParseCron("0 0 30 2 *")
+	if !never.Next(from).IsZero() {
+		t.Error("impossible expression should return zero time")
+	}
+
+	for _, invalid := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "@often"} {
+		if _, err := scheduler.ParseCron(invalid); err == nil {
+			t.Errorf("%q should be rejected", invalid)
+		}
+	}
+}
+
+func TestSchedulerTasks(t *testing.T) {
+	s := scheduler.New(log.New(io.Discard, "", 0)).SetLocation(time.UTC)
+	noop := func(ctx context.Context) error { return nil }
+
+	s.Call("every-minute", noop)
+	s.Call("daily", noop).DailyAt("08:05")
+	s.Call("weekdays", noop).DailyAt("9:00").Weekdays()
+
+	expressions := map[string]string{}
+	for _, task := range s.Tasks() {
+		expressions[task.Name()] = task.Expression()
+	}
+	if expressions["every-minute"] != "* * * * *" || expressions["daily"] != "5 8 * * *" || expressions["weekdays"] != "0 9 * * 1-5" {
+		t.Errorf("unexpected expressions: %v", expressions)
+	}
+
+	// Cumartesi 08:05
+	due := s.DueTasks(time.Date(2024, 1, 20, 8, 5, 0, 0, time.UTC))
+	if len(due) != 2 || due[0].Name() != "every-minute" || due[1].Name() != "daily" {
+		t.Errorf("unexpected due tasks: %d", len(due))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("duplicate task name should panic")
+		}
+	}()
+	s.Call("daily", noop)
+}
+
+func TestSchedulerRunDue(t *testing.T) {
+	s := scheduler.New(log.New(io.Discard, "", 0))
+	boom := errors.New("boom")
+	var ran atomic.Int32
+
+	s.Call("ok", func(ctx context.Context) error { ran.Add(1); return nil })
+	s.Call("fails", func(ctx context.Context) error { return boom })
+	s.Call("panics", func(ctx context.Context) error { panic("oops") })
+
+	results := s.RunDue(context.Background(), time.Now())
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Task != "ok" || results[0].Err != nil || ran.Load() != 1 {
+		t.Errorf("ok task result: %+v", results[0])
+	}
+	if !errors.Is(results[1].Err, boom) {
+		t.Errorf("failing task should return its error: %v", results[1].Err)
+	}
+	if results[2].Err == nil {
+		t.Error("panic should be reported as error")
+	}
+}
+
+func TestSchedulerWithoutOverlapping(t *testing.T) {
+	s := scheduler.New(log.New(io.Discard, "", 0))
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	s.Call("slow", func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}).WithoutOverlapping()
+
+	first := make(chan []scheduler.Result)
+	go func() { first <- s.RunDue(context.Background(), time.Now()) }()
+	<-started
+
+	second := s.RunDue(context.Background(), time.Now())
+	if len(second) != 1 || !second[0].Skipped {
+		t.Errorf("overlapping run should be skipped: %+v", second)
+	}
+
+	close(release)
+	if results := <-first; results[0].Skipped || results[0].Err != nil {
+		t.Errorf("first run should complete: %+v", results[0])
+	}
+}
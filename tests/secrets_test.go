@@ -0,0 +1,195 @@
+// -----------------------------------------------------------------------------
+// Secrets Tests
+// -----------------------------------------------------------------------------
+// secret:// ve vault:// referanslarının parse edilmesini, AWS Secrets
+// Manager ve Vault resolver'larını ve config yüklenirken çözülmesini test
+// eder. Provider'lar httptest sunucularıyla taklit edilir.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/config"
+	"github.com/biyonik/conduit-go/pkg/secrets"
+)
+
+func TestSecretReferenceParse(t *testing.T) {
+	secrets.Register("test-sm", secrets.ResolverFunc(func(ctx context.Context, path, key string) (string, error) {
+		return path + "|" + key, nil
+	}))
+
+	tests := []struct {
+		value string
+		ref   secrets.Reference
+		isRef bool
+	}{
+		{"secret://aws-sm/prod/app#db_dsn", secrets.Reference{Provider: "aws-sm", Path: "prod/app", Key: "db_dsn"}, true},
+		{"test-sm://kv/app#key", secrets.Reference{Provider: "test-sm", Path: "kv/app", Key: "key"}, true},
+		{"secret://test-sm/plain", secrets.Reference{Provider: "test-sm", Path: "plain"}, true},
+		{"https://example.com/#x", secrets.Reference{}, false},
+		{"plain-value", secrets.Reference{}, false},
+	}
+	for _, tt := range tests {
+		ref, isRef, err := secrets.Parse(tt.value)
+		if err != nil || isRef != tt.isRef || ref != tt.ref {
+			t.Errorf("Parse(%q) = %+v, %v, %v", tt.value, ref, isRef, err)
+		}
+	}
+
+	if _, _, err := secrets.Parse("secret://aws-sm"); err == nil {
+		t.Error("reference without path should fail")
+	}
+
+	if value, err := secrets.Resolve(context.Background(), "test-sm://kv/app#key"); err != nil || value != "kv/app|key" {
+		t.Errorf("Resolve = %q, %v", value, err)
+	}
+	if value, _ := secrets.Resolve(context.Background(), "not-a-ref"); value != "not-a-ref" {
+		t.Errorf("plain value changed: %q", value)
+	}
+	if _, err := secrets.Resolve(context.Background(), "secret://missing-provider/x"); err == nil {
+		t.Error("unknown provider should fail")
+	}
+}
+
+// fakeVault, KV v2 API'sini taklit eder.
+func fakeVault(t *testing.T, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		if r.Header.Get("X-Vault-Token") != "root-token" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/app" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"data":{"jwt_secret":"vault-jwt-secret-0123456789abcdef0123","port":5432}}}`))
+	}))
+}
+
+func TestVaultResolver(t *testing.T) {
+	var calls int32
+	srv := fakeVault(t, &calls)
+	defer srv.Close()
+
+	vault := secrets.NewVault(secrets.VaultConfig{Address: srv.URL, Token: "root-token"})
+	ctx := context.Background()
+
+	if value, err := vault.Resolve(ctx, "secret/app", "jwt_secret"); err != nil || !strings.HasPrefix(value, "vault-jwt") {
+		t.Errorf("jwt_secret = %q, %v", value, err)
+	}
+	if value, err := vault.Resolve(ctx, "secret/app", "port"); err != nil || value != "5432" {
+		t.Errorf("port = %q, %v", value, err)
+	}
+	if calls != 1 {
+		t.Errorf("vault calls = %d, want 1 (cached)", calls)
+	}
+
+	if _, err := vault.Resolve(ctx, "secret/app", "missing"); !errors.Is(err, secrets.ErrNotFound) {
+		t.Errorf("missing field error = %v", err)
+	}
+	if _, err := vault.Resolve(ctx, "secret/other", "x"); !errors.Is(err, secrets.ErrNotFound) {
+		t.Errorf("missing secret error = %v", err)
+	}
+	if _, err := vault.Resolve(ctx, "secret/app", ""); err == nil {
+		t.Error("vault reference without key should fail")
+	}
+
+	denied := secrets.NewVault(secrets.VaultConfig{Address: srv.URL, Token: "wrong"})
+	if _, err := denied.Resolve(ctx, "secret/app", "jwt_secret"); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Errorf("denied error = %v", err)
+	}
+}
+
+// fakeSecretsManager, GetSecretValue API'sini taklit eder.
+func fakeSecretsManager(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIATEST/") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var req struct{ SecretId string }
+		json.NewDecoder(r.Body).Decode(&req)
+		switch req.SecretId {
+		case "prod/db":
+			json.NewEncoder(w).Encode(map[string]string{
+				"SecretString": `{"dsn":"app:s3cret@tcp(db:3306)/app?parseTime=true"}`,
+			})
+		case "prod/raw":
+			json.NewEncoder(w).Encode(map[string]string{"SecretString": "raw-value"})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"not found"}`))
+		}
+	}))
+}
+
+func TestAWSSecretsManagerResolver(t *testing.T) {
+	srv := fakeSecretsManager(t)
+	defer srv.Close()
+
+	sm := secrets.NewAWSSecretsManager(secrets.AWSConfig{
+		Region: "eu-central-1", AccessKeyID: "AKIATEST", SecretAccessKey: "secret", Endpoint: srv.URL,
+	})
+	ctx := context.Background()
+
+	if value, err := sm.Resolve(ctx, "prod/db", "dsn"); err != nil || !strings.HasPrefix(value, "app:s3cret@") {
+		t.Errorf("dsn = %q, %v", value, err)
+	}
+	if value, err := sm.Resolve(ctx, "prod/raw", ""); err != nil || value != "raw-value" {
+		t.Errorf("raw = %q, %v", value, err)
+	}
+	if _, err := sm.Resolve(ctx, "prod/missing", ""); !errors.Is(err, secrets.ErrNotFound) {
+		t.Errorf("missing error = %v", err)
+	}
+}
+
+func TestConfigResolvesSecretReferences(t *testing.T) {
+	var calls int32
+	vault := fakeVault(t, &calls)
+	defer vault.Close()
+	sm := fakeSecretsManager(t)
+	defer sm.Close()
+
+	t.Setenv("CONFIG_CACHE_PATH", filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv("CONFIG_PATH", t.TempDir())
+	t.Setenv("APP_ENV", "production")
+	t.Setenv("APP_URL", "https://example.com")
+	t.Setenv("VAULT_ADDR", vault.URL)
+	t.Setenv("VAULT_TOKEN", "root-token")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIATEST")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("SECRETS_AWS_ENDPOINT", sm.URL)
+	t.Setenv("JWT_SECRET", "vault://secret/app#jwt_secret")
+	t.Setenv("DB_DSN", "secret://aws-sm/prod/db#dsn")
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if !strings.HasPrefix(cfg.JWT.Secret, "vault-jwt") {
+		t.Errorf("JWT secret = %q", cfg.JWT.Secret)
+	}
+	if !strings.HasPrefix(cfg.DB.DSN, "app:s3cret@") {
+		t.Errorf("DB DSN = %q", cfg.DB.DSN)
+	}
+
+	t.Setenv("DB_DSN", "secret://aws-sm/prod/missing#dsn")
+	if _, err := config.LoadConfig(); err == nil || !strings.Contains(err.Error(), "DB_DSN") {
+		t.Errorf("unresolvable secret should fail validation, got %v", err)
+	}
+}
@@ -14,7 +14,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/biyonik/conduit-go/internal/config"
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
 	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/internal/router"
 	"github.com/biyonik/conduit-go/pkg/database"
 )
 
@@ -29,7 +32,7 @@ func TestSQLInjectionProtection_OrderBy(t *testing.T) {
 	validDirections := []string{"ASC", "asc", "DESC", "desc"}
 	for _, dir := range validDirections {
 		qb.OrderBy("name", dir)
-		sql, _ := grammar.CompileSelect(qb)
+		sql, _, _ := grammar.CompileSelect(qb)
 
 		// SQL içinde sadece uppercase direction olmalı
 		expectedDir := dir
@@ -57,7 +60,7 @@ func TestSQLInjectionProtection_OrderBy(t *testing.T) {
 		qb.Table("users")
 		qb.OrderBy("name", malicious)
 
-		sql, _ := grammar.CompileSelect(qb)
+		sql, _, _ := grammar.CompileSelect(qb)
 
 		// Malicious input ASC'ye dönüştürülmeli (whitelist default)
 		if !contains(sql, "ASC") {
@@ -89,13 +92,13 @@ func TestSQLInjectionProtection_Wrap(t *testing.T) {
 	}
 
 	for _, identifier := range validIdentifiers {
-		result := grammar.Wrap(identifier)
-		if result == "" {
+		result, err := grammar.Wrap(identifier)
+		if err != nil || result == "" {
 			t.Errorf("Valid identifier '%s' should be wrapped", identifier)
 		}
 	}
 
-	// Test 2: Geçersiz identifier'lar (panic beklenecek)
+	// Test 2: Geçersiz identifier'lar (hata beklenecek)
 	invalidIdentifiers := []string{
 		"users; DROP TABLE users--",
 		"users' OR '1'='1",
@@ -104,14 +107,9 @@ func TestSQLInjectionProtection_Wrap(t *testing.T) {
 	}
 
 	for _, identifier := range invalidIdentifiers {
-		func() {
-			defer func() {
-				if r := recover(); r == nil {
-					t.Errorf("Invalid identifier '%s' should cause panic", identifier)
-				}
-			}()
-			grammar.Wrap(identifier)
-		}()
+		if _, err := grammar.Wrap(identifier); err == nil {
+			t.Errorf("Invalid identifier '%s' should return an error", identifier)
+		}
 	}
 }
 
@@ -309,3 +307,163 @@ func TestMemoryLeakProtection_ScannerCache(t *testing.T) {
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && (s[:len(substr)] == substr || s[len(s)-len(substr):] == substr || strings.Contains(s, substr)))
 }
+
+// TestSecurityProfile_EnvironmentDefaults, güvenlik profilinin ortama göre
+// sıkı/esnek varsayılanlar ürettiğini ve override edilebildiğini test eder.
+func TestSecurityProfile_EnvironmentDefaults(t *testing.T) {
+	// Production: strict
+	t.Setenv("APP_ENV", "production")
+	t.Setenv("APP_URL", "https://app.example.com")
+	prod := middleware.SecurityProfileFromConfig(config.Load())
+
+	if !prod.CookieSecure || prod.CookieSameSite != http.SameSiteStrictMode {
+		t.Errorf("Production cookie'leri Secure ve SameSite=Strict olmalı, got: %+v", prod)
+	}
+	if !prod.HSTSEnabled {
+		t.Error("Production'da HSTS aktif olmalı")
+	}
+	if len(prod.CORSAllowedOrigins) != 1 || prod.CORSAllowedOrigins[0] != "https://app.example.com" {
+		t.Errorf("Production CORS origin'i APP_URL olmalı, got: %v", prod.CORSAllowedOrigins)
+	}
+
+	// Development: relaxed
+	t.Setenv("APP_ENV", "development")
+	dev := middleware.SecurityProfileFromConfig(config.Load())
+	if dev.CookieSecure || dev.HSTSEnabled {
+		t.Errorf("Development'ta Secure cookie ve HSTS kapalı olmalı, got: %+v", dev)
+	}
+
+	// Explicit override
+	t.Setenv("SECURITY_COOKIE_SECURE", "true")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://a.com, https://b.com")
+	overridden := middleware.SecurityProfileFromConfig(config.Load())
+	if !overridden.CookieSecure {
+		t.Error("SECURITY_COOKIE_SECURE override'ı uygulanmalı")
+	}
+	if len(overridden.CORSAllowedOrigins) != 2 {
+		t.Errorf("CORS_ALLOWED_ORIGINS override'ı uygulanmalı, got: %v", overridden.CORSAllowedOrigins)
+	}
+}
+
+// TestCORSWildcardWithCredentials, wildcard origin'in credential'lı CORS
+// vermediğini ve config'de reddedildiğini test eder.
+func TestCORSWildcardWithCredentials(t *testing.T) {
+	profile := middleware.DevelopmentSecurityProfile()
+	profile.CORSAllowedOrigins = []string{"*"}
+	profile.CORSAllowCredentials = true
+	middleware.RegisterDefaultCORSPolicies(profile)
+	defer middleware.RegisterDefaultCORSPolicies(middleware.DevelopmentSecurityProfile())
+
+	r := router.New()
+	r.CORS(middleware.CORSPolicyPublic)
+	r.GET("/api/public", func(w http.ResponseWriter, r *conduitReq.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/api/public", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Wildcard origin yansıtılmamalı, got: %q", got)
+	}
+	if w.Header().Get("Access-Control-Allow-Credentials") != "" {
+		t.Error("Wildcard ile Allow-Credentials gönderilmemeli")
+	}
+
+	t.Setenv("APP_ENV", "development")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "*")
+	t.Setenv("CORS_ALLOW_CREDENTIALS", "true")
+	err := config.Load().Validate()
+	if err == nil || !strings.Contains(err.Error(), "CORS_ALLOW_CREDENTIALS") {
+		t.Errorf("'*' ve credentials birlikte reddedilmeli, got: %v", err)
+	}
+}
+
+// TestSecurityHeadersAndCookies, profilin header ve cookie'lere uygulandığını test eder.
+func TestSecurityHeadersAndCookies(t *testing.T) {
+	defer middleware.SetSecurityProfile(middleware.DevelopmentSecurityProfile())
+
+	profile := middleware.DevelopmentSecurityProfile()
+	profile.CookieSecure = true
+	profile.HSTSEnabled = true
+	profile.CORSAllowedOrigins = []string{"https://app.example.com"}
+	middleware.SetSecurityProfile(profile)
+
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := middleware.SecurityHeaders()(middleware.CORS()(middleware.CSRFProtection()(testHandler)))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Header().Get("Strict-Transport-Security") == "" {
+		t.Error("HSTS header'ı set edilmeli")
+	}
+	if w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Error("İzin verilmeyen origin için CORS header'ı set edilmemeli")
+	}
+	for _, cookie := range w.Result().Cookies() {
+		if !cookie.Secure {
+			t.Errorf("Cookie '%s' Secure olmalı", cookie.Name)
+		}
+	}
+}
+
+// TestCORSPolicyPerRouteGroup, route grubu bazlı CORS policy'lerini test eder.
+func TestCORSPolicyPerRouteGroup(t *testing.T) {
+	profile := middleware.DevelopmentSecurityProfile()
+	profile.CORSAdminOrigins = []string{"https://dashboard.example.com"}
+	middleware.RegisterDefaultCORSPolicies(profile)
+
+	r := router.New()
+	r.CORS(middleware.CORSPolicyPublic)
+
+	ok := func(w http.ResponseWriter, r *conduitReq.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	r.GET("/api/public", ok)
+
+	admin := r.Group("/api/admin")
+	admin.CORS(middleware.CORSPolicyAdmin)
+	admin.Use(middleware.Auth()) // Preflight bu middleware'e takılmamalı
+	admin.GET("/users", ok)
+
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		origin          string
+		wantStatus      int
+		wantOrigin      string
+		wantCredentials bool
+	}{
+		{"public wildcard", "GET", "/api/public", "https://any.example.com", 200, "*", false},
+		{"admin preflight from dashboard", "OPTIONS", "/api/admin/users", "https://dashboard.example.com", 204, "https://dashboard.example.com", true},
+		{"admin preflight from other origin", "OPTIONS", "/api/admin/users", "https://evil.example.com", 204, "", false},
+		{"admin request from other origin", "GET", "/api/admin/users", "https://evil.example.com", 401, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Origin", tt.origin)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Status: got %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("Allow-Origin: got %q, want %q", got, tt.wantOrigin)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Credentials") == "true"; got != tt.wantCredentials {
+				t.Errorf("Allow-Credentials: got %v, want %v", got, tt.wantCredentials)
+			}
+		})
+	}
+}
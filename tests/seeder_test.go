@@ -0,0 +1,97 @@
+// -----------------------------------------------------------------------------
+// Seeder Tests
+// -----------------------------------------------------------------------------
+// Seeder registry'sini, Call'un sırasını ve hata davranışını test eder.
+// Seeder'ların veritabanında çalıştırılması `conduit db:seed` ile yapılır.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	_ "github.com/biyonik/conduit-go/database/seeders"
+	"github.com/biyonik/conduit-go/pkg/container"
+	"github.com/biyonik/conduit-go/pkg/database/seeder"
+)
+
+type orderSeeder struct {
+	log *[]string
+	err error
+}
+
+func (s *orderSeeder) Run(c *container.Container) error {
+	*s.log = append(*s.log, "order")
+	return s.err
+}
+
+type secondOrderSeeder struct {
+	log *[]string
+}
+
+func (s *secondOrderSeeder) Run(c *container.Container) error {
+	*s.log = append(*s.log, "second")
+	return nil
+}
+
+func TestSeederRegistry(t *testing.T) {
+	t.Run("DatabaseSeeder is registered", func(t *testing.T) {
+		if _, ok := seeder.Get(seeder.DefaultSeeder); !ok {
+			t.Fatalf("%s should be registered", seeder.DefaultSeeder)
+		}
+	})
+
+	t.Run("registers by type name", func(t *testing.T) {
+		var log []string
+		s := &orderSeeder{log: &log}
+		if name := seeder.Name(s); name != "orderSeeder" {
+			t.Fatalf("expected orderSeeder, got %s", name)
+		}
+
+		seeder.Register(s)
+		defer seeder.Unregister("orderSeeder")
+
+		got, ok := seeder.Get("orderSeeder")
+		if !ok || got != s {
+			t.Fatal("registered seeder should be returned by Get")
+		}
+
+		defer func() {
+			if recover() == nil {
+				t.Error("duplicate registration should panic")
+			}
+		}()
+		seeder.Register(&orderSeeder{log: &log})
+	})
+}
+
+func TestSeederCall(t *testing.T) {
+	c := container.New()
+
+	t.Run("runs in order", func(t *testing.T) {
+		var log []string
+		err := seeder.Call(c, &secondOrderSeeder{log: &log}, &orderSeeder{log: &log})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(log) != 2 || log[0] != "second" || log[1] != "order" {
+			t.Errorf("unexpected order: %v", log)
+		}
+	})
+
+	t.Run("stops at first error", func(t *testing.T) {
+		var log []string
+		boom := errors.New("boom")
+		err := seeder.Call(c, &orderSeeder{log: &log, err: boom}, &secondOrderSeeder{log: &log})
+		if !errors.Is(err, boom) {
+			t.Fatalf("expected wrapped boom, got %v", err)
+		}
+		if err.Error() != "orderSeeder: boom" {
+			t.Errorf("error should name the seeder, got %q", err.Error())
+		}
+		if len(log) != 1 {
+			t.Errorf("seeders after the failing one should not run: %v", log)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+// -----------------------------------------------------------------------------
+// Streaming Response Tests
+// -----------------------------------------------------------------------------
+// response.Stream ve StreamJSONArray'in parça parça flush etmesini,
+// header'larını ve hata davranışını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/http/response"
+)
+
+func TestStream(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "text/csv")
+
+	chunk := strings.Repeat("x", 20<<10)
+	var flushedMidStream bool
+	err := response.Stream(rec, func(out io.Writer) error {
+		for i := 0; i < 4; i++ {
+			io.WriteString(out, chunk)
+		}
+		// 32 KB'ı aşan içerik fn bitmeden client'a gönderilir
+		flushedMidStream = rec.Flushed && rec.Body.Len() > 0
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !flushedMidStream {
+		t.Error("content should be flushed while streaming")
+	}
+	if rec.Code != 200 || rec.Body.Len() != 80<<10 {
+		t.Errorf("code = %d, body length = %d", rec.Code, rec.Body.Len())
+	}
+	if rec.Header().Get("Content-Type") != "text/csv" || rec.Header().Get("X-Accel-Buffering") != "no" {
+		t.Errorf("headers = %v", rec.Header())
+	}
+}
+
+func TestStreamExplicitFlush(t *testing.T) {
+	rec := httptest.NewRecorder()
+	err := response.Stream(rec, func(out io.Writer) error {
+		io.WriteString(out, "tick")
+		if err := out.(interface{ Flush() error }).Flush(); err != nil {
+			return err
+		}
+		if rec.Body.String() != "tick" {
+			t.Errorf("body after Flush = %q", rec.Body.String())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Header().Get("Content-Type") != "application/octet-stream" {
+		t.Errorf("default Content-Type = %q", rec.Header().Get("Content-Type"))
+	}
+}
+
+func TestStreamErrorBeforeOutput(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Disposition", `attachment; filename="users.csv"`)
+
+	dbErr := errors.New("db down")
+	err := response.Stream(rec, func(out io.Writer) error {
+		io.WriteString(out, "id,name\n") // Henüz buffer'da
+		return dbErr
+	})
+
+	if !errors.Is(err, dbErr) {
+		t.Errorf("error = %v, want %v", err, dbErr)
+	}
+	if rec.Code != 500 || strings.Contains(rec.Body.String(), "id,name") || rec.Header().Get("Content-Disposition") != "" {
+		t.Errorf("expected a clean 500 response, got %d %q %v", rec.Code, rec.Body.String(), rec.Header())
+	}
+}
+
+func TestStreamJSONArray(t *testing.T) {
+	type item struct {
+		ID int `json:"id"`
+	}
+
+	rec := httptest.NewRecorder()
+	err := response.StreamJSONArray(rec, func(emit func(any) error) error {
+		for i := 1; i <= 3; i++ {
+			if err := emit(item{ID: i}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rec.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Content-Type = %q", rec.Header().Get("Content-Type"))
+	}
+	var items []item
+	if err := json.Unmarshal(rec.Body.Bytes(), &items); err != nil || len(items) != 3 || items[2].ID != 3 {
+		t.Errorf("body = %s (%v)", rec.Body.String(), err)
+	}
+
+	// Boş liste
+	rec = httptest.NewRecorder()
+	if err := response.StreamJSONArray(rec, func(emit func(any) error) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Body.String() != "[]\n" {
+		t.Errorf("empty body = %q", rec.Body.String())
+	}
+}
@@ -0,0 +1,190 @@
+// -----------------------------------------------------------------------------
+// Upload Tests
+// -----------------------------------------------------------------------------
+// Request.File/Files ile multipart dosya okumayı, MIME tespitini ve
+// Store/StoreAs/StoreOn ile kaydetmeyi test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/pkg/storage"
+)
+
+// pngHeader, MIME tespiti için yeterli PNG imzasıdır.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR")
+
+type uploadPart struct {
+	field, filename, contentType string
+	content                      []byte
+}
+
+func newUploadRequest(t *testing.T, parts ...uploadPart) *conduitReq.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, part := range parts {
+		header := make(map[string][]string)
+		header["Content-Disposition"] = []string{`form-data; name="` + part.field + `"; filename="` + part.filename + `"`}
+		header["Content-Type"] = []string{part.contentType}
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(part.content)
+	}
+	writer.WriteField("name", "Ada")
+	writer.Close()
+
+	req := httptest.NewRequest("POST", "/upload", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return conduitReq.New(req)
+}
+
+func TestRequestFile(t *testing.T) {
+	r := newUploadRequest(t, uploadPart{"avatar", `C:\Users\ada\Me.PNG`, "text/plain", pngHeader})
+
+	avatar, err := r.File("avatar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if avatar.Name() != "Me.PNG" {
+		t.Errorf("Name() = %q", avatar.Name())
+	}
+	if avatar.Extension() != "png" {
+		t.Errorf("Extension() = %q", avatar.Extension())
+	}
+	if avatar.Size() != int64(len(pngHeader)) {
+		t.Errorf("Size() = %d", avatar.Size())
+	}
+	if avatar.ClientMimeType() != "text/plain" {
+		t.Errorf("ClientMimeType() = %q", avatar.ClientMimeType())
+	}
+	// Client'ın gönderdiği tipe değil içeriğe bakılır
+	if mimeType, err := avatar.MimeType(); err != nil || mimeType != "image/png" {
+		t.Errorf("MimeType() = %q, %v; want image/png", mimeType, err)
+	}
+
+	if !r.HasFile("avatar") || r.HasFile("name") {
+		t.Error("HasFile should only report file fields")
+	}
+	if r.FormValue("name") != "Ada" {
+		t.Error("regular form values should still be readable")
+	}
+}
+
+func TestRequestFileErrors(t *testing.T) {
+	r := newUploadRequest(t)
+	if _, err := r.File("avatar"); !errors.Is(err, http.ErrMissingFile) {
+		t.Errorf("missing file error = %v, want http.ErrMissingFile", err)
+	}
+
+	req := httptest.NewRequest("POST", "/upload", strings.NewReader(`{"a":1}`))
+	req.Header.Set("Content-Type", "application/json")
+	if _, err := conduitReq.New(req).File("avatar"); !errors.Is(err, http.ErrNotMultipart) {
+		t.Errorf("non-multipart error = %v, want http.ErrNotMultipart", err)
+	}
+}
+
+func TestRequestFiles(t *testing.T) {
+	r := newUploadRequest(t,
+		uploadPart{"photos", "a.txt", "text/plain", []byte("first")},
+		uploadPart{"photos", "b.txt", "text/plain", []byte("second")},
+	)
+
+	files, err := r.Files("photos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Name() != "a.txt" || files[1].Name() != "b.txt" {
+		t.Fatalf("Files() returned %d files", len(files))
+	}
+	if mimeType, _ := files[0].MimeType(); mimeType != "text/plain" {
+		t.Errorf("MimeType() = %q, want text/plain", mimeType)
+	}
+}
+
+func TestUploadedFileStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "avatars")
+	r := newUploadRequest(t, uploadPart{"avatar", "../../me.png", "image/png", pngHeader})
+	avatar, err := r.File("avatar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := avatar.Store(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Dir(stored) != dir || !regexp.MustCompile(`^[0-9a-f]{40}\.png$`).MatchString(filepath.Base(stored)) {
+		t.Errorf("Store() = %q", stored)
+	}
+	if data, _ := os.ReadFile(stored); !bytes.Equal(data, pngHeader) {
+		t.Error("stored content mismatch")
+	}
+
+	named, err := avatar.StoreAs(dir, "user-1.png")
+	if err != nil || named != filepath.Join(dir, "user-1.png") {
+		t.Errorf("StoreAs() = %q, %v", named, err)
+	}
+
+	for _, name := range []string{"", "..", "../escape.png", `a\b.png`} {
+		if _, err := avatar.StoreAs(dir, name); err == nil {
+			t.Errorf("StoreAs(%q) should fail", name)
+		}
+	}
+}
+
+func TestUploadedFileStoreOn(t *testing.T) {
+	disk, err := storage.NewLocalStorage(t.TempDir(), log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := newUploadRequest(t, uploadPart{"doc", "report.pdf", "application/pdf", []byte("%PDF-1.4")})
+	doc, err := r.File("doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := doc.StoreOn(disk, "documents")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(stored, "documents/") || !strings.HasSuffix(stored, ".pdf") {
+		t.Errorf("StoreOn() = %q", stored)
+	}
+	if data, err := disk.Get(stored); err != nil || string(data) != "%PDF-1.4" {
+		t.Errorf("stored content = %q, %v", data, err)
+	}
+}
+
+func TestMultipartMaxMemory(t *testing.T) {
+	defer conduitReq.SetMultipartMaxMemory(0)
+
+	conduitReq.SetMultipartMaxMemory(1 << 20)
+	if got := conduitReq.MultipartMaxMemory(); got != 1<<20 {
+		t.Errorf("MultipartMaxMemory() = %d", got)
+	}
+
+	conduitReq.SetMultipartMaxMemory(-1)
+	if got := conduitReq.MultipartMaxMemory(); got != conduitReq.DefaultMultipartMaxMemory {
+		t.Errorf("non-positive value should reset to default, got %d", got)
+	}
+}
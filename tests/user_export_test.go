@@ -0,0 +1,34 @@
+// -----------------------------------------------------------------------------
+// User Export Tests
+// -----------------------------------------------------------------------------
+// Admin kullanıcı export'unun CSV injection korumasını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/controllers"
+)
+
+func TestSafeCSVCell(t *testing.T) {
+	cases := map[string]string{
+		`=HYPERLINK("http://evil.example","click")`: `'=HYPERLINK("http://evil.example","click")`,
+		"+1+2":              "'+1+2",
+		"-2+3":              "'-2+3",
+		"@SUM(A1:A2)":       "'@SUM(A1:A2)",
+		"\t=1":              "'\t=1",
+		"\r=1":              "'\r=1",
+		"John Doe":          "John Doe",
+		"john@example.com":  "john@example.com",
+		"":                  "",
+		"Jean-Luc = Picard": "Jean-Luc = Picard",
+	}
+
+	for input, want := range cases {
+		if got := controllers.SafeCSVCell(input); got != want {
+			t.Errorf("SafeCSVCell(%q) = %q, beklenen %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// -----------------------------------------------------------------------------
+// User Import Tests
+// -----------------------------------------------------------------------------
+// Admin toplu kullanıcı import'unun CSV parsing ve progress testleri.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/controllers"
+	"github.com/biyonik/conduit-go/internal/jobs"
+	"github.com/biyonik/conduit-go/pkg/cache"
+)
+
+func TestParseUserImportCSV(t *testing.T) {
+	csvData := "Email,Name,Status\n" +
+		"john@example.com,John Doe,active\n" +
+		"JANE@example.com,Jane Doe,\n" +
+		"not-an-email,Bad Row,active\n" +
+		"john@example.com,John Again,active\n" +
+		"mike@example.com,Mike,banned\n" +
+		"\n" +
+		"ada@example.com,Ada Lovelace,inactive\n"
+
+	rows, rejected, err := controllers.ParseUserImportCSV(strings.NewReader(csvData), 100)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(rows) != 3 {
+		t.Fatalf("Expected 3 valid rows, got %d: %+v", len(rows), rows)
+	}
+
+	if rows[1].Email != "jane@example.com" || rows[1].Status != "active" {
+		t.Errorf("Expected normalized email and default status, got %+v", rows[1])
+	}
+
+	if rows[2].Line != 8 || rows[2].Status != "inactive" {
+		t.Errorf("Expected line 8 with inactive status, got %+v", rows[2])
+	}
+
+	rejectedLines := map[int]bool{}
+	for _, r := range rejected {
+		rejectedLines[r.Line] = true
+	}
+	for _, line := range []int{4, 5, 6} {
+		if !rejectedLines[line] {
+			t.Errorf("Expected line %d to be rejected, got %+v", line, rejected)
+		}
+	}
+}
+
+func TestParseUserImportCSV_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		csvData string
+		maxRows int
+	}{
+		{"missing email column", "name,status\nJohn,active\n", 100},
+		{"empty file", "", 100},
+		{"too many rows", "name,email\nJohn,john@example.com\nJane,jane@example.com\n", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := controllers.ParseUserImportCSV(strings.NewReader(tt.csvData), tt.maxRows)
+			if err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestImportProgress_CacheRoundTrip(t *testing.T) {
+	logger := log.New(os.Stdout, "[ImportTest] ", log.Ldate|log.Ltime)
+
+	fileCache, err := cache.NewFileCache(t.TempDir(), logger)
+	if err != nil {
+		t.Fatalf("File cache oluşturulamadı: %v", err)
+	}
+
+	drivers := map[string]cache.Cache{
+		"Memory": cache.NewMemoryCache(logger),
+		"File":   fileCache,
+	}
+
+	for name, c := range drivers {
+		t.Run(name, func(t *testing.T) {
+			progress := &jobs.ImportProgress{
+				ImportID:  "import-1",
+				Status:    jobs.ImportStatusProcessing,
+				Total:     10,
+				Processed: 4,
+				Created:   3,
+				Failed:    1,
+				Errors:    []jobs.ImportRowError{{Line: 3, Email: "a@b.com", Error: "boom"}},
+			}
+
+			if err := jobs.SaveImportProgress(c, progress); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+
+			loaded, err := jobs.LoadImportProgress(c, "import-1")
+			if err != nil || loaded == nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+
+			if loaded.Processed != 4 || loaded.Created != 3 || len(loaded.Errors) != 1 || loaded.Errors[0].Line != 3 {
+				t.Errorf("Unexpected progress: %+v", loaded)
+			}
+
+			missing, err := jobs.LoadImportProgress(c, "unknown")
+			if err != nil || missing != nil {
+				t.Errorf("Expected nil for unknown import, got %+v (err: %v)", missing, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,715 @@
+// -----------------------------------------------------------------------------
+// Validation Tests
+// -----------------------------------------------------------------------------
+// Validation şemalarının JSON Schema export'unu ve string kurallarını
+// (OneOf, URL) test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	conduitReq "github.com/biyonik/conduit-go/internal/http/request"
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/router"
+	"github.com/biyonik/conduit-go/pkg/validation"
+	"github.com/biyonik/conduit-go/pkg/validation/types"
+)
+
+func TestValidationSchema_JSONSchema(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"email":  types.String().Required().Email().Max(255).Label("Email"),
+		"status": types.String().OneOf([]string{"active", "inactive"}).Default("active"),
+		"age":    types.Number().Integer().Min(18),
+		"tags":   types.Array().Max(5).Elements(types.String().Min(2)),
+		"address": types.Object().Required().Shape(map[string]validation.Type{
+			"city": types.String().Required(),
+		}),
+	}).When("payment_type", "credit_card", func() validation.Schema {
+		return validation.Make().Shape(map[string]validation.Type{
+			"card_number": types.CreditCard().Required(),
+		})
+	})
+
+	doc := schema.JSONSchema()
+
+	// Çıktı JSON'a serialize edilebilmeli
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("JSON Schema serialize edilemedi: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("JSON Schema decode edilemedi: %v", err)
+	}
+
+	if decoded["$schema"] != validation.JSONSchemaDraft {
+		t.Errorf("$schema eksik veya hatalı: %v", decoded["$schema"])
+	}
+
+	if !reflect.DeepEqual(decoded["required"], []any{"address", "email"}) {
+		t.Errorf("required listesi hatalı: %v", decoded["required"])
+	}
+
+	props := decoded["properties"].(map[string]any)
+
+	email := props["email"].(map[string]any)
+	if email["format"] != "email" || email["maxLength"] != float64(255) || email["title"] != "Email" {
+		t.Errorf("email şeması hatalı: %v", email)
+	}
+
+	status := props["status"].(map[string]any)
+	if !reflect.DeepEqual(status["enum"], []any{"active", "inactive"}) || status["default"] != "active" {
+		t.Errorf("status şeması hatalı: %v", status)
+	}
+
+	age := props["age"].(map[string]any)
+	if age["type"] != "integer" || age["minimum"] != float64(18) {
+		t.Errorf("age şeması hatalı: %v", age)
+	}
+
+	tags := props["tags"].(map[string]any)
+	items := tags["items"].(map[string]any)
+	if tags["maxItems"] != float64(5) || items["minLength"] != float64(2) {
+		t.Errorf("tags şeması hatalı: %v", tags)
+	}
+
+	address := props["address"].(map[string]any)
+	if !reflect.DeepEqual(address["required"], []any{"city"}) {
+		t.Errorf("address iç şeması hatalı: %v", address)
+	}
+
+	allOf, ok := decoded["allOf"].([]any)
+	if !ok || len(allOf) != 1 {
+		t.Fatalf("When kuralı allOf olarak aktarılmalı: %v", decoded["allOf"])
+	}
+	then := allOf[0].(map[string]any)["then"].(map[string]any)
+	if _, hasDraft := then["$schema"]; hasDraft {
+		t.Error("Alt şema $schema içermemeli")
+	}
+	if !reflect.DeepEqual(then["required"], []any{"card_number"}) {
+		t.Errorf("Koşullu şema hatalı: %v", then)
+	}
+}
+
+func TestStringType_OneOfAndURLAreEnforced(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"status":  types.String().OneOf([]string{"active", "inactive"}),
+		"website": types.String().URL(),
+	})
+
+	result := schema.Validate(map[string]any{"status": "banned"})
+	if _, ok := result.Errors()["status"]; !ok {
+		t.Error("OneOf dışındaki değer reddedilmeli")
+	}
+
+	result = schema.Validate(map[string]any{"website": "not a url"})
+	if _, ok := result.Errors()["website"]; !ok {
+		t.Error("Geçersiz URL reddedilmeli")
+	}
+
+	result = schema.Validate(map[string]any{"status": "active", "website": "https://example.com"})
+	if result.HasErrors() {
+		t.Errorf("Geçerli değerler kabul edilmeli: %v", result.Errors())
+	}
+
+	// Opsiyonel alanlar gönderilmediğinde kontrol edilmez
+	result = schema.Validate(map[string]any{})
+	if result.HasErrors() {
+		t.Errorf("Boş istek kabul edilmeli: %v", result.Errors())
+	}
+}
+
+func TestValidationSchema_ValidatePartial(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"name":  types.String().Required().Min(2),
+		"email": types.String().Required().Email(),
+	})
+
+	// Gönderilmeyen zorunlu alan atlanmalı
+	result := schema.ValidatePartial(map[string]any{"name": "Jane"})
+	if result.HasErrors() {
+		t.Fatalf("Gönderilmeyen alan doğrulanmamalı: %v", result.Errors())
+	}
+	if !reflect.DeepEqual(result.ValidData(), map[string]any{"name": "Jane"}) {
+		t.Errorf("validData sadece gönderilen alanları içermeli: %v", result.ValidData())
+	}
+
+	// Boş değerle gönderilen alan doğrulanmalı
+	result = schema.ValidatePartial(map[string]any{"name": ""})
+	if _, ok := result.Errors()["name"]; !ok {
+		t.Error("Boş gönderilen zorunlu alan reddedilmeli")
+	}
+
+	// Tam doğrulama davranışı değişmemeli
+	result = schema.Validate(map[string]any{"name": "Jane"})
+	if _, ok := result.Errors()["email"]; !ok {
+		t.Error("Validate gönderilmeyen zorunlu alanı reddetmeli")
+	}
+}
+
+func TestNumericTypes_CoercionAndRules(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"age":      types.Int().Required().Between(18, 120).Label("Yaş"),
+		"page":     types.Int().Positive().Default(1),
+		"price":    types.Float().Required().Positive(),
+		"discount": types.Float().Between(0, 0.5),
+		"active":   types.Bool().Required(),
+	})
+
+	// JSON sayıları, string'ler ve form değerleri hedef tipe çevrilir
+	result := schema.Validate(map[string]any{
+		"age":      "42",
+		"price":    float64(19),
+		"discount": " 0.25 ",
+		"active":   "on",
+	})
+	if result.HasErrors() {
+		t.Fatalf("Geçerli değerler kabul edilmeli: %v", result.Errors())
+	}
+
+	data := result.ValidData()
+	if data["age"] != int64(42) || data["page"] != int64(1) {
+		t.Errorf("Int değerleri int64 olmalı: age=%#v page=%#v", data["age"], data["page"])
+	}
+	if data["price"] != 19.0 || data["discount"] != 0.25 {
+		t.Errorf("Float değerleri float64 olmalı: price=%#v discount=%#v", data["price"], data["discount"])
+	}
+	if data["active"] != true {
+		t.Errorf("Bool değeri true olmalı: %#v", data["active"])
+	}
+
+	// Kural ihlalleri
+	result = schema.Validate(map[string]any{
+		"age":      17.5,
+		"page":     json.Number("0"),
+		"price":    "0",
+		"discount": "abc",
+		"active":   "maybe",
+	})
+	for _, field := range []string{"age", "page", "price", "discount", "active"} {
+		if _, ok := result.Errors()[field]; !ok {
+			t.Errorf("%s alanı reddedilmeli: %v", field, result.Errors())
+		}
+	}
+
+	result = schema.Validate(map[string]any{"age": 121, "price": 1, "active": 0})
+	if _, ok := result.Errors()["age"]; !ok {
+		t.Error("Aralık dışı yaş reddedilmeli")
+	}
+	if _, ok := result.Errors()["active"]; ok {
+		t.Errorf("0 false olarak kabul edilmeli: %v", result.Errors())
+	}
+
+	// Boş string zorunlu alan için eksik sayılır
+	result = schema.Validate(map[string]any{"age": "", "price": 1, "active": false})
+	if errs := result.Errors()["age"]; len(errs) != 1 || errs[0] != "Yaş alanı zorunludur" {
+		t.Errorf("Boş yaş zorunlu hatası vermeli, got %v", errs)
+	}
+
+	// Boolean() katı kalır
+	strict := validation.Make().Shape(map[string]validation.Type{"flag": types.Boolean()})
+	if result := strict.Validate(map[string]any{"flag": "true"}); !result.HasErrors() {
+		t.Error("Boolean() string kabul etmemeli")
+	}
+}
+
+func TestArrayAndObject_NestedErrorPaths(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"items": types.Array(types.Object(map[string]validation.Type{
+			"sku": types.String().Required(),
+			"qty": types.Int().Required().Positive(),
+		})).Required().Min(1),
+		"shipping": types.Object(map[string]validation.Type{
+			"city": types.String().Required(),
+		}),
+		"tags": types.Array(types.String().Min(2)),
+	})
+
+	var payload map[string]any
+	body := `{"items":[{"sku":"x","qty":"2"},{"sku":"","qty":0},{"qty":1.5}],"shipping":{"city":""},"tags":["ok","a"]}`
+	if err := json.Unmarshal([]byte(body), &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := schema.Validate(payload).Errors()
+	for _, key := range []string{"items.1.sku", "items.1.qty", "items.2.sku", "items.2.qty", "shipping.city", "tags.1"} {
+		if _, ok := errs[key]; !ok {
+			t.Errorf("%s anahtarında hata bekleniyordu: %v", key, errs)
+		}
+	}
+	for _, key := range []string{"items.0.sku", "items.0.qty", "tags.0", "items"} {
+		if _, ok := errs[key]; ok {
+			t.Errorf("%s geçerli olmalı: %v", key, errs[key])
+		}
+	}
+
+	// Geçerli payload: eleman değerleri dönüştürülmüş olarak döner
+	result := schema.Validate(map[string]any{
+		"items": []any{map[string]any{"sku": "x", "qty": "2"}},
+		"tags":  []string{"go", "api"},
+	})
+	if result.HasErrors() {
+		t.Fatalf("Geçerli payload kabul edilmeli: %v", result.Errors())
+	}
+	items := result.ValidData()["items"].([]any)
+	if qty := items[0].(map[string]any)["qty"]; qty != int64(2) {
+		t.Errorf("qty int64(2) olmalı, got %#v", qty)
+	}
+
+	// Tip uyuşmazlıkları alan hatası olarak raporlanır
+	errs = schema.Validate(map[string]any{"items": "nope", "shipping": []any{}}).Errors()
+	if _, ok := errs["items"]; !ok {
+		t.Errorf("Dizi olmayan items reddedilmeli: %v", errs)
+	}
+	if _, ok := errs["shipping"]; !ok {
+		t.Errorf("Nesne olmayan shipping reddedilmeli: %v", errs)
+	}
+}
+
+func TestStringType_FormatRules(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"id":       types.String().UUID(),
+		"token":    types.String().UUID(4),
+		"website":  types.String().URL(),
+		"dsn":      types.String().URL("postgres"),
+		"ip":       types.String().IP(),
+		"ipv4":     types.String().IPv4(),
+		"ipv6":     types.String().IPv6(),
+		"metadata": types.String().JSON().Label("Metadata"),
+	})
+
+	valid := map[string]any{
+		"id":       "6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"token":    "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		"website":  "https://example.com/docs?q=1",
+		"dsn":      "postgres://user@db.internal:5432/app",
+		"ip":       "::1",
+		"ipv4":     "192.168.1.10",
+		"ipv6":     "2001:db8::1",
+		"metadata": `{"tags":["a","b"]}`,
+	}
+	if result := schema.Validate(valid); result.HasErrors() {
+		t.Fatalf("Geçerli değerler kabul edilmeli: %v", result.Errors())
+	}
+
+	invalid := map[string]any{
+		"id":       "not-a-uuid",
+		"token":    "6ba7b810-9dad-11d1-80b4-00c04fd430c8", // v1
+		"website":  "example.com",
+		"dsn":      "https://example.com",
+		"ip":       "999.1.1.1",
+		"ipv4":     "2001:db8::1",
+		"ipv6":     "192.168.1.10",
+		"metadata": `{"tags":`,
+	}
+	errs := schema.Validate(invalid).Errors()
+	for field := range invalid {
+		if _, ok := errs[field]; !ok {
+			t.Errorf("%s alanı reddedilmeli", field)
+		}
+	}
+	if msg := errs["metadata"][0]; msg != "Metadata alanı geçerli bir JSON metni olmalıdır" {
+		t.Errorf("Beklenmeyen mesaj: %s", msg)
+	}
+
+	// Opsiyonel boş değerler format kontrolüne takılmaz
+	if result := schema.Validate(map[string]any{"id": "", "ip": "", "metadata": ""}); result.HasErrors() {
+		t.Errorf("Boş opsiyonel alanlar kabul edilmeli: %v", result.Errors())
+	}
+}
+
+func TestConditionalRules(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"account_type":          types.String().Required().OneOf([]string{"personal", "business"}),
+		"company_name":          types.String().RequiredIf("account_type", "business").Label("Şirket adı"),
+		"tax_number":            types.String().ExcludedUnless("account_type", "business").Min(10),
+		"password":              types.String(),
+		"password_confirmation": types.String().RequiredWith("password"),
+		"address": types.Object(map[string]validation.Type{
+			"country": types.String().Required(),
+			"state":   types.String().RequiredIf("country", "US"),
+		}),
+	})
+
+	result := schema.Validate(map[string]any{"account_type": "business", "tax_number": "123"})
+	errs := result.Errors()
+	if msgs := errs["company_name"]; len(msgs) != 1 || msgs[0] != "Şirket adı alanı, account_type alanı business olduğunda zorunludur" {
+		t.Errorf("company_name koşullu zorunlu olmalı, got %v", msgs)
+	}
+	if _, ok := errs["tax_number"]; !ok {
+		t.Error("business hesapta tax_number kuralları uygulanmalı")
+	}
+
+	// Kişisel hesapta tax_number doğrulanmaz ve validData'dan çıkarılır
+	result = schema.Validate(map[string]any{"account_type": "personal", "tax_number": "x"})
+	if result.HasErrors() {
+		t.Fatalf("Kişisel hesap geçerli olmalı: %v", result.Errors())
+	}
+	if _, ok := result.ValidData()["tax_number"]; ok {
+		t.Error("tax_number validData'dan çıkarılmalı")
+	}
+
+	result = schema.Validate(map[string]any{"account_type": "personal", "password": "secret"})
+	if _, ok := result.Errors()["password_confirmation"]; !ok {
+		t.Error("password varken password_confirmation zorunlu olmalı")
+	}
+
+	// İç içe nesnede koşullar nesnenin kendi alanlarına bakar
+	result = schema.Validate(map[string]any{
+		"account_type": "personal",
+		"address":      map[string]any{"country": "US"},
+	})
+	if _, ok := result.Errors()["address.state"]; !ok {
+		t.Errorf("address.state koşullu zorunlu olmalı: %v", result.Errors())
+	}
+	result = schema.Validate(map[string]any{
+		"account_type": "personal",
+		"address":      map[string]any{"country": "TR"},
+	})
+	if result.HasErrors() {
+		t.Errorf("TR adresi için state gerekmemeli: %v", result.Errors())
+	}
+}
+
+type structTestAddress struct {
+	City string `json:"city" validate:"required,label=Şehir"`
+	Zip  string `json:"zip" validate:"min=5"`
+}
+
+type structTestItem struct {
+	SKU string `json:"sku" validate:"required"`
+	Qty int    `json:"qty" validate:"between=1 10"`
+}
+
+type structTestRequest struct {
+	Email    string            `json:"email" validate:"required,email,max=255,trim"`
+	Age      *int              `json:"age" validate:"required,min=0"`
+	Role     string            `json:"role" validate:"oneof=admin editor"`
+	Address  structTestAddress `json:"address"`
+	Items    []structTestItem  `json:"items" validate:"required,min=1"`
+	Internal string
+}
+
+func TestValidateStruct(t *testing.T) {
+	zero := 0
+	valid := structTestRequest{
+		Email:   "  ahmet@example.com ",
+		Age:     &zero,
+		Address: structTestAddress{City: "İstanbul"},
+		Items:   []structTestItem{{SKU: "A-1", Qty: 2}},
+	}
+
+	result := validation.ValidateStruct(&valid)
+	if result.HasErrors() {
+		t.Fatalf("Geçerli struct hata vermemeli: %v", result.Errors())
+	}
+	data := result.ValidData()
+	if data["email"] != "ahmet@example.com" {
+		t.Errorf("email trim edilmeli, got %q", data["email"])
+	}
+	// Pointer ile gönderilen 0 geçerli bir değerdir
+	if data["age"] != int64(0) {
+		t.Errorf("age 0 olarak kabul edilmeli, got %#v", data["age"])
+	}
+	if _, ok := data["Internal"]; ok {
+		t.Error("Tag'siz alanlar şemaya dahil edilmemeli")
+	}
+
+	invalid := structTestRequest{
+		Email:   "invalid",
+		Role:    "owner",
+		Address: structTestAddress{Zip: "12"},
+		Items:   []structTestItem{{Qty: 20}},
+	}
+
+	errs := validation.ValidateStruct(invalid).Errors()
+	for _, key := range []string{"email", "age", "role", "address.city", "address.zip", "items.0.sku", "items.0.qty"} {
+		if _, ok := errs[key]; !ok {
+			t.Errorf("%s için hata bekleniyordu: %v", key, errs)
+		}
+	}
+	if msgs := errs["address.city"]; len(msgs) == 0 || msgs[0] != "Şehir alanı zorunludur" {
+		t.Errorf("address.city label'ı kullanılmalı, got %v", msgs)
+	}
+
+	errs = validation.ValidateStruct(structTestRequest{Email: "a@b.co", Age: &zero, Address: structTestAddress{City: "x"}}).Errors()
+	if _, ok := errs["items"]; !ok {
+		t.Errorf("Boş items zorunlu olmalı: %v", errs)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Bilinmeyen kural panic'e neden olmalı")
+		}
+	}()
+	validation.ValidateStruct(struct {
+		Name string `json:"name" validate:"required,unknown_rule"`
+	}{})
+}
+
+type enumTestStatus string
+
+type enumTestPriority int
+
+func TestEnumAndIn(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"status":   types.Enum[enumTestStatus]("active", "inactive", "banned").Required().Label("Durum"),
+		"priority": types.Enum[enumTestPriority](1, 2, 3),
+		"role":     types.String().In("admin", "editor"),
+		"level":    types.Int().In(10, 20),
+	})
+
+	result := schema.Validate(map[string]any{"status": "banned", "priority": "2", "role": "admin", "level": float64(20)})
+	if result.HasErrors() {
+		t.Fatalf("Geçerli değerler hata vermemeli: %v", result.Errors())
+	}
+	data := result.ValidData()
+	if status, ok := data["status"].(enumTestStatus); !ok || status != "banned" {
+		t.Errorf("status enumTestStatus olarak dönmeli, got %#v", data["status"])
+	}
+	if priority, ok := data["priority"].(enumTestPriority); !ok || priority != 2 {
+		t.Errorf("priority enumTestPriority olarak dönmeli, got %#v", data["priority"])
+	}
+
+	errs := schema.Validate(map[string]any{"status": "deleted", "priority": 5, "role": "owner", "level": 15}).Errors()
+	expected := map[string]string{
+		"status":   "Durum alanı şu değerlerden biri olmalıdır: active, inactive, banned",
+		"priority": "priority alanı şu değerlerden biri olmalıdır: 1, 2, 3",
+		"role":     "role alanı şu değerlerden biri olmalıdır: admin, editor",
+		"level":    "level alanı şu değerlerden biri olmalıdır: 10, 20",
+	}
+	for field, msg := range expected {
+		if msgs := errs[field]; len(msgs) != 1 || msgs[0] != msg {
+			t.Errorf("%s: %q bekleniyordu, got %v", field, msg, msgs)
+		}
+	}
+
+	enumSchema := types.Enum[enumTestStatus]("active", "banned").JSONSchema()
+	if enumSchema["type"] != "string" || !reflect.DeepEqual(enumSchema["enum"], []enumTestStatus{"active", "banned"}) {
+		t.Errorf("Enum JSON Schema hatalı: %v", enumSchema)
+	}
+}
+
+type formTestStorePost struct {
+	Title    string `json:"title"`
+	Priority int64  `json:"priority"`
+}
+
+func (f *formTestStorePost) Authorize(r *conduitReq.Request) bool {
+	return r.Header.Get("X-Author") != ""
+}
+
+func (f *formTestStorePost) Rules() map[string]validation.Type {
+	return map[string]validation.Type{
+		"title":    types.String().Required().Trim().Max(20),
+		"priority": types.Int().Default(1).Between(1, 5),
+	}
+}
+
+func TestFormRequest(t *testing.T) {
+	var handled *formTestStorePost
+	r := router.New()
+	r.POST("/posts", router.Form(func(w http.ResponseWriter, r *conduitReq.Request, form *formTestStorePost) {
+		handled = form
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	tests := []struct {
+		name        string
+		author      string
+		contentType string
+		body        string
+		wantStatus  int
+	}{
+		{"unauthorized", "", "application/json", `{"title":"Merhaba"}`, http.StatusForbidden},
+		{"invalid json", "ahmet", "application/json", `{`, http.StatusBadRequest},
+		{"validation error", "ahmet", "application/json", `{"priority":9}`, http.StatusUnprocessableEntity},
+		{"json body", "ahmet", "application/json", `{"title":"  Merhaba  ","priority":"3"}`, http.StatusCreated},
+		{"form body", "ahmet", "application/x-www-form-urlencoded", "title=Merhaba", http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = nil
+			req := httptest.NewRequest(http.MethodPost, "/posts", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.author != "" {
+				req.Header.Set("X-Author", tt.author)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusCreated {
+				if handled != nil {
+					t.Error("Handler başarısız istekte çağrılmamalı")
+				}
+				return
+			}
+			if handled == nil || handled.Title != "Merhaba" {
+				t.Fatalf("Form doğrulanmış veriyle doldurulmalı, got %+v", handled)
+			}
+		})
+	}
+
+	if handled.Priority != 1 {
+		t.Errorf("Varsayılan priority form'a aktarılmalı, got %d", handled.Priority)
+	}
+}
+
+func TestNullableSometimesBail(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"nickname": types.String().Required().Nullable().Min(3),
+		"bio":      types.String().Sometimes().Required().Max(10),
+		"age":      types.Int().Nullable().Default(18),
+		"password": types.String().Required().Bail().Min(8).Max(4),
+		"address": types.Object(map[string]validation.Type{
+			"city": types.String().Sometimes().Required(),
+		}),
+	})
+
+	result := schema.Validate(map[string]any{
+		"nickname": nil,
+		"age":      nil,
+		"password": "abcdef",
+		"address":  map[string]any{},
+	})
+	errs := result.Errors()
+	if _, ok := errs["nickname"]; ok {
+		t.Errorf("Nullable alan null ile geçmeli: %v", errs["nickname"])
+	}
+	if _, ok := errs["bio"]; ok {
+		t.Errorf("Sometimes alan gönderilmediğinde doğrulanmamalı: %v", errs["bio"])
+	}
+	if _, ok := errs["address.city"]; ok {
+		t.Errorf("İç nesnede Sometimes alan atlanmalı: %v", errs["address.city"])
+	}
+	if msgs := errs["password"]; len(msgs) != 1 {
+		t.Errorf("Bail ile sadece ilk hata raporlanmalı, got %v", msgs)
+	}
+
+	errs = schema.Validate(map[string]any{"bio": "", "password": "longenough"}).Errors()
+	if _, ok := errs["nickname"]; !ok {
+		t.Error("Gönderilmeyen Required+Nullable alan hata vermeli")
+	}
+	if _, ok := errs["bio"]; !ok {
+		t.Error("Gönderilen Sometimes alan doğrulanmalı")
+	}
+
+	schema = validation.Make().Shape(map[string]validation.Type{
+		"nickname": types.String().Nullable(),
+		"age":      types.Int().Nullable().Default(18),
+		"bio":      types.String().Sometimes(),
+	})
+	result = schema.Validate(map[string]any{"nickname": nil, "age": nil})
+	if result.HasErrors() {
+		t.Fatalf("Beklenmeyen hata: %v", result.Errors())
+	}
+	data := result.ValidData()
+	if value, ok := data["age"]; !ok || value != nil {
+		t.Errorf("Nullable alan nil olarak kalmalı (default uygulanmamalı), got %#v", value)
+	}
+	if _, ok := data["bio"]; ok {
+		t.Error("Gönderilmeyen Sometimes alan validData'da olmamalı")
+	}
+
+	nameSchema := types.String().Nullable().JSONSchema()
+	if !reflect.DeepEqual(nameSchema["type"], []string{"string", "null"}) {
+		t.Errorf("Nullable JSON Schema tipi null içermeli, got %v", nameSchema["type"])
+	}
+}
+
+func TestRegexAndCustomMessages(t *testing.T) {
+	schema := validation.Make().Shape(map[string]validation.Type{
+		"username": types.String().Required().Label("Kullanıcı adı").
+			Regex(`^[a-z0-9_]{3,20}$`).
+			Message("regex", "{field} 3-20 karakter olmalı; küçük harf, rakam ve _ içerebilir").
+			Message("required", "Lütfen bir kullanıcı adı seçin"),
+		"code":     types.String().Regex(`^[A-Z]{3}$`),
+		"age":      types.Int().Min(18).Message("min", "18 yaşından küçükler kayıt olamaz"),
+		"password": types.String().Password(types.WithMinLength(8), types.WithRequireNumeric(true)).Message("password", "Parola en az 8 karakter ve bir rakam içermeli"),
+	})
+
+	errs := schema.Validate(map[string]any{"username": "Ahmet Altun", "code": "ab", "age": 16, "password": "abc"}).Errors()
+	expected := map[string]string{
+		"username": "Kullanıcı adı 3-20 karakter olmalı; küçük harf, rakam ve _ içerebilir",
+		"code":     "code alanı geçerli formatta değil",
+		"age":      "18 yaşından küçükler kayıt olamaz",
+		"password": "Parola en az 8 karakter ve bir rakam içermeli",
+	}
+	for field, msg := range expected {
+		if msgs := errs[field]; len(msgs) != 1 || msgs[0] != msg {
+			t.Errorf("%s: %q bekleniyordu, got %v", field, msg, msgs)
+		}
+	}
+
+	errs = schema.Validate(map[string]any{}).Errors()
+	if msgs := errs["username"]; len(msgs) != 1 || msgs[0] != "Lütfen bir kullanıcı adı seçin" {
+		t.Errorf("required için özel mesaj kullanılmalı, got %v", msgs)
+	}
+	if _, ok := errs["code"]; ok {
+		t.Error("Boş opsiyonel alan regex ile doğrulanmamalı")
+	}
+
+	result := schema.Validate(map[string]any{"username": "ahmet_60", "code": "TRY"})
+	if result.HasErrors() {
+		t.Errorf("Geçerli değerler hata vermemeli: %v", result.Errors())
+	}
+}
+
+func TestValidationErrorFormatters(t *testing.T) {
+	defer response.SetValidationFormatter(nil)
+
+	errs := map[string][]string{
+		"email":       {"Email alanı zorunludur"},
+		"address.zip": {"zip alanı en az 5 karakter olmalıdır"},
+	}
+
+	tests := []struct {
+		format      string
+		contentType string
+		want        string
+	}{
+		{"default", "application/json", `{"success":false,"data":{"address.zip":["zip alanı en az 5 karakter olmalıdır"],"email":["Email alanı zorunludur"]},"error":"Doğrulama hatası"}`},
+		{"flat", "application/json", `{"errors":{"address.zip":["zip alanı en az 5 karakter olmalıdır"],"email":["Email alanı zorunludur"]},"message":"Doğrulama hatası"}`},
+		{"jsonapi", "application/vnd.api+json", `{"errors":[{"status":"422","title":"Doğrulama hatası","detail":"zip alanı en az 5 karakter olmalıdır","source":{"pointer":"/data/attributes/address/zip"}},{"status":"422","title":"Doğrulama hatası","detail":"Email alanı zorunludur","source":{"pointer":"/data/attributes/email"}}]}`},
+		{"problem", "application/problem+json", `{"detail":"2 alanda doğrulama hatası var","invalid-params":[{"name":"address.zip","reason":"zip alanı en az 5 karakter olmalıdır"},{"name":"email","reason":"Email alanı zorunludur"}],"status":422,"title":"Doğrulama hatası","type":"about:blank"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := response.ValidationFormatterFor(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			response.SetValidationFormatter(formatter)
+
+			rec := httptest.NewRecorder()
+			response.Error(rec, http.StatusUnprocessableEntity, errs)
+
+			if rec.Code != http.StatusUnprocessableEntity {
+				t.Errorf("status = %d, want 422", rec.Code)
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.contentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.contentType)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tt.want {
+				t.Errorf("body =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := response.ValidationFormatterFor("xml"); err == nil {
+		t.Error("Bilinmeyen format hata vermeli")
+	}
+}
@@ -0,0 +1,49 @@
+// -----------------------------------------------------------------------------
+// Version Tests
+// -----------------------------------------------------------------------------
+// Build bilgisinin ve X-App-Version header middleware'inin testleri.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/pkg/version"
+)
+
+func TestVersionInfo(t *testing.T) {
+	original := version.Version
+	defer func() { version.Version = original }()
+
+	// ldflags enjeksiyonunu simüle et
+	version.Version = "1.4.0"
+
+	info := version.Get()
+	if info.Version != "1.4.0" {
+		t.Errorf("Version = %q, beklenen 1.4.0", info.Version)
+	}
+	if info.GoVersion == "" {
+		t.Error("GoVersion boş olmamalı")
+	}
+	if !strings.HasPrefix(version.String(), "1.4.0 (commit: ") {
+		t.Errorf("String() formatı hatalı: %s", version.String())
+	}
+}
+
+func TestVersionHeaderMiddleware(t *testing.T) {
+	handler := middleware.VersionHeader()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	if got := rec.Header().Get(middleware.VersionHeaderName); got != version.Version {
+		t.Errorf("%s = %q, beklenen %q", middleware.VersionHeaderName, got, version.Version)
+	}
+}
@@ -0,0 +1,141 @@
+// -----------------------------------------------------------------------------
+// View Engine Tests
+// -----------------------------------------------------------------------------
+// pkg/view'in layout, partial, paylaşılan veri, composer ve cache
+// davranışını ve response.View'in HTML yanıtlarını test eder.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/biyonik/conduit-go/internal/http/response"
+	"github.com/biyonik/conduit-go/internal/middleware"
+	"github.com/biyonik/conduit-go/pkg/view"
+	"github.com/biyonik/conduit-go/resources/views"
+)
+
+// newTestViewFS, layout, partial ve view içeren bir dosya sistemi döndürür.
+func newTestViewFS() fstest.MapFS {
+	return fstest.MapFS{
+		"layouts/app.html":  {Data: []byte(`<title>{{block "title" .}}{{shared "app_name"}}{{end}}</title>{{template "nav" .}}<main>{{template "content" .}}</main>`)},
+		"partials/nav.html": {Data: []byte(`<nav>{{if .auth_user}}{{.auth_user}}{{else}}misafir{{end}}</nav>`)},
+		"users/index.html":  {Data: []byte(`{{define "title"}}Kullanıcılar{{end}}{{range .users}}<li>{{.}}</li>{{end}}<a href="{{url "/users" "page" 2}}">{{.csrf_token}}</a>`)},
+		"broken.html":       {Data: []byte(`{{.missing.field}}`)},
+	}
+}
+
+func TestViewRender(t *testing.T) {
+	engine := view.New(newTestViewFS()).
+		Share("app_name", "Conduit").
+		BaseURL("https://conduit.test/").
+		Composer(func(r *http.Request) map[string]any {
+			return map[string]any{"auth_user": r.Header.Get("X-User"), "csrf_token": "tok"}
+		})
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	req.Header.Set("X-User", "ada@example.com")
+
+	var out bytes.Buffer
+	err := engine.Render(&out, req, "users/index", map[string]any{"users": []string{"<Ada>", "Linus"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<title>Kullanıcılar</title><nav>ada@example.com</nav><main><li>&lt;Ada&gt;</li><li>Linus</li><a href="https://conduit.test/users?page=2">tok</a></main>`
+	if out.String() != want {
+		t.Errorf("render =\n%s\nwant\n%s", out.String(), want)
+	}
+
+	// Handler verisi composer verisini ezer; istek yoksa composer çalışmaz
+	out.Reset()
+	engine.Render(&out, nil, "users/index", map[string]any{"csrf_token": "override"})
+	if !strings.Contains(out.String(), "<nav>misafir</nav>") || !strings.Contains(out.String(), ">override</a>") {
+		t.Errorf("render without request = %s", out.String())
+	}
+
+	if err := engine.Render(&out, nil, "missing", nil); err == nil {
+		t.Error("missing view should fail")
+	}
+}
+
+func TestViewCacheAndReload(t *testing.T) {
+	fsys := fstest.MapFS{"home.html": {Data: []byte("v1")}}
+	engine := view.New(fsys)
+
+	var out bytes.Buffer
+	engine.Render(&out, nil, "home", nil)
+	fsys["home.html"] = &fstest.MapFile{Data: []byte("v2")}
+
+	out.Reset()
+	engine.Render(&out, nil, "home", nil)
+	if out.String() != "v1" {
+		t.Errorf("cached render = %q, want v1", out.String())
+	}
+
+	out.Reset()
+	engine.Reload(true).Render(&out, nil, "home", nil)
+	if out.String() != "v2" {
+		t.Errorf("reloaded render = %q, want v2", out.String())
+	}
+}
+
+func TestResponseView(t *testing.T) {
+	defer view.SetEngine(nil)
+
+	w := httptest.NewRecorder()
+	if err := response.View(w, httptest.NewRequest("GET", "/", nil), "welcome", nil); !errors.Is(err, view.ErrNotConfigured) || w.Code != http.StatusInternalServerError {
+		t.Errorf("unconfigured = %v, %d", err, w.Code)
+	}
+
+	view.SetEngine(view.New(views.FS).Share("app_name", "Conduit"))
+
+	w = httptest.NewRecorder()
+	if err := response.View(w, httptest.NewRequest("GET", "/", nil), "welcome", nil); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "text/html; charset=utf-8" {
+		t.Errorf("welcome = %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	if body := w.Body.String(); !strings.Contains(body, "<title>Hoş geldiniz · Conduit</title>") || !strings.Contains(body, `href="/health"`) {
+		t.Errorf("welcome body = %s", body)
+	}
+
+	// Şablon hatasında yarım sayfa yerine 500 gönderilir
+	view.SetEngine(view.New(newTestViewFS()))
+	w = httptest.NewRecorder()
+	err := response.ViewStatus(w, nil, http.StatusNotFound, "broken", map[string]any{"missing": 1})
+	if err == nil || w.Code != http.StatusInternalServerError || strings.Contains(w.Body.String(), "<main>") {
+		t.Errorf("broken view = %v, %d, %s", err, w.Code, w.Body)
+	}
+}
+
+func TestCSRFTokenFromRequest(t *testing.T) {
+	var token string
+	handler := middleware.CSRFProtection()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = middleware.CSRFTokenFromRequest(r)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/form", nil))
+
+	var cookie string
+	for _, c := range w.Result().Cookies() {
+		if c.Name == middleware.CSRFCookieName {
+			cookie = c.Value
+		}
+	}
+	if token == "" || token != cookie {
+		t.Errorf("context token = %q, cookie = %q", token, cookie)
+	}
+	if got := middleware.CSRFTokenFromRequest(httptest.NewRequest("GET", "/", nil)); got != "" {
+		t.Errorf("token without middleware = %q", got)
+	}
+}
@@ -0,0 +1,394 @@
+// -----------------------------------------------------------------------------
+// WebSocket Tests
+// -----------------------------------------------------------------------------
+// pkg/ws handshake'ini, frame protokolünü ve Hub kanallarına abonelik,
+// private kanal yetkilendirmesi ve bağlantı içi token doğrulamasını test
+// eder. Client tarafı test içinde minimal olarak implement edilmiştir.
+// -----------------------------------------------------------------------------
+
+package tests
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/biyonik/conduit-go/pkg/auth"
+	"github.com/biyonik/conduit-go/pkg/broadcast"
+	"github.com/biyonik/conduit-go/pkg/ws"
+)
+
+// wsTestClient, test için maskeli frame gönderen WebSocket client'ıdır.
+type wsTestClient struct {
+	t    *testing.T
+	conn net.Conn
+	br   *bufio.Reader
+}
+
+// wsMessage, sunucunun gönderdiği JSON mesajıdır.
+type wsMessage struct {
+	Event   string          `json:"event"`
+	Channel string          `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func newWSTestServer(t *testing.T, user auth.User) (*httptest.Server, *broadcast.Hub, *ws.Server) {
+	t.Helper()
+
+	hub := broadcast.NewHub(log.New(io.Discard, "", 0))
+	hub.Channel("private-users.{id}", func(user auth.User, params map[string]string) bool {
+		return strconv.FormatInt(user.GetID(), 10) == params["id"]
+	})
+
+	server := ws.NewServer(hub, log.New(io.Discard, "", 0))
+	server.Authenticate = func(token string) (auth.User, error) {
+		if token != "valid-token" {
+			return nil, errors.New("invalid token")
+		}
+		return &auth.AuthenticatedUser{ID: 42}, nil
+	}
+
+	// OptionalAuth yerine kullanıcıyı doğrudan context'e koy
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user != nil {
+			r = r.WithContext(context.WithValue(r.Context(), "user", user))
+		}
+		server.ServeHTTP(w, r)
+	})
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(func() {
+		server.Close()
+		ts.Close()
+	})
+	return ts, hub, server
+}
+
+func dialWS(t *testing.T, ts *httptest.Server, query string) *wsTestClient {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	handshake := "GET /?" + query + " HTTP/1.1\r\n" +
+		"Host: " + ts.Listener.Addr().String() + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: keep-alive, Upgrade\r\n" +
+		"Sec-WebSocket-Key: " + key + "\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"
+	if _, err := conn.Write([]byte(handshake)); err != nil {
+		t.Fatal(err)
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("handshake status = %d", resp.StatusCode)
+	}
+	// base64(SHA1(key + "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"))
+	if got := resp.Header.Get("Sec-WebSocket-Accept"); got != "BACScCJPNqyz+UBoqMH89VmURoA=" {
+		t.Errorf("Sec-WebSocket-Accept = %q", got)
+	}
+
+	return &wsTestClient{t: t, conn: conn, br: br}
+}
+
+// writeFrame, maskeli bir frame gönderir.
+func (c *wsTestClient) writeFrame(fin bool, opcode byte, payload []byte) {
+	c.t.Helper()
+
+	first := opcode
+	if fin {
+		first |= 0x80
+	}
+	frame := []byte{first}
+	switch {
+	case len(payload) <= 125:
+		frame = append(frame, 0x80|byte(len(payload)))
+	case len(payload) <= 0xffff:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+
+	mask := []byte{1, 2, 3, 4}
+	frame = append(frame, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	if _, err := c.conn.Write(frame); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *wsTestClient) sendJSON(v any) {
+	c.t.Helper()
+	data, _ := json.Marshal(v)
+	c.writeFrame(true, 1, data)
+}
+
+// readFrame, sunucunun maskesiz frame'ini okur.
+func (c *wsTestClient) readFrame() (byte, []byte) {
+	c.t.Helper()
+
+	var header [2]byte
+	if _, err := io.ReadFull(c.br, header[:]); err != nil {
+		c.t.Fatalf("frame okunamadı: %v", err)
+	}
+	if header[1]&0x80 != 0 {
+		c.t.Fatal("sunucu frame'leri maskelenmemeli")
+	}
+
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		io.ReadFull(c.br, ext[:])
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		io.ReadFull(c.br, ext[:])
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		c.t.Fatal(err)
+	}
+	return header[0] & 0x0f, payload
+}
+
+func (c *wsTestClient) readMessage() wsMessage {
+	c.t.Helper()
+
+	opcode, payload := c.readFrame()
+	if opcode != 1 {
+		c.t.Fatalf("opcode = %d, want text (payload: %q)", opcode, payload)
+	}
+	var msg wsMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		c.t.Fatal(err)
+	}
+	return msg
+}
+
+// expectClose, close frame'ini okur ve kodunu döndürür.
+func (c *wsTestClient) expectClose() int {
+	c.t.Helper()
+
+	opcode, payload := c.readFrame()
+	if opcode != 8 || len(payload) < 2 {
+		c.t.Fatalf("close frame bekleniyordu, opcode = %d", opcode)
+	}
+	return int(binary.BigEndian.Uint16(payload))
+}
+
+func waitForSubscribers(t *testing.T, hub *broadcast.Hub, channel string, want int) {
+	t.Helper()
+	for i := 0; i < 100 && hub.Subscribers(channel) != want; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := hub.Subscribers(channel); got != want {
+		t.Fatalf("Subscribers(%s) = %d, want %d", channel, got, want)
+	}
+}
+
+func TestWebSocketSubscribeAndReceive(t *testing.T) {
+	ts, hub, _ := newWSTestServer(t, nil)
+	client := dialWS(t, ts, "channels=orders")
+
+	if msg := client.readMessage(); msg.Event != ws.EventSubscribed || msg.Channel != "orders" {
+		t.Fatalf("first message = %+v", msg)
+	}
+
+	hub.Broadcast([]string{"orders"}, "order.shipped", map[string]int{"order_id": 7})
+	msg := client.readMessage()
+	if msg.Event != "order.shipped" || msg.Channel != "orders" || string(msg.Data) != `{"order_id":7}` {
+		t.Errorf("broadcast message = %+v (data %s)", msg, msg.Data)
+	}
+
+	// Mesaj içinden abone ol / ayrıl
+	client.sendJSON(map[string]string{"action": "subscribe", "channel": "invoices"})
+	if msg := client.readMessage(); msg.Event != ws.EventSubscribed || msg.Channel != "invoices" {
+		t.Fatalf("subscribe reply = %+v", msg)
+	}
+	client.sendJSON(map[string]string{"action": "unsubscribe", "channel": "orders"})
+	if msg := client.readMessage(); msg.Event != ws.EventUnsubscribed {
+		t.Fatalf("unsubscribe reply = %+v", msg)
+	}
+	waitForSubscribers(t, hub, "orders", 0)
+
+	hub.Broadcast([]string{"orders", "invoices"}, "updated", nil)
+	if msg := client.readMessage(); msg.Channel != "invoices" {
+		t.Errorf("unsubscribed channel should not deliver, got %+v", msg)
+	}
+
+	client.sendJSON(map[string]string{"action": "ping"})
+	if msg := client.readMessage(); msg.Event != ws.EventPong {
+		t.Errorf("ping reply = %+v", msg)
+	}
+}
+
+func TestWebSocketPrivateChannels(t *testing.T) {
+	t.Run("guest handshake'te reddedilir", func(t *testing.T) {
+		ts, _, _ := newWSTestServer(t, nil)
+		req, _ := http.NewRequest("GET", ts.URL+"/?channels=private-users.42", nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("status = %d, want 401", resp.StatusCode)
+		}
+	})
+
+	t.Run("context kullanıcısı", func(t *testing.T) {
+		ts, _, _ := newWSTestServer(t, &auth.AuthenticatedUser{ID: 42})
+		client := dialWS(t, ts, "channels=private-users.42")
+		if msg := client.readMessage(); msg.Event != ws.EventSubscribed {
+			t.Fatalf("owner should subscribe, got %+v", msg)
+		}
+
+		client.sendJSON(map[string]string{"action": "subscribe", "channel": "private-users.7"})
+		if msg := client.readMessage(); msg.Event != ws.EventError || msg.Channel != "private-users.7" {
+			t.Errorf("foreign private channel should fail, got %+v", msg)
+		}
+	})
+
+	t.Run("bağlantı içi token", func(t *testing.T) {
+		ts, hub, _ := newWSTestServer(t, nil)
+		client := dialWS(t, ts, "")
+
+		client.sendJSON(map[string]string{"action": "subscribe", "channel": "private-users.42"})
+		if msg := client.readMessage(); msg.Event != ws.EventError {
+			t.Fatalf("guest should not subscribe, got %+v", msg)
+		}
+
+		client.sendJSON(map[string]string{"action": "auth", "token": "wrong"})
+		if msg := client.readMessage(); msg.Event != ws.EventError {
+			t.Fatalf("invalid token should fail, got %+v", msg)
+		}
+
+		client.sendJSON(map[string]string{"action": "auth", "token": "valid-token"})
+		if msg := client.readMessage(); msg.Event != ws.EventAuthenticated {
+			t.Fatalf("auth reply = %+v", msg)
+		}
+		client.sendJSON(map[string]string{"action": "subscribe", "channel": "private-users.42"})
+		if msg := client.readMessage(); msg.Event != ws.EventSubscribed {
+			t.Fatalf("authenticated subscribe reply = %+v", msg)
+		}
+
+		hub.Broadcast([]string{"private-users.42"}, "notification", "hi")
+		if msg := client.readMessage(); msg.Event != "notification" || string(msg.Data) != `"hi"` {
+			t.Errorf("private message = %+v", msg)
+		}
+	})
+}
+
+func TestWebSocketProtocol(t *testing.T) {
+	t.Run("parçalı mesaj ve ping", func(t *testing.T) {
+		ts, _, _ := newWSTestServer(t, nil)
+		client := dialWS(t, ts, "")
+
+		client.writeFrame(true, 9, []byte("hello"))
+		if opcode, payload := client.readFrame(); opcode != 10 || string(payload) != "hello" {
+			t.Errorf("ping reply = %d %q, want pong", opcode, payload)
+		}
+
+		client.writeFrame(false, 1, []byte(`{"action":`))
+		client.writeFrame(true, 0, []byte(`"ping"}`))
+		if msg := client.readMessage(); msg.Event != ws.EventPong {
+			t.Errorf("fragmented message reply = %+v", msg)
+		}
+	})
+
+	t.Run("büyük mesaj 1009 ile kapanır", func(t *testing.T) {
+		ts, _, _ := newWSTestServer(t, nil)
+		client := dialWS(t, ts, "")
+
+		client.writeFrame(true, 1, []byte(strings.Repeat("x", 5<<10)))
+		if code := client.expectClose(); code != ws.CloseMessageTooBig {
+			t.Errorf("close code = %d, want %d", code, ws.CloseMessageTooBig)
+		}
+	})
+
+	t.Run("client close", func(t *testing.T) {
+		ts, _, server := newWSTestServer(t, nil)
+		client := dialWS(t, ts, "")
+
+		client.writeFrame(true, 8, binary.BigEndian.AppendUint16(nil, ws.CloseNormal))
+		if code := client.expectClose(); code != ws.CloseNormal {
+			t.Errorf("close code = %d", code)
+		}
+		for i := 0; i < 100 && server.Connections() != 0; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if server.Connections() != 0 {
+			t.Error("closed connection should be removed")
+		}
+	})
+
+	t.Run("server close 1001 gönderir", func(t *testing.T) {
+		ts, hub, server := newWSTestServer(t, nil)
+		client := dialWS(t, ts, "channels=orders")
+		client.readMessage()
+
+		server.Close()
+		if code := client.expectClose(); code != ws.CloseGoingAway {
+			t.Errorf("close code = %d, want %d", code, ws.CloseGoingAway)
+		}
+		waitForSubscribers(t, hub, "orders", 0)
+	})
+
+	t.Run("geçersiz handshake", func(t *testing.T) {
+		ts, _, _ := newWSTestServer(t, nil)
+		resp, err := http.Get(ts.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("farklı origin reddedilir", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "http://api.example.com/ws", nil)
+		req.Header.Set("Origin", "https://evil.example")
+		if ws.SameOrigin(req) {
+			t.Error("cross-origin request should be rejected")
+		}
+		if !ws.AllowOrigins([]string{"https://evil.example"})(req) {
+			t.Error("allowed origin should be accepted")
+		}
+		req.Header.Set("Origin", "http://api.example.com")
+		if !ws.SameOrigin(req) {
+			t.Error("same origin should be accepted")
+		}
+	})
+}